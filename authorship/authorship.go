@@ -0,0 +1,271 @@
+// Package authorship normalizes and compares authorships of scientific
+// names. It works with authorships from details of the parser's output, so
+// the same authorship written differently, like '(L.) Mill.',
+// '(Linnaeus) Miller' or '(L.)Mill', can be recognized.
+package authorship
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/grammar"
+	"github.com/gnames/gnparser/str"
+)
+
+// Match is the result of a comparison of two authorships.
+type Match int
+
+const (
+	// Exact means that authorships are the same after normalization.
+	Exact Match = iota
+	// Compatible means that authorships differ, but they might belong to
+	// the same name, for example if one of them has no year.
+	Compatible
+	// Conflicting means that authorships belong to different names.
+	Conflicting
+)
+
+var matchMap = map[Match]string{
+	Exact:       "exact",
+	Compatible:  "compatible",
+	Conflicting: "conflicting",
+}
+
+func (m Match) String() string {
+	return matchMap[m]
+}
+
+// MarshalText represents a match as a string in JSON.
+func (m Match) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Score converts a match to a number from 0 to 1, where 1 is an exact
+// match.
+func (m Match) Score() float64 {
+	switch m {
+	case Exact:
+		return 1
+	case Compatible:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// Reason explains why authorships are not an exact match.
+type Reason int
+
+const (
+	// MissingAuthorship means that one of the names has no authorship.
+	MissingAuthorship Reason = iota
+	// MissingBasionym means that only one authorship has authors of the
+	// basionym.
+	MissingBasionym
+	// MissingCombination means that only one authorship has authors of the
+	// combination.
+	MissingCombination
+	// MissingYear means that only one of the authorships has a year.
+	MissingYear
+	// YearMismatch means that years of authorships are different.
+	YearMismatch
+	// Abbreviation means that the same authors are given as an abbreviation
+	// in one authorship and as a full name in another, like 'L.' and
+	// 'Linnaeus'.
+	Abbreviation
+	// BasionymSwap means that authors of the basionym in one authorship
+	// are authors of the combination in another, like '(L.)' and 'L.'.
+	BasionymSwap
+	// AuthorMismatch means that authorships have different authors.
+	AuthorMismatch
+)
+
+var reasonMap = map[Reason]string{
+	MissingAuthorship:  "missing authorship",
+	MissingBasionym:    "missing basionym authors",
+	MissingCombination: "missing combination authors",
+	MissingYear:        "missing year",
+	YearMismatch:       "year mismatch",
+	Abbreviation:       "abbreviation vs full name",
+	BasionymSwap:       "basionym vs combination swap",
+	AuthorMismatch:     "author mismatch",
+}
+
+func (r Reason) String() string {
+	return reasonMap[r]
+}
+
+// MarshalText represents a reason as a string in JSON.
+func (r Reason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// Comparison is the result of CompareAuthorship.
+type Comparison struct {
+	// Match tells how close the authorships are.
+	Match Match `json:"match"`
+	// Reasons explain why the match is not exact.
+	Reasons []Reason `json:"reasons,omitempty"`
+}
+
+func (c *Comparison) add(m Match, r Reason) {
+	if m > c.Match {
+		c.Match = m
+	}
+	for _, v := range c.Reasons {
+		if v == r {
+			return
+		}
+	}
+	c.Reasons = append(c.Reasons, r)
+}
+
+// group is a normalized group of authors with their year. Keys are
+// surnames of authors where abbreviations are resolved. Forms are
+// normalized names of authors as they are written.
+type group struct {
+	keys  []string
+	forms []string
+	year  string
+}
+
+func newGroup(ag *grammar.AuthGroupOutput) *group {
+	if ag == nil {
+		return nil
+	}
+	g := group{}
+	for i, v := range ag.Authors {
+		form := Normalize(v)
+		key := form
+		if i < len(ag.AuthorDetails) && ag.AuthorDetails[i].Key != "" {
+			key = ag.AuthorDetails[i].Key
+		}
+		g.keys = append(g.keys, key)
+		g.forms = append(g.forms, form)
+	}
+	if ag.Year != nil {
+		g.year = Normalize(ag.Year.Value)
+	}
+	return &g
+}
+
+func (g *group) key() string {
+	return str.JoinStrings(strings.Join(g.keys, " & "), g.year, " ")
+}
+
+// groups returns authors of the basionym and of the combination. Authors
+// of a name without parentheses are authors of the combination.
+func groups(ao *grammar.AuthorshipOutput) (*group, *group) {
+	if ao.Combination != nil || strings.HasPrefix(ao.Value, "(") {
+		return newGroup(ao.Original), newGroup(ao.Combination)
+	}
+	return nil, newGroup(ao.Original)
+}
+
+// Normalize converts a string to lower case ASCII, where punctuation is
+// replaced by spaces and spaces are collapsed, like 'hook f' for 'Hook.f.'.
+func Normalize(s string) string {
+	bs, _ := str.ToASCII([]byte(s), str.Transliterations)
+	ws := strings.FieldsFunc(string(bs), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.ToLower(strings.Join(ws, " "))
+}
+
+// Key creates a canonical key of an authorship, like
+// '(linnaeus 1753) miller' for '(L., 1753) Mill.'. Abbreviations of authors
+// known to the parser are replaced by surnames. Ex-, emend- and
+// sanctioning authors are not the part of the key.
+func Key(ao *grammar.AuthorshipOutput) string {
+	if ao == nil {
+		return ""
+	}
+	bas, comb := groups(ao)
+	var res string
+	if bas != nil {
+		res = "(" + bas.key() + ")"
+	}
+	if comb != nil {
+		res = str.JoinStrings(res, comb.key(), " ")
+	}
+	return res
+}
+
+// CompareAuthorship compares two authorships and tells if they are the
+// same, compatible or conflicting. Ex-, emend- and sanctioning authors are
+// ignored.
+func CompareAuthorship(a, b *grammar.AuthorshipOutput) Comparison {
+	var c Comparison
+	if a == nil || b == nil {
+		if a != nil || b != nil {
+			c.add(Compatible, MissingAuthorship)
+		}
+		return c
+	}
+	basA, combA := groups(a)
+	basB, combB := groups(b)
+	if isSwap(basA, combA, basB, combB) {
+		c.add(Compatible, BasionymSwap)
+		compareGroups(combA, basB, &c)
+		return c
+	}
+	if isSwap(basB, combB, basA, combA) {
+		c.add(Compatible, BasionymSwap)
+		compareGroups(basA, combB, &c)
+		return c
+	}
+	compareGroup(basA, basB, MissingBasionym, &c)
+	compareGroup(combA, combB, MissingCombination, &c)
+	return c
+}
+
+// isSwap detects the case where the only authors of the first authorship
+// are combination authors, and the only authors of the second one are
+// basionym authors, like 'L.' and '(L.)'.
+func isSwap(basA, combA, basB, combB *group) bool {
+	return basA == nil && combB == nil && combA != nil && basB != nil &&
+		sameAuthors(combA, basB)
+}
+
+func compareGroup(g1, g2 *group, missing Reason, c *Comparison) {
+	if g1 == nil && g2 == nil {
+		return
+	}
+	if g1 == nil || g2 == nil {
+		c.add(Compatible, missing)
+		return
+	}
+	compareGroups(g1, g2, c)
+}
+
+func compareGroups(g1, g2 *group, c *Comparison) {
+	if !sameAuthors(g1, g2) {
+		c.add(Conflicting, AuthorMismatch)
+	} else {
+		for i := range g1.forms {
+			if g1.forms[i] != g2.forms[i] {
+				c.add(Compatible, Abbreviation)
+				break
+			}
+		}
+	}
+	switch {
+	case g1.year == g2.year:
+	case g1.year == "" || g2.year == "":
+		c.add(Compatible, MissingYear)
+	default:
+		c.add(Conflicting, YearMismatch)
+	}
+}
+
+func sameAuthors(g1, g2 *group) bool {
+	if len(g1.keys) != len(g2.keys) {
+		return false
+	}
+	for i := range g1.keys {
+		if g1.keys[i] != g2.keys[i] {
+			return false
+		}
+	}
+	return true
+}
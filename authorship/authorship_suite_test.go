@@ -0,0 +1,13 @@
+package authorship_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthorship(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authorship Suite")
+}
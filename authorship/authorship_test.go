@@ -0,0 +1,78 @@
+package authorship_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/gnames/gnparser"
+	. "github.com/gnames/gnparser/authorship"
+	"github.com/gnames/gnparser/grammar"
+)
+
+var gnp = gnparser.NewGNparser()
+
+func auth(name string) *grammar.AuthorshipOutput {
+	return gnp.Parse(name).LastAuthorship()
+}
+
+var _ = Describe("Authorship", func() {
+	DescribeTable("Normalize",
+		func(s string, expected string) {
+			Expect(Normalize(s)).To(Equal(expected))
+		},
+		Entry("abbreviation", "Hook.f.", "hook f"),
+		Entry("diacritics", "Döring", "doering"),
+		Entry("spaces", " A.  P. de  Candolle ", "a p de candolle"),
+	)
+
+	DescribeTable("Key",
+		func(name string, expected string) {
+			Expect(Key(auth(name))).To(Equal(expected))
+		},
+		Entry("abbreviations", "Aus bus (L.) Mill.", "(linnaeus) miller"),
+		Entry("full names", "Aus bus (Linnaeus) Miller", "(linnaeus) miller"),
+		Entry("no spaces", "Aus bus (L.)Mill", "(linnaeus) miller"),
+		Entry("years", "Aus bus (L., 1753) Mill. 1768",
+			"(linnaeus 1753) miller 1768"),
+		Entry("team", "Aus bus Döring & A. Smith 1890", "doering & smith 1890"),
+		Entry("ex authors", "Aus bus Desv. ex Ham.", "desv"),
+		Entry("no authorship", "Aus bus", ""),
+	)
+
+	DescribeTable("CompareAuthorship",
+		func(name1, name2 string, match Match, reasons []Reason) {
+			res := CompareAuthorship(auth(name1), auth(name2))
+			Expect(res.Match).To(Equal(match))
+			Expect(res.Reasons).To(Equal(reasons))
+			res = CompareAuthorship(auth(name2), auth(name1))
+			Expect(res.Match).To(Equal(match))
+		},
+		Entry("same", "Aus bus (L.) Mill.", "Aus bus (L.)Mill",
+			Exact, []Reason(nil)),
+		Entry("no authorship", "Aus bus", "Aus bus", Exact, []Reason(nil)),
+		Entry("missing authorship", "Aus bus L.", "Aus bus",
+			Compatible, []Reason{MissingAuthorship}),
+		Entry("abbreviation", "Aus bus (L.) Mill.", "Aus bus (Linnaeus) Miller",
+			Compatible, []Reason{Abbreviation}),
+		Entry("missing year", "Aus bus L. 1753", "Aus bus L.",
+			Compatible, []Reason{MissingYear}),
+		Entry("year mismatch", "Aus bus L. 1753", "Aus bus L. 1758",
+			Conflicting, []Reason{YearMismatch}),
+		Entry("swap", "Aus bus L.", "Aus bus (Linnaeus)",
+			Compatible, []Reason{BasionymSwap, Abbreviation}),
+		Entry("missing basionym", "Aus bus (L.) Mill.", "Aus bus Mill.",
+			Compatible, []Reason{MissingBasionym}),
+		Entry("missing combination", "Aus bus (L.) Mill.", "Aus bus (L.)",
+			Compatible, []Reason{MissingCombination}),
+		Entry("different authors", "Aus bus (L.) Mill.", "Aus bus (L.) Sm.",
+			Conflicting, []Reason{AuthorMismatch}),
+		Entry("different combination", "Aus bus (L.) Mill.", "Aus bus L.",
+			Conflicting, []Reason{MissingBasionym, AuthorMismatch}),
+	)
+
+	It("converts results to strings", func() {
+		Expect(Compatible.String()).To(Equal("compatible"))
+		Expect(BasionymSwap.String()).To(Equal("basionym vs combination swap"))
+	})
+})
@@ -0,0 +1,381 @@
+// Package cluster groups names that have the same stemmed canonical form.
+// Such groups contain duplicates and orthographic variants of names. Names
+// are sorted by stems in temporary files, so the memory usage does not
+// depend on the number of names.
+package cluster
+
+import (
+	"bufio"
+	"container/heap"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnparser/output"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// DefaultChunkSize is the number of names kept in memory before they are
+// sorted and written to a temporary file.
+const DefaultChunkSize = 100000
+
+// Member is a name that belongs to a group.
+type Member struct {
+	// ID is the position of the name in the input starting from 1.
+	ID int `json:"id"`
+	// Name is the verbatim name-string.
+	Name string `json:"name"`
+	// Quality of parsing of the name.
+	Quality int `json:"quality"`
+	// Authorship of the name.
+	Authorship string `json:"authorship,omitempty"`
+}
+
+// Variant is a distinct value of names in a group with the number of names
+// that have it.
+type Variant struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// Group contains names with the same stemmed canonical form.
+type Group struct {
+	// Stem is the stemmed canonical form of names.
+	Stem string `json:"stem"`
+	// Size is the number of names in the group.
+	Size int `json:"size"`
+	// Representative is the name with the best quality of parsing. Names
+	// with authorship are preferred to names without it, then names that
+	// come first in the input.
+	Representative Member `json:"representative"`
+	// SpellingVariants are distinct canonical forms of names.
+	SpellingVariants []Variant `json:"spellingVariants"`
+	// AuthorshipVariants are distinct authorships of names.
+	AuthorshipVariants []Variant `json:"authorshipVariants,omitempty"`
+}
+
+// record is a member of a group with its stem as it is kept in temporary
+// files.
+type record struct {
+	Stem string `json:"s"`
+	Member
+	Canonical string `json:"c"`
+}
+
+// Clusterer collects parsed names and groups them by their stems.
+type Clusterer struct {
+	dir       string
+	chunkSize int
+	chunk     []record
+	files     []string
+}
+
+// NewClusterer creates a Clusterer that keeps temporary files in dir. If
+// dir is empty, the default directory for temporary files is used. If
+// chunkSize is less than 1, DefaultChunkSize is used.
+func NewClusterer(dir string, chunkSize int) *Clusterer {
+	if chunkSize < 1 {
+		chunkSize = DefaultChunkSize
+	}
+	return &Clusterer{dir: dir, chunkSize: chunkSize}
+}
+
+// Add adds a parsed name to the Clusterer. The id is the position of the
+// name in the input. Names that could not be parsed are ignored.
+func (c *Clusterer) Add(id int, o *output.Output) error {
+	if !o.Parsed {
+		return nil
+	}
+	r := record{
+		Stem: o.CanonicalName.Stem,
+		Member: Member{
+			ID:         id,
+			Name:       o.Verbatim,
+			Quality:    o.Quality,
+			Authorship: o.Authorship,
+		},
+		Canonical: o.CanonicalName.Simple,
+	}
+	c.chunk = append(c.chunk, r)
+	if len(c.chunk) >= c.chunkSize {
+		return c.flush()
+	}
+	return nil
+}
+
+// flush sorts collected records and writes them to a temporary file.
+func (c *Clusterer) flush() error {
+	if len(c.chunk) == 0 {
+		return nil
+	}
+	sort.Slice(c.chunk, func(i, j int) bool {
+		return less(&c.chunk[i], &c.chunk[j])
+	})
+	f, err := ioutil.TempFile(c.dir, "gnparser_cluster_")
+	if err != nil {
+		return err
+	}
+	c.files = append(c.files, f.Name())
+	w := bufio.NewWriter(f)
+	enc := jsoniter.NewEncoder(w)
+	for i := range c.chunk {
+		if err = enc.Encode(&c.chunk[i]); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	c.chunk = c.chunk[:0]
+	return f.Close()
+}
+
+// Groups merges sorted temporary files and calls fn for every group in the
+// alphabetical order of stems. Temporary files are removed at the end.
+func (c *Clusterer) Groups(fn func(Group) error) error {
+	defer c.Close()
+	if err := c.flush(); err != nil {
+		return err
+	}
+	m, err := newMerger(c.files)
+	if err != nil {
+		return err
+	}
+	defer m.close()
+	var g *groupBuilder
+	for {
+		r, err := m.next()
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			break
+		}
+		if g != nil && g.stem != r.Stem {
+			if err = fn(g.group()); err != nil {
+				return err
+			}
+			g = nil
+		}
+		if g == nil {
+			g = newGroupBuilder(r.Stem)
+		}
+		g.add(r)
+	}
+	if g != nil {
+		return fn(g.group())
+	}
+	return nil
+}
+
+// Close removes temporary files of the Clusterer.
+func (c *Clusterer) Close() error {
+	var err error
+	for _, v := range c.files {
+		if e := os.Remove(v); e != nil && !os.IsNotExist(e) {
+			err = e
+		}
+	}
+	c.files = nil
+	return err
+}
+
+func less(a, b *record) bool {
+	if a.Stem != b.Stem {
+		return a.Stem < b.Stem
+	}
+	return a.ID < b.ID
+}
+
+// groupBuilder collects members of a group. It keeps only distinct values
+// of variants, so large groups do not use much memory.
+type groupBuilder struct {
+	stem        string
+	size        int
+	best        Member
+	spelling    map[string]int
+	spellOrder  []string
+	authorship  map[string]int
+	authorOrder []string
+}
+
+func newGroupBuilder(stem string) *groupBuilder {
+	return &groupBuilder{
+		stem:       stem,
+		spelling:   make(map[string]int),
+		authorship: make(map[string]int),
+	}
+}
+
+func (g *groupBuilder) add(r *record) {
+	if g.size == 0 || better(&r.Member, &g.best) {
+		g.best = r.Member
+	}
+	g.size++
+	if _, ok := g.spelling[r.Canonical]; !ok {
+		g.spellOrder = append(g.spellOrder, r.Canonical)
+	}
+	g.spelling[r.Canonical]++
+	if r.Authorship == "" {
+		return
+	}
+	if _, ok := g.authorship[r.Authorship]; !ok {
+		g.authorOrder = append(g.authorOrder, r.Authorship)
+	}
+	g.authorship[r.Authorship]++
+}
+
+// better tells if a member is a better representative than the current
+// one. Members come in the order of the input.
+func better(m, best *Member) bool {
+	if m.Quality != best.Quality {
+		return m.Quality < best.Quality
+	}
+	return m.Authorship != "" && best.Authorship == ""
+}
+
+func (g *groupBuilder) group() Group {
+	res := Group{Stem: g.stem, Size: g.size, Representative: g.best}
+	for _, v := range g.spellOrder {
+		res.SpellingVariants = append(res.SpellingVariants,
+			Variant{Value: v, Count: g.spelling[v]})
+	}
+	for _, v := range g.authorOrder {
+		res.AuthorshipVariants = append(res.AuthorshipVariants,
+			Variant{Value: v, Count: g.authorship[v]})
+	}
+	return res
+}
+
+// CSVHeader returns names of fields created by Group.ToSlice.
+func CSVHeader() []string {
+	return []string{
+		"Stem",
+		"Size",
+		"RepresentativeID",
+		"Representative",
+		"Quality",
+		"SpellingVariants",
+		"AuthorshipVariants",
+	}
+}
+
+// ToSlice creates a flat version of the group for CSV output. Variants are
+// separated by semicolons.
+func (g Group) ToSlice() []string {
+	return []string{
+		g.Stem,
+		strconv.Itoa(g.Size),
+		strconv.Itoa(g.Representative.ID),
+		g.Representative.Name,
+		strconv.Itoa(g.Representative.Quality),
+		joinVariants(g.SpellingVariants),
+		joinVariants(g.AuthorshipVariants),
+	}
+}
+
+// ToJSON converts the group to JSON representation.
+func (g Group) ToJSON(pretty bool) ([]byte, error) {
+	if pretty {
+		return jsoniter.MarshalIndent(g, "", "  ")
+	}
+	return jsoniter.Marshal(g)
+}
+
+func joinVariants(vs []Variant) string {
+	res := make([]string, len(vs))
+	for i, v := range vs {
+		res[i] = v.Value
+	}
+	return strings.Join(res, "; ")
+}
+
+// merger reads records from sorted files in the sorted order.
+type merger struct {
+	files []*os.File
+	h     recordHeap
+}
+
+type source struct {
+	r   *record
+	dec *jsoniter.Decoder
+}
+
+func newMerger(paths []string) (*merger, error) {
+	m := merger{}
+	for _, v := range paths {
+		f, err := os.Open(v)
+		if err != nil {
+			m.close()
+			return nil, err
+		}
+		m.files = append(m.files, f)
+		s := &source{dec: jsoniter.NewDecoder(bufio.NewReader(f))}
+		if err = s.read(); err != nil {
+			m.close()
+			return nil, err
+		}
+		if s.r != nil {
+			m.h = append(m.h, s)
+		}
+	}
+	heap.Init(&m.h)
+	return &m, nil
+}
+
+// next returns the next record in the sorted order, or nil when all records
+// are read.
+func (m *merger) next() (*record, error) {
+	if len(m.h) == 0 {
+		return nil, nil
+	}
+	s := m.h[0]
+	r := s.r
+	if err := s.read(); err != nil {
+		return nil, err
+	}
+	if s.r == nil {
+		heap.Pop(&m.h)
+	} else {
+		heap.Fix(&m.h, 0)
+	}
+	return r, nil
+}
+
+func (m *merger) close() {
+	for _, f := range m.files {
+		f.Close()
+	}
+}
+
+func (s *source) read() error {
+	if !s.dec.More() {
+		s.r = nil
+		return nil
+	}
+	var r record
+	if err := s.dec.Decode(&r); err != nil {
+		return err
+	}
+	s.r = &r
+	return nil
+}
+
+type recordHeap []*source
+
+func (h recordHeap) Len() int            { return len(h) }
+func (h recordHeap) Less(i, j int) bool  { return less(h[i].r, h[j].r) }
+func (h recordHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *recordHeap) Push(x interface{}) { *h = append(*h, x.(*source)) }
+
+func (h *recordHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,77 @@
+package cluster_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/gnames/gnparser"
+	. "github.com/gnames/gnparser/cluster"
+)
+
+var _ = Describe("Cluster", func() {
+	gnp := gnparser.NewGNparser()
+	names := []string{
+		"Aus albus L.",
+		"Bus cus",
+		"Aus alba",
+		"Aus albus Linnaeus 1753",
+		"not a name",
+		"Aus alba L.",
+		"Aus albus L.",
+		"Bus cus Smith",
+	}
+
+	groups := func(chunkSize int) ([]Group, string) {
+		dir, err := ioutil.TempDir("", "gnparser_cluster_test")
+		Expect(err).To(BeNil())
+		c := NewClusterer(dir, chunkSize)
+		for i, o := range gnp.ParseNames(names) {
+			Expect(c.Add(i+1, o)).To(Succeed())
+		}
+		var res []Group
+		err = c.Groups(func(g Group) error {
+			res = append(res, g)
+			return nil
+		})
+		Expect(err).To(BeNil())
+		return res, dir
+	}
+
+	It("groups names by stems", func() {
+		for _, size := range []int{2, 3, 100} {
+			gs, dir := groups(size)
+			Expect(len(gs)).To(Equal(2))
+			Expect(gs[0].Stem).To(Equal("Aus alb"))
+			Expect(gs[0].Size).To(Equal(5))
+			Expect(gs[0].SpellingVariants).To(Equal([]Variant{
+				{Value: "Aus albus", Count: 3}, {Value: "Aus alba", Count: 2},
+			}))
+			Expect(gs[0].AuthorshipVariants).To(Equal([]Variant{
+				{Value: "L.", Count: 3}, {Value: "Linnaeus 1753", Count: 1},
+			}))
+			Expect(gs[0].Representative.ID).To(Equal(1))
+			Expect(gs[1].Stem).To(Equal("Bus cus"))
+			Expect(gs[1].Representative.Name).To(Equal("Bus cus Smith"))
+
+			files, err := ioutil.ReadDir(dir)
+			Expect(err).To(BeNil())
+			Expect(files).To(BeEmpty())
+			os.Remove(dir)
+		}
+	})
+
+	It("creates flat and JSON outputs", func() {
+		gs, dir := groups(0)
+		defer os.Remove(dir)
+		Expect(gs[1].ToSlice()).To(Equal([]string{
+			"Bus cus", "2", "8", "Bus cus Smith", "1", "Bus cus", "Smith",
+		}))
+		Expect(len(CSVHeader())).To(Equal(len(gs[1].ToSlice())))
+		bs, err := gs[1].ToJSON(false)
+		Expect(err).To(BeNil())
+		Expect(string(bs)).To(ContainSubstring(`"stem":"Bus cus","size":2`))
+	})
+})
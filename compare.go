@@ -0,0 +1,164 @@
+package gnparser
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnparser/authorship"
+	"github.com/gnames/gnparser/output"
+)
+
+// NameMatch tells how close canonical forms of two names are.
+type NameMatch int
+
+const (
+	// NoMatch means that names are different or one of them is not parsed.
+	NoMatch NameMatch = iota
+	// SpeciesMatch means that names belong to the same species, but have
+	// different infraspecific epithets, like 'Aus bus' and 'Aus bus cus'.
+	SpeciesMatch
+	// StemMatch means that stemmed canonical forms of names are the same,
+	// like 'Aus alba' and 'Aus albus'.
+	StemMatch
+	// RankMatch means that names differ only by their rank markers, like
+	// 'Aus bus var. cus' and 'Aus bus subsp. cus'.
+	RankMatch
+	// CanonicalMatch means that canonical forms of names with ranks are
+	// identical.
+	CanonicalMatch
+)
+
+var nameMatchMap = map[NameMatch]string{
+	NoMatch:        "none",
+	SpeciesMatch:   "species",
+	StemMatch:      "stem",
+	RankMatch:      "rank",
+	CanonicalMatch: "canonical",
+}
+
+var nameMatchScores = map[NameMatch]float64{
+	NoMatch:        0,
+	SpeciesMatch:   0.5,
+	StemMatch:      0.8,
+	RankMatch:      0.9,
+	CanonicalMatch: 1,
+}
+
+func (m NameMatch) String() string {
+	return nameMatchMap[m]
+}
+
+// MarshalText represents a match as a string in JSON.
+func (m NameMatch) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Score converts the match to a number from 0 to 1, where 1 is
+// an identical canonical form.
+func (m NameMatch) Score() float64 {
+	return nameMatchScores[m]
+}
+
+// Comparison tells how two name-strings relate to each other.
+type Comparison struct {
+	// NameA is the first name-string.
+	NameA string `json:"nameA"`
+	// NameB is the second name-string.
+	NameB string `json:"nameB"`
+	// NameMatch is the closest match of canonical forms of the names.
+	NameMatch NameMatch `json:"nameMatch"`
+	// NameScore is the score of NameMatch.
+	NameScore float64 `json:"nameScore"`
+	// Authorship compares authorships of the last elements of the names.
+	// It is nil if canonical forms of names do not match.
+	Authorship *authorship.Comparison `json:"authorship,omitempty"`
+	// AuthorshipScore is the score of the authorship comparison.
+	AuthorshipScore float64 `json:"authorshipScore"`
+	// Score is a combined score, where canonical forms give 80% and
+	// authorships give 20% of the score.
+	Score float64 `json:"score"`
+}
+
+// Compare parses two name-strings and tells how they relate to each other.
+func (gnp GNparser) Compare(a, b string) Comparison {
+	oa := output.NewOutput(gnp.Parse(a))
+	ob := output.NewOutput(gnp.Parse(b))
+	return CompareOutputs(oa, ob)
+}
+
+// CompareOutputs tells how two parsed names relate to each other.
+func CompareOutputs(a, b *output.Output) Comparison {
+	res := Comparison{NameA: a.Verbatim, NameB: b.Verbatim}
+	res.NameMatch = compareCanonicals(a, b)
+	if res.NameMatch == NoMatch {
+		return res
+	}
+	ac := authorship.CompareAuthorship(a.LastAuthorship(), b.LastAuthorship())
+	res.Authorship = &ac
+	res.NameScore = res.NameMatch.Score()
+	res.AuthorshipScore = ac.Match.Score()
+	res.Score = math.Round((0.8*res.NameScore+0.2*res.AuthorshipScore)*100) / 100
+	return res
+}
+
+func compareCanonicals(a, b *output.Output) NameMatch {
+	if !a.Parsed || !b.Parsed {
+		return NoMatch
+	}
+	ca, cb := a.CanonicalName, b.CanonicalName
+	switch {
+	case ca.Full == cb.Full:
+		return CanonicalMatch
+	case ca.Simple == cb.Simple:
+		return RankMatch
+	case ca.Stem == cb.Stem:
+		return StemMatch
+	}
+	wa, wb := strings.Fields(ca.Simple), strings.Fields(cb.Simple)
+	if len(wa) > 1 && len(wb) > 1 && wa[0] == wb[0] && wa[1] == wb[1] {
+		return SpeciesMatch
+	}
+	return NoMatch
+}
+
+// CompareHeader returns names of fields created by Comparison.ToSlice.
+func CompareHeader() []string {
+	return []string{
+		"NameA",
+		"NameB",
+		"NameMatch",
+		"NameScore",
+		"AuthorshipMatch",
+		"AuthorshipScore",
+		"AuthorshipReasons",
+		"Score",
+	}
+}
+
+// ToSlice creates a flat version of the comparison for CSV or TSV outputs.
+// Reasons of the authorship comparison are separated by semicolons.
+func (c Comparison) ToSlice() []string {
+	var match string
+	var reasons []string
+	if c.Authorship != nil {
+		match = c.Authorship.Match.String()
+		for _, v := range c.Authorship.Reasons {
+			reasons = append(reasons, v.String())
+		}
+	}
+	return []string{
+		c.NameA,
+		c.NameB,
+		c.NameMatch.String(),
+		formatScore(c.NameScore),
+		match,
+		formatScore(c.AuthorshipScore),
+		strings.Join(reasons, "; "),
+		formatScore(c.Score),
+	}
+}
+
+func formatScore(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -2,7 +2,11 @@ package dict
 
 import (
 	"bufio"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/gnames/gnparser/fs"
 )
@@ -20,13 +24,31 @@ type Dictionary struct {
 	// This list is used to detect ICN name-strings so we can parse a word in
 	// parenthesis after genus word as an author instead of subgenus.
 	AuthorICN map[string]struct{}
+	// AuthorAbbr contains full names of authors for their standard
+	// abbreviations, like 'Carl Linnaeus' for 'L.'.
+	AuthorAbbr AuthorAbbr
 }
 
+// AuthorName is a full name of an author.
+type AuthorName struct {
+	// FullName is the full name of an author, like 'Augustin Pyramus de
+	// Candolle'.
+	FullName string
+	// Surname is the surname of an author with its prefix and 'f.' for
+	// filius, like 'de Candolle' or 'Hooker f.'.
+	Surname string
+}
+
+// AuthorAbbr maps standard abbreviations of authors' names to their full
+// names. Keys are normalized by AbbrKey.
+type AuthorAbbr map[string]AuthorName
+
 // LoadDictionary creates dictionary from text files.
 func LoadDictionary() *Dictionary {
 	d := Dictionary{
-		Bacteria:  readBacterialData(),
-		AuthorICN: readAuthorICNData(),
+		Bacteria:   readBacterialData(),
+		AuthorICN:  readAuthorICNData(),
+		AuthorAbbr: readAuthorAbbrData(),
 	}
 	return &d
 }
@@ -65,3 +87,83 @@ func scanBacterialFile(path string, isHomonym bool, m map[string]bool) {
 		m[sc.Text()] = isHomonym
 	}
 }
+
+func readAuthorAbbrData() AuthorAbbr {
+	m := make(AuthorAbbr)
+	f, err := fs.Files.Open("authors_abbr.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	err = scanAuthorAbbr(f, m)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return m
+}
+
+// AbbrKey normalizes an abbreviation of an author's name for a lookup. It
+// removes spaces and changes 'fil.' to 'f.', so 'Hook. fil.' and 'Hook.f.'
+// have the same key.
+func AbbrKey(abbr string) string {
+	abbr = strings.Replace(abbr, "fil.", "f.", -1)
+	return strings.Join(strings.Fields(abbr), "")
+}
+
+// Copy returns a copy of the dictionary, so it can be extended without
+// changing the original.
+func (a AuthorAbbr) Copy() AuthorAbbr {
+	res := make(AuthorAbbr, len(a))
+	for k, v := range a {
+		res[k] = v
+	}
+	return res
+}
+
+// AddFile adds abbreviations from a user-supplied file. Every line of the
+// file has an abbreviation, a full name and a surname separated by tabs.
+// The surname might be omitted, then the last word of the full name is
+// used. Empty lines and lines starting with '#' are ignored. Entries of the
+// file override existing ones. If the file cannot be read, the dictionary
+// stays unchanged.
+func (a AuthorAbbr) AddFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	m := make(AuthorAbbr)
+	err = scanAuthorAbbr(f, m)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for k, v := range m {
+		a[k] = v
+	}
+	return nil
+}
+
+func scanAuthorAbbr(r io.Reader, m AuthorAbbr) error {
+	sc := bufio.NewScanner(r)
+	var i int
+	for sc.Scan() {
+		i++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || strings.TrimSpace(fields[1]) == "" {
+			return fmt.Errorf("line %d: full name is missing", i)
+		}
+		an := AuthorName{FullName: strings.TrimSpace(fields[1])}
+		if len(fields) > 2 {
+			an.Surname = strings.TrimSpace(fields[2])
+		} else {
+			ws := strings.Fields(an.FullName)
+			an.Surname = ws[len(ws)-1]
+		}
+		m[AbbrKey(fields[0])] = an
+	}
+	return sc.Err()
+}
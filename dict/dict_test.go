@@ -1,6 +1,11 @@
 package dict_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -36,5 +41,45 @@ var _ = Describe("Dict", func() {
 			_, ok := d.AuthorICN["Abramov"]
 			Expect(ok).To(Equal(true))
 		})
+		It("finds full name of an abbreviated author", func() {
+			an, ok := d.AuthorAbbr[AbbrKey("Hook. fil.")]
+			Expect(ok).To(Equal(true))
+			Expect(an.FullName).To(Equal("Joseph Dalton Hooker"))
+			Expect(an.Surname).To(Equal("Hooker f."))
+		})
+		It("does not keep bare surnames as abbreviations", func() {
+			for k := range d.AuthorAbbr {
+				Expect(strings.Contains(k, ".")).To(BeTrue(), k)
+			}
+			_, ok := d.AuthorAbbr[AbbrKey("Baker")]
+			Expect(ok).To(Equal(false))
+		})
+	})
+
+	Describe("AuthorAbbr", func() {
+		It("does not change the original with a copy", func() {
+			abbr := Dict.AuthorAbbr.Copy()
+			abbr["Smi."] = AuthorName{FullName: "John Smith", Surname: "Smith"}
+			_, ok := Dict.AuthorAbbr["Smi."]
+			Expect(ok).To(Equal(false))
+		})
+		It("returns an error for a missing file", func() {
+			err := Dict.AuthorAbbr.Copy().AddFile("no_such_file.txt")
+			Expect(err).ToNot(BeNil())
+		})
+		It("does not add entries from a broken file", func() {
+			path := filepath.Join(os.TempDir(), "gnparser_broken_abbr.txt")
+			data := "Smi.\tJohn Smith\nJon.\n"
+			err := ioutil.WriteFile(path, []byte(data), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(path)
+
+			abbr := Dict.AuthorAbbr.Copy()
+			err = abbr.AddFile(path)
+			Expect(err).ToNot(BeNil())
+			_, ok := abbr["Smi."]
+			Expect(ok).To(Equal(false))
+			Expect(len(abbr)).To(Equal(len(Dict.AuthorAbbr)))
+		})
 	})
 })
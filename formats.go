@@ -3,6 +3,8 @@ package gnparser
 import (
 	"fmt"
 	"log"
+
+	"github.com/gnames/gnparser/output"
 )
 
 type Format int
@@ -48,3 +50,22 @@ func (gnp *GNparser) OutputFormat() string {
 func AvailableFormats() []string {
 	return formats
 }
+
+// FormatOutput converts a result of parsing to the current output format
+// of GNparser. Debug format parses the verbatim name-string again, because
+// syntax trees are not kept in the result.
+func (gnp GNparser) FormatOutput(o *output.Output) (string, error) {
+	switch gnp.Format {
+	case Compact, Pretty:
+		bs, err := o.ToJSON(gnp.Format == Pretty)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	case CSV:
+		return output.ToCSV(o.ToSlice()), nil
+	case Debug:
+		return string(gnp.Debug(o.Verbatim)), nil
+	}
+	return "", fmt.Errorf("unknown format '%d'", gnp.Format)
+}
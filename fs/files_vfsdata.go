@@ -21,98 +21,106 @@ var Files = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
+			modTime: time.Date(2026, 10, 16, 20, 16, 49, 394570325, time.UTC),
 		},
 		"/README.md": &vfsgen۰CompressedFileInfo{
 			name:             "README.md",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 624043491, time.UTC),
-			uncompressedSize: 729,
+			modTime:          time.Date(2026, 10, 16, 20, 19, 12, 197293505, time.UTC),
+			uncompressedSize: 953,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x92\x41\x6b\x1b\x31\x10\x85\xef\xfa\x15\x8f\xf8\x92\x80\x11\x69\xda\xb4\xd0\x63\x4d\x09\x39\xd4\x94\x1c\x7a\xe8\xc5\x19\x6b\xc7\x59\x61\x49\xb3\x48\xb3\xa9\xd3\x5f\x5f\xb4\xb2\x37\x2e\x81\x9c\x16\x69\xdf\x7c\x1f\x7a\xd2\x02\xbf\xc4\xd1\x76\x0c\x94\x3d\x17\x63\x1e\xb7\xe4\x94\xb3\xa7\xcd\x13\x27\xce\x64\xf5\xa0\x8f\xe6\x2b\xb4\xf7\x05\xc1\x17\x85\x2f\x18\x0b\x77\x50\x41\xa4\xbc\xc7\x40\xb9\x2e\x13\x45\x2e\xa0\x82\x13\x20\xb4\x2d\xfb\x96\xb9\xe9\x25\x4a\x7a\x89\xe5\x2d\xdc\x49\x52\xf2\xe9\x9c\x32\xcd\x78\x77\x14\x68\x4f\x0a\x3e\xd4\xec\x98\x3a\xce\xb8\x5f\xfd\x5e\x43\xea\x77\x0d\x27\x5d\x13\x1e\x3d\x34\x6a\xbf\xf1\x2e\xbd\xe3\xa9\x11\xc9\x05\xb2\x6b\x22\x9a\xb1\xaf\xb8\xc5\x02\xab\xcc\xa4\x5e\xd2\x6b\xee\x3f\xb8\x31\x1f\x2c\xee\x58\xa1\x3d\x23\x90\x72\x51\xdc\x3f\xfc\x58\xdf\x61\xe7\x03\x5b\x73\x63\xf1\xfd\xa0\x99\x9c\x9e\x0b\xab\xa3\xc1\xcc\x47\x8b\x9f\xb5\xc7\x09\x70\x8a\x50\xea\xa0\xb4\x67\x48\x0a\x2f\xb8\xd8\x52\xf1\xb5\xb6\x8b\x39\x70\x19\x69\xcf\xc7\x13\xdd\x5e\x5f\xcf\xfb\x25\x52\x08\x9c\xaf\xcc\x27\x8b\x6f\x99\x69\x3f\xff\x51\xc1\x1f\xc9\x5d\x59\xc2\x49\x08\xec\xb4\x2d\x5b\xad\x94\x19\x8e\x06\xaf\x14\xfc\x5f\xee\x96\xe8\xe9\x99\x91\x04\x03\x67\x2f\x75\x28\x50\x7e\xe2\x5c\xd3\x09\x37\x70\x3d\xe5\xe9\x9a\x8a\x35\xb7\x16\xab\xc0\x94\x30\x0e\xb3\x6c\x97\x25\xa2\x0c\xe4\x78\xf2\xc5\x48\x65\x59\x9f\x0b\x27\xed\xb9\xd4\x6a\x3f\xdb\xd6\x2c\xb7\x33\xc8\x0e\x14\xc2\xe9\x22\x2e\x1d\x25\x49\xde\x51\xc0\x4e\x72\xbc\x32\x5f\x2c\x1e\x38\xca\x33\x37\xf2\x49\x33\x8d\x9e\xcd\xb5\x67\xf7\x2f\x00\x00\xff\xff\x16\x4e\xa1\x06\xd9\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\xcf\x6b\xdb\x4e\x10\xc5\xef\xfb\x57\x3c\x92\x43\x12\x30\x4b\xbe\xf9\x36\x2d\xf4\xd8\x50\x42\x0e\x0d\x25\x85\x1e\x7a\x71\x46\xab\x51\xb4\x78\x7f\x88\xdd\x91\x63\xf7\xaf\x2f\x63\xc9\xaa\x4b\x20\x27\xb3\xbb\x6f\x3e\xcf\xf3\x66\x74\x8e\x9f\xd9\x51\x33\x06\x2a\x9e\xab\x31\xcf\x0d\x39\xe1\xe2\x69\xfd\xc2\x89\x0b\x59\xd9\xc9\xb3\xf9\x0c\xe9\x7d\x45\xf0\x55\xe0\x2b\xc6\xca\x2d\x24\x23\x52\xd9\x60\xa0\xa2\xc7\x44\x91\x2b\xa8\xe2\x08\x08\xd3\x95\x7d\xcb\x5c\xf7\x39\xe6\xb4\x8f\xf5\x2d\xdc\xe5\x24\xe4\xd3\x29\xe5\x50\xe3\xdd\x6c\x20\x3d\x09\x78\xa7\xda\x31\xb5\x5c\xf0\x70\xf7\xeb\x11\x59\x7f\x1f\xe1\x72\x3b\x19\xce\x3e\x34\x4a\xbf\xf6\x2e\xbd\xe3\xa3\x92\x5c\x2a\x72\x87\xa9\x68\xc1\x9e\xe0\x66\xd1\x9a\x9a\xa6\xbc\xc3\xaa\x42\xa9\xa5\xd2\x42\x75\xbc\xf5\x24\x3e\xa7\x03\x7a\x06\x5c\xcc\x4d\xbc\x7a\xe9\x21\x3d\xfb\x62\xba\x31\xcc\x41\x81\x52\x8b\x3a\x96\xe9\x50\x79\xa0\x42\xc2\x2d\x9a\x3d\x84\x9a\x6a\xf1\xe3\xf8\xe6\x93\x0b\x63\xcb\x18\x0a\x77\x7e\xa7\xa9\xa7\xd6\x5c\x74\xf6\x02\x5d\x2e\xe8\x7c\xf0\x63\x5d\x29\x7f\x0f\x2a\xbc\x4c\xcb\x15\x26\x61\x6c\x78\x7f\xfa\x9f\xac\x31\xe7\xe7\xb8\xd3\x37\x9f\xd3\xdf\x1c\xfe\x09\xcf\x98\xff\x2c\xee\x59\x14\x8a\x40\xc2\x55\xf0\xf0\xf4\xed\xf1\x5e\xdd\xd8\x9a\x1b\x8b\xaf\x3b\x29\xe4\xe4\x88\x55\x90\x8e\x64\x82\x99\xff\x2d\xbe\xeb\x9e\x1c\x00\x47\x89\x36\x2c\xb4\x61\xe4\x14\xf6\x38\x6b\xa8\x7a\x5d\x8b\xb3\x85\x71\x19\x69\xc3\x73\xca\xb7\xd7\xd7\xcb\x7d\x8d\x14\x02\x97\x2b\xf3\xc1\xe2\x4b\x61\xda\x2c\x2f\x92\xf1\x9a\x4b\x5b\x57\x70\x39\x04\x76\x32\x1d\xa7\xb5\xd1\x30\x1c\x0d\x5e\x28\xf8\xdf\xdc\xae\xd0\xd3\x96\x91\x32\x06\x2e\x3e\x6b\x51\xa0\xf2\xc2\x45\xd5\x09\x37\x70\x3d\x69\x4b\xac\x21\xdd\x5a\xdc\x05\xa6\x84\x71\x58\xcc\xba\x92\x23\xea\x40\x8e\x0f\x7e\x31\x52\x5d\xe9\xe7\xc0\x49\x7a\xae\xba\x89\x1f\xed\x94\x2c\x4f\x3d\x68\xea\x61\xde\x68\xc2\xa5\xa3\x94\x93\x77\x14\x74\x6e\xf1\xca\x7c\xb2\x78\xe2\x98\xb7\x3c\x91\x8f\x36\x87\xd2\x93\xba\x44\x91\xab\xfd\x33\x00\xc1\xb7\x8f\x3f\xb9\x03\x00\x00"),
+		},
+		"/authors_abbr.txt": &vfsgen۰CompressedFileInfo{
+			name:             "authors_abbr.txt",
+			modTime:          time.Date(2026, 10, 16, 20, 59, 11, 384191209, time.UTC),
+			uncompressedSize: 4019,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x57\x4d\x92\x22\xbb\x11\x5e\xa7\x4e\xa1\x0b\x58\x77\xa0\x7b\xfa\x27\x68\x78\x83\xa1\x63\x3a\xfc\x76\x49\x55\x42\x29\x50\xa5\x18\xfd\x40\xd3\x37\xf0\x31\x1c\xb6\xc3\x9e\xb5\x23\xbc\xf2\x8e\x8b\x39\x52\xaa\x02\xa6\x9f\x5f\x78\x53\xdf\x97\x3f\x45\x29\xa5\x54\x66\x32\x31\x5f\xee\x0d\x4c\xdc\xbe\xf3\x1c\x49\xcf\x7c\xb6\x51\x2f\x2c\x85\x40\x7a\x71\x0a\xd8\xe7\xa8\x5b\xd2\xf7\xc8\xad\x77\x8e\xe0\x86\xab\x89\x79\x0a\x78\x82\x49\x44\x5d\x88\x3c\xd4\xc4\x2c\x6d\xd3\x19\x98\x34\x9d\x75\x8e\xb4\x48\x18\x5a\x18\x50\x4d\x02\x1b\x78\x22\x1f\xb6\xa4\x27\x81\x7d\x4a\xfa\x0d\xdd\x8e\xc2\x20\x41\x05\x75\x47\x9c\xba\x8b\x67\x91\xb0\x87\x01\xd5\x1d\x85\x9d\x81\xb9\x75\x14\xf5\xd4\x47\xda\x77\x5a\x54\xe4\xe8\x04\x23\x11\xa7\xe4\x9d\x81\x09\x27\xcf\xd6\x8b\x47\xf2\xce\xb3\x85\x0b\x53\x77\xde\xc6\x68\x60\x88\xf8\xa1\xed\x3d\xb7\xba\x28\x2d\x05\x18\x89\xba\xcb\xce\x19\x98\x12\xb2\xbe\xc3\x7d\xb2\x31\x91\x7e\x0c\xc8\xe7\x7f\xf8\xeb\x7e\x89\x93\x95\x58\x47\xa2\xee\x72\xe8\x0d\x4c\x7d\x87\xcc\x14\xb5\x88\xc8\x50\xa1\x1a\x37\x06\x7e\xb1\x8d\x77\x88\x51\xcf\x30\x07\xe2\x4f\x6e\x7a\x63\xd4\xbd\x99\x98\x39\x9d\x0c\xdc\x63\x70\xba\x84\xa3\x0f\x9e\xf5\x9c\x4e\x14\xe0\xc2\xd4\x3d\x4a\x2c\x13\x47\xef\xc8\x6d\x20\xfd\x4c\x1c\xac\x7e\xc2\x75\xb0\xe4\xea\x31\xc6\x68\xd9\xc2\x95\xaa\x7b\x3c\x5c\x77\x68\xea\xe3\xf9\x87\xbe\xc7\x03\xb2\x1c\x5e\x84\x2b\x55\x25\x4f\xf2\x36\xc7\x64\xf9\xff\xa6\xc6\x17\x8a\x1b\x03\x4b\xe2\xf3\x8f\x92\x53\x4d\x47\x5a\x74\x9e\x13\x5a\xa6\x08\xb7\x82\x7a\xa8\xd1\x3d\x04\x8e\x49\x3f\x93\xe5\x60\x9b\x4e\x3f\x06\x4b\x6d\x61\x25\x3a\x28\x4f\xf5\xd0\xec\x9c\x81\xfb\x2e\xd8\x98\x2c\xf2\x8d\x97\x58\x3c\x43\x05\xf5\xd0\x85\xce\xc0\x14\x77\x7e\x7d\xeb\xd3\x85\x0e\x43\x82\x01\xd5\x03\xb7\xce\xc0\x2a\xd1\xbe\x43\xd6\x33\x6c\x6d\x74\x98\xa3\x16\xbd\x6d\x3a\x0a\x70\x61\xea\x81\xb7\x92\x4c\xad\x77\x1b\x2d\xbc\x18\x05\xd4\xa3\x99\x67\x92\x04\x79\xa4\xd0\x5a\x46\x6e\xeb\xf1\x88\x72\x3c\xa0\xca\xd5\xa3\x8d\x72\x3b\x6e\x56\x54\x82\x9e\xe5\xf6\x68\xb7\xe5\xad\xe2\x31\xbc\x35\x70\xf5\xe8\x43\x8c\x3b\x03\x0b\x4a\x14\x74\x95\xce\x7f\x77\x70\x61\xea\x31\x18\x78\x70\x16\xa3\x9e\xe3\x96\x73\x2c\x31\x47\x28\x4f\x25\x99\x2a\x5f\x9d\xb4\xc1\x12\xeb\x7a\x2c\x55\x49\x09\x46\xa2\x9e\x90\x42\x62\xc9\xd7\x72\xa1\xaa\x48\x01\x46\xa2\x9e\x82\x8d\xb4\x1e\xd3\xe0\x7a\x52\xcb\x5c\x36\xa5\x9a\xb1\xe9\xe0\xc2\xd4\x53\x96\x94\x7c\xb2\xfe\x80\xcc\x56\x8b\xe8\x99\x60\x40\xf5\x8c\xe1\x60\xe0\xcd\xca\x7d\xe9\x4b\xbe\x9e\xb4\xe8\xe8\x04\x15\xd4\x33\x1e\xeb\xc2\x91\xc5\xd2\x8a\xfd\xe8\x43\xea\x60\x40\xf5\x4c\x7d\x74\xd7\x1f\xb9\xf3\x29\x59\xde\xea\xa2\x96\xdf\xa9\xa8\x9e\x7d\xd3\xc5\xf4\x53\xea\x5c\x4e\xeb\x7a\x1c\xd5\x8b\x52\xa2\x00\x37\x5c\x3d\xfb\xcd\xa6\x1f\xca\x51\xb9\xf9\x1f\xba\xa8\x90\x19\x46\xa2\x9e\xbd\xdf\x5d\x17\x32\xc5\x66\x17\x3d\x6b\xd1\x96\x5f\x13\xa8\x3e\x9b\xcb\x26\x7f\x41\x97\x3e\xfb\xc8\x95\x7f\xf6\x39\x25\x03\x73\xc4\x90\x48\xec\x39\xa5\x7c\x92\x6f\x55\xa2\x9e\x73\x1b\xaf\xdf\x12\xc9\x33\x54\x50\x53\x6c\xbe\x4b\x5d\xd9\xf9\x92\xcc\xc3\xa7\x24\xb9\xc4\x92\x2d\xc3\x0d\x57\xd3\x72\x44\x52\x02\x2c\xd3\x50\x84\x92\xdc\x6c\x31\x58\xca\x70\xa5\xea\xc5\x26\x03\x8b\xf3\x5f\x9c\x7e\xb1\x09\xed\x9a\x1c\x8c\x44\xbd\xf8\x98\xc8\x19\xf8\x66\xb9\x21\xfe\x18\x76\xa9\x6a\x29\x7d\xec\x4e\x70\xc3\xd5\x6c\xa8\x66\x33\xcb\x8c\x94\x23\x8c\x44\xcd\xcc\xe6\x93\x4d\xa7\x8e\xf4\x9f\x7c\xe6\x2d\x85\x8b\x9f\xec\xd2\x0c\xd7\x56\xee\x5d\x09\x4b\x5a\x41\x76\x76\xd8\x2d\x9f\x25\x84\xea\x80\xa1\xb5\xe7\x7f\x06\x82\xdf\x68\xd4\x0c\xa5\x40\x13\xf2\x1f\x2e\x75\x7d\x86\x3d\x86\x66\x07\x03\xaa\x19\xb5\x92\xf4\x65\x45\xd7\x44\x91\x1d\x2c\x16\x9f\x03\xdc\x0a\x6a\x46\x31\xfe\x94\x66\x97\x77\xc4\x62\x79\x0b\x03\xaa\x99\x2d\xe5\x67\xea\x3b\x96\x58\x5b\xe9\x5e\x03\xaa\x99\xcf\x41\xb2\xe4\xfc\x57\x5f\x02\xf1\x39\x90\x0d\x1e\x6e\xb8\x9a\x9b\x3b\x2b\x4b\xbb\xae\x6a\xb8\x99\x73\x0c\xb1\xe9\xd0\xb9\x52\x51\xc4\x89\x42\x4c\x64\x19\x7e\xd7\xa2\xe6\xf8\x6e\xfb\x21\xcc\xda\xb1\x74\x51\xf9\xa3\x6d\x3e\xe0\x4a\xd5\x9c\x5a\xbb\xbb\xfd\xe8\x0b\x46\xdb\xdb\xa0\x8b\x21\x47\x18\x50\xcd\x6d\xd3\xbd\x4b\x73\x69\xc3\xf9\x87\x16\x09\xf3\x3b\x0c\xa8\xe6\xe5\xe4\x16\x9d\x75\x76\xaf\x45\xa0\x20\x0d\x5d\xea\xe9\xdc\x7e\xff\x29\xa8\xd2\xf0\xde\xac\xeb\xc8\xf5\x7a\x6e\xbf\x67\x72\x50\x41\xcd\xcf\xff\x71\xce\x4c\xc2\xf6\xa6\xcd\x16\x5d\x19\x28\xb6\xfe\x60\x89\xa3\x8d\xf0\x3f\x74\xea\x97\x72\xc5\x5e\x3b\xdf\x63\xd4\x22\xa0\x73\x30\xa0\xfa\xea\xec\xc1\xc0\x17\x64\x69\x9d\x22\x50\x80\x0a\x6a\x61\x5e\x30\x48\x29\x59\x94\xda\xa0\x27\xa5\xfa\x15\x1d\x31\x0c\x28\x5e\xb9\xef\x0d\x2c\x30\x3b\xfd\x92\xfb\x9e\x02\x54\x50\x0b\x2c\xa1\x97\x62\xbe\xb2\xbd\x67\x2d\x1a\x8c\x50\x41\x2d\xa4\x27\xd7\x5e\x3c\x76\xe6\x05\x1e\xce\xff\x62\x3d\xb5\xfd\xf9\x07\xd3\x07\x7c\x92\xd5\x82\xc2\x4d\xd2\x49\xad\x24\x6e\x83\xdd\x69\x31\x78\xcf\x30\xa0\x5a\x78\x1b\x86\x49\x46\x1a\xb3\xf4\x8b\x60\x49\x8b\x9a\x12\x54\x50\x7f\xcc\xe7\x1f\xce\xc0\x2c\x37\x72\x9d\x8a\x44\x09\x06\x54\x4b\x73\x17\x0c\x2c\xfd\x9a\x42\xd2\x77\xc1\x1f\x19\xca\x53\x2d\x51\x6e\xae\xe7\x98\x90\x93\x14\x93\x15\xf6\x99\x9c\x5e\xe2\x46\xfa\xfc\xf7\x4c\x70\xa5\x6a\xd9\x74\x6b\x03\x97\x26\xf2\xe4\x53\x72\x96\xd6\x63\x2f\x5c\x92\xb4\x5d\x2e\xfd\xe4\x86\xd7\xd7\x36\xb7\x2f\xe6\x98\xf0\xf0\x7b\xfe\x52\x26\x64\xf6\x34\x50\xc3\xbd\x77\x98\x5b\x1a\xa2\xfe\x3c\x9c\x2e\xfd\xfb\xfa\x5a\x54\x45\xca\x61\xdb\xc1\x48\xd4\xf2\xd4\xae\xe5\xe0\x82\x9e\xbc\x4b\x5c\xa7\x76\x4d\x61\x0b\x03\xaa\x15\x36\x4d\x9d\x25\xb5\x24\x3d\xa1\x16\x0d\x86\xd6\xc3\x48\xd4\x0a\x9d\x8d\x6b\x33\x7e\x52\xa6\xb9\xce\xf3\x49\x57\x7d\x0e\x27\xb8\x30\xb5\x6a\x3a\xa4\x8d\x34\x0b\x6c\xfc\x5a\x5f\x4b\xca\xaa\xe9\xce\x7f\xdb\x6c\x28\xc0\x85\x89\xb3\x4b\x52\x4e\xbe\x58\x6a\x69\x98\xa0\x90\x3f\xf4\x8c\x3c\x97\x4f\xc9\x8d\x17\x2f\x6a\xba\x44\xdc\xa2\x83\xdf\x68\xe4\x57\x02\xb6\x37\xbb\x2b\xc9\xbd\xef\xc4\x2b\x60\x5b\x3f\x58\x88\x78\x1e\xc9\xb2\x81\x19\x1d\x6d\xd4\x5f\xf0\x60\x5b\xdd\x92\x1e\xf4\x36\x7d\xc0\x4f\x92\x5a\x35\x7e\x7f\x33\x0a\x8c\x99\x2d\x6a\xef\x2c\x0c\xa8\x56\x14\xc6\x99\x38\xea\xfb\x0e\x83\xcc\xf8\x2b\x0a\x96\xb7\x04\x03\xaa\x95\x54\x6d\xec\x29\xea\x87\xf6\x28\xb1\xad\x7a\x9b\x3a\x28\x4f\xb5\xf2\xee\x72\x73\x57\xde\x21\x97\x75\x0f\x44\xad\x3c\xb7\x06\xbe\xa6\xe4\x2f\x25\x45\x54\xc5\x45\x40\xad\xf6\x81\x78\x6b\xe0\x25\x87\xa4\x17\xde\x9d\x1a\x0c\x7b\x3d\xf5\xd8\x74\xb6\xd7\xd5\x4a\x0e\x46\xa2\x56\x09\x4b\x19\x2f\x17\xfd\x1e\xc3\x9e\xb8\x5c\xec\xa2\xa6\x13\x8c\x44\xad\x12\xe5\x76\x1c\x73\x2f\xe9\x5e\xce\x40\x2c\x34\x9c\x47\xe5\x6a\x75\x34\xf0\xd5\xf9\x8d\x5e\x1d\x31\xa4\x0f\xa8\xa0\x5e\x89\xe5\x8f\x4f\xd3\x91\x23\xfd\x4a\xec\x03\x41\x05\xf5\xda\x65\x1e\x1b\x55\x9d\x14\x8b\x46\x32\x74\x24\xea\xd5\x87\x30\x74\x1c\xa1\x74\x82\x0a\xea\x35\x60\x4e\x87\x71\x75\xc3\x44\x27\xeb\xa9\x86\x52\xe9\xe0\x93\xac\x5e\x73\x68\x3e\xc6\x49\xc3\x6a\x99\xa3\x91\xfd\x41\x72\xaf\x98\x90\x2d\xfb\x23\xdc\x70\xf5\x8d\x38\x19\x38\xff\x39\x59\x62\xa6\xf1\xaf\x93\x68\x89\x31\xc1\x48\xd4\x37\x1b\xc7\x1a\x53\x1a\xe0\x37\x1b\x2d\xd6\xbf\x2e\x03\x55\x6f\xd8\x39\x92\x88\x9f\xce\xff\x0e\xc8\x7a\x90\x25\xde\x2b\x55\x6f\xe8\x5a\x29\xd6\x75\x1c\x69\x49\xcb\x39\xa1\x9e\xb4\xd8\x97\xee\x58\xed\x34\x0c\x45\x17\x49\xde\x73\x06\x7e\xc1\xd4\xd5\x1e\x20\xb2\x6d\x3a\x18\x50\xbd\xd1\xd8\xbc\x91\x79\xb8\x9b\x7e\xdf\xe9\xa2\x47\x6e\x61\x24\x4a\xea\x49\x3b\x9c\xcb\x50\xdc\x8a\x8a\x64\x6f\x2e\x4c\xfd\x4a\xa7\xee\x67\xaf\xda\x1c\xf7\x5a\x2c\x14\xe0\x57\x3a\x75\x14\xd4\x7f\x07\x00\xb5\xbf\x33\x62\xb3\x0f\x00\x00"),
 		},
 		"/bacteria_genera.txt": &vfsgen۰CompressedFileInfo{
 			name:             "bacteria_genera.txt",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 627376747, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 40194,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x7d\xdb\x7e\xdc\xa8\xf2\xee\xbd\x9f\x62\xbd\xc0\x7e\x88\x15\xc7\xb1\x13\x1f\xd2\x7f\xb7\x27\x99\x99\x3b\x5a\x2a\x4b\x4c\x23\x50\x38\x74\x2c\x3f\xfd\xfe\xd5\x09\x90\x67\xdd\xd8\xdf\x57\x92\x38\x16\x50\x40\x41\xff\xf7\x64\x43\x8e\x61\x9d\xad\xb9\xfa\xef\x29\x96\x35\x87\x75\xde\x86\x92\xae\xfe\x3b\x18\x9f\xe7\xb0\x3a\x28\xd1\x9e\xcc\x90\x21\xa2\x2c\xda\x21\x2c\x40\x8f\xe3\x16\x86\xd9\x85\x68\x91\x41\x36\xde\x40\x0c\xfc\xa6\x2d\x0b\xc9\xec\x12\xbc\xe1\xc7\x36\x87\xc5\x0c\xc5\xe9\x93\x8b\x3d\x45\x1b\x08\x07\xf9\x54\xbf\x0a\x35\xba\x8a\xeb\xa3\x57\xeb\xcc\x02\x3e\x2b\x9f\xc0\xd7\x67\x93\x2b\x43\xf0\xbb\xaf\x67\xe3\xba\x70\x17\x33\x96\x68\x04\xdb\xa1\x8f\xb2\x25\x34\x78\x58\xe4\x9d\x3c\x43\x5c\xa8\x2c\xe6\xe0\x60\x75\x26\xd1\x93\x39\x86\xc5\x64\xfe\x14\x71\x8b\x71\x8e\x41\x3f\xb7\xa3\x59\x6c\x97\x18\xe1\x43\x18\xb8\x74\xed\x68\x07\xe3\x46\xc5\xaf\x10\x63\xff\xb2\xed\xd3\x67\x47\xbb\xce\xd6\x55\x92\x82\x44\x61\xd3\x3a\x1b\x88\x42\x32\xc4\x58\x4b\x1c\xf9\x6c\x31\x48\xeb\x9c\xc4\xb2\x2b\x4c\x3b\x86\x01\x9c\xe3\x6f\x43\xfb\xac\xcb\xb5\x1d\xc3\x25\x44\xf3\x86\xd0\xf7\xf5\x62\x7d\x58\xb6\x81\xd4\xc0\xfa\x90\xe7\x68\xf1\x9d\x18\x06\x13\xd7\x90\xd6\x40\x29\xca\x4d\x6d\xb2\xf5\xa6\x44\xdb\x9e\xf8\x60\x9c\x0b\x4b\x89\x14\x8f\xf2\x0c\x76\x98\xab\xa4\x4b\x7a\xae\x25\xc9\x69\x17\x2e\xda\x44\xcc\xbe\xda\xd1\x54\xd2\xc7\x34\x98\x0c\x7e\x2f\x71\x66\xac\xa9\x26\x41\x88\xc6\x39\xab\xcf\xcf\xd6\x43\xe8\x3f\xa8\x8a\x93\x25\xe7\x79\xff\x78\x1b\x6c\x86\xd4\x58\x18\x5b\x3a\x6b\x7b\x12\x92\xc3\x10\x9c\x7e\xb9\x3a\xe3\xeb\x87\x6b\x70\xdb\x12\xe2\x3a\x9b\x4e\xd0\xc7\xb3\x6e\x83\xb7\x5d\xd0\x69\xb5\x83\xa9\x38\x44\xe3\xa7\xee\xe1\xe6\x45\x15\x91\x65\xe3\xa0\xe2\x59\x2a\x35\x77\x95\x57\x5e\x43\x5c\xb0\x29\x8f\xa8\x51\xb5\xea\x46\x07\x71\x88\x50\xf2\x3b\x96\xce\x18\xad\xe1\x40\xc7\x0b\x78\xd6\x1e\x98\xb6\x35\xa3\x98\xd9\xaf\x12\xa2\xbd\xd8\x8c\x98\x82\x91\x3a\x84\x68\xd3\x60\xe2\x18\x2e\x96\x1e\x85\xfe\x51\xd5\x2c\x88\xb5\x85\x40\xec\xdb\x28\x32\x56\x51\x48\xb9\x98\x68\x6b\x0a\x95\x73\xa9\x2a\xeb\x3e\x7d\xb5\xaf\x9c\xb6\x57\xbb\x62\xdc\x93\x21\xed\x9e\x4c\xec\x54\x6a\xc2\x9e\x4d\xc2\x40\x8c\x7a\xef\xf1\xad\x29\xc2\x64\x9a\x2a\x4f\x36\xd7\x14\x4e\x0b\x95\x01\x7d\x1e\x81\x82\xde\x75\x80\x53\xcb\xcc\x54\x93\x8f\x88\x9b\x8e\x1d\xad\x9f\xb4\xbd\x5a\x6f\x86\x8c\xc5\x7f\x3e\x43\x5c\x8c\x4f\x18\xdc\x39\xb7\x86\xe6\x4e\x35\x0d\xee\xc4\x1d\x06\xd6\x21\xe2\x70\xe1\x86\xe0\x4e\x1a\x9a\x3b\xd5\x98\xdd\x29\xda\x69\xce\x18\x9c\x1b\x8c\xb3\x13\x90\xc2\xb9\xc1\x78\x2b\x8d\xdb\x8d\x5a\x7b\x6e\x6a\x91\x4c\x52\x1a\x6e\xea\xba\x31\x37\x85\x68\x17\x13\xad\x37\x42\xd6\xd9\x4c\x14\x8f\x35\x7d\xb9\x39\x3b\x6c\x83\xa3\x6e\xab\x54\xda\xd5\xb9\xb3\x76\xb4\xa1\x86\x64\xed\x1c\xe0\x95\x54\xd4\xd9\x3a\x36\x38\x9b\x66\xf8\x5d\x13\x67\x53\xb6\x2b\x25\xfe\x6c\x5c\xa7\x5a\x4a\x39\x85\x8d\x50\x25\x28\x97\x22\x42\xf6\xea\xe0\xad\x7e\xe7\xec\xe2\x35\xa7\xc8\xb5\x04\x11\xd7\xd4\x23\x49\xab\x8d\xd6\x69\x28\xbe\x8f\xd0\x5b\x3f\xda\x89\x52\xe6\xcc\x30\xff\x53\x3c\xb5\x4b\xf7\x21\xfd\xce\xa6\x50\x71\x30\xfb\x56\xea\x76\xa5\xe3\x5a\xf7\xe6\x1c\xf5\x60\xce\xd9\xc9\x85\x53\x48\xda\x93\xa1\xbc\x1b\x89\x9c\x0b\xaf\x25\x91\x0a\x39\x17\x6c\xab\x7f\x17\xce\x25\xbf\x7b\x88\x96\x3f\xd2\x0c\xba\x10\x67\xfb\x2e\x6d\xc4\xb9\xd0\xb5\x4e\x97\x21\x42\xdc\xf2\xdc\x1a\x26\x8a\xbc\xf1\x59\x1f\x7b\x05\x2d\xa2\xdc\xda\xa8\xdb\x92\xe5\x8c\x2e\x64\x31\xf0\x1b\x0b\x6c\x66\x31\x58\xc7\x8b\xf5\xb5\x70\xfb\x51\x72\xd9\xf7\xf3\x48\x25\xc4\x65\x31\x39\xd8\x91\x3e\x5e\x82\xb7\xaf\xf0\x26\xa8\x86\xe3\xfb\x6a\x5f\x02\x9c\x5a\xb0\xd8\xa9\x06\x1f\x52\x0e\x83\xb2\xbe\xbf\x24\x09\x85\xb1\xce\x9d\x5e\x21\xcb\xd4\x3b\x2e\xeb\x1c\x6d\xa6\xc8\xd7\xe2\x9c\x89\x9a\xbb\x12\x05\x6c\xa8\x42\xb9\xa1\xb0\x26\xac\x0a\x62\xd4\xb1\x48\x11\x6c\xae\xa6\xca\x9b\x93\x01\xed\xcf\x85\x04\xd7\x88\x04\x21\x44\xfa\x69\x64\xd4\x64\x18\x70\xec\x88\xa9\x7a\xbd\x19\x36\xd6\x01\xb2\xa8\x4c\xa4\x48\xd5\x30\x93\x6c\x75\x76\x5a\x23\x45\x22\x26\x3b\x2c\x74\xba\xce\xa2\x68\xfc\x60\x84\x68\x5e\x88\xbc\xda\xf6\x96\xea\x1f\x11\x34\xcc\x06\xc5\x2e\x9c\xea\x17\xce\x7a\xd0\xa0\x96\x92\x2a\xdc\xde\xf6\x89\xc2\x8e\x45\x1f\xaa\xe9\x45\x24\xce\xe6\x34\xd6\xe0\x92\xf5\x0d\xab\x35\x24\x2c\xec\xf0\x3e\x78\xed\x4b\x88\xe4\x58\x7c\xcb\x93\x76\x3f\x42\xe2\x54\x9c\x86\x23\x3d\xa6\x37\x2d\x45\x79\x86\x01\xae\xfe\xeb\x07\xe3\x9a\x3d\x4c\xac\x1b\x83\xfc\xd0\x57\x7c\xad\xa4\x11\x62\x02\x2f\xca\xe4\xc7\x08\x6b\x84\x4b\xa0\x46\xe6\xd1\xee\xf6\x9d\x36\xfa\xc9\x44\x31\x2e\x09\xaa\x5d\xe1\xa7\xd6\xd8\xfd\x54\xf6\xd1\x4e\x58\x25\x35\xde\xd9\x38\x0e\x3b\x2c\xc6\xb5\x00\xc2\xdb\xd6\x45\x13\xde\x36\x6f\x72\x0c\x9e\x02\xc8\x26\x0e\xb9\x8e\x8c\x95\x4a\xab\x64\xde\x32\x96\xe7\xd8\x02\xca\xf6\x57\x61\x83\xc7\xd9\x3c\x57\x11\x01\x4c\x26\x8f\x6b\xeb\x6c\xbc\x7d\x0f\x0b\xf8\xe0\x99\x85\xc1\xac\xa8\x16\x0d\x4b\x4b\xa8\x02\x36\xb6\x88\x4a\xe9\xaf\x73\x34\xd8\xe9\xd0\x67\x58\xb9\x91\x4c\x64\x34\xaf\x6a\xea\x56\x57\xcb\x69\x0d\xeb\x1c\x22\x64\x2e\xf8\x5f\xc5\xe8\x3b\x15\x52\xf1\xfd\x2a\xa6\x2b\xcc\x5f\xc5\x74\xed\xe2\x57\xb1\x83\x7e\xce\xbd\x11\xfe\xc7\x91\x85\x1f\xea\xd0\x86\x90\x0b\xeb\x57\xb1\x9e\x8b\xf1\x57\xb1\x6b\x37\xab\xfa\x55\x6c\xda\x0d\x68\x22\x68\xdf\x55\xc5\xfe\x55\xac\x58\x72\xd1\x0c\x33\x75\xc2\x71\x30\xbb\x4e\x33\x0e\xb3\x81\x9a\x53\x66\xac\x36\x8c\x39\x2c\xec\x4b\xeb\x63\xe9\x5b\x98\xbc\x07\xac\x08\xc4\xd2\x3b\xc6\x41\x73\x1a\x87\x5a\x98\x71\xc8\x06\xa5\xc5\x71\xcf\x13\xc1\x4b\x86\x23\xb4\xb1\x11\xb1\x7e\x0b\x5e\x33\x14\xe7\x50\x27\x1c\x11\xc7\x2f\xc5\xd8\x16\x5a\x5f\x89\x14\xeb\x89\xda\x77\x94\xc1\x88\x42\xed\x07\x26\x22\x8b\xcd\xf5\x25\xc9\x2b\xc2\x64\xf1\x73\x25\xab\x8d\xfa\x20\x73\xaf\x15\xdb\x6c\x37\xe6\x12\x83\xb9\xfa\x6f\x32\xc3\x30\x9b\x16\x7c\x32\x96\xa4\x9e\x1e\xe2\xac\xaa\x1b\xfa\xd3\x50\xcb\x39\x9d\xc1\x9b\xb4\xe1\x4b\x2b\x44\x93\xb5\x63\x4c\x6b\x1d\x14\x13\x8d\x96\xac\xdb\x15\x4b\x3d\x10\xef\x60\x9b\x6d\xa6\x6c\x87\xc1\x0c\xa6\x38\x6c\x02\x79\x26\x3b\xb3\xb3\x0b\x73\x58\x6b\x62\x09\x53\x9d\x21\xd2\xa4\x21\xd6\xde\x2e\x47\x7c\x95\x2a\xa4\xb4\x1e\xa0\xb8\x90\xa8\x70\x4a\xc4\x21\x5e\xd2\x5b\xa2\xc9\xb5\x32\x4a\x84\x9a\x6d\xc4\xaa\xde\x84\xf5\x7d\xb0\x52\xc8\x08\x45\x4f\x4b\x84\x5e\x3b\x4b\xb4\xd9\x8e\x2d\xa8\x94\x7f\xdb\xe1\x8c\x79\xba\xf4\xc3\xf7\xbb\x8e\x5d\xef\x35\x73\xef\x61\xde\xc6\x5a\x98\x3d\xfb\x8f\x05\x14\xd4\x27\x4b\xf0\x21\x47\x3b\x50\xdd\xbf\x07\xcf\xd6\xde\x7b\x6f\xef\x08\x51\xdb\xe1\x5d\xb5\xe3\xbd\x1f\xfa\xde\x43\xee\x62\x6f\x76\x3f\xe2\x1a\x99\x0e\x16\xef\x5b\xcc\x06\xa7\x90\x9f\xcc\x09\x12\xfd\xc7\xe6\x0c\xf2\x3f\xe8\x7f\xd1\xc9\x4f\x9c\xd7\x80\x55\xae\xd8\x6d\xd9\x0e\x65\xa9\x9c\x34\x59\x09\x75\x81\x4a\x78\x18\xfa\x54\xcb\x8b\x11\x2b\x10\xe2\x30\xe2\x24\x82\x61\xc2\x68\x19\x4b\xc6\x3e\x19\x87\xf6\x82\x8c\x3b\x9f\x8c\xf3\x60\x24\x18\x84\x64\xf2\x10\x94\x9a\x25\x1c\xe8\xd5\x68\x46\x67\x28\x73\xd1\x43\xd2\x00\x62\x16\xbb\xf6\x93\x49\xaf\xf4\xb4\xb8\x91\xfe\x5f\x78\x66\x46\xe5\xf6\x69\x04\xe7\xb4\xbc\x3e\x01\xd5\xfa\x27\x98\x26\x6b\x72\x40\x64\xff\x81\x68\xbd\x88\x9d\xd9\xe8\x9f\x93\x38\x10\xb1\xe5\xf0\x09\x9c\x37\x2b\xbd\xe4\x61\x38\x93\x24\x4e\x10\xf5\xc5\x8a\x51\x2d\x88\x6e\xfa\x64\x11\x53\xfc\x13\xe4\xae\x9b\x47\xa6\x49\x84\x92\xc1\x9f\x20\x4e\x14\xfe\x25\x0c\x26\x65\xec\xdf\x3e\xcd\x26\x4e\xe6\x82\xc6\xeb\x27\x7b\x82\x98\x32\x58\xec\x7d\x3f\xd9\x61\x36\x99\x41\x49\xab\x1d\xd1\x06\xfc\x64\x5f\xab\x8a\x53\x04\xc8\x43\xcf\x27\xe8\xd6\x82\x3e\x59\x47\x8a\x88\x1f\xe2\xc8\x45\xad\x8e\x18\x76\x46\xc5\x49\xb5\xda\x34\x19\x13\xa9\x58\xeb\x78\xf0\xc9\xbe\xdb\x40\xe9\x70\x26\x55\x65\x65\x22\x5d\x86\x10\x31\x4c\x84\x49\xc4\x44\xa4\x8e\x09\xaf\x36\x72\x9f\x8e\x3c\xef\x0a\xc9\x99\x42\xf9\x74\xe0\xd5\x18\xfd\x14\x4e\x91\x26\x0c\x9f\x02\xce\x04\xb9\x68\xc3\x54\x32\x8e\x1d\x24\x8e\xa3\x8c\xb1\x9f\x42\x74\x61\x52\x18\xc1\x59\x05\xd2\x8f\x7d\x0a\xb1\x84\x33\x4b\xdf\xf5\x1f\x37\x00\x6c\x6d\x46\x3e\xcd\xa5\x9b\x26\x21\x0d\x27\x7a\xf9\x62\x35\x98\x8b\xf5\x70\xf5\x29\xfc\xae\x75\x8d\x03\xe5\xd6\x65\x84\xb8\x64\x9a\x30\x37\x7d\xc6\xd4\x6d\xd8\x3d\x93\xa6\x17\xcd\x70\xb6\x1a\xe4\xb8\xb5\xce\xe4\x13\x1a\xc7\xb3\xb5\x27\x5b\xe8\x35\x3f\x9b\x45\xdf\x4b\xd6\x49\x33\x8e\xe0\x79\x0e\xf6\x29\x42\x98\xa4\x80\x22\x5c\xda\xb0\xaf\xac\x26\x14\x2e\x56\xbf\xbd\xd8\x94\x23\xac\x59\x4b\x1e\x25\xdc\xc5\xb1\x72\x44\xb8\x14\x3f\x6a\x7b\x8d\x61\x98\xeb\x9b\x21\x9c\x9d\xf9\xcd\xd1\x95\x41\x12\x56\x96\x6e\x85\xe4\x53\xdc\xaa\xea\xc4\x4d\x56\x05\x3f\x95\xf1\x62\x07\xfc\xaa\xb8\x93\x15\x83\xea\x53\x71\x0e\x2c\xe9\x60\x89\xe7\x39\xb8\x91\xb3\xd4\x88\x7c\x1b\x3d\x64\x7e\x80\xfd\x1d\x8d\x32\x9f\x4a\xce\xd6\x94\x37\x79\x23\x6f\xdd\xea\x50\xa5\x9a\x77\xe2\xd5\x14\x50\x2a\xd9\x23\xa6\xfd\xc8\x96\x92\x74\x87\xd7\x06\xea\xc0\x82\x38\x34\xdc\x4f\x0d\x89\x72\x48\x04\x5b\x87\x7f\x6d\x26\x6f\xb9\x89\x5d\x1b\x37\x9a\xfd\x32\xc3\xbf\x45\xff\x29\xc0\xd2\x7e\x46\xd5\x09\xac\x7e\x55\x27\x12\x1c\xf0\x6e\x99\x1c\x05\x96\x2d\x29\x1c\x84\xcb\x9a\x43\x14\x61\x58\x77\xa1\x4a\xff\x47\xb0\x55\x1e\x53\xc9\x8e\x1b\x6d\x82\x48\x83\x08\x11\x5a\x11\xa6\xce\xa4\x51\x29\x38\x16\x90\x8e\x5c\x1b\x07\x17\xce\xb4\x1d\xed\x6b\xf0\xb9\x15\xa3\x0b\xd1\xe0\xd4\x5b\xf1\x87\xac\x92\x96\xf1\xe2\xe7\xb5\x71\x1b\xcd\xd2\x77\x79\xdb\xda\x8e\x82\x30\x8d\x73\x31\x89\xe2\x5c\xc0\xd5\xf4\x2f\xd6\xb7\xa8\x91\xb0\x2e\x5e\x9b\x65\x95\x75\x79\x86\x35\x8c\x35\x6f\xae\x7b\xc0\x84\x1b\x2c\x0a\xda\xa4\x4b\x58\x7d\x75\x73\xb4\xef\xc1\xa9\xf4\x23\x76\xdc\x94\x44\x84\x35\x39\xab\x0f\xc3\x96\x65\x26\x7a\x6d\x56\x5d\xba\xbb\x46\xc3\x8d\x2c\x26\x44\x64\x02\xa3\x30\x9e\xd4\xa0\x20\xfc\xb6\x8d\xf6\x84\xfd\x08\x47\xc2\x92\xf0\x6f\x89\xe6\x51\xa8\x2e\xbf\x5f\xa3\x26\xee\x0a\x33\x36\xbd\x8d\x7e\xff\x64\x0b\x34\x1b\xf2\x57\xd7\x26\x41\xcb\x73\x82\xdd\x6b\x89\x3a\x63\x19\xf7\xaf\x4d\xee\xff\xeb\x8a\x36\xd3\x5d\x7b\x21\x81\x34\xc5\xeb\xff\xb1\x14\x85\x32\x6f\xf6\x5f\xf8\x0f\x21\xd4\xd1\x8e\x09\xaf\x58\x12\x2e\x38\x64\x32\x72\x7d\x80\x68\x9f\xf2\xea\xf8\xb5\x51\xfb\xe2\xda\x94\xd1\x64\x5b\x15\xaa\x8c\x76\x68\x76\x2c\xf2\x20\x0b\xe8\xd7\xa6\x74\x75\xbf\x41\x79\x23\x65\x23\x20\x71\x10\x4e\x79\xa3\x0a\x83\x11\x06\x6c\x5c\xe0\xda\xe2\x37\x13\x6f\x72\x33\x8a\xaf\xc1\xb9\x57\xe3\x06\x8c\x96\xde\xc6\x66\xdb\x1e\x51\x5a\x48\x59\xb4\x41\xab\x4d\x57\x05\x5d\xcb\x05\xe7\xb4\x31\xc2\x3a\x1b\x17\xfc\xf6\xa6\x10\xe7\xcd\x38\xb3\xf2\x1f\x04\x58\xc5\x80\xe3\x4a\xeb\x97\x88\x6a\xe9\x42\xb2\x5d\xfa\x77\x8d\x71\x36\x64\xb2\x5c\xcf\xc6\xd1\x2c\x3e\x21\x5c\x0c\x0c\xc6\x61\xbc\x84\xb9\x7d\x10\x14\x43\xb5\x27\xd2\xb0\x7a\x09\xb7\x82\xd9\xac\x38\xe8\xdb\x01\x31\x38\x93\x75\x1d\x98\x59\xad\x08\x66\x9a\xd6\x19\x5c\xdb\xae\xbb\x9e\xed\x62\x20\x82\x36\xf9\xd9\xe6\xae\x33\x60\x56\x0b\x93\xa9\x94\x3b\x13\x6d\x79\xcc\x64\x44\x20\xa2\x55\x32\x3b\xb3\xd0\xd8\x45\x68\x1b\x77\x65\xc3\x12\x36\xc3\x94\x49\x2f\x23\x8c\xe6\xc5\xd7\x68\x47\xb5\x24\x37\x22\xa1\x84\xb6\x98\x26\xac\x3d\xe8\x56\x6b\x59\x20\xeb\xd0\x4c\x26\x17\xc0\xf4\x58\x0b\x5b\x05\x3d\x9f\x21\xae\x90\x6b\x7a\xa4\xd6\x10\xae\x09\x8a\x4e\xae\xaf\xe7\xf0\xaf\xd0\xc2\x02\x3e\x87\x4b\x3a\x53\x39\x04\x3f\xc6\x20\xab\x51\xc2\x34\x1d\x44\xa4\xbb\x9b\xa3\x4d\x19\x7c\x92\x2d\x9f\xeb\x3e\x23\x04\xc3\x50\xe2\x85\xb2\xd2\xb6\x43\xeb\x63\xde\x84\xd5\x22\x8b\x61\x87\x51\x17\xec\x68\x35\x79\x4d\x22\x5f\x13\xfd\xf0\x54\x22\xe2\x52\xa9\xa9\x8f\x41\x67\xb9\xd7\x73\xdc\xf6\x3d\x1f\x0b\x64\x19\x52\x58\xd7\x10\x45\x20\x85\x16\xb7\x64\x03\x6f\x93\x10\xc1\x60\x27\x2a\xe0\x8c\x1a\x7f\x42\x4b\x99\x82\x21\xaa\x5f\x15\x5a\x07\xb9\xb6\xce\xf5\x11\xdb\x38\x94\xe5\x62\x26\xeb\x0d\x1a\xe3\xd7\x36\xc6\xda\x7b\xd9\x58\x57\x28\xae\x6d\x6e\x9a\x4f\x58\x82\x25\x23\x4f\xb2\x6c\x73\xd3\x3c\xc4\x5d\x06\x9c\x19\xc3\x64\xe3\x45\x6c\x5e\xe2\x52\x77\xba\xd5\x39\x58\x2f\xaf\xa6\x2c\xc5\xeb\x4c\x9e\x63\x9d\x1a\x28\x95\xd8\x84\x49\xe9\x3a\x73\x31\x9d\xbd\x89\xbc\xb6\x4d\x07\x17\x70\x38\x70\x7a\x8c\x39\x98\x41\xfe\x75\x5d\x94\x52\x2d\x16\x6c\xf7\xd2\xba\x1c\x4e\x79\xec\x68\x75\x09\x8c\x83\x14\x21\xbe\x4b\x1b\x25\xd4\xa0\xc2\x89\x8c\xca\x6b\x54\x90\xda\xfa\x99\x68\xcd\xa3\xb2\xb4\x68\x91\xd5\xec\x55\x42\x41\xa1\x52\xc1\xc8\xa1\xb9\x5a\xdc\x61\x0c\x75\xc3\xf6\x3a\x80\xab\xf9\x47\xbc\x60\x4b\x63\xc8\xf3\x2f\xd5\xda\x4e\x42\x61\xcf\xd8\x3b\x6a\x56\xc2\x2c\x75\x12\x66\xbf\x37\xe2\xaf\x83\x03\x9c\xff\x2f\x35\x1c\xe5\x14\x4a\x23\xdd\xe3\x57\x93\x68\xec\x29\xc2\x79\xe1\x8b\x60\x5a\xd1\x6e\xa0\x2f\x9d\x36\x54\x82\x96\x41\xcd\xa3\x73\xd6\x27\x7d\x9e\x41\xbb\x8f\xe0\xca\xe2\x59\x89\x83\xfb\x0d\xb4\x1d\x7e\x1d\x16\xa3\x9f\xe1\x1c\x87\xf6\x88\xd9\xae\x0d\x7e\x88\x90\x06\x88\xf2\x89\x87\xb7\x96\x67\x3f\xb9\xb0\xd4\xed\x20\xe4\x11\xa6\xee\x31\xb6\x78\x2d\x04\x6f\x8b\x1f\xb2\x6d\xc5\x2e\xbb\xe4\x58\xd4\x1e\xd5\xa0\x39\x12\x5c\x07\x9f\xe1\x2d\x17\x2d\x10\x9f\x43\x54\xbb\x50\x88\x86\xba\xae\xc0\xe9\x5a\x63\xaf\x10\x6b\x53\x70\xc4\x3c\x7a\x21\x22\xab\xab\x36\xaf\x10\x3b\x65\x24\x12\x16\x13\x27\x13\x2d\xda\x2a\xec\x2c\xd0\x3f\x76\x2d\xd4\xb8\xb3\xd9\x42\xc4\x51\x25\x8c\x4a\x96\x53\xf7\xe6\xe6\x61\xff\x6a\xa7\x7b\x09\xfc\xbb\xc1\x8e\x38\xa4\x0c\x98\x49\xca\x4c\xa1\xe9\xa8\x58\x45\xe1\xf7\xc8\x59\xfc\x3d\xc6\x0d\x41\x34\xa7\x1c\x81\x6b\xb6\xe1\xff\x58\x40\x5a\x5a\xce\x63\xdd\x4e\xba\x8e\xd4\x31\x61\xf4\xe4\x9d\xa1\xd1\x63\x7f\x2f\x6b\x79\xd7\x31\xa8\xa1\x18\xc3\x50\x17\x01\x99\xd4\x10\x91\xc8\x92\x20\x13\x5e\xfd\x43\x6c\xbd\xcd\x61\xa1\xc8\xc2\x50\xb7\x68\xae\x63\x78\xcd\xc6\xd1\x34\xf8\x3a\x06\x5d\x73\x41\x18\x5a\x14\x49\x96\x98\xae\x63\x49\x6d\x1e\x51\xa7\xab\x9c\xf0\xad\xda\x7c\x71\x5b\xf3\x7e\x86\x82\x92\xfd\xcb\x6b\xee\xb7\xfe\xae\xcb\x50\x96\x9a\xa7\xb2\x46\x6b\x2e\x76\xc4\x48\x4a\xdc\x7d\x58\x62\xeb\xf0\x10\x6b\x5a\x68\xd1\x47\x4b\x73\x33\xde\x44\xf2\x60\x11\x28\x28\x18\x71\x23\xc0\x90\x88\xc7\x13\xce\xa8\xb6\xdd\xca\x3d\xd3\x86\x42\x8e\x9b\x7c\x4f\x2e\x2c\xa6\xc3\x64\x7d\x12\x73\xa4\x20\x42\xc2\x22\x6a\x4f\x4c\xfa\x2e\xc6\x59\xa4\xbc\x32\xd8\x60\xae\x11\x8e\x76\xc8\x1b\x36\x3b\x24\x93\x49\x39\xea\x93\x29\x1a\xaf\x21\xcd\xdb\xe8\x55\x7e\xde\x4e\x9a\x43\x6e\x84\x8c\xd6\x39\x6a\x30\x3a\x2b\x41\x4c\xab\x59\x49\x71\x1c\xac\xe6\x48\x14\x8d\x20\xaf\x33\x08\xc6\x09\x1b\xe3\x0c\x39\x1a\xf9\x36\xcf\x66\xf1\xa1\x12\x18\x4c\x83\xa0\x50\xeb\x83\xa1\x8c\x5a\xe2\x82\x50\xcb\x7b\x70\x61\xa0\xf1\x90\xd3\x85\x65\xb9\x4a\xf1\x0c\xba\xb3\x71\xbd\x39\x8b\xa6\x10\x3d\x91\x8e\xa7\x17\x51\x40\x6d\xed\x8d\x70\xcd\x45\x0e\x30\x00\x4d\x8e\xeb\x34\xf2\xb3\x19\x30\x5f\x6c\x66\x09\xe9\x14\xf2\xb3\x81\xa9\x58\x7c\x52\x15\xf7\xea\xb3\x89\x4b\x2e\x27\x88\x38\xd7\x49\x70\xf5\xd9\x24\x6a\x37\x9f\x4d\xda\xd8\x6b\x04\x91\x86\x48\x08\x9f\x02\x2d\x5e\x72\xcd\x7c\x06\x1a\xfa\xa4\x43\x56\x46\x7e\x64\x9f\x01\x5e\x27\xfc\x12\x5e\x21\xd6\xb9\xc3\x67\x78\x75\xe5\xf2\x81\x69\x7b\x57\xca\x8d\xbc\xb2\x80\xd9\x83\xce\xda\x63\x42\x16\x1c\x55\x00\xf3\xa9\xae\xbf\x30\x6f\x0b\x30\x9f\xa1\xf9\x02\x74\xd8\xea\xa3\x1a\xbd\x83\x0d\xa3\x72\xaf\x99\x32\xba\xc0\xaf\x82\xda\xf4\x19\xb8\x5a\x9a\x89\xfe\x19\xbc\xa5\x15\x55\xc9\xaa\x17\x23\x8a\xc7\x83\xc6\x6b\x2c\x24\xd0\xd4\x11\x91\x29\xda\x67\x54\x8c\x1a\x6a\x5c\xcc\x00\x3e\xc7\xf0\x06\x9e\x02\x42\x81\xa6\x0e\x5b\x95\xae\x04\x54\xb6\x29\x23\x27\x39\xd3\x20\x75\x70\x9f\x21\xbe\x51\x56\x12\x2c\xef\x02\x62\x6e\xc5\x4f\x8c\xb7\x94\x3e\x43\x5a\xc8\x35\x33\x31\x8c\xc0\xd3\x7a\x62\x6a\x50\x32\xa6\x89\xee\x67\x48\xc5\xbd\x9a\xc1\x7a\xfe\x18\x49\x64\x13\x42\x58\x56\x4b\x6d\x69\x92\x57\x88\xc5\x99\xc6\x69\xd3\xdd\xd6\x37\x6c\x9f\x36\xe6\x7d\x6c\x36\xef\x0a\x15\x45\x61\x1f\x29\x3e\x1f\x4c\x47\x5a\x58\xff\xfe\x94\xa6\x54\xed\xe5\x90\x77\x01\x15\x77\x6a\x8c\xf7\xd7\x94\x68\xb9\x10\xd1\xea\x61\xc6\x13\x16\x61\xaf\xe6\x54\xdf\x7b\xd5\xfa\x66\x16\xed\xd4\xbd\xc7\x1e\x0e\xc2\x26\x67\xa0\x7d\x57\x5d\x4d\x85\xbb\xe2\xeb\xb3\x66\xaa\xab\x40\x34\x4c\x89\x75\x50\x59\x69\x19\xe0\x8d\x78\xe9\x20\xf7\xb2\xcb\x2e\x99\x75\xc7\xbe\xf2\xc2\xbd\x9a\x72\xee\x04\x18\xef\xaa\x2a\xac\xb6\x95\x51\x84\xa9\x2b\x68\x75\xb4\xa8\x34\xac\xa6\x2b\xf8\x64\x5c\xb2\xbb\x8c\x68\xaf\xae\x34\xb4\x48\x77\x59\xa0\x16\xca\x8e\x1b\x22\xd1\x9e\x84\x89\xac\x80\x29\xb5\x53\x97\x33\x71\x23\xa6\xb1\x74\x2f\x6a\x82\x0b\xc4\xc5\xee\xb4\x6d\x5f\x5a\xbc\x14\xdb\x91\x96\x69\x71\xe8\x51\x62\xfb\x74\x0b\xed\x5b\x4a\x75\x6a\xad\x74\x17\x53\x89\xff\x52\xe6\x12\x77\x95\x5f\x62\x57\xe1\xeb\xdc\x12\x2a\xce\xbb\xdc\x2c\x90\xb4\xa6\xba\x97\x75\x9f\x04\x59\xda\xfb\x0c\x97\x90\xa8\x17\xb9\x44\x0b\x09\x4b\xd6\xe2\xfc\x93\x3e\xb4\x66\x9d\xbb\x0e\xd2\x0e\x33\xb8\x9e\x85\xde\x37\xfa\xb3\x1d\xce\xd4\xd7\xda\x41\x06\xd5\xcf\xf8\xac\x2b\x06\x32\x1a\xd0\xdc\xc7\xc2\x26\x22\xa6\x11\x13\xfd\x68\xb2\x59\x06\x54\xc6\xf8\xfc\x6c\x97\x51\x5c\x98\x3e\x5b\x8f\x2a\x01\x2d\x21\xab\x6e\xf4\x40\x4f\xe8\x3b\x71\xe4\x08\x1d\x6e\x72\x34\x19\x08\x9e\xc3\xf9\xac\xe2\x88\xd9\xc8\x99\xca\x04\xb9\x6e\xf3\x7c\x46\x3b\x77\x98\x6b\x10\xe1\x3c\xca\x4a\x23\x43\x7c\x85\xbd\xb9\x24\x4f\xc1\xe1\xeb\x3a\xf0\x23\x15\xe7\x3c\x7a\x31\xd4\x75\x38\x26\x68\x37\xb1\x5e\x06\x3f\xcd\x06\x0c\xb6\x0d\x84\xbc\x26\x8f\x90\x62\x88\x58\x43\xa1\x4c\xce\x24\xc9\x63\xb4\x75\xa7\xf8\x73\x99\x64\x5a\xff\x79\x33\x75\x53\xe3\xf3\x26\x22\x35\xf1\x3f\x6f\x69\xaa\xcb\x11\x9f\xdf\x81\x3c\x48\x3f\xbf\xcf\xc6\x8f\x3a\x9f\xfa\xfc\x3e\x03\x5a\x2e\xd6\x5c\xdd\x9c\x20\xe6\x99\x82\xb8\xa1\xdd\x3a\xcc\xea\xcd\x30\xc3\x05\x6d\x00\x36\x73\x6e\x86\xd9\x8a\x6b\xe4\xcd\xd0\xe9\xf1\xcd\x80\xd3\x2c\x1b\xc8\x8d\x83\x5b\xf1\x5e\x44\x4d\xe6\x66\x44\x3d\x93\xd4\xde\x8c\x76\x22\x93\xe5\xe6\x95\x6d\x05\x95\x4f\x53\x4b\x07\x63\x7c\x69\x8e\x8e\xeb\xe7\xc6\x9e\x65\x2a\x7b\x63\xfd\x0c\x76\xb1\x69\x98\xf1\x3b\x1b\x3c\x98\xab\x9b\xf3\x6c\x47\x6f\xae\x6e\x9c\x0d\x11\x67\x4b\x37\xce\xbe\x9b\x13\xe4\xf9\x6c\xa9\x68\x6f\x5c\xe9\x57\x5c\x6f\xdc\x96\x63\x6f\x6d\xdd\x2c\x6b\xdb\x27\xba\x59\xc8\xd1\x09\x3f\xf3\x03\xaf\xeb\xa3\x9e\xde\xf8\x01\x67\x1f\x28\x1d\xc3\x7b\xb0\x83\x94\xf1\x8d\x27\x0f\x04\xfd\xd8\xcf\x1b\xb9\xf6\xbe\xe1\x8b\x36\x01\xb7\xc4\x1b\x9f\x70\x28\xbd\xba\xf1\x19\xa2\x48\x32\x74\x5f\x75\x1e\x94\x4c\xb4\xd3\x65\x26\x6d\xfb\xc6\x67\x99\xea\x13\x52\x7f\x38\x24\xeb\xbc\x25\x5a\x5b\xea\x08\x5a\x9c\x4a\x43\xa3\xbd\xe7\xcf\x4d\x30\x9d\xff\xd4\x4d\x80\x5d\x50\x01\x56\x2b\x4a\x73\x23\xcb\x6c\x34\xae\xde\xe0\xac\xde\xa6\x45\xd6\x4e\x6e\xb8\xa7\x90\x89\xc6\x0d\x39\x55\x2d\xbc\x6c\x73\x13\x56\x07\x83\x4c\xce\x6f\x34\x6a\x71\xc1\xbc\x09\x69\xf3\xd0\xda\xdd\x0d\x9a\x51\x8d\xbc\x6f\x93\xc5\x98\x57\xf1\x41\xa5\x95\xd7\x9b\xd5\x52\x42\x74\x02\x79\xb3\x5a\x67\x59\x5d\x18\x05\xb5\x2a\x6f\x56\x7b\x31\xee\x42\x4f\x8a\x0b\xab\x4d\x03\xa5\x28\x0e\x30\x05\xde\xd3\xbc\x89\xb0\xd4\xf8\xe2\x02\x7e\x82\x85\x5b\xde\x4d\xf4\x29\x4b\xdd\xc5\xdf\xdc\x2c\xe2\x96\xec\x0a\x4e\xfa\xb2\x9b\x9d\x63\xac\xb0\x01\x47\x55\x25\x9d\xc2\x89\x80\xd3\x45\xba\x2b\x8a\x9d\xb1\xf0\xd9\x1b\xdb\xa7\x0f\xf4\x3f\xd6\x4f\x57\x37\x85\x4d\xfb\x9b\xd2\xb5\xbc\x42\xde\x6f\x49\x80\xce\x7b\x6f\xca\x28\xea\x5f\xde\xe1\xb4\xe9\x7f\xce\xc4\x6f\xeb\x79\xdf\xfd\xe6\x0d\xcd\x21\x31\x0a\x6f\xde\xec\x54\xfa\x36\xfd\x66\x9d\x8c\x75\x37\x6f\x5a\x32\x5f\x4c\xf5\x39\xff\x62\x86\xb3\x33\x8b\x35\x57\x5f\x68\xc3\xa0\x5b\xcc\xfb\x62\x68\x89\xe9\x8b\x71\x83\x0e\x7e\x5f\xd0\x32\xa8\x1d\xf6\x17\x43\x6d\xf1\x0b\x2f\x3c\x62\xb7\x86\x16\xc7\x97\x36\xd9\xa0\x50\xe8\xec\x4a\x2d\xb8\x2f\xed\x28\x0b\x41\x2e\xfa\x2f\x72\x48\x46\xc2\x85\x18\xcb\xd4\x36\x09\xbe\x40\xbc\xd8\x51\x56\x51\x95\x84\x8a\xbb\xdc\x7e\xb1\x27\x6e\x8c\x08\x78\x6a\x80\x48\xeb\x94\xb0\xcc\xe1\xbe\xc8\x79\x23\x79\xdd\x99\x98\xde\xcf\xb6\xc1\x8d\x60\x4d\x82\x75\x76\xd0\x55\xab\x90\x30\x91\xbd\x44\xf8\xab\x19\x72\xe0\x97\x25\x8b\xe4\x18\x54\x23\xec\xb2\x68\x7b\x77\xce\x2f\xd6\x65\x88\xe6\xe4\xe0\xea\x8b\xf5\x30\x05\x72\xb2\xf9\xc2\x7d\xa2\x24\x50\x31\x77\xe1\x5f\x9c\x39\xf1\x4a\xde\x17\x67\x50\x0d\x34\x42\x61\x84\x96\x05\xc4\x22\xfa\xe2\xcc\xc5\xce\x6d\x41\x84\x78\xfd\xe2\x62\xa3\x59\xc8\xa8\x22\x82\x3d\x4b\xff\x1e\x9b\x70\x04\x5b\x68\x7d\xa1\x23\xf5\xf6\x35\x4a\xee\xa9\x7b\x68\x39\x75\xf0\x46\xc9\x6c\xcb\x88\x84\x65\x61\x97\xb0\x28\x01\x42\x8d\xc1\x92\x0b\x2d\xb7\xd7\x2f\x38\xce\x9f\x8a\xc7\x81\x87\xba\xae\x2f\xae\x84\xd8\x42\xe3\x09\x2c\xbe\x18\xc6\x4e\x6b\x98\x58\x4c\x03\xc1\xee\x15\x9c\x24\x98\x8c\x8c\x07\xc8\x2f\xb2\x35\x2e\x49\xee\x36\xca\xbf\x60\xc7\xa7\xda\x1f\xe2\x12\x12\xbe\x1d\xb3\xc5\x22\x89\xc6\x0f\x96\x57\x5c\x11\x93\xfa\x44\x93\xa1\x44\x42\xb0\x6c\xf2\xcc\x8e\x86\xde\xb7\x30\x2e\xc6\x8b\x31\xf7\x25\xda\x29\xec\x9a\x4a\x0c\x7e\xb4\xb3\x39\xd9\x6c\x7c\xba\xfa\x12\xcb\xae\x20\x63\xc9\x58\x56\x58\x68\xc5\xd5\x19\x3c\x61\x59\xa1\x63\xcc\xd5\x8a\x50\x0a\xb3\xf8\xb1\xbd\x9d\x7a\x28\x47\x83\x10\xb2\x42\x61\x2a\x4a\xea\x2b\xb7\xa4\xa0\x09\x28\xa9\xeb\xb2\x6e\x0d\xe4\x53\xa9\x9a\xa2\x94\x0a\x59\x49\xd3\xf0\x5b\xf3\xfa\x8a\x4d\xea\xd6\x70\xde\x6f\x8d\x75\xce\xc4\x31\x64\xa1\xed\x14\xcc\xad\x71\x54\x27\xb7\xc6\x39\x03\xea\x1b\x42\x2c\xd7\x07\xb6\x4f\x88\xab\xd1\x3a\x67\xc5\xcc\xbb\x35\x8b\x49\x32\x89\xbf\x15\xb7\x9d\x5b\xe3\xa7\x7f\x24\x88\x38\x68\x42\xe2\xe8\xa5\x91\x09\x2e\x0c\x7f\x87\x30\xaa\x18\x31\x7d\xd6\xf6\x9e\x6f\xc1\x66\x47\x6b\x72\x8a\xf0\x41\x0c\xbe\x72\xfe\x58\x08\x0d\x97\xb7\xb4\x83\xca\x6b\xf1\xb7\xe0\x9a\xbb\xe4\x2d\x38\x96\x91\x6b\x04\x77\xd2\x4c\x78\x69\xe0\x96\xdd\xca\x78\x38\xbe\x85\x65\x41\xe5\xe5\xff\x5a\x3e\xb0\x2c\x76\xa2\xa0\x96\xa5\xa5\x31\x98\xfe\x0c\xcd\x2d\x34\x75\xba\x85\xee\xad\x61\x1b\x44\x36\xee\x16\x39\x6e\x21\x68\x02\xba\xee\xea\x16\xc2\x9a\xb6\xa1\x8d\x95\xb7\x10\xe2\x44\x83\x05\xa1\xd7\x32\xcc\x89\x49\xbf\x64\x73\x0b\xfb\xc5\xf8\x0f\x5c\x42\xe6\xbe\x00\x41\x98\x28\x08\x69\x7c\xb7\x10\x2f\xe4\x58\x72\x3b\x87\x84\x23\xed\xad\x3d\x9d\xa4\x83\xb8\xb5\x90\x4e\xec\x1f\x88\x04\xfb\x3f\x06\xb5\x95\x10\xa6\x56\x82\xb9\x72\x66\xb0\xc0\x2a\x83\xb0\xbe\x44\x44\xca\xd3\x99\x32\x88\xc5\xcb\x58\x12\xe6\xd8\x64\xd2\x21\x1a\x39\xe8\xac\xe3\xd6\x85\x93\xd5\x69\x03\x92\xe2\x42\x87\x29\x6a\xda\xa9\xbc\x75\x01\x6a\xa4\xa1\xd5\x44\x33\xc8\x2a\xa4\x06\x5f\x59\x8b\x56\x05\x34\x1c\x30\x9d\x0d\x64\x50\xec\xcc\xb2\xc9\x03\xec\xf1\xba\xf7\xd8\x32\xac\xb0\x45\x40\xd9\x20\xcd\xaa\x8c\x33\x65\x7b\xb2\x80\x4e\x9a\x90\x34\x5c\x86\xe0\x4d\x77\xc8\x97\x25\xe1\x7f\x88\x2a\xdb\x06\xd9\xcc\xbc\x25\x6f\xbf\xdb\x30\xd2\xfa\xdf\x2d\xce\xbe\xa4\xb8\xd1\xc4\x12\xb3\xed\x36\x2c\x74\xbc\x87\xf6\xe3\x90\x7a\xb3\xe8\x0c\xe8\x36\xc8\x79\x9b\xdb\x50\xed\xcd\xdb\x10\xc6\x57\x1c\x31\x7f\xd3\xdb\x95\x48\x60\x71\x0c\x5e\xff\x57\x0d\x08\xd1\x85\x8b\xbe\x80\x46\xad\xe1\x6c\x87\xe2\x68\x1b\xf4\x96\x8e\x41\xb4\xa1\xa2\x71\xfa\x9c\x99\x68\x50\x34\xea\x2f\x78\x1b\x1b\xf0\xa3\x6e\x31\x20\xa1\x39\xc4\x2d\xcd\x26\xbb\x40\x90\x55\x35\x12\xba\x23\x92\x41\x62\x61\xf7\x5d\x9b\xa9\xde\x46\x58\xd0\x40\xb8\x8d\xb4\xab\x10\x19\x6e\x89\x26\xbf\xb7\x64\x84\x65\x42\xa1\xac\x57\xb7\x65\x9a\x80\x26\x62\x1c\x4f\x71\x27\xc0\xf1\x8c\xde\xa5\x50\x35\x96\xe2\x5f\x9d\xe5\x2f\xcb\x46\x27\x5a\x6e\xb7\xa8\x6a\x79\x67\x60\x31\xa7\xe6\x36\x4c\xbc\x56\x3f\xb2\xf0\xe1\xa9\xf6\x34\x77\x06\x22\xf0\xb0\x4b\x13\xeb\x3b\xf3\x8a\x71\xdf\x99\x59\x5e\x75\x1f\xbc\xf6\x50\xd2\xef\x08\xf5\x5c\x42\x70\xdd\xf1\x73\x64\x31\x81\x6f\x0b\x2d\x77\xc6\x59\x99\x2d\x12\xa4\x73\x17\x40\x18\x28\x42\x9b\x06\x3a\xbd\xd2\xde\x77\x9a\x16\x39\xe3\xd7\x3a\xae\x26\x6b\x47\x7b\x9b\xac\xe3\x1f\x92\x1c\xf6\x49\x0c\xc6\xd7\x28\xfa\x9c\xca\x17\x3c\xc3\x43\x3e\xd4\xd7\x3a\xe7\x13\xa4\x80\x73\x7b\x69\x3c\xc8\x65\xb9\x17\xe1\xd4\xda\x1b\x52\x39\xb3\x82\xd0\x99\x21\xef\x4a\x36\x38\x58\x73\x70\x9b\x9f\x70\xd6\x71\xa7\x47\x9e\x68\x10\x23\x46\x1a\x8e\xa8\xae\x4f\x22\x91\xd9\x2b\xc2\x6e\xc1\xe0\x8e\xb7\x02\x7c\x45\xb1\x38\x65\x6c\x5b\x12\xa2\x5e\x49\x50\x5c\xba\x2c\xec\x4e\xbb\xa3\x40\x46\x84\x3b\xe3\x36\x7d\x6b\x31\x64\x61\xdd\x19\x3f\x82\x78\x96\xdf\x19\x9f\xd2\x30\x3b\x98\xc8\x29\xf9\xce\xac\x46\x8c\x67\x86\xb2\x0a\x73\x67\x56\x2c\x76\xd1\x83\xd5\x69\x26\x53\xdb\x09\xbe\x33\x29\xf1\x29\xf2\x3b\x73\x81\x38\x5b\xc5\x6c\x36\xdc\xc1\x88\x26\x3e\xe0\x24\xea\x0e\x5c\xe7\xa7\x40\x4c\x9a\xe3\x1d\x60\xbb\xd5\x10\x89\x70\x4c\xe0\xec\xb9\xd7\x24\x70\x76\x17\x82\xfd\xf8\x90\x5a\x8c\xe0\x08\x64\x12\x10\x26\x67\x90\x59\x9f\x48\xc9\x10\x6c\x72\x2a\xed\xae\xd5\x81\x8f\x56\x2c\x21\xc2\x12\x69\x3c\x75\x27\xa2\x90\x62\x6a\x6d\x79\x63\x3c\xaa\x42\x43\x44\x13\x45\xfa\xbb\x3b\x76\x5d\xd2\x62\x85\xb8\x6e\xef\x9a\xc5\xb4\xf2\x64\xe5\x0e\x68\x59\x84\xb7\x34\xa5\x58\x50\x32\xa3\x65\x3d\x05\xcf\x79\xe4\x73\x32\xf6\xe4\xd8\x15\xff\x0e\x91\x26\xcd\x3a\x37\x87\x92\x48\xec\x12\xf0\x3f\x62\xeb\x4a\x2c\x26\xea\xb1\xef\x6c\xaa\x56\xcc\x9d\x9c\x40\xbe\x0b\x6e\x84\x85\xfa\xb4\xbb\xe0\xd4\xb3\xe5\x2e\x38\x59\xf5\x20\x44\x7b\x79\x88\x24\x8f\x74\xa2\x4e\x13\x1b\x96\x00\x61\xcd\xdb\x30\xc3\xd5\x1d\x2f\xf2\xd1\x3f\x2e\xc0\x10\x17\x9e\xda\x13\xca\xb3\xe4\x1e\xf3\x76\x0a\x9c\x10\x9c\x40\xf0\xb1\x0d\xc6\x52\x58\x84\xd7\x10\x69\x2c\x26\x46\x7b\xa1\x56\x9f\x31\xc3\xc2\x61\x46\x4d\x25\xfc\x36\x71\x94\x98\xb7\xc0\xd3\x90\xbb\x62\x6c\x9a\x0b\xa6\x8b\x67\x7b\x52\x6c\x6d\xea\x87\x50\xb3\x53\x96\x36\xdb\xb8\x2b\x31\x78\xab\xb9\xfe\x6d\x68\x5d\x93\xed\xea\xbb\x0d\xbb\x52\x6e\x21\x1b\x96\xc6\x86\xb5\xc1\x74\xa4\xbb\x21\x4e\x74\x5c\x97\x4a\x8e\x24\xc1\x41\x7d\x8a\x38\x29\x8e\x9b\xe7\x3c\x22\x85\x95\x53\x22\x6f\xb6\xed\xc3\x4a\x79\x02\xa3\x34\x7c\xbc\x89\xa4\x3e\xd0\xbc\x75\x9c\xc7\x00\x15\xec\xc3\x0d\x5d\x5a\x99\xb2\x9e\x28\xd7\x7d\x8a\x2a\xd0\x1e\x07\xb9\x74\x59\x3c\xb5\xa3\x7f\x55\x3d\x36\x07\x8b\x8e\x6d\x5b\x3f\x74\x6c\xeb\xac\x96\x2d\xe2\xce\x96\x66\x2a\xa9\x23\xc8\x03\xe9\xb6\x06\x9c\x36\xdf\x6d\xc5\xa7\x10\xbc\x63\xef\x8f\xaf\xb4\x52\xf3\x75\x04\x89\xe5\x6b\x3b\x6a\xff\x75\xf2\x26\xbf\xa3\xf2\xf3\x69\x04\xe4\xfd\xa9\x03\xe6\xb4\x70\xbd\x5c\x7d\x75\xa5\x1b\x97\x91\x49\x1a\xbe\xba\x7a\x62\xe0\xab\x5c\x5c\xf0\xd5\x0f\x64\x32\x7c\x6d\xb3\xc8\xaf\xfe\xd5\x99\x93\x75\xf4\xf4\xd5\x15\xf0\xef\xe6\xff\x55\x65\xab\x22\x59\xe6\xf7\x66\xf6\xce\x0e\x33\xe0\x23\x1e\xce\xbf\x7a\x5a\x6f\x44\xf0\xab\x58\x47\x56\xf9\x57\x4f\x4b\x9a\xdd\x4e\xcc\x57\x9f\xa3\xe9\x16\x75\xbf\x86\xba\xa4\xfb\x35\x49\xe2\x52\xad\xeb\xaf\xa9\x1f\x0b\x91\xf1\x74\xe9\x6b\x0a\x2b\x96\x01\xa9\xf2\xd7\x54\xd7\x19\xbf\x66\x88\x6c\x0c\x7d\xdd\xc0\x4f\x7a\xf4\xfb\xeb\x3b\x27\xf1\x9b\x31\x13\xcf\xda\xbe\x19\xf1\xf4\xfa\xd6\x4e\x08\x7e\x33\xde\x1b\xee\x69\xbe\x19\x9f\xe7\x9d\x31\xf0\xcd\xe4\x82\xb6\x85\xb9\xfa\x06\xff\x60\x5b\xfc\x06\xc1\x4f\x50\x56\x86\x79\xea\xed\x18\x15\x88\x0e\x7d\x9b\x4d\x88\x56\x92\x80\xf6\x89\xa0\x34\x83\xe2\x33\xff\x0b\xb3\xf1\x1e\xd2\xc9\xac\xd9\x26\xb4\xc3\xbe\x85\xd9\xeb\x3d\x04\xdf\x82\xff\x55\xe4\x88\xce\xb7\x10\x64\x23\xf9\x1b\x79\x90\x8a\xc9\xfc\xad\x4c\x85\x07\xe8\x6f\x25\x16\x76\x3c\xb8\x37\x56\x5e\x25\x44\x12\x3f\xf2\x34\xf7\xde\x68\x47\x77\x6f\x22\xe9\xe1\xbd\xa1\xf3\x3e\x1e\x51\x36\x93\x47\x95\x07\xc2\x7e\xa2\xa9\xda\x3d\x9d\xa7\x8a\x0c\x73\x98\x8a\x0b\xde\x0e\x56\xae\xf6\xb9\xb7\xa7\x11\x76\x3e\x12\xf7\x76\x4c\x75\xaf\xeb\xde\xba\x06\x7d\x3d\xf8\x7a\xcf\x57\xb8\x70\x1d\xde\xeb\x7d\x2e\x96\x30\x4e\xe4\xb3\xc2\x8d\x40\x44\xa3\x8e\xaa\x96\xc7\xfe\x7b\xcc\x16\xeb\xfa\xbd\xcd\x26\xd1\x11\x35\x45\xdc\xf9\xdd\x3b\xd0\x89\xe5\xbd\x2b\x53\x45\xdb\x85\xa2\xf4\x81\xc7\xb2\xfb\x30\xd0\x3a\xcf\x7d\x78\x95\x02\x0a\xcb\x85\x56\xca\x83\xbf\xba\x0f\x6b\xd4\x81\xf1\x3e\xd0\xc9\x13\xfa\xd7\x44\xd5\xe1\xea\x3e\x24\x59\x7f\xb8\x0f\x69\xe1\x69\xef\x7d\x48\xdd\x0d\x33\xf7\xe1\xdd\x9c\x31\x04\x3a\x76\xe4\xed\x99\xce\x66\xdd\x47\x7b\x3a\xf1\x77\x88\x58\x02\x93\x48\xf8\xfd\x70\x6e\x7e\x17\xf7\x31\xac\x2b\xf8\x94\x61\xa4\x32\xca\x30\xb6\xb9\xd8\x7d\x81\xfc\x6e\xfd\x84\x3d\xc9\x7d\x71\x2b\x4d\x17\xee\xcb\x62\x86\xb9\x38\x9c\x03\x0d\x48\x71\x72\x44\x72\xde\xce\xb9\x2f\x69\xe6\xce\xe6\xbe\x24\x0f\xef\xf2\x50\x7d\xbb\xee\xeb\xed\x14\xf7\xec\xf5\xc3\x77\x00\xdd\x6f\x31\x17\xee\x0b\xef\xb7\xea\x33\xfe\x60\x4e\xc0\x83\xd9\x83\x39\x45\xf0\xef\x96\xd1\x86\x8f\x06\x39\xe9\xf7\x80\x26\x7a\xd7\xd0\x98\xb3\x12\x3f\x54\x47\x54\x82\xbe\xd0\x69\xc7\x07\x43\xa7\xda\x45\xe3\x90\x35\xdb\x4a\x59\x0b\xac\x4b\xcd\x80\x7a\x28\xeb\xb9\xc4\x54\xe5\x88\x5c\xe8\xfd\x09\x22\x6d\x5d\x49\xda\x16\x52\x32\xae\x32\x61\xb2\xf4\xfb\x60\x96\x76\xe2\x87\x09\x5b\x1c\x0f\xb5\x4d\x3d\x18\x7f\x06\x3a\x21\xec\xaf\x1e\xcc\x6a\xdb\xc1\x8c\x07\x13\x5b\xc6\xe2\x59\xf6\x55\x1f\x4c\xa1\x63\x2e\xe6\xea\x01\xcc\x78\x82\xac\xdb\x50\x0f\x70\xaa\xd7\x73\x3d\xc0\x30\xc3\x05\x27\x34\x91\x5e\x1c\x1c\xc4\x81\x10\xf0\xdf\xf2\x1b\xfc\x1c\x40\x8e\xbf\x3d\xc0\xa4\xcb\x67\x0f\xcd\xfc\x7a\x00\xfb\xca\xfd\xe4\x03\xd8\x64\xfd\x44\xa5\x40\x0b\x52\x1a\xe3\xb2\xd0\x11\x4c\x7e\xa7\x12\x79\xd8\xaf\xac\x2a\xa3\xcc\x20\xae\xa5\x0a\x3e\xbf\x03\xfe\x5f\xd1\x62\xa0\x0f\x77\x0e\x7e\x44\xf9\x9c\xd4\x43\x3f\x37\xa9\x64\x3b\x09\xd1\x1a\x00\x72\x08\x24\xd5\x50\xc8\xc3\x0a\x51\x5d\xd0\x68\x84\x06\x52\x14\x44\x9c\x19\x87\x8b\x81\x1a\x52\x86\x94\x0b\x0f\x06\x0f\x50\xaa\x15\x4f\x58\x46\x19\xc2\xed\x0d\xd9\x0f\x7b\x80\x72\xe6\x71\xfe\x01\x2e\x56\x93\x7f\x91\xff\xbf\xb5\x2e\x2d\xa4\x33\x08\xac\x86\xda\x83\x5d\x64\x04\x26\xe4\xf3\x0c\x84\x42\xf7\x3c\x0c\xb3\x75\x23\x28\x76\xb6\x62\xd1\x1d\xc4\xd5\xc4\x23\xc6\x2d\xef\x81\xce\xe3\x4a\x94\x7c\x06\xf8\xc1\xfe\x2a\x76\xd4\xb5\x6e\x66\x62\x15\x3c\xd8\x34\xdb\xf7\x99\x56\xf8\x1e\xc8\xdb\x40\x80\x6a\x8b\xcd\xb3\xac\x5b\x11\xa4\xcd\x52\x42\x5c\x22\x36\x77\xfd\x1d\xb1\x3d\x19\x38\x7e\x84\xcd\x32\x12\xce\x09\xf8\xc7\xf0\x0d\x36\x0f\x01\x5e\x5f\x55\xd3\x11\xbb\x8e\x4c\x91\x53\x18\xce\x59\xe6\xd1\x0f\xc1\xc3\x6a\x2b\x9e\xb4\x0a\x10\x72\x67\xff\x10\x56\x93\xeb\x1b\xeb\xac\x3e\xbd\x0f\x7a\xee\xf5\xa1\xf4\x0e\xee\x0f\x05\x68\x0a\x21\x1f\x14\x1f\xe8\x80\xdd\x43\xc9\x46\xcb\xa2\xe4\x96\x55\xc4\x5d\x8e\x88\x72\x86\x10\xae\xc5\xe1\x90\x92\x0b\xf3\x5a\x69\x88\x5d\x17\xc6\xbf\xa1\x84\x46\x2b\x9a\xdd\xc3\x1a\xb6\x66\x4e\x1b\x09\xfd\x27\xc3\x9b\x21\x8f\x85\x0f\x5b\xea\x2f\x22\x79\xd8\x52\xd5\x2d\x39\x8f\xf3\x68\x86\x33\xf6\xc5\xda\x14\x1e\xcd\x50\x77\xb5\x09\x73\x8c\x04\xd5\x77\xe2\xd1\x4c\x7e\x77\x3e\x49\x04\xad\x01\x3e\xca\xa2\xce\xa3\x71\x16\xc7\xa9\x47\xe3\xaa\x83\xc3\x23\x1d\x1b\x94\xc7\x7e\xa2\x8d\x72\x4e\xd3\xa3\xf1\xec\x29\x40\x4f\x52\x89\x67\x7d\x2b\xc3\x64\xde\xb1\xf7\x78\x34\xa8\x01\x99\x40\xfb\x2c\xd6\x45\x25\xc2\x96\xd2\x1e\xad\x1c\xa3\x26\xd8\xec\x46\xa2\xec\x42\x86\xb0\xb6\x9d\x47\xb2\xb2\xbb\xd5\x1c\x12\xb4\xc2\x63\x3a\x48\x92\xa2\x6e\x37\x31\xe4\x35\x74\xc1\x0e\xde\xac\x03\x61\x6c\x46\x9b\xca\xfa\x75\x18\x96\x35\xf5\x61\x4e\x2d\x8a\x21\xcf\x44\x04\xcb\x94\x45\x18\x1a\x11\x04\x43\x57\x0c\x7e\x57\x29\xb1\xf9\x5d\x32\xd1\x0a\x40\x9c\x06\xad\xaa\xc8\xd7\xd1\xc5\x9e\x5f\x04\xad\x31\xbc\x16\x3f\x4a\x08\x09\x46\xbb\xf4\x19\xdf\x7f\xd6\x52\x9b\x5b\x27\xa7\xb4\xc5\xcd\x53\x3e\x46\x52\x93\x6f\xe4\x15\x59\x5f\x59\xb4\xc7\x78\x34\x71\x0d\x52\x7a\x19\x9c\x96\x3d\x9d\x63\xd4\x12\xbc\x58\x7f\x8a\x1b\xdf\x6f\xf5\x68\x52\x92\xd2\x66\xef\x20\x29\x4b\x26\xdc\x31\x12\x9b\xf8\x22\x41\x6a\x24\x9d\xa0\x34\x16\x1c\xec\x1f\xf3\x2c\x57\x98\x06\x95\x87\x59\xb7\x2c\x1f\x4d\xce\x38\xe8\x3e\xe2\x90\x1c\x87\xd9\x44\x39\xd8\xfe\x08\x93\x51\x0f\x04\xc4\x92\x4d\x98\xf8\x3e\x3e\x04\x3a\x48\x22\xd6\x17\x6d\x9d\xa4\x3e\x82\xb3\x29\xd5\xaa\x04\x47\xf7\x59\xd1\xff\x2c\x76\xf5\x23\x78\x9b\xf8\x69\xe4\x8b\x4e\xba\x8e\xe3\x91\x1d\x3f\xd8\xa9\xfd\xb1\xf7\x02\xe9\x08\x05\x12\xed\x7b\x58\xb6\x48\x4f\x52\x78\xed\x8e\x0a\x21\xf7\x22\xc7\xc9\x74\xe8\xe4\xb2\x28\x88\xb0\x1d\xad\x7f\xdc\x5d\xd4\x80\xcc\x39\xfd\x04\xb1\x5e\xff\x89\xac\xdd\x5f\xf6\x08\x79\x98\xd9\xfe\xe5\xfa\x85\x3c\xd7\x02\x23\x4f\x8b\x1a\x59\x9e\x37\x47\x8e\xa9\x4a\xac\xc6\x3b\x6f\xdd\xda\x6a\xe3\x12\x77\x63\xdd\xeb\x74\x9f\x67\xc7\x70\xa0\x53\xc2\x5a\x27\x44\xeb\x80\x19\x5b\x06\xc2\x64\x21\x56\xd8\x64\xa0\x62\xf1\x2f\x4d\x3d\x6f\x99\xda\x7a\x47\x01\x15\xf4\x4f\xbd\x21\x7f\x7e\x61\xbc\xb2\x51\x89\xed\x32\x49\xde\x19\x82\xd5\x19\x49\x69\x48\x5d\xe2\xd4\xdb\x53\x29\x4d\xcf\x95\x84\xa5\x3d\xa8\x7a\x49\x34\x83\xef\x59\x55\x4f\xa2\x17\x88\xc9\x64\x5a\x32\x50\x89\xb8\x67\x2a\x0d\xb1\x7b\x9b\xbb\x6b\x3b\x18\x31\x47\x1e\xed\x10\x8d\x0b\x86\x41\x92\x73\x84\x48\x42\xb7\xa0\x4e\xbc\xab\x3d\xa2\x20\xff\x65\x4c\x64\x52\xdc\x89\xb6\x5e\x88\x49\x1d\x12\x94\x76\x4c\x38\x86\xc1\x2a\xc6\xba\x94\x16\xaf\xbc\xa3\x30\x0c\xb4\x08\x23\x64\x6e\xa3\x04\x09\x1c\x1d\xaa\xe6\x69\x2a\x49\xe6\x9a\x17\xf2\x26\xce\x1a\xa5\x54\xac\xc0\x3e\x0c\x1c\x80\xa1\xc3\xfa\x45\xdb\x6e\x60\x1a\x4b\x4d\x87\x2c\x34\x3e\xaa\x5f\x67\x07\xa5\xe7\x43\xda\x6e\x12\x10\xaa\x6b\x2e\xc2\xb9\xbf\x21\x18\xc9\xa5\x54\xdf\x94\x8e\x5a\x21\x55\xa5\x3d\xc7\x70\x3e\x87\x33\xa5\x0e\x31\xbb\x6a\x3e\xd2\x66\x3f\x65\x5e\xf6\x66\x1f\xdb\xba\xf1\xa3\x4d\x19\x3b\x66\xea\x3f\x6c\xc6\x28\x46\x30\xc2\x52\x91\xef\x72\x2a\x41\x06\xfc\x70\xb2\x75\x72\xc4\x44\xc6\x57\xc4\x7c\xdd\xdb\x63\x18\xa1\x1d\xdd\x78\x0c\xa3\x1d\xfa\x63\x88\x8f\x58\x25\x62\x38\x3e\x86\xa9\x33\xaa\x1e\x83\x9f\x82\xd3\x03\x10\xc2\x3c\x21\x6d\xf7\xc1\x87\x48\x46\x08\x19\xf9\x1b\xcd\x22\x1e\x43\xe4\x14\x87\x68\xde\x24\xd8\x68\xb3\xa2\xda\x31\x84\xb8\x89\x2c\xb7\x5d\xc3\xc7\x32\x58\x67\x3a\x7f\x27\x14\x74\x63\x67\x71\x7c\x5a\xfd\xea\xb1\x44\xe3\x47\x43\x3d\x61\x89\x23\x1a\xff\x1c\x5a\xa9\x67\x49\x19\x8a\xac\x21\x19\x6a\x0a\x8d\xf9\x74\xfd\x00\xeb\x6e\x49\x43\xbd\xb0\xed\x71\x1b\x80\x1d\xd5\xe8\x80\x37\xd3\xc0\x97\xa5\x60\x32\xe8\x72\x5c\x0e\x71\xeb\xbd\x46\x91\x69\xec\xdb\x40\x87\xd8\x8c\x22\xea\x8b\x71\xc4\x40\x8d\xe2\xb4\x6e\xb2\x8b\xf5\xd8\xae\x05\x54\x88\x73\x5f\xc6\xe7\x86\xf9\xb0\x14\xc2\x76\xdc\x44\x59\x61\xa8\xc5\x80\x70\xab\x50\x7b\xb1\x27\x73\x36\x7a\x9d\xe3\x93\xf1\xd5\x8d\xe2\xa9\x6d\x42\x3c\x99\x4e\x9a\x63\x77\xcb\x47\xa3\xac\xee\xc8\x9b\x85\xf6\x44\x9b\x5f\x56\x82\x26\xcc\x9b\x6a\x4c\xc2\x87\x17\xa5\xa3\x11\x22\x87\xc4\x9f\xd4\xeb\x9f\x83\x2f\x6d\xea\xfc\x64\x4a\xae\x80\xec\x96\x27\x53\x7d\xa8\x9e\x60\xd7\xd5\x11\xe5\xf6\x44\x70\xad\xa6\xfc\x13\xdd\x72\x7b\x51\x05\x79\x02\x9b\x12\xcd\xdf\x9e\xc0\x0d\x32\x4d\x7a\xa2\xeb\x98\x06\x34\x9d\xf8\xc9\x62\xa4\x8f\x79\xa2\xfd\x5b\x99\xcd\x32\x59\x9d\x19\x8c\xe0\x68\x46\x43\xed\xf9\x09\x82\x19\x69\x9f\x0d\x11\xdd\xad\xf6\xa4\x5e\x08\x23\x93\x73\x58\xcc\x64\x32\x0e\x7c\x4f\xd0\x7b\x94\x3e\x41\xef\xc6\xfd\x44\x0b\x7a\x7e\xb2\xba\x78\xf2\x44\xc7\xce\x6a\xf2\xd7\x5c\xbc\x6d\xa5\x80\xb4\x66\x3c\x82\x1d\xf1\x83\x58\x92\xa5\x9d\x40\xca\x5b\xb2\xed\xba\xaf\x27\x5a\x63\x01\x7f\x26\x73\xe5\x09\xfa\xe2\x86\xdf\xb4\x25\x64\xae\x9e\xac\xe1\x85\xb5\x27\x2b\x37\x6b\x5c\x3d\x61\x5b\x82\x0e\x63\xf7\xf0\x64\x13\xe5\x06\x3b\x2d\x93\xcf\xf4\x25\x9d\x49\x52\x57\x38\xa1\x11\xc6\xb2\xe3\x7c\x17\x0c\x32\xeb\x76\x4c\xb4\x07\x21\xf7\x40\x4f\xdd\xd9\x70\xc2\x5a\x0e\x84\x45\x65\x11\xa7\xdd\x93\xf4\xe1\x19\x1f\x21\x13\xc2\x2e\x28\x42\xa4\xe8\x98\xf0\x28\x21\x44\x46\x5e\x66\xb4\x7d\xab\x10\xdf\x91\x6e\x84\x9a\xe3\x53\xdf\xa7\x3c\x91\xef\x38\xcf\xb3\x9f\xc2\x48\x9e\x07\x99\x5e\x9a\x0a\xf5\x95\x4f\xc1\x3b\x73\x02\x8f\x22\x1f\x96\x42\x0e\xae\x4f\x94\x81\xa5\x1e\x44\x7b\x12\x8d\xa3\x7f\x5c\xea\x04\xe9\x82\x15\x81\x61\xb5\xa9\xe1\x54\x89\x44\x47\xd7\x61\x91\x3e\x28\xb4\x15\xcb\xdb\x97\xae\x9b\x7d\x0a\x17\x34\x68\xac\x9f\xd8\xd4\x7a\x2a\x27\x18\x5a\xea\xcb\x89\x37\xd8\xbe\x9f\x86\x80\x53\x1c\x69\x1f\xdf\x4f\x90\xca\xd2\xda\xe1\xf7\x01\x78\x93\x80\x0c\x18\x61\x7c\x8e\x89\x89\xcc\x3a\x85\x60\x6d\x33\xe4\x7a\x60\x9c\x20\xae\xe0\x33\x8d\xde\x22\x11\x4b\x4b\x18\xbb\x4e\x32\x51\x3b\x8b\x58\xeb\x71\x2a\x45\xcd\x61\xd2\x45\xd1\xcd\xe8\x98\xd3\x84\xf1\xbb\xba\x74\x45\x92\xcf\x62\x74\x7e\x1f\xb2\x19\x61\xd0\x33\x72\xdf\xc7\xe0\xb3\x8c\x19\xdf\xc7\xb6\x94\xf3\x7d\xdc\x92\x94\x51\xf3\xf9\xfe\x0e\x31\xf1\x72\xf5\xf7\x39\x1b\x38\xff\x66\x97\xe1\xef\xe7\x6e\xcc\xfd\xee\xea\x82\x09\x42\x31\x5a\x11\xb2\xae\x7d\xc7\x51\x89\xc2\x75\x34\x11\xe3\x6d\x62\x22\xf4\x0b\x07\xf4\xe0\xd2\x82\x20\x1f\xa2\xef\x4e\x2f\xb5\xf8\xee\x87\x70\xda\x62\x6a\x48\xc4\x67\xb6\x82\xbe\xaf\x33\xdd\x58\xcd\xa5\x83\x64\x87\xc9\xe0\xfd\xbe\xda\x5c\xd0\x4a\xfb\x1e\xa9\x2a\x71\x62\xa1\x6e\x3c\xdf\x79\xd5\xe9\x7b\xef\xc9\xf9\xbd\xad\x39\x7c\x8f\x16\x68\xf6\xf9\x3d\x62\x65\xf5\x6b\x06\xbd\x84\x92\xae\x5c\x0b\x4f\x79\x9b\x00\xb0\x28\xf4\x31\xe9\x22\xdc\xf7\xb8\xbd\x9b\xb9\x90\x2e\xc4\xed\xdd\x0a\x4c\x86\x0a\x9c\x8d\xc1\xd8\x60\x0e\x1f\x08\x07\xd2\x38\xe9\x7d\xc7\xb9\xd1\xb0\xa0\xa6\x98\x58\xbd\x8a\x41\xa8\x54\x5b\xca\xb4\x37\xf4\x3d\xd3\x00\x62\x19\xfd\xc6\xff\x97\x60\xc7\xda\x82\x7e\x83\xff\x0d\x70\xa6\x17\xde\x8c\xb3\xfd\x72\x1b\x0a\xaa\x12\xbf\x19\x9e\xde\xe0\x47\x6f\x4d\xba\x51\x2d\x1e\xcc\x60\x5f\xeb\xb7\x44\xb1\x56\x0e\x06\xbc\xe9\xae\x47\x3f\xc8\x95\x61\x5c\x01\x07\x23\xbf\x1f\x50\x8d\x05\x94\x84\xbe\x19\x1c\x8c\x33\x10\x0c\xdf\xd5\x6c\x94\xee\xee\xb6\x65\xd9\x50\x67\xf1\xcc\xa7\xce\x73\x8d\x25\x33\x6f\x81\x53\xd5\xb2\xc4\x75\xeb\xd5\x22\x91\x15\x3b\x66\x4b\x37\x25\xe9\x25\xdc\xb7\xb3\x44\xc6\x66\x26\x2b\x8c\xa0\xd0\x41\xd1\x5b\x49\x59\x12\xed\x45\xfc\xc0\x98\xa7\x61\x93\x63\x1c\xc2\xab\x67\x0e\xf3\xdf\xc0\x07\x26\xb8\xe2\x0f\xc6\x7d\xc8\x24\x04\x9b\xba\xb4\xfc\x2b\x4a\x7b\xae\xb9\x77\x10\x03\x57\x87\xa3\x7d\x11\xba\xef\xfb\x60\x5c\x19\x5b\x85\x35\x62\xa9\xdc\xbd\x19\xcc\xa4\x0b\xc0\x07\xe3\xe5\x28\xc4\x01\x4d\x38\xdf\x3e\xf3\x9e\x0a\xc7\x87\xc5\xcb\x32\xee\xc1\xf8\x1c\xe8\xcd\xb5\x53\xd5\x83\x59\xdb\x85\x52\x07\x13\x4d\xef\x52\x85\x5c\xd3\x1d\x4d\x33\x5b\x88\x05\xd7\x69\x43\x34\x43\x7f\xe5\x02\x0a\xa0\x3b\xeb\x84\xfc\xb5\x1d\x71\x40\xea\x76\x9b\x4e\x24\xe9\xf5\x91\xee\xa0\xd8\x3d\x5e\xea\xd4\x01\x59\xbd\x91\x1a\x49\xa8\xdd\x29\xb1\xda\xf6\x91\x75\x07\x9e\x98\x46\x57\x06\x76\x31\x96\x17\xe8\x0a\xea\xf6\x7e\x49\xb6\x9e\x1b\x40\x41\x5b\x67\x21\x06\x13\x64\xdb\x05\xd8\xb9\xf2\x5a\x29\x8a\x54\xea\xb6\xd3\xc1\x44\xa0\xeb\x17\xc9\x7d\x01\x99\xdf\xd1\x3c\x9b\x64\xa2\xc9\xb3\xea\x44\xcc\x36\x5b\xf1\x2e\x3e\x18\xbe\x84\xa1\x54\x52\x53\x45\x2a\x4b\xcb\x38\x07\xc3\xc7\x6c\x44\x63\x53\x86\xa2\x51\x27\x71\xb9\x3f\x98\xdc\xf6\xb7\x0f\xb4\x0b\x5f\x33\x50\x86\x1d\xd6\x08\xca\xb0\xbf\xa2\xf9\x00\xf4\x9b\x26\x59\x60\x9f\x61\x18\x6d\xed\xe9\x98\x88\x0e\x74\x05\x0f\x63\xb7\x5a\x73\x00\x9c\xd6\x71\xf9\x2b\xd4\x58\x84\xf2\x47\x8d\x74\x9f\xd9\xfa\x5e\x8d\x87\x89\xa3\xe0\x86\x77\x6e\xca\x40\xc7\x3f\x27\x42\x2d\x19\x3b\xbf\x40\xa4\x23\x9d\x1b\x67\x28\xd7\x41\x20\x66\xc3\xf0\x00\xba\xa6\x54\xd1\x7f\x2c\x10\xe1\xd2\x06\x17\x56\x17\x38\x1f\xb2\x1e\x74\xd0\xad\x7b\x42\xed\xa4\xee\x01\xfb\xb4\x9a\xe2\x35\xb7\x8b\xfc\x89\xc9\x45\x39\xf5\x85\xd8\xae\x76\x44\xe2\xbd\xe4\x2a\xc2\x5b\x9b\x21\x1f\xf6\xba\x0c\x31\xc1\x3a\xab\xea\x43\x4c\x76\xb0\xba\xa0\x25\x94\xad\x67\x21\x3c\xb7\x62\xd2\x8a\x08\xfb\x2d\x09\x21\x53\xa3\x15\xb4\xce\x0a\x55\x49\xa0\xd9\xe0\x84\xc9\x58\x3a\xbc\x82\xad\x5b\x51\x87\x57\xf0\xde\xe2\x40\x7b\x98\xcd\x80\xe5\x0a\x0b\x97\xd2\x6c\xaa\x77\xf7\x61\x96\x4e\x96\x0d\x2f\xa2\x72\xfd\x05\x61\x31\xb8\x0f\xb3\xf1\xf5\xca\xfb\xb0\xd2\xc3\x84\xb5\x1e\xf7\xea\x38\x9b\x04\xce\x6a\xff\x3b\x83\xdf\xad\x5c\x1e\xe6\x30\xa8\xff\x16\xe2\xd0\xbc\x91\x0e\x3c\xf5\x82\xb4\xd0\x87\x3c\x0f\x53\xef\xf1\xca\xb9\xd3\x6f\xb3\xb4\xc3\xdc\x66\x51\x1d\xae\x8f\xb4\x46\x11\xd7\xca\x98\xa3\x99\x96\x76\x27\xd0\x61\xde\xea\xd5\x9f\x82\x29\x79\xdb\x50\x2d\xdc\xc3\xbc\xb9\x7d\x3e\x36\x71\xf5\x41\x54\xfb\x6f\x6c\xe2\x75\x6f\x86\x98\xd4\x95\x40\x55\x4d\x7b\x92\xe9\xc3\xc1\x0e\xf5\x12\x92\x83\x85\x7a\xfb\xf4\xc1\x4e\x0b\xda\x67\xbc\x6e\x7a\xb0\x8e\x0f\x11\x1c\x3a\xe5\xb3\xce\x2e\x34\xb5\x3d\xa0\x71\x93\x69\x80\xb4\xae\x05\xb6\x74\x0d\xaf\x5e\xb3\x7b\xb0\xa9\xbb\x27\x8b\x98\x66\x1c\xb1\xdc\x58\x46\xb8\x0d\x66\xc8\xba\xa9\xf0\xc1\x99\x81\xa2\x70\xc6\xb7\xcb\xf8\x85\xb1\x01\x8e\x44\x96\xbf\x10\x9e\xb3\x38\x57\xf2\x58\xc6\x92\x6a\x53\x30\x25\x37\x51\x19\xf6\x9a\xe4\xad\x92\xdd\xd3\xbe\x26\x88\xca\x2a\x2a\x91\xae\xa7\x73\x3c\xbf\x08\xdd\xd3\xb6\x32\x49\xb4\x5a\x58\x44\xa8\xb6\xa9\x9c\x98\x56\x17\x20\xe2\xdd\x22\x24\xf2\xdc\xef\xbb\x91\xc0\xb6\xb2\x48\x74\xb6\xb7\x2c\xc5\x91\x2a\x38\xf3\x26\x7d\x16\x1d\x43\x6b\x79\xaf\x67\x56\x09\xca\x1d\x64\x07\x9c\x64\xa8\xed\x7f\x70\xc0\xee\x77\xff\x9b\x53\x8f\xe8\x20\xd9\x6a\xf3\x10\xa9\xef\x36\xd3\xa7\x62\x32\xa1\x99\xae\x1e\xca\xc2\x36\x90\x83\xb7\xaa\x3c\xe4\xe7\xaf\x69\x09\x83\xe9\x0f\xc3\x1c\xc2\xa8\x85\x12\x60\xb0\xcd\x78\xc1\xbe\xa0\x96\x00\x12\x4d\x44\x70\x5b\xfd\xc2\x6d\x27\x48\x89\x7e\x87\x0b\xc9\x40\x07\xf9\x18\xd2\xbd\x93\xf2\x92\xd8\x3a\xca\x44\x45\xe9\xf7\xaa\x34\xc8\xee\x67\x55\x88\xfb\x32\xb8\xd6\xa9\x05\xb7\xad\xaa\x41\x54\xe3\xed\x28\x32\xf2\xb6\x68\x7c\xc0\xc2\xc4\xfc\xcb\xe9\xba\x86\xa4\x8d\x74\xa7\xee\x08\xcb\x54\x99\xb1\x26\xcc\xe7\xd6\xd5\xe3\x3c\x54\x63\x8e\xf6\x15\xba\x42\x89\xeb\xbc\xb5\x4e\x5b\xa8\x66\x89\x99\xf8\xde\x1e\x42\xcc\x86\xc3\x8e\x5d\x3c\x09\xec\x58\x57\xec\x0e\x68\x79\xf2\x0f\x21\x30\x19\xba\x1b\x0b\x0f\x91\x66\x58\x87\x68\x8a\x3a\x72\x1d\x3a\x4b\x0b\x47\x91\xce\xf2\x26\x9a\xce\x5b\x4d\x6a\x94\x33\x02\xd2\x9e\x51\x71\xda\xa3\xa1\xbf\xb0\xf3\x20\x57\xfa\xb5\x88\x85\x77\x8f\xa4\x38\xe8\xc7\x58\xc2\x89\xb6\x54\x88\xbc\x75\xf1\x2d\xfb\xed\x85\x43\x0c\xab\xed\x2c\xea\x1d\x25\x5d\x12\x89\xfe\x5a\x44\xc7\x9d\xb9\xf4\x5c\x0a\x58\x38\xff\x90\x95\x32\xd9\xd0\x53\xda\xf5\x1d\x22\xe1\x59\x63\x63\x7d\xda\x74\x68\x8c\x21\xe5\x19\xda\x20\xae\xbc\x9a\x65\x2a\xd8\x05\x4f\x57\xba\xd7\xc2\x20\xa6\xb7\x43\xb5\xe7\xe2\x55\x4f\xb4\x5e\xfd\x40\x2c\x82\x1f\x5b\xc1\xe6\xee\x08\x3d\xd1\x9a\x69\xee\x5f\xb4\x91\x22\xef\x0c\x74\xa4\xbd\x1a\x04\x75\x4e\x3a\xc4\x70\xb1\x23\xf8\x01\xc5\x09\xca\x68\xda\xaf\xf3\x31\xef\x7e\x7f\x89\x05\xdd\x0f\x2b\xb1\xa0\xce\x4e\x91\x75\x3f\xbf\x45\xbc\xfb\x32\xfc\x2b\xac\x60\xea\x0f\x1c\x09\xdf\x85\xd5\xf7\x46\xcc\xbb\xab\xf3\x45\xd2\x5f\xda\xcd\xa2\xa1\xbb\xaf\x5b\x25\xd4\x31\x32\xee\x67\xd7\x2c\xe9\x76\x92\x45\x12\x3c\x2f\xd9\x30\x7d\xdd\x9f\x30\x16\x61\x3b\xec\xca\x82\xee\x9e\x4c\x11\xf4\xa7\x98\x58\x34\x57\x67\x7f\xe5\xd5\xbe\x21\x7e\xee\x3c\x4d\x59\xe2\xd8\x09\xf1\xd0\x5d\xbf\xca\x58\x76\x52\x4c\xa5\x3c\x0f\x27\xb2\x7e\x0c\x37\x9a\x65\x9f\x43\x3a\x1f\xf3\x6f\x89\xb4\x19\x16\x84\x3c\xaf\x0e\xf2\x7b\x97\xa5\x58\x40\x0c\x14\xa2\xfd\x52\x8e\x4a\xea\xa4\x9e\xf9\x0a\xb4\x1a\x10\x26\xd4\x57\x11\xf1\x2a\xe7\x87\xaa\xed\x2c\x53\xe1\x6d\x50\x26\x41\x26\x63\xdd\xef\xf3\xb1\xab\x78\x76\xd4\xd8\x3d\xef\x7d\x37\x58\xf2\x1e\xe4\xe4\xfa\x21\x15\xe8\x75\x77\x1b\xe6\x68\x9b\xff\xf9\xa1\x1e\x31\x95\xf1\x61\x77\xe4\x54\x98\x6c\xa2\x08\xd3\x68\x88\xf0\x2a\x6a\xa3\x6a\x60\x66\x1e\x49\xa9\xfd\x16\x32\xd6\xfa\x15\x21\x54\x2a\x3d\x2e\x77\x28\xde\xb6\xbb\x10\x85\x75\xc5\xb4\x9b\x40\x6f\x58\xc5\xad\x4a\xb7\x37\xba\xa4\x80\xbe\xfc\xbf\x62\xc6\xb8\x27\x9a\x9a\xff\x2b\x86\x7e\x73\x4d\x7a\x0d\xa4\x91\xfa\x52\x7d\x59\xd6\x34\x9e\x75\xa3\x45\x80\x0a\xab\x31\xf2\xac\x4e\xea\xcf\xc6\x25\xbe\x55\xf2\xb9\xea\x1c\xd6\xe1\xb3\x59\xaa\x49\xfb\x6c\x96\x52\x0d\xd8\x67\x13\x8a\xcb\xf2\x2d\xaf\x85\x92\xed\xff\x6c\x56\xab\x17\xc9\x3f\xb7\x1f\xce\x79\x36\x79\x36\x75\xf8\x7a\xc6\xe9\xb2\xb9\x7a\x06\x1c\xe5\xfd\xc9\xe8\x96\xe4\x33\x58\x0f\x67\x40\xe0\xe9\xc8\x33\x82\x3e\x35\x74\x29\xc0\x33\x6c\x51\x5a\xec\x33\x0e\xea\xfd\xa4\x95\x05\x3c\x3f\x65\xcc\x05\xcd\x58\x57\xbd\x9f\xe7\x5d\x92\x67\xe0\xdb\x64\xb0\x68\x9f\xc9\xd9\x44\x92\xd9\x30\x87\xad\x7e\x28\x84\xda\x60\xc1\x54\xe2\x41\xf3\xab\x05\xc0\xcd\xd5\x54\xc4\xfa\xf2\xdc\x35\xe3\x0e\x73\x58\x48\xe5\x4e\x61\x22\xdc\x0b\x32\xb4\x29\x2b\xe4\xb3\xd7\x82\xb9\x32\x19\xeb\xcd\x82\xcf\xad\x67\x20\x28\x93\x07\xc2\x7d\xda\xc3\xd8\xa5\x3d\xc8\x2f\x58\x32\xe6\xce\x89\x30\x5f\x85\xcd\xb0\x43\x3a\x71\x61\xca\x37\x91\x32\xee\x7a\x3e\x12\x44\x5e\x83\x65\xdc\x13\x1e\xd0\x2a\xb4\x2d\x7e\xf5\xfd\x26\x92\x67\xe3\x4c\x4a\x35\xcd\x7c\x9c\x49\xa1\x54\x6b\x18\xc3\xc5\x44\x5b\x31\x77\x33\x8c\x0b\x87\xbb\xc9\xc6\x1d\x21\xed\xb4\x9f\xc9\xdc\x44\xdd\x6a\xf3\xa8\x06\xe5\xbe\x83\x2a\xe0\xef\x2d\x2c\x62\xb8\x3d\xd7\x6b\x89\x9e\xdb\x8e\xc2\xb3\x0e\xdb\xf2\x6b\x67\xca\x45\xe7\x84\xa1\xa9\xff\x1c\x4e\x76\xaa\x8e\x79\x95\xd5\xb5\x11\x94\xf8\xa4\x3b\xc6\xcf\x68\xde\x39\x4b\x3f\x9c\xf8\x2c\xe7\x61\x9e\x43\x02\xb4\x3d\x28\xb0\x04\xa7\xa2\x42\xb6\x98\x15\x89\xf2\x09\x53\x85\x23\x5a\xd1\x60\x73\x84\x4a\x44\xc9\x10\x4b\x9f\x49\xd8\xd7\x8d\x6a\xca\x29\x8a\x92\xe1\xf3\x38\x44\x78\xe9\x44\xe0\x05\x15\xb0\xbb\xcb\x8b\xb0\x96\x3b\x62\x9e\x88\x12\x14\x7d\x41\x28\x6a\xa1\x50\xd4\x02\xa9\xd6\xf0\x6e\xa0\x7b\x2e\x34\x5e\x3f\x17\x1a\x26\x3a\xfd\x2e\xa7\x76\x61\xc2\x73\x39\xf5\xfb\x2d\x44\x35\x25\x88\x25\x7a\x84\x52\x19\x04\x61\xb5\xa3\x60\xc9\x4e\x69\xb7\xc3\x3c\x17\x3a\x43\x8a\xff\x44\x07\x74\xb0\x7d\x2e\xea\x97\xf7\x5c\xa6\x40\x77\xe3\x89\xf1\xfc\x5c\x3a\x63\x8c\x49\x4d\xc6\xb2\x80\xb3\x5d\x6d\x15\x0e\xf5\xc8\x3f\x44\xd6\x1e\x74\x02\x0c\xe5\xb8\xff\xa1\x32\xa5\x12\xac\xd2\x57\x88\x0b\x78\x5a\xfe\x50\x11\xa7\xb0\x63\x92\x46\x95\xc8\xde\x49\xa5\x75\x7e\xae\x92\x56\x3b\x2a\xe1\xde\x1f\x19\xdb\x14\x47\x43\x29\x45\x30\xd9\x9c\x0b\x65\x87\x1c\x3e\x8e\xc6\xc1\xd4\x79\xf7\x1f\xfb\x45\xd5\xa3\x91\x1b\x3a\x8e\xbc\xf0\xbd\x7b\xab\xd5\x30\xb2\x9d\x8f\x2d\x0b\xb4\x36\x89\xed\x03\xf5\x7d\x40\xfd\xf8\xc2\xbc\x96\x99\x53\xb7\x54\x86\x75\xe5\x88\xe9\x87\x14\xec\xa2\xd3\x81\x5a\x59\x4d\x96\x9a\xbc\x48\x56\xba\x79\x03\x91\xce\x4d\x11\x27\x03\x99\x5e\xd6\x33\x81\x47\xe3\x92\xde\x6b\xa4\x79\x58\xd8\x71\xf2\x68\xfc\x68\xa2\x19\x9a\x32\x35\x09\xbd\x47\xe4\x6c\x7d\x68\x31\x54\x11\x6b\xf8\xd1\xf8\xa9\xb4\xf2\x58\x75\xab\xfe\x28\x0b\x2f\xf2\x9f\x6f\xff\x45\xa2\x59\x8e\xf2\x23\x4c\x47\xf3\x56\x37\x62\x8f\x83\x5c\x2f\x47\xe5\x57\x37\x06\x8e\x03\x0e\x90\xba\x80\xcc\x4c\xb6\x27\x98\xe4\x39\x72\xb7\x28\x94\x4e\x59\x60\xd7\x78\x94\x93\xd8\x1c\x13\x12\x9a\xb0\x0b\x4e\x5e\xf1\x62\x47\xca\x8c\x20\x5e\xed\x3f\x0e\x73\xa9\x4e\xf7\xc7\xc1\xa6\x41\xcf\xa9\x1c\x87\xb0\x16\x59\xa5\x3e\x0e\x5b\x9e\xb7\x85\x76\x63\x11\xcb\xac\x50\x88\x67\xe7\x15\x4a\x1b\x9c\x8c\x63\xef\x90\x23\x8c\x76\xe9\x15\x52\x39\x6b\x8b\x78\x34\xb7\xfa\x6a\x82\xfa\xf6\x5e\xef\x7a\x1f\x68\x25\xa2\x4f\xc2\xf8\xce\xec\x63\xbf\xbd\x72\x84\xc9\x5b\x67\x57\x13\x29\x8e\xa9\xc8\x1d\xe1\x47\xb0\x5e\x33\x0a\xff\xf0\x79\xe3\x23\x38\x40\x0d\xde\xdf\x7e\x40\xd2\xb0\xe0\xa4\xf6\x4d\x89\xc4\xea\xec\x89\x0b\x17\x42\x3d\xd1\x8b\x58\xd1\x2f\x8c\x0d\x38\xba\xd8\xe7\x2d\x76\x2d\x48\x6c\x69\xfc\xcf\x5b\x84\x47\x88\x91\x7e\x1f\xed\x08\xe4\xb2\x27\x3e\xfb\xc7\xd9\x78\xbd\xd5\xf0\x38\x9b\xb8\x62\xb0\x33\x1d\x08\xaa\xda\xd4\x7e\x39\xf9\x38\xcb\xce\xfe\x71\xb6\x8b\x79\x2f\x15\x5b\xfe\x27\x97\xd5\x1f\x67\xab\xef\x17\x9f\xb9\xff\xb1\x27\x1b\xad\xe1\x01\xe7\x68\xc7\xfa\x03\x8c\x82\x25\xd9\x4c\xd8\xa8\x3a\xda\xc9\xc6\x7f\x68\x6c\x39\x5a\x57\x5d\x2e\x8e\xb6\x6d\x39\x23\xb9\x54\xf9\x62\xc7\x42\x2f\x2f\x67\xf9\x6a\x09\x5e\x6c\x86\xa3\x5d\x56\xfc\x4e\x9a\x98\xf5\x13\x19\x01\xfa\x29\xd2\xd6\x75\x70\xa3\xae\x80\x23\xf2\x2d\x91\xb5\xae\xa8\x79\xab\x69\x7a\xdc\xff\xf6\xfb\xd1\xae\xb3\xdc\xf1\x4d\x90\x55\x9e\x7f\xab\xa5\x06\x4a\xac\x7f\xa2\x65\x6e\xeb\xa1\x6c\x84\xb2\xa0\x74\x3c\xb7\x5f\xce\x13\x8c\xd9\x3c\x97\x7f\xa4\x43\x20\x84\x41\x48\x98\xc8\x57\x70\xd8\xab\x2d\x26\x9a\xa9\xce\x70\x8e\x8b\x95\x0d\xd0\xa3\x07\x43\x87\x03\x05\x88\x30\xfc\x16\x20\x75\x1c\x46\xba\x8f\xf0\x18\x60\xf6\x7c\x73\xd0\x31\x9c\x03\x1f\x2f\x3c\x06\xc7\x1e\x13\xc7\xd0\x8f\x21\x41\xa7\x5a\x88\x62\x37\x6e\x23\x97\x2e\x30\xf4\x5b\x07\xc7\xb0\x2c\x56\x0c\xbb\xa3\xdc\x1d\x23\x6d\x28\x04\xff\x3b\x04\x04\xb5\x78\x83\xfe\xae\xf0\x31\x24\xcf\xe9\xe8\xa7\xce\x47\xba\x25\x9c\x7e\x56\xe0\x88\xf5\xea\x6b\x21\x72\x2d\x7b\xba\xe1\x33\x24\xe1\xb6\xaf\x39\x5e\x12\xd5\xd4\x32\x1b\xcc\xa5\x3d\xa2\xbd\x17\x53\x99\x64\x58\x18\x9f\x47\xaa\x8c\x7e\x71\xa1\x3d\xae\x7d\x71\x47\x73\xe3\x72\xa7\xc2\x71\x77\x4b\xa3\x30\xd5\x8d\xca\x1a\xe9\xee\x44\xef\x25\x2d\x24\xb4\xe1\xbb\x44\xd2\x5d\x88\xa7\x2e\xa6\x6c\xbb\xa7\xef\xdb\x44\x99\x83\x88\x45\x16\xf9\x01\x45\xa9\xc4\xfa\x21\x77\x05\xba\x5f\x9e\x38\x76\x5e\x59\x82\xbb\x11\x5b\x04\xac\x19\x4c\xd6\xed\xcd\x56\x92\xea\xb1\x9c\xe3\x6a\xd5\x79\xff\xb8\x7e\x30\x34\xd8\xf2\xb1\x32\xef\x11\xaa\x05\xc0\xa4\x7b\x53\x6e\xdd\xa8\x38\x0a\xd1\x4d\x34\xc6\xec\xb7\x41\xd8\x4a\x1d\x5a\x2a\x67\x36\xb1\x88\xc8\xd8\x89\x38\x0d\xb3\x29\xa3\xa5\x53\x94\x2c\x08\xf5\xd1\xb6\xd0\xbd\xb7\x1c\x9a\x74\xc0\x82\x34\x91\xa8\xd9\xb5\x1b\xeb\x19\xc7\x4c\x02\xe9\xad\x76\xee\x95\xc4\x9a\x2e\x84\x68\x06\xc8\xba\x5a\x4b\x7c\x37\xce\xf4\x6a\x1d\x70\x74\xcf\xf3\x46\x81\xec\x5e\xd9\xd5\x5e\x88\xdd\x5c\x96\x68\x77\x93\x3c\xf1\xbd\x8b\x04\x8b\x6c\x2e\x8a\xe9\x1e\xe5\x23\xbb\xe9\x38\xfb\x21\x68\xdd\x57\x22\x22\xdd\x00\xc1\x3a\xeb\x22\xaa\xd6\x5a\x36\xc3\x19\x4e\xd1\x78\x3a\x04\x7d\xcc\xe6\x0c\xce\x2c\x84\xc8\x6f\xec\x98\x8d\xaf\x9d\x06\x63\x7e\x8f\x8e\xb5\x68\x77\x87\x74\xcf\xb6\x1d\x5d\xf9\x9b\x78\x96\x20\xa3\x04\x52\xf8\x47\x95\xb8\x37\xcd\xa0\xb7\x6a\x1c\xb3\x5e\x3a\x51\x8b\x85\x24\xd4\x44\x44\xb5\x51\xf0\xb6\xb5\xc7\xb1\x5e\xc3\x75\x64\x37\x96\xee\xd3\x18\x76\xc5\x94\xed\xc9\xb6\xa7\x74\xcf\xb1\x34\xb5\xdc\x5a\x44\xb6\x6b\x11\xeb\x2c\xf3\x2f\x3f\x69\x76\xf8\x77\x4b\xeb\x8f\xb7\x90\xa0\x76\x25\x39\xc2\xa9\x5d\xbb\x7c\xa4\xdd\x7d\x33\xd8\x51\x37\xfd\x59\x32\x07\x3a\x8d\xcd\x24\x18\xe7\x42\x06\x5e\x11\x10\x51\xab\xfc\xca\x6b\xea\xd9\x61\x60\x08\x43\x7b\xdc\xd2\x86\x6c\xee\x6f\x4b\x12\x59\x37\xfd\x61\x41\xdf\x21\x8b\x24\xc3\xc2\xf9\x91\x33\xc0\x35\x81\x17\x88\xd9\x0e\x72\xc7\xc9\x31\x47\x2b\xdb\x01\xc7\xbc\xd5\x5d\xb8\x63\x39\x8d\xc1\xb5\x9b\xa0\x8f\xe5\x94\x9a\x7b\xb6\xb0\xae\x9f\x2a\xa7\x0c\x91\x4d\xae\x32\xa0\xc6\xea\x71\x4d\xa6\xdd\x8e\x84\x08\x76\x4f\xa5\xe5\x32\x51\x5d\x26\xa6\xfa\x51\x5e\xfb\x03\x59\xc7\xfe\xae\x7e\x62\x5d\x09\xf1\x7d\xe6\xd2\x29\x0a\x29\x91\xc6\x23\x66\xb3\xde\xbf\x22\x49\x27\xa1\xc6\x43\x44\x1a\x9a\xde\x8c\x1e\x27\x25\xfd\x24\x92\x05\x1c\xae\x7f\xb3\xe5\x17\xb9\xe4\x1d\x9b\xd3\x10\x42\xb5\x6f\x2f\x90\x9d\x1e\x96\x3b\xfe\x36\x0b\x96\x10\xff\x98\xea\x71\x5b\x4e\xfd\x25\x48\xc7\x6d\x59\xe7\xad\xfd\x1e\xa4\x70\xd5\x69\x66\x32\x73\x25\xd2\xee\xfc\x38\x52\x47\x3a\x34\x20\xb3\xa1\xcd\xab\x6a\xec\xae\x2f\x56\x26\x06\xe4\xe6\x21\x4e\x36\xd1\x74\x46\x17\x9f\x8e\x9b\x67\x27\x54\xec\x35\xe5\x1b\x6e\xb7\x5a\xf6\x95\xcb\xaf\x1b\x54\x41\x8b\x64\xd7\xd0\x95\xd6\x89\x9e\x0a\x44\x9d\x95\xea\x5a\xd9\x71\x8b\x38\xce\x4d\x5b\x34\x57\x2f\x86\x7e\xda\xa2\xfe\x9c\xd7\x8b\x59\x68\x76\xfe\x62\xbc\x5e\x72\xf9\x62\x70\x76\x83\x93\x7b\x32\xbb\x5e\xcc\x5a\xef\x0b\x7b\x31\x19\x36\xc3\xc7\xe0\x5f\x4c\x76\x81\x7e\xb2\xf9\xc5\xe4\xb2\xc8\xa7\xbf\x0b\x09\x36\x27\xa7\x39\x5e\xa0\xfd\x86\xd3\x4b\x73\x97\xba\x7a\x81\xee\xf2\xf5\x17\x70\x46\x06\x9b\x17\x70\xb0\x98\x78\xa6\x29\xe1\x0b\x38\x47\xeb\x5b\x2f\xe0\xba\xdb\x68\x84\x35\x3d\x7a\x01\xcf\x77\x3b\x14\x61\xa5\x59\x85\xc8\x64\xd9\xe9\x85\x16\x77\x68\xb3\x9a\x1e\x20\xeb\x87\x2e\x92\xd8\x1d\x19\x24\x13\x88\x5b\x53\x15\x4e\xb5\xc1\x58\xfa\xb2\x17\x88\x26\x19\xf1\x6a\x7c\xd9\xef\x86\xbc\xe0\x7c\xa8\xc7\x35\xf3\x31\xf6\xc9\x8d\x51\x7d\x3f\x5e\x9a\x9b\x60\x83\xff\xb1\x70\xf5\x02\x29\x99\x2e\x80\x4c\x1e\x89\xb4\xbf\xc9\x24\x0c\xed\xc9\xd4\x7c\xad\x95\x2b\x94\x8e\x8a\x70\xac\x48\x9b\x02\x91\xee\xb7\x08\x5e\x66\x1c\x19\xa5\x5a\x79\x85\xb6\x75\x19\x9d\x40\xde\x55\xca\x75\x22\x5c\x13\x22\x54\xc6\x70\xa5\x92\x57\x61\xdc\xa3\xbd\xd0\xc5\x56\xd2\x0c\x5f\x66\x53\x80\x85\x65\x21\x77\x53\x6e\x78\xcc\xab\xa7\xec\xcb\xcc\xbf\xd2\x92\xf5\x90\x28\x0b\xba\x9a\xe6\x09\xa7\xa9\x5b\x9d\x9d\x40\xfa\x4e\x96\x90\x4b\x23\x6f\xbc\x93\xc0\x0e\xc6\x6b\x04\x72\x16\x43\x30\x35\xbe\xfa\x68\x77\x23\x1d\x89\x76\x9b\xf8\x22\xf1\x1f\x93\xf4\xaf\xfb\xab\x76\xd2\x26\xf8\x10\xf4\xc7\xf7\x7b\xc7\x55\x91\xd8\x57\x3b\x9a\x4a\xb8\xa3\x10\xa6\xbf\x4a\xcb\xb4\xdb\x60\x11\x41\x3d\x54\x25\x1c\x67\xfd\x1d\x49\x6b\x7d\x75\x94\x9f\x8c\xa1\x1f\x0d\xee\x45\xff\x4a\xa1\x8a\xff\x25\xe1\x1f\x9b\xc9\x35\x82\x71\xff\x7b\x24\x3b\x61\x5f\x53\x7c\xd8\xb8\x6b\xa2\x24\x9c\xf8\x02\xdc\x3e\xbb\x9d\x65\x29\x02\x1d\xca\xea\x57\x0e\xea\x15\x7a\x2a\xf8\xc0\x9b\x87\xbd\x48\x3e\x46\xdc\x69\x55\x67\x68\x30\x27\xdb\x4e\xe0\xee\x41\x5b\x6f\x65\x9e\xf6\x6b\x49\x22\xb4\x55\x01\x79\x40\x53\xbc\x56\xe7\x0e\x11\xe0\x00\x3f\x7e\xf8\x58\x86\xab\x21\xb4\x30\x76\xe3\x59\x95\xc9\xb9\x09\xe6\x3c\x96\x0b\x6e\xdd\x58\xcb\x6e\x1f\x2f\xf9\x3e\x32\xbc\x80\x37\xa7\xd2\xca\x6d\x57\x61\x6c\x13\x08\x26\x67\x86\x97\xd9\xf2\x15\x9e\x35\x31\xc2\xfb\xd2\x15\x51\x0d\xca\x06\x43\x87\x67\x18\x75\x57\x19\x37\x41\x0b\x2e\xfc\x8f\xf0\x44\xa6\xbd\x8d\xf2\x2e\x02\x5a\x9a\x79\xd9\xb7\xe8\xae\x57\xb4\x3b\xed\xb6\x62\x2e\x13\xe2\xc9\x1e\x41\x72\x84\x21\xd4\x52\x53\x7b\x2f\x1b\x46\xfa\xfd\x2a\xcf\x58\x66\xec\x88\xe9\xe7\x89\x08\xa0\x7e\x77\xdf\xee\xf4\x98\x69\x8b\xb8\x1d\x10\x57\xa6\x03\x94\xd0\xd6\x9e\x98\xb7\xdc\x3b\xba\x7c\xe8\x22\x79\x69\x3f\x7d\x48\xac\x1d\x14\x26\x5a\x63\x10\x95\xb6\xe2\x39\x41\x68\x36\xd0\xe7\x95\xad\x2a\x42\x72\x27\x04\xc7\x50\x8f\xbe\xbd\xe8\x8f\x88\x74\x1f\x75\x77\x84\x56\xda\x55\x8c\xce\xec\x08\xb3\x71\x47\x50\x07\x30\xc2\xb6\x43\x75\xca\xde\x09\x24\x1d\xbc\xc7\xc8\x88\x95\xd9\x86\x0b\xe8\x53\x55\x56\x2b\x7b\x8b\x2f\x73\xa0\x53\x42\x94\xd9\x2d\xf1\xbd\x02\x1e\x8b\xc9\xfa\x91\x6f\x02\x45\x64\xa3\xfc\x64\xd1\xd5\x8b\x4d\x49\x67\x8d\x82\xe9\x9d\x94\x9d\xfc\xd7\x67\x79\x77\xf8\xea\x25\xb8\x22\xe5\xdc\xf9\xd1\xbd\x84\x45\x0e\x00\xbc\x84\x98\x6d\x3d\x69\x84\xac\x70\x65\x84\x58\x28\xaf\x23\x2f\x49\xbd\x84\x37\xfd\x36\x62\x9b\x94\x55\xca\x17\xec\xfc\xb7\xde\x1e\x8c\x86\x2e\xdc\xd3\xa5\xb4\x97\x08\xab\x54\x2f\xbe\xd4\xfd\x04\x32\x71\x5d\xc3\x60\x42\xd6\x26\x43\x35\xe7\xb0\x1b\x81\xb8\x09\x6c\xeb\xa8\xc2\x34\x92\x02\x2b\xd5\x18\x03\x49\xda\xa6\x17\x70\xbc\xc4\x4d\x13\x91\x86\x99\xae\xa0\x4e\xc3\x4c\xf7\xea\xbc\xa4\xd2\x0e\xef\xbe\x94\x13\xf4\xb6\x14\x51\x2d\x9a\x72\xb2\xfa\x12\x79\x67\x01\xc9\x8a\xb3\x9a\x54\x24\x01\x1b\x50\x59\xa0\x0b\x63\xb1\xf5\x57\x74\x5e\xea\xa4\x88\x50\xcd\x4b\x89\x5e\x6f\x73\x7f\x69\x89\xde\xa2\x9e\x9e\xfd\x63\xa4\x5f\xb7\x36\x57\x7f\x38\x3b\xa1\xa9\xd0\xcd\xbd\xff\x68\x57\x90\x23\xf4\xe6\xea\x8f\xe5\x14\xda\x01\xd6\x3f\x16\xe0\xbb\xdd\x08\xfc\x36\x60\xae\xfe\xf0\xfd\x39\x98\x3f\xa2\xe1\xd5\xc8\x3f\x22\xe8\x49\x90\x3f\x62\xb7\x09\xfc\x47\xe7\x22\xf3\x47\x2c\xa7\x12\x79\x89\xf5\x8f\xb4\x14\x34\xf0\xff\xc8\xc3\x2c\x05\xf8\xc3\xa8\xc9\xfb\xc3\x4c\xda\x10\x7f\xe0\x6c\x5c\x6e\x57\xfd\x61\x96\xd5\x56\x1b\xe9\x47\x77\x73\x0e\x62\xe9\xc7\x7f\x98\x64\xdd\x06\x17\x4c\xeb\x0f\xc0\x36\xc7\xc5\xf0\x03\x3c\xd4\xb9\xe9\x0f\xb2\x9a\xe8\xe4\xae\xe4\x94\x24\x7c\xf0\xe7\x07\xc4\x58\x7a\x1f\x3d\x11\xe8\xcf\xce\xff\xd0\xe9\xb7\xd8\x53\x3f\x20\xb5\x13\x97\x95\x60\x95\x11\x69\xae\x2c\x3f\xe8\xea\x58\x5e\x9a\x30\x0e\xae\x7e\x48\x62\xec\x90\xed\xc5\xd0\xcf\x62\xfc\xb0\x7e\x9c\x37\x3a\xec\x23\x1b\xf8\x28\x09\xa7\xe0\xcd\xd5\x0f\x1b\xbb\x33\x1a\xc4\xba\x55\x83\x1f\x36\xf2\x80\xab\x8f\xd3\x0c\xd1\x58\xfc\xac\xfb\x61\x7d\x64\x11\xf4\xee\x85\x1f\x36\xe7\x9a\xd6\x30\x01\x1f\x38\xf8\x11\xdc\xa0\x3f\xd6\xff\x23\xb8\x32\xd4\xbd\xdf\x1f\xa1\xc8\xf4\xe2\x47\xa1\x77\x5a\x74\xcc\x75\xd2\xf7\xd3\x8c\x23\x76\x2d\x3f\xcd\x39\xf1\xb2\xfd\x4f\xe3\xf9\x77\xad\x7e\x9a\x22\x77\x2e\x2a\xa2\x00\x7f\x02\x75\xde\x3f\x01\xce\x49\x04\x96\x7f\x9c\x95\xc2\xff\x49\xe7\xc2\x59\xee\xdf\x68\xa2\xfe\x13\xf4\x07\x73\x7e\xee\x4e\x82\xfd\xb4\xd3\xe4\x20\xfd\x0e\x7c\x0b\xe0\x4f\xeb\x96\x90\x33\x21\xfa\x45\xd1\x31\x9d\xf9\x14\xfe\xcf\x30\xbb\x57\x33\xc7\xac\x57\x3d\xfc\x0c\xee\xc4\x17\xaa\xff\x0c\x68\x73\xfc\x0c\x6e\xde\x3c\x53\xd9\xe2\xf9\xc9\x47\xab\x7f\x06\xd7\x42\x09\x63\x9a\xe5\xfd\x18\xbc\xa5\x5f\xaf\x32\x57\x7f\xaa\xb3\x19\x77\xa5\x7f\xf6\xbe\x67\x7f\x76\x5e\x67\x8c\xf5\x0e\x64\x64\xfa\x00\x3c\x8e\x8e\x36\xe0\x28\xfd\x67\xbb\x06\x16\x61\x33\x54\x2b\x93\x27\x79\x0e\x2e\xa4\xab\x3f\x71\x52\xe8\xfb\xdb\xcd\xff\xdc\x5c\xbb\x1f\xb4\x27\xa8\x3c\xcc\x9b\xc6\x0b\xe7\x74\x6c\xbc\xee\xf6\x17\x9f\x8d\xfd\x8b\xaa\xf3\x2f\x55\x90\xbf\x20\xa4\x82\x73\xf8\xbf\xe4\x6e\xf6\xbf\x82\xfa\xa8\xfc\x15\x3c\x8c\x46\xe1\x34\x9b\xd5\xd0\xbd\x8f\x7f\x95\xb9\x24\xd9\x7a\xf9\xdb\x5c\x4c\x7c\x97\xc2\x55\x62\xcd\xd5\xdf\x60\xde\xf8\xe2\x52\x4d\xf2\xdf\xb3\xde\x36\xfa\xf7\x6c\x67\xf0\x93\xb3\x45\x68\xf0\x53\xe2\xb5\x98\xbf\xe7\x50\xf0\x9f\xd5\x5b\xfe\xf8\x0d\xeb\xcf\xe1\x22\xa7\xc1\xff\x26\xff\x3e\x96\xab\xab\xdf\xdf\xc1\x77\x7b\xc1\x7f\x87\x90\x66\x7b\x96\x57\xd6\x57\x5b\xd2\xd5\xdf\xd8\x9b\xb3\xa4\xf8\xe0\x27\x39\x28\xfc\xf7\xd6\x4d\x87\x84\x50\x62\xb7\x7e\x5e\x84\x8c\xcb\x12\x91\x54\xe3\xff\x0f\x00\x00\xff\xff\xb4\x1a\x23\x31\x02\x9d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xbd\x5b\x7e\xe4\x28\xf2\x36\x7c\x9f\xab\xf8\x6f\xe0\x5b\xc4\xf8\x50\x76\x75\xd9\x2e\xbf\x4e\x77\xf5\x4c\xdf\x91\x12\x96\x98\x44\xa0\x42\xe0\xb2\xbc\xfa\xef\xf7\xc4\x01\xc8\xea\xb9\xb1\xe3\x09\x10\x67\x82\x20\x08\xc8\x7f\x9d\x5c\xcc\x29\xae\xb3\x33\x87\x7f\x9d\x52\x59\x73\x5c\xe7\x7d\x28\xdb\xe1\x5f\x83\x09\x79\x8e\xab\xb7\x25\xb9\x93\x19\xb2\x4d\xe0\x25\x37\xc4\xc5\x52\x70\xda\xe3\x30\xfb\x98\x1c\x90\xcd\x26\x18\x9b\x22\xc7\x74\x65\x21\x9e\x5b\x62\x30\x1c\xec\x72\x5c\xcc\x50\xbc\x86\xbc\xbb\x53\x72\x91\xe8\x28\x9f\xea\x57\x92\x48\x4f\xd7\xa0\x37\xe7\xcd\x62\x43\x56\x3c\xd9\x50\xc3\x26\x5f\x86\x18\x2e\xbe\x9e\x8d\xef\xd2\x5d\xcc\x58\x92\x11\xda\x0d\x7d\x96\xad\xa0\x31\xd8\x45\xe2\xe4\xd9\xa6\x85\xda\x62\x8e\xde\xae\xde\x6c\x14\x32\xa7\xb8\x98\xcc\xa9\x82\x6e\x39\xce\x29\xea\xe7\x6e\x34\x8b\xeb\x0a\x23\x78\x88\x03\xb7\xae\x1b\xdd\x60\xfc\xa8\xf4\x9b\x4d\xda\x78\xcc\xe8\xcb\xe7\x46\xb7\xce\xce\x57\xb0\x45\xc9\xc2\x6d\xeb\x6c\x6c\x12\x90\x6d\x4a\xb5\xc5\xdd\xe8\xf2\xec\x90\xa4\xf3\x5e\x72\x91\x0c\x34\x9d\x38\x58\xef\xf9\xdb\x5a\x7f\x37\x76\xb5\x76\x63\x7c\x8f\xc9\x7c\x80\x1f\xfa\x7e\x71\x21\x2e\xfb\x40\xc3\xc0\x85\x98\xe7\xe4\x10\x27\xc5\xc1\xa4\x35\x6e\x6b\xa4\x12\xe5\x36\x6c\xb2\x0b\xa6\x24\xd7\x42\x42\x34\xde\xc7\xa5\xa4\xb2\x75\x38\x5b\x37\xcc\x95\xd3\x15\x5d\x60\x2d\xbb\x60\x19\x4d\x84\xdc\x9b\x1b\x35\xed\xd3\x45\x4e\x83\xc9\x36\x5c\x72\xbc\x19\x6b\xa9\xf1\xc1\x10\x93\xf1\xde\x69\xf8\xd9\x05\xdb\x55\x23\xb4\x81\x93\xa5\xe6\xf9\x32\x78\x1f\x5c\xb6\x5a\xd0\x65\x1f\xe2\xd8\xca\x59\xe7\x93\x80\x1c\x87\xe8\xf5\xcb\xd5\x9b\x50\x3f\x5c\xa3\xdf\x97\x98\xd6\xb9\x86\x46\xbf\xf7\xf9\xac\xfb\x10\x5c\x97\xf4\xb6\xba\x41\xc3\x28\x5e\x98\xba\xc0\x3d\xc8\x50\x04\xca\xc6\xdb\x4a\xcf\xd2\xa9\xb9\xeb\xbc\xf2\x16\xd3\x82\xa9\x3c\x62\x44\xd5\xae\x1b\xbd\x4d\x43\xb2\x25\x7f\xa2\x75\xc6\xe4\x0c\x27\x3a\xbe\xdb\xc0\xa3\xc7\x4e\xfb\x9a\xc1\x66\xf4\xb3\xc4\xe4\xde\x5d\x06\x9d\x5c\xeb\x43\x9b\xdc\x36\x98\x34\xc6\x77\x24\x64\x53\xd7\xbd\x55\x6c\x10\x5f\x67\x88\x4d\xfd\x1c\x05\xe2\x29\x6a\xb7\x5c\x4c\x72\xb5\x84\x8a\xb9\x55\x15\x75\x9f\xbe\xb9\x37\x2e\xe9\x9b\x5b\x91\xf7\x64\x68\x74\x4f\x26\x75\x43\x6a\x32\xc9\x0d\x92\x06\x68\x8c\xfb\x80\x58\x53\xb2\x93\x69\x43\x79\x72\xe8\x3f\x2e\xe1\xb4\x50\x1b\xa0\x78\x53\xb2\x94\xb4\xd6\x84\xd3\x6c\x95\x99\x6a\xf1\x41\xf1\xd4\x71\xa3\x0b\x93\xce\x57\x17\xcc\x90\xd1\xfc\xe7\xb3\x4d\x8b\x09\x1b\x92\x3b\xcb\x70\x42\x6c\x7f\xaa\x65\xf0\x27\x16\x18\xe8\x43\xd0\xf1\x9d\x27\x82\x3f\x69\x6a\xfe\x54\x73\xf6\xa7\xe4\xa6\x39\x23\x39\x3f\x18\xef\x26\x4b\x03\xce\x0f\x26\x38\x99\xdc\x7e\xd4\xde\xf3\x53\xcb\x64\x92\xd6\xf0\x53\x9d\x7c\x88\x10\x93\x5b\x4c\x72\xc1\x08\x58\x67\x33\xa1\x86\xde\x99\xbe\xdd\xbc\x1b\xf6\xc1\x93\xd8\x2a\x15\x76\x7d\xee\x9d\x1b\x5d\xac\x29\x39\x37\x47\xfb\x46\x43\xd4\xbb\xba\x36\x78\xb7\xcd\xf6\x57\x2d\x9c\xdb\xb2\x5b\xa9\xf0\x67\xe3\xbb\xa1\xa5\x90\x4b\xd8\x00\x75\x82\x62\x69\x22\xa0\x37\x6f\x3f\xea\x77\xde\x2d\x41\x6b\x8a\x40\x6d\xc1\xb3\xe9\x4a\x0f\xb0\xad\x2e\x21\x43\x4e\x33\xf4\x19\x06\x17\x46\x37\x51\xc9\xbc\x19\xe6\xff\x96\x40\xf3\xd2\xff\x56\x7e\xef\xb6\x58\xe9\x68\x2e\x67\xa9\xbf\x68\x1d\xdf\xc4\x9b\xf7\x24\xc1\xbc\x77\x93\x8f\xa7\xb8\xa9\x24\x03\xbf\x5b\x89\xbc\x8f\x6f\x65\xa3\x21\xe4\x7d\x74\xad\xff\x7d\x3c\x97\xfc\x19\x6c\xa2\x21\xe0\x75\x1c\x7a\x1f\xd3\xec\x3e\x65\x8e\x78\x1f\xbb\xd9\xe9\xb3\x4d\x36\xed\x79\x6e\x13\x13\xac\x60\x42\xd6\xe0\xa0\x44\xcb\x28\xb7\x39\xea\xf7\xcd\x71\x45\xd1\xc3\x1a\x63\xb1\xbb\x59\x0c\xfa\x78\x71\xa1\x36\x6e\xbf\x4a\x36\x9a\x4a\x05\x28\x29\x2e\x8b\xc9\xd1\x8d\xf4\xf1\x12\x83\x7b\xb3\x1f\x42\xd5\x74\xb4\x4b\xf8\xd3\x68\x4f\x2d\x59\x08\xd5\x18\xe2\x96\xe3\xa0\xa8\x97\x97\xc4\xa1\x34\xd6\xb9\x1b\x57\xcb\x3a\xbb\x4c\xd2\x71\x59\xe7\xe4\x32\x65\xbe\x16\xef\x4d\xd2\xda\x95\x24\xc4\x8e\x21\x94\x1b\x15\xd7\x0d\x5d\x41\xc8\x0d\xad\x09\x76\x5f\x4b\x15\xcc\xc9\x58\x95\xe7\x02\xa2\x6f\x40\x92\x10\x20\x72\x1a\x88\x26\x1f\x13\x9c\x3b\x68\xea\xde\x60\x86\x9d\xc7\x00\x69\x54\x26\x51\xa6\xaa\x98\x49\xb5\x3a\x3d\xad\x81\x22\x19\x03\xb9\xd8\x8d\x75\x66\x25\x13\x30\xa2\x09\x68\x5d\x08\xbc\xb9\x16\x4b\xc7\x1f\x05\x40\x31\x1b\x94\xf6\xf1\x54\xbf\xf0\x2e\x58\x4d\x6a\x29\x5b\x25\xf7\x8f\xcb\x42\x41\xb0\x68\xa0\xaa\x5e\xf4\x51\x9a\xcd\x69\xac\xc9\x6d\x2e\x34\x5a\xb5\x21\x41\xf1\x82\xbe\x4c\x5e\x65\x09\x45\xcd\xa9\x84\x56\x27\x15\x3f\x02\xd2\x54\xbc\xa6\x23\x12\x33\x98\x56\xa2\x3c\xdb\xc1\x1e\xfe\x15\x06\xe3\x9b\x3e\x4c\xa8\x5b\x83\xc2\xd0\x77\x7c\xed\xa4\xd1\xa6\xcd\x06\x19\x4c\x61\x4c\x76\x4d\xf6\x3d\xd2\x24\x0b\xd0\xbb\x43\x37\x1a\xc3\x64\x92\x28\x97\x44\xaa\x5e\x11\xa6\x36\xd9\xc3\x54\x2e\xb3\x9d\xd0\x25\x35\xdf\xd9\x78\x4e\x3b\x2e\xc6\x57\xc5\x24\xc4\x8f\xbd\xcb\x26\x7e\xec\xc1\xe4\x14\x03\xf5\x6b\x36\x69\xc8\x75\x65\xac\x50\x66\x25\xe3\x56\xb1\x3c\xa7\x96\x50\x76\x3f\x0b\x32\xc9\xd1\xbb\x3c\x57\x16\x11\x28\x26\xaf\x82\xeb\x6c\x82\xfb\x8c\x8b\x0d\x31\x30\x8a\x83\x59\x37\xd3\xd3\x32\x13\x2a\x83\x95\x2d\x82\xd2\xfa\xeb\x9c\x0c\x84\x0e\x7d\x86\xce\x85\x60\x3c\xfc\x0b\xea\x55\x2d\xdd\xea\x6b\x3b\xad\x71\x9d\x63\xb2\x90\xaa\xe6\xf0\xaf\x9f\xc5\x68\x9c\x4a\x52\xf3\xfd\x2c\xa6\x6b\xcc\x9f\xc5\x74\xf3\xe2\x67\x71\xa2\x3e\xff\x2c\x2c\x8d\xf0\x1f\x2b\x0b\x07\xea\xd2\xf6\xb3\x68\x63\xfd\x2c\x2e\x40\x36\x10\xb5\x76\xbb\xaa\x9f\xc5\x6d\x17\x0b\x9a\x30\xda\x77\x75\x60\xff\x2c\x4e\x34\xb9\x64\x86\x99\x84\x70\x1a\x6a\x1d\xa9\x98\x69\x98\x8d\xad\x35\x65\xc4\xc3\x86\x69\x4e\x0b\xb2\xb4\x06\x8b\x6c\xe1\xf0\xcf\x88\x8e\x00\x2d\xd2\x31\x0d\x5a\xd3\x34\xd4\xc6\x4c\x43\x36\xe0\x16\x08\x43\x94\xc2\x06\xa9\x70\xb2\x6d\x6d\x04\xad\xdf\xda\xa0\x0d\x91\xe6\x58\x37\x1c\x09\x3b\x29\xa5\x31\x17\x9a\xac\xc4\xd4\x40\x3f\xd1\xfc\x4e\xb2\x18\xd9\xd4\xe8\x5c\xc1\xe2\x72\x8d\x24\x75\x05\x7b\x73\xf8\x5c\xc1\xea\x92\x06\x64\x96\x5a\xa9\xed\x76\x53\x2e\x29\x9a\xc3\xbf\x36\x33\x0c\xb3\x69\xc9\x6f\x06\x6d\xbc\x99\x40\x81\xd8\x65\x75\x4b\xff\x36\xd4\x76\xde\xce\x36\x98\x6d\x47\xa4\xd5\x26\x93\x55\x30\x6e\x6b\x5d\x14\x37\x2c\x90\x32\xb6\x2b\x2d\xfd\x40\xb8\x23\xdb\x6e\x73\xcb\x6e\x18\xcc\x60\x8a\x87\xc4\xc8\x33\xe9\x99\x9d\x5e\x98\xe3\x5a\x0b\x4b\x34\xf5\x19\x28\x2d\x1a\x68\x95\x76\x39\x21\x2a\x75\x48\x69\x12\xa0\xf8\xb8\x51\xe3\x94\x64\x42\xed\x8e\x92\x4c\xae\x9d\x51\x92\xad\xd5\x06\xad\xc3\x9b\x68\x8d\x6f\x9d\x34\x32\x48\x19\xa7\x25\x59\x29\x1f\x15\xac\x24\x97\xdd\xd8\x92\xda\xf2\x2f\x37\x9c\x51\xa7\x77\x61\x52\xb4\x4f\x5d\xbb\x3e\x6b\xe5\x3e\xe3\xbc\x8f\xb5\x31\x7b\xf4\x7f\xce\x1e\xfe\xf5\xd9\x42\x96\x18\x62\x4e\x6e\xa0\xbe\xff\x8c\x81\xb5\xbd\xcf\x5e\xdf\x11\xa0\xba\xc3\xa7\x8e\x8e\xcf\x7e\xe9\xfb\x8c\x4d\xb4\x81\xd6\xb6\x00\x5d\x33\xd3\xc5\xe2\x73\x4f\xd9\x60\x0b\x79\x65\x4e\x16\x82\xe8\x8a\xa6\xb3\x95\xff\x51\xff\xcb\x98\xbc\xa2\x5a\xb9\x88\x2e\x57\xda\xef\xd9\x0d\x65\xa9\x98\x46\xb2\x02\x12\x81\x0a\x78\x19\x12\x54\xbf\xe0\xb1\x04\x6e\x1c\xb1\x89\x20\x76\xdc\x50\x0c\x2a\x4e\x96\x8a\x5d\x19\x0f\x7d\x41\xd6\x9d\x2b\xe3\x83\x35\x92\x0c\x48\x52\x79\x88\x2b\x3d\x4b\x34\x04\xd7\x95\x49\x66\xf4\x98\x11\x57\x26\x05\x2b\x3a\xde\x95\x49\x59\xf4\xda\x2b\xb3\xbd\x51\x68\xf1\x23\xfd\x7f\xc7\x5e\x4c\xda\xed\x6a\xb4\xde\xeb\xe2\x7a\x65\xa9\xd7\xaf\xec\x34\x39\x93\xa3\x39\x5c\x59\xf7\x5f\x9b\x5c\x10\xb6\x37\x3b\x78\xde\x4b\x1e\xd6\x7b\xc7\x9a\xc3\x95\xf5\xc1\x60\x23\x77\x65\x83\x1d\xce\x16\x44\x9a\xac\xa8\x64\x1d\x8d\x61\x41\x70\xd7\x90\x45\x54\xf1\x2b\x9b\x3b\x31\x0f\xa4\x45\xb4\x25\xdb\x70\xb2\x69\xa2\xf4\xdf\xe3\x60\xb6\x0c\xf9\x76\x35\x9b\x34\x99\x77\x28\xaf\x57\xee\x64\xd3\x96\xad\x83\xf4\xbd\x72\xc3\x6c\xb0\xa6\x5e\xb9\xa1\x6c\xab\x1b\x4d\x06\xfd\x56\x87\x38\x35\x2b\x6c\x13\x32\x92\x18\x4f\xb6\xb3\x05\x5d\x39\xcc\x44\x87\x72\x39\xac\x5c\x34\xeb\x08\x41\x18\x15\x2f\xdd\xea\xb6\xc9\x98\x44\xcd\x5a\xd7\x83\x2b\xf7\xe9\x22\x95\xc3\x9b\xad\x0e\x56\x06\x22\x32\x04\x88\x62\x22\x48\x32\x26\x20\x7d\x4c\xf4\xea\xa0\xcf\x42\xdd\xb9\xf2\x26\x5f\x34\x92\x37\x85\xea\xe9\x6d\x50\x65\xf4\x2a\x9e\xa0\x2c\x99\xc3\x55\xc4\x4e\x90\x9b\x36\x4e\x25\x63\xed\x40\xdc\x98\x46\x59\x63\xaf\x62\xf2\x71\x52\x32\x59\x9a\x28\x44\x88\x1c\xbb\x8a\xa9\x44\xea\xfa\x98\x3e\xf5\x1f\x4f\x00\xcc\x36\x23\x9f\xe6\xd2\x6d\x93\xae\x62\x2e\xf1\x44\x91\xdf\x9d\x26\xf3\xee\x82\x3d\x5c\xc5\x5f\xb5\xaf\xb1\x50\xee\x5d\x45\x08\x4b\xa5\x89\xc6\x8c\xd7\x78\x24\x36\xdc\x25\x92\xa9\x97\xcc\x70\x96\x3a\x26\x33\xee\x4d\x98\x5c\x41\x39\x9e\x9d\x3b\x39\x94\x29\x99\x30\x9b\x45\xe3\x6d\xce\xb3\xce\x76\x95\x6c\xe0\x3d\xd8\x55\xb2\x71\x92\x06\x4a\xf6\xbd\x2d\xfb\x8a\x6a\x41\x2d\xea\xb2\x48\xb4\x2d\x27\xbb\x66\x6d\x79\x70\xa8\xac\x32\x38\x92\x7d\x2f\x61\xd4\xf9\x9a\xe2\x30\xd7\x98\x31\x9e\xbd\xf9\xc5\xd9\x95\x41\x0a\x56\x96\xce\x42\x72\x95\xf6\x3a\x74\x60\xd4\xe5\x38\x65\x7c\x77\x03\xbe\x2a\xfe\xe4\x44\xa1\xba\x2a\xde\x5b\xac\x81\x87\xab\x92\xce\x73\xf4\xa3\x4d\x97\x40\xbe\x4d\xc1\xd2\x68\x29\x09\xf2\x8e\x56\x99\xab\x92\xb3\x33\xe5\x43\x62\xe4\xbd\xb3\x0e\x55\xa8\x75\x27\x5c\x55\x01\x85\x52\x3d\x42\x2a\x47\xf6\x6d\x13\x71\x78\x6d\x6c\x5d\x58\x40\x6b\x9d\xae\x4d\xd5\x48\xd0\x5d\x04\x39\x25\x22\x9b\xc0\xbf\x36\x53\x70\x3c\xc5\xae\x8d\x1f\x8d\x9a\x0e\x10\xba\xfd\x0f\xd6\xff\x15\xcb\xdc\x7e\x47\xd5\x31\x9c\x7e\x55\x37\x12\x9c\x70\x8d\xcc\xc5\xf1\x23\xe9\x57\xbc\x08\xc3\x02\x9f\x84\x19\xd7\x8b\x54\x45\xfe\x51\x58\xeb\x3c\x86\x52\x1d\x3f\xba\xcd\x26\x5a\x44\x88\x4f\x16\x61\xd2\x39\x1b\x94\x86\x63\x06\x8d\x91\x6b\xe3\x2d\xa6\xf1\xb5\xf1\x30\x2c\xc5\x50\xb7\x0c\xd7\xc6\xc7\x64\xb0\xf5\x56\xfa\xb7\xaa\xd2\x28\x63\xe3\xe7\xb5\xf1\x3b\xed\xd2\x2f\xea\xb6\xb7\x13\x05\x41\x9a\xe7\x62\x36\xca\x73\xb1\xaa\xe9\x5e\xc3\x42\xde\xb2\x06\x40\xc3\x50\xa4\x55\xec\xf2\x4c\xd6\x34\xd6\xbc\xcb\x04\xeb\x00\x4f\x58\x30\xda\xa6\x4b\x50\x8d\xba\x7b\x3a\xf7\xe0\x52\x86\x11\x82\x9b\x7a\x0b\x64\x2d\xce\x1a\xe2\xb0\x67\xd9\x89\x5e\x9b\x55\x4d\x77\xd7\x50\xdc\x48\x63\x02\x45\x2a\x30\xbe\x4d\x27\x55\x28\x88\xfe\xd8\x47\x77\x82\x1c\xe1\x4c\x98\x13\xff\xc9\xd1\x3a\x0a\x54\xf3\xfb\x35\x46\xa2\x14\x5f\xe2\xb7\x71\x9b\x7a\x95\xff\xda\xa4\x1d\x85\xc4\x0e\xea\xda\x6c\xaa\x6f\xf5\x34\x47\xdb\x48\x18\xcb\xba\x7f\x6d\x72\xff\x5f\x2d\xda\xcc\x96\x5e\xe0\xcf\x88\x21\x53\xf1\xfa\x7f\x98\xa2\xc0\x0b\xdd\x5a\x41\xf8\xb7\x14\xea\x6a\x47\x81\x62\xb1\x24\xba\x60\xc9\x64\xca\xf7\x09\x42\x3f\x65\xeb\xf8\xb5\x51\xfd\xe2\xda\x94\xd1\x64\x95\x28\x40\x6e\x68\x7a\x2c\x70\x14\x03\xfa\xb5\x29\x5d\xdf\xef\xb6\x7c\xd0\x60\x23\x42\xf2\x20\x7a\xcb\x3b\x75\x98\x1d\xed\x80\xc9\x65\x7d\x33\x7e\x33\x08\x26\x37\xa5\xf8\xda\x7a\xff\x66\xfc\x80\x6c\x29\xb6\x2f\x6a\x51\x13\x20\x83\x45\x27\xb4\xea\x74\x12\xba\x75\x62\x17\x2c\x9d\x8c\x76\xc5\x49\x55\xd8\x3f\x94\xc4\xbe\x19\x3b\xab\xf0\x1b\x03\x5d\x6c\xb1\xae\x34\xb9\x44\x50\x5b\xd7\x6e\xae\x2b\xff\xc5\x64\x9c\x0d\xa9\x2c\xd7\xb3\xf1\xd8\xb5\xbb\xed\x70\x3d\xc3\x1e\x37\x18\x8f\x7c\x89\xe6\xf9\x41\xa4\x28\xaa\x3d\x90\x89\xd5\x73\x78\x16\xcc\x66\xc5\xa2\x0f\xab\xe7\xf5\x6c\xbd\xc9\x6a\x07\x66\x54\x3b\x82\x91\x96\x75\xb6\xbe\x1d\xd7\x5d\xcf\x6e\x31\x36\x59\x9d\xf2\xb3\xcb\x9d\x30\x60\x54\x1b\x93\xa1\xb4\x3b\x03\x9d\x79\x8c\x64\x45\x20\xa0\xf3\x77\xf6\x66\xa1\xb5\x8b\xa8\xbd\xd7\xc8\x94\x83\x44\x5a\xb8\x48\x19\x41\xb4\x2f\xbe\x86\x1e\xd5\x8a\xdc\x80\xa4\x12\x9b\x31\x4d\x50\x0b\xe8\xac\xb5\xcc\x10\x3b\x34\x83\xc9\x47\x6b\x7a\x5a\x1b\x5b\x19\x3d\x9e\x6d\x5a\x71\x52\x29\x50\x7a\x0d\x21\xeb\x66\x8b\x6e\xae\xaf\xe7\xf8\x8f\xd4\x22\x0e\x4f\xe3\xfb\x06\x55\xeb\x7a\x8e\x61\x4c\x51\xac\x51\x82\xb4\x1c\x04\x44\xdc\xcd\xc9\x6d\xd9\x86\x4d\x8e\x7c\xae\xfb\x8a\x10\x19\x87\x92\xde\xa9\x2a\xed\x38\xb4\x06\x47\x0c\xdf\xda\x64\x29\x5e\xd0\x18\x0b\x38\x09\x90\xe2\x35\x8e\x7c\x4d\xf0\xb7\x50\xc9\x88\x9a\xa9\x95\x3e\x45\xdd\xe5\x5e\xcf\x69\xbf\x94\x7c\xcc\x10\x33\xa4\xa0\x6e\x22\x0a\x43\x1a\x2d\xed\x9b\x8b\x7c\x4c\x42\x51\x91\xec\x44\x0d\x9c\x31\xe2\x4f\xd0\x94\x29\x19\x82\xda\xd4\x38\x93\x32\x87\x6b\x6c\xf1\xba\x8c\x5d\x1a\xca\xf2\x6e\x26\x17\x70\x82\x70\xb8\x76\x29\x55\xe9\xe5\x52\xb5\x50\x5c\xbb\xdc\x46\x3e\xd1\x92\x2c\xec\xcf\x5a\x65\x97\xdb\xc8\x03\xdd\x55\x00\xe7\x99\x93\x4b\xef\xa2\xf3\x12\x96\xbe\xd3\xa3\xce\xc1\x05\x89\xba\x65\x69\x5e\x6f\x60\x24\x51\x9b\xa5\x42\xc9\x4d\x90\xb4\xae\x37\xef\xa6\xd3\x37\xaf\xbd\x51\x55\xf5\x70\xed\xed\xbb\xf5\x26\x8c\xb0\xfe\x5c\xfb\x68\x06\xf9\xd7\x89\x28\x85\xda\x2c\x98\xf7\x32\xbb\x3c\xb6\x3c\x6e\x74\x6a\x02\xa3\x91\xa1\x4c\xc4\xa5\x83\x12\x52\xcf\xe2\x89\x94\xca\x6b\x0c\x90\x3a\xfb\x19\x68\xcf\x63\xb0\xb4\x6c\x81\x6a\xf5\x2a\xa0\xa4\x86\x21\xae\x96\x84\x41\x1c\x7c\x6d\xee\x38\xc6\x7a\x60\x7b\x1d\xad\xaf\xf5\x07\xbd\x60\xa6\x31\x9b\xf7\x5f\x3a\x6a\x3b\x0e\xa5\x3d\x43\x5e\x6a\x55\xe2\x2c\x7d\x12\xe7\x70\xa9\xc4\x5f\x47\x6f\xb1\xff\x5f\x6a\x3a\x8a\xcb\x72\x01\xba\xe0\x37\xb3\xd1\xda\x53\x04\xb3\xe1\x8b\x82\xb6\x15\x7a\x03\x7d\xe9\x75\xa2\x46\xef\x2d\xc9\xfc\xe8\xab\x92\x05\x32\x6c\x1a\x0e\xb3\x01\x8b\x0f\x1c\x3d\xc0\x86\x47\xb1\x7f\x61\xc9\x07\xb5\x18\xfd\x0c\x7b\x1c\x3a\x23\xa6\x7d\x0e\x70\xb2\xdb\x60\x93\x7c\x12\xec\x47\xab\x73\x98\x7c\x5c\xea\x71\x10\x70\xb2\xf5\x68\xf1\x3a\xe2\xd0\xa3\x36\x42\x70\x25\x0c\xd9\xb5\x66\x97\x53\xf2\x80\x30\x0c\x83\xa1\xa9\xa3\x31\x64\xfb\x91\x8b\x36\x48\xc8\x31\xa9\x5e\x28\x40\x53\x5d\x57\xda\x99\x5c\x8b\x0e\xad\x03\x62\x6d\x03\x1c\x21\xbc\x7a\x81\x22\xad\xab\x4e\x2f\xb8\x03\xb4\x4c\x01\x70\x54\x89\xf3\x4d\xe8\x2a\x08\xbd\x0c\xae\x96\xe5\xeb\x98\x2e\x74\xb6\x98\xf6\x31\xae\x51\x46\x72\xda\x97\x7a\x30\x0b\x14\xec\x65\xd4\x6e\xec\x6d\x36\x7c\xc2\xda\x70\x1d\x61\x0f\xcc\xb4\xc9\xbf\x8e\x85\xb6\xa3\xa2\x15\xc5\x5f\x38\x8e\xe7\xff\x3b\x88\x64\x4e\x39\x59\xee\xd9\x46\xc3\x0c\x72\x9d\x4c\x69\x35\x4f\xf5\x38\xe9\x1a\x46\x3c\xf2\x2b\xb9\x26\xef\x0c\xcd\x1e\xf2\x5e\x6c\x79\xd7\x29\xaa\xa2\x98\xe2\x50\x8d\x80\x0c\x6a\x8a\x71\xa8\x26\x41\x0e\x61\xeb\x1f\x68\x17\xe0\xfa\x43\x99\xc5\x41\xe6\x09\x85\xbc\x65\xe3\x69\x1b\x7c\x9d\xa2\xda\x5c\x40\xd6\x5e\x48\x71\x13\x13\xd3\x75\x2a\x5b\xdb\x47\xd4\xed\x2a\x17\x7c\xaf\x3a\x5f\xda\xd7\x0b\x37\x24\xe6\x5c\x46\x5e\x45\xd1\x65\xe3\xf6\x75\x19\xca\x52\xeb\x54\xd6\xe4\xcc\xbb\xc3\xe1\xd2\x75\x49\x17\x1f\x96\xd4\x04\x1e\x68\x2d\x0b\x19\x7d\xb4\x35\x77\x13\x4c\x22\x0f\x16\x21\x85\x8a\x46\xdc\x08\x50\x04\xc2\xe9\x14\x13\x93\x5d\x1e\x04\x1b\x15\x73\xda\xe5\x7b\x1c\x00\x07\xd3\xd1\xa4\x7d\x12\x82\xa8\xb4\x92\xd6\x10\x17\x19\xf6\x84\x44\x76\x31\x9d\x85\xcb\x96\xc1\x46\xe6\x9a\xe1\xe8\x86\xbc\x43\xc2\x01\x4c\x30\x7c\x69\xc8\x94\x4c\xd0\x94\xe6\x7d\x0c\xca\x3f\xef\x27\xad\x21\xcb\x10\x30\x83\x5d\x61\x85\x67\xa0\xbb\x12\xd0\x64\xcd\x92\xe8\x9b\x49\x83\xd3\x1a\xc9\x40\xa3\x38\x58\xd7\x2a\x8d\x0d\x1b\xd3\xd9\xe6\x64\xe4\xdb\x3c\x9b\x25\xc4\x0a\xec\x20\xf1\xf9\x28\x48\x48\xed\x0f\x26\x65\xd5\x12\x17\x84\xda\xde\x83\x87\x2b\x10\x2c\xec\x45\x42\x49\x88\x22\xbd\x41\x4f\x36\xae\x77\xef\xa0\x0a\x51\x88\x08\x9e\x9e\x45\x09\x35\xdb\xdb\xf5\xbe\xe5\x56\x8b\x1c\xed\x60\x33\x16\xa6\xba\x8d\xbc\x31\x03\xb6\xa9\xd0\x5d\x2a\xe8\x06\xe4\x8d\xb1\x53\x71\x08\xa9\x03\xf7\x70\x63\xd2\x92\xcb\xc9\x26\xec\x75\x36\x7b\xb8\x31\x1b\xcd\x9b\x1b\x1c\x38\x90\x22\x0f\x4a\x53\x24\x0a\xa1\x96\x8c\x97\x2c\x1e\x6f\x2c\x2d\x7d\x22\x90\x15\x91\x1f\xd9\x8d\xb5\x6f\x93\x45\x94\x37\x98\x07\x34\x4f\xfb\xe6\xcb\xfb\x6f\x48\x55\x11\x85\x3c\xc9\x2b\x8a\xa8\x9e\xed\xb4\x3d\x06\xf8\x88\x3b\x80\xf1\x54\xed\x2f\x8c\x9b\x01\xe6\xc6\x36\x5f\x80\x8e\x76\x1a\x54\xb3\xf7\x76\x47\x56\xfe\x0d\xfa\xc0\x8d\x5d\x2c\xce\xd6\x40\x71\x4f\x35\x15\xfd\xc6\x06\x47\x16\x55\xa9\x6a\x10\x25\x0a\xf9\x6d\x1d\xae\xb9\x10\x43\x4b\x47\x40\xb6\x68\x37\x18\x18\x35\xd5\xb4\x98\xc1\x86\x9c\xe2\x87\xc5\xf1\x14\x05\xd6\xd2\x25\x68\xc4\xb2\x1f\xa9\x68\x57\x44\x4e\x72\xa6\x91\x24\xe0\x6e\x6c\xc2\x06\xf5\xc6\x6e\x76\xf9\x14\x22\xd5\x75\x4f\x10\x1f\x29\xdd\xd8\x6d\x21\xd7\x4c\x64\xbb\x2d\x26\x59\xde\xd6\x13\x52\x85\x92\x69\x0c\x2b\xb0\x8b\x7f\x33\x83\xc3\xb4\x15\x80\x91\x44\xc5\x21\x94\x55\x53\xab\xe1\x19\xee\x45\x90\x31\x15\xd3\xa1\xbb\xab\x29\xb8\xbe\x6c\x8c\xfb\xdc\x9c\x8e\xdd\xf6\x45\xbc\xcc\x14\xe1\x83\x46\x97\xc8\x97\xa8\xfb\x94\x7c\x61\x5a\xe4\x98\x2f\x12\x2a\xfe\xd4\x10\x9f\xaf\x29\xd0\x76\x41\xbc\xda\x3d\x1c\xc4\x1b\x16\x41\x6f\xe6\x54\xe3\xbd\x69\x7f\x33\x4a\x6e\xea\xe2\xe1\x38\xbd\xa2\xc9\x1b\xdb\xbe\xab\xae\xa6\x82\x7d\x09\x35\xac\xa9\xea\xca\x90\x11\xa6\xc0\x79\x5b\x51\x69\x15\xe0\x83\x78\x39\x9c\xba\xe4\xd5\x61\xd9\x31\x5b\xfa\x01\xd6\xfa\xae\xba\x22\x04\x98\x16\x2b\x8b\xc0\xd5\xb5\x36\x4a\x76\xea\x1a\x5a\x1d\x2d\x2a\x8c\xab\xe9\x1a\x7e\x33\x7e\xab\x13\x59\x38\x2c\xd5\x15\xc6\x96\xe9\x45\x15\x90\xbf\x38\x6e\x08\x47\x25\x09\x03\xb1\x80\x29\x74\x53\x1b\xba\x51\xdc\x88\x4d\xfe\x07\xab\x31\xde\x6d\x5a\x44\x4b\x56\xce\x45\x6b\xb1\x29\xb6\x03\xad\xd2\xe2\xd0\xa3\x40\x94\xa0\x0b\xd8\xcf\x94\xea\xd4\x5a\xe1\x45\x4e\xe5\x52\xb8\x08\xab\x6f\xb3\x22\x4e\x30\x84\xd6\xb9\x15\x54\x9c\x77\x79\x5a\x00\xc8\x44\xfc\x07\xaf\xfb\x24\x8a\x69\xef\xc6\xbe\x47\x18\x57\x6f\xec\x7b\x72\x76\x83\x70\x77\xd8\x7f\x52\x62\xce\xc0\xf7\xa1\xa6\xed\x06\x98\x61\x3a\x14\x7b\xdf\xe8\x1b\x37\x9c\x49\xd6\xba\x41\x16\xd5\x1b\x84\x75\xcd\x40\x4a\x03\xd4\x7d\x34\x36\x01\x51\x8d\x18\xe8\x47\x93\xcb\xb2\xa0\x32\x8d\xc8\x67\xb7\x8c\xe2\xc2\x74\xe3\x02\x86\x84\x6e\xda\x0f\x37\x6e\xd5\x83\x1e\xdb\x03\x4a\x57\x1c\x39\x62\x47\x37\x3e\x54\x06\x62\x9f\xe3\xf9\xac\xec\x84\x6a\xe4\x4c\x6d\x02\xac\xc7\x3c\x37\xd0\x73\x87\xb9\x26\x11\xcf\xa3\x58\x1a\x99\x44\x7c\xf8\x75\xa9\xea\x79\x13\x3d\x5a\x48\x17\x7e\x40\x71\xce\xa3\x88\xb1\xda\xe1\x18\x40\x6f\xe2\x71\x19\xc3\x34\x1b\x6b\x20\x11\x40\xf2\x01\xdf\x4d\x0c\xd0\x79\x6f\x62\x42\x0f\xc5\x32\x79\xb3\x49\x1d\x93\xab\x27\xc5\x37\x65\x92\x6d\xfd\xcd\x6e\xea\xa1\xc6\xcd\x2e\x2c\x55\xf1\x6f\xf6\x6d\xaa\xe6\x88\x9b\x4f\x4b\x1e\xa4\x37\x9f\xb3\x09\xa3\xee\xa7\x6e\x3e\x67\x0b\xcd\xc5\x99\xc3\xed\xc9\xa6\x3c\xe3\xdb\xc3\x2d\x9d\xd6\x41\x9f\xb8\x1d\x66\xfb\x0e\x1d\x80\xd5\x9c\xdb\x61\x76\xe2\x1a\x79\x3b\x48\xbe\x18\xc7\xb7\x43\x8e\x18\x90\xe4\xc6\xc1\xb3\xf8\x92\x45\x53\xe6\x76\xc4\x38\x93\xd2\xde\x8e\x6e\x22\x95\xe5\xf6\x8d\x75\x05\xe5\x4f\x53\x2b\x07\xd3\x88\x34\x27\x34\x33\x28\x77\x96\xad\xec\xad\x0b\xb3\x75\x8b\xdb\x86\x19\xdf\xb9\x88\xa3\xe0\xdb\xf3\xec\xc6\x60\x0e\xb7\xde\xc5\x84\xdd\xd2\xad\x77\x9f\xe6\x64\xf3\x7c\x86\x6b\x2d\x70\xe9\x2d\xae\xb7\x7e\xcf\xe2\x17\xc6\xea\xff\xed\xb2\xb6\x73\xa2\xdb\x05\x9e\x4d\x38\xf2\xba\x0d\x58\x46\x48\xc8\x6c\x87\xdb\x30\x60\xf7\x01\xee\x18\x3f\xa3\x1b\xa4\x8d\x6f\x03\x79\x20\xe8\xc7\x61\xde\xc9\xb5\xf7\x03\x11\xdd\x66\x79\x26\xde\x86\x0d\x4b\xe9\xe1\x36\xc0\xa5\x92\x39\xb9\xed\x4f\x18\xc8\x98\x61\xa0\x42\x97\x91\xcc\xed\xdb\x90\x65\xab\x4f\x94\xfa\xc3\x01\xac\xf3\x0e\xcb\xc9\xd6\x83\x6c\x1b\x8c\x0d\xf6\x9e\x3f\xb7\xd1\x74\xfe\x53\xb7\xd1\xb6\xaf\x11\x68\x57\x27\x83\xe6\x56\xcc\x6c\xb4\xae\xde\x62\x57\xef\xb6\x45\x6c\x27\xb7\x2c\x29\xc4\x48\x72\x0b\x67\x9d\x04\x13\x2b\x06\x09\x6e\x8e\x0c\xb2\x39\xbf\x95\x6d\x9f\xba\x60\xde\xc6\x6d\x0f\xb6\xcd\xbb\x5b\xa8\x51\x0d\x7c\xee\x93\x8b\xe1\x70\xbb\x8a\x0f\x2a\x59\x5e\x6f\x57\x1c\x3a\xa8\xfb\x92\x39\xdc\xae\x0e\x6e\x65\xae\x52\x51\xb5\xca\xdb\xd5\xbd\x1b\x8f\x03\xad\xdb\x15\x06\x79\xb7\x0d\xd4\xd7\x69\xb0\x53\xe4\x33\xcd\xdb\x64\x97\x9a\x5f\x5a\x6c\xc0\x81\x3c\xcd\xbc\xdb\x14\xb6\x2c\x7d\x97\x7e\x91\xc1\xfc\x16\x06\xc1\xd5\x7a\x11\x80\xb7\x17\x8e\xb1\x82\x06\xac\xaa\x0a\xba\x01\x27\x0c\x1e\x30\x34\x76\x65\x60\x67\x34\x3e\x7b\x63\x87\xed\x37\xf8\x7f\x2e\x4c\x87\xdb\x02\xbb\xd7\x76\xb8\x2d\xdd\xcc\x2b\x18\x97\xc8\x88\x08\xdd\xf7\xde\x96\x51\x86\x7f\xf9\xb4\xa7\x5d\xff\x73\x25\x7e\xb9\xc0\xe7\xee\xb7\x1f\xb0\xac\x8b\x52\x78\xfb\xe1\xa6\x22\x75\xa0\x94\x3f\x9c\x97\xb5\xee\xf6\x43\x5b\xe6\x8b\xa9\x3e\xe7\x5f\xcc\x70\x86\xf9\xdc\x1c\xbe\xd0\x81\x81\xf0\xf1\xe9\x17\x1c\xa2\x82\xef\x07\x3d\x5b\xfc\x02\xcd\xa0\x0a\xec\x2f\xd8\xda\x20\x02\x19\x1e\x21\xd6\xa0\x71\x7c\x69\x9b\x0d\x4a\x05\xb0\x35\xdc\x97\x76\x95\x85\x48\x6e\xfa\x2f\x72\x49\x46\xd2\xb5\x29\x95\xa9\x1d\x12\x7c\xb1\xe9\xdd\x8d\x62\x45\x55\x10\x2b\xdd\xd5\xf6\x8b\x3b\xf1\x64\x04\xc1\x5b\x03\x50\x12\x83\x69\xd9\xc3\x7d\x91\xfb\x46\x12\xdd\x9b\xb4\x7d\x9e\x5d\x23\x77\x22\x6b\x11\x9c\x77\x83\x5a\xad\xe2\x56\xb6\x4b\x8e\xe0\x37\xb8\xe5\x70\x64\xa9\x22\xac\xc6\x2d\xc3\xae\x8a\xae\x77\xe7\xfc\xe2\x7c\xb6\xc9\x9c\xbc\x3d\x7c\x71\xc1\x4e\x91\x9c\x6c\xbe\xb0\x4c\x94\x02\x2a\xcd\x22\xfc\x8b\x37\x27\xb6\xe4\x7d\xf1\x06\xc3\x40\x33\x14\x44\x31\x96\xc5\xca\xe1\xf4\x17\x98\x75\xe7\x66\x10\x21\x5c\xbf\x78\x77\xc9\x2c\xa4\x54\x11\xd8\x62\xb5\x81\x11\x66\x15\x8e\xc8\x96\x5a\xdf\xe8\xde\xbc\xc3\x71\x3b\x49\xed\x49\x3c\xb4\x9a\x7a\x8b\x0d\xd0\x17\xdf\xcc\x88\x44\x8b\x61\x97\x68\x19\x04\x20\x35\x07\x47\x2e\xb4\x3c\x5f\xbf\x60\x9d\x3f\x95\x80\x85\x87\xd6\xbb\x2f\xbe\xc4\xba\xa1\xfd\x22\x1b\x58\xb4\x52\x1c\xbb\x51\xc3\x00\x36\x4d\x26\xbb\x28\x38\xd5\xc0\xe9\xe4\x97\x18\x48\x12\x7c\x91\xa3\x71\x29\x72\x77\x50\xfe\x05\x86\x51\x1d\xfd\x31\x2d\x71\xc3\x57\x29\x3b\x6b\x0e\x5f\xe0\x1f\xe2\xd8\xe2\x0a\x9a\x86\x4f\x32\x19\x0e\xbe\xa0\xec\xb2\x4b\x98\x1b\x31\x8b\xbf\x24\x67\xc7\xc5\x04\x51\xe6\xbe\x24\x37\xd5\x6a\xd0\x30\x48\x31\x8c\x6e\x36\x27\x97\x71\xc4\xf6\x25\x95\x8b\x86\x4c\x25\xa3\xad\xd0\x68\xc5\xd7\x1d\x3c\xd1\x62\xa1\x63\x9a\xbb\xb5\xf8\xda\x5d\x70\x20\xa9\xb1\xab\x01\xfb\x4b\xd9\x9c\x5c\x0d\x02\xc9\x03\x0a\xa5\x28\x5b\xdf\xb9\x65\x8b\x5a\x80\xb2\x75\x22\xeb\xce\xd8\x7c\x2a\x75\xa4\x28\xa4\x46\x56\xd0\x46\xf8\x9d\x79\x7b\x3b\xef\xe6\x70\x67\xb8\xee\x77\x06\xbe\x6b\x69\x8c\x59\x60\xbb\x05\x73\x67\x3c\xf5\xc9\x9d\xf1\xde\x58\xf5\x0d\x21\x94\x6b\x80\x44\xe6\xa4\x7d\xcd\xd6\x7b\x27\x6a\xde\x9d\x59\xcc\x26\x9b\xf8\x3b\x71\xdb\xb9\x33\x61\xfa\xaf\x24\x91\x06\x2d\x48\x1a\x83\x4d\x3d\x8d\x3d\xc3\x9d\x49\xbf\x62\x1c\x95\x0d\x9a\x3e\x6b\x67\xcf\x77\xd6\x65\x9c\x22\x9b\x4a\xa1\x48\xb0\x8f\x29\xe6\x8f\x05\xd0\x72\x79\x87\x53\x52\xc7\xb6\xf8\x3b\xeb\x9b\xbb\xe4\x9d\xf5\xcc\x5b\x30\x38\x59\x48\x33\x60\xd3\x00\xd1\xba\x1c\xdf\x59\x78\x63\x18\xf9\xaf\xed\x63\x97\xc5\x4d\x94\xd4\x52\xcd\xdd\x77\x36\x56\xc7\x16\xc1\x75\x38\xdd\x55\x95\xfc\xce\x46\xdc\xe1\x61\xde\xa8\x66\x0d\x27\x0c\x2d\x40\x27\xae\xee\x6c\x5c\xb7\x7d\x68\x6b\xe5\x9d\x8d\x09\xc6\x1f\x94\x29\xa6\xe9\xad\x0c\x33\x94\xd4\x3b\x7b\x61\xb2\xb9\xb3\x97\xc6\xf8\xdf\xb0\xa4\xcc\xb2\x00\x04\xac\x4e\x77\x56\x35\xa5\x3b\x9b\xde\xc9\xb1\xe4\x6e\x8e\x1b\x56\xda\x3b\x77\x3a\x89\x80\xb8\x73\x76\x83\x9d\x99\xbb\xc3\x41\xfe\x31\x51\x67\xc9\x9d\xd3\x59\x82\x5a\x79\x33\x38\xcb\x23\x15\x64\x8d\x44\x40\xda\xd3\x9b\x32\x88\xc6\xcb\xb4\x14\xcc\xdb\xd8\x2f\xd1\x77\xde\x46\xab\xbb\x8e\x3b\x18\x0a\x74\xdb\x00\x50\x7c\xec\x68\xca\x9a\x4e\x2a\xef\x7c\x94\x13\x02\x70\x5a\x4f\x34\x85\xac\x92\x34\xe1\x2b\x6a\xd9\x2a\x83\x96\x03\x86\xb3\xb1\xd9\x4a\x10\x9d\x6d\x4b\x00\x84\x5f\x17\x8f\xcd\xa9\x95\x6c\x19\x50\x35\x68\x64\x55\x84\x66\xc4\xa5\xa3\x0e\x2c\x56\x37\x4d\x00\x8d\xc6\x15\x5d\xd3\x2e\xf6\x0a\x27\xfe\x0f\x56\x45\xfb\x20\x87\x99\x77\xe4\xed\x77\x17\x47\xb2\xff\xdd\x61\xf7\x25\xcd\x0d\x9d\x5c\xd4\xb6\xbb\xb8\xac\xb3\xa8\x89\x14\x3b\x98\x45\x77\x40\x77\x51\xee\xdb\xdc\xc5\xaa\x6f\xde\xc5\x38\xe2\x82\x60\xfc\xe5\x4c\x0f\x24\xb1\x34\xc6\xa0\xff\xeb\x08\x80\x37\xe3\xbb\x46\x80\x52\x0b\x2f\x1f\xc4\x82\xdf\x2b\xa2\xd3\x35\x88\xb6\x54\x34\x4c\x9f\x33\x92\x11\x94\x8c\xfa\x0b\xde\xa5\x46\x84\x51\x8f\x18\xee\x60\x7c\xc7\x1e\x02\x44\x27\x49\x19\xd5\x61\x24\xf0\x02\x48\x05\x09\xd5\xc6\x65\x54\x77\xaa\x77\xc9\x2e\x50\x10\xee\x92\x0d\xb2\x43\xbf\x4b\x76\xdf\xe8\xc4\xe9\x8e\xee\x13\xc3\xc8\x7a\x97\x62\x59\x0f\x77\x65\x9a\x2c\x6d\xc4\x38\x9f\xe2\x4f\x36\x9c\x1d\x6d\xed\xee\x60\x9c\xae\xa5\x2b\xe1\xcd\x3b\xba\x2e\x76\x57\x76\xba\xd1\x72\xb7\x27\x1d\x96\xf7\xc6\xc2\x96\x5a\xdd\x86\x09\xd7\xee\x07\x8a\xbf\x85\xaa\xa4\xb9\x87\x8b\x08\x2f\xbb\xb4\xb1\xbe\x37\x6f\xc8\xfb\xde\xcc\x12\xd5\x5f\xba\xe8\x11\xa7\x3f\x11\xea\xb1\xa4\xa0\x0c\x27\x08\xf7\x0e\x9a\xa1\xe5\x1e\x4e\xdd\xbc\x5b\x24\x32\x99\x61\x0e\x16\x9f\x61\x6d\xc7\xbf\x0d\x37\xe2\xeb\x70\xbd\x37\xde\x6b\x59\xe4\x8e\x5f\x13\x5c\x8d\xd7\xae\xf6\x36\x5e\x87\x7f\x2b\x72\x7f\x43\x9e\x61\xcd\xa2\xaf\xa9\x7c\xc1\x3b\x3c\xc4\x1b\x6a\xb4\xce\xf9\x04\xd0\x62\x6f\x2f\x93\x07\x58\xcc\xbd\x20\xa7\x36\xdf\x00\xe5\xce\x0a\x48\x8f\x62\x5e\xe4\xe7\xe1\x92\xea\xf7\x30\x61\xd7\x71\xaf\x57\x9e\x68\x11\x23\x44\x23\x1c\x54\xb5\x4f\x02\xc8\xee\x15\x64\x67\x30\x00\xdc\x56\x17\x2a\x95\x8a\x57\xc4\xba\x25\x51\x24\x95\x84\x4a\x4b\x57\x05\x31\x98\xb5\xc2\xc9\x8a\x70\x6f\xfc\xae\xb1\x16\x43\x1a\xd6\xbd\x09\xa3\x15\xcf\xf2\x7b\x13\xb6\x6d\x98\xbd\x9d\xc8\x29\xf9\xde\xac\x46\x94\x67\x26\xc5\x0a\x73\x6f\x56\x1f\xeb\x38\x58\xc5\x93\xf0\xde\x6c\xed\x24\xf8\xde\x6c\x1b\x0e\x86\x51\xd0\x77\x9b\x66\xa7\x34\xab\x0d\xf7\x76\x84\x8a\x6f\xb1\x89\xba\xb7\xbe\xf3\x53\x20\x24\xd3\xf1\xde\x62\xde\x6a\x8a\x04\x38\x27\xeb\xdd\x59\xf8\x34\x0a\xac\xef\xab\x2b\xa8\x0f\xa4\x19\x23\x34\xce\x08\x9c\x44\x43\x5b\x0c\xb3\xc6\x92\x96\x21\xb2\xf1\xa9\xb5\xbb\x59\x67\x03\x1c\x48\xa4\x1a\x21\x69\xa6\xe9\xd4\xdd\x88\xba\xb7\xe9\x84\xd2\xba\x82\xf4\x12\xee\x25\xcb\x80\x86\x35\x36\xe8\x8a\x79\xcf\xae\x4b\xda\xac\x36\xad\xfb\xa7\x34\xa6\xdd\x56\x6c\x51\xf0\x05\x46\xf1\x80\x93\xbc\xda\x2c\xe0\xc0\xbc\x10\x27\x64\xb1\x08\xc7\x5b\x77\xf2\xec\x8a\x7f\x0f\x4a\x8b\xe6\xbc\x9f\x63\x81\x1e\x70\xef\x3c\x0c\xa0\xf8\x47\x68\x5d\x09\xa5\x8d\x24\xf6\xbd\xdb\xaa\x16\x73\x2f\x37\x90\xef\xe1\xc9\xbc\x90\x4c\xbb\x8f\xf0\x97\x24\x91\x7e\x1f\xbd\x58\x3d\x88\xa2\xb3\x3c\x50\x52\x47\xba\x51\xa7\x85\x8d\x0b\xdc\xb5\xf2\x3e\xcc\xf6\x70\xcf\x46\x3e\xfa\xc7\x0d\x18\x71\x56\x04\x0d\x8d\x28\x18\x2e\xa8\x83\x51\xb7\x53\xe4\x82\x60\x03\x81\xae\x15\xb6\x36\x16\xa2\x20\x3f\x5a\x8b\x29\x84\xce\x42\x9d\x86\x31\x42\x5f\x70\x18\x4d\x95\xf8\xcb\xa4\x51\x72\xde\x23\x6f\x43\xee\x8b\x71\xdb\x8c\x73\xc6\x7b\xde\xed\x49\xb3\xb5\xad\x1f\xf8\x5a\x9d\xb2\xb4\xdd\xc6\x7d\x49\x31\x38\xad\xf5\x2f\x43\x76\x4d\xd6\xab\xef\x77\xc8\x56\x9e\x21\x3b\x5a\x63\x47\x6f\x30\x84\x9b\x99\x49\xa7\x08\x3f\x3d\x6a\x39\x98\xce\x86\xe8\x6d\x0d\x05\xbd\x29\x9d\xf6\xc0\x75\x04\xb4\x2b\xaf\x55\x12\xb3\x1d\x1f\x56\xc8\x1b\x18\x85\x17\xd2\xb3\x66\x3f\x75\x22\xba\xc3\xc5\xf7\x11\x2e\xd3\xd5\x5e\x6e\x90\xb4\xdd\x8a\xf5\x9c\xa2\x32\x54\xe2\x00\x8b\xc8\xda\xb9\xbd\xf1\xaf\x8e\xe5\xdd\xdb\x45\xd7\xb6\xbd\x5f\x3a\xf6\x75\x56\xcd\xf6\x7e\x5f\xe7\x4e\x97\x66\x28\xa5\x23\x92\x17\xd2\x7d\x8d\xd8\x36\xdf\xef\x25\x6c\x31\x06\xcf\xde\x1f\x5f\xc9\x52\xf3\x75\xb4\x92\xcb\xd7\x76\xd5\xfe\xeb\x14\x4c\xfe\xc4\xe0\xe7\xdb\x08\xc0\xaa\xfe\xa2\xad\x18\x93\xe1\x7a\x39\x7c\xf5\xa5\x5b\x97\x81\xa4\x0c\x5f\x7d\xbd\x31\xf0\x55\x1e\x2e\xf8\x1a\x30\xf7\xcd\xe1\x6b\xdb\x45\x7e\x0d\x6f\xde\x9c\x60\x58\x22\xb2\xc0\xa5\xe5\xff\xab\x83\xad\xb2\xc4\xcc\x1f\xcc\x1c\x60\xf6\xb5\xdb\xe1\x6b\xe0\xe5\xfc\x6b\x20\x7b\x23\x88\x9f\x05\xee\xef\xf4\x19\x0c\x0f\xb9\x3b\x89\xf9\x1a\x72\x32\x9d\x51\xf7\x6b\xac\x26\xdd\xaf\x9b\x14\x6e\xab\x7d\xfd\x75\xeb\xd7\x42\x20\xde\x2e\x7d\xdd\xe2\x8a\xf1\x42\x43\xf9\xeb\x56\xed\x8c\x5f\xb3\x4d\xac\x0c\x7d\xdd\x2d\x2e\xde\xf2\xb2\xf1\x15\xb6\x74\x6f\x0e\x7f\x18\x38\xf7\x61\x02\xff\x61\xc4\xd3\xeb\x8f\x76\x43\xf0\x0f\x13\x82\x41\x63\x23\x38\xe4\xf9\x42\x19\xf8\xc3\xe4\x02\xdd\xc2\x1c\xfe\xb0\xff\xc5\x5c\xfc\xc3\xc6\x30\xd9\x82\xbb\x4d\x7f\xd8\x98\xa7\x5e\x8f\x51\x86\x8c\xa1\x3f\x66\x13\x93\x93\x22\x40\x3f\x11\x6a\x9b\xad\xd2\x67\x66\xc5\xd9\x84\x60\xb7\x93\x59\x33\x0e\x3b\x88\x13\xf4\x1d\x82\x3f\x62\xf8\x59\xe4\x8a\xce\x1f\x31\xca\x41\xf2\x1f\xe4\x41\x2a\x2a\xf3\x1f\x65\x82\x8f\x4b\x02\x3b\x15\x76\x3c\xf8\x66\x9c\x44\x25\x8a\x38\x01\x2f\x85\x30\x25\x82\xee\x9b\x49\x34\x0e\xbf\x19\xba\xef\x13\x10\x96\xcd\x14\x30\xe4\x2d\xd1\x61\xa2\xad\xda\x37\xba\x4f\x95\x98\xcc\x11\x77\x98\x71\x0f\x54\x9e\xf6\xf9\xe6\x4e\xa3\x15\x39\xcb\x7a\xd8\x37\x37\x6e\xf5\xac\xeb\x9b\xf3\x8d\x0c\xf5\xe2\x2b\xd1\xda\x87\x02\x22\x06\xfc\x37\x87\x7b\x38\xe4\x48\x48\x24\x3c\xa7\xbe\xb9\x04\xa5\x8e\xf6\xe2\xbc\xf6\x7f\x43\xb5\x78\xac\x7f\x73\xd9\x6c\x74\x45\x4d\x29\x1a\x6b\x87\x6f\xde\xea\xc6\xf2\x9b\x2f\x5a\x67\x5f\xf6\x77\xca\x32\x60\x4b\x87\xb4\x71\xd4\x4c\xff\xdf\xa4\x81\xe2\xf2\x4e\x96\xf2\x18\x0e\xdf\xe2\x9a\x74\x61\xfc\x16\xe9\xe6\x09\xfd\x6b\xac\xea\x70\xf5\x2d\x6e\x62\x7f\xf8\x16\xb7\x85\xb7\xbd\xdf\xe0\x9a\x5a\x9d\xc6\xbe\xc5\x4f\x03\xdb\xd2\x37\xf8\x83\xfb\xe0\xce\x74\x37\xeb\x5b\x72\x27\x98\x6c\x84\x62\x0e\x94\x1d\xa4\x94\x38\x7e\x3c\xd7\x81\x79\xf8\x96\xe2\xba\xda\xb0\x65\x3b\x52\x1b\x65\x3b\xb6\xbd\xd8\xb7\x62\xf3\x27\x8e\x56\xd0\x99\xc5\xaf\xb4\x5d\xf8\x56\x16\x33\xcc\x05\x2f\x5f\xc0\x01\xfc\x5b\xc1\xe6\x88\xf8\x89\xec\xf3\xdf\xca\x46\x8d\x0b\xce\x16\xec\xa7\x04\xaa\x6f\xd7\xb7\xfa\x3a\xc5\x37\x2c\xf8\x74\x20\x87\xda\xec\x29\x17\xd6\x4e\xbe\xed\xd5\x67\xfc\xc1\x9c\x2c\x2f\x66\x0f\xe6\x94\x6c\x80\xaf\x04\xa8\x7d\x3b\x3c\x98\xc1\xee\x12\x34\xcc\x5a\x64\x8c\x17\xc6\x3c\x88\x1f\xaa\x23\x2a\x3e\x70\xa1\xd0\x6d\xc7\x07\x88\x89\x3a\xe2\x80\x9a\x6e\xa5\xa8\x25\xd6\x95\x66\xc0\x38\x14\x7b\x2e\x45\xd4\x21\x47\xe0\x9d\xe2\x4f\x36\x61\xc7\xe4\xa4\x6c\xd8\xe2\x69\x97\x09\x12\xd3\xef\x83\x59\xd6\xaa\xf5\x33\x60\x8d\xe3\xa1\xce\xa9\x07\x13\xce\xf0\xf7\x80\x01\xe9\xc1\xac\xae\x5d\xcc\x78\x30\xd5\xd4\xf9\x60\xd2\x59\xce\x55\x1f\x4c\xa1\x6b\x2e\xe6\xf0\x60\xcd\x78\xb2\x59\x8f\xa1\x1e\xec\xa9\x3e\xcf\xf5\x60\x71\xf0\x87\x0d\x0d\xba\xf3\xc1\x0e\xde\x26\x9c\x92\x3c\x58\xcb\x7f\xcb\x2f\x1b\xe6\x68\xe5\xfa\xdb\x83\x9d\xd4\x7c\xf6\xd0\xd4\xaf\x07\xeb\xde\x58\x4e\x3e\x58\xb7\xe1\xd0\x21\x81\x84\x41\x4a\x73\x5c\x16\x58\x8d\x42\x70\x17\x40\x02\x7b\xcb\xaa\x22\x6a\x07\xd0\xb5\x55\x6d\xc8\x9f\x16\xff\x71\xbd\x99\x3f\xbc\x70\xf0\x23\xc8\xf7\xa4\x1e\xfa\xbd\x49\x05\xfb\x49\x80\xf6\x00\x02\x64\x68\x28\x89\x42\x48\x52\x6a\xd0\x68\x80\x16\x52\x30\x12\x76\xc6\xf1\xdd\xd8\x9a\x52\xc6\xe3\x43\xbc\x18\x3c\xd8\x52\xb5\x78\xa2\x65\x95\x21\xba\xc5\x90\xf3\xb0\x07\x5b\xce\xbc\xb9\x7b\xb0\xef\x72\xcd\x0b\x14\x57\xe3\x97\xf6\xa5\xb3\xdb\xd9\x0a\x59\x15\xb5\x07\xb7\xc8\x0a\x4c\x54\xc8\xb3\x25\xaa\xe6\x0e\x7a\x98\x9d\x1f\x85\x3f\xcc\xde\x55\x5a\xc6\x0e\xe8\xaa\xe2\x51\x2c\x9e\x79\x0f\x74\x1f\x57\xb2\x0c\x16\x4b\xe3\x83\xfb\x59\xdc\xa8\xb6\x6e\x46\xa2\x15\x3c\xb8\x6d\x76\x9f\x33\x59\xf8\x1e\xb0\x42\x24\x21\x74\xb4\xb8\x3c\x8b\xdd\x8a\xc8\x65\xff\x10\x8a\x5b\xc4\xe5\x4e\xde\x11\xba\x04\xb4\x34\x33\xd9\x34\x23\xc1\x5c\x80\xff\x1a\x7e\xc1\xe6\x21\xda\xb7\x37\x1d\xe9\xa0\x7d\x07\x26\xbc\x4a\x80\x38\xe7\x2c\xfb\xe8\x87\x18\xec\xaa\xad\x0c\x45\x5d\xba\x00\x24\x0b\xfb\x87\xb8\x9a\x5c\x63\xac\xb3\xfa\xf4\x3e\xe8\xbd\xd7\x87\xd2\x3b\xb8\x3f\x14\x4b\x5b\x08\xf9\xa0\x84\x48\x17\xec\x1e\x4a\x36\xda\x16\x25\xb7\xaa\x82\xee\x6a\x44\x90\x2b\x04\x72\x2d\x1e\x4b\x0a\x4e\x7f\x1f\x4a\xae\x2b\x1c\xd1\x75\xf3\xf9\x50\xf2\x3f\x49\x49\xcd\x2d\x9d\x6c\x28\x75\xd6\x17\xf5\xd9\x7a\xd0\x49\x42\xff\x49\xf1\x66\x16\xaf\x85\x0f\xfb\xd6\x3f\x44\xf2\xb0\xab\xe1\xff\xf0\x20\xf7\x71\x1e\xcd\x70\x86\x2c\xd6\xa9\xf0\x68\x86\x7a\xaa\x4d\x34\xe7\x48\xa4\xfa\x4e\x3c\x9a\xa9\x3d\x29\xe7\x1a\xa3\x4d\xc0\x47\x31\xea\x3c\x1a\xef\xb0\x4e\x3d\xc2\x8e\x20\x43\xed\x11\x82\xd3\x49\x70\x98\xe8\xa0\x9c\xaa\x7c\x78\x34\x81\x0c\x54\xf4\x41\xd8\x4a\x3a\x6b\xac\x6c\x27\xf3\x09\xe9\xf1\x68\x30\x02\x32\x11\xed\xb3\x54\x8d\x4a\x44\x3b\x2a\x7b\x72\x72\x8d\x1a\xbc\x4e\x6f\x24\xc8\x2e\x64\x20\xeb\xdc\x01\x08\xbd\x35\x87\x18\xad\xf1\x18\x0e\x52\xa4\xa4\xc7\x4d\x4c\xb2\x0d\x5d\x68\x9c\x72\x79\x2b\x88\xd5\x68\x8d\x77\x69\x87\x61\x5e\x1b\x3e\x8c\x69\x46\x31\xc9\x3b\x11\xa1\x65\xcb\x22\x08\x4a\x04\x91\xda\xa3\x3d\xa8\x89\xb5\xae\x4c\xf5\x41\x26\xa6\x37\x71\x1b\x14\xd4\x75\x1d\xf0\xbb\x50\x2b\x9e\x06\x0a\xa3\xa4\xb0\x59\x3c\x6e\xd8\x55\xbc\xef\xf1\xd4\x95\x36\x37\x21\xa7\xb0\xe5\xcd\x5b\x3e\xa6\xa4\x27\x3f\xc8\x2b\xb2\x46\x59\x54\x62\x3c\xe2\x51\x42\x69\xbd\x6c\xbd\xb6\x3d\x14\xd8\xda\x82\xef\x2e\x9c\xd2\x8e\x7b\x7e\x08\xdb\x36\x69\x6d\x52\x46\xa2\xb4\x25\x03\x16\x8c\x84\x26\x78\x0f\x8f\x3c\x49\x3a\x46\x69\x08\xf7\x32\x2e\x82\x79\x97\x2b\x48\x93\xc2\xd5\x39\xd1\xb6\x1f\x4d\xce\x58\x74\x1f\xb1\x24\xe3\x0d\x96\x24\x17\xdb\x1f\xed\x24\xb7\x6d\x3f\x88\x96\x6a\x5a\xf8\x06\xa1\xa7\xed\x64\x74\x91\x44\xb0\x46\x74\xd5\x99\xee\xd1\x7a\xb7\x6d\xb5\x2b\x2d\xdc\x42\x10\xd7\xbb\x9c\x45\xaf\x7e\xb4\xc1\x6d\x1c\x8a\xcb\x37\x26\xf4\x82\x03\xbc\x6d\x91\x67\x39\x04\xb0\x76\xd2\x01\x4a\x24\xe1\x39\x9f\x1d\xb2\xff\xd1\x6e\xf1\xad\xbb\x2a\x04\x1c\x84\x8f\xcd\x74\x1d\x75\x76\x53\xa3\x20\x82\xda\xd5\xfa\xc7\x8b\x87\x1a\x80\xbc\xd7\x4f\x40\xeb\xf3\x9f\x08\x69\xef\x97\x3d\xda\x8c\xb7\x71\xa0\xff\x72\xff\xda\x3c\xd7\x06\x23\x4f\x8b\x9a\x59\x9e\x77\x5c\x86\xc4\x59\x1e\x03\xa7\xf9\xce\x7b\x67\x5b\x6d\x58\xf2\x6e\xa8\x8b\x4e\xef\x79\x76\x08\x07\x34\x0a\x78\xd4\x09\xd0\x3e\x60\xc4\x9a\x81\x20\x31\xc4\x0a\x9a\x8c\xad\xb4\xf8\x97\xd6\x98\x73\x35\xb0\x0a\xa3\x13\x01\xc2\xe8\x43\x61\x81\x6d\x41\x6c\xd9\xa8\xc0\x75\x95\x24\x7f\x50\xa1\xd5\x19\x49\x21\x1c\x0e\x6b\xa0\x7a\x7b\x2a\xa4\xed\xb9\x82\x58\x9b\xb7\xee\xa4\x05\x66\x1b\x7a\x54\x87\x27\xc1\x77\x9b\x36\x93\xc9\x64\xa0\x1c\x71\xcf\x54\x18\x53\x17\x9b\xac\x37\x8f\x6e\x30\xa2\x8e\x3c\xba\x21\xc1\x3c\xce\xc4\x26\xf7\x08\x01\x62\x33\x09\x51\x60\xdf\x7b\x04\xad\xfc\x97\x35\x91\x01\x9e\x3e\x78\xd3\x2f\xa4\x0f\x11\xa0\xf3\x98\x68\xdc\x71\x51\x1a\x7d\x29\x33\x5e\x71\x07\xed\x30\x60\x79\x50\x30\xb7\x55\x82\x18\xb8\x3a\xa8\xdb\x54\x8a\x32\xd7\xba\x90\x37\x71\xd6\x2c\xa5\xdb\x85\xec\xd3\xc0\x02\x6c\x3b\x5a\xbf\x68\xc7\x0d\x94\xc0\x9a\x4a\x2d\x87\x18\x1a\x1f\xd5\xaf\xb3\x23\x45\xf2\x21\xa4\xbd\x24\x40\xa1\x7c\x1f\xa3\x25\x28\xf2\x86\x48\x2c\x94\xad\xa9\x44\x50\x2b\x49\xe3\xc7\x9d\x53\x3c\x9f\x23\xdc\x33\x99\x66\x57\xcd\x47\x14\x84\x2b\x2f\x67\xb3\x8f\xcd\x6e\xfc\xe8\xb6\x6c\x36\x68\x22\x08\xcf\xc8\x62\xb4\x46\xd0\x56\xe4\xbb\xbc\x95\x28\x0b\x7e\xc4\x92\xa9\xe2\x8e\x80\xac\xaf\xa0\xf9\xb9\xb7\x47\x5c\xb7\x53\x1d\xe4\xf0\x18\x47\x37\xf4\xd7\x10\x1f\xb1\x85\x17\xc5\xf1\x31\xea\xfd\x35\x9a\x60\x31\x4c\xd1\xeb\x05\x08\x41\x68\xd0\xa8\xaf\x97\x3d\xc6\x00\xa7\x09\x66\x6d\x30\x05\xa3\xa4\xb0\x51\xc9\xff\x0f\x49\x16\xef\x0a\x0a\x55\x05\x43\x4c\xbb\xf0\x72\x3b\x35\x7c\x2c\x83\xf3\xa6\xf3\x77\x02\xa3\x5b\x3b\xf1\x34\x07\x8f\x2e\xbc\xe2\x34\x1a\x92\x84\x25\x8d\x71\x98\x45\x51\x2a\xa9\xb6\x47\xd1\xce\x2c\xfa\x72\xea\x63\xa9\x4b\x4d\xc1\xe2\x0d\x69\xaa\x43\xb9\x6c\x43\x7d\xb0\xed\x71\x1f\x2c\xfb\xad\xd1\x43\x9f\x0c\x23\x3f\x96\x82\x96\xd9\x71\x90\xcb\x6b\xfb\xae\x5b\x20\x09\xa8\xf5\xdb\x07\x88\x7b\x14\x95\x29\x12\xfc\x7b\x72\x34\xa2\xb8\xac\xbb\xf8\x29\x3e\xb6\x67\x01\x95\x4c\x58\x27\x89\x3e\x37\x9a\x2f\x4b\x21\x4a\xbb\x6e\xa2\x08\x15\xde\x3f\xa2\x36\x03\x48\x69\xdf\xfd\xa3\xfa\xac\x3f\x99\xb3\xd1\xe7\x1c\x9f\x4c\xa8\xd7\xa7\x9e\xda\x21\xc4\x53\xbb\x54\xf5\x64\xb2\xbe\x85\xe1\x4a\x0f\x31\xc6\x91\x40\xee\x5e\x37\x01\x52\x1b\x95\xd0\x7c\xa8\xc6\xa0\x2d\x33\x82\x87\x2e\xa6\x5e\x12\x17\xa4\xc9\x97\xb6\x75\x7e\x32\x25\x57\x82\xf4\x96\x27\x53\x7d\xa8\x9e\xec\x85\xa8\x23\xc8\xf3\x89\xc8\xb5\xaa\xf2\x4f\x50\x1a\xdc\xbb\x0e\x90\x27\xeb\x36\xbc\x24\x62\x0e\x4f\x16\x4f\xc5\x72\x06\xb0\x68\xd9\xc1\xa4\x2c\x21\x8b\x11\x19\x03\x32\xeb\x6e\x96\xc1\xea\x71\x11\x98\xe9\x64\x46\x43\xf3\xf9\xc9\x46\x83\x93\x37\x04\x44\x43\x6f\xab\x3d\xa9\x17\x02\x54\x89\x27\x1b\xcf\x71\x31\x93\xc9\x58\xf8\x9e\x6c\xef\x51\xfa\x64\x7b\x37\xee\x27\x72\x1a\xc1\xdb\x87\x62\x3c\x79\xb2\x6b\xbb\xbd\xfc\x64\xd7\x5c\x42\xf5\xd8\x60\x28\xeb\xe1\x13\x1e\x19\x18\x91\x42\xc2\x01\x11\x4e\x02\xa9\x6e\x9b\x6b\xcf\x7d\x3d\x91\x8d\xc5\x86\x33\x19\x34\x9e\x6c\xdf\xdc\xf6\x17\x1d\x09\x99\xc3\x93\x23\x5f\x28\x22\xc4\x1c\xfa\x84\xb9\x24\xf6\x54\xa2\x21\x1e\x9e\xdc\x46\xb5\x71\xd0\xf3\x32\x76\x32\x4f\x7c\x27\x49\x5d\xe1\x04\x26\x3b\x96\x0b\xcc\x6f\xc1\x00\x39\x7f\x81\x64\xf4\x20\x80\x25\xd0\x53\xbb\xc6\xc4\xb4\xb6\x03\xd1\x32\x64\x41\x57\x05\x50\xd1\x45\x18\x5f\x21\x13\x00\x5f\x94\x1a\x22\x4d\xc7\x21\x10\x3a\x35\x9a\xac\xbc\x8c\xe8\xf8\x56\xc9\x64\x0e\x4f\x22\x46\x68\x2a\x2b\x20\x99\xf2\x84\x73\x5f\xd9\x67\x3f\xc5\x91\x3c\x0f\xb0\xf1\x7c\x8a\x13\x2e\xb9\xe2\xd3\x00\x5f\xb3\x00\x56\xc0\x4b\xe1\xd4\x86\x28\x72\x5c\xea\x45\xb4\x27\x19\x71\xf4\x8f\x5b\x9d\xc8\x19\x12\x47\xc8\xb8\x3a\x8d\x38\x6b\xde\x08\xa0\x7d\x2e\x93\x11\x4f\x87\x57\xd2\x55\x5a\x62\xbf\x77\x62\xf6\x29\xbe\x43\xa1\x71\x61\xc2\xcc\x5f\x0e\x4f\xe5\x64\x87\x56\xfa\x72\xe2\x03\xb6\xef\x27\x9c\x86\x0c\xba\x06\x7f\x3f\xd9\xad\x2c\x6d\x1e\x7e\x1f\xb0\x74\xe9\x7e\x53\x10\xdf\x63\x62\x20\xbb\x4e\x01\xe8\x6d\x26\xb9\x1f\x98\xde\x70\x92\x1a\xb2\xf3\x35\x54\x35\x2d\x41\xec\x3a\xc9\x40\xf5\x2c\x42\x4d\xe2\x54\x88\x91\xc3\xa0\xcb\xa2\xdb\xd1\x31\x26\xab\xf3\x77\x75\xe9\x4a\xc4\x9f\x45\xe9\xfc\x3e\x64\x33\xda\x41\xef\xc8\x7d\x1f\x61\x1e\xe3\x35\xe3\xfb\xd8\x4c\x39\xdf\xc7\x7d\x93\x36\x6a\x3e\xdf\xdf\x6d\xda\xa0\xae\x9b\xc3\xf7\x39\x1b\x7b\xc6\xb9\xa2\x33\x87\xef\x67\xf9\x8a\xd2\xf7\xd5\x60\xf2\xdd\x5b\x7d\xef\x03\x24\x4a\x69\x0e\xdf\xb1\x2a\x51\xba\x9e\x36\x62\x7c\x4c\x0c\x2e\xbd\xf4\x33\x53\x40\xdd\x8a\x7c\xf7\xe4\x43\xf4\xdd\xeb\xa3\x16\xdf\xc3\x10\x4f\x7b\xda\x1a\x25\xec\x33\x6b\x41\xdf\xd7\x19\xde\xcb\xd2\x3a\x00\x17\x34\x29\xbc\xdf\x57\x97\x0b\xb4\xb4\xef\x89\xba\x12\x1b\x0b\x75\xe3\xf9\x0e\x9b\x83\x39\x7c\xd7\x86\xa0\xc2\x35\x9b\xc3\xf7\xe4\x60\xe8\x44\x04\x74\x56\x6f\x33\xe8\x39\x54\x7b\xc5\xda\x78\x8a\xdb\x06\x80\x3f\x91\x7e\x65\x86\x1a\xe1\xbe\xa7\xfd\xd3\xcc\x85\xc6\x42\xda\x3f\x9d\x90\x9b\xc1\x29\xf5\x77\x56\x06\x53\x23\x73\xfc\x0d\x70\xab\x34\x4c\xd3\xa8\xc3\x3c\xc5\x98\x51\x4b\x4c\xa8\x3e\xc5\xc0\x81\x22\x22\xbe\xe3\x50\x2a\x98\xc3\xf7\x4c\x0b\x08\x72\xce\x99\x7c\xb1\xbe\xbf\x47\x37\xd6\x19\xf4\xcb\x86\x5f\xd6\x9e\x21\xfb\xbf\x7f\x18\xef\x7a\x73\x1b\x18\x75\x10\x83\x96\x47\xc9\xbf\x57\xdd\xe1\xfb\xc7\x4e\xbd\xf8\x6c\x06\xf7\x56\xbf\x25\x88\x5e\x79\x36\x36\x98\xee\x79\xf4\x67\x79\x32\x8c\x3b\x80\x10\x54\x93\xaa\x2c\x80\x13\xfb\x69\xf0\x6c\xbc\xb1\x38\x78\xc6\x5b\xcd\x48\x8f\xe0\xc5\xdb\xb6\xcc\x1b\xea\x2e\x9e\xf1\xd4\x79\xae\x31\x87\x6e\x95\x48\xd7\x32\xc7\x77\xf6\x6a\xe1\x88\xc5\x8e\xd1\xd2\x6d\x49\x7a\x0e\xcb\x76\xe6\xc8\xda\xcc\x60\xb5\xa3\x15\x3e\xfd\xbe\x87\xe8\xac\xcc\x49\xee\x5d\xfc\xc0\x18\x6f\x78\x02\x4b\x1a\x8f\x70\xf5\xcc\xe1\xf0\x5f\xf0\x2d\xa9\x07\x67\xcf\xc6\xff\x56\x49\x1b\x5d\x5d\x67\x08\xfe\x96\xa5\x13\x03\xf3\x33\x1c\xa7\xe0\x5c\x40\x5c\x3a\x17\xa1\xf7\xbe\x9f\x8d\x2f\xf5\x48\xb9\x07\xe8\xf9\x67\x13\xcc\x60\x26\x35\x00\x3f\xe3\xb6\x13\x5d\x85\x78\x86\x0a\x57\xa7\x0c\x10\x35\x67\x88\x4b\x10\x33\xee\xb3\x09\x19\xcb\xdd\x33\x1f\xa3\xd4\x98\x6b\xa9\xc7\x33\xcf\x26\x89\x38\x63\x85\x12\x58\xcb\x9d\x4c\x53\x5b\x08\x45\xdf\x8d\x86\x64\x86\xfe\xc9\x05\x30\x6c\x77\xd7\x09\xf8\xad\x5d\x71\x00\xf4\x17\x87\x4e\xc4\xe9\xc7\x23\xbd\x41\x71\x11\xbc\xd4\xad\x03\x42\xeb\x8b\xd4\x00\xb1\x8a\x53\x42\x75\xee\x03\x75\x17\x9e\x18\x26\x5f\xb0\x4f\x3e\x41\x5d\x23\x0e\x3d\x41\xdd\xe2\xc3\xe9\x5b\x9d\x15\x11\xde\xec\x2c\x84\xec\x64\xab\xe5\x99\x18\xcd\x95\xd7\x49\x53\x6c\xa5\x1e\x3b\x3d\x9b\x64\xe9\xf9\x45\x72\x5f\x00\x0a\x17\x30\xcf\x66\x33\xc9\x64\xd1\xd5\xc1\x71\xd9\x89\x77\xf1\xb3\xe1\x47\x18\x4a\x05\xb5\x54\x34\x64\xb1\x0e\x1f\x9e\x71\xde\xae\x9e\xd4\xcf\x66\x83\x9b\xbd\xa4\x45\x34\x35\x4a\x6e\xe7\xdb\xcf\x74\x0a\x5f\x2b\x50\xcd\xf8\x4c\x6b\x06\xa5\x6e\x0c\xa5\x03\x2c\x6c\xbc\x74\x3f\xec\x59\xee\x31\x68\x85\xed\xe8\xaa\xa4\x63\x20\x63\xa0\x6b\x78\x3b\x76\xbe\x1d\xcf\x16\xdb\x3a\x6e\x7f\x25\x35\x17\x81\xfc\x51\x03\xdd\x67\xae\xc6\xd3\xb1\x26\x00\x55\xb6\x7e\xf8\xe4\xa9\x0c\xeb\x1f\x0c\xe0\xa0\x5a\x31\x2e\xfc\x02\x01\xf1\xe2\x4c\xa4\x04\xeb\x73\x10\xa0\x59\x31\x04\x25\x0d\xa2\x14\x6e\x1e\x82\xcd\xf2\xc1\xc2\xb7\x2f\x72\x3d\xc4\x1e\x84\x40\x88\x4e\xe6\xb5\x9b\xba\xcf\x90\x69\xb5\xc4\x78\xdd\x4f\x57\x74\x0a\x91\x87\x72\x6a\x84\xd4\x9e\x76\x7c\x86\x07\x68\x90\x5a\x25\x58\xc9\xbb\x90\x7e\x2c\xe3\x6d\x75\xf8\x4d\x71\xef\xe3\x9a\xc8\xe0\xd4\xa0\x25\x90\xb5\x67\x01\xbc\xb7\x62\xd0\x9a\x08\x72\x4b\x52\xc8\x34\x69\x85\x5a\x67\x25\x75\x90\xd8\xa6\x83\x83\xcd\x47\xf4\xcf\x6f\xd6\xd5\xa3\xa8\xe7\x37\x1b\x82\xc3\x42\xfb\x3c\xe3\xf5\xec\x88\x6b\x67\xb0\x9a\x3d\xe3\xe1\x6d\xfd\x76\x16\x21\x8b\x31\xb0\x30\x94\xe7\x2f\x88\x16\x85\xfb\x79\x36\xa1\x3e\x79\x1f\x57\x4a\x63\xc3\xbe\xa0\x3d\xc8\x4e\xa3\x64\x36\x1b\xbc\x08\x45\xfe\xce\x36\x5c\x58\x2e\x9f\x71\x20\x67\x65\xb4\xe0\x3e\x5e\xf3\x46\x7a\x86\xb7\x1d\xc4\x1e\x7e\x15\x46\x80\x53\xef\xf1\x8a\xd7\xad\x0b\xe5\x04\x35\xfb\x9e\x96\xbc\x5a\x8f\x22\xa8\x76\xc6\x9c\xcc\xb4\xb4\x37\x81\x9e\xe7\xbd\x3e\xfd\x29\x34\x1a\x0f\x94\x6a\xb8\xcf\xf3\x7e\xf1\xd0\xd6\x33\x6e\x41\xd2\x29\x28\xa8\x2a\xbf\x61\x54\xaf\x67\x33\x84\xa4\xaf\x84\xd4\xa1\xe9\x4e\xb2\x7d\x78\x76\x43\x7d\x84\xe4\xd9\xd9\xfa\xfa\xf4\xb3\xc3\x8f\xbd\x64\xf1\x5e\x7b\x76\x1e\x0f\x0e\x13\x51\x07\x9f\xf3\x0e\x67\x52\xc4\xf4\x11\xd2\x01\x54\x4b\x6c\xe9\x26\x5e\x7d\x66\xf7\x19\x97\x13\xdb\x7c\x77\x5b\xab\x38\x68\x79\xb1\x8c\x62\xb5\xc5\x0c\xa8\xdb\x0a\x3f\x7b\x33\x50\x16\xde\x84\xda\xf7\x8a\x58\x01\x07\x90\xe3\x25\x90\xe7\x2c\xce\x95\xbc\x96\x31\x47\x8f\xca\x05\x92\x9b\xa8\x2c\x7b\x8d\xf3\x51\xc1\x45\xa8\x64\xea\x24\xfd\x6a\x45\x25\xd0\x49\x3a\x82\xad\xd9\xbd\xe9\x1d\xa1\x29\x72\xd5\xb0\x08\x50\x6f\x93\x54\x67\x58\x5d\x80\x28\x6e\x67\x84\x04\xce\xfd\xb9\x1b\x31\x6a\x8b\xf1\x23\x2f\x38\xab\x2c\x1e\x56\xab\x67\x6f\x3e\x44\x66\xd1\x35\xb4\x56\xf7\x7a\x67\x95\x48\x79\x83\xec\x19\x9b\x0c\xd5\xfd\x9f\x3d\x9e\x8a\x4b\xeb\xfc\xbf\x31\x49\x44\x6f\x37\x57\x75\x1e\x6f\xfb\x6f\x9b\xea\x53\x69\x52\xa1\x19\xae\xc1\x16\xfc\xbc\x09\x15\xe5\xa3\x0e\x1e\xf2\xf3\xd7\xb2\xc4\xc1\xf4\x97\x61\x9e\xe3\xa8\x8d\x12\xed\xe0\x9a\xf2\x02\x59\x50\x5b\x00\x40\x0b\x11\xfd\x5e\xbf\xf0\xfb\xc9\x6e\x1b\xfd\x0e\xd7\x73\xf4\x3b\x2c\xe9\xd9\x10\x7f\xa0\x77\x27\x25\x92\xe8\x3a\x8a\x64\x88\xd2\xef\x55\x69\x92\xdd\xcf\xaa\x50\xa4\x50\x06\xdf\x84\x5a\xf4\xfb\xaa\x23\x88\x6c\xd1\xed\x2a\x32\x22\x37\xa3\xf1\x33\x1a\x17\xa3\x5a\x6e\xd7\x35\x4a\xe6\x88\x20\xae\x55\xc8\xba\x55\x66\x5a\x0b\x16\xe4\xc5\x12\x01\x35\xe7\x84\xdb\xd8\xed\xf3\xb4\xce\x7b\x8a\xbf\x41\xad\x12\x07\x8a\xef\xed\x73\x4c\xd9\x70\xda\xa9\xcb\x67\xb3\x6e\xac\x16\xbb\x67\x68\x9e\xc9\xca\xda\x94\x8c\x1d\xba\x17\x0b\x9f\x13\xed\xb0\x9e\x93\x29\xea\xc8\xf5\xdc\x69\x5a\x50\x05\x3b\xcd\x9b\xe0\x76\xde\x6b\x51\x93\xdc\x11\x90\xf9\x8c\x41\xd4\x82\x86\xee\x45\x4e\xc6\xbe\x59\x93\x2b\xee\x82\xa4\x39\x52\xf4\x70\xfc\xa2\x23\x15\x02\xd5\x6e\xf8\x2c\xb7\x98\x3b\x19\x99\xe2\xea\x3a\x8d\xfa\x02\xd2\x38\x14\x8e\xfe\x5a\x44\x87\xf1\x7a\x60\x87\xa5\x81\x05\x63\x49\xfd\xa8\x48\x0e\xf4\x14\x76\xb2\x43\x38\x58\x19\x5b\x62\x97\x65\xd3\xa5\x11\x3f\x00\x32\xdb\xb6\x88\x2b\xae\x6a\x99\x32\x2e\x92\xa7\x27\xdd\x6b\x63\x10\xd2\xd7\xa1\x5a\xb8\x78\xd5\x13\xac\x4f\x3f\x10\x4a\x36\xe8\x93\xa1\xc0\xdd\x15\x7a\x82\xb5\xd2\x2c\x5f\x74\x92\x22\xa8\x53\xd0\x01\xfb\x61\x10\xd5\x39\xe9\x19\xa6\xe5\xd1\x06\xf8\x65\x3d\x6f\xb6\x8c\xa6\xfd\x3a\x1f\xe3\xee\xf7\x97\x98\xd1\xfd\xb0\x12\x33\xea\xee\x14\xa8\xfb\xf9\x2d\x0a\xed\xbe\x8c\xff\x48\x2b\x9a\xfa\x03\x47\x82\x2f\xd2\x92\x6c\xa8\x1d\x29\xf8\xd4\x3d\x9d\x2f\x31\xfa\x47\xbb\x99\x35\x74\xef\x75\x2b\x87\xd6\x23\xa6\xfb\xdd\x35\x73\xba\x93\x64\xe1\xc4\x00\x43\x8d\x7e\xf2\x76\x79\xc3\x58\x98\xed\xb2\x2b\x33\xba\x77\x32\x85\xd1\xdf\x62\x62\xd6\x5c\x9d\xfd\x15\x57\xfd\x86\xf0\xb9\xf3\x34\xe5\x18\x9e\x9d\x10\x19\xf4\xd9\xc9\x49\x8a\xa6\xa4\xfb\x70\x8a\xd7\x59\xb7\x39\x34\x99\xea\xf2\x20\x8c\xb9\x39\x33\x77\x1c\x99\x33\xcc\x88\x79\x5e\xbd\xcd\x9f\x5d\x95\x52\xb1\xa2\xa0\x10\x6c\xd6\x99\xc6\xa9\x9b\x7a\x8e\xb1\x5a\xec\xe5\x57\xdc\xae\xa8\x2c\xb6\x72\xfe\xd6\xb5\x9d\x66\x2a\xb8\x2d\xca\xc4\xc0\x56\xaf\xbb\x5f\xcd\x89\x5d\x74\x3c\x3b\x6a\x5c\x84\xf7\xbe\x1b\xfc\xc5\x67\x94\x9b\xeb\xcf\x5b\xb1\xfd\xd8\xc5\x8d\x52\xd7\xfc\xcf\x9f\xeb\x15\x53\x59\x1f\x2e\xae\x9c\x4a\xa8\x1c\xa2\x08\xd2\x6c\x08\xb0\x15\xb5\x41\x55\x30\x33\xaf\xa4\x34\x7f\x0b\xde\x6e\x36\xbd\x45\x08\x83\x4a\xaf\xcb\x3d\x17\x78\xf4\xd4\x35\x81\x51\xd7\x4c\x17\x1b\xe8\x1d\x5d\xdc\xba\x74\xff\xa0\x47\x0a\x48\x50\xff\xbf\x62\xc6\x7a\x42\xc7\x40\x4b\xf3\xff\x8a\xa1\xdf\x5c\x13\xa9\x01\x88\xdf\x07\x69\x91\xc5\xa6\xf1\xa2\x07\x2d\x42\x28\xb3\x2a\x23\x2f\xea\xa4\xfe\x62\x3c\xfc\xf2\x1c\x28\x1d\x73\x18\xdf\x2f\x66\xa9\xfb\xa9\x17\x5c\xf7\x57\x1d\xe4\xc5\xc4\xe2\xb3\x7c\xbb\xce\x55\xf7\x7f\x31\xab\xd3\x87\xe4\x5f\xda\x0f\xe7\xbc\x98\x3c\x9b\xba\x7c\xbd\x60\xbb\x6c\x0e\x2f\xf8\x7d\x4f\x1b\x4e\x46\x8f\x24\x5f\xac\x0b\x16\x3f\xe1\xf1\x42\x57\xee\x02\x11\xf2\x11\x95\x86\x1e\x05\x78\xb1\x7b\x92\x19\xfb\x82\x2d\x43\xbf\x69\x65\x06\xef\x4f\x99\xe6\x86\x66\x5a\xad\xde\x2f\xf3\x45\x91\x67\xcb\xaf\xc9\xa0\x69\x5f\xc8\xd9\x44\x8a\xd9\x68\x4e\x5b\xfd\x50\x88\x6a\x8b\x05\x43\xc9\x07\xea\x57\x4b\x80\xa7\xab\xa9\x14\xc6\xc0\x56\x51\x1f\xa7\x66\x02\x88\x75\x46\xe3\xb1\x14\xa4\x68\x03\x0e\xbd\x85\xe4\xbb\xd7\x42\x73\x67\x32\xad\x2f\x0b\xbe\x34\xc9\x40\xa4\x6c\x1e\x88\xee\xcb\x5e\x7f\x7c\x89\x48\xf9\x05\x4b\xa6\x59\x38\x11\x8d\x85\x4e\xd2\x5c\x3b\x4a\x37\x2e\x1c\xc0\x2f\x91\x32\xdd\x49\x3e\x62\x24\xb6\xc1\x32\xdd\x03\x4c\x0d\x49\xa0\xcd\x12\x86\xf0\xb0\xd2\x68\x19\xef\x94\x6f\x5b\x2d\x33\x5f\x67\x52\x52\xba\x35\x8e\x11\x3f\x31\x53\x69\x16\x33\x14\x89\x9f\x98\x7f\x99\x77\x39\xb8\x23\x4a\x85\xf6\x0b\x06\x22\x36\x6b\x2f\x6d\x1f\xd5\x48\x79\xef\xa0\x32\xf8\x7b\x67\x17\x51\xdc\x5e\xea\xb3\x44\x2f\xae\x2e\x49\x2f\xba\x6c\xcb\xaf\x9d\x29\x96\x31\x27\x08\xaa\xfe\x4b\x3c\xb9\xa9\x3a\xe6\x55\x54\x6d\x23\xe0\xe0\x8a\x86\x94\x3b\x0e\x33\x7e\x0c\x0c\x56\xcd\x17\xb9\x0f\xf3\x12\x37\x0b\xdd\x83\x12\xdb\xec\xa9\x28\x13\x85\x69\x94\x0c\x3e\x41\x3a\xe0\x08\x56\x6a\xc0\x73\xd3\x15\xc8\x20\x03\x2d\x32\x93\x22\xc9\x9b\x73\x5a\x53\xb0\xe0\x27\x11\x34\x9c\x4d\x27\x42\xbe\x63\x00\x76\x6f\x79\x81\x5d\xdb\x1d\x34\x6f\x44\x89\x2d\xe3\x05\xa4\x0c\x0b\x25\x65\x58\x00\x6a\x0f\x5f\x2c\x74\x2f\x85\x5c\x29\x5e\x0a\x2d\x13\xdd\xf8\x2e\xa7\xf6\x60\xc2\x4b\x39\xa9\xb6\x2f\x61\x55\xc0\x12\x2d\xd9\x83\x94\xce\x20\xd2\xe2\x87\x7c\x38\x86\x54\xa7\xb4\xd7\x61\x5e\x0a\xdd\x21\xc5\x3f\x19\x03\xba\xd8\xbe\x14\xf5\xcb\x7b\x29\x53\xdc\x5c\x53\x9e\x5f\x4a\xa7\x8c\x31\xa8\xc5\x58\x60\x35\xe8\x7a\xab\x70\xaa\x47\xfe\x21\xb2\x16\xd0\x31\x50\x0c\x81\xf1\x37\x28\xc9\x2a\x7c\xb3\x78\xdd\x88\xcc\x1f\xca\xe2\x12\x76\x48\xca\xa8\x1c\x39\x3b\xa9\xb0\xee\xcf\x95\xd3\x7a\x47\x39\xac\xf5\x02\xb1\x4e\x71\x34\x54\x74\x10\x93\xcb\x19\xf2\xe2\x88\xbb\x81\xf4\xcf\x4e\xb6\xed\xe0\x8e\xbd\x51\x15\x00\x5a\x26\x62\xb9\xdf\x63\xb5\x1e\x06\xba\xf0\xb1\x65\x86\xf6\x26\xa1\xcb\x44\xab\x32\xd2\x83\x9a\x94\x8e\x08\x05\x52\x80\xd0\xbc\x7a\x39\xe4\xb7\x12\x5c\x64\xa7\x0b\xb5\xa2\x5a\x2c\x55\x79\x01\x56\x37\x49\x19\x74\x6f\x0a\x7a\x33\x36\x53\x64\xbd\x13\x78\x34\x7e\x2b\xd3\x65\x1d\x16\x88\x03\x04\x85\x11\x67\x0d\x6d\x30\x35\x0e\xf5\x19\x01\xdc\xe6\x69\x39\x54\x16\x8f\xf0\xa3\x09\x53\x69\xed\xb1\xea\x51\xfd\x51\x0c\x2f\xf2\x9f\x5f\xff\x05\xd0\x2a\x27\xf9\x11\xa6\xa3\xf9\xa8\x07\xb1\xc7\x41\x9e\x97\xa3\xf6\xab\x07\x03\xc7\x01\x0b\xa4\x1a\x90\x19\xc9\xf1\x04\x03\x3c\x8a\x45\x62\x51\x20\x76\xff\x64\x05\x39\xca\x4d\x6c\xce\x09\x80\x36\xec\x42\x6f\x41\xe9\xc5\x8d\xde\x36\x8a\xad\xfd\x47\x5c\x5f\x56\xa7\xfb\xe3\x00\x33\x99\x36\xe9\x10\x71\xac\x23\xb1\xf6\x3c\xe3\x0e\x1a\x15\x14\x57\x8b\x68\x57\x28\x00\x51\xc4\x65\xef\x68\x4f\xc6\xb3\x77\xc8\x11\x3e\xcb\xfd\x80\x54\xcc\xa3\x45\x3c\x9a\x5b\x7f\x35\x46\x8d\x7d\x39\xee\x84\x73\xf1\xb9\x8c\x27\x41\x78\xf0\xed\xe3\x70\xec\x8f\x57\x8e\x76\x0a\xce\xbb\xd5\x60\x8b\x7a\xb4\x53\x91\x37\xc2\x8f\xd6\x05\xad\xa8\xfd\x2f\x2e\x2d\x80\xe7\x2d\x46\xb0\x3e\x6e\xa0\x29\x78\x1b\xe2\x82\x4d\xed\x87\x02\xc9\xd5\xe3\x67\xcd\xd0\x24\x36\xd6\x1b\xbd\x47\x1b\x67\xa5\x7e\x22\x37\xcb\xd9\x89\x4b\x3b\xa7\x98\xba\x19\x24\xba\xf4\xd1\xa6\x95\x8f\x08\x8f\x36\xc1\xd3\x05\x04\xb9\xec\x79\x5e\xd3\x8e\xf8\x71\x49\x11\x9f\xc7\x19\x8f\xe0\x82\x47\x17\x82\xea\x68\x6a\xbf\x9c\x7c\x9c\xe5\x64\xff\x88\xdf\x08\xf9\x2c\x95\x46\x71\x67\xb7\xc8\x63\xf5\xc7\x59\xb4\xe0\xe3\x5c\xf0\xc3\xa4\x60\xb9\x93\x4b\xce\xf0\x82\x73\x74\x63\xfd\x01\x46\xa1\xa5\xd8\x0c\xd8\x5c\x77\x74\x93\x4b\xff\xa5\xb5\xe5\xe8\x7c\x75\xb9\x38\xba\x76\xe4\x7c\x38\xba\xba\xa7\x3c\xba\xc5\x8d\xb8\xbc\x79\x74\xcb\x59\xbe\x5a\x62\x10\x9d\xe1\xe8\x96\x15\xdf\xc9\x14\x73\xf8\x7d\xd6\x26\xa8\x08\x56\x1d\xff\xc8\x93\xba\x12\x9c\x51\x5d\x28\x8e\xd5\xf5\x1e\x54\x73\x91\x3e\xaa\x18\x64\x8b\xde\xd1\xad\xb3\xbc\xf1\x4d\x24\xc6\xb3\x50\x2d\x51\x42\x7d\x88\xb6\xb9\x93\x73\x64\xa4\x53\x4d\x5f\xc7\x73\xfb\xe5\x3c\xa1\x51\xdf\x73\xf9\xaf\x08\x04\xa2\x90\x84\x4c\x30\xe0\xd5\x7a\x48\xb5\xc5\x24\x33\xd5\x1d\xce\x71\x71\x72\x00\x7a\xc4\xef\x0c\xce\xae\x12\xc2\x8c\xbf\x84\x90\x3e\x8e\x23\x04\xe4\xe1\x18\xed\x1c\x60\x19\x02\xeb\x1c\xf9\x7a\xe1\x31\x7a\xcc\x40\x22\x3a\x51\x19\x75\xab\x05\x76\xea\xd6\x6d\x60\x11\x81\x72\x54\x2b\x73\x31\x2e\x8b\x13\xc5\xee\x28\x6f\xc7\xc8\x1c\x8a\x31\xfc\x8a\x11\x44\x6d\xde\xa8\xbf\x2b\x7c\x8c\x1b\x1e\xe1\x35\x87\x63\xbf\x75\x3e\xe2\x76\x0b\x7e\xc7\x0b\xfc\x19\x5e\x02\xda\x88\xdc\xcb\x01\x57\x33\x7d\xdc\x04\xbb\xbe\xe7\x88\x53\x4b\xcb\x68\x30\xef\x2d\x88\xce\x5e\x24\xe1\xa4\xcf\x6d\x29\x8a\x63\x97\xc8\xc0\xbf\xb8\xd0\x82\xab\x2c\xee\x60\x6e\x58\xde\x54\x10\x24\xdb\x00\x41\x3a\x36\x2a\x6a\xa0\x7b\x13\xbd\xe7\xb4\x94\xa0\xc3\x77\x85\x84\xd8\x5f\x4e\x5d\x4e\xd9\x75\xa1\x9f\xfb\x44\x95\xb3\xf8\x89\x14\x32\xc3\x11\x88\x0d\x38\x9c\x68\xb4\x06\xbd\x34\x4f\x28\xa3\xa3\xbb\x15\x5b\x18\x3c\xf1\x18\xac\xfb\x87\xab\x60\xab\xd7\x72\x8e\xab\x53\xe7\xfd\xe3\xfa\x9b\xa2\xc1\x9a\x8f\x93\x7d\x8f\x40\x6d\x00\x06\x5d\x4c\x79\x75\xa3\xd2\x49\x80\x1e\xa2\x31\xcd\x7e\x1b\x44\x3b\xe9\x43\x47\xed\x4c\x0a\x30\x03\x59\x3b\x41\x6f\xc3\x8c\x9f\xbd\xa2\x5b\x94\x14\x48\x9e\x47\x4c\xed\x0b\xbd\x7b\xcb\xa9\x89\x00\x16\x4a\x0b\x89\x91\x5d\xc5\x58\x8f\x38\x67\x62\x88\xb4\xba\x70\xaf\xa4\xa0\x2a\x27\x50\xc7\xc1\x66\xb5\xd6\x1e\xd7\xdf\x7e\x19\xee\xd8\x0f\x6b\xb8\x6d\xce\x79\xde\x29\xc9\x8b\x28\x42\x6b\xb4\x6e\x2f\x4b\x70\x6e\x5e\x3d\x84\x2f\x5d\x24\x98\xe5\x72\x51\x9a\x7e\x4c\x9c\xb8\xcd\x87\xbb\x26\xad\xe7\x4a\x04\x44\x0c\x10\x59\x77\x5d\x14\xa2\xda\x5a\xc6\x8d\xb6\x13\xfc\xa8\x49\xc2\x64\x73\xb6\xde\xa0\x19\xf0\x29\x6a\x92\x4d\xa8\x42\x83\x69\x8e\x87\x6d\x73\x15\xd9\x80\x97\xa8\xdd\xfe\x38\x66\xb3\xe2\xa6\xff\x31\x9b\x74\x96\x24\x93\x24\x52\xd0\xe4\x62\x9f\x3b\x66\xab\xaf\x6a\x1c\xb3\x3e\x3a\x51\x9b\x85\x38\x18\x2d\x3a\xb4\xc1\xf8\xd8\x5b\x70\xb2\xb5\xd3\x60\xd3\x6d\xa6\x27\x82\x17\xcd\x94\xdd\xa9\x3e\x39\x7d\xc4\x4e\xbc\x4e\xb5\xdc\x66\x44\x76\x6b\xf1\x52\x6d\x2a\x64\xad\x0e\xd6\xf9\xa1\xfd\x78\xcb\x11\x3e\xac\x6a\x51\x38\xe6\x64\x4f\xed\xd9\x65\xc0\x35\xc3\x82\xad\x87\xfe\xcc\x99\x11\xe3\x43\x40\x34\xde\xc3\xe6\x4e\x16\x01\x61\xb5\xce\xaf\xb8\x96\x9e\x18\xc3\x10\xa5\x34\x9d\xfb\x80\x20\x19\x4e\x17\x09\x74\xdb\x1f\x8e\xd4\x0b\x64\xe1\x64\xbc\xd6\xa1\xa8\x2f\xe0\xbb\xc5\x51\x90\xbc\x71\x72\xc4\xef\xc4\x66\x69\x99\xbd\x9e\xc2\x1d\xcb\x69\x8c\xbe\xbd\x04\x7d\x2c\xa7\xad\xb9\x67\x0b\xea\xe4\x54\x39\x65\x9b\x58\xe5\x2a\xf8\x65\xa3\xfa\x72\x1f\xc3\xee\x44\x42\x18\x17\xa1\x32\x73\x39\x44\xc7\x32\x21\x1d\x1f\xe5\xad\xbf\x90\x75\xec\xdf\xea\x3f\x1c\xe5\xad\x7d\x69\x21\x7a\xac\x5c\x85\xa2\x00\x5c\x79\x5c\x14\xcd\xf2\xee\x89\x93\xa2\x13\x53\xf3\x21\x20\x13\x4d\x5f\x46\x87\x91\x80\x43\xfa\x4d\x24\x33\x38\xdd\xf0\xe1\xca\x4f\xba\xe3\x71\x6c\x4e\x43\x20\x55\xbf\x7d\xb7\xd9\xeb\x65\xb9\xe3\x2f\xfc\x60\x24\x0c\x8d\xf4\xc1\xbe\xd4\xf1\x4b\xe5\xd9\x97\x75\xde\xdb\xef\x41\x0a\xd6\x31\xcd\x48\x76\xae\x04\xda\x9b\x1f\x88\x8a\x11\x5f\x09\xd9\x0d\xed\x41\x87\xc6\xc5\xf3\xc5\x8a\x44\x81\xdc\x03\x7e\xcb\x66\xcb\x58\x21\xd4\xf8\x74\xdc\x03\x3b\xa1\x42\x6a\xca\x37\xc4\xa8\x6d\x5f\xb1\xfc\xba\x41\x8d\xd0\x32\xb9\x98\xe8\x0a\xeb\x46\x4f\x19\x32\x9c\x15\xaa\xad\xec\xb8\x27\xac\x73\xd3\x9e\xcc\xe1\x15\x0e\x8d\xdd\xcf\x79\xbd\x9a\x05\xfb\x84\xc3\x2b\x36\x4f\xdc\xe6\xaf\x38\x3e\xa7\xcd\x3d\xa9\x5d\xaf\x66\xad\xef\x85\xbd\x9a\x6c\x77\x83\xb3\x23\x44\xcb\x3e\xd2\x4f\x36\xbf\xe2\x45\x7e\xf9\xf4\x17\x14\xe2\x57\x83\x1b\x63\xcc\x81\xf9\x57\x2a\xfa\x6a\x9b\x8f\xc2\xab\xed\x1e\x5f\x7f\xb5\x18\xda\x90\x7c\x87\x57\xeb\xed\x62\xd2\x99\xb6\x84\xaf\xb0\x38\x52\x5e\xd6\x77\xaf\xd1\x08\x6a\xe3\xe8\xd5\x06\x7e\xdb\xa1\x08\x2a\x4d\x2b\x7c\xb5\xa1\x88\xd9\xe9\x95\x8c\x3b\x74\x58\x4d\x01\x40\xfd\xd2\x45\xe1\xad\xb0\x00\x83\x54\x02\x74\x9b\xaa\x82\xa9\x37\x98\x16\x59\xf6\x8a\x1f\x64\x34\xe2\xd5\xf8\x7a\x79\x1a\xf2\x8a\xfd\x50\x4f\xd7\xca\xa7\xce\xd4\x83\x58\xea\xfb\xf1\xda\xdc\x04\x1b\xf9\x7f\xce\x1e\x5e\xed\xb6\x99\x2e\x01\xfc\xb4\xbb\x9c\x6f\xbe\xc2\x31\x02\x01\x35\x64\x6a\xbe\xd6\x8a\x95\x14\x41\x45\xec\x54\x29\x9d\x0a\xc4\x96\xa7\xd5\xa8\x02\x33\x56\x46\xe9\x56\x6c\x2f\xb7\xad\x89\x8c\x8e\x21\x71\x15\x72\x9f\x08\xd6\x82\x08\x94\x35\x5c\xa1\xd4\x55\x10\xfa\x97\x72\x5a\x82\x93\x9f\x24\x7a\x9d\x4d\xa1\xa2\xcd\xa6\x2c\x70\x28\x95\x89\x07\xbe\xfc\xd6\x0a\x55\x03\x03\xdf\xe0\x36\x92\x68\x29\xcc\xe8\x7a\x9a\x30\xf5\xbd\xe6\x59\x19\x22\x3b\x99\x83\x19\x25\x8e\x34\xc4\x70\x83\x09\x9a\x81\x3c\x8f\x27\x34\xe9\x89\x35\x48\x9a\x4d\xdb\x06\xba\x72\x7f\x88\x2f\x9c\x8b\xc1\xf7\x0f\x56\x2d\xb8\x72\x1b\xe3\xb7\xa4\x7f\x8f\xdf\x3b\xae\x0a\xc7\xbd\xc1\xae\xa9\x00\x65\xad\x48\x7f\x95\x96\x61\x77\xc0\x22\x8c\x7a\xa9\x4a\x30\x76\xfd\x1d\xd8\xd6\x1a\x75\x94\x9f\x8c\x81\x37\xf8\x05\xeb\x1f\x35\x52\xf6\x3f\x38\x24\xd3\x5c\x76\xdb\xff\x60\x97\xdf\x98\x7d\x4f\xf1\x65\xe3\x6e\x8a\x12\x73\xa2\x87\x70\x2f\xaa\xdb\x69\x96\xc2\xd0\xa5\xac\x7e\xe5\x6d\x7d\x42\x4f\x19\xbf\xe1\xe6\x61\x2f\x9c\xdf\x33\xee\x46\x55\x67\x67\x65\x4c\xba\x9d\x90\x17\x01\xcd\x1f\x8a\x43\xf5\xba\xfc\x45\x4e\xec\x5b\xa9\xf4\x3a\x6b\xfd\xf1\xa1\x4a\x69\x0e\xc4\x02\x5f\xcd\xe1\xc2\x93\xe5\x6a\x88\x65\xfb\x8d\xa5\x13\x53\x78\x72\x6f\x82\x31\xaf\xe5\x42\x37\x31\xd6\xaa\xdb\xe7\x4b\xbe\x8f\x4c\xbe\xdb\x60\x4e\x32\xff\x09\xf7\x1d\xc6\x3a\x81\xd0\xe4\xcc\xf0\x3a\x3b\x7e\xc2\xb3\x4a\x09\xc1\x7d\xeb\x0a\xab\xf6\xbd\x8b\x86\x2e\xcf\xbc\xce\xee\xf2\x29\xe3\xc6\x68\xc9\xc5\xff\x91\x9e\xf0\x54\xda\xb8\xf8\x8f\x0c\xe8\x60\xe5\xf5\x72\x46\x77\x52\x51\x49\x4d\x8f\x37\x90\x44\xb1\xc7\x24\x91\xe4\x08\x43\x54\x2b\x4d\x95\x5e\x2e\x8e\xf4\xfb\x55\x81\x69\xd9\xb1\x83\xa6\x9f\x27\x22\x02\xe3\xbb\xab\xc9\xc5\x38\x76\xf4\x43\xab\x2d\xe3\x76\x41\x5c\x91\x2e\x50\x02\xdb\x7c\x62\xdc\x6a\xef\xe9\xf1\xa1\x77\xa9\x4b\xfb\xe9\x43\x42\xed\xa2\x30\xc1\x9a\x83\x0c\x69\x27\x9e\x13\x08\xc4\x22\xd2\xd7\x95\x94\x29\x8e\x23\x6f\x42\x70\x0e\xf5\xea\xdb\xab\xfe\x88\x48\xf7\x51\xf7\x46\x68\x85\x5d\xc7\xe8\xce\x0e\x61\xa2\xdc\x11\xa9\x0b\xd8\xec\xba\x8f\x45\x5d\x60\x8d\xb0\x63\x48\x4d\xf9\x8c\x11\x7c\x1d\xcc\x2e\xbe\x5b\x0d\xd5\xc1\xea\xe4\xe2\xf9\xeb\x1c\xe9\x96\x10\x55\x76\xdf\xe0\xcc\x08\xaf\x43\x73\x78\x75\x61\x34\x64\x93\x04\xe5\x92\xfc\x64\xd1\xe1\xd5\xe1\xd1\x2b\x59\x3c\x99\xa6\x38\x5b\xf6\xf2\x5f\xc3\xf2\xc5\xe5\xab\x57\xfc\x36\x27\xb7\x73\xe7\x47\xf7\x8a\x9f\x04\xe1\xf8\x31\x65\x57\x6f\x1a\xbd\xc6\x94\x0b\x77\x46\x4c\xb8\xcc\xb0\xe1\x7e\x12\xf5\x57\xfc\xd0\x6f\x13\xe6\xa4\x58\x29\x5f\x21\xfc\xf7\x5e\x1f\x4c\x26\xfc\x2c\xcd\x6b\xe1\x35\xd9\x55\x24\x16\x22\xb5\x5f\x3d\x66\xac\x36\x0c\x06\x74\x32\xcb\xa4\xaa\x73\x10\x23\x36\xed\x42\x36\x3b\xaa\x20\xcd\xa4\xd8\x95\xd6\x75\x26\xa4\x68\xbb\x3e\xc0\xf1\x9a\x76\x2d\x04\x5e\xd4\xc3\x5d\x4a\x78\xfa\xc3\x04\xf7\xba\x95\x76\x79\xf7\x15\xbf\x94\xd7\xe9\x52\x04\xb5\x69\xca\x49\xaa\x5c\x4e\x68\x2e\xd2\x2f\x0b\xde\xa0\xd7\xa2\x02\xe0\xf1\xfe\xd7\xb2\xd8\x2e\x0d\xf8\x6c\xc8\xae\xe3\xb5\x6e\x8a\x88\xaa\x75\x29\x49\x5e\x90\x33\x87\xd7\x56\xe8\x3d\xe9\xed\xd9\x3f\x47\x5c\x3c\x1d\xcc\xe1\x4f\x8f\xd3\xdf\xfa\x56\x32\x24\xc6\x9f\xed\x09\x72\x90\xc1\x1c\xfe\x5c\x4e\xb1\x5d\x60\xfd\x73\xb1\xfc\xb6\x1b\x11\xbf\x60\x85\xff\xb3\xbe\xb3\x48\x09\x24\xc3\xd6\xc8\x3f\x93\xd5\x9b\x20\x7f\xa6\xee\x10\xf8\xcf\xce\x45\xe6\xcf\x54\x4e\x25\xb1\x89\xf5\xcf\x6d\x29\x50\xf0\xff\xcc\xc3\x2c\x0d\xf8\xc3\xa8\xca\xfb\xc3\x4c\x3a\x7b\x7f\x60\x37\x2e\xaf\xab\xfe\x30\x0b\xcc\x4d\x32\x07\x7f\x74\x2f\xe7\x80\x16\x39\xfe\x03\x96\xce\xdd\xbe\xa3\xac\x3f\x2c\xe6\x1c\x37\xc3\x0f\xfc\xc4\x89\x9e\x8a\xfd\x20\xad\xc9\xae\xcd\x61\x87\x38\x18\x85\x88\x99\x52\xe9\x7d\xf4\x84\xa1\x3f\x3b\xff\x43\xb7\xdf\xa2\x4f\xfd\xb0\x5b\xbb\x71\x59\x01\x36\x8a\x04\x9a\x2b\xcb\x0f\x9b\xab\x95\xd3\x78\x7b\xf8\x21\x85\x71\x43\xc6\x2f\xc7\xc0\xe2\xfc\xc3\x85\x71\xde\x0d\x84\xa9\x1c\xe0\x83\x13\x4f\x31\x98\xc3\x0f\x97\xba\x3b\x1a\x84\x3a\xab\xc1\x0f\x07\xa7\xe1\x2e\x78\xc3\x45\x01\x87\xcf\xba\x1f\xd6\x07\x4a\x56\xdf\x5e\xf8\xe1\x72\xae\x65\x8d\x93\x85\x50\x31\x87\x1f\x11\x37\xaf\x9d\xd2\x65\xa8\x67\xbf\x3f\x62\x91\xed\xc5\x8f\x42\x71\x5a\x76\x8c\x75\xd3\xf7\x97\x19\x47\x88\x96\xbf\xcc\x79\x63\xb3\xfd\x5f\x38\xb0\x63\xa2\xc8\x9b\x8b\x4a\x51\x82\x7f\x59\x12\xde\x7f\xe1\xaa\x9d\x30\x1c\xff\x38\x2b\xa5\xff\x17\xdd\x0b\x67\x7e\xf8\xa0\x8d\xfa\x5f\x72\xff\xab\x51\xdc\x60\x7f\xb9\x69\xf2\x76\xfb\x15\xf9\x15\xc0\xbf\x9c\x5f\x62\x86\x35\xed\x2f\x47\xbf\x28\x3a\x6e\x67\xbe\x85\xff\x57\x9c\xfd\x9b\x99\x53\xd6\xa7\x1e\xfe\x8a\x9e\x5c\x84\x10\x04\x9d\xe3\xaf\xe8\xe1\xbb\x41\x84\x1c\xf1\xfc\x15\xbd\x84\xb4\x54\xe2\xb8\xcd\x12\x1f\xd7\xc8\xf0\xc4\xa9\x33\x87\x7f\xab\xb3\x19\xc6\xee\xd2\x20\x3a\xe1\xdf\x9d\xd7\x19\xd3\xfa\x06\x32\x90\x06\x58\xbc\x8d\x86\xf7\x91\x1d\x03\x59\x45\x41\x36\x45\xb5\x22\x09\xc9\x70\x21\xdc\x0e\xff\x86\x81\x2b\xf4\xaf\x9b\xff\x7b\x17\x4f\xb2\xdf\x01\x46\x38\xe3\x36\xe2\x05\x73\x39\x76\xb6\xbb\xfd\x07\x03\x8d\xfe\xc9\x5f\xe6\xda\xb8\x15\xec\xe1\xff\x23\x6f\xb3\xff\x27\xaa\x8f\xca\x7f\x62\xb0\xa3\x51\x72\x9a\xcd\x8a\xad\xb5\x39\xfc\xa7\xcc\x65\x93\xa3\x97\xbf\xcd\xbb\x49\x9f\x2e\x5c\x00\x67\x0e\x7f\x5b\x43\xae\x79\x4d\x01\xfd\x7b\xd6\xd7\x46\xff\x9e\x1d\x5e\x1b\xf5\xae\x08\x8c\x61\xda\xd8\x16\xf3\xf7\x1c\x61\x02\xf8\xdb\xe9\x2b\x7f\x1c\xc3\x85\x73\x7c\x97\xdb\xe0\x7f\x47\x38\x6e\x30\x5f\x5d\xfd\xfe\x8e\xa1\x3b\x0b\xfe\x3b\xe2\x17\x1c\xce\x12\x65\x7d\x73\x65\x3b\xfc\x0d\x69\xce\x9c\x12\x62\x98\xe4\xa2\xf0\xdf\x7b\xb7\x1d\x12\x40\x85\xad\x24\x5a\x13\x01\xdc\xa7\x7f\xef\x4b\x5c\x67\xe7\xcb\x76\xf8\xff\x07\x00\xb4\x1a\x23\x31\x02\x9d\x00\x00"),
 		},
 		"/bacteria_genera_homonyms.txt": &vfsgen۰CompressedFileInfo{
 			name:             "bacteria_genera_homonyms.txt",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 627376747, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 2014,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x94\xd1\x72\x24\xa7\x0e\x86\xef\x79\x2b\x7b\x66\x6d\xd7\x39\x9e\xdd\x29\xb7\xb3\xd9\xe4\x4e\x03\xca\xa0\x44\x8d\xba\x04\xd8\xc6\x4f\x9f\x12\xd0\xe3\x5c\xf5\x07\x0d\xe2\x97\xf4\xc3\x9d\x07\x4f\xc8\x0c\xee\xce\x17\x4a\xe2\x71\x8b\xc0\x35\xcf\xe1\xda\x3c\x66\x77\x17\x15\x53\x26\x70\x77\x2b\x70\xff\x6c\x91\x4a\x54\xfa\x30\xac\xcc\xa0\x36\x9b\xe0\x02\x98\x6e\x30\xa2\x2a\x7d\x8a\xcd\xe5\x82\x2a\x5e\xbc\xaf\xd9\xdd\x83\x27\xe6\x01\x05\x95\x02\xd5\xd5\xb8\x8e\x40\xf7\x40\xbe\x9f\x73\x0f\xfc\x97\xd4\x31\xa5\x45\x92\x01\xf2\x2a\xa9\x0c\x1a\x33\x92\x11\xdc\xbd\x82\x8f\x2d\x80\x2f\xad\x07\xee\xc3\x84\xeb\xfe\x67\xa8\xbd\xd7\x06\xa9\x74\x61\xf7\xd5\xc7\x84\x0a\xee\x00\x2c\xe3\xef\x01\x0a\x0d\xd5\x07\xa8\xbc\x8b\x3d\x20\xb7\x2d\x76\x48\x85\x36\x0c\xe0\x0e\x91\x61\x6d\x81\x8c\x24\x85\x5b\x5e\x73\x94\x8b\xca\x0a\xee\xc0\x10\xf6\xc8\x82\xa9\x8b\x3d\x48\x1c\x1f\x46\xe9\xea\x0e\xa2\x97\x99\xf6\x41\x3e\x46\x27\x0e\x2d\x17\xf1\xc2\x58\xb3\x3b\x02\xaf\x30\x14\x1f\x71\xc5\x62\x0b\x8f\x98\x82\x82\x2f\x94\xdd\x11\xf3\x3a\x02\x1d\xc9\xc7\x79\xda\x91\xb0\x7c\xda\x3a\x62\x7e\x6f\x23\xa3\x23\x6d\x2c\x1e\xb8\x7d\x4c\x6c\xb9\xef\x37\x9e\xfb\xb3\x9f\xed\x3e\x4a\x92\x37\x30\x9d\xdf\xc2\x3b\x68\xc8\x43\xd6\x37\x73\xc1\x05\xf5\x6a\x3f\x18\x6b\x89\xa8\x63\xef\xb7\x14\xbe\x20\x6f\xa2\xe0\x1e\x50\xaf\x35\xf7\x9c\x1f\x28\xfb\x68\x95\x7e\xa0\x5c\x66\xae\x0f\x2a\x29\x90\x17\x06\xf7\x88\x6b\x0f\xff\x48\xfa\x06\x43\xec\xa3\x04\xc6\xf7\xfc\x0f\x19\x6a\xe8\x51\x1e\x15\x22\xac\x04\xee\x09\x70\x85\xb2\x97\xfc\x09\x43\x2f\xb7\xfd\xc0\x82\xb3\x2c\x1d\xc5\x47\x15\x70\x4f\xc2\xb2\x61\xb7\xd8\x93\xac\x82\xb3\x48\x4f\xed\xab\x6f\x93\xb5\x25\x74\xff\x93\x84\x66\xf5\xff\x53\xba\x76\x31\xcf\xb0\x6e\xba\xd7\xeb\x19\x6a\x41\xbd\x88\x9a\xa4\x67\x78\x1f\x19\x3e\xe3\x56\xe4\x02\xd9\x16\x18\xfa\x08\x58\x70\x70\xaf\x4b\xa7\x4d\xae\x92\x06\x0e\x09\xcf\x58\xfd\x8e\x94\xbc\xa5\xfb\x4c\x76\x4f\xc6\xb9\x54\xe2\x2e\xf0\x04\x5e\x67\x61\x4f\xc0\xe0\x77\x8b\x9d\x86\x9b\xeb\xea\x4e\x90\x0b\x5d\xc7\x69\x27\xf8\x04\x04\x77\x22\xaf\xbb\x94\xc1\x33\x58\xe7\xe6\xf9\x8b\x7b\x6e\x9d\x87\x03\x3a\xe6\x2d\x82\xb5\xed\x24\x7f\xc3\x1b\xd9\x37\x15\x48\x62\x20\x43\xe2\x49\xf4\x3a\x5b\x76\xaa\xc8\x8c\x4a\xc9\x50\xc9\x43\x0d\x9d\x14\x1a\xb8\x53\xf3\x12\x50\x4d\x5a\xfb\x98\xf4\x1d\xdf\x7a\x83\xbf\x8b\x07\x0d\x1d\xc2\x34\xc7\x77\x29\xee\xc7\x4c\xf7\xc7\x16\x69\xd6\xe8\x0c\x3c\x9c\x62\x50\xfb\x57\x61\xe6\x64\xb8\x31\xfa\x59\xef\x33\x32\x5c\xf7\x6d\xc8\x4c\xfb\xd3\x72\x46\xbe\xe5\x75\xc6\x02\xd3\xfc\x67\x1a\x09\x9d\x19\xd2\x5e\xa6\xb3\x04\xf1\xb0\x65\x70\x67\xe1\x36\x6b\x64\x38\xc3\xaa\x14\xbb\xa0\xe7\xca\x6f\x94\x46\xf0\xe6\xd3\xde\x99\x17\xf0\x74\x11\xed\x29\xbe\x20\xf9\x88\xe9\x02\x3e\xda\x28\xc2\x25\xcc\x22\xbc\x44\x09\x72\x31\x6b\x76\x9a\x27\x77\xde\x55\xbe\x98\x23\x4c\xdb\x0b\xbd\xcd\x24\x5e\x24\xcf\x6f\xb1\x80\x0b\x6c\x7b\xdf\x16\xd0\xfd\x12\x2f\x3e\x52\x42\x5b\x66\xf4\x29\x99\xb6\x28\x69\x0e\x46\x0a\x8b\x8f\xef\xa0\xfd\xa9\x58\x3c\xa3\xde\xa6\xdb\x2c\xe3\x82\xba\x59\xa1\x97\x58\x4b\x61\x7c\x17\x1d\x07\x52\x82\xf1\x24\x2c\x3d\xe8\x4d\xeb\xc2\xd0\x5d\xbc\xf4\xf1\x8c\x31\x78\x8b\xa2\x35\xbb\x65\x23\xa5\x6e\x58\xa3\xe1\x4e\x18\x3c\x56\x17\xa8\x2a\x9e\xc1\x1c\xb1\x8c\xc4\x97\x42\xd7\xfd\x0d\x5c\x6e\x26\x5f\x8a\xda\x4d\x9a\xc9\x8e\xc1\xd4\x5f\x1a\xcf\xdb\xb8\xb4\xf5\x42\x52\xb0\xd3\x16\xdb\xdc\xda\x52\x31\x45\x35\xbb\x57\x2c\x37\x0f\xbd\x46\x60\xc8\xd9\xfa\x31\x06\x9b\x6c\x91\x18\xdc\x6b\x44\x5d\x6d\x8e\x38\xa0\xdd\xf7\x57\xb5\xc7\x76\xdf\xd6\x07\x01\xf3\x6a\x7d\x1c\x23\xcb\x16\xfe\xc3\xb7\x65\x79\x23\xfb\x51\x2f\xa8\xbb\x25\x7f\x4b\xc1\x6e\xcd\x4f\xb8\x4e\x1b\xfd\xc4\x7c\xf3\xeb\xef\xc4\x4c\xb0\xda\x83\xf4\x0b\x6f\xd6\xfc\xd5\xb8\x4b\xab\xd9\xfd\x81\x9a\xc9\x34\xfd\x29\x49\x8a\x9d\xd1\x59\xae\x2c\x08\xee\xdf\x00\x00\x00\xff\xff\x9e\x77\x58\xbe\xde\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x54\x5d\x77\x23\x27\x0c\x7d\xe7\x5f\xc5\xf6\x26\x39\x6d\x9c\xf5\xf1\xa4\xdb\x6d\xdf\x64\x46\x35\x6a\x35\x68\x8e\x00\x3b\xe4\xd7\xf7\x08\x18\xef\x13\x57\x7c\x88\xab\xab\x0b\x4f\x1e\x3c\x21\x33\xb8\x27\x9f\x29\x8a\xc7\x35\x00\x97\x34\xc2\xa5\x7a\x4c\xee\x29\x28\xc6\x44\xe0\x9e\x16\xe0\x36\xac\x81\x72\x50\xfa\x34\x58\x98\x41\x6d\x36\xc2\x05\x30\x3e\x40\xcf\xaa\xf4\x25\x36\x97\x32\xaa\x78\xf1\xbe\x24\xb7\x03\x4f\x6c\x97\xec\xc0\x67\x54\x9a\xa9\x2c\x36\x59\x7a\xa2\x1d\x90\x6f\xf7\xec\x80\xff\x91\xd2\xa7\x34\x4b\x34\x80\xbc\x48\xcc\x1d\xf5\x19\x49\x08\x6e\xa7\xe0\x43\x9d\xc1\xe7\xda\x12\xb7\x30\xe2\xb2\xad\x74\xb6\x3b\xad\x10\x73\x23\xb6\x2b\x3e\x44\x54\x70\x7b\x60\xe9\xab\x7b\xc8\x14\xdb\xe2\x1e\x0a\x6f\x64\xf7\xc8\x75\x0d\x0d\xc4\x4c\x2b\xce\xe0\xf6\x81\x61\xa9\x33\x19\x92\x38\x3f\xea\x1a\x51\xca\x2a\x0b\xb8\x3d\xc3\xbc\x65\x16\x8c\x8d\xec\x5e\x42\x1f\x18\xa5\xb1\xdb\x8b\x5e\x46\xd9\x7b\xf9\xec\x9d\xd8\xd7\x94\xc5\x0b\x63\x49\xee\x00\xbc\x40\x67\x7c\xc0\x05\xb3\x89\x71\xc0\x38\x2b\xf8\x4c\xc9\x1d\x30\x2d\x3d\xd1\x81\x7c\x18\xb7\x1d\x08\xf3\x97\xed\x23\xe6\x7b\xed\x15\x1d\x68\x65\xf1\xc0\xf5\x73\xc0\x9a\xda\x79\xc3\xe3\x7c\xf2\xa3\xdd\x07\x89\x72\x03\xe3\xf9\x6d\xbe\x83\xce\xa9\xd3\xfa\x66\x2e\xb8\xa0\x5e\x6d\x81\xb1\xe4\x80\xda\xef\xfe\x16\xe7\x5f\x20\xad\xa2\xe0\x9e\x51\xaf\x25\xb5\x9a\x9f\x29\xf9\x60\x4a\x3f\x53\xca\xa3\xd6\x67\x95\x38\x93\x17\x06\xf7\x82\x4b\x4b\xff\x42\x7a\x83\x4e\xf6\x45\x66\xc6\x7b\xfa\x8f\x0c\xea\xdc\xb2\xbc\x28\x04\x58\x08\xdc\x2b\xe0\x02\x79\x93\xfc\x15\xe7\x26\xb7\x2d\x60\xc6\x21\x4b\x83\xe2\x83\x0a\xb8\x57\x61\x59\xb1\x59\xec\x55\x16\xc1\x21\xd2\x6b\xfd\xd5\xb7\x81\xb5\x46\x74\xbf\x49\x44\xb3\xfa\xef\x14\xaf\x8d\xcc\x1b\x2c\xab\x6e\x7a\xbd\x41\xc9\xa8\x17\x51\xa3\xf4\x06\xf7\x5e\xe1\x1b\xae\x59\x2e\x90\x28\x75\xe8\x03\x60\xc6\x8e\x9b\x2e\x0d\xad\x72\x95\xd8\x27\x3b\x85\x37\x2c\x7e\x83\x14\xbd\x95\xfb\x46\xf6\x4e\xfa\xbd\x94\xc3\x46\xf0\x08\x5e\x87\xb0\x47\x60\xf0\x9b\xc5\x8e\xdd\xcd\x65\x71\x47\x48\x99\xae\xbd\x0b\x47\xf8\x02\x04\x77\x24\xaf\x1b\x95\x8e\x47\xb2\x86\xab\xe7\x5f\xb8\x79\xa1\xe1\xee\x80\x06\xd3\x1a\xc0\xda\x76\x94\x7f\xe1\x46\x36\xc6\x0c\x51\x0c\x48\xa7\x78\x14\xbd\x8e\x96\x1d\x0b\x32\xa3\x52\x04\x77\x2c\x4a\x1e\xca\xdc\x90\x42\x05\x77\xac\x5e\x66\x54\x23\x5c\x3f\x07\x7a\xc7\x5b\x6b\xf0\xbb\x78\x50\x7b\x4a\xef\x32\x0f\x73\xbc\x4b\x76\xdf\x47\xb9\xdf\xd7\x40\x43\xa3\x13\x70\x77\x8a\x81\xd2\x46\x85\x51\x93\xc1\x95\xd1\x0f\xbd\x4f\xc8\x70\xdd\x8e\x21\x33\x6d\x5f\xcb\x09\xf9\x51\xd7\x09\x33\x0c\xf3\x9f\xa8\x17\x74\x62\x88\x9b\x4c\x27\x99\xc5\xc3\x9a\xc0\x9d\x84\xeb\xd0\xc8\xe0\x48\xab\x92\xed\x81\x9e\x0a\xdf\x28\x36\xde\xa7\xea\xe3\xd6\x99\x33\x78\xba\x88\xb6\x12\xcf\x48\x3e\x60\xbc\x80\x0f\x16\x05\xb8\xcc\x43\x84\x73\x90\x59\x2e\x66\xcd\x86\xc6\xcd\x0d\x6f\x2c\xcf\xe6\x08\xe3\x76\xa6\xdb\x28\xe2\x2c\x69\x8c\xd9\x12\x4e\xb0\x6e\x7d\x9b\x40\xb7\x47\x3c\xf9\x40\x11\x6d\x9b\xa1\x2f\x49\xb4\x06\x89\x23\xe8\x25\x4c\x3e\xdc\x41\xdb\x57\x31\x79\x46\x1d\x82\x4d\xbe\x0e\x19\x27\xd4\xd5\x84\x9e\x42\xc9\x99\xf1\x2e\xda\x2f\xa4\x08\xfd\x4b\x98\x5a\xd2\x87\xa2\x13\x43\x73\xf1\xd4\xe2\x91\xa3\xe3\x35\x88\x96\xe4\xa6\x95\x94\x9a\x61\x0d\x75\x77\xda\x1e\x1a\x3f\xc9\x94\xa1\xa8\x78\x06\x73\xc4\xd4\x0b\x9f\x32\x5d\xb7\x3f\x70\x7a\x98\x7c\xca\x6a\x2f\x69\x14\xdb\x83\xc1\x3f\x57\x1e\xaf\x71\xaa\xcb\x85\x24\x63\x72\x53\x5d\xd6\x50\xc7\xd1\x1a\xb3\x31\x2a\xc9\x7d\x60\x7e\x78\xe8\x23\x00\x43\x4a\xd6\x8f\x1e\xac\xb2\x06\x62\x70\x1f\x01\x75\xb1\x0d\xc4\x33\xda\x7b\xff\x50\xfb\x6c\xb7\x63\x2d\x98\x31\x2d\xd6\xc7\xbe\x64\xd5\x6e\xdb\x46\xe5\x3d\x48\x2b\x29\xb8\x8f\x72\x41\xdd\x2c\xf9\x47\x9c\xed\xd5\xfc\x80\xeb\xb0\xd1\x0f\x4c\x0f\xbf\xfe\x49\xcc\x04\x8b\x7d\x48\x3f\xf1\x61\xcd\x9f\x95\x1b\xb5\x92\xdc\x5f\xa8\x89\x8c\xd3\xdf\x12\x25\xdb\x1d\x0d\xcb\x95\x05\xc1\xfd\x3f\x00\x9e\x77\x58\xbe\xde\x07\x00\x00"),
 		},
 		"/genera_auth_icn.txt": &vfsgen۰CompressedFileInfo{
 			name:             "genera_auth_icn.txt",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 627376747, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 65156,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\xfd\xdd\xae\xe3\x3a\x92\x26\x0c\x9f\xeb\x46\xea\xc8\x17\xb1\x7e\xed\x5c\xf6\xea\x2e\x74\x35\x7a\x80\x39\x0b\x49\x61\x89\xcb\x14\xe9\xcd\x1f\x3b\xe5\x9b\xf9\xbe\x9c\xde\x8d\xae\x9c\x79\x37\x06\x85\x3d\x48\x0c\x06\x39\xef\x89\x50\xf7\xf5\xe2\x79\x42\xce\xdc\x8d\x99\x03\x47\x3c\xa4\xf8\xcf\x60\x30\x48\x51\xf4\x83\xe4\x1c\xeb\xe4\x9a\x87\x56\x7a\xa7\x60\xa9\x13\xb0\xd2\x3c\xb4\xad\x64\xd0\x58\xe0\xe8\xd5\x6f\x1e\x6e\xee\xb6\xc2\xff\x24\xa3\xb4\xc0\x95\xd8\xf5\x86\x4f\xd2\xf7\x9a\x0c\x7b\x19\x9b\x87\xd6\x75\xa0\x5e\x3e\xf4\xd2\x3c\xb4\x49\xa6\xf8\x83\x8b\x81\xdc\x3c\x74\x7a\xd1\x3e\x6e\xfe\x29\xf6\x69\xf9\x6d\xa8\x7a\x6b\x1e\xba\x51\x92\xab\x78\x36\xea\x39\x8e\x1e\xc0\x79\xaf\xcd\x43\x77\xd2\x34\x49\x68\x1e\x3a\x1f\x7f\xa9\xf0\xa8\xcb\xdf\xa4\x79\xe8\x99\x14\x68\x0c\xe0\x61\xe5\xf9\x24\x17\x1d\x9a\x87\x5e\x27\x84\xd2\xd0\xc7\x74\x3c\x02\xcd\xcd\x43\x3f\xba\x93\x24\xd7\x3c\xf4\x6e\x58\xbe\xdf\xd4\x37\x0f\x7d\x4c\x1f\xcb\x17\x44\x9d\x3d\x0a\xdb\xdf\xb4\x34\x0f\xda\xc7\xe6\x41\xbd\xd7\xd0\x3c\x1c\x63\x70\x60\x37\xf5\x2c\xe2\x20\xa9\x1f\xc9\xae\xe2\xc1\xf3\xa8\x64\xce\x58\x46\xb3\x0d\x9a\xd0\x32\x43\x50\xef\x23\xb8\x1b\x63\x19\x53\xed\x6b\xf3\x30\xc4\x5c\x5c\xf3\x30\x24\xf1\xc6\x42\xbc\xe4\x93\x7c\x08\x1d\x96\x68\x75\x92\xc8\xfc\xca\x53\xd2\xe6\x61\x44\x9a\xa3\x6f\x6b\x1a\x9a\x87\x71\x92\xbe\x79\x18\x43\xf3\x30\x22\xb9\xb1\x22\x01\x67\xf9\xb9\x93\x5c\xe1\x9a\xb4\x79\x70\x29\xfa\xde\x81\xcf\xcd\x83\x2b\x68\x26\x37\x6b\x18\x90\xf0\x87\x05\x3f\x49\xc9\xf5\x24\x04\x35\x13\x68\xca\xcd\xc3\x69\x58\xbe\x87\xe6\xe1\x34\x4a\x70\x60\x05\x71\x4e\x2e\x32\x84\x9b\x05\x4d\x7c\x9a\xa3\x6f\x1e\x4e\xcb\xd7\xe6\xc1\x6f\x1e\x26\x45\xf3\xfa\xcd\x5f\x46\x1d\x5b\xb9\x01\xfe\xb3\xcc\x33\xe2\x79\xf4\xa2\x97\x1c\xa5\xaf\x49\x00\x21\x13\xbe\x65\x4e\x60\x65\x65\x12\xe2\x8a\x1c\xb2\xf5\x6d\x44\x07\xfa\x36\x69\x37\x32\x4c\xfd\xa5\x6a\xa2\x30\xf8\x4e\x7c\x4f\x1e\x13\x52\xef\x25\xc7\x84\xd8\xfd\x2a\x35\x00\x57\x4d\xea\x11\x51\x8f\xea\x7b\xf0\x0f\x09\x3d\x83\xe9\x29\x2b\x44\xd5\xeb\x67\x09\x94\x65\x7f\xd4\x80\x91\xe0\x8f\x31\x21\x2c\x0a\xe0\xce\x67\x30\x2f\x93\x84\x9e\x00\x29\x7b\x1d\x52\xed\x3a\x3e\xd1\x69\xf9\xd7\x48\x60\x4f\x72\xc7\xae\xf2\xde\x45\x56\xc1\x3b\x8a\xa7\xf7\x56\xa8\x09\x3f\xed\x51\xfd\xe9\x97\xea\x32\x0a\x17\xc3\xe0\xc8\x32\x12\x3a\x2b\xeb\x73\x76\x01\xb5\xcb\xec\x35\x9f\x4b\xaa\xe7\xe6\x81\x75\x61\xdb\x5d\x24\x09\x24\x15\x00\xc3\xc8\x5f\x14\xd2\xe3\x2f\x8a\x1a\x5c\x20\xb6\x93\x98\x28\x4c\x12\xe8\x2a\xb1\x79\x98\xda\x14\xb3\x03\xbf\xb2\x63\x27\x4d\x71\x02\xcb\xcd\xc3\xe4\x92\x40\xb2\xe0\x3d\x21\xb1\x89\x45\x9e\xe0\x0f\x31\x9b\x22\x02\xe5\x52\xcb\xad\x79\x08\x32\xc6\x5c\x13\x40\xf8\x13\xda\x31\x48\xe8\x37\x7f\x4e\x72\x92\x3c\xd2\xe5\x56\xdf\x8f\x79\x05\x65\x94\xb3\xf4\x93\x04\x69\x91\x6e\x90\xb0\x7c\xc5\x93\x2e\x36\x0f\xe8\x81\x56\x21\xdb\x40\x19\x6d\x49\x10\xef\x60\x45\x0c\x9a\x54\x7a\x97\x57\x14\xf2\xed\x34\x1b\xfe\x50\x34\x0d\xd0\xfa\xf0\xba\xf2\xf9\xc7\x83\xe5\xcb\xcf\xf0\x37\x75\x18\x7d\xee\x87\xe3\xfa\xd3\xf1\xf1\xd3\xb1\xfc\x6a\xec\x6b\xf3\x10\x14\x03\x2d\x1c\x1d\x95\x5b\x70\xd9\x99\x76\x0b\xde\x9d\xd0\xe9\x21\x86\x79\x8a\xd0\x14\x21\x53\xd9\x84\x0c\x45\x13\xca\x18\x03\xf2\x2c\x91\x9d\x1a\x4a\x84\x72\x70\x2b\xca\x5d\x35\xb4\xfc\x86\x3a\x96\x14\xcf\x4c\xfe\xe6\x9a\x87\xb3\x0b\xa8\xe9\xf9\x8c\x16\x3b\x9f\x91\xda\xf9\xcc\xf1\x72\xce\x45\xd1\xcb\x67\xe0\x92\x62\x2c\xcd\x43\x92\x81\xc2\x98\xc4\x81\x58\xf7\x27\x99\x5a\x49\x89\x3e\xf5\x03\x4f\x5b\x94\x35\xb5\x84\xf5\xc6\x1a\xa4\x4e\xc2\xa0\x28\x52\xea\x46\xc2\x55\x2d\xfd\x74\xce\x74\x30\x6a\x37\xba\x56\x30\x9e\x80\xbc\x85\x72\x18\xba\xa9\x43\xa8\xde\xa1\xbb\x14\xa8\x9a\xb7\x1e\x39\x2d\x24\x1d\x62\x44\x34\x0d\x90\xaf\xa4\xa1\xcf\x60\xb9\x1b\x63\x1d\x9a\x87\x34\xc4\x8b\xd3\x90\x51\xe5\xe4\x72\xd1\xa1\x3a\x2d\x48\xdf\xdd\x40\x31\x08\x91\xdc\x94\x4b\x8a\x01\x11\x82\x54\xa4\x17\x7a\xd4\x1e\x8d\x4a\x46\x61\x49\x21\xb2\x88\xc1\x74\x60\x0a\x9c\xdf\x52\x72\x32\x08\xb9\xf7\x77\x14\x59\x87\x14\x2d\xf5\x22\xad\x92\xb1\xcd\x8a\xa6\xb6\x62\x44\xa6\x32\x42\xde\x53\x61\xe9\x0a\xc9\xf2\xfd\xd6\x3c\x70\x64\xa6\x8b\x96\xcd\x3f\xc7\x7a\xc1\x1c\x92\x2e\xae\x37\x89\x4d\x9f\x9b\x87\x74\x93\xe0\x63\x6d\x1e\xd2\xf2\xd7\x00\xd1\xcc\x32\xba\x20\xe0\xd0\xa2\x54\x18\x0c\x8c\x81\x93\xc7\x76\x06\xe5\xb0\xcf\x27\x68\xa4\x99\x20\xb1\x9f\xf2\xd9\x57\x28\xa2\x9c\x57\x69\xcf\x18\xcd\xb9\xc8\xd1\xd1\x59\x46\x09\x92\x9d\x8d\x8f\x32\xba\xe5\xbf\x6e\xfe\x12\x2b\x1a\xaf\x38\x0d\xe4\x27\x17\xf2\xca\x90\x47\x29\x23\xe6\xc7\xda\x7a\x94\xbc\xb6\x69\xf9\x7a\xb1\xf9\xb7\xf6\xf4\xe9\x63\x85\x9c\x41\xe7\x56\x4d\xf9\xca\x8e\xaf\x83\x83\x24\x54\xaf\xa1\x95\xee\xd4\x3c\xd4\x5f\xaa\xf9\xe4\x82\xd0\x17\x4d\x8a\xe6\xbe\x68\x9a\x31\xc9\xfd\x44\x02\x98\x4f\x32\x9d\x01\x8a\xcb\x0e\xa2\x7d\x09\x8a\xcc\x2e\x29\x26\x46\x5f\xbe\x6e\x0e\x02\xe5\x2a\xa1\x34\x0f\x57\xc9\x65\x74\xcd\xc3\x67\xc5\xcf\x27\xc8\xd0\xe7\x3c\xb9\x32\x36\x0f\xb3\x86\x5c\xc1\x30\x97\xcc\x93\x40\x75\xcf\x45\x96\x7f\x6f\x1e\x6e\x6d\x3d\xb1\xa1\x6f\x5d\x9d\x9b\x87\x1b\x8d\x8f\xe6\x51\x10\x06\x34\x83\x82\xb4\xf2\x21\x01\xbc\x8b\xdd\x09\x1c\x6d\xf9\x28\xad\x0b\x03\xfa\xe1\x51\xda\xda\x3c\x4a\x27\x24\x89\x41\xbb\x51\x7d\x2c\x04\xd4\xaf\x00\xe5\x03\xcc\x0d\xe2\xeb\xd9\x01\x9e\x94\x3a\x8d\x28\x91\x8d\xb1\x66\x05\x62\xb2\xbd\x92\x3a\xcc\xc4\x8f\x98\x1b\xe3\x15\x79\x20\x80\x76\xa3\x67\x1c\x1d\x03\xd2\xd2\x90\xbb\xb1\x79\x94\xc1\x3b\x2d\x05\xc1\x87\x59\x82\x24\x01\x6d\x1e\x65\xd4\x93\x20\xf8\xe8\x9b\x47\x41\x04\xf7\x11\xa4\x20\x86\xa3\x8f\xd7\x99\xcc\x33\x63\xe7\xe9\x22\x0c\x8e\xf9\x7c\x08\x4a\xff\x71\x66\xe4\x8f\xa2\xe8\xb4\x47\xb1\x72\x9f\xc6\xea\x6e\x2c\xeb\x29\xcb\x4c\x36\x3a\x32\x50\x0f\x7d\xce\xd0\x5e\x4e\xc9\xe5\x31\xb0\x85\xbc\x4c\x9b\x7f\x90\x74\x59\xbe\xe8\x8d\xce\x90\x85\xfc\x56\x3b\xe3\x48\xc9\xb7\x4e\x58\x41\xdf\x0b\x66\x54\x00\x97\xc9\xac\x2f\x7c\x7f\x65\x39\xfd\x31\x56\x94\xc5\x3b\xf3\xf6\x24\x12\x0a\x54\x2a\x21\x7b\x15\x92\x0b\xca\xb4\x27\xe2\x38\xa0\x19\x7c\x19\xe5\xc6\x26\xf2\xd5\xba\x77\xe2\x3c\xff\x28\xd3\x19\xf9\x21\x8f\xd0\xa5\x78\x44\xa7\x86\x5e\x49\xa3\x51\x16\x30\xf4\x15\x4a\x91\x68\x8e\x67\xe9\xc7\x99\x6d\x11\x34\x7d\xa8\xae\x80\x01\x8f\x2b\x85\xc9\xf1\x28\xc1\xda\x30\x9c\x98\x4b\x40\x92\x49\x3e\x24\x6f\xde\x63\x12\xaf\x99\x6e\x6f\x54\xc9\x82\x16\xcc\x51\x84\xf1\x0a\x8e\x31\x26\x06\x1c\x0b\x93\x5a\xf6\x67\x6a\xad\xf3\x52\x6b\x8d\x93\x3a\xcf\x32\xa5\x63\xec\xc9\x6a\x66\xfa\xc3\x18\x61\x25\x3d\x4a\xb2\xc2\xa4\x93\xc9\x43\x3a\x5d\x63\xa2\x94\x24\xef\xc2\xc0\xec\xbd\x65\x19\xa4\x67\xbf\xa5\x60\x0d\x9b\x82\xb6\xb3\xf1\x6c\xac\x14\xf2\x51\x92\x81\xe5\x6b\xac\x0c\x49\x11\x4b\xd6\x6a\x89\xd9\xa5\xb5\x45\x53\x12\x0a\x41\x4a\x6a\x0d\x91\xb4\x18\x47\xee\x16\xd4\x62\xa7\x98\x57\xc6\xe7\x19\x1d\x4d\x40\xef\x22\x9b\x27\xf1\x53\x35\x87\xb2\xf5\xca\x78\xe7\xd1\xc7\x49\x59\x89\xe2\xd7\x52\x16\x54\x8f\x20\x7a\x29\xb2\x22\x67\x9c\x19\x96\x24\x13\xb9\x8d\xb3\x54\x19\x08\x35\x46\x26\xb9\x1b\x83\x0c\xcc\x20\xeb\x18\x25\x6d\xfe\x1c\xaf\x4a\x0d\x92\x47\x21\x3d\xae\x2d\x99\x5d\x60\x4d\xf2\xbd\x4a\x99\x23\x3e\x73\xac\xe4\x6c\xa3\x3a\x17\x6b\xd5\x0c\xc5\x52\xc4\x99\xd8\x16\xf1\x94\xf5\x02\xed\x62\x01\x0b\xf5\x0b\x80\xde\x79\xc0\x22\x60\x73\x31\x17\x12\x2d\x1f\x62\x12\x5d\x4e\x68\xac\x92\x88\xad\x1e\xa5\x48\xe8\x4d\x48\x4a\x11\x7b\x52\xd0\x0f\x04\x1c\xd3\xa5\x58\x45\x4a\x71\xb9\x98\x78\x95\x1b\x63\xd4\x6e\x8c\x67\x70\x0e\x84\xda\xcf\x7f\xff\x02\x6e\xcf\x46\x16\xb5\x4e\x24\x12\xc2\xe6\x31\xf6\x1a\x46\x75\xe6\x33\xa0\x63\x2c\xc4\xb0\xfc\x9a\x8a\x35\x4a\xcd\x0b\x93\xb8\x44\x0e\xf2\xcf\x85\xbe\x68\xe5\xd9\x90\xa7\xff\x9c\xc6\x78\x3c\xc2\x47\x85\xd5\x56\x23\x68\x33\xd0\x4c\xf1\x55\x61\xdf\xa9\xd4\xac\x6e\x08\x5a\x19\xbe\x5e\xd4\x82\x01\x38\x35\xc4\xec\xb4\xeb\x30\xa7\x3f\x2a\x34\x88\x76\xa7\xcd\xbb\x04\xf4\x6a\x81\x40\x68\x77\x62\x77\x6b\x77\x1a\x05\x82\xa5\x7d\x1f\xa1\x40\xb4\x87\x59\xf1\xa8\x7d\x90\xa4\xa7\xcd\x3f\x76\xe3\x8c\xd6\x53\x58\x4b\x8f\xaa\xa1\x7c\x20\x10\xcb\xca\x76\x54\xcd\x56\xac\x41\x13\x86\x92\x0e\xb1\x42\xf8\x75\x4c\x24\x1a\x50\x3a\xf7\xa1\xc9\x05\x16\xc4\xf9\x51\xd2\x8d\x00\x52\x00\xab\xee\x51\xf1\xc0\xd3\xdc\x7a\xb4\xec\xa9\xe0\x1c\x81\x4e\xcb\x5f\x93\x12\x46\xa3\xd7\x4c\xbe\xfc\x75\x52\x7b\x80\x79\xf6\xd1\xac\xb6\x47\xf5\x90\x6d\x76\xac\x86\xcd\x7f\xd6\x3f\x5d\x10\x84\x6b\x1e\x63\x1e\xa6\xfa\xa3\x06\xed\x4e\x7c\xa2\x3d\xb3\x0f\xda\xbb\xae\xac\xe0\xb3\xf1\xe5\x37\xf2\x4c\x0a\x69\xd0\x30\x62\xdc\x68\xf8\x40\x16\x00\x27\x98\xa7\x8f\x1a\x82\xde\x99\x71\x94\x24\x44\xac\x78\x1e\x95\xa6\xc7\xa3\x86\x42\x3d\xae\x58\x1a\x20\x11\xb6\x6b\x92\x9b\xc0\x12\x7e\xd4\x04\x09\x06\xd5\x00\xc1\x06\x2a\xb0\xe9\x1e\x35\xf5\x94\x07\xb4\x25\x92\xc3\x1c\xab\x69\x10\x3e\xe2\xa0\x23\xdb\xfc\x59\x53\x8a\x65\x75\xa9\x81\x21\x29\xa3\x0e\xe3\xca\x1d\x3b\x3b\x0d\x2c\x51\x3a\xa9\x89\x56\xc2\x54\xcd\x18\x5e\xd8\x61\xe4\xce\xd2\xf6\x8a\x21\xad\x09\x56\xac\xb1\xf5\x81\xe9\x4a\xe3\x6c\xa4\xb4\x16\x30\xac\xcf\xc7\xb5\x13\x53\x70\x5d\x37\x3a\xd6\x37\x98\x25\xff\xa8\x29\x69\xb6\x39\x49\x13\x45\x31\x15\xa9\x9e\xf1\x8b\x26\xb6\x40\x59\xcd\x0e\xa2\x95\xaf\x79\x43\xef\xf5\x2b\xa8\xe4\x34\x7c\x0d\x50\x19\x2b\x94\x1c\x2b\x43\x9b\x53\x4d\xdc\x32\xea\x00\xb5\xaa\x39\x33\xa5\x9c\xd9\x02\xec\x28\x68\x22\xb0\xbc\xf2\x82\x70\x15\x85\xad\xc9\x54\xaa\xd6\x64\x06\x90\x5e\x34\x5d\xdd\x07\x24\x67\x46\x13\x81\x4f\xa8\xe0\x4d\xa9\x6e\xc6\xff\x68\xb1\x8c\x58\xc0\x5e\x61\x74\xa0\x4d\xee\x60\x90\x8b\xfc\x00\x01\xa8\x90\x04\x19\x30\x77\x8f\x52\xcc\x5d\xa4\x1b\x25\xcd\x08\x5b\xf3\xa9\x16\x6d\x1e\x9d\xf8\x98\xeb\x29\xa0\x51\x5d\x77\xe2\x62\xe0\xd1\x75\xd4\x5d\xae\xe7\xc2\xe3\xd1\xc1\x20\x5b\x9b\xdb\x0d\x72\xbb\x39\x70\xe5\x94\xe7\x86\x8c\x09\xce\x0d\x57\x0b\xea\x07\x8c\x1a\x82\xe5\x7b\x81\x8e\x72\xab\x91\xe1\xbc\x37\xbb\xce\x79\xb4\x41\x26\x88\x61\xb3\xb5\xd6\x75\xde\xc3\xcb\x06\x98\x0b\x41\x43\xcf\x66\x01\x44\x2a\x31\xa0\x5b\xdc\x39\xc2\x76\x81\xf0\xb9\xf3\x99\x2c\x49\x8f\x4a\xba\xc4\x2a\x24\x98\xae\x8f\xce\x0a\x85\x15\xd2\xb1\x5d\x85\xdb\x5c\x47\x00\xea\x67\xc7\x19\xc6\xe5\x2b\xa6\x2a\x57\xa8\x53\xdd\x25\x32\x3a\xd8\x66\x95\x48\xe4\x7a\x83\xf4\xb8\xdb\x2d\xde\x28\x51\x1f\x02\x91\x6f\x1e\xbd\x74\xae\x23\x3b\x19\xe5\x9a\x87\xe8\xca\x86\xf4\x62\xc1\x4e\x9a\xbd\x12\x05\xd8\xc3\x60\xe3\xea\x8c\x64\x25\x86\xcd\x2e\x46\x58\x3b\x5e\xac\x2c\x5e\x3e\x5b\x1a\x54\xbc\x5e\x9d\xe9\x35\xaf\xae\xdc\x9a\x47\xef\xe4\x34\x52\x5f\x79\xd7\x53\xa4\x60\xe2\xe2\x01\x0d\x40\x50\xc5\xc4\xeb\xe3\x89\xcb\xa6\x47\x1f\xa7\x89\x9a\xd6\x57\x34\x82\xaf\xd0\xd2\xa0\xa9\x79\x8c\x52\xae\xc9\x0d\x63\x69\x1e\x63\x9b\x60\x94\xc6\xae\xc3\x5a\xf4\x31\x42\x8d\x60\x4d\x4f\x84\xa0\x48\x3d\x76\xbf\x54\x8c\x23\xf0\xd5\x38\x8e\x3d\x3b\x39\xf6\x68\xaf\x08\xcd\x1d\xa1\x18\x39\x1d\x47\xa8\xc6\x15\xb8\x0f\x04\xd6\x71\x32\x6a\xbe\x27\x94\x34\xea\xaa\x3c\xa2\x7a\xaa\xd4\x88\x8e\xcb\xca\xf0\x69\x14\xb9\x98\x9f\x97\xc1\x40\xc6\x38\x89\x9a\xf5\xea\x82\xa5\x30\x0c\x90\xfe\x38\x50\x6f\xc4\x21\xce\x72\xf1\x28\xfe\xdc\x3c\xc6\x91\x46\x42\xe4\x9e\xc2\x63\x44\x9b\x81\x65\x12\x25\xe5\x38\x26\xbf\xc8\xfa\x34\xbb\xbb\x9f\xd3\x0a\x50\x54\xc8\x2f\x0c\xf0\xc1\xa7\x68\x10\xab\x9e\xef\xc6\x78\x71\x85\xed\x4d\xb5\x12\xa9\x2c\xa2\xf7\x6e\xb0\x00\x05\x9a\x7f\x60\x9d\x3c\xe7\xbb\xe8\x21\xc4\xec\x1b\xb0\xb3\xb5\x22\x1e\x04\x99\x22\x59\x22\x85\xcd\x02\x46\x3f\x86\xe9\x25\x75\x98\x82\x88\x4c\xd9\x13\x96\xfc\xc3\x17\x50\x56\x7c\xd3\x8b\x6c\xde\x63\x28\x50\x39\xec\xa0\x60\x94\x3d\x19\x86\x35\x67\x77\x94\xce\x05\xa6\xe5\x2c\xb3\xa0\x93\x70\x3b\xee\x31\x06\x6a\xc4\x18\x62\xe2\x22\x2c\x86\xb3\xe9\xf9\x88\x80\x11\x7d\x1a\xc3\x04\x41\x8f\x31\x9c\x93\x0c\xb1\x25\x9c\x2b\x43\x47\x58\x7a\xf1\x7c\x4e\xcb\xd7\xe6\x31\xa2\x5a\xa9\x5d\xbe\xa0\xfa\xa9\x1b\xb3\x06\xce\x29\x31\x71\x93\xe7\x31\xa6\xd1\x51\x96\x92\xcb\x94\xc8\xe4\x72\xa6\xc0\xc7\x44\x5b\x83\x49\x26\x23\x32\x6f\x0e\xde\xc1\xd4\x60\x0a\x28\x71\xb0\x27\xac\x5c\x0a\xd3\xf2\xdd\x4c\xc3\x98\xe2\xd5\x95\xdb\x49\x0c\x66\x03\xb4\xfa\x62\xa2\x20\xa4\x1c\x99\x48\xae\xe8\x58\xcc\x28\x31\xdd\x18\xea\xb6\xfc\x3f\xc6\x7e\x6b\x1e\x63\xee\x48\x10\x8f\xd1\xb2\x29\x97\x98\xcd\x65\xc2\x94\x4d\x0d\x44\xcc\x04\x12\xca\x8d\x1d\xf3\xd3\x21\x77\x57\x0a\xee\x64\x75\xc3\xd8\x67\x53\xd7\x75\xbc\xc1\x9a\x5c\xdd\x6c\xb6\x6a\xd3\x69\xac\x7d\xac\x49\xf3\x2f\x15\xd9\xd5\x61\x0d\x3c\x58\x58\x2f\x66\x45\x03\xcd\x64\xac\x53\xf5\x74\xa4\xde\x42\xa5\x1e\x6a\x0d\xc9\x50\xa8\x6b\x42\xd3\x5b\x88\x6c\x53\x28\xd0\x9a\x5d\xce\xae\x45\x01\x6b\x51\xff\xc1\x3c\x8b\x5b\x33\x37\x41\xbe\x9a\x48\x5c\x7b\xc7\x46\xb9\x6a\x6a\x25\x9c\x0c\x21\xf3\xab\xb5\xff\x15\x81\xe6\x0e\x11\x67\x48\x0e\x2d\xd8\x38\x73\x7f\xe5\x31\x71\x67\x21\x09\x66\x79\xf2\xd3\x66\x27\x34\x9c\x80\x31\xc6\xc9\x43\x72\x3d\x94\x40\x92\x7e\xa5\x89\x8c\x46\x48\x92\x9e\x54\x27\xd0\x61\x58\x13\xc6\x80\x4d\x82\x41\x92\x64\xb2\x6e\xb1\x89\x87\xab\xac\x41\xd5\x50\xb0\xa4\x42\x9f\x6d\xb2\x02\x2c\x60\x6b\x7f\x25\xa9\xc1\xe8\x06\x7a\xdb\xf4\x60\x92\x0b\x8b\x7b\x89\x46\x37\x3b\x0c\x79\x66\x7b\xb5\x32\x69\x4b\x2d\x9e\xb4\xa7\xe2\x49\xab\x79\x9c\x54\x7b\x1f\xa1\xd8\x92\xed\xb5\x3f\x26\x75\x3d\x1b\x2a\xa9\x2b\x57\xa7\xd9\xb0\x77\x0c\x33\x29\xb7\x80\x88\xe8\xcf\x4d\x83\xa4\xa1\x3b\x79\x06\xc8\xd2\x47\x2f\x44\xf7\xf4\xb2\x33\x0d\x98\xb4\xe8\x9a\x32\x3b\x8c\x5b\x4d\xa4\x6b\x51\xb8\xea\x4d\x3a\x07\xa6\x74\x1b\x03\xa4\x35\xe9\xcd\x85\x78\xc1\xfa\x23\xb9\x4e\x97\xbf\xc5\xcd\x27\x0c\x6c\x9a\x5a\xc9\xa1\x53\x10\xd9\xb1\x23\x5c\xaf\xe6\x18\xd8\x65\xae\xa7\xd6\x48\x4e\x29\x8c\xc9\x0d\x03\xbd\x87\xc0\x45\x64\x72\x68\x11\x17\xd6\x6e\xc3\xc2\x8f\xb1\x83\x79\x9f\x6c\x0c\x27\x17\x33\x03\x43\x56\x93\x5b\x1b\x9c\x7b\xa5\xe4\x05\x56\x48\x72\xac\x8b\xc3\xba\xcd\xb8\xae\xdc\x42\x95\x9b\xfa\x49\x66\x14\x22\xc2\x7e\xe2\x04\x0b\x88\x8c\x63\x77\xfa\xa1\x1e\x52\xec\xd9\xd2\x11\xf6\x4f\x8a\x8e\xe5\x8c\xd3\xba\x2b\x91\xa0\x32\x31\x56\x52\x0c\x43\x70\x5c\xc2\xa7\xc8\x52\x46\x4c\x03\xc9\x66\xf0\x14\xb9\x04\x4a\xd1\xec\xcf\x14\x31\x49\xb2\xd8\xb1\xe6\xf1\x44\x01\x88\x35\x4f\x8e\x46\x00\x14\x52\x77\x23\x0f\x46\xcd\x33\x70\x56\x07\xa0\x65\x99\x2a\xc6\x4c\xaa\x18\x5c\xa9\x2a\x4c\x35\x94\xb7\x8e\x68\xb3\xca\x35\x4d\xaa\xd3\xa4\x9e\x35\xaf\xd3\x64\x4d\x52\xa7\x33\x59\x60\xdb\x56\xea\xc3\x54\x43\x30\x6d\x95\x6a\x66\x72\x54\x5a\x09\x43\x9b\x62\x54\x67\x8e\x99\x79\x44\x52\x37\x48\x00\x4d\x80\xb4\xfc\x5a\xd7\x0d\xb6\xb4\x7c\x6d\xef\x7d\xb0\x7c\x0b\xc1\xad\x9d\xb5\x7c\x47\x79\x6a\xcb\xdd\xa9\xda\x2e\x5f\x4e\xcd\x23\x8b\x5c\xa1\xf3\xd0\x39\x2b\xd8\xec\x64\x72\x36\x05\x42\xd3\x05\xb4\xea\xaa\xf2\xc0\x0a\x99\x2d\x8b\x01\xa2\xbf\x11\xac\xbb\x03\xb5\x1b\xc3\xca\xa3\x3d\xe0\x06\xeb\x63\xed\x98\xdf\xc9\x66\x25\x00\x34\x5d\xed\x4e\x93\x65\x8d\xb5\x6e\xed\x25\xd8\x14\x59\x7b\x29\x63\x44\xcd\x6a\x0f\xf9\xad\x9a\xe8\x18\x7a\xdb\x19\xa8\x8e\xc4\x3a\xa2\x9e\x92\x9a\xaf\x97\xcd\xbb\x52\x6d\x55\xef\x1d\xa7\x4d\xb4\x3f\xb3\xe6\x6e\x50\x85\x89\x8a\x28\xe1\x1a\xc9\xa1\x77\x18\x75\x55\x89\x35\xb5\xa6\xc0\x6a\x6a\xd3\x1d\x71\xf7\x02\xa0\x67\xed\x53\x9f\xc5\xdc\x94\xb8\x9a\x2c\xd4\xa0\xe8\xb7\x6a\x26\x2d\x18\x06\x6d\x4d\xc3\x48\x1a\x39\x78\x6b\x72\x8c\xe8\x8a\xeb\x30\x70\x6b\x62\x9e\x27\xca\x6b\x4d\x27\x67\xe9\x5a\x9b\xa4\x90\x37\xf7\xed\x3e\xcc\x00\x0c\x62\xfd\x92\x0a\x1d\xd7\xb5\x5c\x37\x08\x6e\xcd\x9d\xd8\x4b\x3a\x40\xc4\x81\xe8\x54\xaa\x29\x0a\x13\x64\x89\xf9\x94\xb5\x37\xb1\xca\xa8\x85\x71\x8b\x37\x9f\xa2\x69\x1d\x51\xc4\xeb\x6e\x5a\xe5\x3a\xa9\x16\xcb\xa7\xdc\x4c\xa4\xea\xe7\x96\xdb\x25\x75\x66\x03\xcf\x26\x74\xf5\xef\xff\x2f\x56\xdb\xb3\x60\x96\x9a\xfb\x21\xe6\x5b\xf3\x38\x73\x5f\x70\x4e\x68\x83\xd9\x76\xdc\xe6\x5c\x12\x03\xe6\x02\x65\xb5\xfc\x6f\x83\x30\x28\x96\x2f\xa9\xe5\xd6\xce\xf2\x5f\xb0\x1a\x6c\x1e\x97\x5f\x93\x5f\xad\xdc\xe5\xdf\x96\x7f\x43\x91\x96\xaf\x43\x75\x01\x9a\x67\xf9\x3a\x2a\xed\x96\xe5\xeb\x7d\x72\x5d\xbe\xd9\x02\x75\xf9\x9f\x77\x3b\x75\xf9\x9e\xd7\xf8\xdf\x31\xad\x7b\xd7\x3c\x49\x2b\xd0\x2c\x91\x28\xa8\x17\x00\x25\xb9\x61\x91\xf5\x24\x6d\x12\xdf\x3c\xc9\x51\x18\x08\x51\xb0\x62\xaa\xbd\x01\x2d\x2b\xaf\x9f\x01\x02\x09\xe2\x7b\xbe\x92\x0d\x44\x45\x66\x86\xf7\xbd\x64\x32\x5d\x9f\xf4\x9a\x96\xdf\x0d\x61\x14\x3e\x89\xd7\x5c\x30\x36\x81\x66\x50\x3f\xd9\x7b\xdc\x27\xf1\x13\xa6\x02\x00\x2c\x10\x9e\xc4\x5f\xd4\x47\x72\x98\xf8\x9b\x27\x09\xc2\x49\xeb\x49\x26\xee\x37\x22\xe8\xd4\x6a\xce\xcb\x5f\xb1\x6a\x7f\x92\x69\xcd\x75\x72\x21\x6e\xfe\xc5\x79\x49\xcb\xef\x70\x9e\x49\xb8\x4f\x0d\xd0\xae\x89\x9c\x51\xb8\x2f\x44\x71\xf3\x5c\x61\x49\x9a\x23\xaf\x6c\xd3\x33\x8b\x0a\x77\xc0\xbc\xe6\x95\xc8\xd3\xa3\x97\x34\xcc\x04\xf7\x07\x3d\x8c\x14\xa9\x80\x18\xa8\x4f\x12\x02\xcb\x13\x6c\x69\xf3\x24\xa1\xb0\xa2\x18\x9d\x91\x3c\x56\x1a\x88\x80\xc9\xb1\x54\xa1\x58\x7b\xe1\xf9\x59\x60\x47\x02\xa8\xb5\xd0\x19\x96\x29\x1b\xef\x8c\xe9\xa8\x10\x55\xab\xf4\xb9\x26\xf6\x5f\x6a\xe5\x83\xfd\x99\x5a\x6b\x22\x70\xb7\xfc\x35\xb1\x89\x52\x1f\x73\x34\x8e\x6c\xd3\x60\xb9\x26\x23\xf6\x66\x1b\xa8\x76\x9d\x63\xb8\x49\xd2\xf2\x25\x10\xa9\x31\xc7\x7e\x24\x17\x65\xbc\xa0\xce\xb2\x46\xb5\x29\x73\xe6\x8c\x9e\xe2\x92\xce\xba\x56\x9c\xc8\x19\x34\xe2\xba\x93\xf1\x6c\x8c\x3b\x64\x40\xd1\xca\xb5\x76\x73\x4a\xb5\x8c\xb0\xe2\x9e\x24\xad\x49\x95\x11\x8d\x9f\xaa\x15\x81\x7d\x94\x2e\xe2\x47\xe4\x9c\x65\xb0\xad\x6b\x40\x4f\x9a\xf8\xfa\xe5\x49\x32\x0a\x9f\xcf\x92\x10\x3b\x67\x61\x47\xe4\xac\xad\x32\xd9\x9c\xad\x7d\x73\x91\x81\x39\x43\x5a\xb5\x17\x43\xcb\xdf\xee\x50\xbd\x97\x35\x20\x21\x53\x29\x7c\x23\x04\x80\xce\xb4\x7e\xc4\x58\x5f\xd9\xc5\xe9\x07\x70\x91\xbc\xfc\x2f\x94\xb7\x68\x6e\x51\x8c\x8a\x01\x0a\x70\x91\x2e\x92\x0d\x61\x2d\xee\x45\x7c\x07\xb1\xf9\x09\x9d\x41\x6b\x45\x03\xf6\x34\x60\x90\x66\xc2\x84\x42\x5e\xe2\x2a\x2b\x73\xac\xeb\xc0\x9a\x6f\x8c\x75\x83\x8c\x6a\x0f\x5b\xaa\x79\xd2\x8f\x73\xf3\xa4\x1e\x13\x04\xac\xb4\x27\x0d\x83\x93\xe6\x49\xd3\x05\xf9\xe6\xe6\x49\x33\x96\x7e\x4f\x5a\x6e\xe2\x37\x9f\x3e\x37\x4f\xe8\x64\x1f\xf3\xe6\x55\x53\x72\xb7\xe6\x49\xaf\xcd\xd3\x28\x6d\x4c\x98\xa3\x9f\x46\xe9\x46\x1f\x8f\x77\x70\x21\x60\x5b\x8c\xd2\xeb\x91\x7a\x65\x94\x53\x92\x56\x52\x99\x57\x7c\xb9\x63\x2f\x6d\x45\x13\x8f\xe2\x35\x4d\x03\x05\x68\x14\x3f\x19\xa5\x04\x8c\xe2\x23\xd4\xde\xd3\x88\xd1\x9f\xbc\x58\x98\xa9\x5d\x9f\x4e\x30\x0b\x08\xce\x2e\xda\xa3\xb3\xaf\x17\xb7\x46\xa9\x94\xb5\x51\xf8\x24\x74\x4a\x46\x8b\x97\x20\xc9\xe6\x9f\xb4\xef\xe7\xbb\x2b\xeb\x89\xaf\x0e\xef\x4e\xbe\xb0\x63\x01\x83\x5a\xa0\x81\xb4\x48\x90\x6e\x94\xb2\x3a\x92\x84\x58\x46\x61\x7b\x84\x59\xc2\x20\xac\x02\xcb\x15\xaf\x92\x4f\x15\xe8\xac\x7e\x2d\xd6\x79\xb2\x3c\x92\xf4\x37\x16\x89\x4b\x24\xf2\xb5\xed\x92\x63\x9b\x26\xf6\xf1\xf8\x87\xa1\x34\x62\xdc\x18\xc8\x8c\x5a\xac\x41\xca\x24\x9e\x05\x2d\x29\x32\x37\xcc\x74\x1f\x6a\x41\x8a\xa5\x5e\x6a\xba\x68\xcf\x52\xd6\x7e\xe4\xa0\x18\xa5\x5e\x2c\x81\x8b\x84\x60\x99\x5d\xd0\xd3\x06\xe8\x9e\xa1\x81\x1d\xea\xaa\xc8\x41\x3b\x52\x25\x39\x91\x7a\xd2\xac\x56\x2d\xd5\xab\x84\xe1\x14\x99\xa9\x7a\x6d\x69\xde\x3c\x8d\x3a\x31\x27\x2c\x22\x8a\xb0\x5b\x34\xa8\xd1\x99\x6c\x30\x9a\xeb\x59\x82\x9b\x2c\xc7\x34\xe9\xcd\x12\x4a\x61\xce\xa3\x42\xc2\x34\xaf\x31\x72\x56\xce\x58\xa3\xe6\x62\xf2\xa0\xf7\xc1\x42\x74\x87\x10\x59\xd4\xc8\x49\xaa\x83\x01\xce\xeb\x4f\xa3\xeb\x7b\x49\x57\x41\x28\xa7\xf4\x38\x1e\x3d\x94\xe5\xe8\x46\xeb\x79\xe7\x7b\x24\xcc\xa2\x3b\x16\xd1\x85\x00\xeb\xeb\x69\x74\xf1\xa2\x81\xf2\xeb\xce\x67\x17\x7a\x18\x50\x4f\xa3\x2b\x36\xd9\x8d\xae\x44\x76\xbc\x8f\x7c\xa3\xfe\x04\x65\x35\xc6\x6e\x4c\x33\x86\x1f\x70\x4f\x09\x8a\x3a\x9f\x4c\xf4\xa3\x23\xc9\x88\x6d\xcd\x17\xcf\x2c\x44\xfc\x65\x1d\xd4\x23\x3b\x37\x56\x1e\xc7\x02\x30\xd1\x8e\x98\xae\xc7\x78\x25\xe9\x47\xea\x17\xa2\x1f\xa0\x12\xad\x27\xfd\x9e\xc6\x44\xd5\x4f\xc6\x6a\x1b\x18\x6b\xbe\xfd\x70\xe4\x3f\x3c\xb0\xc6\x02\x76\xf2\x87\x07\x4e\x57\x10\xcf\x23\x85\xe0\x8e\xd3\x1a\x26\x5b\xf1\x52\xa1\xa0\xa4\x39\xdb\xc8\x4b\xcb\xd7\xe2\x34\x84\x58\x36\xcf\x8e\x53\xe2\x88\x6a\xd5\x5e\x3e\x5a\x4d\xfd\xcc\x5e\xae\x26\x68\xf5\x24\x17\x17\x0c\x21\x6e\x45\x82\x30\x5f\x57\xc6\x29\xcd\x75\x9d\xd8\xe4\xe1\x34\x9c\xe2\x95\xba\xcd\x1d\x4d\x90\xdd\xb1\xaa\x29\x37\xc7\x69\x91\x9b\xaf\xa5\x79\x72\x75\xa8\x1e\x22\xed\xc5\x25\xa3\x76\xa8\xe2\xc9\x0b\x37\xc8\x9e\xbc\x9c\x47\x0c\x65\x2f\x66\x36\x13\xa0\x29\xbc\xa4\x93\x51\xf3\x3c\xe5\xbb\x2f\x5f\x2b\x3d\x79\xdb\x30\x22\xbf\xbb\xef\x1e\x37\xe9\x19\x69\x26\xe9\xcd\x77\xb6\x68\x8a\xf9\xcb\x6b\xc7\xd4\x75\xd2\x50\x36\xff\x89\xb2\x3d\x42\xc7\x9a\x8f\xde\x41\x06\x08\xbd\x72\xc1\xf0\xe4\x35\x75\x8c\x75\x41\x00\x77\xe4\x3b\x8a\x27\x4f\xeb\xcd\xbb\x50\x28\xb2\x04\xc8\x08\x56\x8f\xaf\xa8\x5a\xc5\x84\xe2\x97\xaf\x93\x86\x0e\x3d\x61\x70\xf9\x77\x04\xe3\xb6\xac\x03\xc7\x12\x09\x8c\xca\xd1\xb8\x02\x9c\x64\x5e\x01\x5f\x55\x34\x4f\xb1\xef\x41\x92\x4a\xa8\xcd\x53\xc4\x33\xa8\x85\x0c\x9e\x21\x23\x11\x2b\x59\x88\x6b\x1c\x51\xf5\x38\x82\xb8\xe3\x11\x0b\x9b\xa7\xe8\x42\x37\x37\x4f\xdc\xdf\x7c\x8a\xbe\x95\x32\x92\xc3\xcf\xb3\xa9\x22\xfa\x27\x7a\x6d\xb1\xf8\x25\x1c\x58\x24\x98\x4a\xf9\xce\x59\x76\x9e\xe5\x7b\x8a\x13\x6c\xd4\x38\xb5\x72\x23\x53\xba\x26\x3b\xcc\x03\x64\xec\x5c\x56\x6e\x26\x49\x0c\x56\x9c\x40\x41\xc1\x92\x1b\xc4\x2a\x18\x42\x44\xe1\x42\x92\xde\x18\x1f\x66\x2b\x58\x80\x05\xd1\xfd\x40\xc5\xd9\xc9\xb1\xa7\x88\x29\xa3\x4f\x2e\x9e\x6d\x73\x0c\x1e\x8e\x34\x69\x12\x73\x33\xd8\x15\x72\x11\xc3\x55\x43\x41\x81\xc3\x4d\x68\xf8\x61\xbd\xff\xb4\xd6\x38\x9a\xb4\xc5\x7b\x6d\xe2\x99\xad\x15\x29\xe2\xf1\xac\x24\x5c\x92\x3e\xc5\xf3\x59\x3f\xac\xf5\xa1\xa1\xc0\x53\xcb\x57\xa9\x4f\x31\xf5\x2b\xa5\x6f\x6f\x56\x1d\xc0\x14\x67\x02\x64\x9b\x26\xe9\xc8\x03\xad\x19\x70\xef\x4c\x94\x23\x97\x23\x4f\xb6\xe9\xf9\x14\x53\xd2\x9e\x0d\x93\xd2\xf2\x5f\x99\x72\x76\x0c\x95\x19\x0f\x62\x1b\x53\x71\x41\xca\x0a\x98\x6c\x59\xbe\xa2\x9a\x79\x42\x20\xd3\xc9\x71\x6d\xb8\xe6\x29\x96\xd1\x76\x69\x9f\x22\x97\x13\xb1\x7a\x9a\x83\xb1\x9e\x57\xc6\x50\x5c\xcb\x16\x82\xbc\xf2\xa2\x5d\xe5\x0b\x97\xa7\xc8\x65\xde\x53\xac\x97\xa4\x15\x91\xd6\x21\x1e\xaf\xec\xb7\x2b\x27\xd8\x08\x71\x9c\x9b\xa7\x24\x6d\x49\x98\xd9\x92\x0c\x48\x3a\x89\x6b\x41\xa9\x0c\x60\x63\x8a\xf7\x9b\xbf\x94\xc8\x85\xe5\x93\x8d\x81\x24\xec\xa9\x24\x17\x65\x8c\xab\x0d\xbc\xa4\x6b\x2c\x55\xbe\x26\x78\x4a\x3a\x09\x17\x2e\xb6\x99\xf6\x94\xf4\x8c\xc2\x26\xcd\x9d\x52\x16\x92\xe6\x73\x24\x33\x2d\x99\xb4\xdc\xa2\xab\xcd\x53\x72\x6d\x6b\x14\xb1\x5d\x6f\x5d\x9b\x5c\x96\x63\xf5\x30\xc0\x93\xcb\x9d\xb1\x33\x69\x59\x7e\x6f\xa1\x48\x92\x5b\xbe\x36\x4f\x3c\x2d\xf3\x94\xa2\xbb\x09\xf9\xd4\x42\x6d\x73\x19\x91\x62\x58\x17\x01\x18\x4d\x9c\xbe\x12\x84\x33\x45\x9a\xaa\x29\x66\x73\xe4\xeb\xe8\x0a\x23\x55\x56\x25\x56\xfa\x63\x14\xa7\x78\x31\xe3\x35\x55\x8e\xcf\x54\xb9\xfb\xf6\x94\xa0\x5c\x12\x56\xeb\x4f\xa9\xa2\x7d\x96\xaf\xec\xad\xb4\x7c\xe7\xf3\x2a\x3d\xb3\xaa\x52\x92\x76\x92\x21\x85\xb5\xa5\xb6\xa9\xf8\xf1\xdc\x31\xca\x58\xbd\x9f\x9b\xa7\x3a\x4d\xd4\x72\xc6\xf3\x0a\xc0\x03\xd4\x1f\xb5\x74\x0d\x08\x18\x96\xaf\xb1\x79\xaa\x29\xc9\x48\x86\xa9\xb8\xf2\x3c\xa0\x31\xf2\xab\x86\xcd\x7b\x07\x74\x43\x66\x99\x72\x53\x4d\xfe\x6c\xdb\xe0\x69\xb5\x21\x2f\xea\x31\x1b\xcd\xc9\xac\xfd\x9b\x7c\x9c\xec\xd4\xe9\xd3\x4d\x21\x93\x37\x4d\x7a\xe6\xc9\xaa\x9f\x50\x88\x83\x13\xa7\x97\x3b\x5c\xfe\x3b\x31\xd3\xbc\xb9\xe3\x51\xd3\xbc\xf9\xcb\xcd\xf9\xe5\xcb\x30\x23\xb1\x1f\xd3\xdc\xf2\xa5\x53\x4e\x53\xcb\x97\x89\x16\xc8\xf2\x25\xf5\x3c\xf3\xfc\xb4\xfc\x97\xd5\xe7\xeb\x19\x8b\x60\x00\x9e\x60\x7d\x5a\x7e\xbf\xc1\x84\x59\xfe\x57\xd0\xd8\x3c\x4b\xab\xa9\x79\x96\x61\xf4\x4e\x42\xf3\x2c\xa3\x27\xe1\x3b\xf5\x67\x71\xcd\xb3\x9c\x1c\x10\xbc\x7d\xab\x33\x18\x1f\x79\xef\xa6\x98\x14\x28\xd1\xf7\x06\x8d\x07\xee\x14\x7c\x6a\xa3\xef\x0b\xc0\xc4\x1c\x42\x8f\x50\x41\x47\xb2\x81\x27\x3f\x00\x2a\xdd\x16\x07\x2c\x93\xf3\xb8\xc3\x33\xcc\x07\xc6\xbd\x2d\x5f\x57\xb6\x59\x75\xc5\xb3\xa4\xd6\xe5\xd1\xb1\x04\xa9\x9d\x7f\x40\xbd\xe4\x13\xf8\xc0\xea\x24\x4e\xf1\x00\x27\xa6\x64\xaf\xb8\x9f\x85\x3d\xfe\x8c\x25\xdf\x33\xad\x3b\x1b\xe8\xcf\xc2\x19\xf9\x59\xf2\x4d\xf9\xfa\xe5\x59\x8a\x20\x1a\xdf\x61\x14\x94\xf4\xc2\x26\xb8\xb8\xde\x68\xd6\x95\x33\xda\xc5\x69\x26\x5b\xa9\x79\x22\xc2\xd5\x30\xe0\xcc\xb6\x9c\x2d\xa3\xbf\x7f\x39\x71\x03\xfb\x59\xe5\x04\x12\x14\x34\x05\xcd\x19\x80\xb1\xb4\xc5\x4f\x5c\x76\x5a\x3f\x03\xaa\xac\x7c\x06\x45\xb9\xb5\x13\x97\x19\xb7\x1b\x79\xfa\xed\x59\xbb\x13\x0f\x72\x8e\x06\x13\x18\x66\x75\xb0\xa9\x55\x87\x04\x78\x3a\xe4\x59\xf5\x02\x73\xf8\x59\x8f\x7e\xe5\x89\xb3\xd2\xb3\x0e\x72\x15\x30\xfb\x56\x02\x20\x30\xa1\xa1\x7c\xa0\x9d\xf9\xac\x76\xa3\x6b\x9e\x75\xe4\x39\x88\xd2\x3c\xab\x1b\x78\xd2\xf5\x59\x4f\x96\xab\xc7\x4f\xba\x14\x99\x25\xd6\xc3\xcb\x7f\x23\x48\x2d\x3a\x0c\x00\x16\x03\x51\x0d\x68\x21\xf5\x1d\xb4\x32\x80\x5e\x62\x9a\x05\x79\xfb\x63\x4c\x03\x43\x0d\xeb\xf1\x4a\x83\x3f\xf8\x1f\x3f\x41\x79\x56\xef\xd8\x2c\xde\x65\x63\x3c\x3b\xf5\x8c\xf5\x38\x68\x3c\x1e\x2d\xef\xb3\x0b\x4c\xe0\x1c\x61\x2a\x01\x24\x35\x50\xd4\xf9\xcd\x33\x16\x0f\x6c\x6b\x4f\x8d\x84\x27\x13\x16\x4b\xe8\x60\x9a\x55\x60\xe7\xcc\x8e\x08\x1d\x16\x1c\xcf\x58\x99\x3c\xd3\xcc\x62\xf5\x83\x49\x81\x06\xbe\xa7\x7f\xd6\x00\x43\xe3\x59\x43\x60\x12\x21\xe4\xa2\x6c\x36\x6e\xcb\x32\xc6\xf9\x8c\x6c\x52\xbb\xfc\x15\x21\xd2\xa4\xe8\xb4\xd5\xd8\x7c\xd6\x84\xd2\x64\x0c\x4c\xcd\x52\x7d\x70\xd6\x67\xb9\xe3\xc1\x43\x03\xc6\xb1\xba\x5b\xbd\x22\xd5\x20\x11\xd4\x9a\x47\x0b\xe5\x23\xcc\x0c\x98\x1c\x70\x8c\x67\x6e\x8a\x3c\x6b\x76\x27\x3b\x88\x01\xfc\x21\xa9\xc7\x58\xd3\x3c\x49\xd2\x5c\x0c\xdd\xd6\x2d\xa3\x67\xcc\x41\xa9\x18\x82\x9a\xcf\xab\x60\xe6\xac\x16\xab\x98\xd5\xf1\xac\xf9\x62\x4f\x8a\xda\xd1\x92\x67\x2d\xe5\x27\x60\xb5\x6b\x38\x1e\x9b\x67\xb6\xe0\x45\x33\xba\xe9\x82\x4a\x5e\xa8\x63\xf4\x4a\x39\xba\xb2\xa1\xaf\xcb\x5f\x2f\xf4\x9c\xe1\xb7\x7c\x39\x35\xcf\x58\x81\x4b\xf3\x8c\x35\x57\x02\x8f\x11\xb2\xe2\xf8\x8d\x07\x1a\xda\x49\x50\x48\x82\x6b\x29\xc1\x0e\x03\xc1\x75\x27\x07\xaf\xbe\xd7\x80\x20\x7d\x72\x7c\xd3\xdb\x3c\x3b\xed\x15\x0e\x22\xc7\x41\xe2\x74\x88\xa0\x50\x8f\xa6\xa4\x1c\x4c\xe8\xcf\xe0\x99\x2a\xc5\x69\x51\x6f\x2c\x79\xc6\x28\xf7\x34\x6e\xcd\xb3\xfb\x38\x91\xe4\x82\xf2\x39\x3b\x07\xf6\x8c\x3e\x0d\xeb\x19\x10\x73\x70\xa0\xd9\xb1\x85\x67\x4b\x36\x38\x44\xe7\x2e\xd9\xb3\x8b\xb9\x8b\xc9\xc1\x32\x78\x76\xe7\xb3\x06\x81\x76\x72\x79\x2d\x41\x2e\x7a\x14\x08\xb4\x2b\x13\x1f\x94\x7b\x11\x2e\x72\xa2\xc7\x67\x57\x40\x99\xfa\xf2\xd5\xc6\xca\x07\x2c\x23\x69\x9e\xbd\xd4\x0e\xca\x39\xb6\x29\xde\xd6\xb7\x02\x70\xd4\xcc\x23\xd7\xcf\xb1\x1b\x49\x4f\xdc\x8e\x7c\x8e\x3d\x7e\x82\x71\x1a\x21\x39\x11\x6b\x9e\xe7\x68\x9a\x30\x72\x2e\x88\x9a\x34\xf0\xa5\x20\x20\xd4\x4b\xf3\x1c\xa9\x97\xe3\x50\x15\x11\x1d\x7e\x16\xd1\xf9\x38\x35\xcf\x34\xdf\x9f\xa3\x47\xe2\x98\x91\x5c\x41\x10\x26\xe6\x61\x33\x40\x97\x47\x2c\x11\x4e\xf0\x9f\xa8\x02\xe3\x04\x69\x8b\x13\x3f\xfd\x7a\x8e\x13\xfa\x2b\x4e\xcb\x97\x35\x10\xfc\x82\xf4\xb0\x21\x09\x96\xdf\x22\x01\xf3\xe0\xd9\x85\xe7\x88\xe6\x8b\xe1\x04\xc2\xe0\xc1\xca\x1f\xe2\x30\x56\x25\xa8\xc3\xd8\x3c\x47\xd4\x24\x61\xf0\xc5\x74\x04\xe1\x60\x8e\x69\x72\x3c\x5e\xf9\x1c\x13\x9d\x41\x7d\xdc\xfc\xc5\x79\x36\x60\x8a\x47\x85\x54\xc7\x14\xf3\x6d\xad\x40\x62\x58\xcc\x69\x31\x97\xe5\x0b\xb2\x2a\x25\x26\xa4\x8b\x49\x26\x96\x9b\xb5\x55\xc5\xaf\x5d\x15\x43\xac\xad\x5f\xbe\x66\x80\x41\x58\xbc\x3a\x78\x31\x77\xac\xa8\x4c\x65\x3b\x54\xa8\x0a\xa6\x5d\xaf\xca\x4a\x5e\x94\x49\x5f\x79\x16\xe7\x39\xae\xbe\xd7\x00\xf1\x8f\x57\x08\x7f\xbc\x66\x2d\xe8\x90\x19\xa2\x1b\x6f\x73\xf3\x9c\x64\xe0\x0e\x27\x10\x5a\x34\x49\xc8\xf6\x62\xe4\x39\xc9\x99\x42\x9d\xd6\x59\x2c\x69\x37\xd2\x0c\x7d\x4e\xea\xb2\x4d\x3a\x89\x33\xd8\xdd\x3e\x7d\x4e\x2e\x04\xa6\x15\xdb\x78\x01\x43\x53\xa6\x98\x7b\x4a\x19\xcc\x41\xd0\x61\x00\xc5\x32\x0b\xb9\xcc\xb6\xfb\xff\x9c\x96\x2f\xed\x3a\x4b\xc2\xa2\x7c\xae\x2d\x6d\x87\xda\xba\x92\x4f\xce\x01\xc5\xdc\xfd\x02\x3e\x37\xcf\x15\xda\xab\x40\x3f\x00\xe5\x2c\xac\x74\xed\xb8\x11\x04\x70\x5a\x69\x22\x73\xa8\x76\xed\xe2\x04\x79\xa4\x55\xfa\x5c\xf9\xd2\xf7\xb9\xea\xf2\x37\x34\x6f\x85\x5e\xaa\x3c\xab\xfe\x5c\x8f\x69\x4d\x66\x80\x5d\xfd\x5c\x07\xcc\x7e\x75\x60\xbf\xd4\x51\x26\xa8\x80\xea\x28\xe3\xf5\xae\x3d\x2b\x73\xf4\xd2\x81\xce\x93\x4c\x1c\x2f\x95\xb2\x53\xa7\x88\x49\xa4\x4e\x31\x39\x0e\x71\x20\x6e\xde\x3d\xd7\x00\x43\xc1\xa6\xc4\x1a\x3a\x86\x0e\x27\xf5\x77\x7d\x51\xc3\x69\x65\x56\xdc\xe0\xd1\xa6\x35\x30\x5c\x2e\x3f\xa6\x8c\x4a\xe9\xae\xe7\xe5\x2b\xcc\xf8\x3b\xd8\x1c\xa4\xf6\xb1\x6a\xa0\x29\x50\x93\x55\xc7\xb6\xc6\x8d\x47\xe3\x65\x65\xe6\x7d\xbb\x71\x1c\xd5\xe4\xb4\x92\x31\xed\xf5\xac\xf7\x73\xe5\x0b\x81\x67\xbe\x66\x03\x5b\xbe\xc2\xc5\x37\x58\xcf\x15\x0b\xc9\xe7\x7a\x61\xa5\x2e\xe2\x37\x6f\xb1\x5e\xe0\x7f\xd1\x14\x22\x2a\x70\x71\x43\x50\x81\x24\xc3\x26\xac\xf3\xf1\x03\x7a\xee\x12\x13\x4c\xa3\x4b\x4c\xd4\xf4\x97\xb8\xfc\xf7\x10\x73\xf3\x7c\x75\xdc\x9e\x7c\xbe\xce\xc8\x6b\x6e\x4d\x40\x8c\x6f\xde\xa4\x1b\xf9\xea\x3a\xc2\x82\xb1\x10\x18\x79\xf3\xaa\x80\xe7\xd4\x3c\x2f\xbf\xc9\xcd\xe8\xe6\xcf\x4e\xfb\x24\xa3\x43\x09\x97\xdf\x20\xda\xcb\xb7\xb6\xe5\x89\x84\xe7\xe5\x1b\xc6\xda\xf2\x2d\x1d\xd5\x17\x02\xd6\x79\xf9\x2e\xb7\xcd\x41\x52\x52\xc4\xf9\x6e\x46\xee\xf2\x7d\x6d\x89\x17\xc1\x1c\xf0\x42\xfb\xed\x45\x72\xe1\x01\xc4\x17\x1e\x6e\x7e\x69\x35\x95\xb1\x79\x59\x87\xf5\x4b\x77\x6a\xbd\xf4\xe0\x3c\xed\xf9\xd2\x9d\xa0\xfc\x5f\xfa\xde\x29\xe8\xdc\xbc\xf4\xea\xed\x80\xe3\x4b\x3f\x48\x02\x55\xbe\x04\x05\xb0\xe3\x02\x44\x7c\x51\xf9\xd2\xf3\x34\xd7\x4b\x7f\xc5\x18\x31\x96\x9b\x17\x9d\x9a\x17\xfb\xc6\xed\xe5\x98\x94\xdb\x7a\x2f\x10\xd2\x97\x01\x56\x0c\x58\x42\x01\x07\xc6\x05\xcd\xc6\x0a\x98\x77\xcd\x0b\xc9\x98\x6c\xb6\x32\xd4\xc7\x74\x44\xf0\x31\x69\x01\xe5\x57\x03\x2f\xae\x1b\xd5\xf3\xad\x28\x20\x5a\x10\x9c\x6f\xc0\x5f\x1c\x47\xdd\x8b\xeb\x05\x4f\x87\xe6\xc5\x9d\x12\x4a\xe6\x6c\x33\x18\x3c\x48\x77\x32\xd0\xc2\xc8\x22\xb2\x67\x05\xe5\xfc\xc8\x1c\x2f\x2f\x27\x7e\x33\xf5\xc2\x57\xe7\x2f\x7e\xb3\x1f\x69\x55\xbf\x78\x0d\x58\xad\xbc\x78\x2c\x99\x72\xf3\xe2\x9d\x90\x66\xcc\xf0\x2f\xde\x7d\x06\x59\xbe\x64\x63\x7f\xff\xd2\xbc\x78\xef\x62\x59\x99\x71\x3c\xf4\xd1\xd1\x85\x4e\x7d\xf1\xfc\xa4\xf0\xa3\x79\xf1\xe7\x11\x86\xc3\x8b\xcf\x0e\xf4\xa2\xad\x9c\x4e\xcd\xcb\xb4\x0e\xc8\x97\xa9\x4d\xaa\xcd\xcb\x04\x25\xf2\xb2\x6e\x1a\xbd\x4c\xa8\x27\xa4\x03\x13\xf1\x0b\x54\x5b\x6e\x5e\xa6\x58\x62\xf3\x12\x7a\x6f\xe7\x26\x5f\x30\xd8\x5e\xf8\x95\x63\x06\x5f\xfe\x47\xf3\xc2\x03\x27\xa4\x66\x58\x13\xd2\x64\x7a\x09\x03\x9b\x35\xa4\x88\x0e\x0f\x05\x99\x86\x72\x75\xd9\x13\xdc\x9a\x97\x33\x57\x39\x2f\x49\xf2\x54\x73\xf3\x92\x5a\xfc\x50\xaa\xd4\xe9\x10\x2f\xae\x6b\x5e\x52\xaf\x0e\xb4\xb0\x09\xd3\x10\x7d\x3e\x89\x13\xc0\xe5\x5b\x58\xbe\xfb\xe6\xe5\x6e\x0f\xbd\x24\x77\xfa\xc1\x59\xa9\x14\x72\x69\x5e\x52\x1e\x21\xb8\x89\x47\x42\x5f\x52\xa9\x43\xaa\x88\x86\x12\xa4\x8b\x82\xa0\x33\xd2\x15\x14\x76\x29\xb5\x08\xd0\x59\xc0\xc6\xdc\x8d\xd1\x23\x74\xee\xc6\xab\x3a\xd6\x2a\x77\xb1\x85\x90\x67\xf5\xfc\x0e\xe8\x85\xa2\x80\x66\xcf\x27\x28\xf1\xe6\x25\x9f\xf5\x23\x6e\xfe\xa2\x29\x08\x1d\xd0\x6a\x2f\x19\x36\x0c\xa2\xfd\x52\xb5\x87\x7f\xd6\x1e\xd4\xaf\xa3\x8c\xdb\xa2\x75\x66\x35\x72\x51\x94\x8e\x6c\xf3\x4f\x72\xd1\x50\x62\x5e\xdd\xc6\x67\xd0\x24\x4c\x88\x7c\xf3\x4f\xd5\xdd\x9a\x97\x22\x73\x6c\x5e\xca\xa8\xb6\xc3\xfb\x52\x60\xb4\xe7\xf5\xc0\xdf\x4b\x29\xbe\x79\xa9\x69\x6e\x5e\x2e\x82\x5e\x5e\xbf\x98\x03\xb7\xb1\x81\x79\x38\x1b\x2f\xcd\xcb\x15\x0d\x7f\xe5\x03\x1e\xa6\x7d\x99\x7b\x6d\x5e\xa0\xab\x5e\xe6\x8f\xe5\x77\x7f\xcc\xfd\xf2\x7b\x29\x0e\xee\x49\x9a\x57\x69\xb1\x42\x7f\x15\x2c\x9b\x40\x5d\x07\x63\xf1\x55\x60\xbf\x82\x91\x0e\x8a\x15\x6e\xdf\xbc\x8a\xe3\x76\xb7\xa1\x89\x11\x1d\x5a\xca\xd8\x06\xeb\x18\x8c\xdb\x57\x39\x8d\xa9\x79\x15\xdf\x9d\x48\x39\x31\x84\x61\xc5\xca\x47\xa7\x75\xd4\xbf\x0a\x2f\x06\x78\x15\xff\x4b\x75\xcd\x2b\xd3\x64\x50\xee\xaa\x80\x73\xa3\xee\x95\x7b\x5c\xaf\x92\xa4\x55\x94\x34\x1d\x31\x29\xbc\x4a\x1a\x46\xf1\x33\x00\xe7\xa5\x57\x49\x1f\x2c\x0f\x3f\x4f\x7a\x95\x34\x31\xb7\x14\xb4\x20\x8d\x44\x87\x95\xd8\x4e\x79\xbc\x0a\x5f\xc9\xbe\x4a\x4d\x0a\x57\x2d\x49\x91\xdc\x85\xed\x71\xed\xd0\xd6\xaf\x76\xa6\xed\x55\x66\x45\xcd\xe7\x08\xba\xfc\xaf\xd0\xeb\xad\x79\x55\x3b\x98\xf3\xaa\x7d\xaf\xa0\x2e\x80\x46\x3b\x9e\x4a\x14\x2f\xe4\xc5\x38\x8f\xc8\xdb\x33\x84\x67\xd4\xa3\xed\x4c\xbc\x2a\x3a\x92\x74\x63\x2f\x7a\xcd\x31\x41\xc4\x81\x7d\x3f\x49\xd8\xbc\xcb\x8d\x67\x08\x57\x77\xf8\x01\xfe\x8f\x27\x9b\xf7\x3a\x66\x99\xe0\x3e\xf2\x90\x3f\xc0\xf2\x6d\x45\x48\x11\x2d\xac\x66\x98\x92\x23\x2e\xf7\xe7\x5f\x35\xdc\xe0\x07\x63\x43\x42\x0f\x51\x7c\xd5\x34\xf0\x5d\xc2\xab\x26\xa4\x99\x68\xed\x92\x07\x2c\x1e\xee\xe8\x46\xe4\x98\x56\x0a\xcb\x97\x1f\x5e\x06\x37\x4f\x31\xc0\xe8\x7f\xd5\x94\x24\xb9\xbc\x82\x48\xae\x2e\xd9\x13\x8b\x9d\x74\x5e\xdd\xeb\x27\x96\x80\x16\x32\xb2\x74\x75\xf0\x0e\xee\x52\xae\xea\x32\xd2\x5a\x4f\x9e\xbd\x3a\xc9\xdd\xe8\x9a\x57\xd7\xdd\x14\x7d\xe9\x7a\xf1\x43\x6c\x5e\x9d\xf2\xdd\xeb\x2b\x8d\xce\x57\x77\xc4\xa3\x41\x12\x42\x0e\x55\x5d\xd2\x3e\x1a\x5c\xd1\x07\xa6\x88\x57\xe7\x25\xf1\x83\x70\xa0\xc2\xce\x72\x9e\xe1\x25\x03\x8e\x08\xea\xdd\x99\x9f\xda\x10\x9d\x2d\x50\x60\x59\xa0\x2e\xc6\x5a\x46\xc0\x13\x08\xe5\xc9\x45\x16\xcc\x4e\xd0\xac\x7c\xf3\x8f\x11\x5a\x05\x4e\xf3\x85\xd4\xbb\x72\x1b\x34\xb1\xb5\x5d\xb9\x9d\x85\x27\x7b\x9a\x57\x2f\x23\x0f\xad\xbe\x7a\x55\x33\x06\x5e\xbd\x42\xc3\xbf\x7a\xbd\xa7\x6a\xc8\xc4\xc4\x2b\x77\x30\x5f\xbd\x96\xf5\x21\xcf\xe3\x81\x9d\x56\xe7\xc9\x34\xdb\xab\x77\x01\xe9\xf2\xa5\xca\xcd\x01\x25\x0d\x9d\x12\x40\xc2\x7d\x2c\x18\x5f\x3e\xce\xc8\x74\xf9\x96\x4e\xda\xbc\x46\x58\x07\xaf\x88\xd3\xbc\x46\x7e\x3f\x71\xe7\xa9\x79\x8d\x2c\x58\xf4\x36\xdd\xbc\x72\x79\xf5\x1a\x43\xd6\x4e\xc0\x0b\x09\x4f\xfa\x10\x04\xf3\xe5\xde\x0a\xc0\xdc\xbc\xc6\x84\x65\xff\x6b\x64\x26\xa9\xdf\x1c\x2c\xf7\x98\x14\x22\x11\xb9\x95\xf0\x6a\xab\xa7\xd7\x98\x7e\xa9\x6e\x08\x91\xf0\xfe\x24\x67\xca\x39\xc0\x69\xf9\x37\x43\x56\xb6\x94\x67\x74\x4e\x4c\x0c\x57\x2a\x4f\x9d\xbf\xc6\xec\x1d\x8a\xb3\x06\x2a\x1f\xb6\xb3\xfa\x8a\x49\xfd\x35\x96\xf9\xee\xac\x30\x74\xea\x67\xa0\xd4\x09\xd4\x40\x34\x6d\x12\xeb\x3a\x0e\x78\xd2\x58\x6a\xf3\x1a\x3f\x37\xaf\x49\x86\x98\x23\x38\xbf\xed\x00\x08\x7c\x7d\x40\x80\x01\x61\xbc\x73\x86\xe2\xca\x36\xef\x91\x07\x3f\xe0\x3a\x19\x55\x63\x16\x85\x07\x45\x01\x32\xb5\xb6\xbd\x15\x00\x5b\x69\x0c\x96\xe0\x6d\xf9\x0a\x7e\x3e\xb3\x68\x49\xf2\x0f\xb6\x79\xa3\xa9\x83\xe4\x6c\xb3\xe2\x97\x3b\xe2\x54\xfd\x8a\xe1\xa9\x64\x45\x86\x95\x33\x70\x18\x2a\xcf\xb0\x03\x96\x9b\x05\x0d\x37\x34\x41\xd2\xf5\x98\x3b\xd0\x68\x86\xe5\x2b\x97\x3b\x7c\x88\xb5\x51\x19\x85\x78\x26\x41\x5c\xd7\x91\x8c\xba\x79\x8b\x99\x95\xc2\xa8\x35\x66\xe2\x03\x44\xe3\x07\x28\x1b\x65\xb6\x8e\xed\x07\x99\x27\xbb\x48\x0f\x4e\xf5\x99\x6c\xbf\x0e\x1c\x2d\xe2\x2e\xe2\x7b\x1b\xd3\x29\x52\x6d\xa7\xe8\xa1\xa3\x53\xe4\x3b\x4e\xc4\x88\x10\x9c\x54\xc3\x8d\xaf\xd0\x5e\x13\xc2\xce\x82\x1a\xcc\xbd\xd0\x81\xe6\x98\x39\xd3\xbe\xa6\xe5\xeb\x64\xfd\xb9\x7c\xeb\xb1\x48\x01\x18\x3d\x4b\x59\xa5\x79\xad\xdd\x98\x41\x9d\x2d\x3b\x81\xd0\x23\x95\xc7\xbc\x5f\x6b\xb7\xfc\xf6\xe3\xc1\xf2\xfd\x0e\xf9\xda\x78\xf3\x28\x37\x48\x75\xd5\x14\x52\xc4\xd4\x5a\x31\xac\xc8\x0a\xaa\x5f\x75\x8e\xcd\x6b\x1d\xbc\xf2\x64\xed\x6b\xfd\x70\x48\xf9\x54\x79\x82\x00\x20\x8f\x0e\xd3\x7d\xb5\x4b\x4b\x5e\x79\x02\x88\x0a\xb5\x42\x23\x54\x08\x54\x4d\x5e\xec\x18\xd0\x6b\x4d\x81\x2a\xb1\xa6\x22\x3d\xdd\xf5\x44\x67\x9d\x91\xc8\x67\x69\x5e\x97\xaf\x0a\x02\xf3\xf7\x75\xf9\x96\x0a\x15\xca\xf2\x9d\x9f\x86\x0e\x40\x6b\x51\xb7\x30\x2a\xd4\x63\xde\xd8\x8a\xda\xf7\x9d\x5b\x39\x56\xd0\x21\x68\xeb\x82\x81\xb3\xac\x88\x01\xd7\x4f\xa8\xee\x60\xf3\x2e\x7c\x5f\xa7\xe6\xc3\x20\x1e\x3f\x21\x92\x9c\x23\x78\x2b\x61\xbe\xf3\x0d\x8f\x62\x65\x38\x7b\x3e\x54\x8c\x1d\x70\x9e\x63\xdb\x8a\x1f\x96\xdf\xbb\x1b\xc3\xbb\xea\x5d\x10\x20\x26\x8a\x1c\xa3\x81\xda\x93\xc7\xab\x30\x20\xd7\xd0\x5b\xf1\xb5\x3b\x31\x40\xcd\xa3\x81\x1b\x0b\xef\x61\x93\x6c\x65\x12\xcf\x0f\x2e\x3e\x90\xe4\xd4\x7a\x94\x7a\xe2\xc4\xb5\x95\x09\x25\x9a\x6a\xe8\xdd\xca\x97\xdf\x9a\x2d\xd6\xc5\x03\x53\x0e\x7d\xf4\x47\x84\x0b\x03\x62\x85\x8f\xda\x6c\x05\xee\xa8\xa5\xd9\x4a\x62\x31\x52\x8b\xce\xd9\x4a\x82\xa6\x05\x5b\x7e\x93\x3b\xdf\xbc\x4b\x9f\x1c\x4a\xcd\x0f\x56\xc0\xac\xc5\xd3\xe0\xaa\x47\x4a\xc9\x95\xc2\x54\xf8\x12\x6f\x2b\x29\xb8\x4e\x8c\x5b\xc0\x10\xbb\xd3\xe6\x2d\x06\x45\x51\x53\xbc\x08\xe7\xd8\x2d\x3f\xc2\xb6\x6e\x4c\xc9\xb1\x81\xd2\xcd\x21\x66\x3e\x73\x1e\x37\x90\x20\xd4\x80\x4c\x2c\x5b\x84\x5c\xd6\x5e\x28\x4c\xb4\xb6\x88\x56\x7b\xd7\x8d\x52\xfb\xcd\xa3\x4a\x3d\xa7\xe5\xaf\xff\x17\xbf\xaf\xe6\x87\x34\x28\x31\x76\x86\x61\x2b\xb5\xc8\x44\x36\x5a\xa1\x0d\x6c\x0e\xce\x76\x71\xe1\x61\x0f\x2e\x2e\x39\xd9\xf0\xdd\x30\xf2\xbc\x72\x2b\x02\x1c\xe5\xe0\x07\x1a\x5b\xb6\xe9\x9c\x28\x50\x73\x36\x41\x98\x97\x7f\x45\x71\x6f\xa8\x96\x4a\x9a\x9b\xad\xb6\xb6\x64\xda\xaa\xe2\x97\x31\x91\x83\x17\x4d\x12\x2a\x82\x8d\xaa\x6d\xb3\x55\x2e\x95\xb7\xea\x02\xd4\xcc\x56\x1d\xdf\xe5\x6d\xd5\xf7\xee\x34\xf2\x2d\xdd\x56\x61\xa1\x40\x2d\x6c\x75\x52\x17\xc6\xe8\x6f\x0c\x12\xf0\x53\xbe\xf4\xdb\xc2\x32\xdb\x6a\x70\x19\xb9\xf1\x63\xaf\x2d\x75\xb3\x80\x73\x70\x6e\x95\xb7\xc6\x6c\x35\x14\x96\x2f\xa0\xb1\x34\xa6\xc1\x28\xe2\x9d\xcf\xcd\x56\x53\x2b\x15\x16\xd1\x96\x37\xe7\x80\xf5\xf6\xb1\xd0\x56\x39\x27\x6d\x35\xf9\x78\x3c\x82\x4f\x1c\x83\x76\x2f\x12\x19\x83\x4f\x9a\x44\x7a\xa0\x80\x25\xd3\x16\xd6\x18\x6c\x45\x20\x76\x2f\x74\xd1\xba\x5c\xdd\xea\xcc\xea\x8e\x72\x3c\x8a\xf1\x18\xc9\xc7\x84\x5c\x5d\xd0\x0f\xac\x80\xb6\xa3\x14\x39\x81\xdd\x60\xbe\x6f\xc7\xd8\x4a\xbf\xf9\x07\xfd\xc0\xe2\x6f\x3b\xc6\x3c\x36\x5b\x27\x5d\xe4\xe7\xc8\x86\x22\xb8\x1d\x42\xdd\x3a\xcc\x87\x2b\x82\x9a\x70\x2d\xfa\xd3\xb5\x2d\x16\xc2\x5b\xbe\xa6\xde\xba\x96\x53\xd2\xd6\xb5\x2c\xa4\xeb\x4e\x7e\x8c\x09\x48\x93\x07\xcd\xf7\x2f\xd3\xb6\x0e\x6e\x6f\xef\x7a\xbe\x73\xff\x7a\xeb\xec\xba\xa9\x95\x5b\x0a\xbe\xb3\x43\x4b\x5b\xe7\x07\x92\xcd\x8f\x8f\x9f\xe1\x82\xa6\xdc\x3a\xef\xee\x11\x4f\x3a\x83\x31\x6d\x0f\xb9\x27\x3b\x43\x89\x99\x8d\x6a\xcc\xb8\x33\xba\x06\x73\x17\x8e\x74\xe7\x3d\xe9\x14\x21\xfc\x6e\xd2\x8c\x60\xd3\xb4\x79\xf1\x83\xa6\xcc\x4b\xbe\xb6\x2e\xf0\x83\x9f\x2d\x04\x8e\x69\xf3\x2d\x10\x18\x69\xf1\xa4\x48\x27\xa6\x9e\x3a\xd0\xc1\x94\x8c\x9b\x27\xe1\x56\x24\x9d\x85\x8c\x4d\x99\xa8\xf4\x1c\x24\xcc\xd1\xac\xd9\xba\x74\x73\x25\x9f\xa4\xd9\xba\xac\x50\x39\x2e\x33\xd5\x4a\xb5\xeb\x2e\x6c\xc2\x4b\x70\xec\xb4\x4b\xf5\x9c\x2c\xb7\xee\x36\x32\xd6\x2c\xcd\xf6\x43\x34\xd5\xa9\xd9\x7e\xd4\x24\x7f\xff\x02\x21\xf3\xa2\x39\x53\x33\x79\x1a\x20\x60\x19\xf4\xe6\x62\x6d\xb6\xde\xde\x52\x6e\xbd\xf6\x36\x6b\x6f\xed\x73\x50\x7a\xb9\x6c\x9f\x2a\x6d\xbd\xb9\xc3\x4a\x67\x30\x0e\x25\xef\x3a\x5d\x3f\x97\xde\x7a\x37\x69\xb3\x35\x53\x16\xfc\x2a\xdd\xc9\x35\x5b\x5f\x3b\x7e\x4d\xba\xf5\xcb\x77\x48\xf5\xa4\x1e\xa1\x83\x60\x09\x47\x51\xe6\xfb\x62\xbb\xde\x68\x1b\x5b\xd7\x6c\xa3\x7d\xb6\x91\x9a\x6d\xec\x15\x2d\x17\x7b\x3d\xa6\x38\x6f\x0e\xda\x6a\x3d\xc2\x7d\x54\x8c\xc5\xd8\x1f\x61\xce\x6c\x23\xcf\xab\x6f\xa3\xb6\x18\xa8\x51\xcb\x30\x22\x24\x12\x38\x1e\xb9\x34\xd8\xc6\xb1\xd9\x46\xd7\x4b\xa0\x3e\x88\xae\x5f\xfe\xff\x2b\x3c\x8d\x82\x99\x23\x9e\x2a\x5f\xc4\x6c\xa3\x47\x39\x7c\x4f\x62\xf5\x07\xf0\x02\x09\xb2\xa3\x49\xc6\x7e\x3c\x4b\x6b\xbc\x7e\x6d\x89\xe8\x63\x3e\xc5\x13\x54\x10\x2c\x9d\xd5\x8b\xef\xfc\x09\x78\x88\x7c\x1b\x7d\x6d\xf5\x72\xe7\xab\x87\xeb\x8c\x5b\xe4\x49\xc2\x0a\xda\xd8\xdd\xc0\x21\xb7\x71\x52\xe2\xc8\x75\xc4\xc7\x0a\x79\x45\xc9\x36\x06\x3e\x0b\xa5\x1b\x31\xa5\x00\xde\xf8\x6e\x71\x05\xb7\x1f\x60\xf3\xae\x7d\xa2\x98\xd2\xe3\xb2\x86\x59\xbe\xf8\x3f\x20\x06\x5f\xfe\xfd\xfe\x38\xf6\x24\xbd\xd5\x37\x42\xc3\xc7\xd8\x53\x8b\xc5\xc8\x00\x99\x9b\x75\xdb\x78\x36\x93\x21\x26\xb3\xdd\xb7\xfc\xac\x13\x53\x78\x4c\xbd\x7d\x99\x3d\xdf\xf1\x3b\xbf\xcf\xde\xc6\x64\xb7\x24\x6c\x63\xfa\x58\x7e\x67\xdc\x10\x73\x11\xc8\x43\x8a\x17\xd6\x33\xe7\x88\x3e\x28\x23\xd3\x2e\x48\xae\xd8\xa7\xf0\x00\xdc\xa6\xdc\xf2\x95\x0b\x58\xcf\xb0\x5c\x33\x10\x7c\x50\x0c\xaa\x85\x49\x24\x58\x76\x6c\xe3\x05\xe6\x12\x4a\x70\x71\xa1\xaf\xcd\x36\x72\xf7\x75\x1b\xe7\x1e\xd2\x19\x67\x45\xbf\x25\x69\xed\x9b\xce\x6d\x92\xbe\x87\xb4\x25\xb9\xf7\x77\x12\xe5\x9b\xc9\x6d\x92\x23\xc8\x88\x39\x93\xd7\x7c\x41\x89\xad\x60\xf3\xaa\x39\x33\xe1\x24\xa1\xff\x93\x31\x25\x73\x16\x37\x30\xb0\x7d\xed\xb9\x4d\x52\xd4\xc7\x7a\x06\xaa\x24\x17\xac\xec\x36\x7f\x29\x32\x31\xf5\xb9\xd9\x26\x6d\x35\xc0\xa6\x48\xda\x45\x50\x88\x26\xa8\x1a\x1b\xc5\x0f\xa3\x41\xf6\x11\x00\x97\xaf\xdb\xa4\x03\x66\x0b\x32\x26\x34\x54\x68\x85\xa4\xee\x68\x14\x8b\x76\x00\x7a\x52\x87\x26\x9d\xa0\x3c\x93\x4e\x93\x65\xb3\x16\x5b\xb3\x37\xe3\x92\xfb\x9f\xdb\xa4\xb5\x98\xbf\xbd\xc0\xd8\x26\x9d\x37\xff\xc9\x99\x5d\x9a\x06\xc9\xdc\xc0\xdc\x26\xb7\xce\x69\x58\x50\x64\xa5\xf1\xbe\x4d\x0e\xa3\x75\xe5\x65\xfc\x01\xf2\x1d\xad\xc0\xe2\x0d\x03\x9d\x43\x4c\x02\x3d\x73\x47\x77\x4f\x0e\xa9\xe4\x26\xf1\x3d\x9f\x72\xe8\x24\xc7\xc6\x73\xa1\x87\xa2\x48\x2e\x70\xab\x75\x9b\x2c\x56\x56\xbe\xfd\xda\xa6\xd8\x2e\x5f\xa1\xc9\x53\xec\xc6\xd8\xb1\xd3\xa3\x06\xee\xf4\x13\xa9\x89\x9a\x41\x85\x09\x00\xc8\x6d\x42\x22\x17\xba\xd3\x67\x40\x6b\x81\x38\x61\x24\xa6\x38\xcd\x18\x01\x29\x86\x6e\x74\xe4\x7d\xa4\x68\x45\x13\x9e\x18\x19\xfd\x8c\x45\x2b\xf8\x99\x2c\xc7\x9a\x7a\x96\x85\xcd\x1b\x73\x66\xdc\xc2\x84\x2b\x63\x5c\xd4\x28\x9e\x57\xc9\x9b\x3f\x7c\x68\xb0\x4d\xb5\x55\x52\x16\xa1\xb6\x48\xa9\xf6\xfc\xee\x8e\x0a\xf7\x87\x63\xa6\xc3\x91\xae\xdd\x51\x27\xb3\x5f\x92\x09\x47\x9d\xad\x67\xe7\x9b\x86\xd1\xb2\x5e\x7e\x3d\xa2\xde\x08\xb3\x7c\xbb\x37\xd0\xf2\x3d\xb8\x81\x1c\xd1\x2a\xcc\x5a\x09\x11\x42\x59\x05\xba\xb5\x4a\xea\x9d\x71\x98\xea\x34\x52\x6b\xa7\x94\x8b\xda\x6b\xf2\x50\xe9\x55\xa1\xac\xc1\xa1\x6a\x73\x11\x85\x4d\x58\x35\x39\x7a\x26\x4c\x8f\x95\x17\x59\x18\xdb\xfc\xc5\xbe\x74\xdf\x56\x2d\xbc\xcb\x67\x5b\xb9\x9b\xda\x6c\xab\x1b\x06\x32\xdf\x72\x17\x07\xc8\x16\x3d\x04\x75\x62\x8a\x10\xd6\x1f\xc8\x69\xe2\x5b\x4c\x3a\xe2\x1a\x05\xd3\x65\x75\x93\xa4\xe5\x5f\xd1\xd2\xd5\xe6\x95\xba\xce\xe3\xd5\x61\x20\xd5\x0f\xe1\xdd\x8c\xdb\xea\x35\xb1\x8e\x21\x68\x82\xd5\x5a\x03\x57\x6e\xdb\x8a\x1a\x9f\xcf\x08\x7b\x2e\x08\x90\x06\x0d\xae\xbf\xa1\x3a\x6b\xcd\x33\x6f\xb0\xdc\x56\xbb\xb4\x6f\x5b\x73\xf5\x2a\x68\x9b\xc2\xdb\xa5\xb6\xb5\x8c\xcb\x37\x98\x56\xb4\xbd\x13\x94\x74\x2d\x6e\xf9\x7a\x87\xc1\x02\x41\x42\x6a\xf9\x43\x90\xab\x7d\x6c\xb9\xad\xb3\x35\xd4\x6d\xe2\x32\xaa\x2e\x5f\xfb\xe5\xaf\x99\x60\xa8\x6a\x3e\x63\x24\x2b\xd4\x9d\xd7\x39\x04\xdd\xfc\x8b\xd4\x81\x5d\x3d\xab\x0f\xee\xd4\x6c\xef\xb7\x08\x6d\x97\x5f\xab\x09\xca\xf2\x35\xd0\xd2\x5d\xbe\x9a\x8d\x83\x85\x1f\xf3\x58\x7e\xe7\x9c\x45\xb6\x79\x8c\x1e\x6b\x04\x73\x1c\xa4\x26\x07\x41\x35\xe7\x7b\xe4\x4d\x54\xdb\xe5\xf7\x14\x1c\x0c\xd7\xe5\x5b\xe8\x6e\xcb\x37\x0f\x64\x37\x2d\x6e\x97\x6f\x65\x3d\x6e\xb3\x5d\xbe\x2b\xc7\xf5\xf2\x7d\xe2\xd4\xbf\x7c\xa7\x60\x40\xf2\xe2\x15\xfc\xa2\x61\xf9\xf7\x66\x27\x32\xa8\xd1\xd4\xec\xa4\x75\x2d\xa8\x9f\x9b\x9d\x7d\xfb\xbd\x93\xee\x97\xaa\x7c\x31\xbd\x93\x5e\xba\x66\x27\x3a\x60\x22\x03\x77\xcd\x4e\x8e\xea\x7d\x60\xdc\x41\x7b\x18\xb7\x3b\x1a\xb4\xa4\xf1\x0a\x9e\x4b\x5a\xbe\x4d\xcd\x4e\x46\x78\xf3\x60\xce\x4e\x3e\xfa\xda\xec\xc4\xcb\xe4\x90\xa4\x5f\x5f\x1a\x02\x25\xbb\x16\x64\x27\xbe\x17\x50\x94\xce\xb6\x0c\x76\xe2\x5d\x87\x3c\xbd\x27\x69\x79\xbf\x13\x11\xec\x8d\x1d\x6f\x54\x23\x0d\x3f\x3d\x2c\x80\xbb\xf3\x60\xde\x99\x74\xf9\x0a\x16\x03\xcb\xeb\x73\x51\xe4\x81\x92\x4e\xc2\x37\x59\x00\x79\x44\x29\xb0\x84\x2e\x11\xc1\x26\x36\xca\xc4\xf4\x26\xc7\x08\xf6\x49\xed\x4e\xa6\xf5\xd9\xa4\xab\x8b\x61\xce\x28\xd3\x74\x93\x38\x78\x54\x39\xc8\x20\x45\x08\xae\x64\x6d\x4d\x68\xed\xd0\x29\x69\xec\x4e\xe0\xac\x3b\x66\x41\x52\xbf\x79\x97\xdb\x4d\xb1\xdc\xd8\xc1\x4e\x03\x3d\xa1\x0e\xd0\xd2\x60\xc4\x59\x0d\xaf\x8d\x15\xf2\xe0\xd8\x0a\x3c\x0a\x0e\xc6\x39\x79\x27\xa1\x7a\xa4\x8e\xfa\x9d\xcf\xcd\x4e\xf8\x42\x08\xec\x24\x17\x82\x9e\x25\x4f\xfd\xc8\xc6\x48\xbd\xb5\x1a\x74\xed\x4e\xf8\x4d\xf8\x4e\xd2\x89\x47\xf9\x76\xc2\x91\xb9\x5b\xcf\x1f\x82\x5b\x5b\xa4\x09\x3a\x94\x3c\xf4\xe4\x0c\xcc\x74\x31\xf5\x04\x03\x79\x65\x16\x87\x09\x17\x37\x18\xb3\xf4\x8a\x37\x6a\xb9\x94\x35\xa6\x1d\xb2\x02\x88\x16\xaa\xae\xa1\x2f\x16\xfb\x62\xc1\x6f\xcd\x4e\xb2\x1d\xf7\x03\xf0\x7d\xe4\xf4\xb3\x93\x3c\xba\xb5\x87\x33\x3f\xa6\x07\x77\x6c\xc5\x6c\xc4\x64\xcc\xb6\x6b\x8d\x17\xf2\x93\x24\xf3\x30\xc9\x2a\x72\x52\xde\x30\xbc\x93\xd2\x8d\x46\xed\x01\x8f\xbd\xec\xa4\xd4\xec\xf8\xb8\xb6\x30\x9c\x77\x52\xd9\xbf\x95\x97\x24\x3a\xf3\xd1\x94\xbd\x5e\x80\xec\x88\xe1\x8e\xb7\x94\x81\xe5\x53\xe0\x75\xc2\x80\x36\xc8\x6a\xfe\xe9\x77\x71\x17\x64\x71\x3d\xf1\xc9\xf5\xc4\x81\x75\x3d\x65\x7b\x63\xbe\xb3\x57\x46\x3b\xb9\x3b\x81\x67\xd4\x1c\x5c\x51\x8a\x39\x40\xbe\x6e\xd9\x3b\xbb\x6a\x76\xa7\xd2\x67\x50\x94\x4b\xb1\x2a\xde\xf1\x38\xeb\x4e\x05\x29\xa8\x94\xda\xd6\xd0\xec\x60\x5c\xe5\x92\xd4\x95\x66\xc7\xcb\xc8\x7a\x72\x48\xbf\x76\x25\xa6\x66\xa7\xb6\x3c\xd8\x69\xbf\x5e\x8d\xbb\xd3\x5e\xc3\xf2\x2b\x52\xef\xa1\x70\xb4\xe7\x9b\xee\x9d\xf6\x69\xf9\xca\xc7\x57\xf4\x9c\xf2\x72\x85\x9d\xa2\x46\xaa\x5c\x3d\xec\x74\xd0\x7e\xf9\x9e\x09\xfc\x24\x1c\xca\x3a\xf0\xa2\xa7\xf5\xe5\x2a\x9c\x57\x2b\x34\x96\xdc\x60\x13\x09\x96\xd9\x3b\x0a\xbd\x3a\xd4\x15\xd4\x24\x47\x5d\xe0\x6b\x60\x22\x0e\x1c\x75\xdc\x64\xdf\xe9\xa9\x44\xba\x7d\x8b\xfa\xfb\x3e\x61\xd9\x07\xe4\xdb\x38\x91\x33\x98\xf7\x56\xe2\x49\x7a\xf4\x34\xcf\xb3\xef\x60\x02\x22\xcd\x89\xd7\xbe\xed\x34\xfc\xa8\x7e\xe8\x93\xa3\xec\x01\xcd\x4c\x30\x70\x89\xb6\xb3\xbd\x95\x9d\x86\x35\x4a\x50\x2e\xdf\x77\xf6\x52\x6c\xf5\x62\x12\x81\xc7\x1d\x09\xf8\xf1\xdc\x4e\x43\xb2\xc6\x47\x65\x4e\x6b\x46\x89\x51\x60\xfb\x33\xd5\x6c\xb5\x33\xf3\x76\xa7\x18\xf1\x8a\xe9\x62\x65\x50\x99\x9a\xe4\x04\xba\xe6\x9b\xda\x4c\x46\x35\xc0\x9b\xcb\x94\x49\xa4\x61\x7d\x6e\xdd\xc2\x4b\x23\x76\xb6\x55\xb3\x5b\xcf\x3f\x1a\xcf\xe4\x96\xef\xfd\x85\xdc\x0f\xb4\xf9\xf1\xd5\xe8\x4f\xaf\xbd\x84\x52\x2d\x74\x1f\x8d\x0f\x69\xcd\x34\xf0\xc8\xf6\xee\xe7\x7b\xbc\xdd\x1f\xde\xe3\xfd\x31\xa9\xd5\xef\x0f\x33\xfe\x1f\x7c\x0f\xcb\xef\xe7\xff\xe8\xf3\x2e\xc9\x05\xcc\x00\xb0\x02\x8c\x26\xd9\x3c\xa4\xc4\x7b\x7c\x77\x9a\xf2\x78\x8a\x17\x57\x18\xa9\xb0\x2d\x93\x09\x48\xba\xad\xc2\x9d\x6e\xab\x1b\x4a\x88\xea\x10\x16\xbb\x51\x7b\xcb\xbf\xd3\x55\xa8\x6a\x8b\x04\x78\xd9\x02\x9a\xb0\x1e\x8f\x4c\xb1\x06\xbe\x27\xd8\x69\x4d\xd0\x0b\x5a\x31\x81\xea\xd5\x7a\x72\xb6\xf7\x0b\x00\x88\x33\x07\x1e\xa4\x01\x80\xe0\xb9\xd6\xba\x0b\xbc\x18\xc7\x32\x60\xe7\x90\x90\xb3\xd1\x08\x16\x8c\xcd\x64\x78\xae\x67\x10\x64\xe4\x06\x53\x07\xce\x2e\x6c\xd9\x71\xe1\xb0\x73\x83\x0b\x6d\xe4\x3d\x77\x3b\x67\xe7\xa0\x77\xee\x03\xc6\x3c\xa2\x78\x71\x68\x2e\x6e\x4a\x81\xf5\xda\xda\x75\x2d\xc0\x49\xa1\x27\x9c\x1f\xec\x99\x9d\x1e\xdc\x39\xe8\x50\xe7\x7d\x17\x85\xc1\xbc\x45\x21\xe4\xe6\xd9\x8e\xeb\xdc\x9d\xf3\x67\xab\x91\x5f\xd5\xfb\x3a\xb1\xba\xc9\xdd\x6a\xb3\x73\x8c\x12\xfa\xe5\x0b\x2a\x18\x22\x88\x0d\x33\x97\xc4\x91\x46\x52\xbb\x26\x1e\xc8\x54\xb3\x4b\xbc\x35\x64\xe7\xf8\x2a\x7e\xe7\x52\x54\xd2\x20\x16\x2c\xc6\x95\x67\xfa\xe7\x6e\xe4\x2e\xdd\xce\xad\xf7\x62\xee\x5c\xe9\x46\x9b\x98\xed\x3a\xf9\xdd\x87\xfa\xe9\x97\x8b\xc3\x30\xf9\x88\xa9\xe4\x82\xb6\xf2\x35\x97\xe5\xb7\x53\xb3\x8b\xe8\xb4\xd8\x8d\x58\xf0\xf1\x86\x4d\x38\x72\x51\xb3\x6b\x62\x37\x56\xef\x9a\x1d\xcf\x95\x92\x66\xb2\x93\xcb\x06\xd8\xf9\xb1\x5f\x6f\x3d\xde\xf1\x4a\xaf\xb0\xd9\x7b\x17\xee\x07\x16\x76\x51\x49\x46\x48\x58\x84\x2a\x8f\xfa\x7f\x09\x93\xc6\xd5\x08\x89\x9a\x28\x39\xf1\x68\xd7\xfb\xee\xe2\xd1\xe8\x91\x63\xd7\xf8\x4f\x90\x75\x18\xcc\x71\xd7\x89\xf1\x47\x80\x9f\xd5\x18\x79\xa6\xc9\xe0\xc4\xab\x39\x76\xb6\x05\xb4\x8b\x3f\x2d\x30\xc0\xc4\xa5\x23\x6f\xe7\x41\x13\x46\xef\x23\xcf\x3d\x00\x2d\xbf\xa3\xce\x7e\x22\xb1\xb8\x54\xa0\xd1\x4f\x36\xec\xa3\x9f\xf2\xe9\xc3\x4a\xef\x73\xd9\xbc\xd9\x27\x99\xbb\xe8\xef\x47\xae\x01\xc7\xea\x18\xa9\x94\xca\xa4\x6a\x6b\xd4\xce\xcc\x69\x37\xf2\x55\xd6\x2e\xfa\x2b\x66\xc1\x38\xb5\x89\x2d\x3b\xa1\x2f\xed\x3b\x38\xca\x63\x9c\xb8\x2c\xdd\xc5\xc9\x06\x5e\xa4\x19\x16\x03\x64\x2c\x86\x21\x82\xa6\xda\x3a\x78\x62\xc8\xc7\x38\x78\x8b\x18\xad\x21\xe2\x85\x0c\x26\x5f\x3c\x9b\x41\x11\xcf\xe6\x3a\xdb\x93\x33\x75\x67\x3c\x9f\x99\x0f\xf5\x6e\x4c\x12\x1c\x6d\x65\x9a\x0c\x31\xdd\x07\x5d\x4c\xf6\x0f\x09\x00\x77\x1f\x0e\x6b\x4a\x67\x4c\xec\xfd\xfb\xbd\x5b\x40\xb9\x1b\x2b\xcb\x9d\x62\x3f\x63\x41\xb3\x8b\x09\x32\x1a\x53\x89\xad\x7d\x63\x03\x5c\x51\xac\x2c\x43\xac\xbd\xa0\x50\xd6\xa0\xd9\x99\xff\x5a\xec\x1c\xd7\xbc\x73\x9c\xc9\xb2\x38\x06\xcb\x6a\xe1\x90\x6e\x81\xa6\x8c\xf7\x35\x07\x10\xdb\xb5\xe2\xf7\x7f\x34\x7e\x6d\x93\x50\x1d\xc5\xbb\x9d\x13\xab\x6d\x71\xef\x62\x9d\xb0\x3a\xda\xf1\xca\x69\xd0\x12\x2b\x9f\x63\xa9\x46\x56\x67\xb8\x2f\xe2\xb9\x1e\x01\xd4\x3f\x40\x3b\x8c\xbd\x63\x1b\x72\x8b\x6a\x17\xaf\x4a\x42\x39\xbb\x32\xd5\x19\x4d\x9e\x1d\x94\x44\x76\x3a\x82\xa2\x2f\x72\xc5\x6f\xb3\x23\xa3\xf7\xf2\xbd\xd9\x55\xc1\xb3\xca\xb4\x2a\x5f\xff\xf1\x42\xfc\x5d\x6d\x6d\x2d\x54\xa9\xfe\x40\x37\x7f\xd6\x5c\xc4\xc7\xdb\xcd\x35\xbb\xca\x63\xe2\x3b\x14\xab\xc2\x4a\x62\x20\xb5\xb0\x9a\x8a\xe4\x66\x57\x8f\x47\x90\xd2\xec\xea\xaa\x76\xeb\x30\x2a\x59\xd5\x80\x36\xae\x3c\x44\xbe\xab\x23\x8f\x22\x02\x40\x8d\xe0\x01\x72\x70\x9b\x03\x03\xb9\x8d\x37\xfe\x91\x39\x8a\xab\x5b\xb9\x6f\x05\x26\x40\xf5\x85\x96\x55\x9d\x2c\xfb\xf5\xbb\xa5\x5d\x9d\xce\x23\xdf\x58\xef\xaa\xf5\x5a\x0d\x85\xc4\xf0\x8d\xff\x0f\xb0\xab\x14\x9c\x9a\x6a\xa6\x14\xd4\x1c\x60\xf6\x57\xa8\xa8\x5a\xba\x71\xd5\x4d\x84\x2b\x1a\x41\x8a\xe1\x52\xad\xbc\x9f\xa9\x9e\x67\x2c\x63\x66\x1b\x29\x64\xc8\x60\xf6\xee\xef\x5f\x9a\xdd\x7c\x59\x7e\x4d\x56\xbb\xf9\xaa\x7e\x7d\xe9\xb7\x5b\x7e\xc5\x14\xda\xec\x96\xaf\x1d\x55\xf7\xf2\x35\xb9\xd0\xfd\x62\x20\x67\x4c\xdb\xcb\x37\x68\x93\xe5\xdb\x18\x18\xf0\xdb\x18\xe8\xfc\xa1\xf4\x96\x6f\x36\x88\x97\x6f\xab\x9e\x58\xbe\xe5\x1f\x3a\x63\xf9\x9f\xd0\xa0\xcb\xf7\xb5\x8b\x96\xef\x03\x13\xf9\x1e\xd6\x19\x73\xf9\x9e\xbc\x5b\x83\x7e\xa7\xc2\xfb\x24\x53\x0c\xae\x8b\xcd\xa7\x56\x92\x0c\xae\xf9\xd4\xba\x9b\x34\x9f\xda\x24\xa3\x9b\x9a\x4f\xed\xf2\x65\xf9\x9b\xde\x9a\x4f\x83\xd7\xec\x24\x37\x9f\x86\x20\xc5\xe9\xc5\x40\xfc\xc1\xa5\xf9\xe4\x5c\xf3\xc9\xe5\xd1\xb5\xd2\x7c\x3a\xc5\xc0\x7b\xe8\x9a\x4f\xfe\x4f\x2e\x18\x15\x63\xb6\x0f\xf5\xc9\xbb\xe3\x11\x94\xde\x1f\x0c\xf3\xf1\xf3\x21\x3f\xf0\xfd\xe4\x8b\xcb\xcd\xa7\x49\x1c\xc8\x4d\x4f\xe0\x54\xbb\x9f\xa6\x21\xc1\xd0\xfe\x34\x79\x99\x9b\x4f\x53\x1e\x35\x68\x81\x6e\xf8\xc4\x3f\xb2\x70\xbc\xf9\xe7\x53\xe8\xe3\xd8\x7c\x0a\x83\x57\x50\xa8\xdb\x4f\x61\x48\x32\x35\x9f\xb8\x3f\xfa\x89\xb7\x22\x7c\x0a\xb1\x2a\xe9\x0c\xb6\x7c\xe9\x5c\x6c\x3e\xfd\xd2\x8a\x6f\x3e\x25\xfb\xd6\xf6\x53\x1a\x30\x70\x3e\xa5\x89\x76\xcd\xa7\x34\xd9\x21\xa0\x4f\x29\x86\xec\x7a\x6d\x3e\xa5\x0b\xc6\xd1\x27\x1e\x9e\xfc\x74\x3f\x88\xf6\x29\x8f\x3f\x91\xfb\xc0\x8a\xe9\x13\x47\xe7\xa7\xec\x51\x88\x9c\xa5\xfc\x0c\x9b\x5d\xf3\x29\x97\xbb\xb3\xf0\x37\x82\x04\xc0\xba\xfe\x6f\xc2\xa7\x72\x73\x43\x8e\x63\x68\x3e\x95\xe5\x7f\x34\x9f\x2e\x5c\x38\x93\xa1\x25\x2f\xb6\xfd\xfe\x09\x1a\xfb\xd3\xc5\x4d\x3a\x37\x9f\x2e\x31\x35\x9f\xae\x92\x15\xb4\x08\x1a\xe9\x0a\x6b\x62\x65\x74\x6b\x68\x3e\xad\x7f\x4c\xf3\x26\x92\xf2\x45\x7d\xdf\xbc\x49\xeb\x23\xb7\x90\x7e\x1c\xd0\x06\x3a\x91\x60\x7c\xbc\x49\x17\x5b\xa3\x9a\xbb\x68\x28\xaf\x4c\xd7\xe7\x79\x0d\xf9\x4b\xd5\x7c\xe7\x9b\xf5\x80\x03\x9d\x2e\xdc\x79\x2c\x40\xfc\x98\x0f\x79\xf2\x6b\xd3\x37\x39\x26\x38\xc6\x80\xd8\x63\xec\xa5\x79\x13\x46\x39\xad\xdf\x89\xbd\xc9\x29\xb6\x0c\x09\x43\xa1\x79\x93\x89\x19\x4d\x6a\x19\xf3\x27\x4c\x30\x48\xe2\xff\x33\x4c\xc0\x7c\xe9\xf5\x26\x41\x41\x06\x90\x93\x90\xd2\x23\x5e\xb4\x03\xcf\x6a\x94\x41\xb3\xf1\x24\x13\xcd\xf3\x37\xfb\x62\xc0\xd8\xf2\x15\x60\x8a\x9e\xfd\xf7\x26\x29\x5a\xd9\xd2\xcd\xe2\xdc\xf4\x8a\xe4\xf9\xfa\xfe\x4d\xf2\xc5\x81\x95\x8c\xd0\x9b\xa7\x71\x52\xaf\xbc\xb5\xe5\x4d\x30\xfb\xbc\x89\xdd\x02\xf1\x26\x75\xd2\xe6\x4d\x79\x71\x21\xd8\xd9\x47\x94\x55\xdb\xb6\x79\x53\xbd\xa2\x82\x7a\xe4\x82\xeb\x4d\xf9\x20\x7c\xb8\x52\xdc\xa9\xfa\xe6\x7e\xe4\xe8\xed\xbe\xc2\x7a\xe3\x9b\xbc\xd5\xd4\x78\xd3\x33\x9b\x47\x53\x66\x64\xab\x9a\xe6\x92\xe2\xb5\x79\x1b\xa5\x79\xe3\xa5\x1d\x6f\x8e\x6d\xe3\xa6\xe5\x6b\xd0\xdb\x0f\xb0\x79\xd7\x8f\xe5\x37\xc9\xcd\x1b\xea\xef\xd2\xf2\x25\xeb\xa9\x79\x73\xb7\x56\x9a\xb7\x88\x26\x8f\x12\xd0\xb2\xb1\x1b\x75\xca\xcd\x5b\x1c\x25\x6c\xfe\xd1\x33\x13\xe0\xf0\x03\xfd\x04\xf1\x0e\x56\x64\x84\x9f\x78\xbe\xc5\x11\xc5\x8a\x23\x44\x21\x7a\x77\xd1\x02\x3e\x37\x6f\x6c\x8e\x18\x06\xae\xcf\xde\x22\x3f\xb2\x30\xb6\xf9\x17\x4d\x63\xd4\x63\xf3\x16\x53\x2f\x2c\x13\x6f\xad\x7e\xe3\xf5\x9f\x6a\xc5\xcb\x7a\x1e\xc1\xb2\xd2\x76\x7f\x83\x01\xc0\x2f\x1d\x82\xdc\x9a\xb7\x68\xf9\x5c\xb4\x6c\x1e\xf8\x60\x46\x59\x6a\x8f\x1f\xc9\xcd\xd9\xb9\xa0\xb7\xaa\xbe\x79\xab\xe3\xf2\x25\xc3\x31\xf1\xf2\xbb\xb7\xca\xe3\x9f\x6f\x15\x45\xac\x61\x18\x2b\xaa\x54\x79\x68\xeb\xad\x86\x9a\x73\xbc\x00\xcc\x8e\x01\x92\xf0\x2e\xd1\xb7\x9a\x6e\xae\xdc\x00\x72\x77\x93\x13\x38\xaf\x8c\x7d\x83\xfd\xd0\xbc\xd5\xcf\xcd\x5b\xbd\xe9\xb9\xbb\xd5\x53\xf3\xb6\x7c\x49\xe2\x36\xfb\x38\xf9\xe5\xf7\xde\x35\x6f\xcb\xaf\x63\xb0\xd7\xb9\x6f\xcb\xb7\x34\xac\x7d\xcd\x6b\xc5\x56\xf8\x9d\x7b\xfd\x7b\x11\x2f\x92\x9b\xfd\xfa\xd7\x11\x7b\x69\x97\x2f\xa5\xd9\x4b\x37\xa6\x18\xc1\x6f\x93\x24\x3d\x35\x7b\xe9\x95\x5b\x22\x7b\x51\x28\x95\xbd\x0c\x12\x36\xff\xb9\x02\x8d\xe2\x4f\x42\xe0\x21\xac\x7b\x19\x43\xb3\x17\x17\xaa\x17\xce\x7d\x7b\x71\x99\x51\x3e\x7a\xe9\xb2\x03\x30\xd3\x0f\x60\xaa\x09\xe0\xe4\xec\x00\xd5\x5e\x78\x0d\xd3\x5e\x7c\x0b\xd2\x8d\x6d\xb2\x63\xb3\x7b\xf1\x43\x2d\x96\x8f\xe7\x17\x04\x7b\xf1\xbe\x86\x13\x02\x4f\xf8\x89\x27\x4d\xf4\x10\x94\xc3\x5e\x39\xef\xb9\x9f\xb9\x97\xc9\xd9\xbd\xc3\x40\xa7\x58\x0c\x78\x56\x7a\xba\x17\x63\x72\x33\xd9\x24\x65\x9c\x01\x2c\xeb\x69\xce\xdd\x78\x42\x7b\x04\xde\x35\x78\x95\xa2\x0a\x57\x8f\xd0\x81\x50\x47\xc7\x24\xc2\x00\xe2\xe8\x87\xf5\xf5\x1e\x23\x60\xb0\x6b\x37\xf7\x12\x68\x04\xee\xf9\x27\x4c\x13\xeb\x1b\x4a\x1e\xe5\xa2\xde\x11\x5f\x9c\x67\x77\x20\xaf\xf3\x20\x05\x61\xcf\xae\x48\x40\x69\xce\x31\x22\x0d\xfe\x64\xf3\x5e\x53\x16\xc2\x23\xfb\x05\xf6\xa7\xd5\x37\x49\xd6\x0b\xf9\x45\x0c\x74\xeb\x97\xd1\x7b\x49\xf7\x30\x0e\xd9\x24\x4f\xe2\x58\xe8\x74\xb6\x7b\x3d\xf7\x92\x92\x5d\xe8\xb1\x97\x94\xe5\x14\x8f\x47\xa0\xda\xa2\x30\x9c\xda\x8c\x59\xd8\x3c\xce\x72\x06\x3f\xb3\x82\xb9\xd8\xb5\x53\x7b\x2b\x7b\x71\x81\xf5\x29\xa8\x90\xcd\x32\xe4\x6c\xe6\x52\xb9\x4d\xb9\x17\x36\x53\xb5\x05\x1b\x40\xd0\x44\xb3\x7a\x2f\xd5\xf3\xaf\x33\xf6\x42\x49\xab\x89\x99\x57\x58\x4f\x7b\xfb\x98\x7e\x2f\x97\xea\x05\x02\x7a\x15\x16\xf0\x2a\x58\x4c\x80\xdb\x42\x03\x68\xed\xdd\xab\x64\x61\x01\x6e\x3a\x9d\x23\x53\xbc\x4d\xce\xa8\x26\x8e\xb2\xbd\xa2\x08\xfc\xa2\x7b\xaf\x52\x32\xe8\xdc\xec\xb5\xc3\xa3\xfe\xa2\xf0\xe0\xcd\xf4\x7b\x55\xae\x76\xf6\x3c\x10\xbc\x57\x37\xf0\x4a\x9e\xbd\x3a\xb6\xaf\x3a\xdb\x44\xdd\xeb\x1f\x3e\x16\xdd\xdb\xee\x9a\x31\xda\x5f\xfb\xf5\xfd\x02\x78\x1c\x90\x9a\xcf\x4c\x7c\x3a\xdb\xd6\x13\xd0\x11\xb4\x7a\x49\x6e\x96\xcd\x3f\x48\xe1\x8d\x52\x7b\x0c\xac\x75\xdf\x0d\x00\x71\x43\xd0\x7e\xbe\xf3\xcd\x3f\xfe\xc9\x60\x19\xc1\xef\xe1\x0a\xc8\x0d\xe2\xa9\x67\x2b\x4c\xda\xac\x07\xb5\xf6\x9a\xa4\xf6\x89\x09\x27\x2c\x6c\x10\x28\xd1\x65\x65\x4c\x67\x10\xa2\xc4\xaf\xe5\xf6\x9a\xf2\x28\xd7\x66\xaf\xf7\xda\xce\x59\x57\x71\xd2\xe5\xff\x47\xaf\x51\x3e\xf4\xec\x30\x2a\x47\xc4\x80\xd2\x17\xb0\xa4\xa4\x27\xbd\x50\x11\x8d\x76\x77\xdb\x05\xa8\x30\xb4\xe6\xab\x78\x8f\xb0\xeb\xed\x4a\xfb\x31\x9e\xc6\xf4\xc1\x9b\x95\xf6\x63\x4d\xc2\x84\x2e\x91\xff\xc8\xb4\x77\xa2\x29\x9f\x62\x6d\xf6\xdc\x89\xdc\xbb\x9e\x53\xc6\x7e\x1d\x79\x4e\xb3\x95\xcb\xe9\xb5\xd9\xf3\x76\x86\x66\xef\x4e\x26\x22\x8e\xd7\x28\x90\x65\x30\xaf\x1a\x8c\xaf\xdd\xe4\xbc\x77\x67\xb2\xbb\x9b\x4b\xde\xbd\x9b\xf0\x6b\x13\x3f\x34\xdd\xbb\x29\xc0\x2a\xdd\xaf\xda\xc4\x4d\xb3\x20\xf1\x60\xdb\xc5\x7b\xcb\x3e\x7c\x44\xd0\x75\xac\xba\x10\xb8\x9a\xd8\x3b\xac\x4d\x84\x7f\x90\xb6\xe2\xe5\xbf\x11\x9f\xcf\x2e\x97\x66\xef\x12\x4b\x98\xec\x0a\x53\xe6\x9d\xec\xcb\x08\x02\x7b\xfb\xb1\x77\xc9\x99\xe1\x41\x84\x76\x22\xbf\x92\x17\xe8\x30\xa6\x99\x3e\xac\xa9\x5d\x3a\x91\xb4\xbc\x6d\x17\xe8\x7e\xf6\x7d\xef\xd2\xd9\x75\x37\x33\xde\xf7\xdc\x36\x5a\xf3\xca\x7f\xc8\x2c\x97\x78\xe4\x7b\xfd\xbd\x4b\xd5\x43\xa3\xb8\xec\xee\x26\xcc\xde\xe5\x7b\x93\xa3\xfc\x45\xf8\xa6\x02\x80\xdb\xd8\x00\x6b\x3b\x41\x53\xb8\xe5\xd7\xb5\xfb\x3e\xd4\xcc\xfe\xbd\xe7\xc5\xcc\xd6\x76\x5e\x7a\x87\xd8\x1c\xea\x3c\x92\xb3\xf7\x52\x33\xe8\x05\xd6\xcd\xde\x6b\xcb\x79\xc7\x6b\x4b\x97\x23\x66\x38\xfe\xa1\xce\xde\x7f\xd4\x99\x95\xf1\xf1\x1c\x0b\xa6\x34\x6f\xfb\x16\x7b\x1f\xf3\xad\x63\xc3\xf8\x58\x6e\x30\xd7\xf6\xbe\xe2\x79\x9d\x39\xd5\xf9\x95\x61\x4d\x06\x10\xe4\x08\x72\x3e\x83\xa2\x0c\x81\xe7\x8f\xf6\xf6\xe6\x62\x1f\x78\x37\xe4\x3e\xc4\x96\xdf\x66\x1b\x8a\x06\xba\x51\xc1\xf4\x7c\x3c\x7a\x2e\xae\x87\x3a\xc3\xc3\x23\x7a\x64\x8a\x91\xdf\x62\x00\xf0\xe2\x1a\x72\x0a\x72\x88\x9f\x49\x36\xeb\x8d\x15\xfb\x50\xf9\xd9\xc8\x3e\x60\x79\xba\x0f\xcb\x6f\x50\x0b\xb1\x15\x12\x7b\x17\xb2\x22\x82\xca\xc9\x8f\x05\x89\x98\xc5\xd8\x47\x77\xb4\x79\xd6\x8b\xeb\x97\xaf\xf4\x61\xeb\x47\x48\x41\xec\x62\x4d\x76\x54\x7a\x1f\xbb\x99\xfe\x3c\xef\xba\x8f\x7d\xaa\x1e\x2c\x57\x6d\x9b\x7d\x54\x7e\x35\xb8\xb7\x6d\xbb\x7d\xd4\xd5\xe5\xd7\x73\x21\xfb\xa8\xc1\x0d\x2b\xab\xe6\x91\xb8\x1e\x6d\xf6\xf1\x18\x5d\xdf\xec\xe3\xe8\x6d\xa7\x8d\x68\xbd\x3e\x79\x0f\xfb\x6f\x1f\x51\x70\xd7\xdf\x2a\xb4\x75\xfc\x71\xb5\xe1\x9e\xe7\xf5\x6d\x62\x8b\x5e\xd2\xf2\x1b\x8a\xec\x95\x9f\x87\xef\xa3\xc7\x22\x01\x0c\x06\xd6\x3e\x4e\xf6\x6e\x12\x20\x19\x35\xef\xe5\x0b\x0d\x1c\x02\x56\x75\x73\xd0\xe1\xc7\x0c\x14\xc3\x9f\x42\x04\xeb\xef\xab\x52\xe0\x24\x65\x86\x88\xc0\xec\xdc\xc7\xe0\x3e\xc2\x1f\xfe\x0d\x08\x1e\xd6\xf8\xc1\xa2\x46\x98\x1b\x6c\x96\x10\xcf\x2c\x2c\x6f\x96\xda\xff\xb8\x40\x8a\xc2\x17\xc3\x2c\xb0\x02\x62\x4c\xbd\xa6\xdc\xec\xe3\x99\x2f\x6f\xf7\x10\x53\x3f\x42\xd0\xf0\x04\x54\x89\x8f\x20\x8e\xfd\x9d\x82\xe9\x25\x9e\x98\x22\xcf\xa3\x0d\x5d\xa2\xb5\xaf\x53\xbe\xfb\x95\x51\x3c\xd2\xc8\xc2\xe2\x64\x2e\x77\xf6\x31\xc7\xcd\x9f\xf9\x01\xbf\x85\x37\xe7\xc7\x0f\x67\x21\x51\x6f\x7f\x8b\xb5\xb7\xef\x36\x8c\x99\xb9\x10\x73\x59\x73\xc8\xfc\xcb\x02\x70\x5a\x0f\xb1\x40\x7f\xb1\xf5\x8b\x17\x16\x19\x6b\x36\x24\x52\xaa\x17\x63\x4a\x86\x4c\xca\x8c\xb6\xad\xa7\x08\x09\xbb\x50\xa7\xc6\x8b\xac\x9a\x2d\x5e\x24\x6d\x5e\x7a\x66\x7d\x59\xbe\x74\xd9\x38\xe6\xea\x78\x15\x6f\x52\xce\x9d\xec\x7d\xbc\xfd\xac\x81\xfb\x8f\x4e\x64\x7d\x83\x09\x90\xa4\x3b\x41\x41\x26\xd1\x70\xa3\x4e\x4e\xa2\x67\x5d\xd1\xb1\x80\xc2\xb6\x07\x0b\x64\xd4\xbe\xfc\x27\x1f\x01\x3f\x43\x03\x9e\x30\x71\x24\xc9\x9b\x83\xd0\x8a\xe2\x93\x2c\xa6\x83\x81\x2e\x6b\x68\x2e\xf4\xdd\xba\x2b\x02\x77\x88\x67\xde\x02\x6d\x78\x05\xd9\xb2\xc8\xd9\xf9\x9f\x21\xf3\x8f\xd4\x72\xb0\x12\xd7\x6c\x54\x57\xe6\x8d\xd3\xf7\xc2\xff\x06\xd8\x27\xea\xc3\xa4\xdd\x4d\x4b\xb4\xa9\x76\x75\x5c\xad\x56\x5c\x5d\xef\x93\x3a\x8b\xae\x1f\x9d\x33\xb6\xfc\x06\x3e\x9d\x8d\x42\x02\x5b\x0b\x69\xf6\x38\x8f\x7d\x81\x96\xfb\x21\xce\x7d\xb2\xff\xa7\x41\x3e\x5c\x91\xef\x93\xd6\x9b\xed\x9e\xee\xd7\x2b\xdb\xc1\xcd\x2c\x06\x28\x2b\xc2\xb2\x74\x9f\x5c\xc6\x74\x69\x7f\x5c\x37\x55\xde\x07\xbb\xba\x92\x7c\x10\x5a\xa8\x1f\x93\x4e\x72\x17\xca\x0d\xc5\x22\xb9\xcb\xf2\x25\x6c\x76\xbc\xff\xb9\xd9\x27\x3b\x8f\xba\x4f\x71\x00\x09\x3c\xd9\xb6\x4f\xd4\xb1\xa9\xc2\xaf\xba\x0f\x06\xa8\xee\x03\x9d\x5c\xcd\xce\x4d\xd6\x9a\x75\x9d\x08\xd2\x9c\xc7\x35\x3f\xba\xec\x36\xc8\x7d\xba\x29\x96\x13\x6e\xb5\xaa\xd3\xf2\x05\x6d\xb7\xfc\x7a\x97\xa0\xe5\xd7\xb5\x37\x96\xdf\x32\x9f\x7f\xb7\xca\x2f\xdf\x73\xb6\x91\xca\x2d\xd8\x3d\x6d\x6a\x6b\xd7\xda\x42\x8a\xc8\xcb\x0d\xd2\x5a\xdb\x08\xf5\x51\xb1\x22\x33\x5d\x54\x3b\xfc\x4e\x15\x9a\xb9\xf6\x78\x06\x45\x04\x99\xa8\x7d\x72\x72\x61\x87\xd7\x1e\xb3\xfb\x4f\x98\x79\xc1\xcd\xbe\x2a\x14\x69\x85\xdd\x23\x98\x2e\xea\x90\x14\x43\xb6\x8e\x9e\x64\x2d\xd5\x68\x84\x05\x62\xbb\xd4\x0f\xc1\xf8\xac\xde\x21\x53\x2c\xf4\xb0\x3c\xab\xde\x2c\x89\xea\xf3\x08\x11\x18\x81\xa9\x57\x41\xa1\x03\xab\xad\xc4\xea\xd4\x8e\xf1\xe3\x64\x4f\x38\x70\x2a\xeb\xcd\xb7\xc2\xfb\x1a\x58\x94\x50\x30\x73\xd5\x70\x65\xb0\x40\xc7\x99\x06\x5a\x3d\xbb\x8e\x69\x27\xbb\xab\x73\xbf\xfe\xc9\xd1\xbe\x26\x2a\xf7\x9a\xe2\xe0\xc8\x6c\x41\x59\x61\x9d\xd6\x54\x8a\x33\x16\xc9\x6e\x46\x39\xad\xd5\x44\xd7\xed\xaa\x0e\x05\xc8\xa3\x6b\x1d\x5b\x3b\x07\xe5\xbd\x03\xfb\x9a\xcb\x4a\x8b\x6d\xef\xed\x6b\xae\x21\x32\x50\x19\x6b\x5b\xcb\x48\xf3\xb0\x96\x98\x06\x84\xbc\xf0\xf2\xd3\x7d\xbd\xca\x5a\xc6\x99\x66\x45\xbd\xb9\x6e\xe4\x17\x05\xfb\xfa\xf7\x2f\xc8\xfb\x22\x1d\x66\x1a\xbe\x5a\xdb\x43\x75\xc1\x75\xe5\xff\x31\xee\xaf\x31\x6c\x9e\x46\x36\xce\x2c\x21\xeb\x79\xf3\x4f\x71\x92\xbc\x26\x30\x53\xac\x96\x5f\x31\x27\xe6\x8f\xe5\x1b\x70\x0a\xca\xcb\x0d\xf6\xcb\xbf\x25\x1d\xb5\x6f\xf6\xcb\xd7\xd5\x80\xdf\x3c\xcc\x13\xff\x32\x7b\xbf\x7c\x3b\x4a\x92\x61\xf9\x7d\x73\x3f\xbe\xb5\x5f\xbe\x71\x26\x26\x43\x39\x09\xaa\x21\x7e\xc1\x00\xbe\x4e\xcd\xcb\xf7\xd1\x5f\x69\x4b\x2d\xdf\xc7\x95\x32\x04\xaf\x4e\xf0\x0c\x51\x30\xc4\x9b\xc3\x9f\xb8\x7b\xcd\xaf\x42\x0e\x22\xa1\x39\x48\x2b\xa1\xe7\xf7\xa6\x07\x69\xdb\xe5\x2b\x98\x93\x13\x99\xfd\x89\x1a\x2f\x62\x3c\x48\x1b\x6b\xd2\x42\x70\x5b\x03\x24\x87\x07\x9d\xa6\x5e\xc8\x67\x50\xbb\xdd\x17\x00\xf2\x04\xee\x99\x4f\x77\x22\xb1\x40\x98\x8d\x9a\x83\xf4\x7d\x73\x10\xcd\x79\xf9\x9f\x70\x41\xea\x0b\x1e\x1f\x79\xed\x67\x73\x90\x41\x30\x95\x1e\x64\x58\xff\xee\x98\x88\x7f\x0e\x70\x90\x11\xc4\xe1\xc7\x4d\xe6\x83\xb8\x80\xce\xd0\x14\x81\x13\x52\x3e\x8d\x12\xce\xe2\x81\xf8\xe7\x2f\x00\xf5\xef\x5f\x5c\xd7\x1c\xe8\xeb\x1d\x86\xc4\x41\x90\xd2\x24\x89\x25\x9c\x5a\x12\x4d\x85\x3c\x16\x16\x74\x42\x46\xf6\x3f\x4a\xe0\x31\x90\xc7\xd0\x93\xb1\x7d\xa6\x7b\x50\xb4\xd3\xca\x63\xfd\x0c\x94\x63\xd8\xfc\xa5\x4b\x76\x41\xd2\x41\x42\x27\x9c\x93\x0f\xf6\x1a\x02\x6c\x48\xda\x2a\x51\x42\x3f\x12\xd4\x89\x3c\xb7\xd1\x16\x2e\x07\x2c\x77\xf9\x01\x39\x48\x1f\x19\x6c\x30\x2f\x4b\x66\xe0\xf7\xfa\x07\x09\x1f\xb1\x5e\xc1\x4f\x7a\xcf\x88\x95\x3f\xeb\x9c\xb8\xb3\x71\x90\x34\x60\x91\x79\x58\xef\x07\x5b\xf9\xe6\x29\xf2\x8b\x8c\x83\xa4\x24\xcb\xdf\x64\x10\x42\x5d\xbe\xf0\xbf\x85\xe1\x80\x29\x0b\x96\x99\x11\x2f\xfc\x38\x48\x6d\x15\x9a\x3b\x1b\x64\xcb\xd5\x81\xff\xbb\x74\x90\x7a\x3a\x31\x48\xb0\x02\xd7\xb0\x3e\x4f\x96\x7f\xcd\x6b\xc7\xc2\xfe\x5c\x3b\x92\x30\x26\x04\xbf\xf0\xcc\xcc\x01\xf3\x32\x68\x92\x10\x33\x41\xbc\xac\x0c\x89\x5f\x20\x34\x97\xe5\x37\x84\x41\xbd\xaf\xe2\xd3\xf2\x15\xf1\xae\xf4\xb2\x8f\x76\x09\x10\xf2\x6a\x85\xff\x8c\xa8\x9f\xa9\xa8\x0e\x72\x5b\xff\x49\x89\x88\x97\x40\x1d\x64\xf9\x2d\xdc\x9a\x83\xb2\x50\x2a\x7d\xab\xfc\x6c\xf3\xa0\x42\x21\x56\x88\x90\x42\xb4\x95\x17\x8a\xae\x3c\x82\x27\x3e\xbf\x68\xb0\x73\x4f\x07\x6d\xb5\xd7\xcb\x9d\x0b\x81\x27\x45\x63\x68\x9b\x2a\x92\xe9\x46\xfe\x1f\x21\x40\x89\x17\xd9\xfc\x73\x0a\x68\x3b\xed\xbc\xa6\xee\x17\x80\x38\x15\x3e\x8f\xf5\xe8\x01\x7a\xe5\x3f\x99\x02\x24\x16\xad\xa7\xc4\x29\x9e\xa9\x97\x0b\xf7\xc7\x0e\xaa\xf5\xba\xae\xd0\x0e\x7a\x14\x77\xc1\xe3\xe3\xd1\x85\x81\xef\x26\x0f\x7a\x8c\x88\x3b\x58\x5c\x5e\xe1\xde\x1c\x94\xeb\x0b\x63\xc6\xb9\xd0\x06\x2a\x34\x78\x0f\xea\x7a\x5d\xd7\x02\xc0\xc8\x68\xbd\x9f\xee\xa0\x0e\xca\xeb\xa0\x2e\x8f\xa4\x96\x14\x2f\x30\xb6\xd0\x26\x96\xe0\x0e\x66\x1d\xd1\xfa\x88\x35\xfc\x10\xbf\xf9\x07\xd7\x45\x94\xee\x43\x6b\xa0\x5f\xf4\xc8\x83\x15\xf7\x4e\xac\xe9\xfc\x7a\x09\xc5\x41\x27\x1e\x0a\x39\xa8\xe5\x35\x9d\x88\xa7\x75\x6b\xe0\xa0\x53\x74\x03\x93\x99\xf8\x12\x15\xfc\xce\xa8\x0c\x35\xf4\x3c\x3e\x05\x60\x25\xc1\x40\x53\xde\xac\x04\x16\x3f\x93\x41\x41\xf2\x46\xd8\x83\x52\x38\xec\x7e\x5a\x70\x84\x8e\x67\xfe\x6f\xdc\x41\xcf\x82\x81\xa9\x67\xed\x46\x48\xee\x0a\x84\x28\x4f\x7c\x94\x9c\xb2\xeb\x52\x44\xad\x32\x7e\xf6\x61\x8d\x2f\x86\xdd\x09\xbc\x17\x26\xc0\x6f\xb0\x0f\xca\xab\x38\x72\x26\xfc\xa5\x6a\x52\x76\x99\xdd\x51\x06\xce\xc6\xcf\xc5\xb5\xb1\xf6\xd1\x21\x51\x3b\x0c\x78\xd0\xa2\x76\x02\xf5\xa0\x25\xd6\x1b\x05\xb8\x14\xa9\xcd\x41\x6b\x37\x96\xb5\x91\xea\x39\x7a\xd4\xa6\x16\xfb\xca\xef\xa0\x17\x2d\x9b\x27\xde\xe2\x8f\x3c\x2f\x8e\x84\xb6\x0a\x40\x20\xe5\x9f\xef\x1c\xf4\x92\xac\x14\x18\x8d\x7a\x99\xa1\x22\xf5\xca\x20\xeb\x2e\xe4\x1d\x6c\x76\x22\x67\xc9\x22\x1c\x34\x57\x16\xf3\x6a\x72\x33\xb7\x56\x80\xe5\x5f\x31\x8e\x96\xdf\x43\x73\x70\x82\x00\x7c\xb3\x71\x70\x2d\x04\xcf\x3e\x2d\x33\x26\x2b\xe7\xe0\x77\xed\xc9\x41\x76\x5d\x1b\xd1\x7e\xe8\x25\xd7\x8d\xe5\xfe\x0d\x14\x1d\x57\xab\x98\xeb\x6e\x41\x79\x73\xfe\xc1\xf5\x3d\x64\xca\xf1\x63\xe5\x83\xd3\x56\x8b\xa0\x6f\x9d\xb6\x94\x25\xe3\x04\x98\x8c\x40\x37\x7f\xe9\xc6\x7a\xf7\xbc\x36\x07\x37\x98\x10\x39\xef\x98\x19\x74\x97\xf3\x1f\x28\x9c\xf7\x11\xf4\x1c\xcf\xcd\x01\xf3\x96\xe3\xab\xf0\x83\x9b\xce\x09\xc5\x69\x0e\x2c\x18\xff\xc1\x6c\xf3\x67\x4d\x8a\x38\xa1\xbb\xe9\xc5\xca\xc6\xea\x84\x1e\x1d\x45\xc6\xb8\xeb\x56\x15\x80\xf2\x88\x36\xb5\x32\x5c\x77\xf6\xf3\xb9\x85\x77\xe6\x5a\xc7\xca\xfa\xd7\x4b\xe0\x56\xc3\x55\xe4\x5d\xe8\xed\x16\x5a\x20\xde\x80\x03\x90\xc5\x82\xe6\x92\x22\x2a\xc0\x8e\xe0\x9f\xdc\xdb\x4c\xec\xc2\xa9\x39\xb8\x38\x58\x0b\x44\x94\xf4\xfc\xa3\xf9\xcf\xec\x78\x07\xb5\xe8\xb2\xdd\xd7\x7a\x58\x47\xbf\x2b\x18\xa3\x5c\x83\xac\xf5\x2a\x85\x84\xbd\x01\xc6\xe3\x82\x07\x87\x14\x2f\x8a\x99\xc6\x5d\xf2\x58\x3d\x13\xbe\x49\x92\x1b\x9e\xdc\x20\x38\x1f\xb5\x95\xc4\xac\xbc\x4d\x11\x5e\xa0\xe5\xbc\xf6\xcb\xef\xcd\xc1\xbb\x29\x62\xf0\xf1\xa6\x84\x43\xb4\xb7\x98\x2b\xdf\xb0\xf1\xe1\xdd\xb3\xb1\x62\xdf\xbb\x01\xc3\x91\x67\xa3\x0e\xb1\x87\x85\x10\xfb\xd1\x81\xd6\x8e\x0e\x28\x81\xa8\x2d\xdf\xcd\x1c\xa2\x72\x97\x88\x80\x1b\x69\x87\xa8\x49\x78\x41\xe7\x21\x6a\x36\x8d\x17\x35\xa3\xb2\xd1\xee\xe5\x68\x0e\xbc\xa9\xef\x10\x61\xd0\x75\x2e\x6e\x56\xb5\x17\x91\x1c\xdf\x38\x20\xca\xd8\xd3\x8b\x3d\x14\x57\x35\x1c\xc7\xab\x20\x07\x17\xe4\x86\xa7\x58\x0e\x6a\x4d\x9b\x67\xcd\x3e\x86\x61\xbd\xa0\xf4\x10\x3f\xa4\xc3\xb2\xe4\x10\x4f\x23\x8f\xdf\x1c\xe2\xc4\xbb\x66\xc1\x3f\x83\xc2\x72\x44\x95\x42\x1f\x97\xbf\x21\x20\x0b\x1e\x86\x8e\xc6\x4c\x0c\xc3\x99\x9c\xcb\x33\x2b\x01\x5a\x35\xc6\xcc\xda\x40\x65\xc5\xb3\x84\x1e\xa6\x54\x3c\xb3\xb9\xce\x7a\x03\x4d\xce\x52\x48\x6c\x9b\xa4\xb2\xf9\xc3\xb1\xc9\xc3\x7a\x7f\x06\x79\xa1\x1b\xc2\xde\x1c\x62\x16\x74\x50\x2c\x19\x29\x43\x0e\x62\x6d\x57\xcb\x33\xde\x6d\x89\x58\xa1\x09\x60\x5e\x51\x9e\x78\xbb\x34\x04\x3b\xd6\x8b\x32\xc6\x4d\x60\x88\xc6\x8b\x7e\xb0\x80\x57\xfc\x94\x04\xb1\xaf\x89\x9e\xfc\xd0\xe3\x10\xaf\x70\x20\xc2\xe6\xcf\xcb\xd7\xc4\xb2\xd1\xf5\xcf\xab\x45\x14\x6f\x12\xe2\xe6\xe7\xbd\xe0\x07\x2c\x05\xb3\x6c\x1e\x20\x2b\xb5\xe5\xbf\x5e\x1c\xb8\x26\x3c\xd4\x76\xd5\x6f\xb5\x8d\x93\x33\x9b\xa4\x76\x9b\x27\x99\x31\x7f\xd5\x8e\xb1\x3b\x14\xb1\x76\xa7\xcd\x83\xf7\x28\x4e\xed\x4e\xfc\x94\xec\x50\x79\xea\xf8\xc0\x11\x50\x87\x51\x28\x70\xf5\x84\x9f\x5c\xf2\x69\xf9\xdf\x40\x9a\xb9\xb6\x3c\xd4\x89\x46\x59\x9d\x5a\xac\x7b\x0f\x75\x9a\xd9\x71\x95\xb3\x54\x85\xb2\xa8\xa1\xe7\x87\x3e\x00\x9c\xdd\x2b\x86\x3d\x99\x9d\x16\x3c\xd8\x8b\xce\x43\x0d\xc3\xe8\x82\x03\xc0\xe3\xd8\x1c\xea\xf9\x0c\x67\x46\xde\x45\x13\x97\x07\xb5\xe8\x4c\x56\x92\xc5\x42\xaf\xd5\xc2\x5b\x34\x0e\x75\x46\xd0\x5b\x73\x98\xb5\x39\xcc\x90\xeb\x39\x0c\xed\x6c\x1c\x14\x43\x73\xa6\xb1\x3c\xe7\xe6\xb0\x7c\xb9\x09\x3a\x6e\xf9\xba\x1a\x4c\xcb\x57\xaa\xa3\xe5\x2b\xc2\x81\x72\x20\x2e\x5f\x2f\xf6\xdf\xce\x77\x84\xe1\xf6\x03\x6e\xec\xff\x0a\x0b\xbd\xe6\x66\x3d\x53\x6b\x6c\xf3\x28\xb5\x38\x2c\x0c\x57\xf7\xdd\x42\x5d\xbe\x05\xbb\xd1\xeb\xb0\x7c\x83\x5e\x58\xbe\x5d\xd7\x0b\x37\x0e\xcb\x77\x1e\x53\x25\xe7\xe8\xe5\xe2\xc9\x46\xdb\xf2\x9d\x5e\xef\x22\x99\x84\xfb\x86\x00\x39\xd6\xc9\x35\xef\xd2\xe1\x67\xdb\xf2\xef\xd2\x69\x1f\xc1\x8e\xd2\xcf\x8a\x70\xb0\xe7\xfa\x78\x01\x3a\xc9\xcc\x20\x27\x7b\xab\x03\x64\xca\xf9\x5d\xba\xf3\x28\xce\x03\xf0\x1d\xfd\xbb\xf4\x63\xcd\x31\xf6\xfc\xd7\xb4\x77\xe1\x35\x3d\xef\xa2\xbd\x80\x72\xfd\xfc\x2e\x9a\xf8\x3f\x15\xef\x58\x32\xa1\x1c\x83\xf8\x91\xdf\x3d\x11\xfa\xe5\xf7\xb0\xf9\x73\xd5\xd6\x0b\x3d\x32\x83\x28\xb2\x1d\x06\xe2\x81\x21\x9d\x47\xb6\x83\x8f\x30\x9c\xde\x65\x08\x7c\x16\x6a\x5e\x19\xac\x89\x77\x19\x52\xac\x60\x75\x0d\x35\x0b\xca\x33\x4a\x41\x65\x47\xde\x66\x92\x10\x71\x9c\xa0\xad\xdf\x65\x44\x68\x78\xb8\x9e\xad\xe0\xbc\x4c\xe6\x0e\x83\xcc\xe4\xc8\xc0\x52\x73\x39\x86\xa0\xf5\x42\x0c\x63\x15\x8c\x81\x0b\x31\x6b\xfb\x61\x47\x4c\xde\xe5\xa3\x4e\x3d\x73\x3f\x09\x9b\xe3\x34\x8a\xa2\x79\x4f\x6c\x8b\x93\x0b\x28\xd2\xa9\xfa\x56\x4f\x6c\x76\x2f\x1f\x7c\xf1\xfe\xce\xcf\x5d\xdf\xc5\xc3\x70\xb1\x8f\xe9\xe0\x88\x7e\x02\x57\xa6\xed\xd7\xbf\x5a\x78\x17\xef\x3a\xa3\x8c\xf8\x21\x17\x2e\xee\xdf\xc5\x7b\x47\x1f\xbe\x25\x20\x47\x11\xfd\xd4\xc6\x34\x10\x28\x29\xff\xc7\xe3\x5d\x3c\x37\xf6\xde\xc5\xf3\xbf\x52\x18\x7f\xf9\xda\xc5\xd2\xbc\xcb\xc4\x66\x98\x94\xf8\x24\xf6\x34\x48\xe8\x47\x56\x2e\x74\xf6\x2f\x2a\x80\xbd\x78\x63\xf1\x64\xcf\xf8\xfd\xcd\xbb\x70\xfa\xed\x63\x3a\x1e\xcd\x11\x98\x30\x46\x74\xb6\xc7\x6c\x11\x3b\x45\xf5\x2e\xec\x58\x5a\x28\xc6\x22\xf9\x89\x74\x8a\x23\xdb\x26\x7c\xa0\xdf\xc2\x69\x3d\x23\xf1\x8e\x01\xf0\x87\x3f\x7c\x7f\x5f\x2f\x22\x7b\x97\x10\x47\x49\xeb\x35\xc6\xef\x12\xec\xef\x26\x01\x9c\xa7\xbc\x85\xec\xd8\x8c\x21\x33\x9b\xb5\x22\xc5\x0a\x84\xb9\xfd\x9d\xff\x7a\x0c\x56\x95\xb5\xbb\x89\x51\xfb\x1b\xd7\x77\x41\xbc\x33\x51\x82\xed\x07\x96\xc5\xb8\xd5\xde\x16\x8a\xef\x92\x3a\x61\x97\x93\x6f\x1e\x35\xd9\xd3\x8e\xc9\xa6\x6e\x94\x3b\x0f\xfd\x1d\x14\x03\x5c\xeb\x11\xb9\x98\xdc\x8a\xb2\x5b\xfd\xb0\x10\x02\x70\x7f\x68\x8e\x44\x71\x48\xd6\x83\x69\x90\x7e\x4d\x6b\x10\xaf\x47\x03\x61\x3d\x39\x08\x87\x66\x56\x38\x0d\x91\xdb\xcc\x44\x16\xbe\x6a\xb2\x9e\x48\xa3\xf5\x4e\xb2\x90\x2e\x74\xb6\x49\xf1\x6e\x7f\x6d\xf2\x2e\xe9\x34\x24\x61\xda\x1e\xba\xeb\x5d\x52\xd0\xe3\x51\x09\x78\xcd\x87\x9c\x63\x2e\x3c\x2e\xfc\x6e\x7f\xba\xf2\x2e\xe9\x97\xba\xd6\x96\x27\xb7\xfe\x39\x0e\xf1\x0f\x0e\x67\xae\xbc\x36\x2b\x2c\x2f\xea\x80\x94\x7f\x00\xe7\xd9\x1c\x30\x9a\xc9\x1d\x2c\x02\xca\x7f\xb2\x3e\x04\xd3\xdb\x0a\xdc\xca\xd7\xb0\xd5\xf8\xcc\x1d\x72\x20\x98\xe5\x2b\xdf\x3c\xf9\xb8\x76\x23\x9c\x6b\x12\x44\x9b\x87\x5b\x4c\x7f\x08\xaa\x37\x7e\x76\xbe\x7c\xa1\x4f\xe5\x5b\x82\x77\x49\xa6\x7d\xd3\x7a\x6a\xee\x8e\x96\x2f\x80\xcb\x6f\x62\x0c\xbd\xc4\xf3\x03\x60\x53\x65\xa3\x64\xcf\x21\x99\x3d\xb5\x42\xf6\x16\xdd\xd4\x5b\xce\xe2\x79\xae\xda\xa0\x29\x9a\x9c\x25\x15\x72\x35\x6a\x8c\x77\xc4\xbf\xaf\x7f\x56\x06\xfe\x41\xe5\xb2\x2a\xca\xcc\x18\xfc\xfb\xa3\x77\xc9\x95\x1a\x2a\xd7\xc9\x18\x55\x4e\x19\x35\xcc\xc6\xaf\x2b\xcb\xc6\x6f\x64\x4e\xcc\x69\xb7\x38\x01\x55\x08\x4a\x89\x9d\x76\xe4\xf4\x8c\x0c\x94\x6a\x37\x52\xa2\xca\x9a\x55\xc9\x95\x2f\x6a\x89\xa2\x31\x3b\xad\xf3\x2e\x58\xe9\x71\xc4\x94\xc2\xf9\xa2\x98\x8e\x2a\x65\x1d\xbc\xe5\x5e\xa2\x1f\x45\x2a\x2e\x45\xcf\x54\xd6\xfc\x4a\xfc\x4c\xb6\x56\xb6\xac\x99\xde\x28\xca\xb5\xd5\x3a\x28\x81\x97\x80\xb2\xd6\x75\x76\xab\xc9\x75\x78\x70\xe1\x1f\xac\xa2\xf9\x3f\xbb\x29\xae\x63\xea\x33\x93\xfa\xcc\x5d\x88\x77\x2a\xc5\x99\x91\x66\xfe\xcd\x8e\x41\x94\x61\x26\xfa\xe9\x47\x7d\x44\xad\x71\xbb\xf1\xfb\x65\x22\x3e\xbb\xdd\x9c\x4d\x47\xb7\x5b\xed\x38\x47\x75\xda\x7b\x97\x2f\x94\xeb\x6e\xa4\x63\x5c\x5d\x5e\x31\x63\xa9\x5c\x64\x04\xe7\x3f\xaf\xe4\x1f\x60\xc3\x3f\x40\xa1\xd3\x83\xba\xae\xf2\xa1\x3b\xad\x3c\xc8\xe6\x5f\x04\x2b\xb6\x77\xed\x3d\xd4\xf3\xff\xc7\xd6\xdb\xec\xb8\x92\x23\x6b\x82\x7b\x7f\x91\x5c\xe9\x21\x2a\xab\x80\xc1\xe0\xde\x88\x50\x49\x3a\xa1\x3a\xb1\x33\xc9\x4d\xee\x0c\xa7\x93\x2a\xfe\x48\x29\xed\xe7\x0d\x1a\x17\xbd\xac\xc0\x64\xa3\xfb\x2c\x72\x51\xa8\x99\x83\x42\x23\xef\xca\x51\xef\x35\xf8\x3e\xa3\x22\xb3\x67\x66\x21\xb3\x8f\x14\x9d\xa4\xf3\xd7\x48\x27\xcd\xb4\xf7\xb7\xd0\x3d\x34\x37\x3c\x69\x6f\x6f\xa6\x9a\xe2\x15\xac\xa2\x39\xe9\x08\xaf\x11\x35\xa5\x63\xe2\x1a\x02\x20\x16\xfa\x70\x68\x50\xf7\xce\xb9\x53\xf9\xed\xe3\x49\x5d\x52\x8f\x71\x45\x1d\x6f\xe0\x3d\xe9\x3b\xff\x7d\x67\xa3\xd7\x69\x2c\x8e\x73\x88\xfa\x1f\x02\x67\x2d\xf5\xdc\x76\x30\x1e\x93\x01\xe3\xbd\x26\xf4\x7d\xf5\x6e\x72\x12\x0c\xbc\x3f\xc0\xad\x01\xd2\x29\x86\x16\x02\xed\xc0\x76\xa5\x8d\xa1\x17\xa9\x37\x05\x02\x4f\xea\x97\x6f\xbc\xcb\xd4\x4e\xba\x3f\x29\xb5\x20\x82\x51\x37\x05\x90\x9a\xa4\x09\xc4\x65\x00\xc0\xdd\x02\x0d\x24\x88\x27\x4c\xa4\xf6\x45\x94\x8f\xdd\x59\xee\xc1\xe2\xef\x9e\x34\x1d\x05\x12\x16\x78\x28\x4a\x60\x6a\x0e\x80\x1a\xa7\x0c\xa0\x18\x30\x31\x17\x68\x8a\x98\x93\x34\x51\xad\xfa\x93\xb6\x61\x4d\xd3\x95\x8f\xff\x34\x57\x3b\xeb\x43\xdc\xbe\x65\x3f\x71\xef\x25\x50\x6b\xce\x93\xe6\x13\x4b\x23\x07\x88\x68\xfc\x70\x86\x02\xc0\xb4\xab\xe8\x44\x1c\xf1\xd4\x54\x8a\x3f\x69\x6d\xb9\x81\x38\x83\xfe\xa4\x94\x03\xb9\x43\x46\xca\x29\x6a\x94\xda\x9b\x13\x8f\xde\x28\x99\xf0\xf5\xee\xb4\xb8\x7f\x7b\xef\x9e\x94\xa7\xb8\x9f\x46\xc9\x9c\xf5\x9d\xf4\xde\x4d\xf6\x05\xfd\xc9\x49\xed\x9e\xcc\x06\x24\xb7\x29\x9f\x4c\x53\x54\xe3\x3f\x91\xa3\xdc\xc8\x28\x27\xb8\xe6\xd0\xc6\x1f\x6e\xbc\x98\xa3\x91\xf1\x27\xbb\xaa\x05\xc6\x50\x7d\xaf\x7e\x8c\x3a\x11\xda\xa5\xe3\x27\xa7\x03\xc8\x88\xff\xd5\x1f\x79\xdc\xf0\x89\x06\x12\x9e\x1c\xb3\x01\xc9\x24\xe0\x7d\xdd\xc0\x1b\xa8\xa8\x43\x37\xf0\x72\x15\x52\x1c\x38\xd9\xbb\xa1\xa2\x42\xdc\x24\x90\x9f\xdd\x84\x97\x77\x93\x14\xfa\x8d\x62\x0a\x40\x9f\x1c\x06\x61\xe7\x85\x93\x84\xa3\xb6\x3f\x63\x17\x88\x66\xce\xf7\x87\x24\xda\x13\xa9\xd1\x60\xf3\x97\xf3\x13\x83\x12\xb2\x64\xf9\x0d\xa3\xb1\x1e\x12\x17\xd0\x8d\x4c\xcd\x3f\x34\x9e\xcf\x52\x87\x11\x30\xe8\x6a\xa3\xfd\xa8\x82\x24\x66\xc5\x18\xec\xc2\x6a\x37\x2a\x4b\xcc\x96\x55\x4f\xb6\xdb\xf2\xe4\xec\xf1\xd0\x42\x4d\x28\x89\x80\x51\xc5\x85\xc8\xd1\xd8\xac\x0b\x3c\xb9\xbf\xda\xfb\xa7\x03\x59\x66\xff\x77\x19\x7d\x0a\x32\x30\x72\x9d\xcd\x8b\x53\x98\x2b\x66\x15\xf8\xc9\x2c\x5a\x3f\xb9\x9b\x1c\x94\xec\xdd\x91\x4d\xe6\xca\xd4\xab\x0d\x44\x76\xaf\x34\xf2\xfa\xe4\xb0\xb2\x6a\x1d\xd4\xfd\xeb\x83\x51\xc6\x83\xb0\x39\x46\xee\x0d\x3d\x45\xc5\x6f\xe4\x9e\xdd\x53\xd4\x80\x61\x28\xf2\x10\x16\x58\x49\xf5\x4c\x70\x07\xbd\xa2\x95\x47\x2c\x1d\xe2\x50\x1f\x75\x1b\x47\x41\x53\x8a\x66\xde\xf9\x29\x8e\x78\xd2\x91\x64\xb5\x10\x0e\x4f\x7b\x34\x58\xde\xca\x42\x9e\x23\xad\xf5\xbb\xd5\x73\xbc\x44\x04\xa1\x02\xdc\xc8\x54\xdb\x45\x3c\x00\x48\xd6\xd1\x7b\xd2\x4c\xa2\xca\x7f\x8a\xa2\xa8\xe2\x1c\x31\x92\xc6\x20\xe8\xa9\xf0\x08\x47\xae\x08\xc0\xfd\xc5\x3c\x94\xe9\x06\x87\x36\x14\xc3\x44\x32\xf2\xeb\xc7\x53\x0c\xd6\x51\x23\x6b\x2a\x06\x33\x3a\xfa\x64\xa6\xc1\xd0\xd8\x63\x28\xdc\x63\x20\x98\x1f\x92\x4e\x0c\xe5\x14\x21\x17\x44\x13\x67\x63\x28\x29\xd6\x7b\x8b\xaa\x15\x37\x17\x41\x31\xf6\xc8\x3b\x86\xdc\x18\xb1\xd0\x89\xdc\x72\x32\xb6\xda\x3d\x52\x49\x72\x70\x98\xa2\x62\x82\xa4\x0a\x8a\x9e\x14\x93\xd8\x9f\x01\x1d\x27\x26\x29\x46\x47\xb2\x0b\x44\x81\x98\x4c\xab\xd7\x13\x15\xf7\x90\xd9\x3e\xcf\x53\x4c\xf6\xde\xa6\x16\x86\xfc\x01\x90\x23\x5a\xee\x31\xf6\x10\x56\x63\x1a\xd4\x16\xc7\x4f\x31\xb9\x23\xa5\xc7\x98\xb0\x48\x8d\x66\x6a\xe9\x89\xba\x4e\x41\x21\x9c\x92\x31\x65\xdb\x9e\x79\x8a\xbc\x7d\xff\x14\x53\xbc\xb3\xf3\xc6\x94\x2c\x4b\x36\xe6\x46\xb3\x25\xfb\x14\x13\x4b\x9e\xc5\x97\x0a\xcf\xc6\x01\x58\x39\xdc\xa5\xe7\x6b\xe5\x43\xaa\xd4\x93\xfe\x14\xf3\x51\x48\x91\xbe\xb5\xca\xcc\xdc\xe6\xb1\xc8\x30\x72\x66\x8a\x99\x1e\xb4\x21\xf8\x14\xf3\xcd\xd6\x81\xb1\x94\x24\x33\xf8\x0d\xe3\x65\xac\xc7\x51\x8e\x8c\xac\x0e\x0a\x89\x29\x56\x5a\xa8\x7b\xb2\xfe\x49\xdb\xc1\x17\x8b\xef\xc6\x8c\xde\xa9\xb4\xcd\x7c\x96\xff\xee\x96\xbf\x23\x0b\xcb\x2f\xf7\xee\x29\xc5\x60\xda\x58\x00\x0d\xad\xf6\x7a\x38\x74\x4f\x69\xf9\xb8\x63\x40\x2c\xff\xab\x84\x51\x25\x43\xf0\xa3\x8e\x2d\x7d\xef\x9e\x6a\xdf\x77\x4f\x8f\x39\xa7\x0e\x32\x1f\x10\x68\x18\x20\xc1\xd4\xf1\x71\x63\xf1\xa9\x3a\x04\x43\x5f\xaa\x93\x26\xf4\xf8\x3a\x8d\x66\x44\xf4\xa9\xfa\x5e\x68\x7d\x13\x88\xd1\xd8\x40\x57\x5b\xa4\x66\x7e\xef\xa9\x86\x7e\xc2\x90\xc4\x29\x69\x45\x2b\xae\x42\xef\x77\x34\xb5\x8a\xfe\x50\x03\x5a\xb8\x99\x33\x00\x2f\xb2\xfc\x3d\x7a\x59\xfd\xf1\xa2\x65\xb2\xa1\xb6\x86\x3b\x89\xed\x1b\x3f\xd5\x24\x36\x70\xd7\x64\xdf\xbc\x29\x2d\xd7\x44\x1d\xe2\x4f\x35\xf5\xd1\xb8\x35\x9b\x9a\x22\xff\x3d\x63\x59\x5f\x53\x12\x63\x6c\x15\x35\xcb\x11\xb2\x09\x90\x69\xb2\x7f\xaa\x3c\x9a\xfc\x54\x73\x91\x93\x18\xc7\x68\x50\xcb\x28\xe7\xb3\x10\xd4\x99\x8b\xce\x5a\x20\xc2\xdc\xbc\xbf\x81\x51\x59\xd0\xd3\xcd\xae\x7b\x3c\xdd\xd8\x56\x6f\x85\xd2\x0a\xd9\xea\xa1\xd8\xfe\x69\xf9\x99\x41\xc9\x56\x7f\xe0\xd4\xa8\x57\xa1\x07\x73\xb0\xfc\x3c\xf4\x7a\x4a\xe8\x3f\xcb\xcf\x6d\x87\x85\x7b\x53\xa4\xb4\x6c\x6d\x5e\xec\x92\x54\xbf\xd2\x77\x4f\xcb\xf7\x03\xa5\x80\xe5\xbb\xd5\xc0\xf2\xcf\xc6\x7f\x1d\x06\x3b\x6e\x06\xfc\x5b\xe5\x3e\xc4\x0c\xe3\xab\x3f\xc5\x03\xf5\xdd\x3d\xdc\x5b\x2c\xe8\xe0\xe2\x88\xbc\xfc\xda\xaa\x67\xf9\x35\x7c\x72\x7e\xc9\x7f\x5a\x7e\x4d\x89\x47\x03\x9e\xe5\x80\x1f\x09\x44\xec\x67\x34\xf7\xe4\xc0\xef\xa0\x3d\xed\x3e\x3c\x0b\xaf\x05\x3e\x0b\x4d\x4e\x75\xcf\x32\xe0\x27\xde\x85\xa1\xce\x84\xb3\xe4\x4a\xc0\xeb\x76\xcf\x32\xa8\x27\xcd\x57\x49\x74\x7b\x26\x36\xdc\xba\x67\x71\x13\x08\x9c\x18\xc0\x9e\x65\x92\x43\x84\x18\xf7\x6c\x5b\x33\x60\x83\xc5\x32\xc9\xe0\x98\x99\x49\x8c\xf2\x66\x15\x00\x57\x2b\x00\xd9\x9e\xa3\x3e\x1d\x00\xae\xf7\x9e\x65\x8a\xb3\x20\xc3\xc8\xdd\x2c\xbc\x7b\xf6\x2c\xf3\xa1\xf6\x16\xdf\x7c\x46\x46\xe6\x5b\xf6\xf6\x59\xe1\x99\xe3\xe5\xb3\x04\x1e\x22\x2a\x44\xee\xce\x12\xe0\x01\x52\x30\x38\x92\x64\x37\x8f\x8c\x39\xc9\x8d\x93\xd7\x03\xe5\x2b\x5a\xf7\x33\x25\x96\x67\x49\x95\x8e\x7c\x74\xb3\x06\xbe\x65\x1e\x41\xf8\xda\x94\xcc\x9e\x25\x83\x14\x49\xf2\xce\x68\x8a\x8d\xc2\xcf\xa6\xac\xf3\x59\xea\x10\x7d\xb8\x4d\x78\xac\xb6\x03\xc1\xcf\x62\x1f\x8e\xc0\xe3\xa5\x31\xbc\x59\x2d\xee\x26\x28\xf1\x8b\x78\x25\x4b\x29\x3e\xf8\x6a\x13\xb3\x0b\xcb\xb7\x4c\x0f\xd0\xab\x5c\x91\x3b\x7b\xe4\xc6\xcc\xdc\xa9\xa6\xb4\x7b\xd6\x83\xe6\xe5\xc3\x4e\x46\x3e\x2b\xfa\x19\xd8\x84\xc4\x55\x4f\x35\x83\xf9\x1b\x68\x1a\x04\x9d\xec\x59\x95\x9e\x05\x0f\xb3\x49\xe9\x90\x62\x70\xe4\xb5\x7b\x6e\x62\xc2\xb3\x3a\x4f\x02\xca\xdf\xcc\xe7\x78\x51\xe8\xd9\x94\xdb\x3e\xeb\x7c\xb4\x4c\x80\x33\x03\xb3\xbe\x1f\xc1\xde\xcd\x3f\xc7\x19\x94\xfa\x40\x9f\x15\xe5\xaa\xf5\xe0\x06\x32\x74\x96\x67\xad\xdc\x18\x7f\xd6\x56\x50\xe0\x37\x84\xad\x67\x41\x0b\xd1\x9a\x4b\x3d\x23\xaa\x0b\x93\xbf\x68\x5d\x3d\x3e\xff\x3e\x37\xa9\xe7\x59\x69\x86\x9b\x81\xd8\x38\xf4\xb2\x7c\x24\xbd\x77\xcf\x7a\x3d\x68\x4a\x78\xfb\x2b\x65\xc6\x67\xbd\xb2\x99\xd9\x17\xc8\x67\xcc\x8f\xcf\x43\x85\xa4\xf4\x3c\x4a\xe9\x9e\xdd\x11\xbf\x31\x7a\x94\x3a\x81\x7f\x80\x4f\x8e\x07\xa9\x3c\xfc\x81\x78\xb8\x81\xdf\xaf\x9b\x4f\x84\x53\x7b\x0d\xf7\x6a\x20\x21\x93\x4e\x47\x41\x75\x38\x94\xa6\x6b\xe5\xe8\x74\x56\xbf\xfc\x0c\x90\x33\xfd\xeb\x95\xf7\x1a\x9f\xdd\xe4\x20\x77\x3c\xbb\xc9\x32\x33\xb1\x08\xdd\x14\xbd\xbc\x43\xe0\x7a\x76\x2d\x39\x9f\x8d\xcf\x73\xec\x9e\x5d\x18\xbb\x67\xb4\x59\xf7\xb0\x30\xf2\xec\xf2\xe8\xd0\x4b\xc1\x79\x18\x8a\xc8\xfa\x23\xd0\x55\x50\x64\x2e\xd3\x94\xea\xb3\x2b\x7a\xa4\x1b\xab\x9f\x89\x1e\x3c\x75\xf7\x4c\x5b\x57\xcf\xee\x2e\x73\xed\xd9\xde\xe3\x01\xe1\x41\x73\xf7\x1c\x6d\x00\x88\x03\x8d\x79\x03\xf0\x14\xff\x73\xc4\x74\xf5\x0c\x51\xae\x7b\x8e\x96\x26\xcf\xd2\xfa\x18\xf1\x54\x2c\x7a\x88\x68\x22\x14\x6d\xa8\xd5\x00\xd0\x4c\xb9\x3d\xc7\xa4\x74\xb3\xca\xa2\x29\xf6\x79\x8e\x29\x2e\x7f\x47\x34\x54\xe6\xf3\x6c\x52\xc5\x73\x4c\x17\x48\xd0\xe0\x58\x14\x3f\x47\xaa\x7f\x7d\x8e\x17\xf5\x11\x0c\x43\x58\xbc\xf0\x81\x4b\x3c\xc7\x29\x35\x53\x24\xbf\x77\xde\xe0\x5a\x3e\x10\xf2\x2a\x47\x63\xcd\xea\xe4\xb3\x2d\x77\x9f\xe3\x95\x67\xb0\x9e\xe3\x8d\x2f\x7d\x67\xd1\xc5\x3b\xdf\xdd\xac\xf9\x3f\xd7\x31\x74\xcf\x35\xe7\xee\xb9\x96\xc2\x36\x77\x33\xcd\x22\xcf\x76\x21\xf9\xf9\x71\x21\xf9\xf9\xc6\xf7\xba\x59\xbb\xbf\x5d\x2c\xec\xf2\x91\x8c\x2e\x1f\xfd\xad\x7b\x5e\x3e\x0e\xea\x51\xa0\xcb\x47\x7f\x89\x29\x40\xb8\x7f\x5e\x3e\xd8\x17\x96\x9f\x6d\x70\xb7\x23\x66\x0f\x0e\x89\xf0\x79\xf9\x5e\xee\xd1\xc3\xef\x9f\x63\x5a\x35\x4d\x4d\xcf\xcb\xaf\x58\xed\x76\x2f\x3f\xfc\x98\x9c\x06\xf0\x5b\x0a\xda\xbd\xfc\xf0\xa7\xc8\xaf\x44\x2f\x3f\xf0\xe2\xd4\xcb\x0f\xff\x46\x35\x30\x2f\x3f\xd8\xe1\x96\x17\x99\x78\xbd\xe6\xe5\x20\xdd\xcb\x41\x93\x9d\x02\x01\x3a\x27\xc7\x43\xe5\xc0\xb9\xe8\xea\x47\x6a\x68\x81\xeb\xea\xc2\x64\xff\xc4\xe0\xee\xdc\x84\x7f\x39\xb4\xfc\xbf\x1c\x12\x16\xad\x2f\x7d\x1f\xbb\x97\xde\x05\x1e\xf1\x7b\xe9\x6f\x35\x76\x2f\xe8\x2c\xdd\x8b\x8e\x9e\xc4\xf0\x4c\xa2\xdd\x0b\x13\xe9\xbb\x97\x53\xd1\x5e\x10\x84\x8d\xee\x65\x70\xb9\x76\x2f\x03\x1a\xd7\x0b\xd6\x27\xa0\x79\x74\xdd\xcb\x88\x0c\x8f\x6e\xb5\x8b\x33\x80\xc7\x0f\x05\xf1\x32\xce\x16\x38\x76\x2f\x23\xe7\xbf\x97\x91\x4b\xa9\x97\xf1\xea\xba\x17\x4e\x6a\x2f\x6d\xca\x7a\x99\x58\x24\x53\xf4\xae\x7b\xf1\x54\x50\xf5\xe2\x15\xa5\xe8\xf5\xdd\xb6\x47\x5e\xbc\xbb\xa8\x51\x87\x27\x00\x12\x99\x33\x4a\xd7\x27\x60\x0e\xac\xd3\xbe\xf8\xdc\x6e\xd4\xbe\xf8\x72\xef\x5e\xe6\xd5\xbf\x89\x2f\x63\xac\x73\xf7\x82\xe9\xe3\x65\x8e\xee\x14\xbb\x97\xc0\xdf\x29\xb9\xee\x25\xa6\xcc\xad\xc0\x97\x98\x4b\x2e\x09\x9d\xe7\xe5\x2c\xc5\x9a\xe9\x0b\xed\x81\xbf\x9c\xdd\xbd\x7b\x39\xfb\xfa\xaf\x8f\xe2\x7c\xf7\xc2\xe5\x43\xf7\x92\x8e\x23\x86\xff\x97\x74\xac\xa5\x74\x2f\x49\xdf\xab\x7a\xe9\x5e\xda\x31\xce\x97\xc4\xe5\xf8\x4b\x9a\x69\xc1\xe8\x25\xa5\xee\x25\x15\xc4\xc4\x1e\xf6\x92\x0a\xa4\xe2\x97\x2c\x3e\xc6\xee\x25\x53\x0a\x7c\xc9\x3c\xb9\xf5\x92\x47\xde\x48\x90\xee\x25\xd3\x94\xe3\x4b\x9e\x6b\xa0\x04\xf2\x92\x63\x72\x08\x4f\xcb\x78\x2f\xb9\xc8\x5d\x99\xd3\x5c\xd4\xce\xff\xbe\xe4\x8b\x1c\x91\xcd\x22\xf8\xa1\x16\x8a\x86\x61\xf5\x55\x0f\x51\x46\x38\x52\xec\x5e\x90\xe3\x52\xe0\x2c\x10\x1c\x5e\xaa\xbc\x4b\xf7\x52\x7b\xbb\x13\xf7\x42\x29\xbb\x14\xfc\x71\x93\x30\x74\x2f\x17\xac\x15\x5b\xf5\x5c\x8e\xf7\xdf\xb0\x26\xd5\x99\x7c\x8a\x88\xf4\x92\xf4\x10\xbb\x97\xab\x84\xee\xe5\xaa\xab\xc7\x69\xb7\x97\xab\x22\xde\xbb\x26\x3c\x75\x9f\xb0\x04\x5d\xff\x20\xa1\x5b\xcb\x21\x97\x6e\x2d\xc7\x31\x72\x2a\x5f\xcb\xd1\x61\xf2\x5c\x9b\x6a\x92\xb5\x1c\x7d\x31\xda\xfe\xf5\x76\x50\x78\x2d\xc7\x58\x8a\xe2\x3f\x2c\xe2\xd6\x32\x68\xb7\x16\xdb\x2f\x58\x8b\xc7\x4f\x66\x49\x3f\xac\xb0\xbc\xbb\xb8\x50\xb2\xf2\x79\xfa\xd2\xf3\xff\xcf\xef\xf7\x01\x31\xdd\xa0\x42\xd7\xe2\x4f\xc1\x62\x75\x07\x17\xc8\x73\x44\xc2\x1e\x33\xc9\x5a\xfc\x4c\x62\x41\x96\x0f\xbd\x1b\xaf\xdd\x5a\xe6\xb3\x78\x7b\x9b\xf9\x2c\xc1\x19\xaa\xd3\xf2\xdf\x2b\x95\xc9\xad\xf9\xb9\x0a\xcf\x85\x5e\x6f\x60\xa8\x9c\x35\x4a\x1c\x44\x3c\x1d\x6e\x48\x32\xe2\xc9\xf0\x7e\x15\x46\x11\x26\x2b\x8c\x90\xf9\xfd\x63\x2d\xa1\x90\xb8\x23\x19\x0f\x52\xac\x85\x67\x8d\xd7\x12\xee\x4c\x01\x1d\x6e\x2d\x67\xb3\x8e\x65\x88\xd7\x0e\xd7\x72\x8e\x0c\x7e\x3e\xa3\x04\x93\xf4\x13\x1f\x4b\x07\x4d\xc8\x52\xa2\x96\xe7\x44\x75\x9e\x6b\x49\x27\x0d\x96\x76\x1a\xaa\xde\x56\xff\xae\x7d\x45\xa2\xc9\xe5\x11\x6c\x22\x51\xa3\xa9\xb1\xd5\x66\x8c\xbd\x66\xba\x4c\xef\xc4\x5a\x92\x97\x7e\xf9\x66\xa0\x60\x95\xbf\x96\x34\x4b\x2e\x78\xe3\xc4\xd1\x73\x2d\x29\xf6\x8e\x0c\xa3\xe7\x5a\xcc\xb2\x0b\xa3\xc1\x54\x05\x46\xef\xcc\xec\xe4\x18\xe8\x57\xcc\x02\xd2\x5a\x12\xa3\xcf\xad\x80\xf3\x31\xb2\x55\xe4\x63\xc4\x9f\x79\xc4\x43\xd9\x79\x56\x64\xfe\x6b\x85\xd8\xb8\xe6\x47\x0c\xc7\x7f\xb2\x26\xab\xae\x9c\x91\xa5\xdc\xf2\x58\xd4\x93\x06\xd2\x64\xef\x52\x46\xc1\x7b\x63\x70\x58\x4b\x89\x0f\x1d\x9c\x6b\x29\x56\x3b\xa5\xe8\xdc\x1e\xff\x7c\x06\x95\x5f\x3d\x89\xac\xb6\xb1\xde\x85\x98\x0d\xba\xfe\xb5\xe2\xc5\x2a\x2f\x18\xac\xa5\xde\xf9\x26\x97\x01\xd9\xb9\xf8\x78\x01\x63\x24\x17\xf4\xdd\xb5\x5c\x96\x7f\xc0\x75\x6d\xb2\xfc\x5a\x6e\xcc\xcf\x2d\x11\x5b\x7a\xf7\x58\x5b\x0f\x32\xc4\x2e\xb4\xfc\x4f\xdf\xad\x55\xd2\x51\xc9\x18\x52\xb1\xa2\x58\x53\xf0\x15\xf0\xa9\x5b\x63\x12\xc1\x28\x6b\x60\xf9\x06\xa4\x49\x58\x2b\xca\x85\x0d\x98\xe3\xd3\x0e\x39\x52\x17\x78\x20\x71\xad\xae\xdc\x41\x7f\x8a\xa8\x58\x0c\x2a\x03\x8b\x44\x7d\xac\xa9\x47\x48\x9f\x19\xce\xac\x22\xac\xb5\xf5\x1f\x0d\xb4\x00\xbc\x56\xb4\x4c\xdb\x9f\x8c\x57\x20\x6b\x19\x76\xcd\xbf\x30\xc1\x10\x90\x8b\x70\x77\x08\x89\xb5\x4a\xee\xd6\xc8\x5c\x11\x72\xd4\x83\x99\xe1\x01\x73\x49\xd0\x98\x2f\xe2\xc7\xdf\x3c\x6c\x2f\x63\x4d\x73\xd5\xa4\x85\x1f\x38\x01\x91\x15\xb6\x59\x70\xbb\x20\xb1\x56\x9a\x61\xe1\x6e\x34\x0f\x7b\xd2\xa3\xac\x7e\x8c\xb5\x8f\x35\xf1\x64\x21\xfd\x1c\xe7\x15\xa2\xe6\xc1\x37\x4c\x56\x00\x29\x61\xb8\x6c\x5c\x0d\xe1\x45\x52\x76\xec\xe3\xad\x9d\x80\x35\xde\xdc\x05\xa1\x32\xf7\xf0\xd7\x9a\xb3\x45\x5a\x64\x40\xf7\xd4\xc2\x0c\x16\xf3\x6b\xa7\x37\x89\x72\x63\xfa\x70\x33\xb2\xe2\x8a\xd1\xd5\x6e\x8c\x55\x2c\x14\x67\xdb\xb5\x96\x84\x46\xa4\x05\x8d\x08\x54\x8d\x05\x73\xc6\x8c\x35\x1b\x11\x25\x3d\x43\x68\x54\x5a\xd2\xcd\x1e\x5c\xfe\x13\xec\xa1\xf5\x63\xad\xa5\x38\xbe\x72\xb9\x8f\x54\x64\xb3\xd6\xdb\x41\x53\x58\xfe\x07\x92\xbd\x35\xcb\x2a\x40\x90\x9e\xba\xb5\x42\x2c\x5d\x9f\xf4\x74\xc2\xdc\x92\x02\xb0\xdd\x69\x5d\x9f\xa8\x96\xaf\x5b\x9f\xec\x30\xdc\xfa\x64\x7a\xca\xd6\x27\x2f\xe1\xee\xc1\xeb\xd0\xad\x4f\xd4\x75\xd0\xad\x47\xe9\xd1\x96\x46\x39\x9d\x40\x3d\xca\x00\x72\xc7\x7a\x6c\xe5\x30\x3a\x7f\xe0\x8d\x50\xa0\x63\xfc\x89\xdc\x9d\x39\x47\x11\x9d\x3f\x81\x7b\x00\xf6\x25\xc2\xfc\xe0\x2d\xaa\x87\x57\x08\x18\xd2\x47\x77\xa6\x33\xc6\x49\xe6\x8c\x22\x1d\x63\x0a\x10\x92\xca\x71\x14\x44\x57\x27\x94\xa4\x5b\x6d\xa4\x8f\xa1\xbf\x75\x6b\x27\x45\xa7\x6e\xed\x8e\x6c\xbc\xee\x78\x74\x95\x83\xb7\x3b\x8e\x2a\x01\xed\xa1\x8c\x48\xcb\x61\x45\xb0\xb6\x8f\x10\x6b\x77\x9c\xec\xd4\xe9\xda\x1d\xff\x5a\x35\xd8\xb3\x7d\x3c\x7b\xc7\x6b\xfd\x6b\xa7\xfd\x21\x6a\x3d\x01\x79\x90\xe0\xe4\xce\x74\xf4\xac\xe1\xc0\x35\xea\xda\x29\xc7\x02\xc7\x6f\x40\xe4\xc9\x9c\xc9\x9c\xe5\x61\x07\x6a\xed\x06\x04\x1f\x02\xd6\xef\x88\x1d\x45\x02\x96\x4c\x1f\xc2\xda\xa1\xc8\x4d\x8f\xdc\x9a\x5f\x13\x32\x39\x3d\x3d\x33\x0c\x09\x6d\xed\xfc\xf2\x81\x98\x67\xfc\x30\xb5\x74\x6b\xc7\xda\x77\x21\x44\xbc\x5d\x88\x28\x91\x90\x2a\x0b\xc9\xa6\x77\x17\x4a\x5c\xd9\xc9\xb9\xb5\x0b\x77\x41\x13\xa5\xd1\xe1\x6e\xed\xd8\x89\x41\x57\x6f\xb1\x14\x49\xdc\xe9\x5d\xbb\x44\x4d\x57\xe0\xf7\x1b\x37\xde\xd6\x2e\x9b\xa5\xf0\xb5\x2b\x92\xd0\xf0\x5c\xb1\x32\xe3\xb0\xc2\x96\xea\x78\xc6\x17\x8c\xbd\xcc\x51\xf5\x2f\xfc\x97\xbf\x63\x2e\x79\xc7\x88\xaf\x05\x79\xf6\x3c\x9f\x33\x03\x4c\xae\xc7\x1c\x05\x99\xa2\x17\xf0\x60\x15\x04\x80\x32\xf1\xf6\x6f\x69\x4c\xf9\x57\x89\xc1\xa2\xb9\x9d\xc4\x25\x03\x11\xb9\xf1\x3a\x22\x00\x3a\x3a\xc2\x3b\x4c\xf4\xde\xd9\x89\xe3\xb5\x7f\xaf\xec\x32\x3e\x16\x13\xc7\xd6\x3e\x5e\xf1\xfe\xbe\xce\xcc\xb1\xaf\x73\x2e\x2a\x88\xa7\x86\x49\xf1\x4e\x7e\xf9\x5e\x6c\x6c\x8e\x07\xed\x9d\xc9\x5c\x3c\xb4\xb7\x8e\xc7\x29\x60\xd1\xb4\x8e\x54\x95\xb7\x8e\xc7\x0c\x82\x72\x8b\x3d\x65\xf4\x75\xec\xcf\x8a\xb1\x32\x9a\x4b\xbd\xe7\x45\x37\xa0\x02\x7a\x3e\x63\x18\x8e\x54\x51\xde\xfa\x73\x1c\x3d\x09\xff\xa0\x29\xc4\x75\x74\x09\x23\x5e\x74\x36\xa6\x45\x57\xb0\xc8\x02\x4f\xd2\xbc\xde\x25\xf1\x85\x1a\x40\x92\xef\x94\x8d\xb0\xa8\xe6\xfd\x46\xfb\xdb\x7b\x39\x1e\x5d\x03\x93\x71\x94\x5b\xf4\x9e\x43\x15\x38\x9f\x8a\xf6\x4d\x72\x1d\x67\xb4\xae\xc8\x9b\x3d\x4c\x34\xa0\xc1\x83\x22\x47\x21\x70\xbb\x74\x1d\x43\xc1\x5c\x67\x28\x06\x87\x09\x38\x5a\xd2\xcc\x5e\x0c\xb7\x82\x77\x8b\x68\x0e\x11\xc3\x43\x3c\x33\x3f\x36\x2e\x70\xb2\x8f\x29\x1e\x89\x0b\xbf\x4c\x00\xf0\x90\x0f\x00\xb2\x94\x7d\xcd\x77\x4c\x5e\x11\x02\x30\x2f\x9d\x66\xf2\xb1\xce\x15\xa8\x50\xb1\xf0\x1a\x6b\xfa\x80\xb9\x38\x5a\x33\x8c\xc5\xb5\x0c\x7d\xe6\x8c\xd7\x01\xd7\xb1\xd4\x23\x3a\x74\x2c\xf7\x82\xf6\x19\x2b\x2d\x82\xad\x63\xe5\x1a\x69\xdd\x8e\xfe\xad\x21\x6c\x20\xf8\x45\xf0\x0a\x5c\x88\xaf\x23\xed\x25\xaf\x93\x1c\xc6\x3a\x48\x0c\x94\x01\x93\xf4\xa3\xd0\x17\xd3\x27\x7a\x36\xe8\x24\x10\xf5\xcc\x12\x3f\x58\xf1\x60\x19\xad\x2c\x49\x71\x07\x48\x56\x49\xca\x3b\xff\x2d\x35\xb1\xb7\xa7\x87\xb2\x88\x75\xd2\x7c\xe4\xd4\x97\x34\x7b\xd2\xe2\x1a\x93\x10\x08\x29\x96\xf2\xe8\xc3\x9a\xc2\x1c\x23\x70\xbc\x60\x00\x8e\xe2\x4a\x4e\x51\x88\x89\x0a\xcd\xeb\x4f\x0d\x71\xa3\x7d\x9d\x5c\x38\x3a\x8c\xd4\xbc\x74\xc9\x63\xd6\x80\x8c\x33\xa0\xb5\x92\x29\x23\x2d\x77\x34\x87\xe4\x2e\xba\xa2\x99\x15\xc0\xe5\xdb\x27\xbe\x51\x53\xd2\x3a\xc5\x83\x29\xfe\x5a\xa7\xd8\xb3\x44\xa2\xf2\xf3\xfc\x3a\xd1\x24\xdd\x3a\x45\xda\xe5\xb9\x02\xcd\xe7\x5a\x0a\x0b\x21\xd2\x8c\x68\xff\x40\x57\x4e\x94\x29\xda\x85\xb7\xd5\xbf\xcb\xc5\x6e\xcd\xc0\x6b\xe2\x21\x6e\x22\x2a\x00\x59\xa7\x78\x91\x07\x33\x11\x36\xd5\xfe\x87\x31\xce\x33\x4a\xa5\x06\x54\x24\x0d\xa0\x77\xeb\x74\x0b\x1c\x6a\xd2\x2d\x22\xd8\xf2\x3d\x1f\x47\x1f\xfb\x07\x64\x0e\x2a\xe6\xbe\x3a\x78\x97\x5d\xb7\xae\xef\x82\xa6\x56\xdf\xb1\xb8\x5f\x57\x8e\xca\x35\x1c\xe3\x71\x7c\x6c\x7c\xae\x6b\xe8\x15\x89\xd6\xe0\xca\xc8\x7d\x6e\x8c\x6e\x35\xe4\x88\x16\x5c\xd3\x24\x37\xe1\x95\x48\xe0\x5b\x68\x0f\xa5\x38\x42\xa2\xa8\x66\xc0\x69\x5d\x13\x5a\x4a\xcd\xa6\x3b\x67\xcd\x5b\xab\x3c\x19\x08\xc8\x21\xa6\xde\x66\x81\x14\x79\xf3\x02\x01\x63\xf9\x48\x7d\x45\x0d\xd9\xa1\x62\x63\xbf\x3f\x02\xdd\x7c\x5e\x52\xd1\x41\xe0\x62\xc3\x5f\x7e\xa1\x91\xe4\xe5\x17\x0c\x55\xcb\x3f\x30\x6c\x2d\xdf\x29\x20\x2c\xdf\x7d\x3f\x0b\x7c\xbf\x17\x0c\x71\xff\xfa\xd0\xc0\x2b\xa8\x7f\x36\x7d\x3d\x7f\x76\xa1\xfb\x73\x15\xed\x2f\xde\xe9\x00\x18\xfa\xd2\xfd\xb9\x2a\x26\x0a\x70\x0e\x9a\x7f\xae\xbc\x97\x60\xff\x24\x90\x1c\xe4\x06\x7e\x4b\x42\x4f\x9e\x9d\xfe\x73\x75\x21\x90\xda\x31\xb2\x3f\x57\x97\x26\xd0\x5c\xe7\x03\xa6\xd7\x3f\xd7\xe5\x1b\x24\x7c\x70\xb4\xbb\x8d\xc8\x61\xb5\x2d\x49\xea\x41\xe9\x20\x2d\xf7\x6e\x23\x66\xe2\x19\x3c\xd8\xae\xf8\x46\x8e\x23\x88\x3b\x44\x5e\xbc\xd8\xc8\x91\xba\x6d\xa5\xdb\x48\x7f\xf4\x10\x8c\x56\xdb\xd9\x95\x91\x6e\x33\xe7\xbe\x91\xbe\x47\xc8\xfe\xc4\x8b\x54\x1b\xe9\x9d\x04\x30\x3f\xf1\x30\x10\x24\x0e\x77\x74\x47\x80\x4f\x39\x6d\x23\x7d\x1d\x1c\x82\x69\x35\x15\xc4\x1b\x39\xb9\x60\x72\xed\x46\x86\xb1\x5e\x94\xcf\x8e\x82\x5c\x21\x7e\xe7\x7b\x52\x8f\xa7\xb1\x00\x20\x4d\xfc\xab\x8c\x8f\x5b\xd5\x70\x5c\x9c\x23\x60\x79\x6d\xe4\x1d\x3f\x99\x40\x91\x3a\xf7\x57\x37\xf2\xae\xa1\x4f\xcc\x28\x91\x12\x4d\xbc\x7a\xbb\x91\xf7\xda\x6d\x64\x8a\xf9\x46\x56\x22\x16\xc0\xee\x82\x84\xa7\x32\x32\x37\xfe\x94\xbb\x8d\xcc\xab\x3f\x5c\x21\x9b\x00\xf2\x5a\x65\x8b\x92\x0e\xf3\x9d\xec\xe2\xb5\xf9\x7a\xbd\x91\xcf\x18\xd3\xc7\x86\xeb\x27\x0c\x42\xc3\x2c\x84\xf5\x5d\x66\x20\xfb\x8a\x0c\x10\x59\x00\xb3\x66\x14\xf2\xec\xd0\x76\x37\x54\x26\x1f\x91\x31\xde\x74\x24\x7b\x3c\x19\x99\xc3\x2c\x8c\x3b\x1f\x62\x29\x91\xfe\xcb\x2f\xf6\x68\x90\x1e\x85\x18\x7a\x2f\x2c\x4d\x9a\x5c\x06\x1b\x19\x21\x4d\x49\x6f\x84\x02\xe6\x46\x42\x7c\xa7\x7d\x89\x8d\x84\x72\x77\x2c\x2d\xb3\xd3\xb8\x91\x58\xf1\xd8\x99\x85\x7f\x76\xf4\xc2\xf4\x46\x8a\x74\xf2\x64\xc3\xda\x46\xf2\x59\x1c\xc2\xe6\x3c\xc9\xbd\x79\xf1\x13\xcb\x06\x2f\xee\x64\x24\x88\x78\x0f\x5e\x58\xda\x48\x9d\xe2\xf9\x04\x9e\x4d\x25\xf2\x46\x2e\x8c\xf3\xc2\x1c\x5c\x1c\x1e\xbd\x00\x5e\xd9\x4e\xae\x1e\x71\xde\x82\x78\x63\xb5\x27\x67\xd6\xd8\x88\xef\x72\x72\xb3\x84\xde\xcd\x07\x2a\xa4\xdc\x40\x6e\xd9\xe8\x41\x43\xa1\x26\xc2\x0d\x2f\x81\x61\x80\xdf\xe8\x11\xb2\xd1\x46\x7b\xb1\x1a\xd0\xbe\xbf\x81\xda\x67\x9a\xc7\xe9\x93\xcd\xc3\x08\xd3\x46\xfb\x58\xcb\xf2\xad\xdb\xa8\xf6\x24\xf4\xd4\xac\xa4\xb9\xdb\xe8\x20\x8c\x87\xc5\xac\xc3\xf2\x3f\xba\x8d\x8e\x16\x6a\x9c\x49\x20\x6f\x6d\xa8\x46\x9c\x94\x92\x36\x91\x36\x16\x78\xaa\xd6\x70\x6a\xff\xd9\xc5\xa9\x8d\x3a\xa4\xea\x78\xff\x75\xa3\x2e\x58\x73\xe1\x4a\x99\x34\x35\xb6\xfa\x53\x52\xb3\x47\x0e\x37\x37\x17\x1f\x80\x11\x86\xc9\x1e\xb0\xd2\x70\xe1\xfa\xf8\x83\xd1\x66\x37\x78\xe3\xed\xa5\x5d\xce\x3a\x19\xff\xf4\x2a\xfe\x01\x67\x74\x3b\xb5\x26\xab\x33\x8a\x0f\x39\x0d\x52\x99\xbc\x5d\xa4\xdb\x68\xe8\x3d\xd2\x6c\xcf\xf0\xdc\xc7\xc6\x74\x23\x6c\xb8\xf0\xde\x68\xb8\x44\x77\x67\x98\x3b\x89\x9d\x28\xdc\xe8\x5f\x2b\x9b\xa2\x5a\x66\x33\x16\x6c\xdd\x46\x8b\x98\x9c\x06\x14\x10\xac\xdc\x5d\x45\xf6\xeb\x24\xf5\x04\xce\xea\xa0\x3a\x95\x8d\x5e\x14\xad\x45\x2f\x34\x20\xdc\x6d\xf4\x27\xfa\xde\x14\x41\x6e\xb3\x84\xe5\x1f\x57\x46\x72\x0b\xac\xb7\x5b\xcb\xdf\x2d\x44\xdf\x23\xcc\x3d\x38\x08\x44\xdc\x7d\x1a\x69\xf7\x61\x33\xde\x50\xea\xee\x40\xcb\x39\x1b\x77\x80\x8c\xb7\xe1\x4a\xcb\xad\x7e\x34\x43\xa9\x1b\x77\x3c\x46\xea\x25\xd8\x40\x04\xd9\xb0\xd2\xad\xce\x1f\x3c\x7f\x02\x34\x54\x5b\x81\x6d\x78\x59\x2e\x81\x4f\x24\x1a\x8c\xd1\x0b\x91\xf1\xa6\xdc\xc6\xd1\x18\xc3\xc6\xf1\x12\xd9\xc6\xf5\xb9\x17\x7a\xf3\xa0\x24\x59\x22\xa3\x63\x30\x3f\x14\x8a\xc3\xda\x6d\xe3\x4e\x18\xe3\xdc\xc0\xfe\xe3\x86\x03\xa2\x18\x06\xfc\xed\x50\x14\x6e\x9a\xb8\xfc\xda\xb8\x09\x0d\xda\x59\x1a\xa1\xbd\x6d\x20\xc5\xe0\xe3\x62\xcd\x59\x0b\xc1\x4f\xdd\xc6\x71\x54\x40\x37\x3b\x38\xb5\x97\x28\x03\xa3\x29\x6d\x0a\x30\x93\x1e\x1b\x77\x11\x3c\x7d\x71\xa1\x92\x47\x5f\xf0\xe0\xfd\xce\xc1\xc5\xdd\xef\xb1\xdb\x44\xe1\x87\xb4\x4d\x3c\xe0\x87\x66\x16\x0f\x87\x44\xbd\x41\x9b\x48\x43\x78\x0f\x6e\xa1\xb8\xa5\xb1\x89\xed\xc2\x3c\x51\x30\xda\x02\xb8\xc6\x03\xe6\xe2\x4d\x3c\x34\xe7\x2d\x20\xa2\xe3\x28\xa0\x48\x05\x2d\x2c\xf6\x6e\x40\x16\x63\xef\x62\xb0\xf9\x2c\xf6\xc9\x0d\x55\xf3\x27\xba\x13\x2d\xbf\xfc\xbf\xe0\xea\x8f\x72\x50\x0c\x45\xbf\xf9\x58\x8c\x57\x8c\xd3\x51\x47\x6f\x94\xd5\x17\x75\x44\x6f\x8e\x3a\xa5\xe6\x86\x74\xba\x89\x83\xf8\x3c\x21\x8e\x81\xbd\x9d\x8c\xd9\x1d\x46\x07\xea\x2d\x54\x2e\x18\xa0\xe2\x88\x7f\xc6\x44\x62\xe3\x47\x1c\xaf\x0c\xe1\x2e\xb6\x8e\xde\xc4\x77\xbe\x4e\xfb\xc6\xb5\x89\xbe\x5f\x3e\xe0\xed\x4f\xc8\x9b\xe7\x27\xb0\x4d\xb4\x73\x2b\x8d\x33\xbd\x59\x86\xa0\xd9\x11\x41\x58\x24\xc7\x78\x6e\x5c\x1e\xc0\xd4\x9a\xfc\xe6\xb8\xfd\xce\xe1\xda\xe3\xb6\xad\x48\x5d\x0d\x0f\x21\x01\xcb\x28\xdf\x35\x8d\x01\xc6\x56\xbf\x19\x56\xdb\xc4\x99\x73\x53\x6c\x87\x95\x37\x31\x98\x22\x86\x0d\xe6\xaf\x48\x49\x02\x94\x57\x51\x37\x68\x90\x11\x83\x71\xa4\x91\x0e\xb0\x26\x0a\x10\xd5\x34\x8c\x06\xf9\x91\x8a\x68\xa8\xa9\xb4\x90\xb9\x58\xed\x67\x0d\x17\x6a\xc1\xda\xf0\xc4\xd3\x26\xe6\x51\xa9\xfc\x7f\x13\x73\xab\xb3\xcc\x84\x58\x28\x39\xbb\x21\x44\xc6\xc6\xfb\xd5\xc6\x6d\x89\x02\x1c\xaf\x60\x4c\xa2\x40\xfc\xb1\x02\xc8\x3c\xda\x53\x19\x0d\x0f\xf9\x6d\x22\xe4\xaa\x58\x46\x08\x10\x89\xe8\xec\x95\x89\x96\x31\x59\xc6\xca\x78\xb5\xb2\x2a\xe5\x10\x3f\xd1\xf2\xcf\x86\xec\xb9\x6a\x03\x6e\xac\x94\x32\x62\x9d\x75\xa8\x3c\x21\xb6\x89\x35\x4d\x64\xd9\x68\xc6\xb2\xd5\x00\x22\xa8\x85\xe3\x2c\xbb\x71\xac\x28\xf3\x2b\xc2\xd1\x72\xc8\x26\xfe\xf4\x28\x3d\x38\x6e\x0c\x78\xc3\x68\xc3\xfb\x79\x17\xf2\xe4\x50\x25\x77\x7a\xea\x49\x39\x72\xd6\x03\x3c\x2b\x35\x2e\x6c\x6a\x2f\xc8\x6a\xed\xe5\xd1\x58\xaa\xd9\xbb\xdb\xd4\x1e\xc5\x5d\x7b\x9a\xfe\xdc\xd4\x3e\xfa\xc6\xce\xe3\x83\xbb\x6e\x53\xb5\xd9\x0a\xdd\x54\xa5\xc9\x99\x4d\x1d\xad\xd9\x56\x6b\xe2\xd5\xdd\xbb\x4d\xf5\x32\x63\x35\xbd\xa9\xf3\x79\x64\x11\xf3\x0a\x5e\xb7\xa9\x71\xc2\x9a\x63\x53\xcf\x67\x90\x36\x90\xd4\x8c\xa1\x8f\xb2\xc7\x43\xf3\x20\x5d\x48\x2f\x8f\x4d\xde\xad\x66\xfd\x65\x53\x4b\xfb\xec\xbc\xa9\xcb\x2f\xf7\x6e\x73\x43\x19\xdf\xac\x89\xdd\xfa\x07\xc7\xa8\x73\xd3\x6e\xc3\xef\xc9\x19\x1c\x45\x78\xbb\x98\x11\xdc\xcd\x5d\xee\x94\xca\xee\xda\xb7\xc8\x96\x0f\x34\xed\xe5\xc3\xa4\xd1\xe5\x03\xcb\xbb\xcd\xf2\x73\x5e\x7e\xe6\x6b\x2d\xdf\x0e\x9e\xeb\xa2\xcd\xf2\x0d\x13\xec\xf2\xed\x82\x81\x64\xf9\x05\x23\x16\x8d\x3a\xa3\x51\x2e\xdf\xd9\x5a\x97\xef\xd6\xf4\x96\xef\xad\x0d\x2e\xbf\x9e\x4e\xa8\x29\x1e\x7e\x02\x6b\x4a\x75\xb6\x22\x3d\x89\x14\xd7\x6d\xe5\x00\x29\x62\x4b\xf5\x22\x98\x12\xb6\x3c\xbd\xd4\x47\x02\x52\x8f\xd5\xf1\x56\x8e\x49\xec\x1c\xce\x56\x7a\xe5\xb7\xc6\xad\xf4\xf1\x62\xdb\x46\x5b\xb1\x19\x7e\x2b\x27\xee\x3a\x6d\x65\x50\x92\xa4\x05\x3c\x92\x2e\x1f\xd9\x14\x59\xfc\x0e\xaf\xfe\xe0\x2f\xd2\x6d\x65\xc4\x8f\x2f\xb4\x95\x31\x20\xc8\x88\xa4\x5c\xff\x50\x63\xb6\x15\x53\x87\x0a\xee\x8c\xd2\xe1\x99\xac\x0b\x99\x7b\x1d\x40\xc5\xe8\xea\x61\xa8\xc2\x5c\xd4\x5d\xd2\x70\xdb\x96\x34\xc7\xab\x0b\x47\xb5\x87\x58\x20\x8e\xef\xf8\xde\x12\x76\xef\xdd\x96\x67\xa7\x40\x27\x77\x90\x24\x84\x37\x49\x91\x7f\x39\x6a\x07\xdb\xca\x94\x6e\x1e\x2c\x6b\xa0\xb3\x26\x3e\xc4\x19\xc9\xd8\xea\x8f\xa3\x42\x72\x13\x3d\x9d\xfe\x3f\x5e\xc3\x60\x5e\x4e\x98\xbc\x97\x4c\x7a\x97\xf4\xe0\xab\xaf\x7a\x56\xfa\xf6\xfc\xa6\x0e\xe0\x2e\x2c\x04\x6f\xfe\xfc\x7a\xbb\x15\xb3\x01\xbb\xe5\x91\xad\x6c\xdc\x9c\x8f\x22\xf2\x90\xfe\xb7\xe2\xe7\xd5\x9f\x6e\xac\x47\x3f\xdb\x37\x39\xa2\xb4\xfc\x23\xac\xb6\xcb\x47\x38\x8e\x7a\xa7\x57\x64\x68\x5e\x61\x26\x40\xe3\xdc\x8a\x2f\x24\x2c\x54\xff\xa9\xde\x6a\x2b\xbe\xbe\x8f\xe4\xb7\xab\x3d\x78\x6f\xcd\x6e\xc6\xef\xc0\xa4\xe7\x03\x72\x34\x47\x3b\x70\xfe\x40\xae\x1c\xd1\x0c\xe6\xb3\x38\xfe\x9d\xf9\x3c\x58\x64\x89\xcd\x55\x7d\x7e\x70\xfb\x0f\xa8\xc1\x1b\x0f\x1c\x6d\x05\x8e\x47\xde\xed\xa6\x3e\xb3\x08\xf1\x91\x34\x65\xf2\xb1\x92\x5d\xb1\xe0\xdd\x4a\x98\xa8\x90\x69\x4b\xf5\x6b\xa4\x33\xea\xe2\x13\x2e\xbf\x18\x56\xef\x8e\x2c\xec\x50\xb4\x25\x8c\xd5\x45\x9d\x08\x22\xff\xb9\x0a\x1a\x42\x40\xfa\xe7\xd1\xf2\x74\x8e\x89\xf1\x26\x29\xc8\x45\x3a\x8c\xf1\x46\x8e\x4c\x98\x2e\x98\xad\xa4\x77\x15\x03\x13\x6b\x3d\xcd\x7c\x64\x76\xad\xdb\xa5\x24\x77\xb2\x68\x3d\x33\x95\xc8\x8e\x92\x4a\x64\xb5\x26\x4b\x38\x5b\x35\xe5\xc2\x16\x9e\x0b\xff\x2b\x23\x1c\x8c\x06\x72\x58\x19\xaf\xe2\x90\x54\x59\xfe\xaf\x6e\x2b\x95\x77\x67\xb7\xdc\x41\xda\x4a\xf5\xda\x23\xe5\xfa\x28\xac\x4a\x9b\xfe\x5b\x2a\x86\x01\xbb\x68\x05\xbf\x58\x27\xbf\x08\x77\x15\xb7\x72\x71\x24\x47\xa3\x77\xb2\xde\x82\xb8\x21\xdc\xc8\xbd\x39\xef\xad\xad\x5c\x62\xe2\x8d\xca\xad\x5c\x85\x69\x5e\xb9\xb7\x02\x4e\xed\x79\x5b\xf9\xc9\xba\xd3\xcd\xc7\xd4\x6d\x8f\x3c\x14\xb9\x3d\x4a\xc1\x22\x01\x2f\x7f\xd4\x21\x7a\xbd\x00\x9c\xd1\xb2\x8e\xa3\xf0\x5e\xdd\xec\xfa\x42\xd7\xc1\xf1\xb3\x22\xa0\x29\xe7\x04\xd2\xd3\xef\xc0\xea\x55\xd3\xd5\xcd\xe7\xe6\xf1\xf9\xd7\x59\x8d\xa7\xe6\x71\x1a\x97\x9f\x6b\xf1\xc4\x6e\x6a\x89\x95\x3b\x59\xed\x5d\x30\x8f\xda\x42\xdb\xe1\xb9\xed\x71\x3c\xde\xb5\xe7\xb9\xc0\xed\x71\x54\x13\xf0\x89\x78\x18\x07\x48\xbd\x1a\x37\xe7\x23\x03\x04\x99\xc8\xf5\xca\x53\xd9\x86\x9b\xc1\xab\xad\xdd\x50\x30\xa6\x0f\x6e\x62\x0f\x1d\x96\x7f\x9d\xd5\x02\x05\xf4\x74\x0a\x5f\x64\x89\xa7\x66\x89\x68\x87\x08\xa8\xe5\x5d\x2b\x25\x52\xa0\xab\x33\xc5\xc6\xdb\xe3\x48\xed\x1b\xe0\xa7\x53\x2b\x46\xda\xff\xa1\xd1\x30\x3a\xfa\xcf\x97\xb7\xcb\x1d\x06\x5a\x4c\x6e\x3e\x37\xaf\xd0\xeb\x48\x7b\x0a\xcd\xf5\x08\x1a\x58\x92\xee\xec\xca\xfd\x16\x0c\x3e\x1e\xe1\x01\x1b\x2b\x41\xac\x30\x5a\xd9\xbb\xe5\xbb\xdd\x89\xdd\x1e\xc7\x29\x26\xee\xaf\xfe\x0e\x5f\x81\xf9\xcd\xa1\xf1\xa2\xbc\xce\xfb\xe9\x4a\x06\x79\x4d\x1a\xc8\x3e\x08\x00\xc5\xc2\x2d\x5f\xc0\xe5\xe7\xf3\xc9\x2e\x0e\xd9\x72\x9a\x7e\xdf\xf9\x55\x90\xf0\xd7\x16\xcf\x2c\x7e\x94\x9a\x2d\xaa\x59\x52\xcf\xdc\xce\xae\x6f\xcc\x4a\x78\xc6\x9a\xad\x81\x62\xbc\x3c\x38\x5f\x3f\xa8\x5e\x62\x1c\x8a\x61\xd7\x5b\xe4\xa1\x55\x74\xd0\xf3\xc9\x78\x69\xc9\x06\x77\xa7\x12\xd7\xed\x71\x8c\xf6\xa6\xb1\xb7\x6a\x8a\x6a\x76\xc6\x09\x03\xa5\x25\xc0\x93\xe3\x1c\x71\x1c\x63\x33\x21\xb7\xe5\xd9\xca\xd0\x52\x8a\x8f\x9a\x8b\x9e\xf9\x89\x33\xc4\x3f\xb6\x98\x68\x69\x47\x5a\x7d\xb5\xca\x80\x64\x4b\xcd\x5e\xb9\xa5\x6d\x2f\x13\x1f\xb9\x8b\x35\x1f\x22\x73\x93\xa4\x25\x90\xc4\xda\x5f\xa2\x4a\x2c\x00\x5a\x46\x21\x68\xff\x70\xca\x3e\x8e\x29\xea\xe3\x99\xd8\x3a\x42\xb2\x3d\x59\x43\x2d\x0d\x53\x78\xb5\xa5\xd5\x1b\xee\x66\x00\xf6\x62\x8d\xbd\x6a\x29\x67\xb5\x57\xa9\xae\x50\x37\x11\xa0\xe7\x95\x72\xa2\xa2\xb9\x81\x51\xdd\x03\x4e\x8f\x3f\xef\x0f\xbe\xfa\xd1\x9d\x7f\x8f\x79\x05\xa5\x3e\xc2\xdf\x75\xf5\x14\x8b\xd5\x71\x6d\xc9\xf9\xbb\x3e\xb8\x65\x74\x16\xee\xc2\x37\x38\xf1\x02\xf1\x7c\x48\xb7\xee\x53\x99\x0d\x80\x05\xc8\x39\xb8\xc1\xd0\xe3\x3d\xa9\xd3\x0f\xe8\x2a\x07\x63\xc7\x49\x0d\x84\xf6\x12\x57\x49\x96\xe3\xab\xa4\x4f\xfe\xf9\x54\xba\xb7\xfa\xb9\x52\x2b\x02\x01\x77\x17\xfa\xe6\xeb\xc2\xa9\x42\xea\x6d\x8e\xd6\x24\xaf\x6a\x9f\xc6\x09\xc3\x23\x4d\xaa\x6b\x6a\x79\xa6\xa3\x8d\x09\xd7\xe5\xe7\x21\x99\x4e\xfd\xed\x71\x5c\x7e\x6e\x83\x19\xc1\xd8\x46\x92\xe5\x7b\xf8\x14\xb9\xcd\x79\x6a\x8d\x72\xf9\xfe\xd9\x52\x21\xae\xb6\xb6\xb8\xfc\x6a\x39\x59\x7e\x35\x65\xd9\xdb\x63\x3c\x47\x8f\xc1\x3f\xa6\xc2\x3d\x3d\xc3\x66\x1c\x73\x7b\x8c\xa5\x45\x62\xef\xdb\xf4\xbe\x01\x5c\x98\x2d\x72\x4c\x27\x2a\x89\x92\x93\x4a\x22\xbd\x20\x98\x1e\x24\x53\x0f\xed\x56\x6d\x2c\xb5\x35\xe6\x56\x39\xe9\xa2\xe8\xa4\xdb\x2a\x37\xd7\xb7\x3c\xc7\xbd\xd5\x56\x12\x3a\x60\x78\x31\x53\x93\x5b\x9e\xe3\xde\xea\xe0\x40\x62\xb8\xcb\x11\x20\x69\x11\xc7\xa0\x66\x91\x78\x0b\x11\x98\x01\x5d\x4b\x05\xc3\xd1\x89\x07\xd2\x0c\xe7\xe3\x18\x2a\x03\xf4\x88\xdc\x9d\x5a\xb0\xc1\xeb\xea\xa9\xda\x25\x25\x73\x22\xd0\x7b\x88\x66\x6a\x69\xab\x94\x21\x74\x72\x98\xd8\x75\xaa\x26\xdf\xa9\x3f\xb4\xbc\x7a\x37\x44\xb2\x76\x9d\x7b\xab\x7e\x72\x69\x02\xf7\x7c\x92\x7b\x29\xc8\xa1\x9f\xd5\xb2\xe8\x31\xc1\xeb\x4c\x71\x57\x1f\x12\xa3\xce\x0e\xff\xcd\x67\x0c\x6c\x76\xa8\x02\x8c\x1f\x88\xb7\x5c\xad\xd1\x7f\x18\x21\x8a\x62\xd9\x7d\x86\x20\xa4\x61\x7a\x7c\xde\xdd\x2a\xf3\x43\xfb\x86\x60\xb6\x9e\xd0\x10\xaf\x07\x49\x6e\xf5\x27\x49\x58\x6c\x6d\x35\x94\xe5\x57\x96\xd8\xf9\x8c\x35\xfe\x56\xcf\xcb\xff\xf4\x17\x4a\x2a\x9f\x70\xf5\xc7\x51\xa8\xa9\x68\xab\xe9\x00\x91\x05\xd3\x5c\xc0\x54\xa0\xa9\x7f\xaf\x93\xe5\x38\xe9\x21\x39\x09\xd4\x7c\xb6\xa5\x25\x43\x64\x31\x79\x56\x4c\x9a\xa3\x47\xdb\xd2\x14\x18\x96\x57\x89\xb7\x9a\x2e\x8e\xd1\x5d\x96\x5f\xc0\xad\x69\x2a\xad\x10\x6d\xb5\x5d\x16\xdc\xf2\x4a\x2a\x19\x5a\x45\x1b\x99\xf4\x72\x74\x2d\x61\x9a\xa5\xda\xea\x2d\xe0\x3d\x46\x61\xdf\x18\xc5\x44\xc4\x51\xa6\x71\xae\xbc\xbb\xbb\xe5\x61\xa5\x19\x7c\x8e\x35\x80\x1b\x19\x48\x0d\x47\x3e\x1b\x41\x28\x27\x8e\x92\xce\x46\x15\xec\xda\x6d\x47\xce\xf2\x36\xa9\x8d\x8a\x3a\x1d\x69\xc9\x93\x4c\xcd\x89\xde\x0e\x49\xe3\x06\x46\xec\x26\x32\x2a\xd3\xde\xb2\x1b\xd3\xb4\xc9\x76\xd4\xf3\xa8\xf6\xf4\x19\xcb\xda\x6d\x8b\x1c\x5d\x9a\x32\x04\x19\xad\x52\x03\x50\x75\xd4\x03\xac\x78\x96\x63\x3b\x6a\x21\xbd\xe8\xfb\x84\x8c\x3b\xfc\x0e\x82\xf6\x30\xba\x91\xc4\x25\x3a\x58\x15\xa3\x6b\xc3\xf4\xe8\x66\x2e\xa7\x88\xdc\xbd\x82\xf3\x7f\xe6\xcb\x9d\x2d\xab\xee\x8c\x36\x0e\xc0\x85\xd7\xe8\xea\xea\x6b\x0b\x71\x41\xdb\x1b\xe7\x56\x14\x11\x4d\x98\xef\x1f\x51\x3f\x63\xac\x83\x15\x6d\x9d\x3c\xd2\xa8\xcc\x65\x35\x05\x58\x1c\x0f\xc7\x7a\xe3\x0a\x74\xbc\x48\x2a\xdc\xec\xd9\x8e\x37\x2c\x67\x1c\xa4\x4b\x01\xc7\x34\xe7\xec\x72\xf7\xd6\x71\x6d\xe1\x6c\x62\x73\x5c\xdf\x81\x53\x74\xa5\x7e\x5f\x97\x8b\x21\x3b\xb3\xbb\x75\x3a\x4b\xbe\xa3\x4c\x9c\xce\xbc\x47\xb5\x75\x9a\x62\x71\x04\xb6\x79\x3d\x74\xdb\xd6\xc9\xdd\x90\x9a\xee\xe9\xad\x9b\x22\x8a\xe5\x51\x50\xed\x94\xcc\xd6\xf9\x8b\x9a\xf8\x0f\x29\xc6\xf1\xc5\xf1\xf7\xec\x62\xd0\x7c\x44\xe6\x4c\x87\xe6\xd6\x71\x6d\x07\xba\x32\xf5\x4e\xc4\xc7\xec\x8e\x0d\xa2\x13\xb9\x47\x58\x1e\x50\xda\xba\x99\x8e\xe5\xff\x8c\xab\x1f\x1d\x56\x5a\x1c\x7f\xdd\xbc\xfc\xdf\x68\xbb\x2e\x1c\xb1\xea\x06\xe0\x08\xe8\xc2\x30\x1a\x45\xad\xb8\x80\xa5\xa1\x0b\x2d\xdf\x4d\x7e\x73\x21\x70\xa8\x76\x67\x77\xbc\x63\xf1\x62\xa6\x4d\xb7\x2e\x01\xa7\xd8\x47\xfc\x59\xc6\xc8\x86\xe7\x0a\x4b\xea\x22\xbd\x64\x86\xba\x48\xd0\x86\x34\x1d\x95\xaf\x7a\xd1\x54\xac\x20\x2f\xee\x68\x49\x5f\xca\xd1\xb4\x7d\x6f\xdd\x4f\x9c\xab\x1d\x3f\x53\x6d\xdf\x69\x14\x0c\x93\xe0\x24\x07\x67\x2a\x6a\x9c\xfb\x5f\x5c\xef\x70\xf9\x58\x7b\xf0\x74\xb8\x75\xdb\x49\xb9\x14\x9d\x14\x7d\x6f\x8a\x03\x48\x29\xa6\x4c\x73\x3b\xc5\x0a\x72\xe1\xd9\xf8\xed\x54\xb1\xd4\x99\x2e\x92\x3c\x79\x4c\x25\x33\x61\xc2\x3b\x32\xe4\xe5\xb2\xfc\x62\x0a\xe4\xb7\x1e\x43\x2b\x46\x35\xaf\x75\x46\x09\xf2\xf4\x18\x26\x28\x1f\x05\xd3\x92\x8f\x91\xc3\x8c\xaf\xb4\xa1\xbc\x9d\x05\x63\xcf\x8c\xce\x6c\x6e\xed\x67\xc1\x48\x3e\x63\x4c\xcb\xdd\x76\xe6\x26\xfe\x76\x76\x89\x1d\x64\x76\x09\x03\xdf\xec\xf2\x5d\x11\x8a\x0b\x60\x50\x87\xfa\x9b\xa9\xf9\x1b\x1c\x85\x6e\x92\x67\x30\x41\x34\xb8\xc1\x25\x7d\xac\xf3\x7f\x73\xdd\x7e\xef\xba\x01\xbf\x33\x23\x1c\x78\x43\xbc\x72\x32\x8b\x58\x4b\x52\x83\xdd\x36\x52\xfc\x68\xe2\x5d\xd4\x81\xe3\x0b\x55\xe2\xd3\x8d\xce\x11\x07\x1e\x67\xda\xc6\x11\xe5\x1b\x47\x16\x44\x7c\x5f\x3e\xa6\x6e\x1b\x27\xcc\xa5\x71\x8a\xdc\x34\x34\x80\xe2\xfc\x14\x63\xa3\xd7\xd4\x22\xf7\x26\xc8\x47\xaa\x5e\xdc\xc6\x66\x98\x62\x1b\x3d\xda\x30\xe8\xea\xdf\xa5\x72\xdf\x7c\x1b\x3d\xc6\xf2\xc8\x6f\x6c\x64\x76\x93\xa9\x19\xce\x37\x46\x4d\xc2\x06\x73\xa1\xf6\x1f\x38\x2c\xee\x39\x0e\x37\x07\x9e\x50\x94\xe7\xe8\xbb\x6d\x0c\x07\xca\x29\xb1\xe5\x2b\x70\xb6\x8a\x7c\xdd\x40\xff\xa0\x49\x10\xe3\xf9\x0c\x92\x6c\x04\xe3\x6d\x1c\x3e\x97\xac\x68\x92\x72\x4b\x7e\x1b\x53\xe4\xda\x27\xe6\xd0\xf6\xc4\x22\x06\x4f\x2c\x57\x98\xe9\x12\x67\x64\xb8\xb6\x6d\xaf\x78\x55\xb6\xd5\xb8\xfc\xa3\xdb\x9e\x45\xd0\x8c\xcf\x22\x37\x50\xbc\xf1\x99\xa2\xc8\x59\x82\x8d\x2b\x67\x09\x71\x18\x15\x20\x4d\x99\xcc\x56\x6f\x67\xe1\x66\x19\x18\xd7\x8c\x00\xac\x60\x00\xd4\xf1\x59\x8a\x9c\xd8\xa3\xcf\x52\xbd\x0d\x56\x67\x4c\x32\x60\x5c\x64\x9c\xb9\xba\x3d\xeb\x20\xf6\xa1\x07\x70\x90\x07\x0c\x47\x6d\x8c\x81\xf8\x75\x70\x7b\xd6\x24\x67\xb1\x00\xb4\xab\x81\x84\x14\xef\x7b\x76\x16\xd0\xf9\x12\x1d\x41\x20\xb1\xc7\x5c\xa2\xf3\x5d\x7d\x1f\x04\x2d\xfa\xfc\x5e\x91\x03\xef\x8a\xa9\x31\xee\xb6\xe7\x18\x2d\x6c\x92\xa1\x22\x69\x94\x33\x46\x84\x73\xc2\x88\xee\xe9\xe5\xa8\x04\x2a\x5b\xe3\x38\xa7\xca\x3c\xa6\xca\xab\xac\xdb\xbf\x56\x17\xe4\x80\x3a\x86\x30\x11\xfb\xe8\x7f\x68\xdd\xe2\xe1\xfe\x74\xaa\x9d\x3b\x00\x9a\xa5\x1f\xe5\x82\x92\xc3\xc0\x96\xdc\xea\x7f\x0f\x7d\x92\x5c\x4b\x3f\xd2\x1d\x30\x53\xd9\xdf\x84\x15\x03\x06\x15\xbe\x7b\xcc\x36\x09\x7e\x45\xd0\xd6\x8b\x40\xd4\x2c\x82\x4c\x15\xe6\x10\xd4\x1c\xed\x96\xfd\xb6\x98\xee\x32\x70\x3b\xed\xb7\x2d\x72\xf2\x5a\xc1\x47\xe5\xe3\x23\x29\xbf\xab\x6c\x8b\x78\x1b\x66\x0a\x6f\x1a\xb0\x81\x16\x31\x4d\x95\x00\x16\x26\xb8\xec\xa5\x0d\x89\xcd\xc5\xad\x6c\x38\x5a\x48\xac\x13\x8b\x9c\x29\x59\x93\x73\x7e\x06\x72\xe4\xe9\xb0\xfc\x8c\x59\xaf\x70\x90\x2a\x8f\x56\x56\x84\xa6\x79\xc1\x63\x5f\x0f\x54\xdf\x05\x07\xbd\x8a\x45\x56\xee\x1c\x8f\x1a\x58\xed\xc4\x6c\xa0\x6c\x8b\xd4\x9e\x6f\x5c\x6d\xf7\xa4\x48\xc5\x92\x01\x03\x3e\xeb\xb5\xa8\x60\x29\x5c\xf4\x70\x70\x98\xca\x0a\xf5\xff\x6e\x0b\x77\x07\x0a\xe7\xe0\xf2\x90\xe2\x0d\x30\x90\x86\x43\x6c\xff\x05\x67\x3e\x68\xd3\x45\x95\xb1\xeb\x89\xcd\x93\x9c\x43\x51\xe1\x58\x26\x06\x90\x0d\x1d\x39\xa8\x14\x75\x9f\x55\xa0\x36\x68\xf3\xbb\x3e\x29\x8f\xa9\x1b\x4a\xc6\x47\x75\xde\xd0\x44\xe3\x05\x84\x8f\xc7\xdf\xf3\xc4\xaa\x57\x3f\x0b\xb3\x16\x52\x8c\x0c\x83\x76\x59\x4c\x6b\xcb\xb6\xe8\x99\x9f\x67\x1e\x80\x79\x3a\x8f\x96\x32\xb8\x33\xa0\xf6\xa2\x04\xac\x25\x4d\x6d\x07\xa9\x68\x6a\x31\xd5\xde\x38\x17\x4f\x64\xf9\xc1\xed\x11\x2c\x3b\xc1\x6f\xd4\x09\x0c\xa0\xc9\x66\xa0\xe2\xfa\x1e\xd4\x2e\x8e\x01\x24\x74\xae\xe2\x4e\xcd\x6d\x8d\xc4\x25\x6b\x22\xee\xfe\xdb\xba\xb0\xc4\xe3\xc4\xef\xda\x44\x6c\x59\xf1\x38\xb5\x9a\x21\xba\x3d\x82\xd1\xe7\xfd\x51\x07\x71\x62\x9c\xd1\x4f\x9d\xdd\xe5\x26\xb5\x08\x82\x1a\xfd\x74\x5a\x5d\xf0\x1c\x30\xd8\x19\x34\xcd\xa0\xc5\x72\x18\x1f\x2d\x8a\x2a\xc0\xc1\xf0\x86\xb4\xf1\x0b\x46\xd1\xae\x50\x1d\x5b\x30\x30\x91\x85\x63\xbb\xb3\xbf\xb5\x13\xfe\x3c\xa2\x95\x1b\x33\x7e\x05\x55\x16\x6d\xe2\xea\x0f\xc0\x31\xa8\x53\x48\x1e\x44\x03\xff\xb7\x8d\xf1\x92\xa2\x35\xdb\xc4\x29\x84\xcc\x5e\x20\x71\x0a\x00\xa8\x81\x19\xa8\x57\xbc\x69\x3e\x8e\x3a\x78\x7d\xc7\xd0\x59\xea\xe1\xe0\xb5\x6d\xff\x94\x3a\xd9\x85\xee\x6d\xa9\xdc\x87\x29\x58\x62\x96\x9a\x98\x4c\x65\x09\x2c\xff\x8d\xa3\x03\xed\xa3\x76\x5b\x2c\x66\x26\xda\x85\xd8\xd6\xc3\xa8\x3d\x46\xb4\x7a\x70\x90\x81\xea\xc1\xa5\xe5\x6f\xe0\x49\x46\x99\x25\x50\x34\xa6\xeb\xf7\x8e\x59\xa8\xcf\xe8\x81\xcd\xff\x38\xa2\x91\xd6\x1e\x52\x61\xed\x9b\xb8\x5d\x69\x5f\x71\xa8\x86\xdb\xdb\xd6\x61\x40\x5a\x83\xc3\x0c\x50\x07\x33\xc2\xbd\xad\x43\x75\x91\xcc\x0c\x2a\x6c\x2b\x9e\xe1\xed\xb9\x6d\x1d\x91\x47\x2c\x67\xea\x34\xae\xfe\x84\xe1\xa4\x36\x19\xab\x4e\xd9\x4e\x63\x01\xf1\x36\xd3\xb6\xfa\x23\x48\xeb\x9e\xd5\x53\xae\x07\xbb\x3c\xdc\x17\x6e\xce\x57\x6f\x33\x00\xed\x34\xf0\x1a\x9f\xc1\xdf\xd0\x28\x37\x34\xa7\x3a\xb7\x6d\x04\xac\xe0\x6a\xe8\x2b\xe1\x40\x32\x09\xaa\xa4\x86\x91\x7b\xaa\x15\x13\x7f\xb7\x45\x15\x60\x41\x8f\xd5\x28\x77\x60\x59\xc6\x58\xfd\xb6\xeb\xf1\xdd\xb6\x9a\xee\xb4\x6d\x2d\xf6\x86\x65\x64\x18\x54\xbe\x6d\x79\xd4\x12\x49\xd2\xf2\x9f\xe0\xec\x56\xb5\x15\xd5\xbd\x62\x9c\xbe\xe8\x11\x45\x7e\xb1\x3d\xa0\x8b\x86\xa6\x45\x69\x7b\xd1\x4c\xdd\x4a\x88\xff\xe2\xec\x5c\xe9\xf6\x12\x0f\x90\xd6\x96\x8f\xd5\x3a\xfa\xe5\xa3\x15\xdf\x25\x1d\x21\x39\x5e\xd9\x3d\xaf\x42\x85\xaa\xdb\xab\xcc\x37\xd0\xa0\xe7\x88\x56\x6a\xa3\x42\xdb\x92\xba\x2a\x16\x12\xd7\x36\x64\x5c\x9b\x12\x1f\x80\x81\x71\xd0\xd2\x57\x06\x68\x09\xdf\x7a\x08\x16\xb7\xcf\x85\xcc\x6d\x6e\xd7\x75\xb6\x77\x39\x48\x8f\x11\xef\x2e\x07\x08\x36\x77\x5b\x47\xdf\xb5\xdb\xde\xdd\xbb\x24\x94\xc0\xdd\x63\x36\xe4\x3a\xe1\x5e\xf4\xbd\x0d\x69\xf7\xdb\x6c\x26\x6e\xb6\xf7\x5b\xbe\xdf\xbc\x9d\x2c\xd8\x2e\x1f\x1a\x8c\x91\xb6\x4f\x47\x0d\xac\xfe\x28\x90\x5a\x7f\x73\xf3\xea\x40\xb7\x5d\xbe\x1d\x47\xca\x7d\xcb\xb7\xb6\x83\xb3\x7c\x4b\x83\xe3\x3f\xa9\x66\x27\xf5\xa7\x6e\xcb\xed\xca\x5c\xd2\xf2\x7d\x86\x23\x71\x2e\x5a\xfe\x79\x1c\x0b\x67\xbc\xe5\xd7\xf3\xd9\x3b\x05\xc0\x98\xc0\x1d\x2f\xd7\xed\xe4\x10\x53\x2f\xdd\x4e\xfa\x5e\xe1\x3c\x95\x6e\x67\xda\x1c\x76\x32\x0c\x28\xd5\x1d\x8f\x48\x1d\xaa\x76\x3b\x41\x88\x49\xec\x7a\xed\x8e\x9f\x28\xc9\x66\xda\xac\x05\x0a\x11\x4c\x19\xe3\xa4\x9f\x01\xf5\x33\xc4\x58\xa8\x3d\x61\x27\x93\xa3\xa1\x41\x80\xbb\x25\xe7\x0f\x11\xa9\xf9\x1e\x29\x79\xcc\x30\x3b\xf1\xee\x08\xd7\x2c\x73\x3e\x8e\xf6\xe0\x2c\x37\x3c\x36\x1f\x84\xda\x6f\x76\x76\x4f\x77\xc7\xff\x02\x73\x48\x33\xfb\x3b\x09\xbd\xf9\xf5\x37\xd0\x81\x04\x91\x85\x09\x04\x13\xe0\x4e\x10\xd3\xf9\xcc\x70\x49\x72\xbc\x80\xf7\x4e\xeb\x83\xaf\x7e\xf4\xcc\x14\x4a\x3b\xb8\x4f\xb0\xda\xc5\xbb\x6d\x00\xee\x24\x1f\x47\x50\x2f\xe3\xd1\x32\x98\xbd\x4c\xe3\x91\x5a\x6b\x3e\x1d\x37\x73\x64\x3c\x50\x38\x59\xee\xa4\x28\x49\x64\x66\x31\xb1\xb1\x34\x6d\x23\x67\x27\x75\xe0\x95\x33\xbe\xe1\x27\x5e\x99\x71\x87\x1d\x4f\x31\x76\x3b\xb9\x50\x89\xe6\x4e\x2e\xea\xbb\x9d\x7d\xb6\xda\x1d\x47\x39\x4a\x8a\x27\xa0\x7a\x11\x7e\xcd\x78\x60\x42\xd5\x24\x57\xe4\x02\x72\x57\xb7\xb3\xe9\x72\xa7\x23\x1d\x66\xdc\x9b\x88\x92\xd2\x4e\xdd\x4f\xbc\xd6\xbc\xd3\xc9\x63\xb5\xbb\x53\xaf\x79\xf5\xbf\x25\xe7\x23\x30\x3f\xc0\xef\x14\x63\x92\x7d\x9d\x97\xd5\x8f\x72\xb0\x3a\x56\x3e\x1f\x06\x74\x5a\x80\x80\x31\x6d\xa7\x21\x26\xed\x76\x8a\xc7\x63\x1f\x79\xbe\xbe\xdb\xf1\x2a\x5c\xb7\xd3\x24\x68\x3b\x9a\xf8\x7c\x52\xcb\xbc\x26\x45\x4c\x89\x8b\x9f\x9d\xa6\x24\x77\xc9\x04\xca\x98\x53\xba\x81\x2e\x1f\x78\xa8\x99\xc5\xdb\x69\x36\x2b\x44\x3b\x2d\x9a\x34\x70\xf7\x82\x79\x63\x5d\xd0\xf3\xf7\x39\x36\x5f\xaa\xb7\xdd\xe9\xad\x15\xc2\x28\x53\x4d\x60\xb4\x3e\x2a\xd7\x98\xe8\x19\x46\x52\x37\x89\x9b\xd9\x2c\xb8\x1f\xb6\x1b\x05\x43\xee\x6e\x7c\x5c\x96\xb2\xf7\x1d\xe5\xa7\xa2\x8c\xe4\x86\x87\x79\xce\x91\x00\x59\x07\xb5\x87\x34\x1e\xc4\xf7\x04\x7d\x4c\xb1\x12\x9d\xc7\x24\xb9\xd4\xdc\xed\x46\x77\x90\x8a\xd8\x9c\x7a\x6d\x6c\xf5\x79\xc3\x0c\xee\xa6\xc7\x18\x30\x59\xc7\x1d\xa9\xb1\x6d\x37\xba\xd0\x93\x2a\x1d\xa9\xce\xe2\xed\x54\xf1\x6e\x74\xd9\x36\xdf\x76\xa3\xbb\x80\x2c\xdf\x5a\x32\x91\xc2\xd3\x6e\x8c\x33\x4a\x9a\x2c\x33\x5c\xb4\x8d\x19\x80\x07\x5f\xbe\x81\xd9\xf6\xca\x6e\x8c\x09\x8d\x71\x8c\xa9\x65\x27\x96\x51\xca\x88\x62\x6d\x77\xf6\x76\x63\x8a\xa1\xb7\x42\xa8\x10\xd3\x77\x63\xb5\x41\x73\x37\x56\xae\x99\xc0\xb5\x90\xb5\x48\xae\xe2\x0a\xb3\xbf\x7c\x0b\x8e\xa5\xba\x7c\x4b\x2e\x36\xc0\x7c\x2c\xdf\x2e\x76\x8d\x6c\x37\x2e\xbf\xce\x8c\x7d\xf9\x35\x77\x3b\xc7\x79\x73\xe7\x8e\xa3\xe9\xeb\xee\x76\xae\x57\xdb\x1c\xd8\x39\x53\xda\xb8\x73\x3a\x8c\x0a\xb7\x66\x0d\xed\xd3\xda\xce\x9d\x4e\x12\x6e\xdd\xce\x4d\xbf\x3d\xe9\x65\x02\xed\xf9\x3f\x9f\xc4\x53\x73\x2f\x84\x73\x33\xba\xb6\x73\x73\x3c\xa9\x5e\x08\x32\xb7\x5c\x77\x8e\xeb\x9c\x6e\xe7\x02\x05\xcd\x9d\x0b\x4a\xd7\xd0\xed\xb8\xe5\x82\x84\xd2\x05\x12\x7d\x5f\x11\xa7\x5d\x04\xde\xb9\xda\xed\x9c\xb5\x4a\xf7\x13\x9b\x7f\x5c\xfd\x21\xd4\xd0\xed\xe2\x41\x41\xd8\x79\x63\x2f\x29\x82\xc1\xcb\x95\x6e\x17\xdf\xdd\x41\x90\x81\xf8\x0e\xff\x49\x12\x1d\x93\x43\xef\x8a\x58\x24\x62\x38\x8e\x53\xe5\xe8\x13\xbd\xf6\x08\xe6\x73\xb9\x61\x5c\x69\x27\xe7\x26\x43\xdc\x1e\x20\x6a\xca\x0a\x76\x71\x3e\x80\x70\x77\x6f\x17\x67\x54\x61\xa4\x99\xde\x5d\x9c\xdb\x89\xbe\x1d\xf7\x2d\xea\x19\xe0\x5f\x1f\xd1\x74\x9b\xef\x20\x5c\xed\xd8\x63\x62\x88\x18\x40\x62\xb0\x5d\xac\x5d\x8c\x25\x77\xbb\x78\x46\x1c\xd6\xab\x23\xdf\x28\x61\xac\xa0\x96\xb1\xbe\xf1\xd2\x3c\xb2\xb1\x1b\x19\x69\x71\x47\xb2\x98\x11\x4b\xc5\x12\x75\x17\xb1\xac\xdd\xc5\x3a\x33\x20\x3a\x73\xac\x29\x28\x55\xbb\xed\x62\xbd\x76\xbb\x78\x0d\x29\x1a\xcf\x96\x0a\x45\x98\xdf\x46\xf8\x24\x82\xea\x4e\x72\x40\xcf\x48\x34\x7d\xb4\x4b\xe2\x3c\x69\xc0\x98\xcb\xe1\x2a\x49\xb8\xdb\xf1\xd3\x5d\xe2\xed\xf4\x1d\x55\x18\x53\x4b\x67\x83\x0f\x5c\x0f\xa4\xa5\x5d\x77\x21\xbe\xd8\x77\xd6\x5d\x92\x8b\xa6\xac\x0f\x10\xbb\x5d\x52\xcf\x5b\xce\xbb\xa4\x19\x39\x78\x04\xd4\x8b\x4b\x12\x30\x3a\x00\x66\xe6\x41\xaf\x24\xa8\x92\xe4\x20\xc6\xed\x92\x3b\xc4\x60\x13\x49\x72\x47\xee\xbb\xed\x20\xf4\x33\x9f\xce\x62\x74\x03\x44\x88\x5d\x72\xec\x37\xc9\x05\x79\xcf\x2c\xcd\xe4\x28\xaf\xed\x92\x3b\x4b\xc1\x5c\x9e\xdc\x45\xfb\xc6\x0b\x1e\x8d\xdc\x93\x35\x10\x99\xdb\xd8\xb2\x17\x79\xa8\x62\x97\x62\x3d\x33\x40\xed\x07\xc6\x0e\x49\x22\x55\x0b\x73\x43\x53\x49\xcb\xb7\x63\xf5\xdd\x2e\x63\xf6\xce\x98\x93\xa9\xab\xf8\x66\x66\xd2\x76\xec\x09\x58\x7f\x7e\xa2\xd5\x3e\x6a\xce\x74\x3b\xbb\x6e\xf7\x1b\x5c\xbd\x89\xd7\x6c\x1b\x22\xf0\x8d\x90\x6e\x0d\x56\x48\x02\x99\xb3\x12\x26\xe2\x4c\x33\x23\xbb\x8c\x89\xce\x36\x16\x77\x39\xb2\x85\xe6\x7a\x88\x05\xce\xda\x93\x22\x70\x6d\x76\xb1\x77\xb9\xb6\xb0\x95\x3b\x6c\xbb\x5c\x4b\xae\x33\x82\x5c\xd4\x23\xbf\xf5\xc0\x99\xbc\x72\x71\x19\x08\xea\xa9\xdb\x55\x9e\xad\x36\x06\xb1\x7f\x87\x65\x11\xfa\x40\xf5\x92\x83\x82\x53\xd9\xf8\xae\xc6\xd9\x4d\x91\x1d\x0d\x6b\x24\x6b\x84\x35\x1d\xd9\x6f\x6a\x3a\xde\x25\x38\x4a\x10\x9f\xf8\x0a\x3c\xa1\x42\x2b\xcd\x02\xef\x6a\x62\x91\xd7\x84\x0c\x37\x35\x6c\xbb\x9a\x2a\x45\x0f\x59\xfd\x9b\xf2\x1a\xc5\xae\xe6\x2c\xc7\x6e\x57\xcd\x6c\xcc\xae\x16\x3e\x74\xc7\xd0\x5b\xef\xec\xc5\x37\x5f\x0b\x32\x72\x4b\x58\xef\xee\x6e\x45\x8f\xd2\xed\xee\x8a\xfc\xdf\xdb\xfb\x2e\xff\x28\x63\xb7\x5b\xbe\xfb\x09\x6f\xbb\xfc\xf3\xd1\xa5\x97\x5f\xd3\x59\xbb\x2f\x72\x4e\x72\x8b\x31\x5c\x50\xb4\x5f\x8e\xa3\x2d\xa6\xbe\xf4\x26\x65\x7e\xe9\xc5\x61\xea\xfd\x62\x97\x21\xbe\x68\xae\x83\x33\x56\xc1\x2d\xf0\xa8\x69\xb2\xeb\xec\x5f\x46\xdf\x7d\x71\x90\x96\x84\x80\xc7\x49\xbf\xf0\x3e\xf4\xd8\x7d\xf1\xda\x7d\xf1\x27\x37\x74\x5f\x3c\x56\x43\x5f\xfc\x04\xe2\x25\x0b\x58\x0b\xd3\xd8\xdd\xbd\x1f\xc7\x5a\x64\xe8\xbe\xcc\xb2\xfc\x5d\xba\x2f\x33\x97\x35\x5f\x82\xcc\x35\xc4\xee\x4b\xe8\xdb\x97\xa5\x2f\x5c\x5c\x93\x86\xd5\x6f\xbb\x16\x5f\x02\x64\x08\xee\xa4\x7c\x89\xc5\x79\xe9\xbe\x9c\xa5\x1f\x6f\x72\xeb\xbe\x9c\x8f\x23\xd7\xb7\x5f\xd2\x01\x2f\x97\x0e\x34\x5c\xd7\x7d\x49\xee\xa0\xab\x3f\xc6\x80\x9e\xdd\x7d\x49\xe8\x7f\x60\xcb\x2f\xe0\xe8\x37\x3c\xc3\xf4\xe5\x2a\xae\xfb\x72\xcb\x78\xc5\x7b\x35\x29\xfa\x55\xec\x48\xff\x2b\x3f\xec\xc7\x42\x90\x64\xc6\x34\xf3\x2a\xa7\x13\xe6\x86\x57\x19\x3d\xc8\xa7\xb7\xeb\x6f\x02\xe6\x49\x3c\xe4\x91\x57\x71\xc1\xc5\xee\x55\xde\x31\xbe\xf8\xda\xbd\x8a\x3f\x2e\x1f\xd4\xa3\xfe\x6a\x87\xfc\x34\x47\xc2\xe5\x5b\x06\xe7\x96\xda\xab\xf8\x89\x72\x2b\x40\xd3\x74\xf7\x2a\xde\xf7\x31\x6b\x12\x42\x05\xc5\x7c\xd1\xd3\x5d\xf0\xf0\x7c\x8e\x1e\x2e\x44\x10\x0e\x49\x6b\xb6\x99\xf6\x55\x82\x6d\x3b\x13\x04\x63\xe9\x1c\x63\x2a\x9f\xae\x8b\x4e\x9f\xf8\xda\xe2\x0f\x63\x8c\x29\x10\x39\x96\x41\x08\xbc\x50\xfb\x2a\xa1\xfe\xc4\x78\xf9\x09\xf5\x95\xa6\xe4\x40\x47\x09\x72\x18\x6b\x41\x90\x34\x88\xd1\x4c\x36\x6a\x66\x98\xf7\xca\x19\xfa\x55\x52\x16\x6e\x74\xbf\x4a\x96\x50\x46\x82\x63\x0c\x47\xf5\x3e\xe2\x91\xac\xf4\x72\xfe\x07\x16\xae\x01\x31\xf4\xf8\x52\x41\xc7\xad\x79\xff\x2e\x44\x76\xbe\xdd\x72\x33\xc7\xef\xc2\x67\xe7\xdf\x7f\x0b\xf5\xae\x8f\x3c\x64\xe7\x27\x16\x78\xae\xbd\xfd\x5f\x58\xc7\x65\x42\xb6\x2b\xfb\xec\xab\xd0\xf4\xd3\xea\xc9\x29\x23\x7b\xb8\x96\x6f\xe6\x1c\x46\xd0\x33\xeb\xf6\xc2\x7a\x5a\xfe\x3e\x4a\xf7\xaa\x28\x59\xd5\x70\x10\xc9\xab\x8d\x7b\x9f\x72\xf7\xaa\x43\x70\xa0\x45\xbb\x57\xe5\x26\xd0\xab\xd5\x80\x7a\x2b\x4e\xf5\x72\x3f\x46\xf0\x7e\x92\xf9\x0c\xa0\xed\xdb\xc0\x27\x5c\xfe\x13\x98\xf7\x54\x51\x66\x66\xb2\x4b\x08\xe2\x9d\xcf\x42\xfa\x04\x5f\x3e\xee\x7f\xad\x7a\xef\x5e\xcd\xf8\xe8\xab\x86\x49\x0a\x1a\x39\xff\x46\x4b\xe5\x76\x81\x34\x90\xc8\x6a\x42\x16\x93\x84\x1c\x57\xff\xee\x0e\xe2\x05\xb9\x4c\x87\x96\xd1\x74\x70\xa5\x95\xab\x26\x53\x2f\x4a\xef\xfe\x18\x6b\x2a\x44\x4a\x1a\xcd\x1b\x8d\x89\x00\x23\xfb\xab\xa6\x81\x4d\x5e\xd3\x18\xb9\x01\xf9\xaa\x69\xf2\xa8\x74\x4d\xb3\x56\x6f\x3e\x33\xed\xfc\x00\x05\x65\x94\x31\xf0\x59\x53\x18\xff\xaa\xe9\xca\xbc\x66\x96\x54\xc6\x7b\x82\xff\xb5\x22\xe1\x5c\x48\x38\x7c\xbc\x72\xcb\x7f\x48\x8c\xeb\x8e\x3e\xe3\xf8\xea\x8e\xd6\xd8\x5f\x79\x58\xe6\xd6\xbd\x3a\xc8\x96\xaf\xae\x8f\xdd\xab\xd3\x76\xe7\xe4\xd5\x69\x08\x91\x3a\x3c\xd2\xe0\x02\xdc\x69\x34\xc5\x87\xaf\x6e\xe0\x65\xb5\x57\x37\x40\x28\x79\x75\xb6\x61\xf0\xea\xde\xe5\xd6\x2e\x10\x76\xaf\x6e\xa2\xdc\xfd\xea\xfc\x80\x69\x16\xe0\x3d\x2a\x3d\xbc\x84\xaa\x17\x59\xfd\x61\x96\x3e\xde\xcd\x27\x35\x96\x94\x59\x01\xcc\xc6\xb3\x2e\x7f\x8f\xf6\x37\xd7\x1a\xaf\xce\xcf\x54\x20\xfb\xea\x3c\x4b\xc7\xcd\x7c\x24\x98\xee\x78\x3b\xfc\x9c\xbb\xc7\x21\xe8\x57\x17\xdc\x64\xf1\x03\x2c\x1f\x44\x13\x48\x1c\x92\xf0\xf4\xcb\xab\x8b\x18\x26\x5c\xab\x5a\x3c\xcc\xd1\xaf\x09\xc8\xaf\x78\xa5\xba\x7a\x72\xa5\x24\x85\x8b\xea\x48\x5e\xdd\xe3\xa3\xd5\xab\xcb\x8f\x80\x85\x59\x29\x33\x8b\xa5\x14\x12\xe9\x1d\x83\x17\xfb\xf3\xd2\x9e\xb6\xef\x46\xaf\x6e\xf9\xc6\xc1\xc2\x4b\x4f\x59\x17\xc0\x71\x29\x80\x29\xe4\xd5\x6b\x1d\xd0\xb3\xe2\xc1\xe5\xee\x35\xf6\x1a\xdc\x91\x16\xac\x5f\xa3\xf9\x0f\xea\xbd\x7d\x69\x7c\x8d\x68\x8a\x49\x5d\x31\x07\x4f\x49\x83\x53\xdb\xe1\x6b\x1c\x4a\xf7\x1a\x9d\xd1\x92\xf9\xb0\x9f\x48\x58\x5e\xd1\x4f\x58\x08\xad\x7e\x33\xc8\x0d\x2f\x16\x4f\xf4\xde\xda\x63\xf4\x71\xe8\x51\xf4\x31\xf4\xc9\x0c\x99\xbe\x46\xf4\x41\x2c\xb7\x5f\x63\x32\x83\xfd\x04\xf6\x49\x18\x90\x7f\x67\xcb\x14\x70\x86\x77\x26\xca\x87\xe4\xc2\x11\x91\x70\xea\x7a\x8d\x15\x32\xe2\x6b\xbc\x6b\x70\x61\xc5\xc3\x2a\xdd\x2b\x27\x30\x52\x05\x7b\x9f\xa3\xf6\xdd\x6b\x8a\xe8\x34\x69\xf9\x6f\x9e\x17\x87\x5e\x2b\x5e\x8c\xc6\xb0\xb0\x56\x78\xad\xef\x4d\xbb\xf7\x6b\xa5\x15\xee\x86\x13\xaa\xe0\xa2\xbd\x86\x3c\xb9\xdb\x27\xbc\x75\xaf\xcb\x07\x5a\xed\xf2\x11\xcc\xc1\x81\xec\x73\xf8\x58\xbe\xa7\xe5\x7b\xee\xf6\x72\x1c\xb9\x8d\xbe\x97\xc1\x46\x94\x3d\xcf\xe0\xef\x65\x08\x64\x9f\x4a\x59\x09\x53\xe9\xf6\x32\xb5\x2d\xea\xbd\x59\xf0\xda\x8b\xe7\x29\xae\xbd\xf8\x9e\x9f\x72\x01\xec\x52\xe4\x1e\xb3\x1e\x43\x4c\x91\xfe\x9e\x44\x8e\x4a\x7e\x48\x35\x30\x90\x65\xc0\x53\xd1\xff\xbe\x7d\x30\x02\x0f\xcb\xf7\x53\xfb\x8b\xda\x97\xf7\xed\xfb\xd7\x5e\x3c\xd3\x2b\xf6\x27\x37\xd6\xc1\x23\x1e\x0b\xc8\x6b\x98\xe2\x15\xcc\x5e\x22\x09\x07\xe6\xbd\x24\xce\xc0\x7b\x49\xc7\x7a\x06\xc3\x4b\xa4\x59\x7d\x24\x77\x7c\x34\xf1\x00\x1d\x38\xbf\x1f\x03\xe5\xa3\x99\x38\xd9\x4b\xba\x62\x2d\xb2\x97\xb4\x7c\x43\x5a\x39\xb7\x65\xef\x9e\x3b\x43\x07\x25\x48\x0e\x8c\xda\xa5\xc1\xd3\xc9\x5e\xbc\x60\x84\xac\x99\x61\xbc\xa5\xc5\xdb\x71\x7b\x29\x05\xef\x74\x4d\xd2\xed\x55\xec\x4b\x31\x75\x26\x83\xdc\x40\xf1\x16\xa0\xb4\xae\x44\x74\xe0\x41\xde\xbd\x1e\xd8\x0c\xf7\xda\x73\xb7\x76\xaf\x68\xcb\x7b\x55\x0c\xd5\x7b\x5e\x7e\xdd\x2b\xbf\xcc\x93\xdd\x0c\x18\x19\x65\x44\x18\x87\xdf\xa0\x28\x50\xf0\xd0\x93\xb3\xdc\xd4\x0e\x09\xec\xa9\xce\x94\xd4\x7c\x43\x6f\x1d\x70\xaf\x8e\x24\x9b\x77\xce\x46\x1f\x0d\x46\xbd\x7d\xda\x07\xb0\x78\xbc\x47\x10\x9f\xb9\xfd\xb5\x57\x5f\x90\xb8\x6f\x87\x53\xf7\x28\x46\x85\x80\x73\x88\x06\xac\xce\x95\x17\xa4\x7b\x02\x64\xd2\xea\x14\x03\xe1\xde\x14\x42\xed\x31\x3f\xd9\x96\xc7\x5e\x93\xe6\x8a\x82\x4b\xa7\x13\x68\x50\x54\x97\x26\x7b\x26\x85\x51\x66\x72\x2a\xa4\x24\xc8\x3c\xe8\x0d\xcc\xc4\x32\x66\xae\xbd\xf2\xde\xd5\x1e\x33\xcf\xfe\x31\xf3\x00\x58\x43\xa7\xee\xa8\x3e\xa6\xb3\xc1\x14\xc8\xad\x4a\x35\xb3\x15\xa2\xea\x9b\x29\x04\x78\x96\xd2\x3a\x84\x52\x15\xc5\x5e\x4d\xab\xe5\x7e\x14\x7e\xf6\xda\x8f\x76\x58\x71\x3f\xaa\x67\xcd\x8d\xdc\x9c\xdb\x8f\x2d\xf8\xe8\x4e\x27\x94\x0e\xb8\x23\xcf\x16\xdc\x15\x35\x7a\x8a\x2c\x52\x40\x5e\x8b\x06\x6a\xbd\x14\xe8\xf1\xa7\x8f\x78\xd5\xd1\x15\x6a\x2a\x03\x08\x4c\xde\x59\x43\x1c\x4d\x95\x3e\xb9\x6b\x21\x79\x71\x80\x00\x21\x1c\x16\xc0\x7b\xaa\x40\x87\xb7\x6b\x8a\x82\xf6\x9c\x77\x59\xb8\xae\x7f\x87\x24\xb1\xc7\x5a\x19\xb4\x5f\x3d\x6b\xbd\x3a\xed\xe1\xe0\xa6\xe9\xde\xa9\xbd\xbd\xc3\x28\xbd\x77\x3c\xde\xc7\x27\x52\xd6\x19\x60\xe0\xbd\x4a\x70\x17\x1e\xdc\x92\x1f\xc6\xd2\xed\xdd\xe4\x2b\x9f\x9f\xda\xc6\xfd\xde\x21\xc2\xd5\xe3\xeb\x01\xa2\xf0\x87\x8a\x94\x3c\x86\x1a\x53\xf7\xb4\xa7\x2c\x39\x19\x37\xf5\x40\x7b\x87\x02\xa2\x89\x04\x52\xb6\x52\xe7\xed\xd5\x58\x31\xce\x9f\x40\x46\xf5\x4c\x85\x4f\x4f\x96\x29\xf4\x09\xe7\x7d\xaf\x58\x49\xee\x79\x24\x81\x74\x46\xdd\x63\x6a\x67\x69\x90\xe7\x4f\xc0\x97\xf0\xde\x35\x1f\x5e\xfe\x03\x9a\xe2\x6c\xa1\x63\x1d\xc6\x03\x13\xf6\x0c\xd3\x9e\x28\x79\x74\x0c\xca\x93\x2e\x7b\xd7\xba\x98\x0b\xbd\x15\x7a\xe8\x79\x7d\x7e\xcf\x36\xc8\xd3\x92\xa0\xad\x05\xb8\x30\xf8\x93\xd5\x40\x60\xe1\x98\x96\xd1\xbd\x0b\xd9\x33\xef\x14\x14\xf7\x8e\x3d\xc0\x25\x4c\x02\x0e\x23\x87\xcb\x3e\x56\xc6\x9a\xcd\x40\x3c\x4a\x2c\x43\xe8\xdb\x3b\xbc\x63\xb1\xb4\x0b\xe7\xea\xbd\x2b\x5e\x8e\x77\x87\x52\x29\x65\x16\x34\x1f\x02\x36\x8f\x52\x78\x4f\x12\xa0\xf5\x84\xd8\x6b\x1b\x0d\xa3\x62\x96\xb0\xee\x13\xdf\x8f\x4e\x4d\x8b\x8a\xeb\xf6\x11\xa5\xcf\x4b\xb0\xa0\xc4\x13\x88\x17\xeb\x65\xb4\xeb\x44\x1a\xae\x36\x48\x46\xef\xc3\x0d\x2c\xe6\xfb\xf1\x2e\x53\x83\x37\x6b\x15\x3c\x39\xc4\x7a\x8d\x4c\x2c\xf6\x24\x2c\xe3\x18\x7b\x9e\xfd\xdc\xc7\x68\x71\x27\x53\x99\xb8\x37\xe5\xfd\x7b\x48\x00\x8e\x9b\x36\x06\x1b\x47\xf9\xc5\x16\x69\x12\x10\xcc\xbf\x60\xd6\xe9\x92\x35\xd9\x7a\xbc\xb7\xdb\x89\xfb\xfa\x8e\x46\x58\x3d\x7b\x74\xe5\x6b\x55\x7f\xb2\xd5\xff\x9e\xf7\x79\x6c\x1e\xac\x89\x47\xe4\xf7\x35\xcd\x87\x6e\x7f\xe3\x41\x91\xfd\x6d\xea\xf6\xb7\x99\x16\xfb\xf6\xb7\x10\xb4\xdb\xdf\x72\x84\x7f\xce\xab\x3f\x8e\xb1\x97\xd2\xed\x97\x9f\xed\xea\xc4\x7e\xf9\xc7\xfb\x91\x36\x2c\xf7\xcb\x77\x14\xdc\xf2\x6b\x2a\x45\x67\xa6\xf4\x17\x27\xf8\x05\xa2\xd8\xfd\xc5\x69\xf7\x17\x47\x57\xb8\x19\xc7\xcb\xff\xa5\x6a\xf7\x15\xd3\xda\x57\xe9\xe5\x22\xdd\x57\x31\x0d\xc5\x5f\x65\x8a\x17\xaf\x17\x00\x9a\x82\xe9\xbe\xca\x8c\x9f\x0c\x2e\xf3\xff\xf9\x33\x24\xd0\xef\x3c\x01\x57\xbb\x38\xbb\x22\xf4\xe1\xf7\x2c\x80\xfc\xf0\xa1\x7e\xe0\xaf\xc8\xda\x57\x0a\xf5\x5f\x05\x01\x52\xcc\x76\x2b\xf9\xab\x9d\x68\xfa\x2a\x39\x40\xb6\x75\x08\x9b\x2b\x68\xb1\x9c\x16\xcd\xa0\x79\x72\x7a\xb9\x1d\xc7\xee\xab\xf0\x2b\xe8\x57\x7e\x22\xfa\xaa\x01\xbf\x3e\x76\x76\x55\xef\xab\x62\xe5\x59\xf9\x0f\xa9\xf3\xbd\x4b\x6e\xf6\xae\xfb\x8a\xd7\x42\x59\x7c\x8d\x93\xed\x6e\x7e\x8d\x34\x92\xfa\x35\x06\x6d\xee\xd8\x7d\x8d\xa6\x29\x9a\x9c\x7b\x5d\x44\xf6\x12\xb1\xe2\xf1\x2a\xa1\xfb\x8a\x92\x84\x30\xd7\x7d\xad\xc8\x4c\x4d\x25\xa3\xfc\x8c\x4b\xf7\xb5\xd2\x62\xc1\xd7\x9a\xe3\xe9\xd4\xbd\x09\xc6\xd9\x37\x6e\x5e\x38\xc9\xdd\x9b\x8c\x90\x96\x8e\x13\x7a\xda\x1b\x26\xee\x37\x71\x73\xf7\x26\x13\x7e\xa3\x29\x35\x7f\x93\x89\xfb\xed\x6f\x32\xd9\xde\xdc\xbb\xfc\x86\x6f\xc0\x5e\x20\x18\xb6\xad\x3e\x03\xb4\x98\xd3\x80\xeb\xde\x64\x3e\x68\x29\x32\x39\xa4\x39\x57\x4b\x2e\x48\xa2\xc0\xff\x66\xa3\xfe\x1b\xaa\xe6\x4d\x82\x33\x4b\x7b\x6f\x10\x4b\x69\x01\xe9\x4d\xb0\xf4\xec\xde\x24\x1d\xe1\x48\x3d\xcd\xe2\xbd\x7d\x3e\x9d\x14\x84\xe9\xa5\x4a\x9d\xeb\x6f\x94\x62\xde\xe4\x22\x3d\x7c\xaf\xd2\x33\x5f\xbd\x1e\x98\x2d\x3d\xc8\xea\x47\x09\x11\x28\x59\xf7\x79\x6b\x62\xc8\x9b\xba\x62\xbc\x39\xbd\xfd\x6b\x39\x54\xe4\x50\xc3\x44\x6c\x85\xa3\x37\x48\x89\x6f\xa3\x38\x10\xfc\x3f\x4a\xec\xde\x46\x64\x98\xc7\xa7\xdf\xc6\x68\xb4\x76\x6f\x23\x7f\x16\xaa\xa6\x03\xdb\xdd\x9b\xd3\xf9\x60\x06\x2d\x76\xd7\x98\xee\xb7\x1e\xaf\xec\x06\x64\xcf\xbd\x7b\xf6\xc9\x37\xf7\x7e\xee\xde\xdc\xa4\xb9\x7b\x73\xb3\x0b\xf0\xf9\x34\xb0\xfd\xe6\x8c\x30\x3b\xee\x7c\x46\x0d\x93\xb9\x9a\xbb\xb7\xd9\x95\xb6\x42\x7a\xe3\x11\x92\xb7\xd8\xa3\x99\xbf\x51\xfd\x1a\x72\x1e\x87\xa1\x7b\x8b\xb4\x29\xf8\x16\xbd\x77\xa6\xdd\x87\x90\x3b\x6d\x6f\xf1\x7c\xea\xde\x62\x41\x0b\xc8\xc7\x91\x57\x4f\xde\x72\xf4\xa5\x7b\xab\x07\x2a\x9c\x7f\xab\xc7\x23\x75\xe5\xc5\x06\x59\x2d\xf5\xc8\x3d\xd2\xb7\x3a\x89\xef\xde\xaa\x8f\x32\x08\x39\xd9\xdc\x6e\xf1\xbd\xd9\x45\xed\xb7\xab\xd9\x41\x7a\xa3\x36\xed\x69\xf9\xe8\x96\xff\x23\x0c\x6d\x1a\x5e\xfe\x0b\xfb\xf9\xf2\x5f\xa6\x7a\x88\xdd\xf2\x1f\xa6\x78\x7a\xf9\x0f\xac\x34\xdc\x6a\xbd\x7c\xf8\x6e\xf9\x8f\x7b\xef\xa6\x59\x43\xb7\xfc\xd7\x5c\x46\x2c\x3a\xba\xe5\xbf\xf2\x14\xae\xf8\xee\x5f\x7f\x6b\x47\x78\xff\xf5\xb7\x29\x52\x48\xe3\x61\x86\x7f\xfd\xcd\x6e\x6b\xfd\xeb\x6f\x67\x09\x4e\x11\xae\xe6\x22\xdd\xff\x13\x00\x00\xff\xff\xda\x72\x72\x2a\x84\xfe\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xfd\xcd\x72\x23\xbb\x92\xa8\x89\xce\xf1\x22\x7b\xc4\x87\x48\xfd\x67\x4a\x94\xb4\x45\x2d\xa9\x56\xce\x40\x06\x18\x01\x11\x01\x70\xe1\x87\x4c\x72\x7e\xdf\xa0\xed\x58\xcf\xba\xb2\xcf\x2a\xab\xca\xee\xbb\xac\x6d\xdb\xee\x9b\x56\x56\x96\x75\x27\x61\xfb\xbd\xda\x3e\x07\xa8\x5c\xd5\xe7\x0c\x08\x77\x80\x08\x04\x02\x3f\x0e\x87\xc3\xdd\xf1\x41\xa7\x14\xca\x68\xd5\x87\xa5\xee\xac\x01\xc4\x95\x06\x64\xf5\x61\xb9\xd4\x89\x30\x64\x22\x9d\x71\xb3\x0f\x47\x7b\x6c\xe8\xab\x1e\xf4\x12\xbc\xb8\xd9\xab\x1e\x6c\x57\xf1\x8d\xee\x3a\x13\x2b\xee\xf4\xa0\x3e\x2c\xed\x8a\xd0\xe9\x37\xb3\x53\x1f\x96\x51\x8f\xe1\x1d\xf2\xa2\xa8\xc7\xa4\x3e\xac\xcc\xce\x74\x61\xf6\x14\xba\x38\xfd\xd1\x17\x73\x54\x1f\x56\x83\x8e\xb6\xf0\xdf\x60\xb6\x61\x70\x20\xd6\x39\xa3\x3e\xac\x36\x26\x8e\xda\xab\x0f\x2b\x17\x7e\x2b\x24\x94\xe9\x6f\x5a\x7d\xe8\xa4\x28\xc2\xe0\x89\xf9\x06\xd3\x46\xef\x4c\xaf\x3e\x74\x66\x24\x97\xf1\x5d\x88\xeb\x35\xd8\x41\x7d\xe8\x06\xbb\xd1\xd1\xaa\x0f\x9d\xed\xa7\x1f\x47\xe3\xd4\x87\x2e\xc4\xb7\xe9\x2b\x8f\x1e\x1c\x95\xed\x8e\x26\xab\x0f\xa6\x0b\xea\x83\x71\xce\x78\xf5\x61\x1d\xbc\x05\x1c\x8d\x93\x2a\xf6\x3a\x76\x83\xfa\xd0\xeb\xb8\xd7\x0e\x98\x06\x23\xc0\x56\x90\x68\xb6\xde\x44\x5a\xa6\xf7\xc6\xb9\x00\xb4\x43\xc8\x43\x2c\x5d\x51\x1f\xfa\x90\xb2\x55\x1f\xfa\xa8\x5d\x05\x3e\xec\xd2\x46\xbf\x69\x89\xd4\x42\x8b\xd5\x3c\x5e\xac\x6b\x30\x46\xa3\x3e\x0c\x94\x39\xb8\x65\x89\xbd\xfa\x30\x8c\xba\x53\x1f\x06\xaf\x3e\x0c\x14\x37\x14\x0a\xb0\xf5\x7d\x76\xa3\xf7\xc4\x46\xa3\x3e\xd8\x18\x5c\x67\x81\x07\xf5\xc1\x66\x9a\xc9\x1e\x8c\xef\x29\xf8\xad\x66\xdf\xe8\x9c\xca\x46\x0b\x52\x92\x20\x26\x26\xf5\x61\xd3\x4f\x3f\xbc\xfa\xb0\x19\xb4\xb7\x80\xcc\x33\x1b\x1b\x24\x87\x3d\x68\x9a\x78\x73\x08\x4e\x7d\xd8\x4c\xdf\xd4\x07\x37\xfb\x30\x1a\x9a\xd7\xcd\x16\x83\x19\x96\xfa\x08\xfa\xac\x0f\x07\x9e\x73\xf4\xa2\xd3\x29\xe8\xae\x44\x0d\xba\x23\x5c\xca\x9b\xdc\xd2\xc4\xdc\x80\xf6\xa1\x61\x96\xd7\xba\x65\xa0\x03\xdd\x32\x9a\xd5\x20\x79\xca\x6f\xc5\x44\x19\x0c\x6e\xa5\x5d\x27\x30\x44\x4a\xef\x74\x0a\x91\xa7\xbb\x36\x6a\x5c\x67\xe2\xde\x44\xe3\x78\xd0\xac\x8d\xeb\x80\x6f\xda\x77\x92\xcd\x6c\x92\x61\xa8\x3a\xf3\x45\x7b\x19\xcb\x6e\x6d\x3c\x33\xc1\xad\x43\x24\x2f\x15\xb0\xdb\x2d\xc0\xe9\x51\x7b\xd2\xea\x97\x38\xd3\xc7\xb2\x5a\xc9\x3f\x66\x9c\xfe\x3b\xe5\xc9\x80\x71\xce\xa4\x95\x74\x95\x73\x36\xc8\x27\x38\x2b\xc3\xd3\xb9\x5a\xa9\x51\x7d\x70\xa3\xe9\xf8\xfc\xf1\xb7\x62\x13\x95\x0b\xbe\x27\x67\xf0\x89\x82\xb6\x46\xbe\x67\x6b\x3d\x5f\x97\xa4\xd7\x5c\xca\xb1\x6c\xd5\x07\xf9\x16\x69\xbb\x9d\x8e\x9a\x91\xea\x76\x3a\x32\x8d\xdc\xce\x30\x7a\xdc\xce\xf0\x05\x3b\x86\xed\xa8\xeb\x50\x18\xb5\x97\x58\x0e\xea\xc3\xb8\x8c\x21\x59\xe0\x5e\x3a\x76\x34\x31\x8c\xea\xc3\xc8\x53\xa3\x8d\x9a\x91\x45\xf2\x48\x61\xa3\x54\x79\x24\x9d\x61\x36\x06\x32\xa5\x5c\xf2\x51\x7d\xf0\x7a\x08\xa9\x44\x10\xff\x17\xda\xd1\x6b\xdf\xcd\x1e\xa3\xde\xe8\x34\x48\xcc\xb6\xd4\xb7\x43\x43\xf2\xa0\xb7\xba\x1b\xb5\xd7\x4b\xca\xf5\xda\x4f\xdf\xf8\x67\x15\xd4\x07\x7a\x60\x69\x18\xdb\x60\x89\xb6\x14\x24\x9c\x10\x69\x44\xcf\x17\xfb\x2e\x1a\xdd\xd9\xd4\x30\x9f\x8e\x9b\x43\xc5\xdf\x0c\x4d\x03\xd6\xfe\xdc\x37\x78\x78\xff\x63\xfa\xfa\x33\xff\xd1\x58\x66\x9f\x7d\x8f\xec\x7f\x46\xde\x7e\x46\xa6\xdf\x2b\xf8\xa6\x3e\x78\xc3\x44\xf3\x6b\x2b\xc4\xcd\xdb\x04\x42\x8a\xb3\x1b\x3a\xdd\x07\x7f\x18\x03\x94\xc2\x27\x21\x36\x3e\x41\x68\x7c\x1e\x82\xe7\x9d\x39\x48\xa7\xfa\x1c\x20\x0e\xb6\x61\x69\x55\x2a\x36\xfd\xc1\x37\xe6\x18\xb6\x52\xfc\xd1\xaa\x0f\x5b\xeb\xf9\xd2\xed\x96\x16\xdb\x6e\x29\x6d\xbb\x95\xf9\xb2\x4d\xd9\xd0\xcb\x5b\xf0\x1c\x43\xc8\xea\x43\xd4\xbd\x0c\xc6\xa8\x2d\x91\xda\xfd\x51\x8f\x4b\x1d\xa9\x4d\xd4\xe5\x8d\x7f\x97\xd4\x35\x2e\x05\x2d\x47\xf9\x82\xb8\xd2\xbe\x37\x10\xa5\xb8\x1a\x04\x6d\x64\xe9\x67\xf4\x20\xff\xc9\xa3\xab\xc1\x2e\x35\xf3\x29\x42\xab\x6b\x2e\xcb\xd4\x8d\x2b\x72\x75\x36\xa5\xc0\x87\xc6\xae\xd4\x64\xb3\x96\x65\x21\x9a\x3e\x04\x1e\x33\x5e\x3b\x01\x5d\x02\xa4\xd5\x10\x4a\xaf\x3e\xc4\x3e\xec\xac\xf1\x89\x4f\x8e\x36\x65\xd3\x17\x6b\x32\xe5\xdb\x23\x21\x93\x90\xe2\xc6\x94\x63\xf0\x3c\xe0\x75\xa1\x3c\xdf\xf1\xf5\x34\xaa\x00\x19\x2c\xd1\x07\xa9\x22\x80\xef\xf2\xb2\xbe\xc5\x68\x75\x4f\x59\xd1\x3a\x77\xc2\x82\x13\x18\x6a\xe9\x59\x2f\xa9\x7b\x96\x1e\x8c\xd9\xc4\x65\x61\x46\xc6\x3c\x30\xde\x63\x96\xda\x65\x09\xa6\x1f\x47\xf5\x41\x66\x66\xdc\x99\x3c\x7b\x0e\x65\xc7\x1a\x12\x77\xb6\xa3\x09\xd4\x87\xf8\x45\x7d\x88\x47\xed\x5d\x28\xea\x43\x9c\xfe\xcd\x33\xd1\x92\x1e\xac\xd7\x40\xa8\xa8\x10\x0c\xc9\xcc\xc4\x49\xc3\xf2\x40\x28\xd3\x3e\x6d\xa0\x48\xc4\x37\x26\x4a\x3f\xa5\xad\x2b\x10\xa2\x94\xda\x68\x4f\xcc\xe6\x94\xf5\xda\x4a\x34\x0f\xda\xeb\x64\xeb\xfc\xc8\x83\x9d\xfe\x8f\xd9\x22\x14\x1a\x2f\x5b\xe3\x05\x6e\xac\x4f\x0d\xf0\x8e\x9c\x07\x73\x50\x1f\xca\xd2\x51\xf3\xb2\x8c\xd3\xb7\x5d\x5d\x7f\x4b\x27\x29\x5d\x28\x8c\x33\x68\x6e\x31\x31\xed\xa5\xe3\x4b\x6f\x19\x09\xc5\x19\xbf\xd4\xab\x8d\xfa\x50\x7e\x2b\x35\x25\x65\x72\xef\x4c\x34\x34\xf7\xce\xc4\x83\xf6\x61\xf7\x13\xd3\xa0\x69\xa3\xc7\x2d\x48\xb6\xc9\x32\xb4\x77\xde\xf0\xb2\x5d\x0c\x51\x1e\x9f\xbe\xcd\xee\xb4\x73\x66\xd4\x3e\xab\x0f\x7b\x9d\xf2\x60\xd5\x87\x2f\x86\x9f\x8b\x8c\xa1\x2f\x69\xb4\x79\x50\x1f\x0e\xc6\xa7\x02\x60\x2d\x39\x8c\x1a\xd2\x7d\xc8\x7a\xfa\x57\xf5\xe1\xb8\x2c\x1b\x69\xe8\xe3\xaa\x1c\xd4\x87\xa3\x30\x1f\xea\x4c\x93\x87\x30\x81\x13\x2c\xf5\x9b\xf6\xc0\x55\x58\x6d\x80\xb4\xe5\x99\x5e\x5a\xdf\xd3\x0f\x67\x7a\x59\xd4\x99\x5e\x91\xb6\xd2\x51\xb2\xae\x06\xe3\x42\x16\x44\xe8\x2b\x48\x7e\x23\x6e\x7b\xed\xca\xd6\x82\x6e\x8c\xd0\x34\xc1\xa2\x24\x0c\xa1\x24\x03\x26\xc5\x76\x46\x42\xcb\x4a\x7c\xc6\xda\x18\xf6\xbc\x83\x0c\x66\x35\x38\x79\xc6\x0c\x9e\xb2\x8c\x4f\xab\x41\x9d\xe9\xde\x59\x93\x33\xd9\xfb\x83\xf6\x3a\x6a\x42\x75\xa6\x07\xb3\xd1\x64\x1f\x9c\x3a\xd3\x3c\x60\xdf\xbc\xce\x3c\x61\x25\xc5\x99\x83\x00\x27\x2f\xb6\x4e\x62\x82\x7a\xfa\xed\x4c\xbf\x69\x6a\xff\xb6\x95\x87\xdf\xb2\xa1\xd3\xce\x34\x64\xed\x4c\x6f\x86\x62\x8f\x52\xd7\x4d\xd2\x3c\xb9\x49\x03\xd9\x36\x89\xd0\x41\xcf\x25\xb7\xd3\x9b\x68\xd3\xe0\xa5\x85\x9c\x1e\x67\xf7\x3a\xee\xa6\xaf\xe6\x28\x51\x9f\xf8\x38\xa7\x8f\x65\x55\x21\x25\xb9\xa5\x65\x21\x3a\xd3\xae\xd3\xac\xa8\x20\x96\x3e\x71\x5d\xed\x0b\xd7\xed\xad\x14\xb7\x0e\x85\xba\x38\x5b\x93\xf9\x2a\xe7\xb4\xcf\x90\x54\x41\xa5\x57\x19\xb9\xc4\xa4\xec\x51\xf0\xd0\xd3\x0c\x2e\x0f\xfa\x28\x4d\xe4\x4a\xed\xde\x51\xd6\xf9\x33\x3d\x6e\x79\x1f\xef\xf0\xab\x18\xd6\x74\xaa\xef\x78\x12\x72\x20\xa1\x54\xd0\x77\xc5\xc1\x20\x91\x72\x08\x5b\xdd\x0d\x07\x69\x0b\x6f\xe2\x9b\x31\x0d\x91\x8c\xeb\x16\xc2\x72\x9c\x69\x5f\xdb\xd0\x6f\xe4\x2d\x9e\x22\xa3\x7e\xd3\x69\x36\x0f\x51\x3b\x43\x6a\xd4\x4e\x52\xa5\xee\x51\x7b\x93\x59\xa3\x48\xf1\x61\x4f\x12\x73\x8c\x37\xc7\xa5\x86\x31\x02\x91\xfe\x8c\xcb\xda\x79\x71\x59\x1b\x27\xae\x9c\xd4\x29\xae\x03\xaf\x8e\xeb\x92\xa4\xfc\x7e\x08\x70\x49\x67\x3a\xd6\xca\xc4\x4d\x1d\x0f\x71\xb3\x0f\x51\x46\x49\x74\xd6\xf7\xf2\x7a\x57\x5f\xe9\x75\x27\xfd\x16\x7d\x9d\x2e\xd1\x9b\xa5\x3c\x02\x09\x13\x90\x69\xa9\xe8\x07\x1d\x2b\x32\x7d\x0b\x45\x72\xca\x10\x8b\xb0\x3f\x67\x3a\xca\xeb\x62\x6b\xd1\x18\xb5\x0c\x82\x18\x4d\x6d\x88\x68\x64\x2c\xc7\xc8\xdb\x6b\xd6\xfa\x74\x0c\xa9\x01\xf9\x3f\xd1\xd1\x82\x48\x72\xd6\xb3\x73\xed\xc6\x52\x23\x46\x5a\x2f\x0f\x27\x18\x5c\x18\x8d\x7c\x44\x76\x50\x21\x41\xac\xef\x25\x25\x38\x9d\x75\xc3\xa4\x82\x75\x86\xc7\x1c\xf5\x28\xd1\x3a\xcf\x62\x91\x4c\x7c\x31\x2f\x49\xab\xc1\xeb\x5e\x5e\x90\xcc\x10\x74\x9c\x3d\x86\x3d\xec\xeb\x99\x4e\x03\x39\xd3\xb0\x6e\x2d\x99\xac\x97\x36\x4e\xa7\x4f\x4a\x32\xe3\x21\xd4\xe0\x75\x56\xa7\x5c\x5b\x35\x41\x58\x32\xf3\x92\x3c\x59\x3b\x19\xeb\x19\xea\x52\x33\xe6\xd5\x00\x17\x76\xa6\xb3\x39\x41\xcf\x26\x60\xb6\xab\x31\x0a\xcd\x6f\xba\x8e\xe8\xbc\xa1\xb1\x72\x14\xbc\x7e\x47\xce\xda\x77\x75\x90\xe4\xac\xeb\x3f\x59\xc7\x86\xc8\x9c\xce\xb9\x7e\x48\xce\x16\xe2\x2d\x25\x1e\xe5\x89\xb2\x1a\xc2\x16\x28\x13\xa1\x74\x87\x7f\x7c\x05\xd6\xff\x06\xa9\x6a\xa1\xd1\x0a\x24\x70\x76\x16\x3a\xe3\x07\x63\x6b\x4a\xaf\x63\x2d\xbe\x8c\xfd\xf4\x7b\xcc\xb5\x51\x4a\x9a\xa4\x88\x5d\x90\x49\xfe\x25\x4b\x2a\xad\x7c\xa8\x98\x93\xf4\x43\x1c\xc2\x7a\x4d\x8a\x81\xf3\x06\x48\x40\x9b\x11\x26\x19\xbe\x46\x4b\xdf\x19\x5d\x92\xb1\xbd\x37\x4c\x01\xa3\xcb\xce\xd4\x6c\x20\xd6\x54\x4c\x5e\x67\x56\x2b\xd6\xf4\x33\xb3\xda\x48\x30\x9b\x6b\x4f\xaf\x66\x06\x84\x59\x6d\x74\xa4\xd0\xd5\x66\xd0\x0c\x2c\xd3\x75\x01\x02\x62\x3a\xd8\x8a\x33\xd3\x79\x1d\xcd\x66\xf6\xb0\x1a\x0e\xb4\x9e\x81\x5b\x3a\x33\xc6\xe7\x37\x32\x49\x5d\xa5\x1d\x8d\x11\x9e\xfd\xcc\xf4\x26\x32\x95\x4c\x1f\x0a\x83\xdf\x0c\x64\x19\x60\x7a\xd4\x99\xb1\x6f\x26\x5a\x2f\x15\xb1\x6e\xd0\xf1\x28\x08\x04\x00\xae\xee\xcc\xf0\x87\x83\x33\x00\xf2\x7a\x21\x70\xbc\x91\x55\x71\xfa\xb7\x48\x4e\x59\x37\xd8\xc7\xed\x79\xaf\x73\xd3\xbf\x8d\xa6\xfe\xc1\x3a\x7b\x56\xb9\xb6\x33\xe3\x18\xdb\xd2\xb1\xc6\xcf\x3e\x9b\xbf\xec\xc8\x02\x57\xdd\x80\xb3\x66\x07\x6a\x56\x1b\xf9\xc7\x74\xf2\x7a\x6f\x3a\xbb\xca\x0d\xf9\x52\xe1\xf4\x87\x40\x5e\xe7\x0d\xa3\xc1\xf8\x81\x79\x63\xfc\x1b\xaf\x00\xd9\xc0\x9e\x9e\x19\xef\xcd\x09\x54\x48\x4d\x7c\x60\xc7\x73\x66\x64\xcb\x7e\x66\x7c\x16\x3a\x6e\x7c\xae\x85\x48\xbb\x46\x7d\xd4\x70\xc2\x67\x26\x32\x82\x09\x8d\x67\x60\x83\x65\x78\xba\x33\x13\x3b\x19\x14\xb4\x25\xc5\xb1\xc6\x9a\xd8\x6b\xf9\x4b\x26\x9d\x89\xbd\x89\xb3\x47\x13\x23\x8b\xb4\xc4\xa4\x3a\xb1\xef\x23\xa3\xd2\xc4\x7e\x68\x90\x9d\x3d\x8f\x49\x8d\xe2\xc6\xd4\xa1\x15\x37\x43\x28\xf2\x84\x63\xe7\xd7\xa0\x4c\x24\x13\x9d\x61\x4a\x9b\x08\x17\x5b\x41\xfb\xc3\xcb\xac\x26\x25\x76\x90\x49\x13\x5b\x05\x7d\xfb\x7f\x68\x9d\x18\xbd\x5d\xad\x06\x2b\xdf\xeb\x2b\x27\x7f\x66\x62\x34\xa9\xae\x49\x26\xca\x50\x8c\x59\x17\x27\xcf\x67\x13\xa5\x05\x72\x63\x3b\x4c\xcc\x83\x69\xb0\xbd\x1b\xba\xd7\x35\xa4\x08\x14\xc6\xb7\x22\x42\x8c\x0d\x44\x4e\x3e\x46\x78\x4e\x48\x0c\x38\xdf\xc0\x32\x61\x52\x92\x92\x52\x92\x16\x90\x8e\x82\x12\x01\x52\x83\x99\x7c\x85\xca\x16\x59\x31\x04\x61\xf6\xab\x33\xb3\x33\x71\x6f\xdf\x18\x39\x07\x9a\x08\x38\xf2\x81\x47\x23\xe4\x66\xf8\xaf\x1c\xcb\xc0\x06\x76\xaf\xdf\x04\x7b\x47\x7a\xbd\xd3\xef\x88\x07\xcb\x12\x78\x8d\xd0\x02\xac\xc6\xb3\x46\x6e\x74\x20\x6f\x49\x9b\x92\x8d\x3a\xb3\xda\x85\x54\x36\x9e\x46\xb5\xab\x8d\x97\x69\x63\x57\x42\xbb\x6c\x27\x1b\x8f\x33\x0b\x43\xd6\x9a\xdb\xf6\xfa\x78\xb4\xea\xcc\xf6\x46\x96\x3c\xdb\x27\x16\x38\xdb\xef\x6b\x56\xd7\x33\x6b\x04\x99\x7e\x64\x96\x55\xdb\x98\x0c\xeb\x5c\xe5\xeb\xac\xa3\x0d\x92\x20\xc1\xcf\xae\x6b\xeb\x5a\xe7\x48\xaa\x13\xcc\x7a\x6f\x7c\x27\xcd\x02\x4a\x29\xc1\xd3\x2d\x76\x1b\xe0\x5d\x18\x7c\x76\xbb\x15\x10\x75\xc7\x47\xda\x28\x9f\x10\x61\x5d\xcf\x6c\xad\x14\x3b\xa4\xf5\xb2\x0d\xee\x1a\x5b\x83\x08\x7d\xb6\xb2\xc2\xd8\xb4\xd7\x94\x02\x39\x57\x67\x76\x17\xe4\x71\xc0\xac\x8d\x4c\xde\x7a\x64\xf4\xd8\xe3\x31\x1c\x65\x44\xbd\x69\x13\x99\xf4\x4e\xaf\xec\x4a\xc0\xa6\x86\xb2\xe7\x11\x6c\x2f\x0d\xe9\x74\xcd\xb6\x31\xc9\xc1\xed\x38\xed\xe1\x87\x01\x43\x8b\x06\x01\x39\xf8\xd9\x4d\x08\x70\x3b\x8e\x15\x26\x02\xbf\xd4\x32\x84\xf0\x3a\x63\x19\x7d\xea\xcc\x19\x9b\x8f\xea\xcc\x59\xbd\x19\x84\x5e\x39\xdb\xc9\x90\x82\xc5\xe5\x0f\x61\x00\x5d\x58\x6d\x0c\x0b\xaf\x0b\x1b\xd9\x36\x9d\xb9\x30\x8e\x42\x69\x5d\x59\xaf\xd5\x99\x2b\x50\x69\xc2\xa8\xce\x82\xce\xfb\x68\xfb\x21\xab\xb3\xb0\x8c\x30\xa5\x61\xb5\x62\x2f\x7a\x16\x20\x23\xec\xe9\x05\x23\x2b\xa5\x87\xd5\x6f\x85\x79\x04\x6c\xcc\x71\xe8\x64\x12\x87\x8e\x5e\x0a\x50\xee\x00\x61\x94\xe5\x38\x40\x1a\x1b\x62\xdf\xc8\x6c\x86\xb1\x86\x35\x75\x43\x4d\x83\x69\xc4\x23\x18\x27\x24\x35\xd0\x71\x08\x35\xce\x82\x89\x83\xd6\x10\xe0\x00\x45\x81\xe3\x0a\x26\x26\xe6\x49\x30\xc9\xec\xad\xaf\x25\xf4\x3d\x04\x2e\xf4\x42\x37\x42\x1f\x0e\x7a\xe7\xa8\xfe\x41\x9d\x85\x41\x98\x84\x20\x32\x85\xb3\x40\x9b\xa9\x33\x59\xdf\x82\x65\x1c\x04\x86\x43\x6c\x70\xa7\xdb\xbf\xc9\x9e\xd2\xac\x29\xa4\x64\xa3\x05\x22\x2a\x3a\x0b\x6f\xf2\x2f\x0d\x22\x0c\x62\x70\xab\x21\xec\x6c\x96\xf6\x16\xb2\x12\x84\x58\x04\xe7\xac\xd0\xd7\xe0\x32\x94\xbf\x97\x6f\x72\xb2\xde\x05\xc7\x20\x96\xbe\x01\x6c\x6b\x2b\xf2\x87\xd7\x63\x10\xc0\x83\x5e\xc3\xc8\x05\xaf\x61\xfc\x02\xf3\x25\xf8\x4e\xc7\x15\x4b\x90\x60\x95\xd8\x0b\x9a\xd3\x7b\x2a\xa8\x6e\xf8\xd1\xec\xf4\x6c\x1e\x7c\x86\xe4\x48\x07\x09\x77\x19\x64\xc5\x09\x88\x37\xa5\x54\xbb\xd6\x2b\xc4\x0f\xa0\xf5\x65\xde\x8c\x5a\xc4\x71\x67\xc1\x0b\x45\x0c\x3e\x44\xd9\x84\x05\xbf\xad\x74\x3e\x90\x31\xd0\xa7\xc1\x8f\x0c\xf4\x10\xfc\x36\xea\x3e\x2c\x05\x3d\x14\xc9\x1d\xe0\xf4\xc2\x76\x8b\x54\xe8\x2c\xf0\x59\x71\x39\x7d\xe5\xf3\xe3\x6a\x48\xc6\xcb\x9a\x12\xa2\x08\x79\xce\x42\x1c\xac\x8c\xa5\x68\x93\x8c\xc8\x88\x7c\x84\x01\x1f\xa2\xf0\x1a\x52\x24\xd3\x2d\x44\xaf\x0f\xb3\x3b\x67\x61\x35\xa4\x04\x6a\x0c\x71\x0d\xf0\xdb\x02\xc6\xe9\x87\xab\x63\x31\x86\xbd\xcd\x47\x76\x24\x21\x22\xae\x12\x44\xb8\xbe\x10\x65\x20\xc4\x04\x13\x1d\x62\x2a\x74\x2c\x2b\x4a\x88\x47\xc9\x75\x9c\xfe\xbf\x15\xfc\xa1\xce\x42\x5a\x49\xc0\x73\xf2\x58\xaa\xc4\x25\x08\x55\x09\x6d\x30\xa5\x4a\x06\x42\x4e\xab\x41\xfb\x7c\x34\xbb\xff\x12\xd1\xa7\x58\xf4\x76\x23\x93\x39\x30\xf7\xa5\xa9\x4b\x9b\x6f\x70\x93\x2d\x2e\xcd\x56\x2a\x5f\x1a\x4a\x17\x4a\x34\x09\xa9\xee\x59\x28\x7d\xcb\xdc\xd7\x67\x1d\xc2\x28\x49\x90\x9d\x4d\x28\x4e\xbe\xa9\xb0\x81\x0d\x25\x76\x35\x57\xec\x20\x6b\x14\x23\x83\xba\x44\x9a\xbe\xe6\x48\x75\x09\x0d\x25\xb6\x29\x50\x52\xb2\x4b\x2a\x58\xb2\x71\xf0\x6e\xa1\x64\xdb\x5e\x5e\x07\xf2\xbe\x0e\x89\x7d\xc7\x99\xc6\x19\x7c\xfe\x52\x7b\x9a\xb0\x72\xfc\x61\x5f\xdb\x7f\x4f\xa6\xc3\x8a\x07\x0f\x0c\x38\xe1\x60\xc3\x41\xe4\x2b\x67\x51\x24\x0b\x51\xb3\xca\xab\xb3\xa8\x57\x9b\xd9\x8d\x96\xad\x13\x38\x73\x5c\xa0\x8f\xb6\x83\x08\x44\xdd\xb5\x30\x0a\x10\x26\x24\xea\x4e\x42\x33\x12\xf6\x7d\x2b\x98\x09\x1b\x35\x93\x24\xea\xb1\x76\x4b\x5d\x78\x08\x4d\xcf\xa0\x05\x13\xd2\x01\x92\x58\x37\x6a\x5a\x06\xb4\xfe\x8a\x9a\x75\x9c\x70\x06\xdd\xae\x74\x30\xea\x9d\x95\x30\xd4\x70\x76\xc3\x94\x97\xd7\xee\x6b\x9d\xcc\x52\xa8\x78\x34\x9d\x10\x9e\xd8\xd8\xe3\x68\x4c\xe7\x02\x84\x2d\x56\x59\xfb\x59\x34\xb6\x93\x86\x8a\xc6\xe6\xbd\x35\x32\x8e\xa2\x71\x56\xf2\x8c\x46\x44\x40\x82\x49\xba\x08\x0d\xa2\xf1\xab\x0d\x84\x26\x9a\xa4\x3b\xc4\x72\x67\xf0\x44\xad\xbc\x64\x2b\x05\x8c\x26\x9b\x56\xb2\x74\x98\x88\x9a\x88\xe4\x56\x15\xd9\xf5\x46\x73\x80\xee\x47\x73\x1c\x3c\xa3\x35\x9a\xa3\xf5\x61\xc7\xfe\x23\xda\x95\x99\xfe\x16\x66\x1f\x99\xd8\xc2\x6a\x45\x4b\xa7\xf0\xb0\x95\x8e\xb0\x9d\x74\x11\x9d\x43\x51\xb6\x13\xaa\x11\x2d\x4c\x3d\xf1\xbe\x97\xe4\xde\xcb\x26\x32\x5a\x5a\xc4\xfa\xae\x36\x11\x1b\x3f\x79\x9a\x41\x13\xad\xdf\xd4\x39\x1c\x2d\xa2\xf7\xb3\x68\x19\xab\xd1\xb6\x06\x87\x16\x48\xd1\x29\xc3\x85\x44\x2b\xdf\x62\xd9\xb7\x55\x68\x1a\xac\x15\xc8\x47\xe3\x46\x7d\xe0\xe3\x03\xfc\x93\x2c\xb0\x31\xd4\x91\x12\x56\x9b\x77\xf2\x10\x03\x07\x7d\x67\x31\x74\x74\x65\xb0\x52\xcf\x30\x36\xa9\x44\x0c\xbe\x93\x05\x00\x39\xaa\xb7\xb2\x85\x8f\x41\x6a\x19\x02\xb5\xae\x2b\x78\x0c\xb2\x05\x8a\x81\xb9\x4c\xb4\xb0\x53\x95\xbc\x25\x0d\x1b\x19\x00\xa1\xa4\x91\x15\x1d\x74\x6f\x57\x47\x81\xf2\xc7\x5e\xda\x3f\xec\xbd\xac\xea\xa4\x0a\x67\x19\x0b\x73\x26\x16\x26\x57\x2c\x06\x56\x8d\xfa\x16\x44\x57\xb1\xc8\x9e\x26\x96\x71\x34\x9c\xa8\x08\x56\x9b\xa4\x8c\x5b\x01\x5e\x7a\xa6\x08\x3d\x8c\xc5\xfb\x4a\xad\x62\x49\x52\x9c\x10\xad\xc8\xd4\x96\x61\x54\x0e\x32\x67\x0e\x03\x45\x1d\x19\x01\xc2\x02\xc4\xe9\xf7\xd2\x04\x6c\x71\xfa\xb6\x3c\xf5\xc1\xf4\xdd\x7b\xdb\x3a\x6b\xfa\x41\x7d\xca\x52\xa4\x53\x65\x39\x7d\xdd\xa8\x33\xa9\x72\x81\x00\x6a\xff\x8e\xcc\x6e\xf4\x68\xeb\x12\x08\xa5\xf3\xb4\x6a\x23\x79\x80\x2c\xb1\xba\x2d\x06\x09\xee\x28\x48\x93\x0e\x94\xd5\xe0\x1b\x0c\xf5\x0f\x11\xb0\x9e\x15\x26\x59\x59\x6d\xea\xaa\x04\x42\xd3\x95\x15\x63\x09\xc8\x5e\xb7\x74\xda\xb3\x2e\x0a\x96\x87\xc0\x97\x95\x8e\xf1\x5b\x4c\x94\x48\xdf\x55\xc9\x40\x81\xa3\x2c\xb6\x76\x44\xd9\x44\x53\x53\x9d\x9e\xcd\x8d\x90\xad\xe2\x9c\x95\x65\x93\xf6\x97\x57\x8b\x34\xa8\xc0\xa2\xf6\xc4\xf6\x41\x1e\x85\xee\xc8\xa3\x8d\x24\x96\xb8\x64\x8e\x48\x42\x23\x65\x85\x0d\x1b\xf3\xb0\xc4\x8e\x3e\x2a\xb1\x4b\xb2\xcd\x6b\xd4\xb9\xc4\x9a\xbf\x37\xf4\x1b\x90\xf7\xc3\x20\x25\x81\x34\x71\xec\x83\x4c\xde\x12\x91\x5e\x96\x68\xb3\x5d\x31\x71\x4b\x94\x77\x6e\x64\xbc\x96\xb8\xb1\xb5\xdc\xda\x26\xd1\xa7\xd9\x49\xdc\xc7\x0a\x20\x59\x6a\xbf\x44\x46\x50\x89\xfb\x56\xaf\x23\x03\xb7\xa4\x95\xae\x87\x74\x67\x45\x56\x4d\x91\xcc\x16\x21\x53\x32\x98\x0a\x4b\x1f\xcf\xe5\xd6\x9b\xec\x32\x0a\xe2\xed\xb3\x92\x65\xec\x14\xd6\xb8\x36\xa3\x04\x6f\xd2\xb4\x22\xfb\xa4\x92\xeb\x7b\xf2\xb1\x0e\xa9\xf2\x65\x29\xe2\x92\x72\x90\x06\x3e\xd4\x41\x57\xfe\xf1\xff\x67\xb7\x7d\xd0\xac\x52\x87\xae\x0f\xe9\xa8\xce\x0e\x22\x17\x3c\x44\xda\x80\x90\x48\xca\x51\x32\xa6\x0c\xb1\x9a\xfe\xb3\xa2\x30\x14\xd3\xd7\xb8\x14\xd1\xce\xf4\xbf\xb3\x1b\x54\x67\xd3\xef\xd1\x35\x2e\x77\xfa\x97\xe9\x5f\x60\x56\xa6\x6f\x3d\x67\x31\x19\x6c\x30\xc2\xb7\x4c\xdf\x4e\x8b\xeb\xf4\x3d\xca\x20\x9c\xfe\xfd\xc4\xa7\x4e\x3f\x52\x7b\xfe\x07\xcb\xba\xb3\xea\x5c\x2f\x11\xc8\xc7\x20\x98\x37\x4e\x83\x18\x09\x8e\x6c\xb2\xce\xf5\x12\x39\xe6\xb9\x5e\x6b\xc9\xc4\x23\xec\x98\x4a\x57\x11\x93\x1b\x2c\x5f\x40\xbc\x04\x3c\xcf\x10\x8f\x81\x38\xa2\xb9\x83\xe4\x77\x9d\x4e\x02\x4c\xfb\xa7\x33\x71\xfa\xbb\xe4\xe9\x98\x85\xe7\x48\x4e\x33\x73\xf3\x5c\x43\x74\xcf\xb5\x1c\xb0\xb2\x7c\x22\x18\x64\x29\x20\x2f\x1b\x84\x73\xed\x76\xc6\x51\x23\xc7\xc1\x46\x98\x9d\x6b\x64\x9b\x8e\xaa\x8e\x08\x1a\xa5\x12\xe3\xd2\xa4\x34\xfd\x1b\xbb\xf6\x73\x3d\xb6\xb7\x8e\xd6\x87\xd9\x0b\x07\xf1\xd3\xdf\xc9\xb3\x95\x40\xe4\xd4\x20\xcb\x56\xc8\x96\xca\x7d\x95\xff\xc2\xec\xa2\xc0\x49\xd6\x48\x6a\x60\xd6\xc9\x2b\x0a\x71\xcf\xba\xe6\x88\x7b\xed\x24\xa1\xd3\xb1\xe7\x0b\xfc\xfb\x1f\x5d\x28\x29\xe9\x42\x1a\x13\xf5\x5c\x7b\x2f\xad\xe0\xeb\xd6\xe6\x5c\x7b\xc8\x3e\x00\xfe\x16\x18\x8a\x30\x88\xa0\x1c\x6c\x91\x37\xd7\xf6\xe2\xff\xad\x86\x8f\x04\x31\xb5\x85\xb6\x70\xa6\xd2\x78\x5b\x96\xa3\x2c\x58\xa9\x1f\xbd\x2d\x51\xfa\x2f\x72\x2a\xc2\xf7\xc5\x25\x1b\xa8\x0a\x2d\x92\x27\x6a\x1d\x3b\x24\xb3\x02\xa9\x50\xec\xeb\x5b\x63\x0d\xea\xc9\x36\x18\xc7\xe4\x92\x6f\xd4\x11\x65\x0b\x30\x53\x01\xdc\x55\x83\xda\xc8\x73\xde\xd8\xfa\x6a\x6f\x76\x68\x48\x9c\xd7\x91\x14\x03\x3b\x9e\x73\x1d\xb7\xa6\x7d\xb8\x60\xb0\x73\xe7\x08\x98\x09\x38\x15\x10\x28\x95\x8b\x52\x4f\xc1\x42\xad\x57\xeb\xe6\x18\x4b\xe6\xb8\x0d\xb4\x15\x95\x07\x1a\x3f\x96\x5a\x05\xe9\xa3\xb8\xd3\x6e\xe0\xcd\x49\xf7\xb0\x4d\x7c\x7d\x92\xb1\x9d\x34\x6c\x86\xfc\x45\xe5\xd3\x16\x99\xe1\xb9\x4e\x09\xcd\x05\xa0\x59\x1a\x29\x36\x25\x04\x6e\xe7\x3a\x65\xdd\xcb\x9b\x53\xd6\x9e\x73\x7f\x49\x9a\xfe\x76\x42\x0d\x67\x18\xf6\x27\x2a\xa5\x64\xc6\xa9\x20\x74\x66\xed\x47\x8e\x3c\x1b\xd8\x59\xf3\x46\xbe\xac\xd3\xf4\x1f\xd4\x37\x1b\xa4\x04\xe7\xba\x38\x67\xa4\x3e\x3b\xbd\x22\xc3\x8e\x77\xd7\xea\xee\xb4\x5b\x31\x6c\x7e\xa2\xb6\xa2\xb5\x15\x2b\x42\x3d\x77\xda\xb3\xc6\x53\x2c\x3a\x06\x80\xd0\xc6\xca\x21\x94\x36\xb1\x0e\xc8\x87\xcf\xf5\x91\x31\x6a\x3a\x78\x29\x75\x6e\xde\xb6\xea\xdc\x38\x16\x08\xb8\xb4\x73\xe3\x7b\xab\xd5\xb9\x89\x3b\xde\x9b\xd4\xb9\x49\x6c\xfd\xce\x4d\x3e\x6a\x37\xfb\xf8\x45\x9d\xd3\xc9\x2e\xa4\xd9\x95\x89\xd1\x1e\xd5\xb9\xd9\xab\xf3\x41\x2f\x43\x64\x8d\x3e\x1f\xf4\x6a\x70\x61\x7d\x42\x76\x82\x48\x5b\x0c\xba\x33\x6b\x0d\x9d\x18\xf4\x26\xea\xa5\x8e\xf9\xd0\xf0\xdd\x09\x77\x7a\x59\x68\xe2\x41\x3b\x13\xc7\x5e\x06\xd0\xa0\xdd\x28\x29\x22\x3e\x00\x09\x90\xbd\xf3\x41\x8f\x4b\x76\xe3\x35\x0f\x78\x12\x04\xb6\x40\x90\xad\x65\x66\x80\xb8\xb2\xb3\xed\x91\x12\xad\x64\x93\x7f\xfc\xca\x08\x10\x8e\x57\x90\xa8\x67\x4f\xa6\xeb\xa4\x62\xbe\x8b\x3a\x71\x40\xa7\xfd\x7b\x74\x90\xb8\x44\xa1\x5f\x9c\xb2\x4b\x98\xb5\xd7\x2b\xc4\x5e\x35\xc2\x11\x50\x1e\xb4\xb4\x87\x3f\x68\xdf\x6b\xf9\x84\x20\xc1\x5e\xa7\x4d\x01\xdb\xc2\x56\x4b\xb5\xb6\xac\x85\xe7\x22\x5c\x3b\x4a\x95\x64\x8b\x24\xb0\xb5\x5d\xb4\xd2\xa6\x51\xfa\x78\xf8\xd3\x54\x1a\x98\x3e\xb5\xfa\x49\x1e\x65\xa9\x03\x8c\x1a\x62\x3f\xe8\x1c\x83\xbc\x8d\x95\x8e\x23\x2f\x41\x6b\xe9\xb9\xc4\x9d\xe9\xa4\x96\xa5\x43\x32\x27\x18\xb2\x85\xf3\x41\xef\xb4\x67\x09\x03\xa3\xa7\xf9\x6b\x57\xe3\xe8\x11\x45\xcb\xb7\x1a\xde\x60\x56\x12\x52\xb2\x31\x1b\x09\x9d\x84\x89\x6d\xb0\x60\x7b\xed\xfb\x4d\x90\x97\x1a\x67\x96\xc2\xde\x9c\x0f\x06\xd9\xf3\x39\xcc\x97\xcf\x5a\xba\xc5\x30\xe9\x06\x83\xc4\x8d\xf4\x5e\x22\x7d\x2a\x5b\xed\xed\x58\xdf\x18\x47\x73\xac\x05\x45\x7f\x48\x83\x61\x84\x99\xd4\x9e\x48\xc9\xc0\xe8\x82\xe5\x3a\x1e\xcc\x69\xb2\x08\x76\x42\x19\xb2\x7c\xb6\xd5\xb1\xf4\x15\x91\x75\xfd\x7c\xb0\x5d\xa7\xe3\x5e\xf3\x80\x85\xe2\x0d\x76\xbd\x76\x81\x22\x2d\xdd\x03\x74\x1d\x05\x4b\xd5\x61\xb3\x40\x3d\x42\xaf\xf3\xc1\x86\x9d\xf1\x32\x7e\xed\x76\x6b\x7d\x07\x03\x75\x3e\xd8\x5c\x17\xbb\xc1\xe6\x20\x1d\xef\x82\x67\xb3\x7d\x0e\xb1\x1a\xc2\x6a\x88\x07\xa6\x1f\x78\x27\x23\x28\x98\xc3\xa6\x0e\xfd\x40\xdd\x82\x4d\x7c\x5c\x6d\xbe\xb0\x95\x4a\x84\xdf\xda\xa4\x46\x6a\x7c\x3e\x84\x22\xea\x58\x20\x83\x34\x79\x60\xb9\x1e\x02\xdf\x19\xf6\xdd\x20\xf4\x45\xb0\x77\xa4\x08\xd6\x34\xfd\xce\x07\x14\x37\x88\xa3\xbf\xe1\xdf\x91\xa1\xa4\xe3\x7b\x24\xfd\xe9\x8f\xda\x58\xe0\x56\xff\xe9\x0f\x6b\x1a\x12\xb6\x9c\xeb\xfd\xc4\x63\xcb\x93\x6a\xf5\x62\x96\x81\x12\x0f\xa9\xce\xbc\x38\x7d\x43\xd3\xc1\x87\x3c\xbb\xb0\xb2\x24\x0e\x7c\x56\xe9\xf4\xdb\xd2\xc4\x0e\x5d\xa4\xf3\xa1\xd4\x81\x56\x36\x7a\x87\x84\x0b\x8c\x67\x0b\x05\xc2\xbe\x36\x20\x4b\x9a\xe5\xd0\x48\x16\x0f\x8b\x64\x6a\x2f\xb4\xcd\xae\xeb\x40\xb6\xeb\xc2\x92\x94\xd4\xb9\x95\x65\xd1\x32\x35\xb2\x3a\xb7\xa5\x2f\x8e\x21\xed\xb4\x8d\x35\x84\xf7\x30\xa0\x22\x20\x3b\x77\x7a\xcb\x99\xc7\xb9\xd3\x95\x6d\x16\x84\xa6\x70\x3a\x6e\x6a\x58\x13\x37\xe9\x94\x2a\xc7\x4a\xe7\xae\x0a\x8c\x04\x9e\xe2\xa7\x84\x23\x52\x84\x73\x04\x25\x04\x48\x31\x80\xf5\x31\xd4\xc3\xce\x9d\x59\x49\xe9\x66\x34\x3e\xcf\x5e\x65\x6c\x0f\xd0\xd8\x9a\x62\x4e\x48\x02\xf1\x9d\x91\x0d\xc3\xb9\x33\x91\x41\xe9\xcc\x8e\x0c\x76\x2d\x67\x14\xe7\x8e\x83\x77\xc2\x2c\x43\x56\x10\x5e\x14\x78\xb8\xf0\x69\x25\x92\x61\xfa\x36\x1a\xbf\xa2\x27\x2a\x3a\xfd\x2b\xf5\x11\xb1\xac\x05\xb2\x45\x02\xa0\x57\xd1\xa0\x21\x7d\xa3\xd9\x02\x80\xa0\xc4\xe7\xd4\x79\xe8\x3a\x02\x74\xbe\x8a\x3a\x0f\xfc\x07\x59\x48\x40\xf4\xac\xce\x43\xef\xad\x66\xb8\x06\xce\x76\xce\xc3\x40\x60\xd7\x6b\x36\x36\xe7\xc1\xfa\xd5\x41\x9d\x8b\x7c\xf3\x3c\xb8\x25\xca\x11\x40\xd2\x9c\x34\x55\x80\x2b\x0b\xce\x2c\x63\x08\x1b\x41\x11\xc2\x9e\x23\x00\xf1\xe9\x04\xa5\xee\xb0\x9a\xea\x3c\x8c\xf0\xa8\x61\x5c\xea\xa3\x00\x06\x80\x88\x3f\xa5\xc7\xc2\x38\x56\xb0\xcd\x0d\x56\x96\x04\x31\x28\xd5\xf1\x32\x50\xd8\x72\x9f\x23\x98\x64\xa8\x05\xef\x03\x95\xf3\x51\x4b\x8e\xda\x22\x3e\xd5\x8a\x79\x38\x88\xd5\x3b\x96\x6d\xd5\x1c\x3b\x0f\x9e\x23\xde\x68\xc3\xb6\x0a\xc7\x48\xa0\x61\x7d\x8e\x26\x4a\x0d\x7d\x96\x6c\x7b\xc6\x45\xf0\x7b\xe3\x33\x15\xf6\x47\x9d\x59\x8f\xd9\xef\x9f\xb7\x2f\x0e\x75\xb4\x85\xd3\xd7\x84\xad\xb4\x56\x90\x21\x1e\xb6\xbc\x7c\x6b\xe0\xd7\x41\xb6\xe6\xad\xb6\x3e\x14\x0a\x18\x97\x72\x94\x7a\x1e\x62\xd7\x42\x49\xed\x2a\x57\x87\xa4\x75\x0c\x54\x81\x23\xb0\xf3\x10\x47\x0d\x1f\x8d\x64\x13\x6e\x06\xe8\x10\x59\xf3\xcd\xb2\x1d\x11\x40\x1b\xc5\x68\x3a\x69\x98\x18\xa7\xff\x43\x4a\x4e\xd0\xb4\x10\x59\x9e\x43\x84\x78\x85\x88\xac\x3a\x37\x44\x8a\xcd\xd3\x37\x3e\x33\x8d\x64\xaa\x34\x39\xb4\x86\x53\xe7\x21\x0f\x55\x4a\x7b\x1e\x64\x3b\x11\x8a\x13\x76\x30\x94\x6d\x03\xf2\x06\x88\x81\x0c\xcf\x12\x53\x83\xd9\xac\x0a\xd5\x24\xc2\x36\xef\x3c\x94\x5d\xe4\xe8\xf9\x3c\xb4\x29\x1e\xf6\xd2\x6f\x7b\x59\x60\x03\xc3\xf1\xa0\xce\xa3\x5e\xe6\xc8\x9a\x19\x75\x4f\xd1\x51\xdb\x25\xa1\x10\x03\x78\x4c\xed\xdc\x6c\x91\x83\x6c\x2c\xcf\xeb\x1c\x88\x08\x5a\x01\x3b\x86\x67\xd4\xfb\x3a\xf1\xa2\x69\x4f\x19\xd3\xcb\x08\x8d\x66\xd4\xb2\x71\xa9\xc2\xb4\xf3\x68\xb6\x54\x16\xbd\x3a\xd8\x64\xc1\xb6\x41\x40\xa5\x92\xd1\xe4\x63\xb0\x45\x9d\x47\xbb\xa4\x16\x76\xb9\xe4\x69\xdb\xd5\xae\x8d\x36\xe9\x35\x12\x01\x41\xe9\xa4\x68\xd3\x56\xc2\x3c\xfd\x7d\x09\x21\x89\x76\xfa\xa6\xce\x45\x5b\xe6\x3c\x06\x7b\x64\xb5\x89\x61\x5c\x42\xb6\x65\x1b\x11\x83\x6f\x9b\x00\x66\x93\x2c\x5f\xa8\x74\x9c\xc7\x20\xac\x6a\x0c\xa9\x46\xd2\x7e\xb0\x74\x60\x0c\x45\x3e\x05\xed\xc9\xf3\x28\x8b\x4e\x0c\xbb\xca\xbc\xc6\x22\xf3\x33\x16\x91\xbe\x9d\x47\x88\x4b\x64\xb7\x7e\x1e\xcb\x51\x9d\xc7\xe9\xdb\x56\x9a\x74\xfa\x21\xff\x17\xdd\xc9\xab\x8a\xce\xd1\xac\x74\x62\x0e\x94\x25\x1b\xfd\xf3\xc2\xcf\x2d\xdb\x14\x2d\xc8\x88\xcf\x11\x30\x41\xe5\x2a\x4c\x0d\x01\x7a\xc8\x9f\x50\xe9\xe2\xc9\xe8\xa7\x6f\x41\x9d\x97\x18\xf5\x20\x80\xa5\xb8\x88\x3e\x60\x05\x3c\x13\xf7\xc6\xcf\xe6\x2b\xb0\x23\x2f\x4b\x32\x6e\x4a\x1d\x7f\x55\x6c\x70\xde\x78\xc8\x9d\x71\xac\x46\x07\x78\xfc\xa0\xce\x8f\xfa\x4d\x96\x17\xab\xce\x8f\x86\x31\x79\x34\xd1\x6c\x45\xb3\xea\x27\xaa\x25\xd9\x5b\x6d\x4d\x4d\xf6\x56\x4f\xff\x97\xe0\x52\xe6\xd1\xa2\x36\x71\x98\x2d\x8e\xd6\x4d\x5f\xfb\x03\x85\xbd\x2f\x73\xd3\xd7\x95\x91\x65\x6a\xfa\x3a\x0a\x07\x32\x7d\xe5\xf4\x83\xf6\x99\xfe\xf7\x96\xf2\x6d\xcb\x26\x18\x44\x34\x58\xcf\xa7\xbf\x1f\x61\x61\xa6\xff\xf0\x26\xa8\x0b\x8d\xea\xe8\x85\xee\x07\x87\xd6\xde\x85\x1e\x9c\x04\x72\xa6\x7e\xa1\xad\xba\xd0\xc8\x04\x2f\x34\xc9\x6e\x69\x0e\x00\xf9\xcb\x39\x3b\x86\x68\xc0\xa2\xa4\x1e\x21\xe9\x40\x6b\x80\xe3\x32\xb8\x2e\x83\x30\x82\x2f\xb4\xef\xc8\xe5\xcd\x20\xa0\x47\xe7\x43\x90\x22\xf1\xfa\x8c\xb7\xc6\x25\x89\x3a\x1a\xe6\x02\xf6\x41\x9e\x3d\x4e\xdf\x1a\x98\x35\x5a\x71\xa1\xe3\xd2\xa6\xc1\x4a\x0d\xe2\xf2\xf0\x8e\x9a\x5d\xda\x90\xc4\x3c\xba\xd0\x51\x96\x78\xe2\xac\x12\x17\xba\x1e\x71\x5f\xa0\x2e\x33\xa8\x0b\x9d\x24\x48\xc1\xd7\x89\x7e\xa1\x65\x45\xbe\xd0\xe9\x68\xe4\xf8\xe5\x42\xa3\x25\x7f\xa1\xe5\x0c\x23\x53\xd3\x9d\x34\xc1\xce\x76\x35\x4c\xa6\x41\x79\x6c\x67\x0d\xd5\xdf\xd9\x16\xd6\x44\x1e\xd8\x33\x5a\x2e\x34\xe8\x41\xda\xf2\x50\x5f\xf4\x8f\xaf\x1b\x11\x60\x5f\x18\xbd\x51\x17\x06\x22\x71\x61\xd8\x2d\xa7\x44\x54\x9e\x32\x4b\x75\x61\x96\xda\x26\x6b\xca\x17\x50\xa3\x1b\x3c\x10\x73\x3c\xb2\xd2\x36\xc9\xb3\x2b\xf6\x34\x3c\xcb\x51\xc4\x52\xaf\x86\x8a\x46\x00\xab\x3a\x60\x5c\x1a\x4b\x01\xfc\x01\xd8\x99\xc8\x13\x6b\xd7\x60\x94\x55\xe9\xc2\xf4\x68\x1b\x5f\x18\xb4\x88\x0b\x7f\xf4\x06\x22\x7e\x61\xfa\xfc\xa6\x23\x6c\xfa\x85\xe9\x91\x7b\xaa\x0b\x33\xa0\x34\x46\x77\x1b\xe4\x19\x52\xeb\x4d\x7d\xab\x53\x17\xc6\xe9\x55\x0c\xf2\x4a\xf6\xc3\xd3\xff\x29\x48\x5c\xd2\x77\x20\x70\x0c\x82\x15\x4f\x0b\x19\xb7\x82\x2a\x83\x98\x5d\x88\x07\xcd\xbb\x51\xe1\xef\x25\x7f\xdf\xd4\x2b\x2b\xfa\x0e\xff\x6c\x82\x72\x61\x9c\x95\x66\x71\x9c\x9f\x12\x43\xe4\x45\xdc\x51\x69\x17\xd6\xeb\xfa\xee\x2d\xd2\x96\x0b\xe3\x38\xb9\xaf\x09\x9c\x44\x80\x64\x63\xdd\xec\xc2\x24\x5f\xdb\xdc\x09\x45\x22\xcb\xc8\x11\x38\x1d\x2c\x6c\x15\x60\x8b\x0c\xfc\xc2\x70\x6c\xbe\x53\x17\xec\x4c\x2e\x84\xcd\x92\xcf\xf7\x72\x3a\x49\xc2\x31\xd0\x3c\x1e\x46\xe3\xc2\x78\x94\xc0\x01\x29\x1b\x69\x36\x04\x3f\xf5\x89\xed\x96\xd7\xc4\xe5\xf4\x6f\xe4\x88\xa3\xa1\xd3\x1a\xb3\x79\x61\x22\xb5\x49\x4c\x4c\xc3\x39\x98\xb7\xb5\xcf\x90\x7c\x9a\x13\x52\x21\xbb\xbb\x96\x14\x44\xa7\x81\x44\x1a\xd6\x3a\x5a\x28\xad\x61\x33\x60\x39\x88\x0c\x5b\x11\x8a\x5c\x98\x64\x37\x6c\x56\x99\xd2\x26\xbd\xe9\xc8\x91\xd0\x85\x49\x23\xc7\x97\xd4\x33\x8d\xfa\xd8\x44\x46\x17\x26\x6d\x59\xab\xe5\x1d\x11\x01\x57\x1b\x98\x29\xa1\x8e\x71\x81\xd6\x94\x70\x1d\x17\x26\xed\xea\x3f\x59\x2c\x39\xf8\x2f\xe7\x9f\x88\x7c\x76\xf1\xeb\xb5\xba\x90\x16\xdc\x99\x44\x37\xed\xf8\xc8\x9d\xd0\x18\xb3\x87\x3e\x98\xbd\x34\xf4\x7e\xfa\xb7\x9d\x24\x1e\x48\x43\x8c\x7f\xc1\x0e\x5c\xab\x8b\xc1\xfa\x3e\x02\x43\x60\xac\x58\x34\xcd\xd0\x9b\xbe\xb0\x1c\xd4\x91\xb2\x94\x11\x8c\x68\xe9\xc2\xae\x36\x96\xa4\xae\x33\x9e\x2c\x5d\xb4\x72\xd2\xab\x2e\x2c\x32\x35\x64\x59\x60\x1c\x23\x91\xd4\x07\x42\xc8\x63\x25\x52\x16\x16\xfa\x0b\x10\xb5\x40\x60\xa6\x8e\x00\x54\x65\x88\x9f\xca\x38\xaa\x0b\xd4\x45\x08\x12\xaa\x7c\x17\xb6\xea\x81\x5d\xd0\xa7\xbe\xe9\x80\xd4\x08\x0c\x0c\x18\x63\xa6\x16\xeb\x2d\x8f\x8b\x94\xec\xc2\x86\xb4\x0a\xd1\xc2\x19\x5c\xd8\xed\xd6\x78\x0d\x75\x42\x03\x40\xb2\xa6\x6c\xd6\x48\xad\x2e\x6c\x1e\xe5\x8f\x7c\xaa\xc2\x4e\xa3\x4b\x7c\x61\xbf\xd8\xac\x2e\xec\x17\x29\x1d\xf9\xb1\x39\xaa\x8b\x37\x38\x23\xad\x2e\x9c\x2e\x2b\x88\x33\x5a\x16\xc7\x76\x2a\x70\x11\x96\xb1\x24\x51\xb9\xbe\x08\xab\x41\xab\x8b\xb0\xda\xb0\xba\xa9\x8b\xd0\xf1\x43\x6c\x7f\x81\x4a\xc5\x45\x60\xcf\x73\x11\x2a\x25\x0c\xb2\x16\x04\x13\x8d\x97\x43\xc1\x8b\x60\x22\xe4\x45\x5d\x04\xa1\xcb\xa1\xe7\xa8\xec\x22\x58\x75\x11\x64\xb3\x74\x11\xac\x0b\xa3\xba\x10\xf6\xfd\x02\xfd\x84\x8b\xc0\x8a\x64\x33\x59\xa4\x30\x07\xcf\xb0\x09\xea\x22\xb0\x45\xd8\x90\x3e\x0a\x09\x0c\x88\x0a\x2e\xc2\x28\xa6\x5f\x17\x61\xa4\xbf\xc2\x88\x5d\x88\x64\xe2\x3f\xaf\x3b\x78\x48\x41\xa6\x3f\x82\x20\xf2\x0e\xd1\x5d\xb8\xe0\x24\xe4\x22\xf8\x0d\x09\x92\x5d\xb8\xf7\x8b\xe0\x43\x3f\x14\x4a\xf3\x9c\x62\xab\x8b\xb0\x55\x17\x01\x53\x8c\x8b\x10\xd7\xa0\x32\x99\x43\x1c\x6d\x46\x7c\x76\x81\x46\x2e\x81\x71\x61\xb6\xb0\x4e\x1a\x30\x86\x35\x56\x49\x17\x21\x86\x74\x6c\x1f\x10\x25\x2f\x6b\x5a\x48\x79\xfa\xca\xd7\xe5\x1c\x22\xe5\xb2\xc8\x84\x7c\xac\x6d\x55\xd4\x45\x28\xcb\x46\x18\x42\x59\xba\xe9\x5b\x02\xe9\xe1\xbe\x80\x0e\xda\x18\x0a\xfa\x88\x40\x69\x87\x02\xa9\x90\xb2\xcb\x9e\x73\x8c\x8b\x80\xd6\xa4\xba\x08\x7b\xd1\xc5\xb9\x08\x2d\x75\xef\x19\xfe\x1c\xe1\x11\x26\x4e\x5c\x2f\xc2\x81\xa1\x1b\x8e\x07\x75\x11\x75\x8f\x5c\xd3\x83\xd1\xa2\x51\xfb\x54\x0f\x46\x2e\xa2\xde\xca\xa0\x8e\x75\x27\x7a\x81\x2d\x97\xb0\xa1\x17\xd1\xd8\x54\x17\x1d\x38\xd3\x1a\xd6\x3f\xac\xe7\x38\xf8\x22\x86\x65\xd8\xa9\x8b\x18\x68\xca\x18\x52\x27\xeb\x3c\xec\xa0\xba\x88\xa5\xef\x09\xd9\x66\xf1\x96\x03\x24\x89\x67\xa7\xaf\xcb\xb6\x4a\xc2\x51\x5e\x94\xa5\xf0\x0e\x65\x69\x73\xda\x58\xab\x2e\xca\x32\xa4\xd5\x6f\xc0\x83\xba\xe0\x0c\x2f\x66\xe8\x03\x58\x4a\x5a\x3e\x9a\x73\x3b\x59\x20\x8b\x4c\x67\xc2\x48\x8e\x8d\xe5\xb3\xcb\x2a\x8c\x8c\x47\x46\x18\x21\x22\x99\x8b\x62\xa6\xbf\xd1\xbc\x9c\x38\x5c\x14\xd1\x55\xbf\x28\xeb\xd8\x8a\xe9\xe1\xab\x2f\x4a\xcf\xea\x57\x7a\xe9\x97\x32\xe8\x11\x12\x50\xac\x8c\xf1\x72\xa2\x9e\x45\xde\xe8\xf4\x8a\xf0\x30\xea\x51\xe6\x0b\x1a\xb6\xea\xa2\x8c\xc1\xf3\xe2\x31\x44\x2b\x53\xbc\x8c\x6c\x7c\x04\x43\x17\xf1\xa2\x2e\x89\xc5\xaf\x24\x37\xba\x44\x27\x7a\x51\x44\x0d\xfd\xa2\x78\x39\x48\xbc\x28\xde\xd1\xa6\x05\xa2\x5a\x50\x40\x3c\x2d\x19\x45\x46\x77\xd9\x4e\xdf\x60\xe3\x4f\xc8\xec\x4e\xa3\xa5\x61\xbc\xb0\x02\x85\xfd\x4a\x03\xa6\xc1\x50\x61\x6e\xa0\x26\x1f\x8f\x32\x8f\x4a\x44\xe7\xe8\xa2\xa0\xe4\xdd\x80\xd4\x45\x0e\x04\x2e\x58\x09\x88\xa5\xe9\x1b\x2f\x94\x13\xac\x8b\xc2\x46\xf2\xa2\xec\xe4\xa3\x76\xda\xcd\x3e\x61\x5a\x03\x6e\xa2\x0f\xb4\xf7\xce\xf6\xde\x20\x04\xbe\x80\x27\x2c\x87\xf5\x1b\x74\x6e\x17\x22\xac\xd1\x2e\x44\xa1\xf4\xbb\x30\xfd\x5f\x3e\x24\x75\xb1\xb7\x22\x9e\xbc\xd8\x73\xda\x79\x71\x58\xd6\x01\x52\xe1\xec\x93\x5e\x0d\x72\x74\x8d\xf9\xc5\x45\xcd\xc1\xcc\x3b\x34\x02\x7c\x88\xea\x62\xfa\x43\x1f\x6b\x38\x7b\xb4\xa6\x8b\x1a\x61\x1c\x09\x0c\xed\xe9\xfb\x72\x69\x64\xe4\x4e\xdf\x99\x6b\xd3\xf7\xb8\xc6\xee\xf0\x62\xfa\x5e\xbf\x79\xfa\xa1\x8f\xb3\x3b\xd4\xdd\x35\x11\x84\x04\xc0\xd6\x12\x97\x9a\x35\xe0\x52\x43\x0c\x2f\x75\xca\xa2\x80\x78\x29\xca\xcd\x97\x48\xe3\x06\x75\xd9\xa6\xf5\xe5\x6a\xb3\x74\xba\x53\x97\xab\x8d\x68\x7b\x5e\xae\x36\xe8\xac\x5d\x76\x9c\xf5\x5f\x22\x6d\xbe\xec\x8c\xab\x0a\x8e\x97\x1d\x2a\x93\x97\x5d\x8f\x02\xa8\x44\x4d\x55\x17\x10\x4c\x0e\x2a\x2f\x3b\xd1\xe6\xba\xec\xf6\xcc\x91\x0a\x92\xba\x34\xa3\xba\xac\x36\x6e\x97\xeb\x68\x44\xac\x77\xc9\x20\xbd\xec\xe1\x62\xd4\x25\x8a\xcd\x9d\xba\xec\xe5\x59\xc2\x54\x23\x19\xe0\xac\xba\x94\x00\x4d\x67\x46\x5f\xc5\xb0\xc5\x25\xfb\xc0\xb9\xec\xe5\x10\x99\x76\xea\x12\xbd\x01\x27\xa7\xa2\xa0\xb4\x20\x50\x4e\xc0\x2f\x59\x4a\x89\x77\x7a\x54\x97\xb6\x57\x97\x76\x13\xa9\x99\xad\xc2\xe0\x4b\x9b\x8c\xc7\xf4\x48\x90\xa5\xb1\x49\xfe\x12\x8e\xe9\xd2\x22\x7a\xbc\x7c\x4b\x32\x5f\x2e\x37\x62\x33\x75\x29\x47\xe7\x97\x6e\x76\x8b\xdc\xd9\xa9\x4b\x67\x3c\xbb\x95\x4b\xc7\x96\x29\xa9\x4b\x67\xb5\x84\x89\x15\xfe\xd2\xd9\x2f\x44\xd0\xea\x12\xf0\x8f\xaf\xea\x12\xa3\xce\xdc\x40\x85\xfc\xe9\x02\x64\xe1\xd2\xd1\xa9\x97\x8e\xd3\x0f\xfb\xa6\x2e\xdd\x76\x80\x71\xb8\x74\xc9\x12\xee\xcc\x52\x6f\x36\xea\x72\x6c\x13\xf2\x72\x5c\x22\x0e\xb8\x1c\x21\x22\x97\x4d\x68\x74\x39\xf2\x9d\x8c\x0e\x16\xe2\x4b\x48\x5b\x52\x97\x63\xc8\x41\x5d\xfa\xce\xd1\x5a\x11\x4c\xa2\x50\x4b\xe0\xf4\x7f\xab\x4b\x51\x38\x91\xb0\x32\xd6\x82\x0a\xcb\x74\xe9\x7b\x69\x56\x1f\xd1\x59\xbb\xf4\x99\x97\xfa\xbc\xb7\x89\x21\x87\x7c\xe1\x72\x2b\xbb\x9c\xcb\xa8\x13\x06\x15\x97\x71\xa9\x2e\xe3\x92\x5a\xc5\x95\xe9\xc3\xce\xae\xd4\x65\xec\x8c\x25\x84\x0d\x53\x97\xb1\x0f\x58\xc2\x58\x0d\x3a\x7d\xf7\xd3\x0f\xa7\x2e\x4f\xfc\xd0\x65\xb4\x9b\x77\x28\x23\x3a\xfa\x94\xd5\x65\x4c\x03\x03\x37\x22\x21\x05\x94\x3e\x16\x1e\xa3\x06\x71\x67\x08\xe8\x8c\x88\xb5\xcf\x25\x0c\x2a\xa7\x46\x82\x6d\x35\x60\x40\xe9\xd5\x91\x3b\xad\x86\xbd\xb1\xf2\x55\x69\x15\x96\x1a\x68\x1c\xf2\x30\x10\x2f\xfa\x49\x97\x69\x03\x11\x57\x97\x69\x6b\xde\xc2\x6c\x81\x02\xac\x44\xa0\x6a\x97\x09\x1e\x86\xc7\x7e\x2b\x9c\xc3\x5d\xa6\x64\x3a\x75\x99\x92\x6b\xb3\x0c\x7a\x38\x94\x83\x7c\x46\xca\x88\x3d\x2b\x98\x3d\xe9\x9d\xf1\x39\xa4\x16\xaf\xf0\x40\x88\xee\xee\x09\xce\x9e\x8a\x3d\xaa\xcb\xac\x0f\x41\x5d\x72\xdc\x28\x4c\xcb\x65\x86\x69\x4f\x4d\xe1\xef\x32\x67\xa7\x2e\x11\x9d\x5f\xee\x90\xa2\x5d\x36\x8b\x39\x60\x9d\x1b\xac\xc3\x34\xdc\x4e\x26\x16\x32\xa5\xcb\xbd\xfc\xf1\x05\x76\xe3\xf2\xd0\x19\x75\x09\xad\xba\x3c\xbc\x4d\x7f\x77\xeb\xd4\x4d\x7f\xcf\xd9\x12\x1f\xb5\xba\xd2\xd8\x55\x01\xa2\x91\xd0\xae\x60\x16\xaf\x34\xfc\xab\xba\xd2\x35\xec\x0d\x3b\xdc\x4e\x5d\x69\x2b\xe2\xee\x8a\xd1\xc7\xc0\x70\x02\x33\xf6\x31\xcc\xdb\x2b\xbd\x19\xa2\xba\xd2\x6e\xb5\x91\x30\xf8\xd9\x1d\xe7\x56\x15\x37\xf2\xd7\xa6\xcd\xfa\x2b\x2d\x8e\x01\xae\xb4\xfb\xad\x58\x75\x25\x65\x4a\x56\x91\xaa\x00\x33\x8b\x03\xf0\xa8\xae\x74\xd4\x4b\x43\x4d\xe3\x9a\x45\xe1\x4a\xc7\x7e\xd0\xee\x40\x82\x75\x4e\xfe\x78\x93\xfa\x44\x74\xb5\xaf\x38\x44\xe6\x6d\xd1\x9b\x4c\x19\x51\x22\xf4\xed\x95\xae\x5a\x1e\x57\x5a\x8e\x64\xaf\x74\x89\x86\x58\xc9\xd1\x50\x1c\x7b\x81\x2b\xbd\x5f\xc1\xc0\x5c\x55\x9d\xb6\x2b\x7d\x30\x7c\xf9\x21\x10\x4e\xff\xe1\x31\x7c\xba\x32\x55\x31\xe7\xca\x74\x9d\x51\x57\x86\x9d\xcd\x15\x62\x48\x61\xca\x04\x0b\x3b\xd2\x43\xae\x90\x3d\x63\xfb\x8f\xfc\x34\x86\x59\x57\xc9\xc4\x95\x41\x10\x26\xe1\x8c\x13\x5c\x2a\x44\x04\xe3\x46\xc1\x1d\x56\xd1\xb3\xb9\x3e\x06\x3a\xb4\xc5\xfd\x3b\xf2\x3f\xfc\x33\x9b\x97\x21\xe9\x91\xf8\x5a\x94\xfc\x41\xa6\xef\x0d\xa3\x44\x5a\x1a\x13\x8c\x1a\xa9\x1f\x22\xf2\xf9\x2b\xe3\x8f\xa4\xc1\x6c\x68\xdf\x31\xc4\xaf\x4c\xec\x0b\x33\xf5\xca\x44\xca\xc4\x9e\xa1\xab\xd0\x77\x26\xbd\x63\x47\xc1\xac\x94\x15\xfd\xf4\xf5\x3d\xa9\xa2\xb3\xf3\xe0\x61\xfa\x39\xcd\xd5\x9c\x8d\x56\x24\x90\x25\x1a\x1b\xeb\x3f\xf5\xe9\x68\x0e\x2d\xde\x4c\x2c\xf9\xab\xe6\x0c\x52\xbb\xd2\x3b\x4b\x3c\xe7\xbd\xb1\x89\xb2\x9a\xe6\xd9\x95\xd5\x09\x21\xc2\x95\x5d\x21\x14\x53\x57\xb6\xd3\xae\x0f\xea\xca\x1a\x39\x7b\xbd\x12\xa6\xf3\xca\xae\x8d\xba\xb2\x3d\x22\xac\x2b\xdb\x17\x63\x11\x21\x93\x52\x4c\xc3\x30\x71\x54\x57\xd6\xe9\x28\x06\xe1\x60\x59\x3a\xcb\x3a\xc9\xaf\x13\xff\x0e\x94\xec\x2c\xfa\x12\x0d\xdb\xd6\x4c\x1c\x2d\x5d\x09\xb9\x18\x4a\x1e\xd4\x95\xf5\x1b\x02\x19\x4f\x96\x5d\xe8\x15\x5a\xfa\x52\x21\x81\xb3\x87\x00\x55\x21\x0a\x11\xbf\x42\xf5\x9d\xa0\x37\x51\x5a\xdb\xe6\xe3\x56\xb3\x01\xdb\xa8\x2b\xa7\x07\x51\x5a\xbd\x72\xc6\x54\x66\xe0\xca\x21\x57\x51\x57\xce\x9c\x4a\xad\x18\xa3\x03\x1c\xc9\x25\x30\xb7\x3f\x59\x29\x04\x6c\x5a\x74\x23\x55\x25\xc9\x67\x75\xe5\x02\x6b\xeb\xd1\x82\x45\x0e\x67\x04\x61\x84\xbb\x80\x16\xe2\x95\x0b\x07\x5e\x3a\x7d\xe7\x14\xea\x2a\xc0\x1d\x5c\xf1\x8c\xba\x42\x5b\x3d\xbf\xc3\xa8\xae\x82\x54\x2c\x88\xfa\x8d\x57\x57\xb2\xbd\xba\x0a\x3e\x71\xc4\x76\xc5\xf2\x7b\xd5\x24\x07\x15\xf1\x35\x55\x64\x2b\x20\x07\x75\x15\x22\xdb\xfe\x2b\x64\xdb\x04\xb3\xbb\xfa\xf6\x10\xd1\x39\xbc\x0a\x22\x4a\xb8\xaa\xbb\xa7\xab\x10\x7f\x2b\xb6\x47\x1b\xe6\x2a\xc4\xd3\x3f\x9c\xd5\x52\x87\x98\xd2\x66\xfa\x97\x8a\x49\x5b\x87\x98\x0e\x74\x4e\x88\x92\x2f\x17\xd1\x3a\xbf\x0a\x09\x25\xd4\xab\xd6\x21\x21\xbf\x55\xc9\xea\x15\x06\xe2\x57\x21\x37\x33\xe5\xab\x50\x60\x74\xca\x17\x75\x15\x4a\x5c\x71\xb4\x06\x22\x5f\x5b\xda\x3c\x08\x48\x81\x75\x51\x57\xe1\x8b\xba\x42\x99\x34\x05\xa0\x45\x42\x04\x82\x94\xa7\x21\x4c\x08\x12\x6c\x5a\xd9\x8a\x85\x06\x66\xf3\x80\xa0\x4b\x62\x9b\x1a\x9a\x0a\xea\x23\x1c\xd0\x0a\x92\x84\x6a\xc3\x5e\x1f\x25\xde\x42\x24\xdb\x82\x4c\xdf\x48\xd8\x6e\xa5\x6a\x68\x1c\x9c\xc0\xec\x93\xb0\x3a\x14\x57\x85\x15\xbf\x9d\x30\x59\xaa\xaf\x98\x9e\xbc\xd2\xd8\xac\xfb\x06\x25\xb3\xef\x8b\xe1\x1c\x00\x34\x63\x01\x0c\x82\xf3\x94\xab\x68\x9a\x9a\x3b\xd8\x50\x19\x4b\x50\xb4\x33\xaf\xa2\x61\x6f\x94\x07\x2d\xf8\x41\x02\x9e\xb5\x2b\x09\x06\x33\xfb\x14\x90\x8b\x5f\x45\x66\x2d\x69\xa6\x51\x3c\x30\x72\x48\x1a\x85\xa3\x37\x2c\xe9\xd2\x7e\x8c\x79\x01\x3b\xdd\x01\x11\xa2\x00\x5a\x32\x73\x2a\xda\x9d\x76\x5d\x9d\xd3\xe8\xc0\xf2\x70\x70\xd0\xe8\x18\x90\xc1\xc9\x13\x81\x21\x15\x8b\x3f\xca\x11\xda\x55\xa4\x8a\x78\x2a\xb9\x8a\x87\x4e\x4b\x84\xe6\x38\xc8\x4a\x7b\x15\xa7\x6f\xc2\x47\x5e\xc5\xe9\x7b\x37\x7d\xa3\xc0\xe9\xfb\xe0\xa4\x96\x45\xab\xab\xb2\x1a\x12\x21\x8a\xef\xd3\x57\xc1\xe8\x91\x82\x46\x31\x60\xfa\xe3\xfd\x8f\xe9\xc7\x09\xa5\x26\x69\x76\xa6\x8f\x2c\x8c\xc5\x44\x1f\x03\x4b\x2b\x16\xf6\x59\x00\x92\xb2\xab\x62\x0e\x41\x5d\x95\xde\x19\xb6\x27\xea\xaa\xbc\x59\x4a\xde\x14\xd1\x20\x00\x49\x83\x65\xb9\x2f\x48\x3c\x3b\xa0\xab\xec\xfe\x55\x81\x22\x14\xa8\x52\xc1\x74\x4d\x4e\x52\xae\x0a\x76\x40\x94\x10\xb3\x86\x0a\x96\x58\x36\x12\x2d\x07\x0a\xf9\xa2\xd5\xd5\xf4\xcd\x10\xc0\xfe\x5e\x4d\xdf\x31\x33\x8b\xea\x6a\xfa\xc1\xf9\x3c\x25\x4e\x3f\x5a\x55\xaf\x61\x2a\x8c\x63\xdd\xb8\xd6\xa6\xda\x77\x5e\xeb\x75\x21\xec\xbd\x59\x5a\xd2\x7b\x6f\xb6\xba\x61\x92\xb1\x99\x50\x9d\x90\xd9\x5c\xcb\x79\x9d\xa9\x7f\x49\x16\xc7\x4f\x0b\x86\xd7\x22\xe0\x52\xfb\xc3\x09\xb2\x82\xa2\x38\x77\xad\x5d\x17\x48\x34\xcc\x1d\xa0\xe8\xb1\x5d\x6b\xd7\x4f\x7f\x5f\x1d\x25\xbf\x2d\x0e\x61\xd4\x35\x02\x10\x02\xb4\xd5\x48\x17\xa5\x44\x60\xe0\x4c\xf4\x5a\x3b\xd9\x43\x5f\x6b\x57\x56\x1b\x29\x0b\xe5\x66\x41\xd0\xe0\xbc\xd6\x0e\x9e\xe4\x5a\x8f\xda\x89\xc1\xc5\x1b\x45\x8e\x4b\x47\xad\x47\x59\xb8\xae\xf5\x48\x8d\xc6\x82\x69\x57\x85\xd3\x1f\xea\x1a\xdb\x71\xc8\xae\x20\x6e\x4d\x89\xa8\xd5\x5e\x6b\xff\x56\xd4\xb5\x26\x1e\x4c\x56\xd7\x3a\x4a\x35\xd0\xe2\xe4\xf1\x08\xa5\x05\x4c\x7f\xe8\x13\x9c\xcd\x75\x17\x6d\x47\x14\x2a\x00\xa8\x2d\x1e\x7b\x5b\x1c\x25\xa1\x23\x2e\xa5\xc8\x21\xde\xb5\xc6\xc4\x50\x1e\x17\x52\x05\x0c\xab\xcd\xec\x53\x40\x86\x7b\xad\x71\x27\x21\x6b\xec\x35\xbb\xd2\x5c\xbb\x31\x46\x2b\x0d\x14\x8f\x96\x27\xd1\x6e\xb3\xa9\x21\x11\x6e\x0d\x54\x0a\x93\xf3\xac\x6b\x44\x3e\xb5\x17\x18\xad\xd7\xba\x2c\x79\xac\x74\x68\xf4\x95\x6e\x76\x66\x74\xd9\xc6\xe9\xdf\xfe\x27\x69\xdf\x6a\x1a\x2f\x95\x11\x83\x10\x40\x22\x59\x8f\x02\x86\x5a\x69\x11\x07\xc4\xd9\x9d\xad\x52\x5c\x12\xea\x1f\x3b\xac\xe4\x38\xde\x61\x4b\x7d\xad\xf7\x22\x8a\x00\x52\x0f\x31\xd0\xb8\x96\x36\x3d\xa0\x93\x7a\xad\x0f\xa9\x0e\x84\x03\xfe\x51\xae\xf5\x91\xcf\x32\xa8\x29\x5d\x9b\x65\xdd\x32\x5d\x1b\xa3\xae\x8d\x49\x2c\xe4\xc0\x6c\xa2\xf6\x85\x6c\x83\x31\x4b\x75\x6d\x64\xab\x7c\x6d\xac\x67\xe9\xbe\x36\x56\xce\xf2\xae\x8d\xeb\x2c\x4e\x92\xc2\x4e\x5d\xa3\x73\x9e\x21\x0b\xd7\x66\x34\xd6\x0f\xc1\x21\xe7\xbb\xa6\xbb\x8c\x37\x72\xe8\x77\x8d\xc0\xff\xda\x78\x4e\x1a\xae\xb1\x95\x75\xfc\xb7\x91\xca\x19\x2f\x93\xf3\xda\x88\xd7\x98\x6b\xe3\xb3\xd4\xcf\x4f\xdf\xd4\xb5\x09\x91\xe7\xe4\x54\xe3\xda\x6c\xb7\xea\x1a\xa3\x94\x82\x8a\x12\x98\x94\xcc\xf9\x3a\x44\xf4\xda\xc8\x9a\x74\x6d\x22\x47\x17\xfc\x31\xca\x1c\x04\x36\x20\xd9\x47\x13\xb5\xee\xc0\x3c\x5b\xa6\x6b\xb8\x31\x78\x46\x52\xa4\x7b\xa1\x45\x6d\xbb\x7a\x6d\x0e\xf2\xb9\x83\x46\x9d\x42\x5d\x0f\x7a\x1d\x82\xc4\x07\x4e\x9f\xbd\xc5\x35\x4e\xa7\x49\xc8\x7a\x03\x38\xc2\xbe\x5f\x0f\x61\xa9\xbb\xd9\xbd\x79\x63\xf3\x77\x3d\x84\x34\xa8\x6b\xab\x57\x70\x09\xb6\x61\x01\xc8\x86\x5a\x12\xfc\xea\x84\x41\x26\x2c\xae\xc9\xae\xed\x72\xc9\x46\x18\x28\xa1\x2c\x49\xd7\x76\x29\x95\xb4\xab\x8d\x1b\x10\xa5\x5e\x5b\x13\x9d\xba\x96\x05\xa3\x5a\xa6\x5d\x5b\xe2\xae\x9e\xf5\xfc\x90\xb3\x9e\x6b\xeb\x90\xa8\x9c\x60\x2d\xc1\xad\x44\xbb\x88\xc4\x5e\x82\xd9\xbb\xf1\x33\x31\x28\xe5\xb5\x75\xf6\xf4\xe0\xc6\x1c\x00\x52\x36\x67\xfc\x15\x6c\xad\xa9\x09\xb9\x81\x0a\xf9\x28\xe7\x6c\xcb\x66\x77\x32\xd3\x2d\x07\xcf\xd7\xd6\x8d\x88\x09\xaf\xed\x68\x12\xd9\xc6\x71\x76\xe9\x90\xa6\x2c\x0b\x9d\x6d\xbd\x18\xfc\x5c\x33\xe0\xa4\x6c\xef\x19\xb5\xd6\xd7\xc4\xcc\xfb\xe1\x9f\xae\x2d\x9a\x16\xd0\x40\x0b\x2b\x89\x7e\xb3\x88\x22\x25\x4a\x25\x22\xec\x1b\xa0\x08\xe0\x55\xc2\xd6\x5c\xdb\x78\x44\x28\xaa\xd5\xb5\x4d\x06\x92\x63\x93\x94\xca\x69\xb5\xba\xb6\x68\x1d\x5c\xdb\x9d\xb7\xd2\x69\xbb\x82\x6f\xab\xa2\xae\xed\x71\x90\xa7\x0e\x5a\x5d\x63\x3e\x5a\x46\x75\xfd\x56\xa2\xfe\xc7\x57\x06\x99\xd3\x26\x25\xa1\x4c\x0e\xce\x83\x84\x94\x08\x8f\x36\x14\x75\xed\xea\x29\xe5\xb5\x33\x9d\x65\xc7\x44\x0a\xcc\xaa\x24\xd9\x54\x4d\x95\xae\xb1\xfc\x20\x68\xe1\x01\x20\x53\xc9\xd9\x95\x69\xe6\xd2\xd7\xce\x8e\x46\x5d\x57\x56\x16\xb8\xd7\xab\x8d\x55\xd7\x0e\x33\xb3\x9d\x56\xd7\x6e\xfa\xc1\xa8\x1e\x0d\x0a\x70\xd7\x5e\xb3\x85\x93\x29\xec\xd9\x95\x55\xf7\x46\xd7\x61\x69\xd5\x35\x27\x8a\x5e\xd3\x15\xa1\x33\xb4\x5c\xe8\xcc\x3a\x86\xc3\xec\xce\x2c\x4d\x59\x13\xe7\x50\x5d\x20\xbb\xc8\xeb\xd0\xb1\xd5\xbc\x0e\x66\xc9\x44\x0d\x26\xf7\x03\x39\x29\x60\xbd\x16\xad\xb3\xeb\x30\xa8\xeb\x60\x3b\xed\x85\x1e\x04\xdb\x4d\xff\xdc\xd0\x0d\x2a\xa9\xc0\x22\x07\x31\xd7\x58\x39\x5d\x23\xcc\x23\xa8\xdf\x0f\x82\xf1\xac\x60\xb4\x85\x80\xf7\xff\x62\x7b\xae\x6b\x2d\x11\x5c\x48\x9b\x80\xe2\xdf\x35\x9c\x4e\x4b\x12\xd5\x36\x49\xf0\x9c\xbe\x5e\x07\x57\x96\x66\x77\x82\x2d\xc1\xae\x2a\xac\x0f\x8f\xda\x37\x64\x19\x56\x47\x75\x1d\xf0\x0b\x46\x28\x78\x60\x3f\x61\xdf\x1a\x9a\xe1\xb0\xae\x83\x67\x52\x05\x9f\x91\xa3\xcb\xa3\xfe\x28\x67\x8b\x0d\x39\xbe\x23\xb3\x39\x52\x53\x86\xa9\xfc\xb3\x6b\x79\xa6\xaf\xa7\xdc\x60\x92\x7d\xfa\xd7\xd3\xdf\x81\x46\x09\x18\x9a\xf5\x82\x18\x09\x85\x8a\x85\x20\x19\x58\x36\x40\xb6\x95\x65\x08\xbc\x41\x3e\x16\xc5\x06\xd6\xf2\x80\xfe\x2b\x96\xd9\x87\x13\x3e\x17\xfb\xec\x6b\xac\x33\x5b\xd6\xb7\xe9\xef\x94\x18\x3d\xfa\x41\x8c\x87\x18\x76\xf2\x9d\x29\x85\xac\xae\x03\x4e\x95\x00\x14\x97\x19\xb5\xd4\x23\x67\x11\x53\x5e\xcb\x91\x8b\xba\x0e\xa5\x93\xbc\xb2\x67\x10\xe4\x4d\x86\x41\xa9\x79\x18\x1a\x58\xcc\xf3\xe4\x0e\x76\x89\x4a\xef\xac\xef\x8a\xba\x0e\x88\x5d\xd5\x75\x38\x70\x7e\x71\x1d\x0e\x86\x7e\x8b\x7a\x89\x32\x88\x60\x5d\xc7\x68\x8b\xfa\xd4\xdf\x51\x1b\x39\x99\xbc\x8e\x7a\x4d\x0c\x15\x95\x6b\x71\xf3\x95\xf2\x3b\x32\xbb\x32\x29\x49\xc1\x88\xe7\xff\x42\xba\x2c\x91\x51\x57\x7e\x80\x6d\x04\x61\xb5\xf6\xbc\x8e\x3a\x1b\x17\xca\x96\xa4\x22\xc1\x8e\x9d\xdd\x6c\x91\xf5\x28\xa5\x1f\xd4\x75\x34\x4b\x83\xd5\xf7\x75\x34\xab\x40\x94\xa1\x49\x48\xb1\x06\x7b\x60\xd7\x0f\x15\x95\x3e\x02\x91\xed\xeb\x35\x8e\xbf\x22\xff\xf4\x81\x79\x14\x4d\x8f\x89\xde\x75\x34\x96\x57\x1b\xbb\x66\xd3\x0e\x22\x89\x42\x43\xa3\x19\xa1\xf2\xd1\x8c\xc8\x9c\xaf\xa3\x69\xd5\xc6\xfe\x5c\x5a\x5c\xe4\x9f\xd7\xd1\x14\xb6\x1e\xd7\xd1\xd4\x03\x8c\xeb\x68\x0e\xb3\x57\x5b\xf9\xd2\xd8\xeb\x24\x02\xcc\xeb\x68\xdb\x9a\xc6\xb6\x22\x19\x27\x53\x31\x5a\x66\x6b\x83\xf9\x94\x90\x87\x74\xc2\x1a\x52\x9f\xc3\xe4\xef\x3a\xda\x9e\x13\x06\xfb\x13\x3b\x25\xca\x1c\x8b\x76\xc4\x7f\x11\x49\x32\x75\xa2\x95\xc6\xb3\x1e\x73\xc2\xeb\x68\x3d\x12\x46\x12\xe4\xbf\x64\xe4\xf4\xeb\x3a\x86\xe5\xf4\x0d\x4a\x1e\xc3\x6a\x40\xf1\x11\xcc\x78\x91\xf4\x0b\x66\xea\x50\xab\xa8\x81\x05\x00\x15\x31\xa1\xfc\x8f\x23\x91\x2f\x24\xd6\x16\x08\x88\xee\x01\x07\x66\x40\x44\x2c\x43\x85\x03\x9e\x8c\xa8\xa4\x88\xea\xae\xc5\x65\xdc\x35\xce\xe6\xa0\xf6\x31\x6c\xb7\x02\x52\x28\xb1\x93\xba\x48\xf3\x06\xfc\xd6\x5c\x63\x00\x48\xa4\xd0\x31\x58\x79\x4a\xc8\xff\x45\xa7\xd9\x9f\x0c\x0d\xae\x63\x59\xf2\x6f\xe1\xe0\x0e\x40\x49\xa5\x13\xbb\x3b\x21\xb8\xef\x91\x83\x44\xe0\x33\x63\x69\xdd\x51\x70\x38\x48\x5b\xd7\xc1\x51\x0e\xb5\x67\x0f\x47\xe3\xc5\x9e\xfe\x3a\x4e\xbf\xaf\xf9\x6e\xf2\x4c\xdf\x4f\x0d\x34\xfd\xf0\xb6\x27\xe5\x07\x8f\x15\x5c\x64\x69\x8f\xc6\xee\x75\x41\x71\xfd\xba\x70\xb2\x56\x21\xac\xba\x30\xa9\x65\x65\x64\x5c\x14\xdc\x9f\x40\xd2\x8b\x81\x58\x03\x21\xb5\x29\x6b\x14\x2d\xae\x0b\x4e\x5a\x48\x44\x0e\x02\x88\xba\x81\xd9\x42\x54\x3d\x89\xe1\x57\xa7\x53\xd7\xcc\x7c\x46\x6c\xb1\x7d\x2f\x80\x83\x0d\x47\x21\xa7\x4d\x0f\x22\x2c\x5d\x10\x90\x48\x9a\x79\xc7\xac\x89\x72\x8a\x29\xc9\xa1\x3d\xc2\x72\x59\x2c\x66\x33\xff\x9d\xa1\x54\xea\xba\x52\xda\x3a\x5e\xf0\xe9\x79\xcd\xa9\xa1\x86\x5d\x2a\xce\xe0\x35\xe2\xba\x78\x6f\x22\x5c\x6b\xf1\xb2\x73\xbb\x2e\x7c\xf1\x76\x4b\xde\x6d\x26\x43\xec\x8d\xb7\xdd\x91\xcf\x69\x5f\x9e\xc4\x83\xe5\x35\xab\xbe\x34\x5a\x42\x75\x99\xb6\xc9\xe2\x5d\xea\xba\xe4\x61\xfa\x0e\x6b\x25\xbc\x77\x84\xe0\x97\x6c\xa7\x6f\x27\x54\x46\x13\xe6\x6f\xa4\xff\x29\xcb\x9e\x7e\xa6\x6e\x87\xda\x50\x47\x4e\xd9\xd5\x75\x99\xbe\x75\x68\xab\x80\xf4\x98\xd3\x83\x0c\x54\x73\xfa\x96\x23\x5f\xbf\x3f\x78\x6f\x66\x2f\xe8\x51\xd1\x25\x07\xe3\xbc\xdd\xa8\xeb\x93\x17\xa1\xeb\xe9\x77\x8e\x43\xbd\xba\x9e\xbe\x71\x04\x08\xac\x3c\x0e\x1b\x3f\x79\xc7\xf4\x77\x59\xb3\x04\xcc\xce\x02\xaa\xd8\x2d\x72\xa7\xb1\x26\x0c\xa7\xe8\x1c\x81\xae\xc4\xa2\xb7\x30\xae\xd3\x77\xbf\x3a\x4e\xdf\x1d\x58\xf5\xb4\x78\x3d\x7d\xcf\x4d\xdd\xe6\x7a\xfa\x61\x64\x5e\x4f\x3f\x46\x59\xfa\xa7\x1f\x32\x30\xa6\x1f\x29\x85\xbd\xba\x9e\x7e\xec\x8c\x9f\xfe\x55\xdd\x68\xdd\x9b\x1a\x46\x75\xa3\x97\x76\x49\xe8\x0e\xea\x06\x9b\x6f\x07\xf8\xad\x18\x39\x98\xbe\xd1\x9d\x5e\xa9\x1b\x6d\x7a\x16\x32\xa0\x55\x37\x7a\x6d\x9c\xe3\x53\x6f\x74\x8f\xa4\xd9\x0b\xd2\xc2\xb0\x07\xa6\x1c\xa7\xef\xa3\xba\xd1\x03\xc9\xa2\x98\x73\xa3\xdf\xba\xa2\x6e\xb4\xd3\xa3\xa5\x48\xd7\x0e\x0d\xc1\xd8\x1c\x56\xb4\xd3\x84\xd4\xae\x8a\x0c\x6e\xb4\xb3\x2b\xde\xe9\xa8\x97\x63\xd0\xd6\x8c\x30\xca\xbd\x20\x92\xb9\xe9\x9e\x54\xb4\x66\xb0\x27\xe8\x6b\xbe\x24\xf9\xa6\x6f\x80\xe0\x0d\x15\xe3\x2c\x92\x77\x50\x53\xce\xb2\x73\x10\x04\x9f\x59\x37\xb2\x85\x46\x56\x71\x23\x27\xe1\x84\x94\x37\x5a\x79\xa0\x9a\xd4\xde\xa0\x10\x48\xc5\x64\x15\x10\x20\x79\xb6\xd4\x69\x3c\xea\xd0\x3b\x3e\x19\xf7\x30\x99\x22\x3d\x4a\x68\x37\x1a\x17\x59\xb4\x36\xa2\x4d\xc2\xb0\xda\x10\x93\x6f\xf7\x9d\xa4\xe1\xe7\x78\xae\x8f\x47\xc3\x76\xe3\x06\x3e\x8d\xd4\x0d\xdf\x00\x95\x06\x08\xce\xbe\x14\xd0\x1a\xcb\xa7\xde\x46\x3e\x57\x5c\x18\x01\x64\x4d\xbe\xd1\xbe\x38\x4a\xe7\xfb\xb6\x5b\x75\x83\x01\x0e\xf1\xa8\x37\xb8\xb4\xb9\x61\xdb\x4e\xcd\x63\xc7\x6a\x0d\x64\x25\x00\x52\x4f\x8c\xf0\x00\x1b\x51\xe5\xbb\xe1\x3c\x58\x92\x5b\xd3\xc6\xd6\x16\x71\x84\x86\xde\x68\x36\x72\x34\x52\x1c\xa9\x62\x94\x72\x59\x7a\xa8\x69\x64\x71\xa9\xa0\x3e\x93\x25\xb0\xf2\xb2\xdc\xca\xcb\x34\x69\xcc\xed\x2d\xb9\x3d\x09\xac\x8f\x84\x9a\xbb\xb4\xdc\xbb\xfa\x34\xda\x8c\x37\xb8\xaa\xba\xd1\xa9\xaa\xfb\x81\xb8\x2e\xc8\xf2\x73\xa3\x91\x45\xc1\x25\xdd\xe8\x24\xc6\xf4\x40\xb6\x30\x37\x5a\x3e\x2a\x89\x19\x30\x91\xf6\x67\xe2\x8c\x1c\xb8\xd1\xb1\x26\xd4\x91\x95\xf5\xc6\x60\x19\xa4\x6e\x74\x5e\x0d\x35\xac\x7f\x88\xda\xcb\x8d\xce\x25\x21\xf5\xba\xd1\x05\xb5\x51\x75\xa3\xb1\x96\xbe\xd1\x45\x9c\x24\xda\x9a\x62\x62\x42\xbd\xf6\x06\x2a\x52\x5b\x0f\xa2\xc1\x3f\x69\xe3\xc5\x9d\x30\x68\x9d\x64\x25\xfd\x4c\xdb\xd9\x1d\xe3\x61\x8f\xe4\x5c\x40\x12\x90\xea\x89\xf9\x4d\x3d\x32\xba\xd1\xa7\x28\xf8\x81\x2f\x07\x1a\x6a\x81\xd5\xc2\x8d\x3e\x72\x98\x28\x52\xc8\x1b\xa3\xbb\xa4\x6e\x0c\x4b\x38\xe1\x01\x3c\x0a\x4e\x81\x46\xe7\xb2\x2c\x5e\xdd\xc0\x5c\x61\xe3\x6b\xb3\xba\x11\x67\x64\x64\xa9\xe4\xc2\xac\x72\x88\xea\xc6\x74\x75\x4a\x72\x24\x25\xdc\xcc\x8d\xe9\x8c\x9f\x7e\xa7\x74\x4e\x18\x6f\x4c\x27\x27\xdd\x37\xa6\x8b\xc8\x29\x6f\x4c\xb7\xa7\xe7\x0c\x76\x51\x00\xca\x60\x45\x23\xad\x37\xdd\xf4\x83\x27\x7b\xe3\x46\x2d\x53\xd9\xf4\x26\xff\x3c\x5c\xe5\x1f\xe1\x3b\x6e\x8c\x85\xd8\xdd\x60\xc2\x4e\xc0\x36\xfb\x46\x06\xbd\xe1\xcc\x15\x14\x11\x40\x8d\x23\xbc\xa5\x54\x2b\xb6\x3c\x37\x1c\x73\x49\x59\x9b\x1c\x24\x8e\xc6\xd6\x8d\x71\x5d\xc4\x0b\x10\x71\xb7\x0c\x14\x8b\xe5\xa2\x80\x5a\xe3\x11\x69\x97\xba\x11\x7d\xf6\x1b\x58\x40\xca\x1c\xc5\xed\xdb\x8d\x39\x79\x06\x06\x8b\x56\xc6\x1e\xd8\x41\x0a\xf4\xb2\x45\xbb\xa9\xb2\x95\x1b\xe3\xdb\x23\x1e\xcf\x4f\xb4\xac\x1c\x8a\xb5\x24\x2a\x24\xc7\x91\x94\xee\xbd\xdd\xca\x08\x31\x5e\x56\x93\x1b\xe3\x29\xbc\xbd\x28\xca\x23\xf0\xfe\x52\x2a\xfb\x0e\xa0\xb0\xb7\x37\x08\x64\x08\xa4\xb2\x00\x47\xb3\xa0\x89\x72\x23\xc2\x19\xde\x1b\x97\x49\x80\x90\x01\x54\xdd\x3a\x23\x45\x20\x5e\x91\x3f\x6a\xb7\xe0\x2e\x82\x58\xad\x49\x6c\xed\x0a\x4c\x02\xeb\x7b\x4f\x07\x72\xef\xd8\xec\xdd\x6a\xf4\x67\xd2\xad\xf6\x59\x06\x56\x84\xf1\x13\xd8\xc7\xf6\x52\xdf\xc7\x86\xb4\x73\xbc\x9f\xe8\x7f\x2d\xaa\xa5\xfd\x69\xc5\xff\x53\xea\xdd\xf4\xf7\xed\x7f\x4d\x99\xeb\x68\x11\x58\xdd\xc0\x05\xf0\x07\x36\x28\xb3\x0f\x48\x96\xa0\xcf\x26\x22\x58\xdd\x21\x41\xbb\x31\x11\xe9\xbd\x00\x69\x91\x63\x1b\xdc\x91\x9d\x3e\x71\x88\x90\x90\x43\x38\x76\x41\xdb\x29\xff\x8d\x69\x83\xaa\x2c\x29\x80\x83\x7e\x69\xc2\xb2\x5e\x4b\x89\xc5\xcb\x39\xc1\x8d\x29\xd8\x2d\x01\xf8\x54\x51\xaa\xbe\x31\x07\xd1\xdc\x14\x84\x67\x0e\x88\xe9\xe8\xc2\x03\x4c\xe4\x8d\x5d\xd6\xee\x02\xe6\x0a\x6d\x4e\xea\x86\x73\x39\x02\xca\x07\xf8\x0a\x0e\x02\xf8\xdf\x6c\x09\x22\xe9\xb8\x85\xb7\x40\x91\xdf\x00\xc9\xd0\x5b\xbf\x64\x27\x39\x82\x8b\x1e\xf4\x8d\x7d\xdb\xb3\xec\xab\x1b\x8b\x81\x98\x01\xa2\x5f\x7f\x63\x5d\x67\x96\x6c\xd7\xa8\x00\x53\x05\x3a\x61\x9d\x30\x13\x16\x11\x74\x45\xa8\x8a\x73\xab\xa0\x25\x1b\x76\x4b\xb2\x2a\xd8\xe6\xca\x81\x34\x5e\xec\x84\x8b\xb9\xb1\xae\x91\xf7\xb6\xb0\xda\xd1\x1e\x8b\xba\xe1\xf8\x9f\x00\x75\xa9\x1b\xf4\x9b\x6f\xac\xaf\xd3\xcc\xa2\xba\x78\x63\xa3\x26\x2d\x36\x37\xf1\x60\x95\x34\x23\x7f\x95\x72\xe5\x28\xfe\xc6\xc6\xc0\x07\xc4\xe0\x75\xcd\x16\x42\x83\xf4\x9d\x8d\x69\x35\x88\x94\xee\xc6\x36\xbf\x98\x37\x36\xaf\x06\xf1\xb7\x7b\x53\xdd\xc9\xdf\xbc\x19\x37\xfe\xb6\x43\x10\x77\xf3\x16\x62\x4e\x88\x82\x6f\x5c\x49\x79\xfa\x63\xa3\x6e\x02\x3d\x8a\x79\x98\xc1\x05\x03\x05\x84\xd5\x90\x32\xb6\x8b\x15\x2f\xce\xaa\x1b\xd1\x2b\x95\x30\x49\x64\xc3\xe1\x31\x88\x74\x7e\xe8\x9a\xd7\xe3\x1b\x71\xe9\xe5\x67\xb7\x0e\x87\x5b\x8d\xb1\x09\x46\x82\x81\x11\x16\x20\xe5\xc1\xfc\x4f\xf2\xc4\xa1\x31\x21\xc1\xe0\x5d\x5f\xdd\x84\x35\x4c\x1d\x09\x38\xac\xbc\x09\xeb\xb5\xcc\xdd\x0a\x7f\x22\xc9\xf4\x3c\xbf\x5e\x8f\x27\x9a\x18\xde\x33\xfc\xfc\x0c\x04\x51\xad\xb4\x61\x14\xd7\x1c\x37\x22\xfb\x51\xf8\xe1\x79\xaf\x04\xc4\x52\xb6\x8e\xa4\xca\xd0\x0c\x9c\x65\xc8\xb2\x1a\x30\x18\xe7\x9b\xdd\x28\x01\xe3\x0b\x50\x93\xea\xb4\x0f\x6e\x4c\x9b\xb7\x5a\x7b\x97\xf2\xec\x13\x22\x67\xc9\x97\x4f\x24\x27\xb8\x3c\x14\x38\x89\xe0\x72\x2e\x52\x54\x59\xd6\x90\x13\xac\xd9\x27\x83\x98\x6d\xfa\x4a\x12\x87\x28\x37\x01\xd7\xf2\x94\x31\x32\x3a\x64\x73\x94\x64\x3c\x22\x2d\x82\x1e\x86\xb1\x4e\x3c\xdc\x79\xa9\x9b\x80\x9d\xed\x4d\xf0\x7d\x00\x8f\x65\x69\x79\x8a\x29\x1f\x42\x2f\x27\x18\x37\x41\x6c\xf5\x6e\x02\x1a\xa9\xea\x06\xeb\xb3\x9b\xb0\xa5\x03\x89\xd4\x18\xc6\x5a\x44\x84\x76\x86\xed\x56\xde\x23\x74\x17\x49\x90\xc5\x7b\xee\x8d\xb0\x0c\x21\x9e\x26\x5d\x88\xf5\x86\x04\xd2\x4f\x29\xb2\x54\xc8\xe8\x44\x1d\x97\x94\xd3\x8a\x16\x22\x2c\x05\x26\x62\x37\x21\x86\xee\xc0\x86\xe6\x26\x70\x46\x77\x13\x62\x0e\xcb\x6a\x63\x03\x5e\x68\xab\xa4\x51\xae\xd5\x54\xaa\x36\x68\xe2\x88\xf4\x26\x34\x3e\x28\xa4\xd0\xde\x9d\xc2\x81\x2a\x88\xc2\xa9\x40\x53\xf3\x51\x2e\xaa\x8f\x84\x6d\x9e\x04\x71\xfd\x73\x13\x8a\xba\x09\xff\x63\xe3\x97\x25\x0a\xb7\xbc\xea\xc4\xe7\x84\x02\xe7\x4e\x41\x65\x64\x77\x74\x13\x30\x95\x22\xcc\x81\xe5\x00\xb3\x35\x89\xe7\x5c\x0e\xc4\x77\xda\xc9\x7e\x04\xd4\xfc\x09\xad\xca\xd8\x37\xd2\x86\x22\xa2\xba\x09\x7b\xfe\x69\xe3\x6c\x2f\xa5\xa2\x02\x7c\x93\x2c\x44\x22\x59\x33\x10\xd2\x17\xa9\xf0\x9b\xdd\x08\x90\xe4\xe9\x87\xba\x29\x28\x0b\xdd\xb0\x1f\x07\xe7\xf8\x2f\x08\x07\x51\x96\x75\x2f\x54\x84\xfc\x11\xce\x1e\x71\xef\xe1\xc2\xf1\x68\xd5\x4d\x11\x35\xf1\x9b\x42\x4e\xb8\x24\xc9\xc4\x7a\x0e\x88\x59\x27\x75\x83\xda\xed\x4d\x59\x67\x75\x53\x1a\xd9\x2d\xfd\xc0\x9e\xa8\xb0\xc5\x84\x57\x01\xe1\xef\x41\x54\x11\x41\x20\x23\xfc\xc1\x1b\xec\xec\x4e\x32\xd9\x99\xf0\xfe\xc5\xbe\x25\x99\xc5\xc5\x36\xe8\x96\x9c\x16\xdd\x14\x97\x85\xb3\x2a\xe8\xf1\xa9\x9b\xd2\xec\x96\x6e\xca\xb8\x1d\x10\x2d\xa9\x9b\x52\x7b\xad\x78\x5e\x26\x8a\xf8\x37\xc5\x1f\xe5\x7e\x80\x9b\x22\x03\xa7\xc4\x92\x64\x14\x94\x84\x43\x96\x1b\xa4\x1d\x37\x25\xaf\x86\x46\x9b\x04\x6d\xd8\xa0\x6e\x4a\xed\xff\x92\x73\xa9\xf5\xfd\x22\xe4\x19\xfd\xaf\x9b\x43\x9d\x29\x02\x78\xc1\xc1\xd9\x7f\x7c\x55\x37\x87\xdd\xf4\x7b\xac\x5f\x77\xd8\x1b\xd7\x0e\xfd\x6e\xa6\xdf\x61\xbd\xd5\xcd\xf4\x6d\x25\xdb\x82\xe9\x1b\x2e\x68\x7f\x23\x01\x97\x74\x2c\xdb\xd3\x77\xa8\xc9\xf4\x7d\xe0\x1c\x5f\xa0\x44\xdf\x89\x1e\x0a\xad\x4c\x86\xe9\x7b\xa3\x13\xd3\xf7\xf4\x4e\x33\xa6\x7f\x87\x82\x4e\x3f\x5a\x17\x61\x8e\x47\x21\x3f\x7c\x5b\x31\xa7\x1f\xd1\xd9\x96\xf5\x87\xd0\xed\x8f\x7a\x0c\xde\xae\x82\xfa\x88\x03\x9b\xde\xaa\x8f\x4b\x3c\xfd\x7f\x5c\xa2\x5f\x3b\xaa\x8f\xcb\xe9\xeb\xf4\x37\x73\x54\x1f\x7b\x67\x12\xea\x99\x1f\x7b\x74\xf0\xcd\xae\x22\xe1\x1d\x6a\xf5\xd1\x5a\xf5\x11\x1b\xaf\xa5\x56\x1f\x37\xc1\x8b\x1f\x3a\xf5\xd1\xfd\xc5\xfa\x1a\xea\x0a\xaa\xe0\xff\xa3\xb3\xeb\xb5\xfa\x28\xc7\xc3\x1f\xdd\x9b\xe4\x79\xfb\xf9\x27\xca\x2c\xea\xa3\xcb\x36\xa9\x8f\xa3\xb6\x04\x47\xb3\x01\x0a\xd9\xfd\x38\xf6\x11\x46\xfb\xe3\x88\xad\xf5\xc7\x31\xe1\x68\x00\xf5\x72\xf5\x11\xde\x74\x69\x33\xe7\xc6\x1f\x7d\x17\x06\xf5\x11\x05\x4c\x42\xc8\xed\x47\x2c\x4e\x46\xf5\x51\xb4\x54\x3f\x7a\x7a\xe5\xa3\x0f\xc5\x48\x78\x00\x4c\x5f\x57\x36\xa8\x8f\xbf\x2d\xb5\x53\x1f\x63\xb5\xb5\xfd\x18\x7b\x26\xce\xc7\x38\x0a\x5f\xf3\x31\x8e\xf0\xa1\x51\x7d\x8c\xc1\x27\xfc\x95\x7d\x44\x77\x12\x80\xf2\xe4\xc7\x93\x22\xda\xc7\x34\xfc\xc4\xec\x1b\x3b\xa6\x8f\x32\x3b\x3f\x26\xa7\x47\xf5\x31\x25\xfd\xae\xb4\xf6\x11\x31\xdf\xc7\x94\x4f\xd1\x2c\xbf\x81\xc0\x83\x96\x76\x6f\xc2\xc7\x7c\xb4\x7d\xc2\xb2\xfa\x63\x9e\xfe\x6f\xf5\x11\x6f\x21\xab\x0a\x68\xc9\x5d\x15\xbf\x7f\x84\x62\x7f\xdc\xd9\xd1\x1c\xd4\xc7\x5d\x88\xea\xe3\x5e\x27\x43\x98\x91\x9d\x02\x53\x69\x40\xe2\xc6\xab\x8f\xed\x62\x9a\x4f\x38\xe6\xdf\x21\x0f\xfe\xa4\x97\x2e\x88\x08\xe9\x5d\x41\x5b\x7d\xd2\xab\x8d\x04\xcc\x8f\x4f\x7a\x15\x96\xc4\xc2\x12\x63\xa5\x8a\xa5\x06\x4c\xfb\x3f\xb5\x9c\xbf\x15\x93\x4e\x70\xd6\x14\x1c\x24\x6a\xdb\xdf\xe2\x1d\xe9\x93\x5e\x89\x31\x9f\x55\x9f\x10\xc9\x44\xf5\x49\xaf\x23\x91\xc1\xf3\x34\x7e\x15\xd4\x27\x68\xf6\x27\xbd\x69\x76\x62\x9f\xf4\x26\x40\x0c\x3e\xe1\xbc\x9b\xca\xb1\xd6\x4a\x28\x2f\x96\x1f\x5f\x0d\x88\x1c\x25\xe8\x91\x24\x5a\x1a\x68\x08\x7a\x82\x8d\x96\x50\x12\xc2\xce\xac\x80\x49\x62\xac\x25\x80\x0a\x71\x4b\x0b\x7b\xfe\x49\x84\x08\x0d\x4c\xdf\x40\xc6\xe0\xa4\x3b\x3f\xe9\x28\x1e\x1d\x3f\xe1\x2c\x53\x9e\x39\x9a\x3d\xc5\xcb\xf1\xfd\x27\x9d\x76\x96\x0a\xe7\x44\xee\xd9\xf9\x80\xb3\xb5\x1d\x9e\x0d\x3e\xe1\x5a\x57\x7d\xd2\xd0\xe0\x0c\x1c\x8d\xfa\x84\xa1\x61\x12\xb0\x75\x98\x07\x7c\x32\xcb\xa5\xfa\x64\xcc\x9e\x0f\x34\x58\xc7\x1d\xd4\x27\x0e\xb6\x3f\x19\xff\x66\x73\xb6\x9b\xe2\xd4\x49\xe5\xe8\xd3\x69\x87\x05\x22\xf9\xe4\x2b\xcc\x56\xfa\xc5\x44\xcc\xe1\x3e\xc1\xdb\x13\x4b\x19\x97\xd9\x9f\x06\xad\x3e\xe1\xae\x43\x7d\xb2\xd2\x36\x76\x44\xd2\x77\x7c\x47\x66\x73\xf3\x36\xfd\xa1\x93\xfa\x44\x4f\xd8\x38\x7d\x4d\x66\xa3\x3e\xd9\xe3\x52\xab\x4f\x41\x7b\x09\xbc\x51\x9f\x38\xd9\x1b\x93\xfa\x14\x06\xed\x67\x0f\x4e\x5e\x02\xee\xdf\xb1\x9f\x48\x38\x21\x0d\xab\x81\x98\x78\x7e\x0a\x03\xd5\x0a\x03\x43\x21\x38\xcb\x25\x1f\x9f\x82\x3b\xa8\x4f\xd2\x1c\xc1\xf7\xb2\x3f\xfb\x14\xc4\xc8\xa2\x82\xd9\x8b\x89\x43\x30\x6b\xf5\x89\xe3\x59\xa9\x13\xc7\xb4\x00\xcb\xd7\x4a\xf5\x92\xd9\x0e\x80\x84\xb2\x41\x07\x46\xc1\x65\x67\xbc\x3e\xaa\x4f\xa1\xbe\x87\x9b\x45\x3e\x24\xb0\x03\x75\x29\x1d\x3f\x09\x8e\xb6\xea\x05\x7d\x2a\xc6\xa9\x4f\x65\x98\xbe\x26\x22\x23\x8a\x08\xea\x53\x61\xa7\x0b\xe8\x25\x18\xca\xc0\xd3\x62\xf5\xfe\xa9\x78\xc4\xbd\x3b\xe2\x07\x48\xc2\xa7\x82\x5f\x48\x46\x20\x76\xcb\xf9\x08\x92\x56\x47\xbd\x01\x62\x4a\x0a\xcc\x41\x7d\x2a\x5f\xd4\xa7\x72\x34\xdb\xd5\xb1\x6c\xd4\xa7\xe9\x6b\xd4\x76\x76\x1b\x46\x37\xfd\xbd\xb3\xea\xd3\xf4\xfb\xc0\x29\x27\x6d\x3a\x7d\x47\xd2\x2c\xcd\x3b\xfd\xfb\x4f\xf4\x87\x83\x09\xbc\xd5\xda\xe1\x20\xf3\x16\x57\x62\x61\xa7\x6e\xf5\x72\xfa\x9a\xd5\xad\x5e\x0d\x31\x04\xe0\x71\xc4\xdb\xba\xba\xd5\x9d\x11\x91\xc8\xad\xc6\xd9\xab\xba\xd5\xbd\xf6\xb3\xcf\x25\xaa\x5b\x3d\x68\x87\x9d\xda\xad\x1e\xd0\x79\x22\xc1\xab\x5b\x6d\x11\xc4\xc9\xda\x77\xab\x2d\x27\xd0\xb7\xfa\xad\xd3\xab\x64\xd5\xad\x7e\xab\xac\x1f\xc8\x58\x22\xc8\x86\x15\x63\x04\x13\x37\x4c\xb7\xda\x2d\xc1\x57\xc3\x32\xd2\x45\x3c\xed\xfa\x92\xeb\x7b\x9c\x58\x10\xdc\x6a\xe7\x8a\xdf\x50\xde\xc8\x4f\x3b\x09\xa3\x24\x68\x3e\xa2\x1e\x39\x03\x79\x6a\xb4\x38\xee\x95\x7f\xed\x26\xe4\x8a\xa0\x90\x06\x6c\xd5\x18\xed\x81\x3a\x8c\xa3\xc6\x1b\xd5\xad\x1e\xeb\xab\xc7\x43\x5a\xa1\x88\x74\x0b\xb9\x10\xd9\x66\x36\x46\xdd\x8a\x20\xf3\x16\xd2\x41\x30\xa0\x5a\x7b\xcb\x3c\xb9\xe5\x68\x8f\xbf\xd9\x5f\xdf\xe2\x74\xa7\x47\x1b\x82\x92\xbc\x30\x81\x48\x04\x06\x0d\x83\x0e\x96\x06\xbd\xc3\x89\x3d\xf8\xce\x62\x1c\x76\xab\x79\xd7\xb6\xd7\x99\xa2\xb7\x36\xa3\x77\x75\xab\xb7\x21\x50\x86\xfc\xf4\x6c\x5e\x62\xa2\x80\xa8\xd7\xd2\x2f\xf0\x9f\xf5\x7b\x51\x39\xe4\xb3\xa2\xde\xe9\x8a\xac\x9a\x65\xf4\xad\x8e\xa7\x3c\x96\xd7\x44\xda\x0c\xdd\x73\x2a\x1d\x71\x1f\xb1\xe1\x45\x51\x74\x49\x24\x43\xd2\x1b\xce\x25\x6f\xb5\x1c\x28\xdd\x6a\xb1\x0b\xa8\x00\x3a\x75\xab\xd3\x70\xd0\x5b\x75\x5b\x15\x92\x6e\x75\xca\x9c\xe9\xf3\x87\xd4\x1d\x6f\x10\x94\x93\xf9\xa0\xba\xca\x08\x94\x66\xce\x45\x04\xd1\xb7\x5a\x9a\xa9\xc8\xfe\x4c\x10\xcf\x2d\x3b\xd3\x57\x70\xb7\x86\x2d\xbb\x45\x2b\x80\x40\x5e\x5e\xe0\x9e\x6e\xb5\x1c\xac\xdf\xea\x5d\x71\x7a\xa3\x6e\xf5\x1e\x2f\x78\x00\x36\x13\xc0\xba\xd1\x00\x6b\xbd\xbb\xd7\x89\x05\xe0\x56\x1f\xcd\xb8\x0d\x52\xe2\x71\x94\xf8\x88\x5d\x28\xb3\xec\xd6\x30\xb2\x20\xb4\x84\x39\x11\x1e\xd4\xad\x59\xf1\x57\xc7\x69\xf8\xad\x11\xcf\xf4\xb7\xc6\x6c\xa5\xd9\x44\x21\xf8\x16\x03\x6a\x54\x13\x6e\x8d\x95\xf6\x45\x59\xda\x31\x76\xcc\x9f\x8c\x45\x6f\x8d\x7b\x4f\xab\x6c\xdb\x6d\x3b\x5f\x00\x86\x9e\xd2\x1c\x74\xf8\xd6\x8c\x5b\x61\xe6\x04\x5b\x13\x16\xfc\xd2\x1c\xf4\xec\x5e\x67\xdd\x1d\x8d\xba\x65\x62\x35\xb9\x1b\x08\xcf\x7a\x6f\xba\xc3\x09\xce\x1e\xfe\x52\xd1\x3c\x00\x4f\xf9\x32\xc1\x91\xe1\x69\xb6\xb5\x32\x71\xd6\x14\xb5\x6e\x0d\x47\x5a\x6c\x4c\x6f\x4d\x64\x57\x49\xa6\x28\xb1\x5a\xc7\xb8\x05\x17\x2c\x5a\x0c\x91\x6e\x91\x23\xe9\xbd\xba\x6d\x06\x83\xb7\xe6\x90\x4c\x1b\x4e\x66\xfa\xdf\xa4\xfc\x41\xbf\x99\x2d\xda\x2e\xb7\x9c\x17\x12\xd0\x6b\x83\x8e\x46\xc2\x0d\xe7\x7f\x03\xa8\xf8\x6e\xdb\x81\x65\xc9\x6d\xd2\x1e\x0d\x41\x75\x7b\xf2\xae\x74\x3b\x84\xcd\x10\xdf\xb0\x71\x55\xb7\x03\x56\x6e\x14\xb4\x0b\xa8\xd0\xa9\x5b\xab\x4d\x4c\x9b\x50\xd4\xad\x48\x22\x6f\x6d\x27\x4b\xc6\x6d\x9b\x79\xd6\x20\x08\xee\xd5\xad\x35\x7b\x75\x2b\xde\x19\xd4\xad\xc5\x38\xc5\xaa\x5b\xcb\x49\x65\x05\x09\xe0\x90\xce\xdd\xda\x9f\xdd\x84\x2c\x67\x2b\xe0\x14\x47\xba\xa8\x6e\xed\xc8\x6f\x19\xc5\xd0\xf4\xd6\x8e\xb8\x0c\x23\x45\xc6\x9b\x1d\x0f\x9a\xc2\x7d\x15\x17\xdf\xd6\xd7\xfb\xb7\x40\x5a\x9b\xab\xd6\x7b\xd9\x4d\xdc\x5a\xf6\x26\xfa\xc0\xe9\x75\xc3\xa7\xff\x53\xf0\xed\xd6\xa6\xac\x6e\x2d\x4e\x68\x6e\x31\x0f\x19\x44\xf4\x4b\x4a\xb5\x8c\x10\xa4\x6a\x24\xdc\xda\x68\x2b\xe3\x21\x18\xed\x24\x70\x2f\x30\x43\xc3\xa4\xcc\x28\x9a\xd6\x20\x1b\x09\x96\x58\x27\x0b\x76\xd2\x7d\xbf\xb5\x71\x6b\x57\xc7\xca\xbc\xdf\x8a\xd8\xa8\xbd\x2b\xfd\xe9\x65\x29\x87\xb5\x9c\xeb\xdf\x5a\x0e\x8f\x69\xbb\x64\x4f\x2c\xcc\x2d\x53\xa0\x7e\x33\xf5\xcf\x5a\x4e\x2a\x40\x44\x8c\x0d\xd2\xda\x09\x4a\x61\xa7\xdf\x5b\xf7\x71\x21\xa2\x0c\x7d\x9c\xde\x37\x51\xcb\xad\xd3\x9d\xe5\x69\x99\xea\xa2\x92\x73\xeb\xb8\x1d\xe4\xd6\x41\x06\x80\x66\x29\xeb\x8e\x33\x4b\x89\x51\x41\x51\xfe\x42\x6c\xd4\x1b\x75\xeb\xde\xca\x41\x3e\xc6\x85\x6d\xc0\x59\xd5\xad\xab\x72\x8b\x5b\x17\xd2\x71\x25\x63\xc8\x85\x7c\x84\x5d\xbb\x75\x85\xff\xcb\x41\x96\x3a\xd7\x00\x7b\x32\x10\xaf\xd7\x04\xdb\x2d\x21\x75\x40\xa6\xed\x00\x9c\x5c\xdc\x7a\x7c\x29\xa8\x5b\x1f\x96\x62\x9b\x5d\x31\xa4\x66\xb7\x1e\x36\x88\xb8\xd9\xae\xb1\xab\x9e\x3d\x9a\xbe\x1c\x48\x70\x3c\x1e\xa4\xc4\x20\x77\xf0\x80\x88\xe3\x1a\x81\x32\x90\x7d\xf8\x42\xea\x97\x59\xf3\x58\x71\xeb\x8b\x98\x8d\xdc\x7a\x0c\x1f\x6e\xfd\xf4\x07\x64\x21\x2c\xb5\x04\xf5\x2c\xa4\x61\x82\x14\x59\xfc\xa4\x22\x81\x55\x4c\xfa\xe8\x84\xcd\x30\xdc\xef\xa6\x6f\xea\x36\x88\x35\x03\x70\x43\x10\x4a\xac\xaa\xd2\xb7\x61\x75\xa0\x57\x42\x27\x03\x21\x74\x98\x8d\xdd\x86\x2e\x15\xb3\x54\xb7\xc1\xb0\xf8\x0b\x84\x74\x06\xd3\x62\xae\xe9\x85\xdc\xa2\x1d\xd1\x93\xe2\x6d\x5f\x6a\x02\x8a\xb1\x1b\x32\xaf\x83\xed\xd4\x6d\x18\x5c\x95\xb4\x09\xd6\xdc\x27\xdf\xb2\xc5\xb9\x0d\x54\xdc\x76\x47\x6e\x4e\xbd\x0d\xef\xae\x0d\x41\x7d\xd5\xce\x01\x8d\xc8\x27\x6f\xe1\xbc\x35\xab\x7b\x70\x81\xe5\x19\xa5\x2c\xba\x3d\x8c\xf5\x6c\xf2\x36\x60\x65\x2f\x61\x4d\x9e\xbe\x0a\x83\x23\x88\x7c\xea\xec\xce\xf4\xef\x2b\x50\xf0\x7f\xf1\x01\xd0\x9d\x76\xa5\xe0\x51\xe7\x03\x43\x04\xb6\xf3\x36\x78\xfb\xe6\xff\x74\x1b\x10\x09\xb5\xf1\x7d\x7d\x34\xc0\x6e\x48\xb3\xf8\xb0\xdd\x48\x09\x78\x96\xba\x7d\x77\x20\x25\x83\x2f\xf8\x83\x76\x3c\x15\x38\xa2\x4c\xea\x36\x6c\xe5\xf0\xf6\x96\x61\xea\x06\x06\x1a\xff\x10\xd2\xfb\x08\x4f\x6e\x43\xc4\x85\xfa\x2d\xee\xe8\x85\x2e\x41\x0b\x19\xf9\x21\xa6\xa1\x4e\x5d\xc1\x5a\x5f\xc7\x74\x4a\xc3\x24\x80\x32\x12\x42\x62\x00\xa6\x0e\xb7\x21\x85\xd9\xa3\x18\xf0\xd7\xfc\x35\xfa\xf6\x1e\xcd\x12\x18\x57\xaf\xc5\x12\x3c\x36\x50\xd9\x85\x90\x72\x7b\x03\x5a\xea\x52\x72\x16\xee\x21\x64\xe8\x57\x26\x25\x3b\x2d\x55\x66\xcf\x46\x21\x99\xf3\x5a\x01\x46\x00\x2f\xc9\x07\xda\xb6\x6c\x02\x23\x6c\x27\x34\x35\xec\x74\xa3\x6c\x61\xa7\xe3\xec\x92\xe3\x9b\x5b\xba\x68\xc5\x77\xec\xa6\xaf\xac\xd5\x61\xaf\x5d\x1d\xe5\x22\xc9\xbe\x0d\xc7\x9f\x5f\x60\xff\x6b\x94\x57\x1f\x61\x01\xa2\x5e\x6d\xc2\x1e\x68\xfc\x51\x68\x72\xd4\x06\x3f\x8e\x82\xad\x33\x21\xbc\x3d\xc0\x0b\x10\xea\xcb\xe6\x8f\x85\x2c\xea\x2d\x14\x70\x03\xd7\x16\x75\x9a\xdd\x69\xe1\xa2\xe4\x9f\xa4\xd1\x70\xac\xd8\xae\xe5\x96\x8d\xbe\x6d\x52\x11\xfe\xf1\x61\x2b\x5e\xa0\x2b\xde\x10\x59\xb2\x80\xd6\xfd\xcc\x99\xde\x4b\x4b\x08\x54\x6f\xa3\x10\x40\x42\xd3\x80\xab\x50\x52\x77\x72\x37\xc0\x6d\x14\x7a\x18\xcd\xea\x68\x32\x15\x1d\xde\x23\xb2\x63\xb9\x8d\xb2\xbb\xbe\x45\xe5\x0b\xa2\x1c\xcd\xdb\xca\x56\x30\xfd\x01\x1c\xb7\x35\x34\x6e\x60\x2f\x4a\xa4\xf2\xe3\xa2\xf6\x75\x8b\xe9\xcf\x49\x89\xf3\x16\xfd\xf5\xd5\x20\xef\x01\x21\x4f\x39\x42\x7d\xf9\x18\xdb\x5e\x64\x8d\x28\x6e\x0b\x92\x1b\xc6\xb6\xf4\x16\x6f\x57\xbc\x5a\x2e\xae\x1b\x4b\x14\x0e\xbc\x5e\x63\x17\xf5\x9b\xfc\x51\x73\xbd\x2f\x3a\xd1\xee\x64\xdc\xc8\x82\x17\xed\x6e\xfa\xea\x67\x37\x48\x55\x79\x4f\xd5\x47\xbd\x8d\xa1\x27\xe2\x45\xb3\xed\x36\x0a\x8d\x8d\x85\xb4\xc2\xd5\x4f\x15\xd2\xc9\xa5\xf2\xb9\xb1\xb6\x66\x69\x0b\x41\x3c\x70\x05\xe4\xfa\xd4\x72\x87\xea\x0d\xf2\x36\x1e\x0d\xdb\x09\xdb\xb8\xea\x88\x37\x88\xdb\x38\xfd\x7e\x1a\x41\xd3\xef\xad\x37\xa6\x3f\xe0\xd1\xd1\x7a\x96\x77\x4d\x3f\x12\x72\x4c\xaf\x6e\x45\x04\x7b\x2b\x3c\x75\x6d\xd7\xb2\x64\x14\x09\xcc\x47\xc6\x30\xae\xb2\x02\x60\xfa\x9a\x19\xe8\xea\xb6\xac\xf8\x6d\x38\x64\xbf\xc5\xd3\xf3\x6d\xe9\xa2\xc6\xec\x11\xc4\xea\x9d\x74\x78\xe9\x58\xdd\x7f\xa2\x0c\x38\x70\x03\x21\xe5\x64\x2e\xe2\x71\xef\xb6\x70\x02\x91\xd4\x2d\xde\xde\x09\x5a\xad\x24\x53\x65\x2b\x6a\xbb\x94\x37\xed\xb4\xba\x2d\xce\xf2\x52\x36\x7a\x6c\xcf\x8a\xdb\x08\xcd\x2a\x2e\x0d\x0c\x81\x01\x5c\xe8\x6a\x19\xc5\xbb\xaa\xc4\xc8\x38\x2e\x87\xf0\x26\xdb\x36\x5c\xc9\x03\xe4\xbb\xe5\x54\xf8\x16\xe5\x28\x22\x99\x95\xab\x78\x9c\x74\xde\x62\x0c\xa4\x6e\xcb\x56\x18\xb4\xb2\xb5\x2b\x29\x3b\x56\x5f\x9d\xb7\xed\x92\xa3\xdb\x12\x85\xb8\x97\x18\x7a\xde\x12\xab\x3c\xff\xb6\x44\x1e\x8a\xa8\x92\x08\x08\x02\x8e\x35\x94\x65\xad\x44\x89\x1d\x39\x5c\x57\xb7\x05\x39\xa5\x95\xd6\x4e\xde\x1c\x03\x2c\x46\x49\xb9\x85\x0c\x62\xa9\x45\x2a\x3e\x48\xa6\x3c\x94\x25\x8e\x8b\x21\xcb\x25\x87\xd8\x93\x93\x4d\x16\x70\xaf\x5b\x1d\x0f\xc2\x56\x94\xa3\x5d\xe1\x9a\x9f\xbf\xfe\xf1\x95\x77\xef\xf4\x8a\x95\x46\x8e\xd6\x6e\x21\x5d\xc4\xf6\x72\x1f\xe3\xed\x3e\xf8\x19\x9e\x2f\x7b\x75\x7b\x40\x1a\xb2\x9d\x3d\xe1\xd7\xb0\x15\x70\x90\x61\x35\xfd\xce\x9a\x98\xde\xa6\xef\xea\x76\xfa\x3d\x7a\x23\xce\x0d\x6e\xa7\x7f\x89\x66\x30\x9d\xba\x45\xfd\x4a\x18\xf8\xd9\x87\x03\xf2\x5c\x1e\xf9\xbe\x46\xa0\x3b\xfd\x7d\x76\x52\xdf\xba\x9d\xbe\xcb\x4a\x2c\x80\x7a\x0a\x22\x4b\xf2\xf4\x9d\x43\x72\x52\x4e\x4b\xf3\xf4\x63\x70\x7b\xe1\x2c\xa7\x1f\x43\x0b\x25\xc7\x0f\x3a\xd3\x19\x30\x64\xac\xbd\xba\xfb\x8b\x48\xaf\x33\x0a\x0c\x77\x5a\x7b\x75\x87\x48\xa1\x33\x36\x6a\xd0\xe5\xf4\x0d\x80\x6a\x17\x40\x14\xf1\xc4\x21\x11\xa9\x58\xf3\x65\x41\x8e\x2d\x43\xb4\x46\xdd\xe9\x15\xf7\x8b\x09\x3c\x10\x0e\xe2\xe0\x0a\x84\xf1\x04\x44\xd7\xf8\x0e\x49\x24\x41\xcd\xc4\xa2\xa4\xee\x74\xd7\xa9\x3b\x34\xe0\xa7\x7f\xa7\x20\x46\x7d\xe6\xef\xb5\x43\x7e\xae\xee\x34\x77\xd3\xf0\x4f\x6f\xf8\xe4\xbe\x62\x70\xd6\xea\x4e\x0f\xa3\xba\xd3\x96\x9f\x08\x99\xef\xb4\xf5\x74\x06\x56\x90\x77\xda\x46\x4a\xc6\xd2\x64\xab\x1d\x98\x5c\xfe\x02\x52\xfe\xf1\xd5\xae\xd4\x9d\xa4\x3a\x9b\x07\xbe\x9b\x92\x46\xcd\xe9\xf7\x9d\x1e\x97\x44\x44\x9e\x07\xe4\x5e\x16\x12\x78\x11\x8d\x99\x04\x06\x2f\x10\x25\x44\x80\xb4\xcf\x78\xca\x4a\x3b\x35\x18\xca\x17\xb0\x14\xfc\x6c\xb1\x8a\x56\xd4\x90\xef\xb4\x5f\x61\xd5\x4c\xdb\x20\xfe\x16\xd0\xa3\x2c\x2c\x58\xa4\x1f\x05\x29\x54\xcb\x77\x69\x19\xea\xc6\xe5\x8e\xed\xae\x18\x90\x13\x74\x41\xb2\xf5\x35\xa9\x16\x83\x32\xb5\xa4\xbe\x85\xb2\x07\x6e\xcc\xe9\x45\xf9\xa8\xee\xf4\xd6\x1c\xa2\x48\x36\xee\x34\x22\x26\x3a\x13\x1d\x5a\x73\x82\xb3\xf3\x80\x4d\x0e\xd1\xa8\xa7\xbf\x21\xc1\x06\x35\xd3\x57\xb9\x5b\x98\x74\x58\x59\x00\x52\xbf\x3b\x8d\x01\xbf\xba\x43\xfc\x09\xe5\xa6\x4d\x4a\x6b\xb9\xd2\xcb\xbd\x4b\x77\xba\x6c\x36\x94\x52\x44\xa9\x02\xd8\xfe\xc7\x44\x92\xf4\xd4\x3a\x16\xfe\xb3\x75\xa4\xa0\x9c\x2f\xde\xe9\x1d\xda\x32\xea\x8e\xdd\x03\x21\xfa\xea\xbc\x65\xc7\x5d\x55\x15\x50\xf8\x8e\x41\xb3\xe3\x16\xbc\x3b\xcd\x77\xef\xb5\x8b\x18\xe6\xdd\xe9\xbd\x24\x55\xa3\x5d\x41\xc8\x29\xaa\x0d\x77\xfa\x0b\x8f\x7e\x11\x42\x75\xa7\x8f\xed\x26\x25\xc1\xc4\x09\xd4\x1d\xd7\xea\x1d\xd5\x9d\x91\x4a\x19\xdd\x2d\x8d\x98\x6d\xde\x19\x8d\x26\xc3\x1d\x1e\x62\xef\x90\x43\x10\x74\xd1\x36\x18\x80\x51\xfe\xdf\xa1\xf0\x82\xde\x13\x86\x11\x9d\xd9\x9d\xa0\x16\x84\xa7\x6b\x63\x99\x65\x2c\x14\xb3\x1a\x46\x6c\xb4\x41\x38\xa3\x99\x3d\x47\x4f\xdb\x99\x95\x33\x71\xf5\x1b\x48\x18\x19\x62\x66\x15\xca\xda\x81\x74\x46\x6e\x32\x05\x89\x52\xb5\x4e\x46\x9c\xe1\x3f\xc3\xb5\xf1\xac\xce\x77\xc6\x94\x7d\xdb\xa1\xdd\x99\xb5\xb6\x3b\xfe\x46\x97\xbb\x97\xb3\xc9\x3b\xb3\x0e\x3c\xdb\xd7\x67\xc5\x85\xbb\xba\x33\xb2\xbf\xa8\x80\xca\x0d\x48\xd1\xe5\x1d\x9c\x9b\x32\x0a\x0c\xb7\xbe\xd4\xbd\xc0\x9d\xb1\x1d\x2f\x6a\xfe\xe9\xee\x30\xfb\xea\x89\x27\x3e\xbe\x1e\x0a\x02\xb3\x1c\x75\xdd\xb5\x23\x7d\x81\x16\xb6\x4e\xb0\xf6\x97\x7c\xe1\x9b\x76\xb3\x7b\xbb\x0a\x34\xd2\x9b\x29\x8c\x01\xf3\x86\x08\xf9\xce\xc8\x87\x3b\xe4\x08\x19\xa4\x39\xa1\xb8\xe3\xae\x2a\x69\xbc\xfa\x2e\x1c\x55\xdd\xa1\x09\x15\x5b\xfd\xc7\x80\x07\x1c\xfe\x97\x43\x54\xe0\x09\x08\x31\x44\xc3\x68\x6c\x48\xad\x09\x13\xcd\x88\x67\xa5\x3b\x3c\x24\xd3\x36\x1e\x65\x9d\x3b\x8c\x7b\x89\x30\x38\xaa\x7f\x5a\x20\xb9\x03\xd7\x78\x31\x12\xb6\x68\xb4\xde\x99\xad\xe1\x98\xf2\x1d\xa1\x33\xb7\x26\x8d\xf2\x57\xb4\x98\xc0\xdc\x99\x18\xf8\xaa\xc4\x0f\xa6\x53\x4c\xd9\x05\xb7\x1b\xd2\x3a\x2d\x05\x88\x0d\xf6\x1d\x76\x53\x1e\x8d\x40\x52\x7e\xc3\x38\x5f\xba\xac\xfa\x28\xbb\x33\x72\x78\x73\x67\x52\xb6\xcb\x50\x3a\x2e\x39\xbb\x6b\x36\xee\x77\xdc\x01\x24\x4e\xb8\xef\x4c\x0e\xe5\x28\x03\x38\x67\x5d\xd4\x9d\x29\xab\x21\xb7\x46\x2a\x5b\x8e\xdd\xee\x4c\xc9\xd5\xca\xef\xce\x20\x4d\x3f\x17\x2f\xfe\x54\x7a\xc7\xb7\xc1\x64\xc9\x60\x96\x49\x69\x76\xf5\xf2\x9d\x3b\xb3\x93\x1d\xf1\x9d\x61\x36\x9a\x1d\x37\xc9\xdc\x71\x43\x5b\x0d\xd9\xaa\x9c\x90\xd9\x8d\xd6\x5b\x9d\x34\xe6\x7b\xa4\x49\x46\x36\xcf\x77\xe6\xc0\x01\xb1\xba\x33\xa8\x58\xdf\x19\x0c\x4d\xee\x2c\x1e\xca\xef\xe4\x64\xe3\xce\xe2\x68\x85\x90\x69\x23\x40\x37\x28\x93\xdf\x2e\x37\x28\xe8\xdc\x59\x7c\x56\x0d\x42\xf0\xed\x6a\xc8\x27\x1b\x28\x89\x70\x34\xcf\xc3\xab\xa3\x47\x12\x65\xd5\x9d\xed\x3a\xc6\x94\x15\x63\xe5\x3b\xee\x3c\xcc\xda\xf3\xa8\x59\xca\x58\xaa\x50\x90\x8e\x7c\xa6\x33\xb3\xc5\x6a\x28\xa7\xc4\xbd\xba\xb3\xbd\x98\x5b\xdc\x59\x87\xf4\x3a\x1b\x68\x97\x75\x6f\x54\x8e\xa3\xa7\x3b\xeb\xb6\x61\xab\xee\x58\xb7\xac\x1c\x85\xdf\xd9\x71\x1b\xa9\x8e\xba\x93\x8a\x79\x5c\x00\x72\x01\xa7\xe1\x19\xbf\x3a\x22\x9c\xa1\x6e\xf2\x39\xbe\xa3\xa3\xf0\x6b\x0e\x03\x70\x77\x12\x55\x81\x18\x7c\x4d\x55\xaa\x4c\xec\x04\x7e\xfe\x5f\xf3\xdb\xbe\x82\x3a\x57\xda\xd5\x4b\xa4\xd4\x2f\x6c\x43\xde\xfa\xae\x7a\xa1\x25\x45\x3c\xe0\x80\x70\x87\xb5\xc0\x1c\x03\x1f\x20\x1d\x81\xc7\x9c\x54\x57\x62\x8e\xf1\xee\x6c\xe8\x6b\x0b\x04\x6a\xba\x7d\x6f\xfe\xad\x74\xbc\x65\x51\xb6\x09\x21\x00\x1f\x55\x17\x25\x9b\x99\xa3\x16\xf6\xad\x7d\x57\xe6\xcd\x39\x4b\x6f\x00\x44\x5d\xf0\xce\x52\xe2\xce\xb0\xd2\xd8\x5d\x1a\x0a\x5e\x6d\xee\xec\x51\x47\x7d\xe4\x1f\x9c\xd2\xdf\xbd\xe1\xb7\x4c\x5e\x85\xac\x21\xa9\x3b\xa7\xa1\x72\xce\x74\xd3\xdf\xd5\x1d\xde\x54\xbd\x56\xd5\x53\xc2\x9d\x5c\x5e\xe8\x4f\x10\x4d\x06\x99\xc2\x01\x35\x51\x75\x87\xfd\x14\xb7\x58\xdd\xa1\x15\x45\x14\x0e\x21\x74\x83\x25\x2c\x2b\x89\x40\x04\x82\x59\x3a\xf6\x69\x77\xc1\x88\x94\x88\x94\x35\xc4\x08\x24\xea\x0d\xdb\x9a\x3b\x3c\x6d\xfb\x86\xf0\xb1\xc1\x60\xaa\x00\x82\x5c\xf6\x0e\x4f\x7d\x71\x65\xc3\xac\x91\xbd\x40\x71\x72\xe2\xc0\x23\x03\xa3\x2d\x40\x7f\x1b\xe0\x89\x61\xaf\x7b\x75\x17\xac\xd7\x47\xfe\x65\x3b\x68\x4a\xc4\x99\x26\x67\x8d\xcd\x41\xe9\x5d\x78\xd3\xab\xe0\x83\xba\x0b\x9b\x41\xd4\x6f\xee\xc2\x28\xbe\x66\x81\x5f\xd4\x5d\x18\xe1\x1c\xf9\x24\xdf\x85\xe9\x6f\x64\x94\x8a\xfb\x7e\x85\x33\x0e\x62\x5b\x81\xb2\x3d\xab\x35\x08\xb4\x49\x60\xcb\x7b\x17\x20\x59\x01\xaf\x96\xb0\x52\x61\x2b\xcd\x85\x6a\xe3\x5d\xe0\x82\x14\x79\x12\xbf\xf0\x77\x21\x1a\x3d\xfb\xa9\xef\x48\x72\x5d\x8a\x81\x74\x23\xf7\xd7\xaf\x80\x49\xd3\x41\x21\x27\x4a\x66\x1c\x60\x28\x56\x39\xcf\x70\xe2\x25\x02\x1a\x56\x77\xb0\x57\x32\x9e\x42\x89\xd9\x30\xb0\x43\xe1\xe4\xef\x2e\x94\x23\x4a\xe4\x77\x61\x67\xde\xa4\x82\x7b\x7e\xd4\x65\xcf\xf4\x08\x7b\x56\xe5\xb0\x17\x43\x8f\xbb\xb0\x27\xc2\x03\xb3\xc7\xe9\x5b\x94\xba\x49\xec\xb9\x71\x44\xe1\xa8\x7d\x98\xfd\xf4\x0b\x7e\xc7\x56\x30\xe9\xd9\x07\xc6\x4a\x41\xef\x91\xb4\x25\x65\x61\xd2\x26\x63\xaf\x2c\xc3\x68\x45\xce\x7d\x57\x56\x33\x6e\x00\x71\xea\xae\xac\x34\x39\x71\xe9\x7e\x57\x56\x9b\xd9\x07\x8e\x1a\x48\xd8\x88\x29\xd9\x5d\x11\xad\xe3\x3b\x99\x01\x98\x70\xc8\x80\x2b\x1b\x7e\x7a\x97\x36\xd3\x7f\x82\x99\x24\x7b\xcb\xbb\x82\x78\x4c\xdd\x95\x71\xc9\xbe\xf7\xae\x8c\x07\x59\x78\x8a\xac\x52\xe8\x58\xdc\x15\x8f\x31\x64\x45\x64\x75\xc7\xc8\xbe\xfe\xf9\x9b\x6c\x69\xee\xea\x41\xe7\x1d\x67\x9c\x98\x15\xdf\xe1\xd5\xee\xae\x04\x75\x57\xb6\x5b\xa2\x89\x77\x63\xcf\x2d\x65\x66\x73\x10\x90\x63\x7d\x8a\x5e\x2b\xf9\x18\xe4\xc9\x03\x59\x8f\xea\xee\x60\xd4\x1d\xaa\x4d\x77\x07\xdf\x2f\x25\x82\xe1\xfe\xdd\x81\xa9\x79\x08\xac\x04\x87\xa4\xee\xa6\xaf\x47\x1c\xdc\xdc\x4d\xdf\x1a\xc3\x34\x7d\x13\x72\x34\x7d\x23\x1f\xa1\x4c\xc4\xe9\xdb\xae\xde\xed\x7c\xc2\xa6\x6f\x7f\x42\x67\x67\xe2\x45\x24\x4b\xd2\x41\x35\x9d\xda\x0a\x66\x67\xba\x64\xcb\xc6\xb0\xc5\x4f\x1c\x2a\x37\x95\x55\x7a\x36\x7d\x87\x2e\x4c\xdf\xf7\xcd\xe1\xc6\xdd\xf4\x43\xd4\x54\x05\xca\xec\x95\xcd\x53\x9d\x6d\xd3\x0f\x1c\xc1\xa8\x39\x67\xaf\x04\x72\x8b\x38\x48\x0a\xc8\x38\xe7\x7a\xa5\xe6\x7a\x55\xc5\xf2\x73\xbd\xc2\x53\xce\x5c\xaf\xd6\xba\x3b\x18\xcf\x3f\xc3\x68\xba\xb0\x03\xdb\xe8\x83\x64\xd9\xd4\x53\x1d\xb0\x4a\x9c\xe7\x7a\xb5\x1d\xb4\x75\xfc\x29\x67\xf4\x73\xdd\x0d\x25\x85\xd0\xc9\xad\x69\x73\x2d\x6e\x7a\xe6\x1a\xc7\x5b\x73\x6d\x64\xff\x3c\xe7\x16\x5a\x66\xc4\x5c\xaf\xd7\x86\x7a\xf4\xda\x0d\x62\xf7\x24\xa8\x9b\xfe\xee\x67\x8f\xc5\x2c\x1d\xcf\xf4\x3a\x49\x16\xc3\x6b\xb1\xb5\x22\x94\x9c\xe8\xc4\xce\x75\xef\x02\xda\xb4\x73\xdd\x7b\xf9\x0f\x2b\xfe\x0a\xe0\x26\xe6\xba\xe7\x1a\x93\xb9\xee\x4b\xcb\x85\xe3\x8f\xb9\x1e\x74\xe6\x63\x07\xf1\x66\x12\x79\x70\x18\xa1\xd6\x73\x3d\x90\x9b\x04\xcb\x72\x34\xd7\xd6\x69\x74\x82\xe6\xf8\x8a\xd4\x07\xd2\x3d\x2f\xa8\xa5\x61\x75\xe1\x0d\xbe\x0a\xe7\xda\xc2\xac\x02\x24\x73\x16\x5c\xbe\xf6\x0d\xf9\x0d\x89\x6f\x65\xe4\xca\xdf\xb9\xde\x30\xd1\xe7\x7a\x33\x70\x70\x3a\x17\xca\x26\x21\x55\xda\xa0\xd0\x87\x78\x73\xae\x9d\x7e\x93\x83\x77\x30\xaa\x82\x67\x58\xa4\x60\x07\x1e\x76\xab\xe0\x46\x20\x9a\x4a\x80\x7a\xd5\xc2\x1c\x03\x1f\x92\x39\x5f\x99\x6b\xf7\xa6\x77\xb2\xb9\x9f\x73\x89\x89\xa4\x70\x57\x58\x85\x54\xd1\x8d\xcb\x10\x7b\x41\xf8\x08\x37\x72\x4b\x07\x88\x08\xf6\xe6\xda\xc9\x5d\x29\xf2\xc2\xe9\xdb\x2a\x64\x35\xd7\xa3\x34\xc3\x68\x04\xdf\x88\x58\x62\x4e\x6f\x77\x83\x7c\x1c\x5e\x70\x64\x85\x9c\x6b\xdf\x69\xba\xc8\x77\x3a\xb0\x99\x9e\x6b\xee\x27\xa2\xb6\xb2\xfc\xa2\x99\xb7\xae\x11\x94\x71\x40\x06\x2b\x9d\x8d\x2d\xbc\x00\xb8\xa9\x39\x47\xf5\x04\xb5\x48\xcb\x0e\x99\x28\x1f\xe3\xed\x88\x12\x07\xd8\x1b\xfd\xe6\x37\x4d\x47\x62\xce\x04\xf8\xd3\x85\xef\xa0\x75\x2e\xf8\x80\xfc\x64\x35\xa0\xe0\x37\xd7\xbe\x5e\x37\x09\x82\xb9\x1e\x45\x24\xae\xff\x01\xca\x6b\xda\x87\xe4\x5a\x21\xd6\x76\x62\x35\x07\x7a\x18\x73\xed\x8f\xb4\x8e\x3f\xa2\xf0\x23\x43\x98\xe7\x58\x53\xe6\x3a\xc2\xfb\x01\x92\xcc\x8c\x28\x57\x30\xcc\x75\xdd\x28\xce\x75\x5c\xe1\xcc\xa4\xc1\xd9\x99\x89\xf5\xdf\x95\x14\x1b\x57\xcc\x6f\xfe\xc4\x81\xf1\x09\xc9\x15\x91\xbd\x9e\x60\x36\xc8\xe8\x05\x4b\xb6\xa5\xb1\x11\x02\xb1\x7f\x6a\x0e\xa4\x03\xa4\x49\x43\xc7\x5e\x77\xad\xac\x5e\x3b\x43\x27\xa0\x74\xd6\x34\x07\x89\xe0\x19\x43\x52\x51\xc9\xe2\xfb\x62\xdf\x9e\xc4\x48\x52\xda\x24\x8a\x2a\xbd\x98\x00\x10\xb3\x7e\x55\x85\x14\xe0\xd2\x3e\x71\xd3\x47\x2d\x65\x3b\x68\xd7\x1c\xd7\xe5\xeb\x35\x0d\x28\xfe\x94\x10\x62\x87\x94\x03\xa4\x72\xae\xe5\xc6\xae\xb9\x8e\xbf\x95\xf6\xb5\x68\x70\xcd\x9e\x43\x1f\xfe\x14\xb1\x35\x96\x5a\xb3\x26\x9a\x48\xda\x28\xbd\x23\xd6\x49\x73\xc0\x34\x0b\xb4\x70\x04\x32\xfe\x71\x3d\xd3\x80\x39\x36\xc4\x36\xd8\xf2\x0a\xe9\x88\xf9\x20\x12\x72\x52\x60\xcb\x1b\x9c\x71\xd7\x49\xed\xc6\x3c\xfd\x71\x2a\x42\xb0\xd9\x87\x63\x88\x7f\xca\x8a\xf9\x3b\xc4\xfe\xab\xa4\x14\x39\x25\x98\xeb\x58\xa9\x6f\x6c\x5a\x73\x27\x6c\xfa\x4a\x26\x7c\xbf\x08\xa0\x97\x44\x7f\x00\x30\xb2\x73\x9c\xeb\xc4\x8a\x0b\x10\xaa\x00\x20\x73\x25\x6f\x98\x59\x89\x5e\x75\x45\x2b\xa1\xc1\x14\x9c\xde\x62\xa7\x25\x61\x05\xe2\x23\x5e\x10\x99\x51\x29\xbd\x09\x71\x69\x84\x32\xc9\x13\x19\xc6\x60\xae\x53\x11\x0a\x95\x8a\x54\x3d\xe1\x30\x68\xae\xb9\x45\x83\x49\x93\xb9\x08\xa9\x02\xf2\xe6\x41\x1a\x23\x0f\x9c\x6b\x13\xe5\x30\x9c\xba\xe5\xa1\x30\x50\x72\x58\x19\x56\x1a\xb6\x48\x84\x92\x89\x0b\x41\x65\x44\xe5\xf6\xaa\x9c\x8a\x1c\xd4\xce\x51\xdc\xa0\x9b\x73\x6a\xee\x8e\xe6\x9a\x9d\x9e\xcc\x18\xdc\xab\x57\x20\x45\xe5\x36\x79\xf3\xa9\x46\xef\x55\xca\x36\x06\x27\xa5\xb4\xf7\xe5\xc0\x9c\xc8\xb9\x7d\x6c\x6e\x2f\xc5\xfa\x64\x8e\x00\xa8\xf4\xb4\x51\x59\x72\xb5\x39\x48\x5b\xdd\x0a\x97\xc7\xaa\x39\xa2\x9a\xce\x0a\x41\xfc\x62\xc7\xd0\xe6\x14\xbe\xb2\xe7\xfa\x8b\x48\x21\xe6\x42\x14\x11\x26\xcc\xf5\x01\x5d\x30\x24\xcb\x73\x7c\x42\xce\xb9\xbf\x95\xe0\x3d\x4d\xe8\x91\x50\x8d\xe3\x51\xec\x97\x05\x93\xfc\xc7\xa3\x65\x5b\x49\xbc\xac\x64\x8d\x5a\x99\xce\xd9\xb4\x93\x71\xbd\x1a\x24\x32\xb4\x98\x33\xac\x58\x46\xef\xf4\x00\x44\x0c\x1a\xd2\x3b\x32\x3b\xc7\xc1\x81\x44\x1d\xa1\x5d\x31\xbc\x4d\x67\x37\x0d\x7a\x3d\x7b\xd1\x38\xd4\x99\x9b\xce\x41\x9e\x4d\xe7\x0e\x5e\x9d\x3c\x37\xcc\x4d\x57\xbf\xcc\x18\xd4\xf4\xe6\xc6\x20\x7a\x9b\x9b\x81\xd2\x06\x7a\xca\x0c\x51\xf6\x10\x44\x03\xa2\xb4\xb9\x61\x13\x35\x37\xf6\x4d\xd6\x4e\x23\x67\x1f\x73\x63\xa3\xe1\x90\x79\x6e\xb8\x87\x80\x84\x37\xf9\x17\xa5\x3e\x35\x37\x9b\x01\x15\x5f\x1e\x76\x7f\xf1\xb2\x6a\x19\x27\x62\x87\x0a\x03\x0f\xb8\xd5\x50\x21\x5e\xcc\x28\xc9\xd9\x0d\xca\x14\x82\xbc\x9d\x90\x43\x43\x24\xdc\x04\xdf\x72\x30\x0e\xaa\x54\x7a\x6e\xda\x41\xd7\xdc\xc8\xed\x90\x7c\x8e\x9b\xbe\xc1\xdb\xcf\x9a\xa6\xfb\xdc\x88\x17\x44\x40\xf0\xd3\xbf\x52\x2d\x6f\x2a\xa7\x09\x46\x56\x49\x3a\xd6\x4c\xbd\x04\x94\xc3\xd9\xe6\xdc\xf8\x7a\x22\x2a\x8f\xe1\x39\x6d\x6e\x7c\x2d\x5f\xcd\x0d\x5e\xe5\xa8\x0a\xe4\x3e\x93\x39\xae\x38\xdc\xe1\xbb\xe2\xaa\x41\x6c\x72\xe6\xb8\xf2\x91\x95\x02\x9b\x13\x8a\x8a\xe2\x56\x7d\x8e\xf1\x14\x64\xcd\xc4\xbd\x3c\xfe\x65\x2c\x55\xd7\x47\xf0\x76\x96\x3d\x17\xd9\x0b\x3c\x32\x05\x24\x5c\x1e\xcc\x4d\xf2\xb0\x68\x86\xbd\x2a\x0d\xb0\x5e\x13\xc9\x46\xb4\x03\xe7\xa6\xba\x14\x9f\x9b\xd2\x6a\x03\x3b\xc3\x7c\x32\xc2\x07\x8a\x84\x4c\x2e\x8e\x95\x25\x0a\x5f\x4f\xf2\x17\x74\xc2\xa0\x8b\xae\xe6\xf2\x79\x47\xb9\x71\xff\xf0\xa6\xe6\x46\xb4\xb8\xe7\x83\x4e\xb2\xea\x5b\xdd\x39\xc4\x64\xb2\x90\x58\x5d\xd4\x9c\xe3\x16\xe3\x44\x4c\x29\x38\x25\x0a\xfc\x22\x90\x76\x13\x00\x6b\xc0\x48\x97\x88\x69\xf0\x14\xe7\xc3\xac\x5c\x32\x3e\xe7\x18\x41\x9e\xe1\x36\xe9\xb9\xed\x3a\xe3\x86\x60\x36\x82\x56\xa3\xe3\xb9\x35\xbd\x9a\x5b\x33\xf0\xbf\x71\x2b\x51\x37\x9c\xe3\x26\x82\xb8\x04\x98\x60\xf0\xbd\xb6\x17\x0b\x54\x56\x00\xcb\xe4\x44\x22\x36\x47\x9d\x80\x37\xf4\x9c\x23\xcf\xed\x06\x89\x3a\x80\xfc\x1b\xcd\x34\xc3\x2f\x14\x47\xa7\x82\xf2\x27\x6e\xd8\x8e\x15\x86\x06\x38\x4f\x9c\x5b\xd7\x2d\xa3\x36\x7c\xb2\xeb\xa8\x0e\x16\x3f\x75\xfd\xb2\x8e\x65\x5d\xfa\x1a\x6e\x84\xc2\x39\xc3\x68\xa0\x83\xe3\x02\x3b\x08\xa0\x57\x6c\x55\xec\x22\x9a\xb6\xd8\xbe\x92\xe4\xcd\xec\xc9\x74\x83\xd1\xbc\x62\x44\x4a\x37\xb7\x7e\xf6\x3c\x18\x69\xb1\xba\xad\x9a\x8b\x98\x85\xa8\x14\xe3\x5b\xae\x0d\x2d\xe1\xa1\x2a\xd6\x07\xa1\xc6\x56\x8c\x1a\xe6\xdc\x5f\xcd\x53\x11\x1d\x94\xb9\x4d\x32\xff\x2d\xce\x8e\xe7\xf0\xc0\xd4\x3a\xd5\x24\xd4\x23\xe6\x58\x7b\xd5\xd7\xc8\x8d\xd6\x73\x7b\x40\x6d\x0e\xf0\x66\x05\x30\x59\xac\x68\x8f\x48\xdb\x1d\x6a\x13\x1e\x4b\x16\x66\xcf\xb2\xb3\x6a\x13\xd4\xfe\xe3\xab\x14\x89\x8e\x09\x45\xa1\x7d\xdd\xab\x79\x30\xfc\x06\x91\xd9\xcd\x03\x17\x6c\x10\x67\x7b\x0f\xc8\xb1\x6c\x25\x7e\x24\xdc\x33\xca\x39\xc2\x9b\x87\xbe\x9c\xfa\x36\x0c\xe8\x41\xcc\x61\x1f\x19\xc2\x61\xe0\x49\x2b\x41\x92\xa3\xf2\x79\xb0\x3c\xed\x18\xb0\x62\x95\x45\x9d\x03\x36\x04\xd1\xce\xee\xc3\x2e\x68\xa2\xd8\x8a\x05\x79\x6b\x33\xc4\x9b\x63\x9c\x75\x90\xb8\x84\xbc\xda\x25\x43\x7f\x07\x97\x71\x3f\x30\x0f\x63\x80\x92\x06\xf4\xb8\x64\xc2\x07\xbf\x92\x1d\x01\xd0\xed\x6a\x82\x91\xf7\x7a\x9c\xe9\xcf\xb9\x7e\x80\x60\x90\xd3\x8f\x39\xe6\x0e\xf2\x4a\xe9\x29\xfc\x6c\xf6\xf0\x09\x20\x50\x2d\xc1\x58\x02\x2a\x32\x9e\x38\x9d\xe0\xf3\x3a\xc4\x2c\x08\x43\x01\xd9\x40\x28\xc7\x56\x54\x6b\x6e\xd9\x04\x85\xd0\x51\x77\x48\x6e\x40\xa0\x32\x0f\x88\x9a\x1a\x98\x3d\x9f\xde\x12\xf5\x12\xe7\x0a\x20\xae\x86\x4c\xe8\xc0\xc9\x2c\x31\xbc\xe3\x01\x79\xa3\x1c\x07\x03\xd0\x74\x9f\xe3\x03\x54\xe8\x13\xaa\x26\x92\xb9\xab\x72\x1e\x1c\x34\xc8\x77\xb3\x62\xd4\xa8\x3b\x21\xd4\x08\x2e\xb5\x81\x13\xb3\x8a\x93\xb4\xea\xee\x7a\x1e\xa2\x5d\x09\xf7\x18\x38\xd9\x23\x8a\xf4\x12\x28\xef\x10\xe6\x14\x5f\xfa\x47\x79\x73\x3d\x18\x9c\x07\xb1\xbe\x9f\x87\x18\x8e\x32\x79\xf1\x31\x27\xd9\x79\x5a\xa0\x70\xa6\x21\x4a\xcb\x4b\xf3\xc5\x2c\xba\x71\x73\x6c\xc2\x24\x2b\x37\xf1\xf1\x59\x69\xc9\x3d\x05\xf2\x57\x5a\x51\x16\x76\x0a\x73\x11\x3a\x11\xd2\x38\x69\xc8\xba\x1f\x64\x65\xe2\x52\xac\x39\x27\x89\x52\x68\x3a\xb0\x03\x54\xf3\x90\x33\x46\x23\xf3\x90\x0f\xd0\x4b\x2e\x2c\xd3\x2b\x29\xac\xf4\x06\x8e\x29\xe0\xe6\x90\x27\x65\x7e\x72\xb5\x8a\xde\xd5\xf2\xb0\x45\x9f\x87\xa3\x38\x6d\xab\x29\xd3\xbf\x5a\xc4\x63\xf3\x30\xfd\x71\x54\xf3\x18\x7c\x75\xcd\x02\x5a\xb1\xd9\x2b\xda\xfd\xf3\x38\x7d\x3d\x42\x10\xf3\x7f\xe5\x30\x8a\x4e\x30\x7e\x45\xd4\x34\xdf\xd4\x1c\x45\xf4\xf9\x69\xcd\x29\xbd\x1e\x97\x64\xea\x7b\x4b\xa6\xa1\xa9\xa0\xaa\x79\xb1\x64\x63\x2e\x95\x8d\x89\xcc\xf8\xb2\x19\x4c\x7c\x63\x0b\x5f\xb8\xeb\x19\x5d\x81\x79\x71\xc2\x62\x95\x4a\xe8\x4a\x2b\x14\xff\x12\x49\xcd\x8b\xef\x36\x90\x24\x59\x92\x66\x5c\xde\xca\xf5\x7b\xf3\xe2\xb9\xa4\x78\x8e\xa3\xcb\x79\xf1\x8c\xf0\x7a\x9d\x01\x30\xeb\xe9\x6f\xc1\xe9\xd9\xf9\xce\x64\xac\x8e\x57\x24\x1e\x25\xa8\x67\x2c\xf3\x82\x7b\x06\x08\x77\xc1\x11\x12\xf6\x0a\x70\xcb\x25\x8a\x0f\xf1\x79\x89\x5d\xa8\x50\x2c\xab\xe7\x25\x06\xf9\x77\xcb\xb6\x9e\x6b\xc0\x2a\x90\x51\x51\x92\x5e\xc1\x9b\xa8\x79\xe1\xbe\x6c\xa9\x80\xa8\x26\xcf\x39\xd1\x5f\xd3\x1c\x49\xfc\xc4\xcc\x39\x8a\xd8\x6e\x49\xc8\x88\xdc\xd9\x74\x22\xe3\x51\xf3\x83\x73\x87\xa4\xe6\x07\xe8\x31\x20\x09\xcf\x7c\x90\xb1\x7a\xc8\x1e\xca\x2a\x60\x76\x72\x6c\x3f\x9f\x7e\x97\xac\x02\x66\x1f\x64\x69\xc4\xcc\x83\x74\x69\xbb\xe9\xf7\x1e\x4f\x66\xcc\x9f\xe9\xf7\x26\x61\x41\x2a\x55\x43\xb9\xd9\x9a\xc2\x39\xbf\xa7\x34\xdc\xaf\x74\x6a\x3e\x7d\x5f\x0a\x17\xc0\x5d\x06\x52\xca\xbf\x37\xf8\xa3\xef\xab\xba\x19\xf8\xcf\xce\x3d\xb1\x19\x15\xce\x2e\xc2\x52\xfc\xdd\x9d\xe2\x0b\x36\x74\xfc\x2b\x14\x79\xfa\xd1\xba\x67\xfa\xe1\xdf\xa1\x9c\xe4\xcf\xa7\x1f\x31\x5a\xba\xe5\x5e\x2f\xd5\xbd\x5e\x4a\x00\x8b\x7d\xcf\x70\x8f\x56\xdd\xeb\xd5\x91\xb0\x93\x7b\x1f\xee\x75\x07\xe7\x7e\x8f\x9d\x8e\x23\xb9\xe7\x87\x11\x4e\x5f\x46\x41\x47\x9d\x8a\x20\x62\x6e\x77\xaf\xd1\x98\x26\x4c\x7b\xf4\x25\xee\x75\xcf\x67\xdd\xeb\xfe\xa0\xee\xb5\xdd\x10\x10\x85\x80\xdd\xeb\x8d\x5e\x06\x44\x7e\x60\x1d\x99\x37\x55\x8f\x54\x10\xd4\x8a\x41\x6a\x28\x96\x55\x20\xb2\x5b\x01\x49\xf5\x39\xf1\xa7\x03\x22\xfb\xbd\x7b\xbd\xe1\x2e\x1a\x75\xaf\xa9\x1d\xbe\x44\x3d\xc5\x8d\xcb\xd2\xd5\xf2\xc6\x2d\x15\x19\x0f\xc9\xc1\x19\x91\x02\xbd\xbc\xd7\x9e\xa3\xe8\x2e\x13\xf7\x16\x83\xc8\x7b\xbd\x65\x64\x01\x88\x44\x9d\xec\x88\xcc\x03\xf4\xa0\xfd\x9f\xb0\xb4\x87\x2d\xb9\x17\x8e\xe5\x5e\x47\x64\x7c\xf7\x3a\xad\xec\x88\x17\x7b\x75\xaf\xd3\x40\x60\x29\x4b\x38\xb3\x7b\x9d\xc0\xb3\x8e\x1a\x1e\xfa\x5e\xe7\x4a\x85\xef\xab\xb3\xce\x7b\x5d\xfa\xe0\xfc\x61\xc3\x63\xa5\x29\x04\xdf\xeb\x7a\x70\x04\x0c\xbb\x06\xf8\xb2\x92\x2d\xf7\x2c\xdc\x73\xe1\x34\x0d\xb2\x13\xff\x4b\x0d\xce\x9e\x42\xc2\x53\x40\x92\x3f\x08\xf7\x7a\x4f\xed\xea\x23\xc8\xe4\xee\x39\xb9\x96\xa2\xcc\xd2\xa4\xe9\x6b\xd5\xcd\xbf\x37\xcc\x33\x75\x8f\x01\x77\x54\xf7\xc6\xac\x4b\x02\xb8\x03\x61\xec\x35\x93\xec\xde\x18\x49\xcc\x3c\x2c\x43\xca\xf4\x11\x09\xef\xbd\xe9\x63\x51\xf7\x8d\x4d\xb8\x37\xd6\x49\x40\x28\x3f\x6c\xb7\xee\x8d\x18\x0a\x01\x18\x5f\x66\x14\x25\xdd\x0a\xa5\x02\xa3\x79\x5b\x01\xde\x6a\x7a\x0a\xa3\xba\x37\x29\xcb\x68\x32\xb4\xab\x29\x4b\xdb\x0b\xc0\x93\xe3\xbd\x29\x22\x18\xbf\x37\xad\xa1\x80\x07\xf2\x72\x1b\xb1\x01\xa6\x5c\xb6\x14\xb5\x93\xd7\xef\x4c\x99\x9d\x8e\x7f\xef\x1b\xd7\x73\x6f\x76\xc2\x69\xf0\x77\xda\xf0\x21\x3b\x14\x48\x8f\xea\xde\xec\x97\x26\x46\xbe\x7e\x2f\x3c\xe3\xbd\xd9\xcb\x30\x33\x7b\xd6\xa2\x7b\xd6\xc7\xfb\xbe\xc0\xb8\xdf\x73\xad\xf4\xbd\x5d\xa9\x7b\xb8\x64\x0c\x3e\x2a\xe2\x4e\xc8\x3b\xe4\x41\x58\x68\x71\xb3\x71\x6f\x57\x1b\x4e\x37\x48\x7a\xff\x2f\x50\x12\xee\x47\x8e\xa5\x22\x5c\x61\x72\x6f\xcd\xa0\xe9\x0e\xcb\x4c\xb3\xad\x1d\xad\x19\x8d\x9b\x7e\x27\x21\x25\x49\x2f\x7b\xb1\x6b\xbc\xb7\x1b\x8b\x08\xe6\xde\x6e\x6a\x65\x36\xd2\x84\x76\x13\x9c\x7e\x83\xe1\xba\xb7\xed\x75\x4e\x36\xe9\xf7\x76\x1c\x83\xba\xb7\x7e\x50\xf7\x8c\x59\x7b\xba\x61\xe4\x1e\x2b\x1e\x66\x29\x10\x61\x5e\xc5\xea\x7c\x04\xdb\x23\x3f\xb9\xe7\x3e\x6f\xa9\x65\x36\x9c\x15\xdd\xcb\x49\xdd\x46\x12\x44\xeb\xee\x5e\xee\xba\xba\xb7\x47\x3d\x16\x9c\x0e\xaa\xfb\xb0\x24\x3f\x61\x52\xf7\xa1\x12\x80\xd0\x63\xe7\xb2\x01\x11\x2d\xfe\x7b\xb4\x81\xd5\x3d\xac\x9c\xba\x0f\xf5\x9d\x01\x36\xc6\xe1\xa3\xf0\x3e\x84\x6c\x96\x81\x21\x42\x1a\x9a\xb7\x15\xc5\x31\x52\x06\x33\x12\x97\x2e\x13\x27\xe7\x1d\xd1\x30\xfd\x4d\x03\xd9\xdb\xde\x57\xae\xe2\x3e\xc4\x1d\x1c\x34\x90\x4d\xf1\x7d\x10\xf7\xaf\xf7\x18\x6d\x07\x75\x1f\x76\x90\xb0\xb0\x93\x07\x76\x61\x1b\x36\xb1\x5d\x45\xf2\xe7\xe8\x81\x3f\xf1\xf5\x70\x1f\xf6\x28\x30\x01\xda\xad\x93\xf7\x75\xbb\x7b\x8f\x2c\x83\x46\x09\x07\x06\x63\x38\x4a\xd3\x85\xa3\x7c\x7b\xbd\xcd\xff\x1e\x4b\xb2\x7b\xf4\x3d\xef\xb9\xa7\x87\x3a\x1d\xaa\x67\x91\x7b\x76\x7f\x5d\x03\xf4\xe5\x41\xbe\x8b\x10\xc0\xbd\xd9\xea\xfe\x30\x7d\xe5\x9f\xe9\x6b\x9c\xbe\x76\x07\x75\x3f\x7d\xe5\xf2\x9c\x0d\x48\xb7\x0b\xd1\xc3\xdc\xdf\x4f\x5f\x65\x2e\x4c\xbf\x57\xe2\xce\x12\x05\xcd\xaf\x10\x4e\xf8\x7e\xfa\x9e\x8f\xc8\x1b\xef\xa7\x7f\x1f\xe2\xac\x79\x6a\xba\x9f\x7e\xb0\xdb\x55\x0f\x7f\x39\x8b\xdc\xba\xfd\xf0\x97\xb3\x03\x26\xfd\x0f\x7f\xb9\x08\xec\x80\xd5\xc3\x5f\xb0\x99\x02\x88\x1b\x98\x87\xbf\x54\xe5\x96\x07\x8c\x98\xcc\x41\x3d\x2c\xb5\x7a\x58\x9a\x58\xb5\x40\xc0\xb6\x78\xb9\x6e\x78\xca\x66\x76\x26\x1e\x5a\xf8\x67\x6f\xb9\x4c\x89\x7f\x82\xb7\x47\xa8\x3c\x68\xad\xff\xc3\x32\xe2\x29\xe6\xa1\xeb\x82\x7a\xe8\xac\x17\x15\xbf\x87\xee\x50\x82\x7a\x40\xb7\x45\x3d\x20\x35\x21\xa8\xf8\x28\x81\x51\x0f\xf8\xdc\x35\x9d\x7a\x58\x67\xd3\x69\xb2\xc8\xa0\x7b\xe8\x6d\x2a\xea\xa1\x67\x70\x3d\xb0\x3f\x51\x0f\x03\x5b\x25\xf5\x30\x50\xe1\xc1\xce\x9e\xc3\x08\xe2\xf8\x31\xeb\x1e\x06\x19\x89\x0f\x43\x50\x0f\x83\xac\x7f\x0f\x83\x6c\xa5\x1e\x86\xbd\x55\x0f\xb2\xa8\x3d\xb4\x25\xeb\x61\x23\x4d\xb2\x09\xce\xaa\x07\x27\x0e\xaa\x1e\x9c\xa1\x15\x9d\x79\x43\x3a\xb0\x53\x0f\x0e\x25\x1b\x09\x2d\x4f\x80\x44\x01\x3c\x62\xc5\xb7\xf1\x83\x7b\x47\xa4\x06\x75\xd2\x3e\xb8\xd4\x2c\x6a\x1f\x38\xe7\x7e\x18\x67\xb7\xda\xe5\x21\x94\x51\x3d\xc0\x33\x3f\x8c\xc1\xae\x83\x7a\xf0\xf2\x5b\x47\xab\x1e\x02\x66\x1f\x21\x2b\xae\x4c\x48\x39\x32\x79\x1e\xb6\x3a\xd7\x61\xfa\x20\xf7\x81\x3f\x6c\xed\x51\x3d\x6c\x5d\xf9\xc7\xd7\x6c\x9d\x7a\x90\xed\x83\x7a\x88\x48\xf1\x3b\x60\xc9\x59\x3d\x44\xf3\x56\x8c\xd3\xea\xa1\xa9\x71\x3e\x44\xd9\x8e\x3f\xe0\xb2\x24\x90\x2d\xaa\x87\x98\x29\x49\x66\xd8\x03\xb2\xda\xa3\x7a\x40\x80\x1a\xd4\x43\x12\x2e\xf0\x01\x75\x35\xaf\x1e\xd2\x20\x16\x09\x5a\x3d\x24\x56\x4f\x40\xf1\xc2\x81\x3c\xa4\x10\x2d\xf9\x51\x65\x51\x0f\x29\xeb\xa3\x91\x9a\x72\x70\xc2\xd2\xad\x1e\xd2\x4e\xaf\xa8\x66\xd6\xfc\xe8\x85\x6c\x7c\x3f\xfb\xd5\x2c\x83\x1e\x88\xc4\xa0\x1e\xa8\x71\xce\x44\x33\x8c\xc3\x43\x41\xb9\xf9\xa1\x88\x57\xe7\xa4\x1e\x84\xcb\xce\x99\x3f\x0e\xe8\x8e\x3c\xec\xd8\x2b\xb6\xee\xd9\xad\x8e\x3f\x71\x13\x8d\x19\x05\x6e\xb8\xd2\xe0\x61\x17\xcd\x32\xa8\x07\xae\x90\x79\xd8\xa3\x5f\x11\x6b\xcf\xec\x11\x89\x3f\x1c\x31\x39\x50\x0f\xc7\x0d\x5b\xd0\xc7\xbf\x68\xaf\x1e\x35\xbe\x8a\x1e\x31\xba\x96\xcb\x9f\x1e\xf5\xca\xb2\x78\x3e\xa2\xed\x18\xd5\xa3\x5e\x39\xf9\xdb\xe5\xf6\x2f\xc8\xf4\x15\x0c\x95\x41\xfe\x63\x13\xf7\x88\xca\xd0\xa3\xb6\xc2\xe7\x3d\x6a\xa7\x1e\x35\x27\x67\xf1\x2f\x33\xb6\x77\x3b\xeb\x73\x82\xc8\xb7\x54\x49\xfc\x9f\xa5\xfd\x39\x23\xcb\x0d\x1d\xfa\xa8\xdd\x1a\x31\xc7\xa3\x76\x16\x67\xa0\x40\x7c\xb5\x3e\x62\xb9\x95\xc8\x39\x4a\x50\xb3\x4c\x5f\xcd\xb1\xc2\xa2\x1e\xf5\xb8\x45\x3e\xce\xd7\x8c\x5b\xed\x11\xe3\x3d\xea\xb1\x6c\xa6\x7f\x2d\xe2\x4c\xee\x11\x5b\x4b\x79\xce\x77\xe6\x00\xe0\x78\xe9\x91\x16\x27\xd0\x4e\x22\xb6\xc7\x43\x01\xd8\xdb\x9e\x59\xf5\x28\x9e\x73\xa9\x98\xe7\xde\x5c\x6a\xe4\xa9\x8e\xcf\x76\x25\x00\xb6\x14\x04\x5d\xe3\x47\xf4\xa4\x01\x4c\xb8\x47\xbd\x45\xb2\x18\xd7\x15\x13\xb3\xc3\x47\xbd\x0d\x92\x7d\xbb\xa5\x05\xa3\xee\x60\xce\x1e\x35\x3a\xa3\x54\x29\x8a\x97\x67\xbc\xd9\xf2\xc6\xb8\x36\x72\x3c\xf1\x28\x67\x31\x87\xd9\x9d\xe9\x0a\x2f\x45\x87\x1d\xb0\x91\x40\x0a\xaa\xdd\x17\x37\x26\xce\x9e\x86\x80\xb4\x8d\x58\xf5\x3b\xf1\xa8\xa3\xd3\xdd\xf4\x8d\x7c\xdc\x85\x12\xe5\x89\x11\xcb\x4a\x10\xa1\x9e\x8f\x1a\xa9\x37\x51\x11\x66\x3c\xa2\x4b\x69\x51\x26\x00\xb3\x15\x48\x05\x93\x54\x2c\x71\x52\xfe\xa8\x63\x8e\x16\xdd\x93\x47\x1d\xa5\xf8\xd4\x1a\x38\xad\x82\x8c\x8a\xb4\x0a\xfc\x89\x53\xc0\x47\x9d\xac\x93\x8e\x4c\xbf\x15\xd8\xc6\x47\x39\xc4\xb0\xf2\x4f\xc2\x77\x19\x2d\x86\x7f\xea\x47\x2a\x26\x75\xc4\x8d\xd4\xa3\x46\xaa\x44\x28\x2c\xcb\xa3\xce\x83\xe6\xbb\x21\x0e\x8f\x3a\x87\x93\x0f\xce\x47\xec\xcc\x24\x63\x36\x63\x7b\xfc\xfd\x19\x46\x47\x21\x7f\x71\x7a\xb6\x40\xfb\x42\x70\x43\x75\xca\x6f\x85\x0f\x2b\xb0\x0c\x40\xee\x18\x7e\xd4\xbb\x9e\xea\xec\x5c\xd8\x01\xa4\x90\x1d\x73\xf7\x51\xef\x50\xf5\x7a\xd4\xfb\xc6\xcb\x3f\xea\x83\xd4\xe7\x10\x79\xe0\x50\xeb\x78\x0c\xa5\xcd\xa0\x8a\xc9\x14\x9a\xfe\xc3\xa9\x47\xc3\x99\x9f\x00\xc9\x69\xf0\x9e\xf0\x28\x8c\xaf\x56\x8f\x50\xa5\x47\x16\x11\xa8\x6c\x45\x50\xf4\x7a\x34\x5c\x25\x43\xaf\x18\xd9\xd8\x3c\x1a\xfc\xfb\x93\x8e\x7a\x20\xa1\xdc\xd1\xf0\x68\x90\x7a\x3c\x1a\xfb\x25\xd0\xb1\x10\x95\x5e\x9a\x04\xf7\xbf\x28\x27\x3f\xc2\xb6\x45\xfe\xc9\x10\xf3\x47\xd3\xe6\x8f\xf1\xb0\xdc\xea\x11\x11\xf7\x63\x95\x4f\x86\x3d\xd1\x3a\x32\xaa\x99\x3f\x64\x94\x24\x6a\xe1\x8f\x96\x9c\xec\x55\x92\x7a\xa4\x72\x99\xda\x8b\x73\x35\x14\xc3\x3a\x5e\x2f\xe3\x87\xc1\xbc\xd3\x6e\xf8\x99\x50\x65\x19\xc4\xe4\x51\x03\x29\xdd\xd4\xbf\xa9\x8a\x8c\x59\x20\xbb\xfa\x01\x04\x29\x91\x48\xa3\x91\x32\xd5\x84\x8c\x66\x45\x87\x76\x4d\xc2\xa5\x0d\x69\xb0\xbb\x59\xfe\xb5\x2d\x41\xbe\x10\xd3\x37\x01\x90\xcb\x06\xe9\x75\xe1\xab\x1f\x4d\x4c\xdc\x2d\x4a\xb4\xf6\x46\x4c\xa1\xc1\x16\xcf\x7c\x6e\x92\x0b\xcb\x1e\x0d\xa7\x6a\x14\x8a\x0c\x8e\x4f\xc0\x85\xe1\x23\xf7\xbc\x82\x37\xed\x4d\xc1\xf8\xb2\x7c\xea\xc5\xd3\x20\x34\xd9\xf2\xe2\x6c\xf3\xec\x79\x08\x45\xd7\x5c\xb2\xda\x3e\x9a\x8c\x6b\x23\x80\x25\x11\x91\x96\x00\xa9\x5b\x8e\x21\xb1\x67\x23\xa5\x72\x7a\x15\x63\x50\x99\x1c\x0f\xf5\xc1\xe9\x3f\x01\x27\xaf\x1f\x8f\x1c\xbe\xcb\x27\xe7\x23\x67\xb7\x60\x87\x25\xda\x4d\xff\xc6\x6b\x0f\xed\x66\x15\x30\xb8\x27\xf5\x68\x60\x4b\x1f\xd7\x06\xc5\xf6\x0d\xcb\xe2\xe3\xda\x54\x9b\xd6\xc7\x35\xc7\x24\x40\x2b\xfb\xfb\xc7\x75\xf5\x53\xf6\xb8\x76\xda\x1f\x9d\x7a\x5c\xbb\xd2\xab\xc7\x75\x90\xdb\x1e\x1f\x07\xdd\x31\x96\xb8\x4e\x80\x90\xe3\xcc\x47\xf8\x8e\xc7\xa1\xb5\xc3\x60\xdd\x32\xc2\xe6\x3e\x0e\xd6\xad\xc2\x17\x81\x76\x2b\x6b\x94\x60\xdb\x77\xc4\x9e\x10\x99\x4b\x82\xa6\x13\x6c\x45\x9d\x92\xbc\x87\xa4\x0f\x76\x2b\x51\x5c\x94\x8d\x89\x26\xc5\x35\x19\x4c\x52\x5e\x0d\x9a\xe2\x0a\x72\xfc\x47\x3b\x7b\xd2\x5d\xe0\x2a\xf2\x47\xab\x31\xdd\x7c\xb4\x2b\x19\xbc\x76\xb5\xb2\x45\x88\x37\xe7\x0f\x28\x06\x04\x9f\x07\xde\x65\xd9\x11\x3c\x32\xcc\x24\xb2\x81\x1d\xc9\x60\xbf\x15\x23\x8a\xb5\x8f\xb6\x0b\x5b\x87\x85\x46\x50\x5c\x24\xb9\x0c\x78\x98\x7f\x64\xc3\xf4\x88\x65\x8f\x3e\xca\x7b\xcc\xd6\xf8\xa5\xec\x51\x1f\x31\x11\x37\x24\x71\x06\x24\x10\xb2\x05\xa8\xd1\x7c\xba\x07\xea\xd1\xf6\xbd\x7a\xb4\xbd\xd7\x59\xb3\x64\x59\x8b\x4b\xe7\x47\x8b\x23\x37\x4f\x03\x5b\x9a\xbc\xfa\x91\x7b\x84\x1e\xfa\x44\x14\x22\x8b\x7c\x8b\x0c\x70\x68\x8f\xdc\x1c\x4f\xc9\x23\x3f\x96\x16\xf5\x68\xa5\xf7\xad\xf7\x81\xaf\xe3\x02\xce\x47\xeb\x23\xf7\xab\x3e\xb6\xe5\xdd\xfa\x1c\x66\x55\x73\xee\xd1\xfa\x23\x47\x96\x8f\x72\xe9\xb0\x7a\xb4\x32\x89\x09\x67\x9f\x03\xce\x92\xe5\x24\xea\xd1\x46\x22\xfc\x1d\x8e\x07\x11\xbc\x3d\xda\x54\x6f\x0a\x7f\xb4\x19\x4d\x86\x0a\x69\x6f\x21\x2b\x32\x52\xd1\xcd\x2d\x02\x64\x96\xe1\x86\x98\x93\xed\x47\x3b\xfd\x8d\xb5\xe4\x4d\x67\xcb\xf9\xce\x4e\x3d\xe2\x05\xa2\x67\x48\x39\xbd\xb1\x1d\x6b\x14\x3c\x45\xa7\x81\xbe\x76\x10\x08\x6d\xe2\xea\xbf\xb9\x01\xda\x8a\x15\x50\x3e\xdd\xe9\xc3\x5a\xdb\x48\xca\x41\x7c\xc0\x3e\x3a\x33\x90\x81\x89\x4e\x7e\xcb\x42\xef\xb8\x44\x99\x3e\x70\x6f\xc8\xff\xd4\xa3\x0b\x88\xea\xa4\x80\xb0\xe7\xfb\x5d\xc1\xd8\x5f\x60\xca\x46\x53\x4e\xf1\x1b\xc3\x37\x39\x3c\x0c\x0b\x6d\x0e\x4b\xd3\x71\xf1\xa6\x56\x8f\xa2\xb4\xf7\x18\x56\x1b\xcf\xa6\xe9\x31\x88\xab\xbc\xc7\xb0\x4a\xfc\x45\xbb\x85\x4e\x78\xf4\xc7\xd0\x6d\x4d\x24\x5e\x63\x58\xf6\x0b\x85\x0f\x18\xe9\x3c\x06\xb3\xdd\x42\x86\x83\xb8\x28\x6f\xf3\x39\x0c\x94\x38\x38\xf9\x83\xe3\x0b\x00\xb6\x30\x8f\x81\x3d\xb2\x27\x9a\xd9\x64\x01\xb1\xfb\xa3\xb1\xc2\x9b\x86\x01\x7e\x47\x78\xe5\x9b\xf0\x46\x81\x21\x84\x7d\x63\xfd\xdb\x39\xbd\x5a\xd9\x86\x6c\x2a\xa4\xdd\xf0\x55\x07\xa9\x02\xca\x53\xd0\x14\x46\x42\x18\x0d\xf5\x11\xcb\x1e\x79\x29\x1a\x95\x8f\xc1\xa3\x61\xf8\x18\x3c\x26\xcf\xbc\xcc\x67\xd3\xd5\x2f\x45\xdd\xc6\xb2\x00\x87\xfa\x6a\xa9\x5e\xf0\xdc\xf5\xf5\x18\x38\xd0\x78\x0c\x90\x87\xb0\x95\xfa\x6c\x6b\x7b\x32\x6b\x42\x0c\x2b\xc1\xb3\x66\x4b\x0f\xc2\x44\x90\x14\xaa\x94\x5c\x49\x47\x7f\x00\xa3\x10\xae\xea\x23\x57\x42\x52\x5b\xc0\xb2\x38\x16\x7e\x64\x4f\xef\x61\x4a\x42\x96\x4e\xc5\xce\xb6\x56\xe8\xbd\x66\x59\xa6\x45\xc8\x65\xc5\x84\x0e\xf9\x98\x19\x9f\x08\xef\xe5\xab\x8a\x93\xd5\xbb\xa9\xfe\x3d\xc2\x6c\x90\x7d\xa7\xf9\x04\xd9\x88\x3f\x06\xb9\x2f\xf9\x31\xea\xe5\x50\x7a\x1d\xbc\xf0\x80\x51\x77\x88\xfa\x1e\x23\x27\xc7\x8f\xcc\x6c\xf5\x18\x11\x39\x0e\x40\x69\x38\x2c\x5e\x1d\xb1\xc4\x28\x8b\x3a\xdb\x25\x9c\x55\xd4\xf9\x4d\xfe\xcd\x25\xca\x6c\x8f\x27\x67\x11\x8f\xd1\x24\xae\x5d\x11\x84\x27\x51\xb8\x6f\x00\x99\x23\xa8\xb0\xa5\xa2\xfa\xf0\x28\xcc\x9c\x14\x80\x64\x87\xc7\x2d\x17\xb1\x01\xe0\x28\x38\x25\x11\xbd\x92\x8a\x89\xa0\xfd\x11\xad\x58\xbb\xb5\xfc\xcb\x1d\x98\xa8\x59\x83\x4a\x99\x18\x1f\x54\x40\x8b\x70\x1a\xc3\x70\x88\x76\x67\x66\xdc\x99\x22\xe8\xf4\xed\x1d\x3f\x88\xa7\xa4\xc7\x18\x96\xd8\x20\xf2\x61\x01\xbf\x3a\x8f\x31\xe0\x7b\x5b\xe2\x08\x7a\x1f\x63\x90\x7b\x79\xf6\x24\x8c\xdb\x92\xb1\x8c\x7a\x14\xb7\x48\xc1\x75\x27\x6c\x2f\x0b\x65\x0c\x22\xfc\xd7\x33\x2c\x70\x2a\xa3\x11\x43\xda\x88\x12\x37\x19\x37\xe2\x00\xe4\x11\x45\xd8\x13\xa8\x2c\x6c\x2c\xdd\x5f\x06\x7c\x1d\x82\xe2\x9f\xfd\x31\x8a\xb9\xfb\x63\x3c\x78\x76\xd8\x8f\xf1\x10\x68\xa0\xe9\x3b\x87\x02\xa1\x3b\xa1\x52\x83\xc2\xda\xc7\x45\x87\xc9\xaa\x47\xec\x1d\x93\x00\xaf\xd5\x63\xe1\xe4\xed\xb1\xe0\xb8\x7a\x38\x09\x3e\x1f\x8b\xef\x0c\x2f\x2d\x1e\x2b\x2f\xd7\xa8\x5b\xf1\x09\x95\x80\xc7\x12\x91\x40\x88\x49\x24\x38\x1a\x4b\x2c\xfd\x25\x86\x01\x8e\xa2\xc0\xe3\xf0\x9e\xc8\xa6\xa0\x24\x8d\x49\xa5\x7a\x14\xab\x55\xee\xf5\x10\x54\x48\x4c\x39\x8c\x38\x29\x7e\x3c\x38\x0d\x83\x31\x7d\x8d\x5d\xa1\x87\xaa\x52\x71\xd5\x2d\xfe\xb3\x0a\x74\x4b\x79\x88\xd9\xf4\x9a\x6c\x32\xf0\xa7\x3f\x50\x1a\x19\xa6\x3f\x20\x55\xd3\xdf\x21\x5b\xd3\x77\x61\x10\xa6\xef\xae\x1b\x35\xa9\xdf\x33\x24\xee\x1f\x5f\x8d\x17\x13\xd4\xbf\x56\x7f\x3d\x7f\xb5\x5e\xfd\xb5\x68\xd3\xed\x1c\x47\xf4\x7f\x45\xfd\x2b\xab\xbf\x16\xc3\x42\x01\x14\xa2\xf9\x57\x9c\x3d\x8b\x08\xed\xaf\xf4\xd2\x5f\x8b\x49\xdc\x7d\xfd\xd7\x62\x0e\x51\x4b\x76\x94\x9f\xd5\x5f\x8b\xf5\x14\x67\xfd\x6f\x94\x03\x16\x37\x84\xa9\x8c\xb8\x47\x57\x7f\x2d\xd3\x37\xc4\x68\x40\xd4\x33\x9e\xb4\x5e\xce\x16\x39\xa2\x01\x24\x11\x09\xf3\x51\x3d\x69\xa4\x54\x51\xa0\xaf\x52\xf1\x27\x94\xf6\x9e\xf4\xca\x2e\x83\x18\x5e\x3c\xe9\x15\x26\xc8\x47\xad\x9e\x74\xb7\xc2\xc3\x9c\x99\x2d\x46\x9b\xc9\xd4\xad\xea\x75\xee\x4f\xba\xeb\x2c\x71\x0e\x5e\xe5\x0f\xce\xe5\x9e\x74\xe7\x36\xa2\x0c\x04\xc7\x61\x57\x76\xc5\x3f\xef\x7c\xda\x93\xee\x0a\x0a\x90\x4f\xda\xb0\xba\x48\x35\xb9\x67\x46\xf8\xda\x27\xdd\x0f\x65\xc7\xc8\x78\xd2\x08\x78\x9f\x34\xe5\x5b\xd7\x49\xe8\x78\x25\x1b\x00\x09\xa3\xfc\x95\x87\x93\x55\x35\x91\x9d\xb5\x82\x48\x7b\x3d\xe9\x37\xf5\xa4\xdf\xf4\x86\x90\xb7\xcb\x11\xcf\x93\x7e\xc3\xe5\x8b\x54\x54\x30\x23\xd8\xa6\x8c\x9a\x47\xdf\x8a\x7a\xd2\x9b\x90\x0e\x02\x72\x60\x03\x6c\x77\xbc\x78\x93\xe1\xa7\x9e\xb4\x5b\x27\xf5\xa4\xc7\xd9\x07\x3c\xc0\xf1\xc8\xa8\x45\x89\xb1\x16\x29\x91\x9a\xba\x61\xd3\xea\x5b\x2a\xc7\xb0\xc0\x11\x9a\x3e\x34\xbc\xbc\xa3\x9e\x4d\x5e\xcd\xe9\xcb\x9b\x1e\xc1\x10\x03\xf1\x42\xf1\xcb\x08\x34\x89\x46\x1e\x2d\x63\xf7\x09\x23\x4c\x44\x3b\x4f\xd5\xd2\x51\xc0\xe9\xc9\x20\x35\xc4\x34\x04\xb0\xc4\xeb\xa6\x94\x38\xfd\x51\x1f\xf5\x5c\x00\xf9\xa4\x7d\x87\x16\x87\x7a\x12\x45\x14\x01\x83\x14\xc8\xfd\x8a\x00\x18\xcc\x27\xed\xc3\x1b\xfe\x65\x48\xc8\x47\x2b\xad\xe5\x45\xc3\xfc\x49\x87\x42\xef\x71\x7e\xf3\x84\xc9\x3e\x95\x65\x79\x93\x90\xf7\xa4\x4d\x25\x6b\x4f\x3a\x6d\x51\xa3\x7e\x12\xff\xe8\xc7\x96\xc4\xd9\x8a\x7a\xe2\xc3\xad\xe6\xc3\xf2\x10\xf8\x8e\x9c\xa5\xc9\xca\x26\x6c\xd7\x40\x06\x49\x64\x28\xec\xa4\x4c\x6e\x72\x7a\xd2\x3b\xbb\x03\x07\xdd\xcb\x38\xd9\x3b\x5e\x73\xe0\xe6\x78\x01\x85\xa2\x0e\x30\x22\x4f\x5a\x06\xf1\x51\xaf\xed\x88\x51\xc4\xb8\x44\xa7\x43\x3d\x19\xdd\xa9\x27\x5c\x70\x66\xf1\x44\xf8\x64\x10\x41\x41\xe0\x9f\xcc\x0a\xde\xe8\xc9\x74\xba\xf6\x80\xe1\x32\xf2\x27\xd3\xb1\x67\xe4\xef\xaa\x7d\xf2\x74\xba\x84\xe9\xc9\x74\xa1\xe4\xe9\x9b\x7a\x42\x0f\x82\x40\x0a\x31\xc9\x48\x98\xd4\x93\xe9\xb5\x94\x23\xcd\x6c\xfa\xe9\xdf\xd4\x93\x19\x20\x20\x4f\x48\x4b\x9f\x9a\x19\xdf\x93\xb8\x11\x97\x50\x38\x6d\xc1\x28\x03\xe0\x45\xab\xb6\xe2\xb1\xfd\x57\x1d\xd6\x3c\x19\xcb\x5b\xed\xc8\xd6\xf4\xc9\xe0\x9a\x99\xf9\x22\xb6\x99\x44\xe5\x35\x80\xd9\x45\x34\x83\x78\x41\x27\x19\x6e\xfe\x1d\x91\x02\xd1\xf1\x02\xd4\xd6\xb0\x98\x92\xd7\x5a\x48\xb1\xc9\xf6\x54\xfe\xe4\xf2\x18\x8c\x93\x03\x81\xef\x49\xb9\xad\x91\x4f\x66\x64\xda\x71\xc1\x04\x65\x8d\x34\x1f\x35\xf5\x9a\x3b\x84\x9e\x9a\x21\xdd\x93\xc1\xd4\x89\xe4\xfa\xb8\x87\xff\x78\x62\x13\x5e\x81\x3c\xb5\x0b\xf6\x48\xbd\xfc\x51\x82\xaa\x51\xf8\x64\x7e\x2b\x32\x14\x45\x40\xfe\x84\x46\x26\x23\xda\x20\xc0\xd7\x2b\xde\x9c\x11\x2c\x3f\x99\x7c\xe4\xf8\xf7\xc9\x94\x8d\x2e\x6b\xf5\x24\x4b\xff\x53\x75\xa7\xf2\x64\x76\x58\x89\x02\xc4\xf7\xe0\x93\xf9\x22\xaf\xe7\xa8\xe0\xc9\x1c\x46\xed\xa7\xbf\xef\xa5\x90\x03\x3b\x86\x27\x73\x68\xf5\x3b\x78\xae\xab\x52\x4f\xe6\xe8\xed\x8a\x26\x40\xfa\x34\x68\x18\xbf\xa7\x01\x2d\x85\x27\xbb\x94\x9b\x73\x9e\xec\x12\x1e\xef\x49\x76\x5a\x76\x76\x06\x4f\x13\xd4\x93\x5d\xad\xc2\x12\xad\xa2\x27\x58\x90\x27\xe9\xf4\xda\xe7\x27\x98\xde\x11\x06\x6a\xdd\x81\x91\x22\xd5\x66\x81\x7b\xe2\x58\x43\xd2\x64\x44\xda\x15\x85\xa1\x0d\x06\x60\x29\x78\xb2\x62\x44\xf6\x64\xbb\xd4\x21\x7a\x7a\xe2\x58\xcb\x55\x10\x05\x48\x44\x06\x24\x47\x59\x44\x68\x20\xbb\x86\xc6\xd9\x9e\x43\xe9\x27\xdb\x2f\x0f\x44\x7a\xea\x83\x3c\xf8\xc9\x6e\x36\xa2\x46\xf6\x64\x37\x0c\x68\x5b\xdf\xe1\xdb\xd7\x72\xc4\xfb\x64\x21\x3e\x16\xc5\x0d\x93\x05\xf9\xa2\x9e\xac\x50\x05\xa6\xd9\xd2\x22\x2d\x50\x4f\x36\xf7\x52\x4c\x6e\x4b\x40\xbd\xd2\xe3\xc9\xee\x34\x4f\xef\x2c\x76\x0e\x4f\x76\x17\x5c\xe6\xc1\xe3\x51\x88\x8b\x3d\x1e\x83\x7a\x0a\x5a\x0e\xd2\x9e\xc2\x52\x3d\x85\x25\xc3\x2c\x2c\x97\x11\xb3\x5c\x30\xb9\x8e\xaa\xc1\x9a\x4b\xcc\xd6\x9f\x42\x33\x98\x27\x85\xce\x26\x6c\xc5\xd8\x06\x51\xdf\x07\xb6\x28\x36\x92\x4f\xf8\xf6\x51\x4f\xf0\x12\x4f\x81\x11\x16\x3a\xdb\x53\xc5\xd0\xd9\xe0\xeb\x7a\x16\x3a\xfc\x31\x9b\xf4\x8e\x1d\x05\x9b\xfe\xf8\x7f\xa1\xb3\x73\xbd\x34\x47\xfd\xe7\x94\x5a\x22\x2e\xa7\x9f\x70\xfe\x53\x43\xe9\x3e\x94\xbf\x28\xd1\x6c\x62\x8b\xc3\x9d\x3e\x85\x1e\x25\x68\xca\xe0\x6e\xbd\x06\xa4\xba\xfd\x60\x09\x65\x55\x0f\x7d\xca\x10\x28\xfc\xfe\x3c\xa1\xfa\x45\x50\xe9\x47\x18\xf6\x92\xc3\xee\x58\x42\xf9\xfb\x4d\x3e\xa7\x9d\x71\x3d\x05\xd7\x4d\x5f\x49\x76\x6b\xea\xc6\xc6\x9a\x82\xe0\x7d\xd3\x09\xca\xfb\x46\xdd\x7b\xae\xfa\xc3\xc3\x02\xcc\xa2\x40\xe8\x79\x85\xfa\x84\x54\xb7\x26\x3f\x23\x87\x3f\x45\x6c\x7b\xbc\x8a\x15\x41\xdf\x99\x04\xb6\x51\x4e\x35\x8f\x01\x15\xcc\x7e\x5e\xac\xf6\x14\x46\x59\x9b\x42\x53\x56\x7e\x42\x67\x4c\x68\x08\xeb\x57\x10\x4e\x82\x90\x51\xa0\x9e\x18\x90\xc8\x5a\x9e\x82\x5c\xd2\x01\x68\xac\x80\x60\x25\xf6\x83\x64\xf0\x72\x48\x25\x58\x8f\xe3\x8b\x9a\x98\x72\xed\xfd\x64\xfc\x0e\xe2\x26\xa9\xbc\x09\xcb\x11\x36\xa7\x4f\xa1\xba\xe8\x7a\x0a\x4c\x23\xb9\x63\x0a\xd4\xf6\x3e\xf0\x05\xe2\xac\xa4\xc1\xba\x45\x01\x0f\x7b\x92\x68\x36\x34\x6a\xaa\xae\x0e\xf8\x26\xec\x84\x60\x85\xaa\xe4\xf7\x84\xe6\x17\x01\x0c\x04\x4f\xe6\x61\x8b\xeb\x20\xc1\x62\xad\x58\x1e\x64\x8b\xf5\x14\x72\x5e\x86\x77\x6c\xfa\xf7\x86\xd5\xe7\x8a\x58\x2e\x3f\x85\x22\x5c\x46\x28\xa3\xe9\x8b\x68\x88\x3d\xe1\x9c\x4a\x80\xb4\x10\xd3\x56\x97\x86\x50\x00\xf7\x24\xc9\x13\x5f\x08\x68\xf3\x3d\xf9\xe4\xe6\x90\xa7\xf0\xe5\xd4\x7a\xfc\xc1\xb1\xfc\x53\xc0\xd2\xec\x49\xec\xf3\x18\x0c\x08\x4f\xe8\x12\x48\x78\x38\xe2\x6c\x03\xca\x59\x96\x36\x10\x0a\xa1\x2f\x1d\x42\x81\xa7\xd2\xe9\xe6\x03\xe7\x89\x0b\x97\x99\x59\x05\x41\xfb\x53\xe9\x1c\x1a\x07\x4f\xa5\x0b\xae\x81\xed\x70\x82\x56\x3d\x15\xd3\xee\x0a\x7d\x2a\xc6\x0b\x29\x2b\x43\x1d\xb6\xa5\x0e\xf1\x62\x8f\xea\xa9\x38\x5c\x41\x64\xf5\x84\x77\x6b\x69\x62\xb6\x20\xbc\x37\x70\x44\xaf\x9e\xca\x76\x4b\xd0\x08\x49\x49\x90\x3e\x61\x50\x4f\x9e\x07\x25\xc6\xfb\xd2\xd0\xf8\x5d\x1a\x4b\xaa\x9e\xdb\xb1\xf3\x53\xe1\xcc\xed\x09\x4d\xed\x27\x64\x8f\xbd\x7a\x3a\x74\x27\x08\xd5\x39\x18\xf5\x24\xe7\xc9\x09\x48\x13\x1e\x76\x78\xd1\xf0\xea\xe9\xa8\xd1\xb3\x53\x4f\x47\xd3\xb5\xc2\xa6\xaf\x0c\xed\xe9\x6b\xe5\x46\xa7\xaf\x6c\xef\x9e\xa6\xdf\xd3\xf4\xbb\x7c\xd6\xf4\x6d\xe9\x64\x5f\xf4\x34\x7d\x63\x81\x9d\xbe\xed\x20\x24\xd3\x1f\x50\x2c\xb9\xd4\x19\xc2\x31\x7d\x67\x1f\xf2\x34\x7d\xaf\x43\x6f\xfa\xde\xc6\xe0\xf4\x03\xc3\x58\xf5\x24\xca\x4f\xea\xe9\xdd\xa9\xce\x42\xeb\x4e\x02\x9d\xad\x5a\xe8\x25\x5c\xc4\x42\xdc\x8b\xb0\x24\x2c\x44\x7b\xa9\x0b\x82\x48\xe8\xd8\x1d\x2f\xf4\x2a\x6a\x4e\x12\x49\xea\xcc\x12\xa9\xfe\x42\x77\x61\x57\xc5\x46\x0b\x5d\x57\xf8\x85\x5e\x8b\xd4\x69\xc1\x61\x1a\x01\x62\x9a\x85\xc6\xf6\x66\xa1\xfb\xe9\x6b\xaa\x8e\x2c\xfe\x84\xcf\x3e\xb8\x9d\x56\x0b\x3d\xf0\x93\x59\xb6\xd0\x83\x27\xcb\xc0\xab\x6c\x77\x72\x63\xb6\xd0\x56\xac\xef\x80\xfc\x8d\x02\x3d\x80\x6f\x5d\x60\xd4\x26\xb2\x0e\x30\xde\x65\x7d\x9e\x9d\x2e\xaa\xa8\x31\xf1\x5d\xd2\xf0\x26\x96\xac\x91\x17\xeb\x57\x1c\x91\x2e\xc4\xf4\x8d\x90\x17\xbf\xb5\x17\xdb\x37\xb5\x10\xdd\x29\xc2\x8d\x5d\xa2\xae\x01\x7a\xd0\x31\xc8\x5f\x56\xbc\x83\x2d\xf4\x26\x1e\x1c\xf1\x64\xbc\x44\x0b\x17\x52\x2c\xb4\x08\x93\x2a\x98\x9d\x0f\x66\x19\x5c\xa7\xcd\x7a\xfd\x3f\x24\xf5\x7d\x4d\xb2\x18\x4d\x80\x25\x89\x23\x67\x69\x70\xf6\xab\x41\x5e\xb9\xd0\x9c\x79\xcb\x3b\x5c\x67\x77\xd2\x08\x48\x87\x16\xda\x31\xf7\x88\xf5\x5a\xfa\xcf\x89\x57\xd1\x05\xaa\x5b\x35\x7a\x6a\x22\x07\xf7\xbf\xd0\x6e\x9c\x5d\x1c\xa4\x1f\x9d\x08\x4e\xa5\xa4\xd1\x44\xcc\x17\x17\xd3\x57\x0e\x16\x8f\x92\x14\x24\xb7\x98\x30\x0b\xc2\xe0\x5c\x68\x47\x93\x39\x16\x75\xc0\x69\x1d\x58\x68\x57\xde\x06\x81\x07\x3c\x2e\x2f\xb4\x3b\xb6\x61\x37\xf2\xe3\xfc\x13\x40\x8d\xc6\x50\x15\xce\x4f\x98\xe5\xf0\x61\xc1\x91\xa7\x95\xbf\x59\xa6\x2b\x80\x3c\x2c\x34\xe6\x02\xe9\x04\xeb\x7f\xa4\xb4\x6c\x78\xff\xa1\x2c\x22\xa7\xba\x57\x4b\x7d\xa9\x22\x17\x69\x91\xc0\xaa\x0a\x1c\x8a\x24\xee\xd9\xf0\x2e\x10\xd2\xb0\xbb\x5b\x68\x9f\xa5\x8c\xac\xf1\xcc\xf4\x13\xc5\xd4\x03\xdc\x70\xa7\xab\x14\x90\x4d\x7b\xb1\xcf\x36\x15\x5a\xd1\x63\xaa\xb3\xe0\xd0\x94\x81\xe0\x79\xff\x76\xa8\x75\xda\x86\x28\xe5\x72\x6f\x25\x60\x39\x04\x3a\x22\x2e\xa9\x04\x0a\xc0\xb4\x65\x7c\x33\x18\xb5\x2d\x74\x44\xd8\xb1\xe0\xde\x2c\x52\x71\xa1\x25\x63\x22\x46\x4d\x99\x31\x86\x3a\x33\xd1\x02\x60\x88\xc5\xcc\x35\x95\x0b\x1d\xeb\x8b\xc5\xfa\x6a\x81\xe5\x2d\x1f\x9c\xb2\xfc\x87\xec\x62\x51\x87\x16\x7c\x58\x1e\xf6\xda\xf2\x2a\xdc\xa2\x2f\x74\x59\x99\x8e\xbf\x90\x4d\x2c\x38\x43\xec\xa8\x6c\x39\x35\x56\x91\x3b\xfd\x17\x5a\x98\xea\x85\x2e\x3b\xb4\xed\x17\x5c\xa3\x48\xa1\x3b\x5c\xdc\x4b\x9c\xca\xb0\x8f\x94\x90\xaa\xee\x90\xbd\x09\xec\xfd\x41\xa0\x93\x27\xd0\x3f\x79\xe3\x15\xbb\x80\x6b\x0b\x9e\xdb\xb3\x9f\x5f\xe8\xbd\xc8\x56\x80\xe2\x3d\x6f\xa1\xbf\xd4\xe9\x74\x70\x21\xaa\xc5\x4a\x94\x22\x17\x2b\x9d\xd9\x24\x40\x25\x56\xa6\x0f\x5c\x9c\xb5\x58\xe1\x68\x56\x2d\x56\x03\x0e\xd9\x57\xc3\x68\xbb\x2c\xb1\xa5\x95\x63\x45\xd0\x95\x78\x65\x02\x33\xeb\x3f\x21\xb8\xbe\xde\xdb\x71\xdb\x12\xde\xff\xda\x9a\x9a\x12\x5b\xc2\x7a\x98\x7e\x2f\xd9\x49\xa2\xdd\xb4\x97\xe5\xa3\xc4\xd1\x37\xac\x09\xa5\xe5\xae\xca\x73\x8b\xd5\xb0\x3a\x9a\x0e\x51\x1c\xff\xb2\xf2\xd4\xff\xcd\x4a\x94\x71\x48\xc3\xa3\x8a\xc0\xfa\xc7\xa9\x02\x82\x24\x49\xb2\x9d\x11\xad\xec\x8a\xb7\x0b\xaf\x88\x38\xa9\x0d\x87\xa2\x27\x28\x4b\x59\x8d\xd4\xfa\xa3\xcd\x26\xd0\x33\xd3\x81\x15\xc4\x25\x4b\xa4\x60\xeb\x75\xcb\xd2\xea\x6e\x90\x1f\xf6\x92\xb2\xb7\xd5\xb1\xf1\x62\x35\xb0\xaf\x20\xcd\xae\xd7\xad\x19\xad\x43\xc9\x48\xba\x89\x48\xf7\xfe\xf1\x9c\xd2\x9c\x90\x56\x92\xc5\x55\xaf\x24\xf9\xce\x0c\x72\x9f\x42\x8b\x9d\xb2\x32\x5f\x56\x83\xdd\xda\x7c\x3c\x48\xc3\x71\x1e\x53\xff\xa2\x43\x5b\x0b\xb2\xc3\x68\x6d\x6f\xb1\x2a\x6f\xc5\x6f\x42\x14\xf9\xea\x9f\xf0\x3d\xb8\x9c\x39\x34\x98\x71\x4a\xe7\x4e\xa9\xad\x1f\x1c\x5e\x74\xa4\xb8\x76\x20\x00\x16\x32\xca\xa2\x92\x38\xfd\xbe\x5d\x9b\xc8\x51\xad\xec\xa2\x6b\xda\x77\x39\x15\x14\xf4\x47\x2b\x67\xd4\x0e\x5d\x42\x08\x23\xe7\x5b\x78\xe4\x22\xd5\x76\x0d\xd4\x16\x1e\xd9\xb3\x35\x44\x86\xe7\xc8\x29\x51\x85\xf2\xf9\xde\x98\x5d\x08\xbd\xa4\x79\xaa\x26\x85\xfb\xd6\xd1\xde\x6c\xa5\x2b\xd8\xc6\xb4\x7f\xec\xd1\x21\x21\x58\xac\x06\xac\xa2\x01\x5d\xed\xa6\x80\x09\x51\xac\xff\x18\x0f\x43\x22\xe8\xda\xca\x1a\xb1\x1a\x42\xbb\x42\x8e\xc4\xb6\x35\x10\xb4\x75\x47\x70\x52\x9f\x30\x2e\x4b\xec\x65\xc4\x84\xfa\xee\x10\xc3\x7b\x67\x60\x9f\xb7\xc1\x68\x36\xb5\x77\xd7\x8f\x09\xa7\xda\x85\x92\x96\x41\x6a\x13\x75\x7b\x41\xd4\x7e\xd3\x60\x9d\x3a\x51\x6e\x46\x21\xa5\x8d\xcc\x28\x4b\x36\x9e\xd5\xcd\xe9\x99\xd0\x26\x42\xac\x32\xd9\x8a\xb5\x77\x54\x87\x57\x20\x4d\xa6\x02\xda\x21\x21\x00\x31\x39\x6f\x4d\xfd\x94\x62\x31\x25\x91\x06\x29\x2c\x80\xd2\x41\xc5\x65\x96\x4e\xae\x63\xa2\xcf\x4f\xe8\xe6\xf4\xe7\xf1\x04\x67\x67\x76\xfb\x67\x5c\x4c\x50\xca\x29\xff\xd1\xcc\xe6\x01\xc5\x0e\xc9\xd1\x1e\x3a\x9a\x13\xac\x15\x45\x6e\xd8\xea\x3c\x8a\x6a\xd2\x0c\x07\x67\x50\xe7\x93\x33\x1b\x72\xd5\x0c\x29\xe1\xc0\x49\xb0\xd3\x77\x8a\x4f\x3f\xfe\xdc\xeb\x65\x05\xe8\x37\x08\xe2\xdb\x47\xec\x35\x5e\xfc\x2a\xf2\x0e\xdf\x9f\x8a\xc7\xd6\x3f\x7b\xf1\x8a\x20\x08\x42\x86\xd6\x68\x7b\x63\xfd\x1a\x71\xe5\x29\xd2\x86\xe4\xde\x70\xc4\x21\x75\xd8\x1b\x7f\x7a\x27\xd2\x98\x53\x9d\x25\xd2\x68\xc2\x7e\xfa\xbd\x8f\x48\xb0\xe4\xa5\x5c\x80\x1b\xdf\x91\xa1\x51\x92\xe9\xbb\x7f\x77\xf6\xcd\x7f\xdf\x45\x63\xbc\xa1\xa7\x91\x0a\xbb\xda\xc6\x22\xce\xf3\x6a\xd2\xf4\xbf\xd5\xa4\xb0\xe5\x38\x7b\xb1\x0a\x31\x8b\x4c\xaf\xe2\xf5\x72\xcc\xc5\x2a\xe4\x56\x48\xfd\xde\xe6\xf7\x0d\x04\xc9\x62\x83\x2c\x27\x86\x9b\x2b\x93\x40\x09\x51\x5b\x58\x98\x25\x17\xca\xc1\x13\x33\x32\xfb\x0a\xb2\x5a\x18\x59\x74\x69\x3a\xad\x16\x78\x37\x27\xaf\x71\x07\xc2\xda\x12\xc8\x00\x25\x84\x4b\x15\x3f\x9e\x0b\xee\x47\x5d\x98\x9e\x3b\xc4\x57\xa4\x45\x93\x35\xd3\x15\xfd\x6e\x34\x46\x17\xb0\xc3\x92\xd1\xb6\xb7\x40\x8e\xd6\x5a\x68\x12\xf3\xdf\x63\xf1\xc8\x6a\x6b\x6c\xe7\x08\xd7\x2d\x5b\xef\x0c\xbe\xed\x11\x77\xb6\x28\x99\xde\x7c\xa8\x57\x2d\x2d\x8c\xf0\x10\x66\x63\x59\xd8\xcd\xa6\x54\xfe\xce\xb8\x65\xab\xab\xc3\x16\x1d\xd0\xcc\xb9\x17\xc6\x6d\x70\x74\xbd\x30\x08\x51\x1a\xe8\xa8\xa1\x1b\xc5\x2a\x76\x81\xc9\xa9\x5a\x98\x51\xd8\x5d\x73\xe2\x18\xcd\x68\x79\xcf\xc8\x65\x56\x0b\x06\x02\x31\xdf\x89\xf2\xce\x42\x76\x6b\x92\xde\x0f\xb0\xa2\xc6\xf7\x72\xaf\xef\x82\xfb\x0e\xdb\xf1\xee\x82\x8b\xf3\x09\xe4\x93\x9b\x56\xe0\xc2\xf8\xb0\x5f\xea\x68\x67\x17\x3a\xb2\xd9\x5a\x18\x9f\x51\x96\x55\x0b\xb3\xdd\xb2\xc7\x5f\x98\xed\xf4\x1f\x6e\x87\x5f\x8e\x9f\x28\x86\x36\xe2\xa9\x68\x81\xc3\x42\x32\xc5\xa5\x5c\xab\xb5\x30\xb1\x7b\x2b\x72\x0c\xbc\x30\xd1\x2c\x23\x2a\x32\x78\x3e\x5b\xa0\xa4\xd5\x53\x45\x1c\xb2\x03\xb8\xff\x8b\x4e\x8b\x9e\x92\xa3\x98\x12\x2f\x4c\xdc\xc1\x2e\x99\xb8\x9b\xfe\x00\xca\x0e\x6b\x81\xe7\xc4\x6f\x6a\x61\x9a\xb1\xe0\xc2\x88\xb1\x20\x80\x51\xd1\x28\x93\xd9\xad\x6c\x7b\x31\xe2\x56\xb5\x30\x07\xcf\x77\x0c\x5a\xe6\xc6\xa0\x2b\x8b\x38\xe8\xcd\x30\xc2\x71\xc9\x5f\xce\x85\x11\x38\x72\x07\xd6\x82\x1d\x3d\x41\x4f\x12\xad\xc5\x49\xa1\x3c\x1b\x08\x84\x4f\x1c\x74\xdc\x4a\x84\x65\x7f\xd0\x7b\xb5\xe0\x3a\x9d\xb8\xac\x8b\xda\x60\xe8\x53\xc2\xae\x02\x79\xb5\x5c\xe9\xb9\x80\xe5\x38\x10\x1b\x08\xec\x46\x80\xdc\xba\xb7\x90\x69\x2c\x57\x9b\x2c\x06\xb3\x1d\x0c\xd5\x1f\xcc\x16\xc9\xc0\xa2\x15\xce\x94\x16\x1e\x42\x80\xe5\x6c\x13\x64\x8f\x1d\xde\x09\x99\x89\x2e\xc7\x62\x30\x2c\xfa\x83\xd9\x99\xb7\x0d\x15\xb7\xfc\x96\x58\x4e\x2e\x06\xcb\x5b\xad\x5c\x0e\xb1\x40\x17\x86\x52\x6c\x23\xd3\x83\x1d\xf5\x06\x05\xab\xc5\x50\xaf\x01\x5c\x60\x5d\x4d\x40\x83\xd8\x6d\xad\xaa\xdd\x32\xc6\x49\x90\x8d\xd7\x60\xcb\xec\xd7\x96\x03\x91\xe3\x62\x18\x5b\x53\x04\x86\xb0\x7c\x3f\xd2\x82\xc5\x10\x8a\xdc\x57\xb4\x18\xca\xc6\xf1\x8e\x22\xb5\x2c\xd5\x01\x96\xd0\xc3\xa1\x1c\x64\x07\x3a\xec\x74\xcc\x22\xec\x59\x0c\x07\x3d\xaa\x85\x85\xbb\xd4\x40\x96\x39\x96\x6b\x9b\x80\xec\x2d\x70\x64\x16\x05\x70\xb6\xb3\xc0\xb1\xaf\x23\xda\x93\x29\x57\xac\xea\xec\x2e\xac\x19\x75\x3a\xd2\x26\xd6\x8c\x29\xd1\xe8\x78\x09\xc9\x7c\xa5\x6d\xc2\xeb\x5e\x2d\xda\x24\xb7\x7d\x6c\xbe\xa7\x17\x76\x13\x68\x96\x53\x43\x35\x2d\x99\x85\x75\x3b\x4c\x48\xa9\x12\x95\x94\x0f\x27\xdb\x88\x44\x33\xad\xa8\xdc\x38\xa2\xec\xb8\xc0\x31\x17\xb1\xe0\x67\xd5\xbd\x93\xe0\xab\x64\x57\x0d\x85\xef\xb7\xa7\xbc\xa2\xa0\xb4\xb0\xa3\x44\xa6\xff\x1e\x66\x67\x96\xa3\x65\x24\xb7\x24\xfc\xff\x18\xd6\xd6\xaf\xd8\x75\x83\x08\x05\xb4\xbe\x1f\x24\xc2\xd1\xda\x82\x43\x09\x62\xad\xde\x8d\x7f\x43\x53\x07\x52\x6d\x71\xa6\xcf\xe6\xa5\x5e\x6d\xba\xb0\x11\x3c\x86\x0e\xf1\x81\xcd\x43\x90\x81\x67\xb3\xb4\xd4\x4e\x77\x3a\xd1\x15\x76\xa7\xbd\x69\x18\xca\x47\xcc\x71\x34\xd0\x72\x6d\x48\x54\xee\xe4\xd5\x3b\x2e\x2a\x93\x21\x60\xbf\xc8\x5a\x6d\xe5\x98\x6a\xf1\x26\x97\x82\xb1\x08\x6e\xf4\x92\xa5\x8b\x5d\xbf\xfd\x2f\xb1\x37\x62\x2e\x20\x1e\xd9\xe8\xb8\x3c\xa8\xc5\xc6\xc8\x56\x74\x63\x98\x7b\x9b\xd0\x13\xe4\x9c\xea\x8a\xb1\x09\x85\x60\x47\xb1\x6a\xb1\x29\x6c\x75\x36\x3b\x1d\x19\x5e\x9b\x1d\x97\x45\xca\x8b\x05\x3d\x52\x21\xa7\x77\xd3\x1f\xe2\x55\x5d\x2d\x1c\xa4\x15\xaa\xe6\x4c\x41\x22\xbc\x40\x57\x4c\x18\x20\x17\x38\xcb\x5b\x38\x6c\x34\xbc\x5a\x38\xae\x5b\xf7\x6a\x31\x22\x5c\x5b\x8c\x4c\xe6\x1a\x37\xdd\x88\x1a\xed\x02\x0d\x15\xba\x74\x14\x21\xfe\x62\xb4\x51\x26\x08\x2e\xb2\xf6\xa4\x26\x94\xb7\xea\x89\xaf\x84\x98\xc1\x2d\x46\x71\x04\xae\x16\x63\xa0\xd1\x2b\xe7\xe9\x19\xd9\x80\xde\x46\x73\xda\xe7\xff\x8c\x1d\xfe\x1c\x3b\x80\xbf\x49\x45\x84\xf0\xfa\xb0\x97\xc5\x2c\xb0\x97\x14\x0f\x76\x8b\x20\xec\x47\x63\xef\x02\x77\x8a\xf6\x6a\x21\x2e\xf1\x25\x9e\xb2\x5a\x84\x3e\x48\x5d\xc3\x40\xfb\x06\x1c\x73\xaa\x45\x78\xc3\x14\x64\x11\x36\x7a\xa5\x16\xc8\xf4\x84\x9f\x10\x84\xe6\x7c\x67\x63\x83\x43\x5d\x53\x9e\x70\x95\x91\x0f\xe2\x7a\x71\x11\xda\xc5\x14\x0b\x6e\x94\x24\x75\x4c\xb8\xef\x13\xb9\xf9\x22\xb8\xe9\x9b\x5a\x20\x87\x4e\x15\x54\x4b\xa6\x76\x71\x7e\x05\x6b\x59\x79\x04\x45\x9d\x57\x5e\x3f\x8a\xa2\xd4\x22\x8c\xa1\x3f\x58\xe2\x91\xa6\xdc\x06\xa7\x16\x81\x83\x3a\xd2\x5a\xbd\xbc\xac\x56\xe8\x4a\x2f\x64\xe7\x8e\xef\x25\x8e\xff\x17\x61\xbb\x55\x8b\xb0\x8d\x95\x82\x61\x79\x23\x6b\x50\x88\xb5\x69\xf0\x41\xcd\xcc\x0b\x31\xc8\xde\x27\x24\xdf\x64\x62\x01\xe2\xc9\x76\x85\xf1\x11\x72\x18\xa9\x70\x69\x62\xaf\xb0\x37\x32\x56\xc3\xf4\x77\xb5\xd8\x6a\x8d\x28\x6b\xab\xf5\x81\x90\x2f\xde\x0a\x2b\xb2\xd5\x5c\xf9\x16\x89\xfa\xd0\x23\x26\xd8\xea\xb8\x49\x02\xaa\x3c\x6e\x8b\xde\x74\x05\xb2\x67\x04\x91\x0e\x06\xa1\x8f\xb7\x3a\xeb\xb5\xcc\xe8\x2d\xa7\x7f\xf5\x21\xa3\xe5\xa1\xba\xc9\xd8\x72\xe0\xbf\xd8\x9a\x5e\xd7\x83\x1e\xd0\x5e\x9f\x50\xf4\x80\x2a\x90\x4c\xb4\x0a\x30\x6a\x34\xde\xc1\x60\x82\x0c\x2f\x32\x7c\xef\xd6\xae\x24\x03\x57\xc3\x42\x69\x64\xe3\xbf\xb5\xed\x31\x8b\xf2\xcb\x62\xfb\x66\x5c\xe7\x35\x23\x7a\xfb\x56\xb2\x5a\x6c\x1d\x07\x52\xa8\xa4\xab\xc5\x36\x84\x9a\x37\xea\xbe\xf0\x6a\xda\x19\x8a\xb0\x8d\x50\x74\x38\x19\xec\x68\xd0\x0c\x4d\x75\x70\x6c\x63\x91\x3a\xc6\x22\xa6\xac\x0b\xcc\xc9\xf5\x92\x3e\x86\x99\x08\x5d\x70\x7f\x69\xd3\xe2\x14\x7f\x8f\x9a\xaa\x77\x00\x36\xea\x6e\xd0\x28\xe5\x2c\x20\x6c\xd1\xce\x3e\xa2\x53\x90\x4a\xc6\xbd\xe1\x42\xd4\x10\x2a\x49\x6b\x28\xbe\x1a\x16\xe2\xf0\x9d\x1b\xf0\x16\x91\xb3\xb3\xcc\xfd\xec\x8b\xac\x61\x35\xb3\xa6\x52\x59\x6a\x48\x58\x23\xcd\xca\x7e\x91\xab\xef\x32\x60\xd5\xf6\x5b\x64\xbd\x76\xa6\x90\x09\xa3\x14\x80\x84\x62\xc5\xba\xc8\x9a\xab\x77\x13\x09\x58\x1a\xc8\x00\xc5\x1e\x5f\x68\x47\xc6\xae\x80\xbf\xbc\x4d\x4e\x37\x92\xd8\x62\x22\xca\xe6\xaf\x96\x93\x7d\x62\xd6\x5b\xe1\xac\x05\xca\xfa\x0c\x46\xc7\x64\x1d\x97\xd3\xef\x6c\xcf\xb2\x10\xa9\x7c\x1a\x65\x59\x63\xe7\x22\x30\x74\x65\x29\xee\xbb\x88\xc8\x17\xc8\x06\x27\x6b\x74\xad\xdc\x3b\x32\x7b\xd6\x32\xa1\x49\x28\xec\x7a\xb3\x16\xcf\xed\x82\xb0\x65\x80\xe0\xcb\xaa\x9b\x8d\x66\x2b\x9c\xcd\x92\x33\x41\x41\x6a\xb2\xb4\x9c\xac\xc1\xf9\xc4\xc5\x57\x24\x09\xe2\x97\xa1\xfd\xe7\x21\xa4\xd9\xe0\x1f\x98\xa8\x94\x6e\xd6\x32\x3c\x05\x0a\x29\xca\x42\xcb\xe8\x9e\xca\xfd\x67\x33\x08\x51\xc9\xc6\xbe\x77\x81\xa9\x44\x5b\xce\xf5\x25\x44\xe5\xa0\xc6\xeb\x23\xd6\x0f\xd8\x79\x4a\xca\x26\x6a\x26\x1c\xe8\xe9\xf1\xb7\xb4\x91\xae\x37\x8e\xf3\xf7\x45\x36\x3e\x62\xb8\x07\x92\x49\xf6\xa8\x05\x2d\xb2\xa1\x0b\x77\xef\x88\xd4\x69\x2b\x7c\x64\x85\x52\xef\x2d\x1e\x4f\xde\x11\xe9\x25\x13\x9b\x04\x29\x9b\xd8\x4a\x2a\x1c\xa2\x2c\xb2\x91\xcd\x93\x80\x74\x82\xf5\x91\xbd\x86\x8f\xca\xe6\x20\x3e\x81\x17\x19\x63\xb4\xba\x02\x65\xdb\x75\x84\xd5\x70\x0c\x04\xbb\xcb\x45\xb6\xeb\x16\xaf\x83\xc4\x22\xc9\x24\xdf\xf1\xe7\xbe\x90\x33\xb9\x4e\x4b\x6e\x9c\x60\x32\xb2\xc2\x6a\xd3\x7a\x46\xb0\xc3\x29\x9b\xa4\xbc\x9d\xfa\x20\x6c\xa4\xcc\xe0\x68\x1d\x6c\xb9\x25\x94\xa1\x89\xad\xc3\x02\x6b\xa5\xf7\x68\xed\x0b\xd1\x03\x06\x6c\xc9\x14\x47\x42\xb1\x8f\x5d\xe4\x70\x1a\x51\x78\xc1\x24\x1d\x5a\x92\x83\xec\x14\x73\xd8\x09\x6b\x97\xa3\x5e\xd5\xa6\xe5\x96\x0c\x01\x7e\xd5\x6e\x74\x90\x48\xcd\x23\x3d\x09\xa8\x70\x4f\x68\xa4\x69\xa3\xec\xfe\x40\x90\x17\xe5\x68\x0d\x9c\x87\x60\xbd\xfc\x5f\x05\xe3\x39\x06\xbc\x97\x2c\x72\x94\x25\x44\x40\xfd\x00\xdc\x47\xd4\x02\xf0\x2c\xb0\xc8\xb1\xec\xf9\x52\xce\xb6\x7a\x67\xde\x20\x9d\xb9\x2c\x97\xce\x34\xf1\x4f\x2e\x1b\x5d\xa5\xe5\x99\x6b\x05\x00\x3c\x5d\xd0\x62\x06\xd0\x02\xd3\xbf\x0c\xbc\x6b\xfa\x81\xcf\xdf\x05\x9b\x99\x4d\x94\x16\x2a\xcb\xc1\xe0\x06\x70\x51\x96\xd6\x25\x01\x71\xfa\x67\x60\xd4\x83\x1e\xb5\x17\xd6\x58\x62\x7f\x8e\x8c\x5a\xfc\x19\x9d\xf0\x9a\x09\x51\x92\x5a\x94\x0e\xae\xb0\x74\x8d\xdd\x2e\x6c\x1a\x7d\x5f\x60\xbd\x8b\x69\x5f\x5b\x70\x9a\xb8\x28\xbd\x65\x05\x28\x7d\xbd\x84\x7b\x51\xfa\xc2\x51\x41\xe9\xdb\x85\x0a\x8b\xc2\x33\x62\x3d\xb7\x28\x03\x75\x64\x3b\x53\x36\x03\x37\xf7\x80\x54\x1e\xab\x6c\x12\x4a\x49\x7c\xd7\x26\x89\x35\xd3\xa2\xb8\x95\x5a\x14\xd7\xa6\x67\xc1\x19\x0a\x71\xd9\x1f\xbf\x23\x19\xac\xae\x00\x05\x26\x00\xfd\xad\x86\xfe\xc4\x06\x8d\x7e\xc9\xa2\x8c\x4d\x8c\xc0\x0e\xae\xf8\x8e\x85\x86\x8b\x0b\x16\xc5\xf7\x1b\x4d\x97\x14\x3f\x88\x4c\xb5\xb0\xf0\xab\x05\xbb\x7c\x36\xf4\xec\x46\x45\x02\x2b\x6d\xcc\xee\xb7\x99\xc7\xab\x45\xa9\xbe\xd3\x16\x05\x93\x77\xb5\xc0\x37\x03\x79\xe8\x7c\x98\x1f\x50\x5a\x23\x87\x38\xfd\x27\x50\xa6\x55\x69\x4d\x75\x2c\xd0\xe9\x9d\x59\xd1\xe4\x3b\x04\x19\x00\xdf\xbc\x28\x2d\x76\x26\x0d\xbe\xed\x54\x77\xd8\x2a\xc3\x65\xef\xc2\x12\x6e\x6d\xfa\x3a\x7b\x0c\x8e\x73\x4a\x69\xbe\x5d\x5c\xc1\x39\xee\x65\x7a\x72\xfd\x18\x23\x64\xaf\xc7\x03\xa1\x37\xdb\xc0\x28\xad\x54\xa1\x89\xa4\xf6\x86\x8d\xc4\xbe\x91\x8c\xbd\xe5\x60\x96\x3c\x16\xde\x43\xe0\x46\xf8\xe9\xfd\xe9\xc5\x87\x0e\xc6\xe2\xf0\xbe\x91\x39\x8c\xcd\x5c\x67\x71\xd4\x4b\xdd\x41\xf1\x8e\x7a\x09\x63\x73\xac\xfb\x68\xa4\x6d\x47\xfb\xa6\x23\x67\x1c\x47\xc7\x6a\x28\xfb\x84\x63\x36\x6f\x8d\xa4\x1d\xd1\xf8\x91\xc5\xea\x78\x48\xc7\x83\xab\x9a\x05\x8b\xe9\x2b\xae\xc4\x01\x47\xf5\xf3\xd8\xab\x21\xb3\x73\x0e\x52\xc2\xcf\x38\x16\x04\x44\xbf\x21\x11\x8f\x20\x4d\x82\x33\x7d\x8b\x3d\x63\x71\xfa\x16\x4b\xb2\x9a\x33\x53\x11\x57\xa6\x1c\xa7\xef\x23\x91\x28\x6b\xd1\xf4\xef\xab\x21\x0b\x8b\x34\xfd\xd8\x6e\x9d\x35\x6a\x81\xac\xab\x86\xce\xaa\x67\xbd\xe4\x0a\x4e\xf5\xac\xbb\xce\x10\x5d\x67\xf5\x5c\xbd\x39\x3c\xeb\xbe\xa7\x55\x9f\x39\xfd\xd0\xcb\x62\xd4\xb3\x26\xc7\x46\x57\xf3\x5a\xb0\x4d\x05\x23\x5e\xe8\x04\xf3\x01\x00\xe7\x09\x78\xcf\x68\xde\x73\x0c\x59\xbc\x27\x3c\xeb\x8d\x95\x8b\x06\x41\x8e\xf5\x75\x6e\x19\x78\x1b\x6e\x9e\x9e\x31\x67\x94\x08\x2a\x4d\xcf\xe8\x39\xa6\xd5\x50\x1f\x1c\x71\x13\xf7\xac\xc7\x25\xde\xd8\x28\x53\xec\x74\x9f\xe5\x3f\x2f\x35\x94\x6b\xf6\xf1\xbb\x53\xd3\xba\x03\x61\x2f\x01\x85\xf9\x0d\x01\x2c\xc6\xb3\xa6\xa4\xed\x56\xf2\x45\xcd\xa5\x9f\xcf\x3a\x76\xd6\x94\x13\x9c\x9d\x39\xa9\x14\xad\xed\xed\x3b\x32\x7b\x0e\xc7\x2a\x00\x7c\xd6\x88\xa3\x9f\x75\x72\x7a\x58\xd5\x0a\x26\xa7\x37\x03\xe6\x68\x7f\x8a\x1c\x6a\x24\xf1\x40\x96\xc5\xf2\x99\xc3\x39\x82\x20\x95\x65\x61\x93\xd6\x84\xf6\xf1\xc6\xd2\x87\x12\xbb\xfa\x85\xef\xf8\xac\x5e\xee\xf0\x2c\x5a\x8c\xea\x19\x77\x11\x26\x01\x8d\x53\xcf\xf5\xd8\xea\x99\xb3\x27\x1d\xc3\x5a\x3d\xaf\x86\xb2\xa3\x7a\xbc\xad\xe2\x10\xc0\x67\x6c\xe1\xd0\x35\xf5\xf0\x5d\xea\xb9\x2e\x97\xcf\x06\xa5\xb0\x67\x53\x2f\xf7\x16\x4c\x38\xa5\x67\x63\xbf\x18\x04\x25\xcf\x66\xe3\xb0\x3f\x79\xc6\x3b\xdd\xec\x3a\x5a\x17\xd4\xb3\x71\x72\x00\xff\x6c\xa0\x49\xf5\x74\x5e\xcf\xce\xf4\xb2\xf6\x31\xfb\x89\x67\xe3\x7b\x26\x2d\x31\xcf\x81\xe3\xb3\xf1\xd8\x16\x3e\x1b\x1e\x0f\x1d\x17\x67\xad\x40\xd9\x76\xaa\x67\x13\x39\x99\x03\x50\x05\x94\x27\xa4\xc6\xd1\x50\x52\x94\xcd\xcf\xb3\xe1\x68\x52\x27\x12\x70\x78\x24\xf0\x40\x88\xa6\xd1\x33\x1a\x7c\xd2\xb7\x06\x47\x90\x1b\x8a\xce\x26\x1a\xea\x16\x6b\xdd\xa4\x2f\x4c\xfe\x7f\xd5\xb8\xa6\xee\x31\xce\x79\x36\x87\xd6\x08\x03\xa2\x20\xf5\x2c\x5e\xba\xf3\xa0\xf7\x98\x75\x3d\x0f\xda\x0f\xa4\x79\xbb\xd1\x48\x2b\x2c\x91\xb8\x25\x84\xe4\x3e\x0f\x27\x63\xa9\xfa\xbd\x83\xfe\x82\x54\xfd\x79\xd0\x07\x1e\x46\xbf\xd1\x08\x42\xd5\x11\xe5\xd5\x87\x4c\x40\xc9\x8f\x14\x1a\x25\x14\xc1\xb6\x43\xd4\x29\x97\xa4\x9e\x91\x5d\x95\x0c\xe4\x04\xaf\x82\xd9\xbb\x85\x19\xf1\xe6\xc7\x18\x34\xd6\x89\x0b\x26\x8f\xfa\x4e\x42\x86\xca\x60\x63\x19\xb9\xd4\x1e\x62\xfe\x3c\xe0\x52\x1c\xba\xfd\x3c\xd8\x1d\x7f\x4e\xdf\xda\x6b\x02\x06\x27\xc0\x91\x96\x16\x80\x3c\x86\x84\xed\x09\x39\xc1\xe9\x1b\xd1\x2a\x5e\x79\x1e\x42\x64\x30\x0e\x21\xb6\xea\x84\x3c\xe8\xcc\xed\xc0\x27\x9b\xbd\xe7\x21\x06\x2f\xd7\xe4\x3d\x0f\x78\x67\x52\xcf\x43\xa9\x44\xf3\x79\xc0\xbd\x10\xf5\x92\xdb\x67\x00\xad\x90\xbd\xb6\x70\xe5\xcf\xc3\xf4\xcd\x5b\x69\x55\x6e\xb6\x09\x0d\x91\x7a\xe0\x34\x5a\xcc\xc8\x9e\x87\xe9\xc7\x28\xa5\x4f\x3f\x92\x7a\xe6\x66\x43\xa7\x9e\xd1\x7a\x1c\x2d\xe6\xdc\xcf\xb8\xd2\x13\xe1\xc0\x33\xe7\x9b\x74\x83\x35\xfd\x60\x46\x60\xc2\x7a\xb3\x48\xe5\xec\x7a\xcd\x1d\xaf\xcf\x76\xf3\xf3\x49\xee\x3a\x7c\xb6\x0e\x21\xc3\xb3\x95\x27\x79\x6a\xe4\xa8\xef\xd9\xb6\x0b\x28\x78\xe7\x18\xd6\xc6\xf0\xc0\x18\x92\x88\x5c\x9f\xad\xec\x73\xd4\xb3\xe5\x5c\xd0\x00\x99\x01\xd2\x64\x22\x72\xe1\x45\x71\x07\x47\xdf\x15\xca\x84\x9d\x8a\xea\xd9\x16\xf5\x6c\xeb\xa8\xb4\x5f\x64\xf8\x87\xd9\x07\x5f\xbc\x7a\x0e\x4b\x43\x20\x93\x37\x74\x58\xb5\x3d\xa3\x52\xf8\x1c\x6c\x56\xcf\xe1\xcd\x2e\x35\x15\x08\x6f\xa4\x6f\xb0\x1d\x03\x5a\x66\x57\x60\x93\x08\xc1\x0e\x9b\x22\xd4\x27\x38\x4e\xe4\x9f\x83\x4b\xf9\x00\x35\x6b\x9a\x73\x9b\x8a\x89\x78\x40\xb0\xe6\xac\xe0\x39\x8c\x4b\xfe\x32\x69\x45\x21\x23\x5d\x18\xe4\x9a\xde\xe7\x30\xa2\xd9\x47\x7f\x88\xdc\xa2\x6c\x41\xfe\xf1\x15\x01\xc2\xf4\x9f\xea\x19\x56\xf2\x59\x66\x4c\xf0\x01\x02\x12\x7c\x95\x62\x3d\x87\x90\x93\x7a\x0e\x5b\xca\xa8\xb3\x3a\xc8\x17\x21\x0c\x78\x16\x2f\x63\x5d\x83\xb9\x25\x90\x3d\x72\xbd\x36\x40\x42\xec\xc9\x01\x21\x51\x0a\xd6\x44\x00\x27\x91\x51\x32\x32\x99\x43\xc1\xb3\x2e\xd2\xd8\xe7\x50\xf6\xea\x39\xec\x3d\x22\x2a\x20\x6a\xbf\xea\x39\x08\x0b\xf3\x93\xc2\x47\xad\xe9\xee\xa8\x97\xcc\x8c\x28\x57\x1f\x3d\x47\xf4\xcf\x09\x91\x8d\x3f\x0b\xb9\x8a\xda\x1f\xe9\x35\x49\xc7\x3a\xfd\x59\x5c\x18\x63\xc0\x71\x42\x4f\x78\x59\x4a\x98\x9b\xb9\x8b\xe0\x3b\x04\xfa\x52\xd6\xce\xe0\x3e\xad\x21\x41\x3d\x47\xe3\xc4\xca\xf9\x19\xab\x23\xa2\x2d\xa3\xd9\x71\x83\x1f\xd4\x01\xf3\x35\xf6\xf2\xcf\xd1\xec\x89\x71\x13\xc7\x33\x82\x5b\xad\x9e\xa3\x5d\x06\x5f\x17\x92\x88\x09\x26\xd3\x08\xa6\x9f\x59\x2a\x72\x07\x40\x0f\x0b\xf1\x1c\xb1\x69\x24\xea\xf5\x5b\x92\xd6\x8c\x56\xf8\xb5\xe7\x68\xb7\xb8\xaa\xe5\xbf\x9d\xe9\x1a\xcc\x34\x47\x10\x99\x6c\x45\x82\xd4\x36\xb4\xea\x05\x51\xaa\x78\x8e\xa1\x20\x33\x79\x8e\xa8\xdf\x09\xe4\xdb\x44\xbb\xe3\x39\xe2\x34\xfe\x39\x4e\xdf\x56\xc5\xa9\x67\xee\x9a\x7b\x4e\xac\xc9\xe2\xab\x98\x0b\x9e\x19\xb5\x80\xc8\xfe\xf3\x1d\x9b\xbd\x06\x14\xf3\x88\x5b\xec\xee\xf4\x9f\xd0\xd9\x67\xcc\xd7\xaa\x40\x84\xd4\x00\x77\x5b\xd1\x02\x27\x00\xb3\xaf\x9e\x59\x88\xb1\x5c\xee\x01\x0c\x59\x11\x2c\x3e\x63\xac\x4a\x52\x59\x22\xeb\x7a\x16\x67\xbb\xcf\xa9\x90\xb9\xb4\x7b\xb1\x9f\x53\x69\x79\x8b\x48\xd8\x9e\x53\xc1\x19\x2f\x59\x76\x5c\xb3\xaa\x9e\xcb\x12\x9d\x98\x67\x16\x72\xf9\x60\xfc\xe5\xae\xd5\x73\xe1\xf4\xb3\x01\xd8\xfe\x67\xb6\x45\xcc\x81\xe2\x74\xf2\x06\xe8\x38\x56\x7f\x2e\x61\xb4\x9b\x00\xeb\xf8\xcc\x1e\xa9\x0e\xc2\x12\x57\x32\x6f\x0a\x77\x22\x7b\x2b\x1c\xc4\x3b\xbe\xe7\x7f\x9c\x7a\x3e\x33\xb6\x23\x31\x69\x72\xfc\xb0\x11\xc1\xd8\x0c\x88\xc3\x48\x9a\xe8\x16\x2b\x50\xad\x9e\xf1\x86\xb7\x52\xcf\xe2\xe5\x91\xa7\x38\x03\x78\x2e\x47\x48\x6f\x39\x0a\x75\x3f\xb8\x92\xa9\xc8\x21\xb2\xdf\x7d\x3e\x64\xb3\xd2\xea\xf9\xc8\x4d\x48\xcf\xc7\xf6\xbd\xd3\xdf\xf3\xa0\x9e\xa7\xef\x0e\x15\xf2\xe7\xe9\xdf\x4f\x53\x7a\xfa\x11\xb7\x46\xfd\xa2\xb7\x51\x1f\x42\xf0\x3b\x9a\xf6\x97\xd5\x50\x37\x53\xbf\x74\x95\xcb\xfc\xa5\xd3\x96\xa5\xf7\x17\x24\x13\x51\xfd\x62\x52\xe9\x6d\x05\x05\x58\x33\x0f\x98\x54\x23\x5b\x57\xbf\x0c\x4e\xfd\x62\xe1\x96\xb4\x20\xa2\x4e\xfa\x8b\xd8\x43\x0f\xea\x17\x67\xd4\x2f\x6e\x6d\x7b\xf5\x8b\x63\x37\xf4\x8b\xdb\x10\x38\x9d\x34\xa0\xe5\x69\xe0\x68\xdf\x56\x43\xc9\xba\x57\xbf\x8c\xdc\x7f\xa5\x7e\x19\x65\x5b\xf3\x0b\x37\x1d\xfa\xa0\x7e\x41\x8e\xca\x81\x92\xfa\x45\x36\xd7\x12\xfa\xd9\x4f\xa9\xc5\x2f\x58\xf7\x56\x49\xca\x2f\x21\x5b\xa7\xd5\x2f\x5b\xdd\x0d\x07\x7d\x50\xbf\x6c\x57\x03\x7d\xa7\x7e\x89\x4b\x3e\x2e\x2e\x35\x73\x5d\xfd\x12\xed\xd2\x70\xcf\x04\x53\x5c\xfd\x82\x8d\xbd\x80\xe9\x0f\x93\xd4\x2f\xcc\x1b\xd1\x61\xfa\x65\xaf\xad\xfa\xe5\x90\xf8\xc4\x63\xa9\x5c\xf4\x8b\x2c\xd9\x9d\x40\x8e\x6b\x04\x89\x7a\x64\x99\x79\xd1\xeb\xb5\x35\x51\xbd\x20\x63\x7b\xd1\x3f\x93\x6d\x77\xd0\x00\x52\xe5\xbc\x26\x83\x78\x1b\xd4\x8b\x7e\x83\xbe\xb8\xa2\x5e\xb4\x5b\x4d\x5f\xc5\x8f\xfa\x0b\x8a\xf7\x5c\x64\xcb\xff\xae\x9b\xbe\x25\xa0\xb8\x84\x7d\xc1\x28\x8e\x51\x07\xd2\x3c\xdd\xbd\x68\xe7\xba\xc0\xfd\xfc\x64\x73\x86\x70\xd4\x89\x59\xf1\xa2\x5d\xe6\xe1\x71\x8b\x32\xec\x8b\xf6\xea\x45\xfb\x25\xa6\x92\x75\xa5\x7d\x41\x7a\xce\xba\x22\x88\xfc\xdb\x99\xc8\x65\xea\x9c\x36\xd4\xd8\xce\x6c\xde\xf1\x7d\x2b\xdf\x0f\x21\xe0\xb3\xe8\x45\x7b\x2b\x6d\xe0\xbd\x18\xd4\xbe\x68\x5f\xbe\x48\xb9\x72\x84\xfa\x82\x12\x2c\x11\x51\x5e\x5c\x0e\x25\xf3\x26\xae\x06\x94\x90\x9a\xc5\x7e\xc0\xa2\xe6\x45\xc7\xb7\x22\x2b\xf4\x8b\x8e\x09\x61\xe3\x41\xbd\x20\x17\xc5\x92\xea\x45\xa7\x15\xa6\xb6\xce\x05\x1e\xe1\x1a\xf0\x17\x9d\xac\xfb\x8b\x34\x6e\x45\x28\x32\x59\x77\x3a\xa9\x90\xc8\xa1\x25\xff\x29\x47\xb2\xae\x59\xb9\xd5\xc8\x9f\xf2\x27\xeb\xde\x7e\xe6\x7a\x33\xa7\x3a\x24\xcb\x8d\x8e\x24\x97\xae\xfe\x0f\xcd\x7c\xd1\xdc\xa4\xf3\x82\x8b\x6f\x89\xc9\xd5\x4f\xb3\xb9\x35\x6f\xfa\x4f\xb1\xe9\x5b\x8d\xf6\x03\xe1\x56\xfa\x76\x27\xfd\x34\xfd\x6d\xd0\xea\xc5\xd0\xb2\xc6\xf8\x25\x17\x5d\x3c\xd9\xb7\x4d\x52\x2f\x86\x3b\x21\x5e\x4c\x9f\x8d\x7a\x31\x22\x04\x7a\xa9\x3d\x60\x5c\x6d\x4e\xe3\xf4\x71\x15\x80\xdd\x46\x8f\x5b\x10\xd3\xce\x06\xde\xd1\xe9\x3f\x49\x46\x89\x43\xda\x0c\xde\xc1\xd3\xec\x5c\xa6\x7e\x94\x67\x47\xcd\x88\xc0\x73\xc6\xf1\x37\x2c\x1a\x5e\x8c\x28\x7f\xbc\x18\x8f\x6b\x60\x08\x3f\x7f\xfb\x2c\x01\xf7\x06\x57\x24\x0a\x28\x91\x2a\x46\xdc\x0a\xcc\xee\x30\x8b\xd1\x03\xf1\x65\xab\x68\x5c\xda\xdc\xda\xd5\xc4\xea\x5e\x94\xef\x8c\xdd\x8a\xfb\x63\x04\xe3\xe3\xf0\x0e\x53\x01\x0c\x3d\x08\x5b\xc8\x17\x13\x7b\x19\xf2\x28\x8f\x20\x71\x24\xcb\x06\xe9\xdd\x0b\x4e\xaf\xb8\xd4\x94\x94\x11\xc7\xb9\xf2\x14\x76\x0a\x2f\xdc\xc2\x27\xcf\x26\x31\xec\x45\xbd\x8f\x7e\x91\x5d\xcd\x8b\x49\x7c\x27\x31\x6c\x08\x5e\x58\x6c\x09\x84\x3e\x82\x98\xc8\x95\x9f\xea\xc5\x1c\x99\x33\x56\x3e\xdd\x6a\xcf\x68\x14\x65\x99\x83\x7a\xb1\xf0\x96\x2f\x28\x56\xbc\x58\xd3\x6c\x4e\x5e\x2c\x77\x94\x89\x0f\x8f\x88\xed\xe3\x8b\x45\x10\x04\x17\xa9\x5e\xf0\x71\x50\xc8\xc2\xd5\x6e\x00\x11\x18\xbc\xd8\x37\x7d\x68\x06\x84\xea\xc5\x6e\x84\xef\x7e\xb1\xae\xe7\xca\x0a\xf5\x62\xdd\x5b\xa0\x1e\x94\xef\x8b\xd9\xe9\xd9\x87\x51\x77\xe1\x58\x53\x62\x03\x11\xfb\xa6\x8a\xa6\x9a\x94\xcc\xf4\xb7\x50\xff\x96\xbd\xc6\x8b\x75\x63\x88\xb5\x68\x69\x1d\x3b\xca\x23\x1e\x19\x3a\xf9\xfc\x0a\x21\xf0\x49\x09\xfa\xc5\x7a\xbb\xa9\xe5\x83\xe0\xfb\xec\x05\x0f\x62\x2f\xd6\xe3\x49\x4e\xb4\x5f\x5e\x2c\xa7\x5a\x2f\xb6\x75\x2d\x0f\x0b\xf5\x6b\x0c\xf2\x0b\x9f\x54\x66\xb8\x4e\x8e\x46\xbd\x58\x16\x75\xb2\x9f\x0e\xad\x5e\x6c\x3a\x65\xc4\xc8\xfc\x45\x7c\x11\xa8\x17\x34\xe1\x08\x74\xc7\xa9\x90\x60\xfc\xb9\x6b\x4f\xd7\x73\xa3\x17\x3b\x7d\xc3\x3a\xe7\xc5\xe9\x4e\x78\x5d\x10\x2b\x5b\x01\x96\x90\x17\x67\x0a\x42\x96\x97\xb0\xb4\x49\xbd\x84\x0e\x5b\x5d\x1d\x99\xb2\xa1\xa6\xf7\xc6\xe1\x10\x81\x6a\x86\x1e\xc7\xbb\xc6\xca\x40\x0e\xe8\x93\x06\x12\x9d\x78\x3b\x7c\x41\x09\xef\x25\xd8\x1a\xe6\x24\x0f\xbb\x8d\x04\xd2\x5e\xc1\x21\x8c\xf6\xb3\x9f\x17\x72\xf3\x97\x34\x4f\x70\xae\x8e\xc7\xe0\x42\x8f\xdd\xd9\x0b\x77\x61\xd7\x8b\x4c\x5f\x10\xd6\xbf\xb0\xdd\x7e\x09\x91\x33\x4e\xf2\xc7\xe0\xeb\x91\x30\xa8\xfc\xcd\x38\x54\x2f\x92\x35\x91\x9c\x04\x4b\xf8\xd8\x58\x51\x88\x2c\x5d\x2f\xa1\xc0\x23\xbe\x84\xa3\xf1\xd6\xcf\x44\x59\x45\xbd\xc8\x02\x26\xa1\x21\xf2\x36\x06\xd3\xa9\x97\x18\x98\x34\x71\xfa\x17\x27\x86\x43\x2f\x85\x0f\xc3\xcd\x90\x61\xaf\xf0\x82\x21\x77\x40\x75\xf7\xa5\xc8\x2d\xdc\x0d\xc7\x2f\xf2\xcb\xce\xa0\x86\xb4\xb1\x87\x77\xf4\xa0\x5e\xa6\xaf\xcc\x88\xe9\xab\x87\xd2\x4c\x5f\x85\x90\xbd\x93\x8f\xe9\x7b\x9c\xbe\x27\xf5\xaa\x57\x83\x88\xd1\x5f\x75\x5f\x29\x0a\x48\x54\xaf\x18\x16\x01\xde\x9d\xb2\x0a\x1a\xb3\x7a\xd5\x9b\x26\xa2\x7e\xad\x37\x78\xbd\x6a\x27\xa6\xfe\xaf\x28\xbd\x73\x94\x0b\x52\x8d\x22\x5f\x59\xf5\x24\xc7\x26\x48\xba\x93\x80\x23\x31\x20\x77\x3a\x4a\xa6\x5a\x01\xe7\x18\x1f\xaf\xed\xc0\x08\xe8\xa7\xef\xeb\xf6\x17\xe2\x73\x10\x39\xff\x7a\x45\xb1\xfd\xb5\x2a\xb6\x03\x86\x06\x03\x8f\xf9\x9e\x60\x13\xf6\x80\xfa\x11\x51\x0b\x61\x7e\xd5\x51\x56\xe0\x57\x1d\x57\x65\xab\x5e\x21\x0a\xaf\x3a\x62\xc1\x4b\x64\x64\x5b\xf9\xaa\xa3\x28\xd0\x01\xe5\xfc\x98\x7f\xd2\xaa\xde\xf8\xf2\xaa\x23\xca\xcf\x24\xe1\x1f\xe9\x55\xa7\xd4\xb6\xbd\xaf\x22\x19\x5a\xf2\x5d\x99\x6d\xe7\xab\xce\xe2\x5d\x1a\x18\xd7\x48\x72\x04\xab\xc7\x7e\xaf\x5a\x2c\x34\xc9\x0b\xbb\xf8\xaa\x73\xa6\x27\xf6\x51\xab\x57\x54\x04\xe4\x13\xcd\x72\xa9\x70\x9c\x7c\x20\xe4\x2b\x08\xe5\x76\x25\x89\x2f\x45\x91\xf7\xd5\x2c\x65\x18\xbe\x1a\xfc\xd1\x3b\xf5\x6a\x18\xcb\xaf\xc6\x40\xaa\x5f\x0d\x4b\xf5\x2b\x5e\x00\xc9\x39\xd4\x29\xf0\x6a\x86\x1a\x0c\x7a\x20\x8f\xe5\xd7\x73\x68\x2e\xd0\x77\x12\x97\x76\x33\x55\x49\xe0\x15\x02\x4a\xaa\xa8\x0c\xbf\x1a\x14\x82\x65\x02\xbe\x1a\x2b\xb9\xd0\xb4\x05\x50\x6b\x91\xd3\xd4\x01\x63\x5c\x3d\xda\x7f\x35\xad\x47\x8d\x73\x64\x71\x49\xc4\x5f\xaf\xb8\x83\x22\xda\x94\x53\x5f\x69\x46\xb4\xd3\xdc\x32\x54\xa4\xf6\x39\xe6\xa9\x70\x98\x24\x51\x49\x5f\xeb\xe6\x05\xc7\x21\xd4\x2b\xeb\x53\x95\x9b\xbc\xe2\xde\xa9\xd0\x70\x71\xbd\x26\xc4\xc3\x54\x85\x3c\x13\xfd\xa0\xa9\x4f\xbb\x14\x58\x10\xe8\x5c\xcd\x23\x2f\x4b\xac\x5c\xaf\x9c\x6e\xd0\x9a\x89\x97\xb4\x95\x07\xa4\x0e\x74\x96\x20\xdf\x85\xb8\xad\x68\xe4\xbd\xa9\x75\x29\xb7\x88\x13\xe7\x41\x3e\xcb\x4b\x05\x73\x6e\x13\xc2\xb0\xc4\xab\x57\xb9\xd3\xa0\x53\xaf\xb8\xbc\xe2\x7d\x43\x55\x56\x7c\x1d\x8c\x93\x9e\x1b\x44\x38\xf7\x3a\xb4\xec\x83\x5d\xaf\x69\x1d\xa0\x15\x28\xca\x6d\xaf\x03\x57\xe2\x49\xb8\xc6\x3f\x28\x7f\x64\x23\x66\xd1\x60\x6d\x96\x82\x9d\xfe\x74\x9c\x95\x82\x8c\x90\x37\x10\x2c\xf8\x80\x32\x10\x07\x0c\x40\x1b\xb4\x2d\x67\xb6\xed\x4d\xf2\x5d\x88\x85\xd4\xab\xb8\x40\x27\xd9\x36\x47\x41\xaf\xb4\x67\x94\xc6\xb5\xdd\x1b\x9c\xc4\x2b\x7b\x65\xf5\x6a\x4d\x37\xbb\x37\x65\x6f\x4d\x47\x04\x12\x02\xac\x5f\xcf\x45\x90\x84\x72\x90\x22\x48\x42\x57\xe7\xd5\xa2\x49\x9f\x04\x72\x14\x5c\x61\x7d\x7d\x3f\x64\xf5\x6a\x37\xae\xc8\xf3\x9b\x26\xb8\x7f\xe5\xe6\x83\x6e\x76\x3a\x3d\xa0\x08\xb7\x2c\xd4\xcf\x41\x6a\xaa\xbb\x27\x22\x68\xd3\x08\xac\xee\x81\x5e\xf1\x69\x40\x20\x99\x44\x5b\xb6\x22\xf2\x28\x2d\x63\xdd\x9a\x60\x30\x8e\x4f\x73\xf2\xb4\x78\x0a\x7b\x95\x39\x61\x9d\xeb\x0c\x3a\x34\x44\xa5\x10\x54\x83\xa8\x22\x87\x53\xe3\x09\xa6\x77\x44\x3e\xc2\x39\xdb\x52\xc4\xf8\x0f\x6c\x13\xc6\x9a\x9b\x33\xcc\xa5\xbc\x98\xb9\x52\xbd\xd5\xbe\x5a\x97\xd3\x80\xce\xf5\xab\x48\xcb\xd4\xab\x6d\x34\xd9\xfa\xae\x36\xba\xef\x58\xd9\xf9\x43\x52\x7b\xb2\xfa\xbe\x8d\x00\x4e\x70\xd6\xb5\x07\x70\x74\xf2\xda\xbc\x8c\xbe\x5a\x8f\x63\x68\x72\x0a\x0d\xb1\x9c\xec\xbd\x5a\x0e\x83\x5e\x2d\x94\xc3\x26\x87\x77\x19\x90\x7a\x41\x3c\x2d\x96\x60\xfa\x5e\x2d\xdf\x98\xeb\xbb\xb3\xac\xd5\xaf\x36\x3b\xbc\x69\xd3\x2a\x39\xa3\x3d\xdd\x10\x19\x1e\x39\xc7\xd0\x52\xda\x4c\xc0\x5a\xbb\x52\xc3\x60\xdc\x06\x35\x28\xa8\x6f\x78\x5b\x59\xc4\xd7\xf5\x65\x81\xd6\x17\x23\xd8\xd7\xe0\x98\xd0\x9c\x18\xbf\x06\xe7\xf0\xd4\x47\x19\xd2\xe8\xac\xe5\x7e\x0f\x51\x94\x14\x7f\x00\x84\x74\x5c\x1d\xf5\xa6\xa1\x87\x3a\x2a\x50\x19\xaa\x13\x9c\x5d\xf5\x2b\xbb\x54\x02\x69\xe3\x80\x09\x30\xd3\x24\x84\x5a\x76\xd4\xdb\x9a\x2b\xd6\x27\xf0\xf6\x2c\x42\x9b\x8a\x36\x48\xfb\x85\x56\x68\xd4\x04\x2c\xc5\x80\x4a\xe4\x22\xf7\xfd\xaa\xd7\xb2\x3a\x36\xeb\xc4\xd7\xf2\xc6\x20\x2c\x4e\x66\x74\x91\xcf\x2a\x6e\x5d\x77\xff\xaf\x68\x97\xd6\x0b\x6f\x5e\x4b\x14\x15\xf9\xd7\x12\xc7\xa5\x7a\x3d\x88\xa2\xc8\xeb\x61\xa3\x5e\x0f\xa3\xdc\xd8\xf7\x7a\xf0\xde\xa8\xd7\x43\x0a\x91\x30\xcd\xce\x87\xd0\xe9\xac\x5e\xa7\xdf\xab\x09\xcb\xeb\xf4\xf7\xb7\x95\xdc\x61\xf9\x8a\x98\x41\xbd\x4e\x3f\x62\xce\x86\xcb\x55\x7b\xf5\x4f\x56\xf3\xf3\x82\x05\xf5\x4f\xd6\xa8\x7f\x62\xf0\xfc\x93\xf5\x87\x0a\xf9\xf8\x7f\x2a\x46\xfd\x8a\xc7\x92\x5f\x75\x87\x52\xcb\xaf\xba\x7a\x28\xfe\x15\x75\x45\xc4\x18\xbf\xea\x0d\x32\x24\xaf\x7e\xd5\x23\x3f\xdd\xe3\x1c\xb9\x62\x2d\xe7\xc8\xbd\x78\x3f\x13\xf9\x7f\x86\xf8\x33\x53\x1c\xae\x38\x72\x10\x24\x9d\x52\xc4\x3f\xf0\xaf\xda\xf7\xea\x57\x61\xea\x7f\xd5\x64\xc0\xa3\x8e\xec\x04\x7f\xad\x1a\x4d\xbf\xea\xe4\xe1\x6d\x2d\x05\x23\x6c\xfa\x55\xe7\x5a\x53\x44\xde\xbf\x6a\xfe\x32\xbb\xc3\x6a\x50\xbf\x6a\x39\x05\xfd\x55\x8e\x88\x7e\x35\x5e\xfd\x8a\x7b\x4c\x55\x4d\xf5\x7e\x35\xec\x3c\xd9\xe6\xfc\x6a\x24\xb4\xae\xb3\xd1\x8e\xce\xaa\x5f\xad\xe7\xd7\xab\x5f\xc3\x26\x88\x44\xe5\xd7\x20\x97\xa4\xfe\x1a\xbc\x69\xf1\xa0\x7e\x0d\xd5\x53\xb4\x40\x91\x75\x09\x56\x3f\x22\x70\x4a\xf1\x2b\x5e\xcd\x7e\xa5\x25\x61\xe6\xd4\xaf\x85\xca\x94\x88\x5f\xd2\x13\xd4\xea\xd7\x82\x25\x33\x00\x91\xd1\x67\x5c\xc3\xa8\xcf\x22\xbc\xb0\x3a\xa9\xcf\x7a\x70\xcb\x58\x56\x1b\x66\xda\x67\x16\xee\xcf\xda\x8e\xea\xb3\xde\xf0\x1b\x60\x9f\x35\x18\x4c\x36\xb0\xca\xe6\xde\xf4\x4f\xfc\x00\xee\x74\xda\x1c\x54\x13\xf5\x55\x64\xcf\xc4\x68\x88\x55\x9f\xf1\x77\x92\xb3\xde\x58\xde\x39\x72\x13\xb9\xfa\xac\x39\x1f\x80\xb3\xff\x5c\xa9\xfe\x67\xba\xe6\x33\x22\x34\x11\xb2\x7d\x86\x2d\x65\xa3\x4a\x4e\x96\x8f\xcf\x5c\x01\xa7\x3e\xb3\xfb\xc5\x1d\xdb\xe7\xf7\xa7\xa3\x21\x55\xde\x17\x0b\xae\x27\xd5\x67\xe1\x62\x3e\x6b\x14\x45\xd5\x67\xbd\xd7\x9d\xd4\xab\x33\x4b\xa9\x96\x59\x72\xf6\xe4\x83\xfa\x8c\xc6\x56\xad\x61\x63\x43\x3e\x1b\x2b\x7c\xeb\x67\xd3\xa2\xae\xfe\x5b\x6b\x88\xb4\xf2\x33\x0a\xec\xfc\x53\x1b\xc7\x1c\xe0\x12\x3f\xa3\xe2\xfa\x59\xf4\xb4\x3f\x0f\x3a\xa8\xcf\xa8\x4f\x7f\x16\xf5\xe9\xcf\x03\x23\xfe\xf3\x10\x8a\xfa\x3c\xc8\xaf\xe6\xe2\x70\x85\x71\xf7\xd9\x1a\x1c\xfc\xa4\x8d\x9e\x3d\xef\x43\x3c\x1e\x3a\x3e\x19\x03\x73\xf5\xd9\xbe\x39\x99\x93\x9f\xed\xdb\x56\x7d\xb6\xa8\xb7\x7c\xb6\x23\xf2\x91\xcf\xa7\xf3\x0d\xaf\x3e\x63\x7f\xf6\x19\x21\x24\xc9\xa8\xc8\x37\x80\xac\xf8\xf3\x68\x73\xdb\x21\x7d\x16\x15\x92\xcf\xa1\x63\x98\x7f\xc6\x98\x5c\xba\x21\xf4\xbd\xfa\xcc\xc5\x82\x07\xf5\x19\xfd\xf7\xea\xdd\x47\x50\x64\x6c\xea\x33\xd6\x3f\x9f\x03\x16\x56\x9f\x91\x04\xc3\xc5\x7e\x4e\xc1\x65\xf5\xb9\x2c\xc5\xe1\xfc\xe7\xb2\xe2\x52\x22\xe9\x2a\x41\xa5\x5b\xca\x4a\x64\xa4\x9f\x11\xac\xaa\xcf\xc5\x05\xae\x4f\x05\x0a\x18\x9b\x15\xdf\x67\x28\x93\x53\x9f\xe1\x7f\xa9\x9d\x78\xd3\xde\x4c\x5f\xd5\xf4\xff\xf1\x7d\x5b\x86\xa7\xff\x85\xc9\xad\xa6\xff\x65\x53\x96\x41\x4d\xff\xad\x3a\x9e\x9e\xfe\x5b\x9c\xbe\x27\x3b\x7b\x9c\xbe\x3a\x35\xfd\xb7\x63\x67\x37\xc8\xcb\xa7\xff\x15\xcf\x52\x48\x1a\xa6\xff\x55\xb4\x70\xb5\x53\xff\xf8\xe7\xa6\xc2\xfb\x8f\x7f\xc6\x17\xa4\x5d\x55\x65\x86\x7f\xfc\xf3\xa8\x39\x6e\xff\xc7\x3f\xa3\x6c\x68\x9c\xfa\xc7\x3f\x97\x94\xb5\xfa\x7f\x06\x00\xda\x72\x72\x2a\x84\xfe\x00\x00"),
 		},
 		"/images": &vfsgen۰DirInfo{
 			name:    "images",
-			modTime: time.Date(2020, 12, 18, 14, 33, 4, 627376747, time.UTC),
+			modTime: time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 		},
 		"/images/favicon.ico": &vfsgen۰CompressedFileInfo{
 			name:             "favicon.ico",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 627376747, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 1150,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x93\x5f\x48\x53\x51\x1c\xc7\x7f\xa3\xe5\xfa\x33\xe6\x08\x2c\xaa\x97\x95\x2c\x7c\xaa\x55\x44\x65\x61\x44\x81\xfa\x52\x60\x99\x3d\xac\x1e\x42\xc2\xca\x07\x89\x48\x8c\x44\x88\x16\x83\x12\xc1\xc8\xf5\xb0\x32\x25\x63\x84\x44\x81\xc5\x72\x9b\x33\x49\xc2\x30\xb7\x46\x63\xce\x45\x1b\x8b\xbc\xb9\x54\xe6\x2e\x6d\xde\x7b\xcf\xf9\xdd\x38\xca\xa5\x56\x1b\xe1\x93\x07\x3e\xdc\xcb\xf7\x7e\x3f\xf7\x9c\xc3\xbd\x07\x40\x05\x2a\xd0\xeb\xd9\xd5\x00\x75\x6a\x80\xf5\x00\x50\x02\x00\x7a\x00\x30\xc0\x62\xbe\x30\xd4\xf0\xcf\x90\x65\x79\x59\x11\x04\xa1\x88\x10\xb2\xe6\xef\x5c\x24\xa8\x55\xee\x89\x24\x68\x97\xf2\xce\x60\x0a\xf7\x84\x79\x3c\x38\xc3\x73\xdb\x08\x25\x05\xdf\x83\x83\xd5\xe9\x59\xae\xf8\x7f\x1e\xa1\xa8\x7a\xc1\x61\xad\x69\x08\x93\x22\x45\xcd\x9b\xf1\x67\x67\xa3\x89\xe0\xee\x39\x2e\x62\x7a\x7e\x71\xcb\x37\xee\x93\xf7\x38\xa5\x34\xaf\x7b\x3e\x80\xae\x8d\x6e\x94\x4b\xbc\x38\xcf\x7a\x4d\x4f\x8f\x79\x6c\x9e\xab\xb6\x4c\xea\x87\xb1\xa7\x1a\x64\xc6\xd8\xe3\xc6\xbb\xb9\xfc\xc9\x0c\x6e\x65\x6e\x91\x0b\x65\xc3\x00\xce\x8f\x7e\x71\x57\x95\x59\x40\x3e\x62\xd5\x88\xe3\x11\x6f\x95\xe2\x3b\xce\x68\x45\x4a\x69\x41\x8e\xf9\x57\xd4\x05\xb0\x6f\x83\x0b\xe5\x9b\x13\xd8\xd1\xe6\xac\xef\x62\x3e\xc3\xf1\xee\x4e\xcb\x50\xeb\x49\xd7\x93\xd3\x6a\xea\x77\x34\xb7\xff\xe9\x4d\xf0\xb8\xa3\x62\x04\xfd\xa5\xc3\x18\x73\x4e\x61\x8d\x44\x51\xc7\xf2\x0f\x51\x4f\x45\xe5\xed\xc2\x9f\x27\xda\x37\x27\xe2\xd3\x61\x13\xcb\x28\x91\x74\x9f\x07\x3a\x1b\x9c\xd7\xf6\xf9\xdf\xdb\x2f\x75\x4a\x42\x7a\xb5\x79\x0c\x5f\xae\xeb\x47\x99\x61\xf4\x62\x52\xa2\xb8\x72\xa1\x4b\x29\x7c\x9d\x89\xec\x9d\x4e\x4d\x1a\x95\xb9\x52\x5c\x64\x7b\xcf\x29\x15\x55\xf6\x12\xea\x6b\x6b\x36\xfb\xb2\x7c\x5e\xf1\xef\x7b\x1a\x3b\xd8\xda\x0f\xdf\x52\x93\xc1\x50\xef\x39\x96\xcd\xc6\x3e\x96\x2a\x2e\x23\xd0\x7b\xc3\x1a\xe6\xd1\x54\x39\x82\x81\xfd\xc3\x18\x7f\x9d\xc0\x1a\xd6\x93\x88\xa8\x39\x6a\x5d\x45\x95\xfd\xd7\x77\x97\xf9\x94\x35\x8d\x3e\xba\x6c\x77\x98\xd7\x92\xfe\xeb\x07\x7c\x99\x64\x62\x53\xae\x6f\xc1\x7a\xb5\x0f\x76\x85\x14\xbf\xf5\xd5\x85\xae\xac\xe7\x44\xd2\xe4\xfb\x0f\x14\xa6\x92\xf1\xe2\x7b\xee\x2b\xb6\xee\xb7\x16\x4b\x5a\xe0\x75\xcb\x7d\xce\xf2\x03\x59\x48\x51\x80\x78\xf9\x22\xb1\x72\x80\x87\x4d\x00\x85\x73\x00\xda\x06\x00\xfb\x4e\x00\x72\x08\x00\x5b\x7e\xf7\x7f\x05\x00\x00\xff\xff\xac\x43\x59\xfc\x7e\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x92\x5f\x48\x53\x51\x1c\xc7\x7f\xa3\xe5\xfa\x23\x73\x04\x16\xd5\xcb\x4a\x16\x3e\xd5\x2a\xa2\xb2\x30\xa2\x40\x7d\x29\xb0\xcc\x1e\x56\x0f\x21\x61\xe5\x83\x44\x24\x46\x22\x44\x8b\x41\x89\x60\xe4\x7a\x58\x99\x92\x31\x42\xa2\xc0\x62\xb9\xcd\x99\x24\x61\x98\x5b\xa3\x31\xe7\xa2\x8d\x45\xde\x5c\x2a\x73\x97\x36\xef\xee\x39\xbf\x13\x47\xb9\xb1\xd1\x84\xde\xea\xc0\x87\x7b\xf8\xde\xef\x87\xf3\x3b\x70\x00\x54\xa0\x02\x9d\x8e\x7f\xf5\x50\xaf\x06\x58\x0f\x00\xa5\x00\xa0\x03\x00\x3d\x2c\xe5\x8b\x4b\x0d\x7f\x2c\xc6\xd8\x3f\x45\x92\xa4\x62\x42\xc8\x9a\xec\x8c\x93\x21\x58\xa8\xec\x89\x2c\xfd\xde\xff\x0d\x81\x24\xee\x09\x89\x78\x70\x56\x14\xb6\x11\x4a\x0a\xbe\x07\x86\x6a\x52\x73\x42\x49\xbe\x6e\x36\x84\xa2\xea\x85\x80\x75\xc6\x61\x4c\x64\x28\x6a\xde\x4c\x3c\x3b\x1b\x89\x07\x76\xcf\x0b\x61\xe3\xf3\x8b\x5b\xbe\x09\x9f\x3c\xc7\x29\xa5\xcb\xba\xe7\xfd\xe8\xdc\xe8\x42\x56\xea\xc1\x05\xde\x6b\x7e\x7a\xcc\x6d\x75\x5f\xb5\xa6\x93\x3f\x0c\xbd\x35\xc0\x38\xe3\x8f\x9b\xee\xe6\xf3\xa7\xd2\xb8\x95\xbb\xc5\x4e\x64\xfa\x41\x5c\x18\xfb\xe2\xaa\x2e\x37\x03\x3b\x62\xd1\x64\x26\xc2\x9e\x6a\xc5\xb7\x9f\x29\xcc\x50\x4a\x0b\xf2\x9c\xbf\xa2\xde\x8f\xfd\x1b\x9c\xc8\x6e\x4e\x62\x67\xbb\xa3\xa1\x9b\xfb\x1c\xfb\xbb\x3b\xad\xc3\x6d\x27\x9d\x4f\x4e\xab\xa9\xcf\xde\xd2\x91\xed\x4d\x8a\xb8\xa3\x72\x14\x7d\x65\x23\x18\x75\x4c\x63\xad\x4c\x51\xcb\xf3\x0f\x11\x77\x65\xd5\xed\xa2\x9f\x27\x3a\x36\xc7\x63\x33\x21\x23\xcf\x28\x91\xb5\x9f\x07\xbb\x1a\x1d\xd7\xf6\xf9\xde\xdb\x2e\x75\xc9\x52\x6a\xb5\x69\x1c\x5f\xae\x1b\x40\xc6\x31\x78\x30\x21\x53\x5c\xb9\xd8\xa5\x14\xbe\xce\x86\xf7\xce\x24\xa7\x0c\xca\x59\x49\x21\xbc\xbd\xf7\x94\x8a\x2a\x77\x09\xf6\xb7\xb7\x98\xbc\x39\xbe\xa8\xf8\xf7\xdd\x4d\x9d\x7c\xf6\xc3\xb7\xd4\x64\x28\xd8\x77\x8e\x67\x73\xd1\x8f\x65\x8a\xcb\xf1\xf7\xdd\xb0\x84\x44\x34\x56\x8d\xa2\x7f\xff\x08\xc6\x5e\xc7\xb1\x96\xf7\x64\x92\xd1\x1c\xb5\xac\xa2\xca\xfd\x1b\x7a\xca\xbd\xca\x4c\x63\x8f\x2e\xdb\xec\xa6\xb5\x64\xe0\xfa\x01\x6f\x3a\x11\xdf\xa4\xcc\x96\x0d\xef\xd5\x3d\xd8\x15\x54\xfc\xb6\x57\x17\xba\x73\xfe\x13\x59\xb3\xdc\x3b\x50\x98\x4e\xc4\x4a\xee\xb9\xae\x58\x7b\xde\x9a\xcd\x29\x49\xd4\xe6\xeb\xfc\x1f\x40\x0e\x72\x04\x20\x56\xb1\x44\xb4\x02\xe0\x61\x33\x40\xd1\x3c\x40\x61\x23\x80\x6d\x27\x00\x39\x04\x80\xad\x00\x8c\x01\x30\x06\xf0\x6b\x00\xac\x43\x59\xfc\x7e\x04\x00\x00"),
 		},
 		"/images/gna.svg": &vfsgen۰CompressedFileInfo{
 			name:             "gna.svg",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 3718,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\xdf\x6f\x1b\xc7\x11\x7e\xb6\x01\xff\x0f\xd7\xcb\x53\x81\x9d\xd1\xce\xfe\x5e\xda\x4c\x10\xbb\x4d\x1c\xa0\x06\x0a\x08\xf0\x6b\x21\xaf\x2e\x32\x61\x9a\x12\x78\x14\x25\x39\xe8\xff\x5e\x7c\xb3\x14\xa9\x14\xae\x81\x26\x7a\xe0\xed\xdd\xde\xce\xcc\x37\xf3\xcd\x37\xa7\x57\x3f\xdc\x7f\x5e\x0f\xfb\x69\x3b\xaf\xae\x37\xcb\x51\xd8\x8e\xc3\xb4\x69\xd7\x97\xab\xcd\xd5\x72\xbc\xdd\xfd\x4a\x65\xfc\xe1\xfb\x17\xcf\x5f\xfd\x85\x68\xf8\x79\xda\x4c\xdb\x8b\xdd\xf5\x76\x31\xfc\x78\x79\xfd\x61\x1a\x7e\x59\xaf\x6f\xe7\x9d\x3e\x1a\xa4\xb2\xb0\x35\xc3\xf9\xfb\x9f\x87\xbf\xdf\xdf\x5c\x6f\x77\xc3\x3f\xd7\xb7\x57\xf4\xcb\x66\x60\x7d\xf8\xbe\x3b\x59\x0c\x89\xad\x1d\x5e\xdf\xae\xd6\x97\x83\xfd\xeb\x30\x10\xc1\xfe\xbc\xbf\x7a\x1a\x86\x8c\xc3\xea\x72\x39\xfe\xe3\xe2\x61\xda\xfe\x4b\xc6\xe1\xfe\xf3\x7a\x33\x2f\xc7\x8f\xbb\xdd\xcd\xe2\xec\xec\xee\xee\x8e\xef\x3c\x5f\x6f\xaf\xce\x9c\xb5\xf6\x6c\xde\x5f\x1d\x5e\x59\xdc\xaf\x57\x9b\x4f\x5f\x7b\x51\x6a\xad\x67\xba\x3b\x0e\xf7\xcb\xd1\xde\xdc\x8f\xc3\x43\xbf\xbe\x78\xfe\x6c\xd8\xaf\xa6\xbb\xd7\xd7\xf7\xcb\x91\xc4\xdb\x41\xb2\x1b\x7c\xb4\x43\x88\x76\x1c\xe6\xdd\xc3\x7a\x5a\x8e\xd3\xe6\xe2\xc3\x7a\xa2\x0f\x17\xed\xd3\xd5\xf6\xfa\x76\x73\xb9\xd8\x4c\x77\xc3\x7f\xbf\xfe\x52\x03\x59\xcc\x37\x17\x6d\x5a\x8e\x37\xdb\x69\x9e\xb6\xfb\x69\x54\x88\xb0\x33\xec\x1e\x6e\xa6\xe5\xb8\x9b\xee\x77\x67\x6d\x9e\xb1\xf1\x8c\xe7\x9d\xfd\x6d\xde\x6d\xaf\x3f\x4d\x8b\xef\x7e\xd2\xbf\x97\xfd\x96\xee\x56\x97\xbb\x8f\x0b\xf7\x78\xfb\x79\xb5\x9b\xb6\xeb\xd5\xe7\xd5\x6e\x21\xf6\xe5\xbf\xfb\x59\xf9\xed\xd7\xd5\x7a\xbd\xf8\xce\xda\x5c\x7d\x78\xf9\xc7\x0d\xb9\x83\xa1\x9f\xfe\xf6\xfa\x47\x71\x7f\xc2\x90\x3f\x46\x24\xa9\xd4\x3f\x68\xe8\xd5\x99\x26\x0c\x99\xbb\xb9\xd8\x7d\x1c\xda\xfa\x62\x9e\x97\xe3\xbc\xb3\xe3\x70\xb9\x1c\xdf\x91\x88\xb0\x33\xa1\x08\xfb\x66\x49\x1c\x67\x13\x38\x93\xc3\x42\x3c\x57\xf2\x96\xa5\x55\xf6\x94\x39\x18\xe7\x48\xc4\xf8\x42\x22\x1f\xa3\x67\xd9\x4b\xe1\xf0\x91\x5c\x61\x69\x41\x8f\x16\x93\xb9\x9a\x6a\x2a\xce\x3b\xae\x2f\x9e\x3f\x6b\xc2\xc5\x78\xae\x06\x36\x0b\xac\x74\xdb\xa1\x59\x93\xd8\x11\xb6\xc5\xb1\xa7\xc8\xc1\x48\x61\xdf\xc8\x73\x32\x89\x0a\x8b\x11\xcb\x89\xc4\xf7\x13\xa5\x51\xe4\x64\x3c\x0e\xc1\x5d\x44\xa4\x99\xbd\xc9\x9c\xe1\x88\x0a\x57\x23\xec\x71\x20\x98\xf0\xb8\xa8\x2c\xcd\x1a\x07\x37\x38\x64\xa2\x49\x5c\x9a\x67\x6f\xe0\xba\x72\x30\xf0\x27\x70\x17\x39\x36\x09\xdd\x87\x71\x01\x1e\x0c\xd0\x99\xcc\xb1\x05\x76\x7a\x02\x08\x03\x47\x23\xc2\xc1\x64\x2e\x70\x1d\x7b\x38\xa6\xc0\x12\x5c\x61\xe1\x44\x41\x0a\x42\x8a\xc6\x59\x04\x14\x8d\xcb\x33\x39\x8f\xf3\x96\x85\x82\x05\x6a\x24\x99\x24\x71\x35\x16\x19\x8f\xe4\xd9\x63\x2b\x13\xb6\x66\x92\x08\xcc\x91\x4b\x5f\xb9\xcc\x0e\x5e\x2d\xce\x88\xa9\x5c\xc9\x25\x64\x16\x2b\x2f\x30\x96\xb9\x52\x64\x21\x11\xae\x6a\xe4\xb0\x8a\xec\x9a\x25\xdf\x93\x41\x99\x9d\xd1\xb7\x2c\x87\xe6\xd5\xaf\x98\xd2\x2b\xe1\xb9\x50\xd2\x0a\xbe\x21\xb1\x8e\xa3\x89\x20\xcb\x23\x65\x6a\x41\x65\x4e\xf4\xf9\x32\xbc\xa3\xac\x6f\xa5\xc0\x59\x03\x30\x16\x75\x0a\xea\x49\x2a\xb6\x1a\x45\xe3\x95\x4c\x09\x45\xd3\x2b\x68\x82\x2c\x39\x14\x44\x50\x87\x60\x3c\xe2\xc0\x4a\xf9\x63\x39\x1a\x8b\xdb\x48\x82\xb4\xa2\xb2\x81\x13\x92\x4e\x1e\x99\x56\x72\x6a\x3c\x6a\x8b\xa2\x22\x49\x24\x16\xc8\x0a\x68\x10\xdf\x50\xc4\xe1\x98\x12\x47\x4a\xd2\xc3\x7c\x12\x31\xe2\x4f\xa8\x40\x48\x96\x93\xd2\x09\x29\xb5\xc8\x5b\x31\x0e\x49\x4a\xec\x4d\xe2\xd8\x08\xd5\x47\x10\x89\xb3\xa9\x30\x07\x7e\x82\x3b\x60\xb4\x18\x07\xa2\x08\x8b\x49\x80\x87\x8c\xeb\x01\xd4\xc9\x24\x23\x78\x3b\x35\xfc\x2a\x6a\x21\x87\x67\x89\x12\x0b\xdc\x06\x8e\x14\xf4\x70\xa6\xaa\x1e\x33\xca\x16\x9a\x25\xb4\x8b\xd3\x12\x70\xa0\xc3\x73\x00\x03\x63\x43\x72\x9c\x29\x59\xd4\x03\x08\x9e\x80\xf9\x32\x9e\xfd\x4f\x05\x70\x20\x6b\x08\x96\xdd\x5e\x23\x45\xb2\x61\x3f\x19\xe7\xb0\xca\x1c\x8d\x8f\x60\x0e\x5a\x20\xf7\x4a\x04\xed\x9d\x0a\x4a\x83\xf6\xa5\x25\x6d\xa2\xa2\x54\x47\xbf\x19\x6d\x22\x10\x16\x88\x7c\x47\x61\xa2\x11\x74\x6b\x34\x5e\xb8\xec\x53\x61\x79\x9b\x23\xc7\x3d\xa5\xcc\xa5\xcb\x8f\x10\x58\xea\x50\xb4\xc8\x09\xac\x16\xc8\x41\x26\xd0\x1e\xec\xce\x28\x67\x2f\x79\x69\xa4\x59\xb6\x78\x3f\x19\x7d\xbf\x20\xb2\xc4\x79\x5f\xe2\x5b\x42\x3a\xdf\x2b\xb6\xb7\x80\xf9\xad\x34\x48\x41\xc3\x86\x92\x39\xef\xa3\xe5\xd4\xac\x01\x6f\xbd\xd6\xb3\x57\x43\x1a\x52\x65\x4d\xd6\x22\x08\x1a\x04\x0c\x08\x7b\x70\xbc\x69\xcf\xa0\x83\xaa\x86\x52\x48\x55\xa0\xcb\x92\x57\xe1\x70\xa4\x74\x38\xb4\x23\x16\xad\xaf\x7a\x17\x93\xef\x49\x2e\xda\xa8\xd8\x82\xb6\x74\x68\xa8\x07\x79\x25\x55\x82\xca\x39\x9c\x51\x21\x80\x02\x42\x26\x4a\x17\x04\x4d\x1a\xd8\x5f\xc0\x12\xc7\x41\x17\x4e\x01\x11\x8a\x87\x03\x02\x21\x2b\xe0\x1d\x64\x27\x72\xa0\x08\xdd\xf4\x88\x26\x19\xe7\x49\xc2\xda\xdb\x83\x2a\xec\x01\xb1\x69\x23\xa3\x6d\x85\x9c\x70\x3c\xfd\xa8\x53\x85\x6a\xc9\x81\x0b\x8e\x7c\x05\xdc\xa2\xdd\x60\x09\xc1\xe5\x4e\x5a\x0f\x69\x17\x70\x1b\x19\xa1\xda\x7f\x5b\xed\x9e\x0a\xd4\x2b\xa0\xb6\xde\x04\xb4\x83\x8e\x82\x6e\xda\x38\xa4\x10\x34\xf2\x16\xa1\xda\xde\x29\x0e\xe1\x6a\x7d\xaa\x89\x46\xc5\xb7\xe1\xde\xab\x80\x63\x2c\x88\x0e\x1e\xb1\x9c\xdf\xa0\xc2\xde\x84\x0c\x4f\x87\x6a\x67\x95\x9f\x53\xe5\xbf\x0c\xef\x24\x45\x08\x82\x8f\x9c\xf6\xe4\x23\xfb\xb5\xca\xae\x39\xf4\x26\x95\x3e\x7d\x30\x90\x12\xa2\xcd\x2a\xbf\x09\x35\xf6\xba\x15\x95\x1f\x51\x1b\x7b\x46\x1b\x15\x9d\x16\x98\x86\xcd\x6b\x3c\x18\x26\xee\xf0\x08\x11\x21\xb6\x88\xb0\x63\x00\x10\x89\xd5\xc4\x80\x49\xf5\x24\x96\xaf\x52\x57\x3a\x75\x0b\x26\x88\xb7\x2a\x3f\x24\xa1\x8b\xbd\x47\xed\xbd\xd7\x6b\xc3\x38\x07\xcd\xbc\x57\x95\xc2\xd5\xbb\xa6\x7d\xac\x9c\xaa\x48\x7d\x3a\xad\x66\xb4\x73\x51\x82\x1b\x97\xba\x18\x26\xd6\xee\x75\x06\x52\xeb\xa1\x64\x5d\x19\x9a\xd5\xb1\x29\x46\x90\x8c\x8c\xa7\xe4\x63\x4b\x20\xb3\x0e\x43\x74\x89\x4b\x4f\x57\xe7\x3d\x62\xc1\xe4\x3d\x05\xff\x55\x88\xae\x43\x44\xd1\xbd\x08\xa4\x57\x0d\xe3\x6b\xc1\x05\xf4\xbb\xe7\x40\x21\x74\xe5\xf7\x6f\x28\x79\xe3\xc5\x52\x8a\xc6\xbb\x02\x0d\xc9\xc6\x07\xcf\x0e\xf1\x20\xdd\x01\xc3\x43\x75\xcb\x9d\x56\x2f\x9e\x3f\x9b\x31\x9d\xf4\x1b\x45\x07\x3b\xbe\x0f\x44\x99\xa3\x29\x89\xea\x1e\xc9\xc0\xd8\x76\x59\x89\x07\x99\xce\x5d\x82\xb5\xa3\x23\xf9\xc0\xae\x91\xc7\xa8\x4b\x84\x99\xef\x2a\xba\xf9\xb0\x38\xd7\x7c\xa9\x85\x23\x9c\xaf\x62\xf6\x1d\x73\x85\x9e\x76\xd0\x8f\x98\xcd\x11\xb3\x39\x62\x6e\x82\x19\xd1\xfb\x47\x43\xac\x87\x54\x9b\x10\x34\x5b\x0a\x9b\x8e\x60\xe9\x77\xb0\xe9\x80\x5b\xb3\xd9\x71\xd3\x11\x38\x1d\x81\xd3\x23\x70\x3a\x20\x37\x8f\xc8\xcd\x09\x79\x07\x6e\x1e\xf1\x1e\x17\xe7\x25\x1f\x70\x9f\x20\x7d\xab\xd8\xe4\x55\xa8\xa2\x60\x26\x1c\xa6\x90\xb6\x2e\x86\x02\x7e\x74\x35\xeb\x52\x77\xe8\xb8\x33\x1f\x6f\x4f\x6f\x9f\x1f\xec\x39\x7c\xc4\x9e\x4c\x1f\x22\x68\xab\x6d\x5b\x4f\xbf\xeb\xa9\x76\xbf\x1c\x43\x62\x3f\x0e\xed\x61\x39\x3a\x09\x5c\xc7\x61\xbb\x1c\x61\xec\x1b\xf5\x72\xfd\x3b\x21\xd4\xff\x3f\xec\x73\x09\xfa\x5d\x6c\xab\x91\x2c\xb8\xe2\xa3\x4b\xaa\x26\xdd\x56\x73\x30\xad\x9f\xba\x4f\xdc\x1c\x20\xe0\x3f\xb7\xef\x5f\x3c\xff\x4f\x00\x00\x00\xff\xff\x5b\xa1\x2c\xe0\x86\x0e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xef\x6b\x1b\x49\x12\xfd\x1c\x83\xff\x87\xb9\xd9\x4f\x07\x55\xe5\xae\xfe\xdd\x4a\xb4\xcb\xc6\x77\xbb\x59\xb8\xc0\x81\x21\x5f\x0f\xa7\x3d\x6b\x8b\x28\xb2\xd1\xc8\xb2\x9d\xe5\xfe\xf7\xe3\xf5\x8c\x25\x73\xe4\x02\x97\x35\x58\x6a\x4d\x77\x57\xd5\xab\x7a\xf5\x6a\xde\xfc\xf4\xf8\x79\xdd\xed\x87\xed\xb8\xba\xdd\x2c\x7b\x15\xd3\x77\xc3\xa6\xde\x5e\xad\x36\xd7\xcb\xfe\x7e\xf7\x3b\xe7\xfe\xa7\x1f\x4f\x4f\xde\xfc\x85\xb9\xfb\x75\xd8\x0c\xdb\xcb\xdd\xed\x76\xd1\xfd\x7c\x75\xfb\x71\xe8\x7e\x5b\xaf\xef\xc7\x5d\x7b\xd4\x69\x11\x15\x43\xdd\xc5\x87\x5f\xbb\xbf\x3f\xde\xdd\x6e\x77\xdd\x3f\xd7\xf7\xd7\xfc\xdb\xa6\x93\xf6\xf0\xc3\xe4\x64\xd1\x45\x31\xa6\x7b\x7b\xbf\x5a\x5f\x75\xe6\xaf\x5d\xc7\x0c\xfb\xe3\xfe\xfa\x65\x18\xda\x77\xab\xab\x65\xff\x8f\xcb\xa7\x61\xfb\x2f\xed\xbb\xc7\xcf\xeb\xcd\xb8\xec\x6f\x76\xbb\xbb\xc5\xd9\xd9\xc3\xc3\x83\x3c\x38\xb9\xdd\x5e\x9f\x59\x63\xcc\xd9\xb8\xbf\x9e\x8f\x2c\x1e\xd7\xab\xcd\xa7\xaf\x1d\xd4\x52\xca\x59\xdb\xed\xbb\xc7\x65\x6f\xee\x1e\xfb\xee\x69\xfa\x3e\x3d\x79\xd5\xed\x57\xc3\xc3\xdb\xdb\xc7\x65\xcf\xea\x4c\xa7\xc9\x76\x2e\x98\xce\x07\xd3\x77\xe3\xee\x69\x3d\x2c\xfb\x61\x73\xf9\x71\x3d\xf0\xc7\xcb\xfa\xe9\x7a\x7b\x7b\xbf\xb9\x5a\x6c\x86\x87\xee\xbf\x8f\xbf\x6e\x81\x2c\xc6\xbb\xcb\x3a\x2c\xfb\xbb\xed\x30\x0e\xdb\xfd\xd0\x37\x88\xb0\xd3\xed\x9e\xee\x86\x65\xbf\x1b\x1e\x77\x67\x75\x1c\xb1\xf1\x4a\xc6\x9d\xf9\x63\xdc\x6d\x6f\x3f\x0d\x8b\x1f\x7e\x69\x7f\xaf\xa7\x9f\xfc\xb0\xba\xda\xdd\x2c\xec\xf3\xcf\xcf\xab\xdd\xb0\x5d\xaf\x3e\xaf\x76\x0b\x35\xaf\xff\x3d\xdd\xd5\x3f\x7e\x5f\xad\xd7\x8b\x1f\x8c\x49\xc5\xf9\xd7\xdf\x6f\xc8\xce\x86\x7e\xf9\xdb\xdb\x9f\xd5\xfe\x09\x43\xee\x10\x91\xc6\x5c\xbe\xd3\xd0\x9b\xb3\x96\x78\x64\xee\xee\x72\x77\xd3\xd5\xf5\xe5\x38\x2e\xfb\x71\x67\xfa\xee\x6a\xd9\xbf\x67\x55\x15\x4b\x3e\xab\xb8\x6a\x58\xad\x24\xf2\x92\xd8\x62\xa1\x4e\x0a\x3b\x23\x5a\x8b\x38\x4e\xe2\xc9\x5a\x56\x25\x97\x59\xf5\x26\x38\xd1\xbd\x66\xf1\x37\x6c\xb3\x68\xf5\xed\x6a\xa6\x24\x85\x0a\x15\xdc\xb7\x52\x4e\x4f\x5e\x55\x95\x4c\x4e\x0a\xc1\x66\x86\x15\xec\x39\xf1\xd5\x50\x14\xcb\xd8\x56\x2b\x8e\x83\x78\xd2\x2c\xae\xb2\x93\x48\x91\xb3\x28\xa9\x91\xc8\xea\xa6\x1b\xb9\x72\x90\x48\x0e\x97\xe0\x2e\x20\xd2\x24\x8e\x92\x24\x38\xe2\x2c\x85\x54\x1c\x2e\x78\xf2\xcf\x8b\x22\x5a\x0d\x59\xb8\xc1\x25\x0a\x14\x25\x57\x27\x8e\xe0\xba\x88\x27\xf8\x53\xb8\x0b\x12\xaa\xfa\xc9\x07\x59\x4f\x41\x12\x01\x1d\x25\x09\xd5\x8b\x6d\x37\x80\xd0\x4b\x20\x55\xf1\x94\x24\xc3\x35\x0e\xe2\x3f\xc3\x12\x5c\x61\x61\xb5\x81\x54\x84\x14\xc8\x1a\x04\x14\xc8\xa6\x91\xad\xc3\x7d\x23\xca\xde\x00\x35\x92\xcc\x1a\xa5\x90\x41\xc6\x03\x3b\x71\xd8\x4a\xac\x46\x74\x64\x0d\xc0\x1c\x24\xe3\xc3\x02\xb4\x85\x57\x83\x3b\x4a\x45\x0a\xdb\x88\xcc\x62\xe5\x14\xc6\x92\x14\x0e\xa2\xac\x2a\xa5\x19\x99\x57\x41\x6c\x35\xec\xa6\x64\x70\x12\x4b\xed\x94\x11\x5f\x5d\xf3\xab\x94\xa7\x4a\x38\xc9\x1c\x5b\x05\xcf\x59\x8d\x95\x40\x01\x64\x79\xa6\x4c\xc9\x12\x5f\xd2\xe7\x4b\xf7\x9e\x53\x3b\x15\xbd\xa4\x16\x00\x19\xd4\xc9\x37\x4f\x5a\xb0\x55\x39\x10\xa0\x25\x89\x28\x5a\xfb\x56\x2b\x05\x59\xb2\x28\x88\x82\x01\x9e\x1c\xe2\xc0\x0a\x28\xd5\x48\x20\x83\x8d\xc0\x8a\xb4\xa2\xb2\x5e\x62\x45\xfd\x1d\x21\x0e\x90\x13\xdf\x93\x2d\x0e\x0d\x49\x64\x35\x80\x9e\x41\x83\x70\xce\x01\x97\x43\x8c\x12\x38\xea\x14\xe6\x8b\x88\x11\x7f\x44\x05\x7c\x34\x12\xe1\x97\x91\x52\x83\xbc\x65\xb2\x48\x52\x14\x47\x51\x42\x65\x54\x1f\x41\x44\x49\x54\x60\x0e\xfc\x04\x77\xc0\x68\x25\x0b\xa2\xa8\x28\x45\xc0\x43\xc6\xdb\x05\xd4\x89\x22\x29\x6e\xc5\x8a\xcf\x86\x5a\xd9\xe2\x59\xe4\x28\x0a\xb7\x5e\x02\xfb\x76\x39\x71\x69\x1e\x13\xaa\xee\xab\x61\xb4\x8b\x6d\x25\x10\xcf\xf3\x73\x00\x03\x63\x7d\xb4\x92\x38\x1a\xd4\x03\x08\x5e\x80\xf9\xd2\x9f\xfd\x4f\x05\xb0\x20\xab\xf7\x46\xec\x1e\xd4\x6a\xc9\x86\xfd\x48\xd6\x62\x95\x24\x90\x0b\xe0\x10\x5a\x00\xbf\x0c\xa9\x47\xa9\xd0\xd1\xa6\xd1\x3e\x57\x64\xaa\x71\x4a\xc5\x51\x41\xed\x10\x92\x86\xa9\x39\xd0\x6a\x80\x1b\x48\xd1\xad\x81\x9c\x4a\xde\xc7\x2c\xfa\x2e\x05\x09\x7b\x8e\x49\xf2\x24\x3f\xca\x60\xa9\x45\xd1\x82\x44\xb0\x5a\x21\x07\x89\x41\x7b\xb0\x3b\xa1\x07\x50\x6a\x5c\x41\xa0\x4a\x86\xad\x41\x65\x71\x3e\x23\xb2\x28\x69\x9f\xc3\x3b\x46\x3a\x3f\x34\x6c\xef\x00\xf3\x5b\x69\xd0\x8c\x86\xf5\x39\x49\xda\x07\x23\xb1\x1a\x02\x6f\x11\xb7\xd2\x54\x0d\xad\x48\x95\xa1\xd4\x8a\xa0\x68\x10\x30\xc0\xef\xc1\x71\x28\x13\x52\x10\xb8\xb4\x50\x32\xc0\x64\x9a\x64\xc9\x81\x0f\x62\xb9\xd1\x61\x6e\x47\xe4\xaf\x4e\xab\xa9\x8b\x19\x6a\xab\x0d\xde\xbc\x05\x6d\x99\xa0\xa1\x1e\xec\x1a\xa9\x62\xe5\x2c\x16\x9d\x85\x8c\xa0\xe4\x16\x32\x91\x27\x41\x80\x3e\x36\xf6\x67\xb0\xc4\x8a\xc7\xe1\x66\xa3\x1a\x46\xf1\x70\x41\x21\x64\x59\x14\xcf\xb5\x06\xf1\x1c\xa0\x9b\x0e\xd1\x44\xb2\x8e\xd5\xaf\x21\xfb\xf8\xed\xf7\x80\x88\xda\x80\xef\x09\x97\x54\xc2\xf1\xa3\x39\x6d\x50\x0d\x5b\x70\xc1\xb2\x2b\x80\x9b\x5b\x37\x18\x46\x70\x69\x22\xad\x83\xb4\x2b\xb8\xcd\x90\xf0\x32\x7d\xd6\x32\x79\xca\x50\x2f\x8f\xda\x3a\xf2\x68\x07\xac\xea\x64\x9a\x2c\x52\x08\x1a\x39\x83\x50\xcd\xd4\x29\x16\x62\xd0\xea\x53\x28\x50\x13\xdf\x6a\x45\x9b\x9c\xe3\x70\x6a\xeb\x76\x3e\x9d\xa3\xc2\x8e\x7c\x82\xa7\xb9\xda\xa9\xc9\xcf\xb1\xf2\x5f\xba\xf7\x1a\x03\x74\xca\x05\x89\x7b\x76\x41\xdc\x1a\x89\xce\x34\xf7\x26\x63\x92\x35\x28\x89\xa2\x78\x34\x05\xe4\x37\xa2\xc6\xae\x6d\x85\xc6\x8f\xd0\x1a\x7b\x44\x1b\x61\x26\x61\x58\xa9\xad\x10\x35\xfc\x43\x75\xa7\x47\xe2\x30\x6e\xcf\x35\x20\xec\xe0\x01\x44\x43\xa1\xe0\x15\xdb\xc7\x58\xbe\x4a\x5d\x9d\xa8\x9b\x31\x41\x9c\x69\xf2\xc3\xea\x91\x4b\x65\x87\xda\x3b\xd7\xbe\x2b\xc6\x39\x68\xe6\x1c\xd4\x28\xb0\x73\xe4\x2c\x24\x17\x44\xc0\x58\x40\xea\xe3\x71\x35\xa2\x9d\x73\x23\x38\xd9\x38\x89\x61\xc4\xc0\x42\xe0\x90\x5a\x07\x25\x03\x9d\x5c\xa8\x60\x70\x40\xc7\x23\x19\x09\x83\x92\x5d\xa8\x11\x64\x46\x62\x61\x04\x6d\xfc\x62\x75\x31\x45\xac\x98\xbc\xc7\xe0\xbf\x0a\xd1\x4e\x10\x51\x74\xa7\x0a\xe9\x6d\x86\xf1\xb6\x60\x3d\xfa\xdd\x89\x67\x8f\x15\x72\x79\xce\xd1\x91\x53\xc3\x31\x90\xb3\x19\x1a\x92\xc8\x79\x27\x16\xf1\xa0\x24\x1e\xc3\xa3\xe9\x96\x3d\xae\x4e\x4f\x5e\x8d\x98\x4e\xed\x1d\x05\x4a\xad\x78\x3f\xc0\xdb\xcf\x9c\x12\xb4\x87\x83\x18\xb4\xb1\x6d\x53\x23\x1e\x64\x1a\x23\x4c\x9b\x38\x42\x35\x9d\x17\x5b\xd9\xb1\x16\xc4\x83\x10\x8b\xe4\xc3\xe2\xa2\xe5\xab\x59\x38\xc0\xf9\x2a\x66\x37\x61\x2e\xd0\xd3\x76\xaa\x3e\x63\xa6\x03\x66\x3a\x60\xae\x8a\x19\x81\x29\x47\xb6\x85\x58\xe6\x54\x93\xf7\x2d\x5b\x0d\x36\x1f\xc0\x1e\x57\x80\xcd\x33\x6e\x3c\x6d\x93\x09\x9a\xfa\x0c\x9c\x0f\xc0\xf9\x19\x38\xcf\xc8\xe9\x19\x39\x1d\x91\x4f\xc0\xe9\x19\xf8\x61\x71\x91\xd3\x8c\xfb\x08\xe9\x5b\xc5\x66\x87\x4c\xd9\xa0\x98\x09\xf3\x14\x6a\xad\x8b\xa1\xe0\x01\x14\x1f\x23\x5e\x8f\xda\x28\x68\x2f\x4a\xd3\xce\x78\xf8\x79\x3c\x7d\x31\xdb\xb3\x78\x89\x3d\x9a\x9e\x23\xa8\xab\x6d\x5d\x0f\xc7\x18\xb4\xef\xea\xe3\xb2\xf7\x51\x5c\xdf\xd5\xa7\x65\x6f\xd5\x4b\xe9\xbb\xed\xb2\x87\xb1\x6f\xd4\xcb\x4e\xef\x09\xbe\xfc\xff\x61\x5f\xa8\xc7\x4b\x8f\x35\x85\x34\x29\x59\xd3\x5e\xba\xb4\xb4\xa4\x9b\x42\xb3\x69\x8b\xe1\xf9\xc2\xcd\x0c\xe1\x6c\xdc\x5f\xff\x78\x7a\xf2\x9f\x01\x00\x5b\xa1\x2c\xe0\x86\x0e\x00\x00"),
 		},
 		"/styles": &vfsgen۰DirInfo{
 			name:    "styles",
-			modTime: time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
+			modTime: time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 		},
 		"/styles/parser.css": &vfsgen۰FileInfo{
 			name:    "parser.css",
-			modTime: time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
+			modTime: time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			content: []byte("\x2e\x70\x61\x72\x73\x65\x72\x20\x74\x65\x78\x74\x61\x72\x65\x61\x20\x7b\x0a\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x31\x30\x30\x25\x3b\x0a\x20\x20\x68\x65\x69\x67\x68\x74\x3a\x20\x37\x65\x6d\x3b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x31\x65\x6d\x3b\x0a\x7d\x0a"),
 		},
 		"/styles/screen.css": &vfsgen۰CompressedFileInfo{
 			name:             "screen.css",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 35125,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\xeb\x76\xdb\x38\x92\xf0\x7f\x3f\x05\xd6\x39\xf3\x25\xf6\x48\x14\x45\x5d\x2c\xcb\xa7\x67\xc7\x97\xc4\x51\x26\x4e\xdc\x71\x3a\x17\xf7\xf6\x0f\x90\x84\x48\xc4\x24\xc1\x01\x40\x5d\xdc\xdb\xe7\xec\x6b\xec\xeb\xed\x93\x7c\x07\x00\x2f\xe0\x4d\x74\xb2\xee\xfe\xbe\x3e\x93\xf1\x74\x2c\x01\x85\x42\xa1\x50\xa8\x2a\x14\x0a\xf0\xdf\x1d\x1f\x52\x86\x38\xd8\xff\xe9\xfd\x8b\xfe\x6c\xff\x64\x6f\x70\xf8\x6f\x20\x22\x34\x84\x01\xbe\x47\x86\xc3\x18\x58\x8d\x0c\xd3\xb0\xc0\x7f\x82\xab\xc5\x7b\xf0\x1a\x3b\x28\x62\x08\xfc\x27\xf0\x30\x37\x30\x19\xe4\xb0\xe0\x70\xb0\xe7\xf3\x30\x00\xbf\x82\x25\x89\x78\x7f\x09\x43\x1c\x6c\xe7\x80\xc1\x88\xf5\x19\xa2\x78\x79\x02\xfa\x21\xeb\x73\xb4\xe1\x7d\x86\xef\x51\x1f\xba\x5f\x12\xc6\xe7\x60\x68\x9a\x7f\x39\x01\xfd\x35\xb2\xef\x30\x6f\xad\xff\x6d\x6f\xcf\x26\xee\x16\xfc\x0a\x42\x48\x3d\x1c\xcd\x81\x29\x0b\x21\xe5\xd8\x09\x50\x0f\x40\x86\x5d\xd4\x03\x2e\xe2\x10\x07\xac\x07\x96\xd8\x73\x60\xcc\x31\x89\xe4\xe7\x84\xa2\x1e\x58\x12\xc2\x11\xed\x01\x1f\x41\x57\xfe\xf6\x28\x49\xe2\x1e\x08\x21\x8e\x7a\x20\x44\x51\xd2\x03\x11\x5c\xf5\x00\x43\x8e\x6a\xc9\x92\x30\x84\x54\x74\xeb\x62\x16\x07\x70\x3b\x07\x76\x40\x9c\x3b\xd5\x77\xe2\x62\xd2\x03\x0e\x8c\x56\x90\xf5\x40\x4c\x89\x47\x11\x63\x3d\xb0\xc2\x2e\x22\x7a\x1b\x1c\x05\x38\x42\xfd\xb4\xe9\x0a\x09\xa2\x61\xd0\x87\x01\xf6\xa2\x39\xb0\x21\x43\xa2\xbe\x40\x3a\x8f\x08\x7f\xf6\xb3\x43\x22\x4e\x49\xc0\x7e\x39\xd0\x71\x45\x44\x40\xfa\x08\x7b\x3e\xcf\xb8\xf0\xb3\x8f\x5d\x17\x45\xbf\xf4\x00\x47\x61\x1c\x40\x8e\xea\x2d\x04\x6e\xf0\x2b\xb0\xa1\x73\x27\x86\x1d\xb9\x7d\x87\x04\x84\xce\x01\xa7\x30\x62\x31\xa4\x28\xe2\x0a\x6a\x0e\x1d\x8e\x57\x82\xa7\x73\x9f\xac\x10\x05\xbf\x02\x92\x70\x41\x61\xce\x75\xdb\xa6\x3f\x73\xcc\x03\xf4\x8b\x40\x49\xa8\x8b\x68\xdf\x26\x9c\x93\x70\x0e\x86\xf1\x06\xb8\x84\x73\xe4\xaa\x69\xeb\x01\xc6\x29\x89\xbc\x4c\x34\xd6\x29\xe9\x47\xa6\x42\xe6\x2e\xa3\xac\x8a\xf1\x6d\x80\xe6\x00\x73\x18\x60\x47\x56\xfa\xc3\xbc\x0e\xdf\xa3\x39\xb0\x50\x78\x92\x8b\x80\x31\x3d\x42\x61\x4a\x52\x08\xe9\x5d\x69\x78\x73\xf0\x64\xb9\x34\x4f\x40\x3a\xca\x27\x66\xda\x1d\x0b\x61\x10\x94\x91\xce\x52\x09\x63\x89\x20\x36\x89\xcb\xb5\x47\x93\xbf\x9c\x00\x39\x7f\x1a\xd3\x63\xc2\xb0\x10\x91\x39\xa0\x28\x80\x82\x5d\x1d\xf3\xaa\xd0\x72\x12\xcf\x41\xdf\x98\x88\x51\xa8\x0e\x25\xff\x14\xe3\xfa\x86\x95\x55\xe0\xd0\xcb\x19\x9b\x31\x9d\xad\x3c\x29\x17\x73\x4a\x08\x17\x22\x21\xe6\x66\x19\x90\xf5\x1c\xa8\xe9\x97\x50\x4a\xd6\xb5\x75\x32\x44\x21\x18\x9b\xf1\x46\xb1\x53\x4c\x66\x3f\x24\xf7\x7d\x9b\x6c\xc4\xf8\x70\xe4\xcd\x81\x10\x34\x14\x71\x51\x76\x02\x5a\x2b\xca\x22\x17\xcb\x4e\x0a\x12\x60\xc2\x89\xac\x70\x88\x58\x87\x77\xb6\x2b\x56\x04\xea\x01\x06\xc3\xb8\xaa\x15\x42\x12\x11\x16\x43\x07\xf5\xf2\x4f\x27\x3a\xcb\x87\x29\x1b\xec\x84\x73\xb1\x0c\x71\x14\x27\xbc\x07\x48\xcc\xd3\x15\xcb\x50\x80\x1c\x2e\xc4\x7d\xc3\x21\x45\x42\xb0\xd3\x79\xc6\x91\x8f\x28\xe6\x0a\x9d\xf6\xb5\xac\x36\x14\xe2\xd2\x00\x56\x98\x61\x3b\x40\xa5\x7e\x55\x37\x62\xde\x84\x5a\x92\x0b\x65\x49\x68\xa8\x2d\xa9\x0c\x52\xea\x3e\x49\xe6\xcf\x7c\x1b\xa3\x1f\x54\xf9\x2f\x3d\xbd\x8c\x22\x86\x78\xb9\x88\x25\x76\x88\xb9\x58\x44\x99\x02\x84\x71\x8c\x20\x85\x91\x83\xe6\x40\x21\x39\x01\x4e\x42\x99\x18\x5b\x4c\x70\xc4\x11\xd5\x3a\xfe\xd9\xc5\x0c\xda\x01\x72\x7f\x29\x91\x90\x97\x0a\xbe\xa4\x8d\x5d\xb4\x84\x49\xc0\xb5\xc6\xf3\xb9\x94\x84\x25\x71\x12\xd6\xc7\x51\x24\x54\xa2\x6c\x5f\xaf\x28\x0b\x63\x0c\x5d\x57\xca\x87\x62\xa6\x6c\x03\x7e\x2d\xaf\x11\x65\x19\x8a\x7a\x35\x5e\xc7\x47\xce\x9d\x4d\x36\x15\xc6\x40\x17\x13\xa5\x49\x0a\xd9\xcb\xb5\xca\xa6\xb9\x43\xd5\x34\x4a\x42\x1b\xd1\x5f\xe6\xf3\x8c\x7f\x92\xdc\x3e\x8b\x71\xd4\x2f\x49\x4f\x0b\x38\x49\x78\x19\x1c\xfc\x9a\x0b\x7a\x2e\xd2\xfa\x84\x21\x48\x1d\xbf\x6d\xc2\x84\x98\x2c\x31\x0a\xdc\x93\x8e\x45\x96\x35\xfe\xfa\x55\xd8\x48\x4e\x31\x1e\x55\xd0\x77\x04\x3d\x41\x13\x07\xda\x5a\xb8\xc8\x21\x14\x0a\x95\xd6\x32\xb6\x5c\xe4\xe5\x00\x85\xd7\x50\xc8\x84\x50\xf9\x8c\x04\xd8\x05\x0c\x07\x2b\x21\xa1\xf9\x72\x03\x56\xac\x4f\xa0\x31\x9a\xa0\x10\x18\x53\x4b\xfe\x3a\xca\xf4\x5d\x80\x3c\x14\xb9\x3b\xa5\x4c\x5b\xea\x0d\x5a\x27\x53\x0d\x6d\x46\x86\x8b\xf5\x50\xd8\x2a\x87\x04\x01\x8c\x19\x12\xcc\x55\x9f\x4e\xb2\x2a\xa1\x8d\xf4\x6e\xdd\x1e\xe0\x3e\xf8\xb5\x4a\xce\xe0\x10\x5c\x52\xec\x62\x16\x82\x53\xc0\x70\x18\x0b\x27\x84\x22\x16\x93\x88\x29\xe3\x19\xb9\xc0\x87\x91\xbb\x05\xe7\x37\x37\xc0\xa3\xd8\x05\xf6\x16\xfc\xdd\x21\xf6\x56\x34\xf2\x39\x8f\xd9\x7c\x30\xf0\x30\xf7\x13\xdb\x70\x48\x38\x48\xab\x06\x5e\x8a\xf7\x70\x20\x7a\xb9\x16\x6a\x83\xae\x10\x60\x24\x44\xc2\x9f\xc2\x7c\x2b\xaa\x0c\x01\xd6\x03\x46\x12\x61\xae\x4d\x59\xdb\x12\xaa\xc9\xa3\x5e\xd9\x56\xae\xc6\x79\x83\x38\x48\x62\xd5\x3f\x4d\x02\xc4\x00\x27\xc0\x13\x93\x10\x01\xee\x23\x35\xb6\x8c\xa2\x06\x57\xc9\x09\x10\xa4\x02\x31\xf7\x25\x4a\x05\x97\x11\xbe\x0c\x08\xe4\x73\x10\xa0\x25\x3f\x01\x6b\xec\x72\x3f\xf3\xf9\x72\x8e\x0f\x33\xf3\x35\x38\x04\xef\x7d\xcc\x00\x8a\x58\x42\x05\x1d\x3e\x02\x72\x05\x03\x2f\xe1\x1c\x51\x06\x20\x45\x00\xfd\x33\x81\x81\xa0\x51\x54\x3f\x73\x49\x22\x54\xe1\x01\x50\x8a\x2c\x05\x34\x0a\x82\x25\x21\xf3\x25\xa6\x8c\xf7\x1d\x1f\x07\x6e\x31\xd9\x7d\x41\xd5\x1c\x58\x59\xff\x7a\x83\x00\x36\xc0\x53\x25\x75\x96\x46\xf0\x1b\xc4\x38\x72\x25\x93\x18\x80\x01\x45\xd0\xdd\x02\x1f\xae\x50\xd6\x08\x70\x9f\x24\x9e\xdf\x03\x8c\x80\x00\xf1\xff\xf9\xaf\xff\x66\x20\x4a\xee\x10\xc0\x5c\x12\x29\xf9\xd4\x4d\xa4\x12\x4b\x1d\x7a\x17\x85\x25\x68\x41\x5b\x09\xf7\xdf\xf2\xd9\xc9\x9a\x49\xc7\xa5\xde\x48\xeb\xa2\xde\x26\xf3\x6b\xf2\x05\xf3\x1a\x71\x10\x23\x12\x07\x48\x0d\x50\x0a\x8f\x9a\x8f\x41\xc6\x0c\x87\x88\xa5\xc4\x51\xb0\x05\x38\x02\x10\x38\x24\x11\xf0\x64\x09\xd6\x70\xcb\x24\x43\x22\xd2\xcf\xa6\x5b\x76\x99\x2e\x02\xbd\x5c\x5f\xae\xe0\xdf\x70\x18\x13\xca\x61\xea\xdf\x0e\x0e\xc1\x47\x0a\xe3\x58\xf4\x06\x39\x80\x20\x84\x1b\x1c\x26\xa1\x12\x3e\x80\x99\xf0\x33\x30\x89\x60\x20\x7b\x5b\x53\x18\x83\x6c\xb1\x89\x5f\xaa\x44\x38\x58\x9b\x7e\x2a\xaf\xc7\x47\x33\x31\xe1\x85\xd2\xcb\xf5\x92\xe8\x4c\xa2\x75\x02\xc8\x18\x12\x12\xc0\x88\x9a\x7e\xe6\x13\xca\x85\x86\x10\x8e\x23\xc3\x24\x12\xd3\x2e\xcc\x13\x72\x01\x64\x60\x49\xa1\xdc\x84\x30\xf0\x82\x50\x80\x36\x50\xf0\x65\x0e\x96\x84\x02\xa8\x96\x9c\x64\xf7\x70\x30\x02\xcf\x48\x24\x98\x89\xa9\x7b\x20\x18\x25\xd8\xaa\xfc\x79\x35\xa4\x9e\xd2\x4f\x5b\x00\x63\xf1\xaf\xa4\xe4\x87\xfd\x75\x7f\xd8\x1f\xed\x67\xcb\x04\x05\x28\x44\x11\xd7\x97\xc5\xda\x27\x42\xa7\x65\xdf\xfa\xc3\xbe\xf0\xc5\x4b\x2b\xb4\x58\x12\x3e\x0c\x96\x25\x58\xab\x80\x9d\x94\x41\x49\x84\xfa\x92\xd8\x12\xfc\xa8\x80\x1f\x8d\x8c\xd1\x68\x64\x95\x1a\xf1\x35\x51\x8d\x98\xd6\xca\xd2\x5b\x4d\xa7\xc6\x74\x3a\x9d\xd4\xba\xfa\x67\x02\xa9\xdc\xf5\x69\x65\x4b\x92\x50\xc1\x17\xad\xff\x71\x81\xc9\x2a\x23\xe1\x3e\x45\x39\x9a\xa2\x7b\x55\xac\x30\xe9\x44\x8d\x74\x54\x47\x75\x7a\x96\x78\x59\xe9\x7a\xa2\x75\x6d\xd6\x46\x2d\xe1\xcb\xa3\xd6\x1a\x8c\xcd\x06\x5a\x6b\x4d\x46\x7a\x93\x69\xb9\x89\x18\x41\xbd\xc5\x58\x6f\x31\x2b\xb7\xf0\x48\xe0\xa2\xa8\x2f\x37\x52\x5a\x13\xaf\xcf\xb4\x39\x9c\x19\xd6\xd1\x70\xfa\x97\xdc\x56\xca\x36\xd9\xc6\x7a\x0e\x64\x63\x44\x41\x8c\x91\x83\x34\xa1\x4b\x71\x07\x90\x7a\xa8\x84\x3b\xd0\x06\x30\x34\x8e\xac\xd9\xa8\x15\xb7\x6c\xac\xa1\x1e\x1c\x82\x73\x61\x82\x96\x78\x03\xe0\x52\x58\x0b\xb4\x42\x74\x9b\x62\xd7\x0c\xd7\xe1\x3d\x91\x1b\xd8\x62\xb0\x73\x1b\x2d\x09\xcd\x28\x99\xab\xd6\x9a\x85\x93\x6e\xc5\x49\xe6\xa1\xcd\xc1\xfe\x7e\x7d\xab\x98\xe3\xca\x5a\x57\xed\xe1\xe0\x10\xfc\xc4\x71\x20\xec\x7a\xa6\x25\x04\x51\x72\x17\xd9\x77\x50\xa4\x5a\xc9\x2d\x48\xba\xb3\x54\x85\x0a\xb5\x02\x13\x36\xa0\x02\xa4\x2c\x6a\x01\x22\x35\x7f\x05\x46\x96\x29\xa0\x38\x09\x82\x0c\x4d\xc9\x26\xe7\x95\x19\x82\xb4\xb6\x68\x3b\x38\x04\xa7\x20\xa6\x24\x46\x94\x6f\x53\x0d\x65\x23\xa1\x89\x01\x45\x91\x8b\xa8\x50\xb5\x64\x09\x70\x08\x3d\xc4\x84\x5e\x17\xaa\x8b\x89\xdd\x99\x50\x5b\x4c\xa8\x76\x60\x63\x4f\xcd\x9a\xc3\xa5\x6d\x17\x96\xfc\x4b\xc2\xb8\x70\xaa\xf0\x3d\x72\x01\x56\x9a\x4d\x6a\x3d\x1b\x39\x24\x44\x2c\x17\xa3\xdc\x4e\xaa\x8d\xb3\xa6\x9a\x73\x45\x35\x38\x04\xef\x72\xf7\x0c\xdc\xf0\x64\xb9\x14\xcd\xfe\x1e\x22\x17\x43\xc0\x1c\x8a\x50\x24\x9d\xb6\x67\x5a\xeb\xc9\x74\x16\x6f\xc4\x5e\x5b\xb8\x41\x1c\x3a\x77\x00\x46\x5b\xee\x2b\xab\x0d\x39\xc0\x2c\xfa\x9f\xff\xfa\x6f\x0e\x96\x82\x3f\xca\x7e\x90\x28\xa7\x4a\x21\x65\x40\xa2\x75\x09\x62\xd1\x53\x2e\xf8\xb4\xc2\xae\x32\x7e\x11\xe9\x33\x81\x55\x58\x4b\x12\xf5\x43\x62\x63\xe1\x5e\x49\x29\x10\xc4\x81\x54\xee\xc4\xb6\xdf\x68\x06\x3e\xd0\x0c\xaf\x36\xe4\x92\xd1\x6b\xf2\x66\x5a\x3c\x16\x00\x34\xf3\x5e\xb3\xe8\xa9\xa7\xd1\xd0\x3e\x6f\x2e\x18\x45\x42\xc4\x71\x88\x58\x0f\x6c\x49\xa2\x26\x71\x0d\x23\x2e\x6c\x8d\x8d\x80\x8b\x97\x4b\x24\xad\x53\xc6\xaa\x9c\x51\x6a\xc8\x4a\xba\x75\x86\x34\x49\x7f\xc5\xac\x03\x60\xf8\xd8\x45\xe5\x56\xa5\x10\x58\xb9\x01\xf8\x4d\x48\xc4\xf3\x4d\x2c\xe6\x46\xcc\x85\xb4\xea\x10\xd8\x58\xcc\x26\xe5\x3e\xa2\x82\xbe\x54\x97\x68\x04\x36\x09\x0c\x8e\x72\x71\x1b\xce\x4c\x25\x31\xc6\x1a\xbb\x88\x56\x3c\x07\x59\x54\x96\x4f\xd9\xa0\xc1\x77\x00\xbf\xed\x19\x3e\xf6\xfc\x20\x5d\x75\x42\x8b\x91\x50\x18\x69\x70\x38\x10\xdf\x9e\x53\x4a\x68\xfa\xf9\x12\x45\x88\x62\x27\xfd\xf6\x0f\xb4\x5d\x13\xea\xa6\xdf\x5e\x63\x8e\xa8\x74\x66\xc4\xb7\x37\x30\x44\xe9\xc7\xb7\x31\xa2\x90\xe7\x38\xde\xca\x31\xab\xcf\xd7\x49\xe4\xf0\x44\x6d\x07\x55\x49\xda\xb7\x71\x95\x04\x42\x57\x45\xa8\x52\x7e\x4d\x51\x4c\x89\x53\x29\xbd\xc1\x91\x17\x54\x41\x6f\x62\xe4\xe0\x9c\xa0\x94\x74\xe3\x02\x09\xb7\xcf\xad\x94\x3e\x0f\x63\xbf\x5a\x54\x1f\xb7\xf1\x12\x41\xe9\x3d\x96\x4b\x17\x11\x43\xb4\x8e\xf3\x6d\xc2\x65\x38\x49\x6c\x13\xf0\x12\x23\x17\xac\x31\xf7\x01\x8b\x61\x24\x44\x34\xa6\x68\x25\xb8\x2c\xbd\x25\xb5\xd2\x31\x03\x32\x34\x99\x79\x4b\x97\x04\x04\x30\xf2\x12\x28\x8c\x14\x43\x08\x3c\x19\x0e\x27\x23\xa3\xd2\xcf\x35\x25\x61\xcc\x2b\x85\x37\x2a\x16\x5a\x29\x4c\x6c\xbf\x71\x04\xef\x29\x74\x90\x2d\xf4\x4e\x69\x66\x8d\x73\x12\x31\x21\xc8\x95\xe2\x0b\xe4\x04\x90\xea\xd3\x96\xd5\x88\x69\x97\x71\xb6\x4a\xf9\x35\x43\x89\x4b\x2a\x85\xef\xd4\x8e\xd3\xad\x14\xbf\xdf\xc6\xa8\x2c\x53\xc6\x05\xe4\xd5\xa2\x37\xd2\x87\xad\x14\xde\x70\x5a\x0c\x4e\xd0\x62\x9c\x72\x4e\xb1\x9d\xe4\xcd\x65\xe1\x59\x82\x03\x8e\x23\xbd\xe8\x3c\xd5\x85\x45\x41\x79\xe8\xb2\xec\x42\x05\x2f\x72\xb1\x90\x85\xcf\x23\xae\xb6\xca\x45\xc9\xc6\x41\xb1\xc6\x1c\x59\xf8\x42\x88\x7a\xa5\xec\x35\xb4\x91\xbe\x60\x6a\xec\x93\x85\xfa\x82\x91\x05\xd7\x99\x09\xd4\xca\xde\xc3\xd2\xb0\x3f\x40\x8a\x65\x28\xa2\xbc\x04\x8d\x8f\xc5\x7a\x7d\x8f\x36\xdc\xf8\xe8\x63\x5e\xea\xb1\xcc\x5e\xe3\x85\xb0\xc1\xcd\x55\x2f\xd1\xa6\xb9\x62\x11\x71\xe4\xd5\xa6\x26\xad\x7c\xeb\xf0\xc6\x39\x33\xce\xa0\x73\xc7\x71\x2e\x81\x95\xda\x73\x1f\x36\xcf\xb5\x71\x91\x2b\x83\x5a\x45\x52\x30\xa0\x52\xf7\x9c\x39\x30\x6e\xa9\x7b\x29\x76\x46\x6d\x48\xc5\xd8\x68\x4c\x82\xe6\x5a\x7d\xa6\x2a\x55\xef\x90\x57\xe3\x57\x5a\x55\x52\x5e\xd5\xba\x6d\x68\x93\xa0\x41\x76\xcb\x2b\xaa\x34\xe7\x75\x59\xce\x6b\x2e\x03\x62\x97\x54\x74\x51\xb5\x90\xe2\xde\x26\x07\xe9\x9c\x1a\xaf\x53\xa5\x52\x32\x1a\x86\x2f\x4f\x3a\xea\x47\x3e\x4f\x96\xcb\xe5\xd2\x91\x27\x2c\x3a\xb8\x53\x84\xd1\x9f\xcc\x8e\x1c\x84\xec\x2a\x04\xa2\x54\x83\x59\xca\xff\x55\x61\xbc\x4e\x88\x3b\x1d\xc2\x44\xd3\x59\x8d\x92\xa0\x13\x47\xd4\x09\x41\x3a\x21\x36\x9d\x10\x71\x27\x84\x13\x76\x32\xcd\xd1\xb1\x38\xee\xc4\x99\xd4\x39\x3f\xec\xc6\xc2\x3a\x41\x3c\x57\x03\x31\x4d\xd3\x74\xcc\x93\x72\x38\xd4\x26\x81\x7b\xd2\x24\x10\xa6\x39\x33\x67\x66\x0d\x21\xd2\x29\x4f\x11\x4a\x57\xac\x08\x17\xcf\x41\x22\x5c\xfc\xec\xa0\xab\xd4\x9c\x96\x9a\x3b\x5f\x41\x8f\xe8\xcc\xac\xd3\xe3\x77\x72\xd2\xc3\x0d\x53\xd6\x38\x60\x35\x9a\x52\x6b\xe1\x02\x18\x9e\x2e\x38\xd0\x75\x67\x68\xfa\x50\xaa\xc7\xae\xf8\xa9\x91\xd4\x2d\x45\x1e\xeb\x06\x49\xba\xc7\xce\x1f\x99\xdf\x77\x4e\xe7\x4a\xbd\x73\xbb\x41\xa2\x6e\x90\xb8\x1b\x84\x76\x83\xe8\xe3\xb7\x5d\xfb\x68\x5a\x5b\x22\x81\xdb\xc9\xe8\xb0\x13\xa2\x7b\xb2\x22\xd8\x0d\x62\x77\x83\x38\xdd\x20\x65\x2d\x07\x4d\x58\x9b\xc4\xa8\x7b\xc8\x51\x79\xd1\xb8\x08\xd6\x84\x38\x42\xdd\x58\x96\xdd\x20\x0f\x50\xec\xdd\x9a\x3d\x7a\x80\xe2\xde\x76\x63\xe1\x9d\x02\x15\xad\x34\x90\xe3\xd9\xd2\x3e\x9e\xd5\xac\xcc\xba\xb3\xa3\x6e\x88\xb0\x9b\x73\xa1\xdf\x0d\x82\xbb\x41\xba\x8d\x22\xeb\x96\x4b\xd6\x2d\x97\xac\x5b\xe8\x98\xd5\x0d\xd2\x2d\x74\xac\x9b\x2f\xac\x9b\x2f\xac\x5b\xa2\x58\xb7\xe7\xc3\x86\xdd\x20\xdd\xba\xc3\xee\x36\x17\x2b\xa7\x53\x2e\x57\x5e\x37\x08\xee\x04\xc1\xdd\xeb\xd5\x86\xcc\x4f\x57\x4a\x76\x06\x99\x30\x44\xfb\x2a\x77\x22\x3b\x37\x96\xc7\x8f\x8d\xe5\xac\xb1\x98\x34\x95\x36\x14\xc9\xc0\xde\xbf\xed\x81\x43\x00\x5e\x90\x88\x83\xd3\x35\x92\xe7\x94\x63\xc3\x32\x4c\x79\xe0\xea\xc2\x15\xf2\xe4\x39\x6c\x5f\x1e\xba\xce\x07\x03\x61\x12\xa1\x02\x34\x30\x01\x7d\xf0\x77\xad\x44\xe2\xca\x12\xdd\x5a\x9a\x0c\x82\xb4\xfe\xd9\x0b\x99\x65\x72\xb3\x78\x0d\xde\xbe\x78\x0d\x86\xc6\xb0\x07\xce\x6f\x6e\xe6\x7a\xb6\xdc\xc1\x9e\x0c\x1b\xa5\x09\x30\x0e\xaa\x26\xc3\x3c\x15\x38\x52\xba\x9f\x9e\x00\x46\x9d\x39\x48\x68\xf0\x6c\xdf\x30\x64\xb7\x4c\xef\x5c\xb0\x58\x7c\x35\x10\xe1\xff\xbe\xfa\x41\x0e\x73\xff\xe0\xab\x5a\x3d\xc1\x68\x89\x37\xff\x27\x6f\x0c\x96\x84\x86\x90\x3f\xdb\x47\xa1\x8d\x5c\x17\xb9\x7d\x12\xa3\x88\x6f\x63\xb4\x7f\xd0\x7b\x00\xce\x35\x59\x2e\xff\xbd\x8e\x4d\x14\x3f\x0c\x01\xe7\x4d\xed\x39\x4d\xd0\xc3\x89\x60\x2b\x2f\xc3\xf1\x44\xab\xa7\xc8\x4b\x02\x48\x35\xb4\x6c\xe5\x09\x7e\x95\xbc\xa2\x2c\x57\x45\xcf\x41\x2b\xf2\x57\x8c\x25\x6c\xcf\xe5\x53\x59\x46\x0a\xb8\xf2\x6b\x38\x8e\x37\x83\x21\xd0\x66\xb7\x94\xe3\x94\x67\x26\x49\x7f\x3a\x8f\x93\x67\x39\x0d\xd9\x5a\x52\x2d\x42\x42\x64\xd4\x79\x0e\x60\xc4\x31\x0c\x30\x64\x28\x4b\x32\x21\x6c\x53\x83\xf2\x28\xdc\x32\x07\xa6\xe9\x4c\xc6\x12\xf6\x03\x1c\xdd\xa5\xa7\x19\x72\x49\x67\x07\x16\xff\xb1\x34\x9d\xe1\x7e\x0e\x16\xa3\xc8\xc1\x41\x0b\xe0\xd8\xdc\xcf\x42\xe9\xd9\xf6\x94\xa5\x07\x2c\x67\x90\xc9\xb3\x96\xc3\xdf\x31\x15\x21\x4d\x00\x55\x2c\x1f\x9b\xa6\x4c\x6d\x7b\x0d\x39\xe9\x81\xa7\x2f\x51\xb0\x42\x1c\x3b\x10\xbc\x41\x09\x7a\xda\x03\x79\x41\x0f\x9c\x52\x0c\x83\x5e\x29\x15\xb5\x94\x14\xd8\xbc\x3f\xce\x13\x42\xe4\xd1\xf7\x24\xcf\x70\x79\x62\x9a\x47\xc7\xa3\x71\x25\x87\xc7\x87\x2e\x59\x8b\x49\x65\x88\x03\x13\x8c\xe2\x0d\x18\x99\xf1\x06\x50\xcf\x86\xcf\xcc\x1e\x48\xff\x6f\x8c\x0e\xf4\xf1\x7f\x4d\xab\xaf\xef\x46\x97\xa0\x25\x82\x3c\xa1\xa8\xcf\x10\xe7\x38\xf2\xd8\x1c\xec\xdf\x21\x1a\xed\x83\x61\x4a\x4f\x37\x14\xe9\x86\x79\x18\x80\xf8\x26\xe7\x57\xcb\x11\x33\x6c\x8a\xe0\x5d\x9e\xb6\x98\xe7\x0e\x8c\xd2\x04\x21\x43\x1e\x9a\x35\xe7\xe1\xaa\xba\xe2\x78\x2d\x17\x59\xb0\x7f\x52\x83\xd7\x0e\xe6\x4a\xe7\x70\x32\xfd\x4f\x1e\xc2\x95\xd2\x29\x65\xfa\x4b\x7a\x0e\x7f\x38\xd8\xcb\x32\x87\xf3\x04\x61\x95\x52\xbc\x33\x8d\xa5\xe1\xb8\xef\xa5\x44\x53\x20\x04\xfe\x30\xcb\x4a\x06\x11\x5c\xb5\xab\x9b\xdf\xf6\xf6\x44\x7d\x12\x54\x12\x92\x4a\xd9\x8d\x02\x22\xc0\x3b\x91\x18\x21\xc4\x51\x5f\x75\x95\x72\x5c\x89\xb9\xa5\xce\x71\x8a\x7a\x89\x28\x05\x49\xcf\x7a\xb2\x74\x9c\x12\x10\x2c\x2d\x7c\xb9\x70\x28\x74\x71\xc2\xe4\xda\xc9\x85\xbe\x5e\xd1\x54\x56\xd2\xce\xc7\xa6\x59\x4e\x0e\x1d\x97\x32\xca\x4c\x63\x82\x42\x95\x31\xaa\x92\xc7\xd3\x35\xa2\xfc\x83\x6a\xe2\x66\x12\xc7\x88\x3a\x90\x21\x2d\xe5\x5c\xd4\xa6\xc9\xbc\x30\x08\x80\x69\x58\x13\x96\x12\xdc\x56\x47\xda\x6a\x5a\x8a\xab\xcc\xca\x13\xab\x1b\x74\x8f\x35\x11\x3f\xbb\xf5\xcb\x50\x2c\xfc\x86\x75\x3f\x39\xe8\xa5\xb5\xa6\xaa\xb3\x26\x93\x1e\x28\xfe\x31\x8d\xe1\x2e\x05\xf4\xbf\x43\xfb\xf8\x18\xcb\x6c\x33\x9c\x84\xca\x73\xc3\xe6\x3c\xf6\x5c\x31\xe7\x4a\xdc\xb5\xe1\xd0\xea\x66\x64\x1b\x05\x05\x8d\x93\xc6\x11\x74\x30\xf2\xdb\xd1\x3e\x3e\x46\xb1\xe4\x19\x71\x30\x0c\xfa\xea\xf8\xb2\x7a\x8a\x9f\xae\xf0\xf4\x84\x58\x29\xdd\x72\x0b\x68\x30\x1f\x52\x29\xb4\xc5\xda\x8b\x37\x60\xdc\xb0\x88\x65\x59\x36\x0d\xa3\xd1\x68\x07\x36\xac\xa5\xaa\x67\xca\xa7\x02\x29\x9c\x66\x9b\x90\x5a\x7a\xff\x64\x7a\x34\x5c\x6a\xc6\x3c\xdb\x9c\x54\x9a\xf3\x35\xe6\xbc\xb2\xd8\xda\x5b\xef\x19\xea\xf8\xb8\x9f\xeb\xd9\x7a\x9a\x7d\x49\xbd\x57\x73\x41\x7e\x2b\x61\x80\x3b\x4d\x43\x9b\xe7\x91\x4b\xf4\x28\x8b\xb7\x56\x12\x3f\x76\xa8\xb5\x9a\xa6\xac\x2b\xd3\x7c\xfe\x94\x66\x7e\x2c\xad\x5d\x1e\x79\xd9\x02\x49\xe6\xf4\x1d\x14\x04\x27\xa5\x5c\xa7\x9c\x94\x59\xbc\xc9\xe7\x5f\xc3\xd2\xb9\xe6\x47\xe6\xf7\x05\xdf\xbc\xe0\xb3\x64\x59\x87\xb8\x08\xc8\xdf\x09\xe5\x88\x6d\xc1\x19\x8a\xc0\x19\x0c\x64\x52\x5c\x48\x5c\x75\x04\x6e\x6f\xc1\x35\xa4\x77\x88\x82\x2b\x22\x5c\xff\x25\xa1\xe0\x0b\xba\xdb\x06\x01\x95\xd9\xc9\xd9\x46\xd8\x46\x91\x61\xcb\xc6\x32\x67\xd9\x32\x87\xe3\x81\x39\x1a\x0c\x47\x83\x18\x7a\x88\xf5\x61\xe4\xf8\x84\xca\xed\x06\x1b\x00\xee\xa3\xa8\xe8\x43\xe0\xf4\xe4\x49\x57\x04\x43\xc4\x0c\x42\x3d\x99\xb9\xa3\x3c\x1f\xd9\x46\x68\x97\x86\xab\x35\x69\xe2\x89\xba\x38\x43\x62\xe8\x48\x3f\xad\xec\x17\x98\xc6\x4c\xd4\x36\x99\xf5\xb4\x85\xb0\xc6\x0c\x20\xc8\x50\x1f\x47\x7d\x92\x70\x61\x66\x9a\x4c\x7d\x17\x3c\xf9\x1a\xe8\xaf\x00\x15\xea\x27\x20\xf2\x00\xa2\xa4\x90\x47\xd9\xf5\x1d\x2b\x75\x1c\x74\x96\xf5\x80\x3f\x6a\x2e\x1e\x37\x17\x4f\x9a\x8b\xa7\x0d\xc5\x42\xfd\x65\xdc\x56\x69\x71\x69\xfa\x8b\x9e\x24\x75\x94\x25\x49\x15\xc6\x5a\x2a\xce\xa6\x44\x33\xf0\x5b\x13\x86\xd9\xc8\xac\x62\x30\x98\x4f\xd6\x2d\xd9\x3c\xca\x9d\x4d\xb3\x7e\x8a\x16\x1d\xf9\x3f\x79\xd2\xcf\x0b\xe5\xb3\x1f\x0e\xf6\x72\xef\xbd\xc9\x0d\x1b\x8a\x9f\xb2\x3e\x9d\xea\x99\x4f\xd9\x16\x65\xa2\x1b\xbb\xec\xd4\x45\x77\xaa\xb3\xdb\x57\x69\x6f\x95\xa3\x01\xbd\x26\x9d\x01\x95\xc4\x56\x66\x7c\x0a\x52\xca\xe5\x8b\x77\xf9\xf8\x69\x03\x85\xab\x05\xaa\x69\xa9\x49\x92\xb5\x74\xe6\x54\x04\xe5\x28\x73\x92\x8c\x99\x9e\x9e\xaf\x5b\x91\x36\x69\xef\x58\x65\x3b\x17\x55\xfb\x1a\x6a\x13\xc9\x3c\x6f\x2f\x63\x5b\x9e\x74\x0c\xe2\xfa\x26\xd3\x54\xa2\x94\xc1\x16\xb9\xc6\x3a\xb0\xba\xba\x97\xe6\xc9\x29\x41\x5a\x44\x9c\x12\xa9\xc1\xb0\xfc\x54\x49\x97\x53\x3b\x25\x60\xe6\xc9\x75\x29\x58\xe5\x7e\xa1\xdc\xba\x94\x92\x46\x87\xfa\x25\x47\xb3\x3c\xc6\x22\xd5\x6c\x32\x3d\x4e\x17\x4d\x3b\xda\x94\xd0\x53\x5b\xe8\x98\x34\xc9\x40\xe6\xb2\xa8\x0c\x53\x51\xdc\xf7\x22\x60\xc4\x50\x9e\xef\x34\x2c\x03\x84\x50\xab\x8b\xa0\x36\x82\x4d\x3e\x82\xaa\x69\x2c\x6c\xd8\xa4\x35\x89\x61\xed\xf2\x47\x41\xad\x6f\x55\x46\x6a\x8c\x0b\x86\xa9\x79\x4a\x2f\x10\x16\x6d\xe2\x1e\x28\xbe\xc0\x26\x4e\xe9\xc0\x9a\x4f\x2a\xef\x28\x15\x57\x18\x4e\xd5\x7d\x63\xd0\x07\x3f\xb1\xd4\xa2\x89\x8d\x3d\x70\x89\xc3\x64\x32\x60\x84\xd6\x92\xb9\xe9\xc5\xe4\x3f\x88\xa9\x39\xb7\xac\x4a\x32\x61\x8a\xad\xc1\x21\x52\xd1\xa3\x61\x53\xf4\xa8\x71\x8f\xd8\x01\xff\x15\xa0\x35\x8d\xda\xbc\x86\xc7\x59\x26\x65\xc6\x4a\x3d\xea\x51\xbe\x99\xd3\x6c\x55\x72\x25\x50\xcc\x85\xbe\xa8\xf4\x46\xc5\xaa\x3a\xaa\x37\xca\x99\x3b\x96\xfb\x1d\x33\x0d\xba\x95\x06\x62\x0d\x33\x42\x0c\x97\x38\x7d\x57\x5d\xc1\x2e\x79\x27\x47\x42\xcc\x52\x09\xf0\x28\xda\xa6\xb9\xd9\x71\x9c\x43\x97\x76\x64\xdd\x6d\x07\x87\xe0\x9d\x80\xed\x33\xec\x22\x19\x37\xea\x83\x84\x29\x5f\xae\x45\x28\x25\x64\xe5\x56\xcf\x28\x5d\x60\xaa\xd2\x1f\x37\x5c\x77\x7d\xf0\xf6\xe2\xa8\xb4\xbd\x30\x53\x01\x4c\xbb\x28\xd9\x93\xbe\x2a\x6b\x31\x4c\x0d\x57\xca\xf3\xe8\xeb\x70\x3a\x3b\x2e\xe8\x6d\x10\x0a\x53\xaf\xad\xdc\x99\xd2\x95\x84\x06\x27\x37\x2b\x01\x66\x69\xf8\xbf\xcf\xb7\x71\x7e\xe5\xb1\x76\x9d\xc9\x48\x83\x92\x79\xd3\xaa\x3e\x51\x6e\x42\x83\x52\x2b\xda\x14\xfb\x9a\xa6\x58\xfb\xfe\x89\x76\x51\xb1\x72\x47\xbf\xf5\xb3\xd2\x2a\xd9\x2d\x46\x75\x8a\x21\x99\x96\x17\xe6\x7b\x4f\x41\x5f\xfa\xc5\x2c\x85\x44\x0b\xa2\xa1\xcd\x48\x90\xf0\xcc\x21\x30\x33\x1f\xbc\x3f\xd2\xee\xb6\x5d\x10\x27\x09\x51\xc4\xb3\x8c\x53\x29\xfc\x4c\x5f\x6f\x38\xca\xad\xda\xcc\xcc\x34\xb9\x04\x32\xd2\xe1\xd6\xaf\x56\x1a\x69\x74\x35\x8d\x4f\x36\xed\x7f\x4b\x02\xac\xfc\xab\x26\x7e\xeb\x1b\xfe\x84\x06\xcf\x0c\x63\x80\x43\x6f\x90\xd2\xd7\x57\x06\xdf\x88\x23\xef\x40\x8c\x32\x4b\x2c\x8f\x48\x9f\xa2\x18\x41\xed\xd6\xb8\x5c\xf4\xd2\xf8\x17\xf6\x48\xa7\xf2\x6f\xc0\xc5\x2b\xed\x56\xce\xb1\xa1\x2e\xfe\x94\x80\x60\x0f\x94\xbe\xcb\x84\xe3\x8a\x2f\x58\x13\xa3\xae\xa5\xd8\xbc\xf2\xc4\x6e\x7a\x68\xe5\xd4\x3e\xda\x16\x7f\x70\x58\xbf\xf2\x2b\x09\x3f\x1c\xb4\x18\x99\xb6\x00\xe0\xe8\xa0\x57\xda\xea\x0e\x33\x98\x86\xcd\x6e\xa3\x35\x7a\x1c\xc4\xbf\x0b\xce\xba\xc1\x3f\x9a\x8a\x9f\xba\x44\xe4\x71\xe0\xf2\xc1\x53\x1d\xc1\x6c\x36\xab\xb5\x36\x22\xb4\xe1\x15\x99\x32\x62\x8a\x56\x2d\x9b\xe8\xa6\xe6\xea\xd4\xa4\x09\x49\xa1\x97\x34\x99\x1c\x4d\xf5\xcd\x8e\x65\x59\x8d\xe1\xa5\x36\x25\xd2\x3f\x6a\x58\x3a\x1a\x19\xba\x0e\x7c\xfa\x1f\x96\x39\x3a\x7d\x7a\x02\xaa\x87\x0e\x3b\x08\x2d\x37\x3e\x7e\x9a\xe9\xac\xf6\xb6\x8d\x03\xcf\xe6\xa4\x6c\x55\x46\x66\x1b\xf1\x55\x24\x72\x40\x55\x24\xe9\x30\x9a\xb1\x64\xef\x35\xe8\xbb\x3e\x63\x72\xd2\xf8\x76\x83\x81\xc3\x98\x92\x55\xcd\x8c\x5b\x93\x8a\x4b\x92\xf9\x56\x79\x03\x7d\xe3\x79\x7c\x7c\xac\xb0\x09\x5d\x2c\x88\x48\x77\xcd\xc5\x93\x17\xa5\x23\xd2\x86\xcb\x54\x6f\x52\x9f\xc2\x1f\x66\x63\xa9\x98\xd7\xec\x11\x8f\xcc\x1a\xf8\x56\x97\x2d\xde\x33\x62\x22\xea\x21\x47\x1e\xa1\xdb\x1e\x50\xdf\x43\xc4\xe1\xc3\x5f\xd3\x09\xb1\xeb\xaa\x3b\x79\xe5\xa0\xa0\xc6\x19\x15\x28\xaa\xf5\xd7\xde\x47\x93\xe7\x92\xeb\xda\x69\xe6\xeb\xce\xf4\xe8\x40\xba\x6b\xde\xa5\x7e\x4d\x19\xbd\x13\xff\x99\xcd\x6a\xb8\x04\xb0\xab\xae\x69\xb5\xb7\xab\xe3\xc9\x03\x34\x5c\x5b\xe4\xd1\xd2\xe0\xfa\x1a\x60\xd7\x11\xf7\x1f\xd6\xf3\xff\x9b\x4e\xeb\x0a\xfb\x18\x59\x33\x21\x76\x5a\x95\xbc\xfe\xa8\x1c\x11\x17\x72\x38\x97\xdf\x07\x6c\xe5\xfd\x75\x13\x06\x27\x36\x64\x68\x3a\xee\x5d\x5f\x1c\x8f\xed\x8f\x6b\xcf\x0d\x3f\x6c\x1d\x2b\x58\xd9\x5f\x4c\x7c\x75\x33\x5e\x2f\xf0\xe9\xe0\xda\x23\x33\x67\x74\x1b\x2d\x5e\xfa\xdc\xbe\x9c\xdc\x5f\xdf\xbc\x22\xee\xcb\x77\xeb\xb7\x78\xb6\x72\x47\xee\xe8\x75\xe4\xdc\xbf\x0e\x8f\xb7\xb7\xdb\xd9\xf6\xea\xe2\x74\xfd\x7a\xf4\xc6\xba\xdd\x2e\x3c\xd7\x0a\xee\xdc\x4b\xef\x78\xf1\xe5\xf9\xfa\xea\xfc\x27\xbc\xb8\xf4\x03\xf8\xd1\x25\xee\x85\x89\xaf\xde\x9f\xae\x5f\xdd\x2c\x3c\x37\x0c\x02\xd7\x7c\xb5\x42\xa2\xec\xfc\x74\xbd\xb8\x78\xee\x5d\xdd\x2c\x3c\xe7\xe5\xab\xc0\xb1\x3e\x6c\xdd\xf0\xc3\x99\x33\x3a\x0b\x3e\x8f\xde\xdd\x7c\xfe\xf4\x2e\xb6\xef\x4d\x6c\x87\xc7\xc9\xed\xcd\xe2\xaf\xe7\xf8\xd4\xbb\xbe\xdc\xc4\x76\xf8\xc1\x77\xee\xdc\xed\xe7\x8f\xef\xe2\xdb\x8f\x13\x73\x71\x19\xdc\x5d\xdf\xbc\x8a\x9c\xf0\xc5\xdd\xeb\x4f\x1f\xbe\xdc\x5a\x0e\xbf\xb5\x3e\x24\xb7\x9f\x5e\xf9\xee\xe5\x87\xbb\x05\x3e\x93\x75\xf0\xe3\x87\xc4\x7d\xf1\x21\x81\x9f\xde\x89\x31\x0d\x45\x7f\x3f\x8d\xce\xfc\xcf\xd6\x87\x6b\x3b\xf8\x70\x7f\x7b\xb3\xf0\xd0\xc5\xf3\xe3\xc5\x97\xd3\x60\x81\xcf\x26\x57\xef\x05\x8d\x3f\xe1\xc5\x4b\x6f\x7b\x7d\xb3\x90\xf4\xa3\xf7\x8b\x7c\x7c\xd7\x1e\xf1\x16\xe7\xa7\xde\xf5\xcb\x37\xa6\x3d\x3a\xf5\x6c\xeb\x36\x74\xac\x0f\x66\x06\xeb\x8c\xde\xad\x9c\xf3\xe1\x17\xdb\xda\xac\x9c\x2f\x26\x5e\xdc\x07\xc1\xd5\x17\x6f\x73\x85\xf3\xba\x95\x73\xf9\xe2\x0b\xfc\xf4\x6e\x72\x7d\xb3\xd8\x2c\xf0\x4c\x8e\x71\x71\x7e\x3a\x53\xf5\x67\xab\xdb\xf0\xf6\xfe\xf6\xd3\x8f\x05\x4f\x55\x5f\xfc\xb3\x75\xcc\xec\xd1\xe2\x78\x81\xaf\xac\xdb\x2f\x67\x77\x57\x97\x3f\xe6\x75\xb6\x1c\x53\x60\x22\x41\xff\xcd\x62\x25\xe9\xbc\x58\xaf\xec\xcb\x20\xb9\xfd\xf8\x62\xfb\x6e\xf4\xca\xbf\xbd\x0c\x02\x3b\xfa\x51\xf1\xf4\xe5\xab\xe0\xf3\xe8\x47\x4f\xcd\x89\x18\xa3\xfa\xad\xcd\x67\x79\x2e\x6f\x16\xde\x6d\x18\x30\xfb\xc2\xc4\xee\xa7\x57\xec\x1f\xe7\x6f\x5a\x79\xff\x8f\x9b\x85\xf7\xfa\x7e\xfc\x8f\xeb\xf3\xe3\x7b\x37\x74\xfe\x7a\xd0\x24\xaa\x99\x0e\xf1\x84\xd6\x41\x11\x7f\x26\x94\x22\xa4\x3d\x69\x57\x85\x4d\x4f\x3f\xa9\xad\x51\x0f\x2c\x29\x09\x9f\xa5\xe7\xb0\x07\x3d\xc0\x89\xf8\x62\xb9\xc3\xd1\xc1\x4e\xf4\x0a\x6b\xd1\x8b\x44\x9c\xa2\x01\xe6\x5f\xe4\x67\x81\x45\xda\x9f\x66\x4c\x42\xdf\x3c\x02\x1a\xf2\x08\x48\xea\x18\x72\xfe\xec\xc0\xb3\xc4\x01\x17\x7e\x75\x4c\x89\x87\xdd\xf9\xc5\xa7\x85\xc0\xf6\x3e\x73\xfc\x8d\x2b\xec\x50\xc2\xc8\x92\x1b\x39\x62\xc0\x38\xa4\xfc\x5c\x28\x1c\xc6\xe9\x0f\x4f\x53\xec\x4f\x7b\x00\x45\x6e\xa9\x78\x3a\xb5\x9c\xa7\xbd\xcb\xb4\xe1\xfb\x6d\x8c\x7e\x30\x81\x3a\x5f\x2f\x59\xc0\x96\xbd\x68\xbb\x43\xa7\x3c\xab\x7c\xdb\xdd\xbe\x69\x15\x44\x98\xd6\x71\xfe\xbb\xf4\xe8\xdc\x03\xf6\xac\x93\xd6\x2d\x6b\x61\xc6\xd3\xfd\x64\xfb\x15\x70\x23\x90\xf7\xfa\x2a\x27\xb2\x5f\xb5\xc3\xea\x08\x37\xd7\x1c\x8a\x46\x1f\xb2\x23\xf2\x54\x43\x52\x76\x3f\x26\x39\x12\x03\xae\x20\x97\x59\x51\x2d\x1e\xc6\xa8\x6d\x83\x37\x6a\xd8\xe0\x8d\x76\xc4\x63\xda\x9c\xac\x9c\xf9\xa9\x8f\xa6\x9f\x2c\x03\xed\x64\x76\x3a\xad\xde\xa1\x99\x9a\xfa\xdc\xb9\x90\xa3\xcc\xdd\x83\x09\xf7\x09\xad\x0e\x3b\x7f\x03\xc8\x90\xc1\xac\xcc\xa9\xfc\x6b\x2d\xb8\xd7\xd7\x25\xb3\xd5\xf3\x4a\x03\x53\xed\xc1\xd6\x0a\xc8\x83\x6a\x95\xdb\x5b\x6c\x8c\x27\x93\x49\x9b\x1f\x96\x3a\x13\x4f\xac\xa5\xf8\x69\x74\x98\xaa\x20\xbb\x6b\x95\x53\x7e\x4e\x5c\x04\x5e\x66\xc9\xd9\xea\xca\xed\x9e\x7c\x86\x4f\x9e\x02\xff\x0a\xd6\x3e\xe6\x48\xbe\x71\x85\x84\xae\x41\x27\xbb\x5d\xde\xfc\x8c\x75\x9e\xa6\xb3\x0a\xd7\x19\x5c\xa1\x28\x20\x3d\x70\x4e\x22\x46\x02\xc8\x7a\x60\xff\x9c\x24\x14\x23\x2a\x36\x05\xfb\xa2\x42\x7e\xeb\x81\xfd\xd7\xd8\x46\xe9\x7d\xe4\x2b\x12\x91\xfd\x1e\xd0\x1e\xfc\xd3\xe3\x84\xf9\x93\x88\xbb\x16\x86\x3e\x90\xfa\x46\xa7\x94\x97\xde\x03\x31\xf8\x9b\x6a\x20\x3e\xa8\x47\x09\xc5\xa7\x88\xd8\x34\x2f\x08\x70\xfe\xd1\x9f\xe4\x1f\x8d\x88\x70\x94\x7e\x6b\x39\x1b\xb4\xc5\x4f\x25\x77\xe4\xd1\x82\x2c\x5d\xe9\x0b\x43\xb3\xc5\xa3\xed\x4a\xa8\xea\xb5\x7b\xb1\x9d\xc9\x0d\xbf\x57\xa7\x7f\x74\x7f\x52\x83\x88\xf9\x6d\x9f\xdd\x96\x18\x4c\x15\x9b\x75\x50\x4d\x9b\x32\x26\x5a\x98\x3b\x7b\x58\xc3\xa8\xee\xcb\xcd\x62\xfb\xa9\xbf\xef\xa0\xbf\xd7\x69\x9e\x54\xcf\x10\x2b\x5b\xf0\x86\x17\xf0\x06\x87\xe0\xe5\xfb\xab\xd7\xe0\xb9\x7a\xc9\x29\xdd\x9b\xf7\x80\x6f\xf5\x80\x3f\xea\x01\x7f\x2c\x84\xbc\x07\xfc\x69\xfd\x11\xdd\xd2\x76\x5f\x25\xd1\xd5\x6e\x79\xa6\x17\x33\xfe\xd0\xa4\xc9\x7a\x7c\xcc\x85\xc7\x8e\x69\xa9\xf7\x50\x77\xbe\x2a\x1b\x57\x1f\xa4\x1c\xe6\x2a\xc6\x68\x7b\xe5\xa6\xf5\x81\x9b\x87\xbd\xa0\xf3\xe0\x97\x78\xba\xde\xf4\xd1\xce\x19\xe5\x94\x6a\xc7\x89\x62\x36\xf3\xcf\x23\xed\xf3\x58\xfb\x3c\xd1\x3e\x4f\xeb\xa2\xf5\x5b\x81\x6d\xdc\x30\xf3\x45\x75\x12\xa8\x83\x91\xfa\x59\x69\x19\x22\xae\x4a\x54\xb9\x5a\x5a\x94\x7f\x26\x84\x97\x9e\xa5\x4d\xc5\xba\x02\x5c\x50\x4e\x02\x3d\xf7\x58\x9d\x40\x34\xce\x67\xe9\x78\x50\x83\xae\x9c\x2a\xe4\xb2\x5f\x1d\x4d\xcb\xcb\xc3\x25\xaa\x53\x35\x9d\xae\xf3\xc2\xc2\x1f\x1d\x1d\x35\x3f\xaf\xd3\x84\x54\xef\x78\x56\x3b\x3a\x9b\x98\xf9\x21\xf3\xfb\xfc\x66\x45\xf6\x6c\x65\x57\xd6\xa0\xf4\x34\xf2\x23\xeb\xf4\xc4\x3a\xad\x94\x26\xff\x77\x36\x4f\xbb\x42\xe7\x5f\x19\xbd\x7f\x78\x4c\x5e\xb0\x4b\x31\xa8\x94\x52\x93\xc6\xfb\x7e\xdb\xdb\xe3\x3e\x82\x6e\xdd\x3b\xe6\x24\x96\x73\xd5\xc5\x03\x01\xa8\x25\xbf\xec\x6e\x5d\xef\x42\x6a\x86\x87\xf4\x91\x5a\x8a\x6a\x27\x0d\xed\xbb\x4e\x09\x46\x50\xfc\x7c\x0f\x3a\xfd\x39\x82\x4e\x6f\xfc\x2b\xeb\xf9\xfd\xe7\x9b\x47\x0d\x3a\x6d\x6e\xdf\xbf\x08\xae\x3e\xfe\xf4\xaf\x16\x74\x52\xa2\x9f\x06\x9d\x86\x48\xfc\x7c\x4b\xd0\x49\xa1\x51\x01\x1a\x85\xe5\x9b\x82\x4e\x5f\x85\x86\x3c\x02\x92\x5d\x41\xa7\x76\x3c\x8f\x11\x74\x52\xd8\x6b\x41\x27\xd5\x51\x73\xd0\x49\xe9\x65\xf5\xaa\xf2\x03\x4f\x2e\x1e\x12\xac\x1f\x7e\x6b\x82\xf6\xf0\x1b\x52\xb0\x87\xa5\x91\x54\x4e\xb6\xfe\x00\x83\xa3\xf5\x5d\x7a\xe7\xf7\x8f\x31\x44\xf2\x61\x6c\x3d\xde\x63\x94\xdf\xf3\xe6\x6e\xdd\x29\xdc\x93\x13\xfe\xc0\xd4\xa2\x69\xf9\xd5\x70\x0d\x7b\xf5\xbe\x06\x97\xd7\x42\x79\xf1\x78\x78\x2d\x0c\x73\x34\x16\x3f\x6d\x51\x9a\xc6\xe4\xb7\x3f\x8b\x04\x56\x13\x5c\xbe\x9b\xf8\xff\xaf\x4c\xbc\x75\x1b\xca\x9f\xe6\x73\xa5\xf5\xeb\x2f\xcf\xb1\x30\x87\x02\xe7\xe2\x62\x73\xef\x5e\x1e\xaf\x17\x97\xc7\xe1\x6d\xf4\x26\xd0\x79\xf8\xb5\x78\x33\xd7\xe1\xfa\xfc\x98\xc1\x8f\x93\xe0\xf3\xa7\x57\x2f\x73\x7e\x5c\xc8\xfe\x66\x4e\xf8\xe1\x8b\x7b\x7e\x36\x56\xf0\x67\x69\xbb\xb3\x11\xfc\xf8\xce\x84\xa9\x59\x87\x97\x1f\xe2\x5b\xcb\x37\x95\x3b\x72\x16\xc2\x8f\x1b\x26\xf9\x18\xae\xc9\xc2\x12\xf3\xf1\x23\x77\x3f\xbe\x89\x6e\xb7\xc3\xe8\xf6\xe3\x24\x70\xc2\x17\xe6\xed\xc7\x1f\xe3\x05\x3e\x15\x6e\xc6\x4c\xca\xc9\xfd\xf8\x87\xc7\x33\xf3\x6d\x2b\x41\xd9\xfd\xc6\xda\x83\x6f\x70\x03\xda\xba\x91\x36\xb4\xb9\x97\x6f\x71\x13\x1e\xb7\x1b\xf2\x07\x74\xb2\xc3\xcd\xf8\x5f\xf4\xf3\x18\x6e\xc8\x10\xaa\xe7\x49\x1a\x4e\xbf\xb2\x8a\x46\x57\x24\xb3\x1e\x71\x63\x4c\x59\xaf\x6f\x08\x3c\x8f\x53\x5b\x28\xaa\x0c\x92\xfe\xb5\x29\xee\x83\xfc\xb3\xac\x50\xc3\x53\x15\xea\xb3\xb6\x5d\x9d\x98\x67\xd9\x51\x88\x02\x0e\xa0\x97\x82\xca\x4f\x0a\x75\xfa\x62\xa9\x44\x2d\x3f\xeb\x71\x9b\xf1\xf1\xc5\xf9\xf3\x3a\x1d\x1a\xba\x12\x19\x1a\xc6\xfa\x55\x08\x4b\xfb\xc3\x05\x84\x23\xc0\xb7\x31\xca\x5e\xdd\x2f\xc5\x6f\x46\x69\xfc\x66\x67\x6a\x8c\x4c\x98\x54\xff\x8c\xbf\x27\xc7\x7c\x4f\x8e\xc9\xd3\x21\xd1\xd4\x1d\x7f\x4f\x8e\xf9\x93\xc4\x29\xdc\xe0\x4d\xf8\xa3\xf9\xc8\xc9\x31\xc3\xcf\xf7\xef\x82\xab\xfb\x7f\xb9\x38\x85\x12\xfd\x34\x4e\x31\x71\xc6\x68\x34\xf9\x96\x38\x85\x42\xa3\xf6\xf4\x0a\xcb\x37\xc5\x29\xbe\x0a\x0d\x79\x04\x24\xbb\xe2\x14\xed\x78\x1e\xc3\x41\x50\xd8\x6b\xee\x81\xea\xa8\xd9\x39\xd8\x9d\xea\x51\x32\x88\xd9\x71\x5f\xff\x81\x29\x1e\xe5\xc6\xf5\xcc\x0e\x51\xff\x3b\x66\xe3\x58\x96\xa5\xfe\x79\xcc\x3c\x1c\x35\x28\x7f\x92\x9d\xa0\x57\x22\x00\xd5\x67\x1a\x52\xe8\x96\x73\xba\xb6\x27\x60\x6a\xaf\x73\xe5\x5d\x95\x5a\x8c\x2b\x8f\xc6\xa4\xc1\x83\xd6\xbb\x3c\x8a\xe1\x4d\x59\xe8\x53\x73\xf2\xf4\xa4\x76\x48\xd6\x3a\x09\xca\x27\x4c\x73\x56\xc6\xd5\x5b\x62\xb3\xe6\x1b\x53\xd9\x30\x60\xe3\x75\x90\x75\x71\x81\xa6\x00\xdd\xfd\x04\x50\x0e\xb3\xeb\x1d\xa0\xb6\x8b\x27\xe9\x01\x75\x13\xf2\xa1\x7a\x6a\xa7\x38\x49\x94\x17\xd8\x02\xec\x64\x7f\x39\xae\xf1\x89\x86\xd9\x31\xfc\x7e\x28\xf1\x27\x31\xf6\xa7\xf7\x6f\x2f\x02\xff\x91\x0f\x25\xd6\x57\x17\x9f\x37\x6f\xac\xcf\xff\x72\x99\xb0\x52\xf4\xf3\x4c\xd8\xe9\xf0\x68\xf9\x4d\x99\xb0\x12\x4d\x96\x35\x2a\xb0\x7c\x5b\x26\xec\xd7\xa0\x21\x8f\x80\x64\x67\x26\x6c\x2b\x9e\x47\xc9\x84\x95\xd8\x9b\x32\x61\x87\x47\x2d\x91\x80\x3d\x23\xc0\x36\x55\x7f\xec\xf9\x7b\x3e\xff\xf7\x7c\xfe\xef\xf9\xfc\x99\x16\x53\xa2\x9f\x6a\xb1\xe9\xd2\x74\x4d\xf7\x5b\xb4\x98\x42\xa3\x56\xbc\xc2\xf2\x4d\x5a\xec\xab\xd0\x90\x47\x40\xb2\x4b\x8b\xb5\xe3\x79\x0c\x2d\xa6\xb0\xd7\xb4\x98\xea\xa8\x4d\x8b\x25\x11\x45\x01\x82\x4c\xde\x26\x6c\x7a\xe9\xdf\x3d\xb6\x46\xc7\xdf\x15\xd9\x9f\xe3\x00\xe9\xcd\xdd\xdb\xf7\x8b\xfb\xb7\x8f\xea\x8e\xbd\xf1\xaf\xbe\x38\xc3\xab\x2f\xde\xbf\x9a\x22\x53\xa2\x9f\x2a\x32\x68\x1d\x4d\xac\xd9\xb7\x28\x32\x85\x46\x2d\x7a\x85\xe5\x9b\x14\xd9\x57\xa1\x21\x8f\x80\x64\x97\x22\x6b\xc7\xf3\x18\x8a\x4c\x61\xaf\x29\x32\xd5\x51\x9b\x22\xd3\x36\x96\x45\x24\x64\xf7\x0d\x23\xe1\x45\x66\xbf\x4b\x91\x0d\xcd\xbd\x7b\x10\xae\x54\x97\x67\xbf\x6b\xb8\x0a\x25\xfb\x30\x74\xd3\xf1\xd1\xf0\x38\xff\x5d\x43\xa7\x0f\xb5\x29\x06\x71\x3a\x39\x7f\x5a\xd9\xaf\xb7\x07\x20\x26\x5a\x00\x62\xf2\xe0\x00\x44\xc1\x9f\x26\x02\x8e\xcc\xe7\x8f\x43\xc0\xc8\xaa\x11\x30\x36\xcd\x1a\x53\x1b\x03\x31\xc7\x96\x46\x83\x65\x5b\x32\xcd\xb3\x95\x8c\x59\x41\x45\x8d\x88\x76\x2e\x94\xff\x4a\x28\xcf\x13\x71\x77\x3f\x7b\xa7\xde\xab\x64\xd8\x45\x7d\xe6\x50\x22\xff\xf8\x67\xf1\x70\x68\x7f\x33\x07\xaa\x58\x7f\x99\xa9\x3f\x2e\x9f\x95\x65\x6f\x35\xc9\xf0\xdf\x8e\xc7\xef\xf2\xbf\x7b\xf0\xc0\xbf\x5c\xba\x0b\x97\x0c\x04\xd5\xfe\x90\x26\x7c\xc0\xe3\x7b\x4d\x7f\x4c\xf3\x25\x0a\x62\x44\xd3\xbf\x5e\xca\xe1\x1d\x8a\xa4\xde\x05\x67\x84\x70\xc6\x29\x8c\x0d\xf0\x12\xbb\x88\x01\x18\x65\x7f\x6f\x1a\x70\x22\x33\xfe\x5d\xb4\xc2\x0e\x62\x00\xc9\xbf\xd0\x97\x8d\x89\xca\x87\x08\x99\xb1\x77\x38\xd8\x33\x18\xed\x93\x28\xd8\x96\x72\xc6\x8a\x29\xcf\x12\xa4\x05\x07\xf3\x48\x62\x99\xbf\x05\xf3\xfb\xb2\xa6\xfe\xae\xab\x13\xe0\x78\x0e\x28\x72\xb8\x76\x86\x75\x90\x85\x40\xd3\xd0\xda\xff\x0d\x00\x00\xff\xff\xa3\x2c\x66\x44\x35\x89\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xeb\x76\xdb\x38\x92\x38\xfe\xdd\x4f\x81\x71\x4e\xff\x13\x7b\x24\x8a\xa2\x2e\x96\xe5\xd3\xb3\xed\x4b\xe2\x28\x13\xa7\xdd\x71\x3a\x17\xf7\xf6\x07\x90\x84\x48\xc4\x24\xc1\x21\x41\x5b\xb2\x37\xe7\xec\x6b\xec\xeb\xed\x93\xfc\x4f\x81\x20\x09\xde\x44\xdb\xe3\x9e\xdf\xce\xe9\xac\x77\x3a\x12\x50\x55\x00\x0a\x85\xaa\x42\x55\x91\xfa\xc9\x72\x71\x14\x13\x8e\xb6\x7f\xfd\xf0\xaa\x3f\xdb\x3e\xd8\x1a\xec\xfe\x05\x05\x2c\xf2\xb1\x47\x6f\x89\x66\xc5\x31\xba\x1e\x69\xba\x66\xa0\xff\x42\x67\x8b\x0f\xe8\x2d\xb5\x48\x10\x13\xf4\x5f\xc8\xa1\x5c\xa3\x6c\x90\xc3\xa2\xdd\xc1\x96\xcb\x7d\x0f\xdd\xa1\x25\x0b\x78\x7f\x89\x7d\xea\xad\xe7\x28\xc6\x41\xdc\x8f\x49\x44\x97\x07\xa8\xef\xc7\x7d\x4e\x56\xbc\x1f\xd3\x5b\xd2\xc7\xf6\xd7\x24\xe6\x73\x34\xd4\xf5\x1f\x0e\x50\xff\x86\x98\x57\x94\xb7\xf6\x7f\xdb\xda\x32\x99\xbd\x46\x77\xc8\xc7\x91\x43\x83\x39\xd2\x0f\xd0\xb7\xad\x2d\x1c\x71\x6a\x79\xa4\x87\x70\x4c\x6d\xd2\x43\x36\xe1\x98\x7a\x71\x0f\x2d\xa9\x63\xe1\x90\x53\x16\x88\xcf\x49\x44\x7a\x68\xc9\x18\x27\x51\x0f\xb9\x04\xdb\xe2\x5f\x27\x62\x49\xd8\x43\x3e\xa6\x41\x0f\xf9\x24\x48\x7a\x28\xc0\xd7\x3d\x14\x13\x2b\xc5\x8c\x13\xdf\xc7\x11\x0c\x6b\xd3\x38\xf4\xf0\x7a\x8e\x4c\x8f\x59\x57\xe9\xd8\x89\x4d\x59\x0f\x59\x38\xb8\xc6\x71\x0f\x85\x11\x73\x22\x12\xc7\x3d\x74\x4d\x6d\xc2\x54\x1c\x1a\x78\x34\x20\x7d\x89\x7a\x4d\x60\xd2\xd8\xeb\x63\x8f\x3a\xc1\x1c\x99\x38\x26\xd0\x5f\x10\x9d\x07\x8c\xbf\xf8\xcd\x62\x01\x8f\x98\x17\xff\xbe\xa3\xd2\x0a\x18\x40\xba\x84\x3a\x2e\xcf\xb8\xf0\x9b\x4b\x6d\x9b\x04\xbf\xf7\x10\x27\x7e\xe8\x61\x4e\xea\x18\x40\x1b\xdd\x21\x13\x5b\x57\xb0\xec\xc0\xee\x5b\xcc\x63\xd1\x1c\xf1\x08\x07\x71\x88\x23\x12\xf0\x14\x6a\x8e\x2d\x4e\xaf\x81\xa7\x73\x97\x5d\x93\x08\xdd\x21\x96\x70\x98\x61\xce\x75\xd3\x8c\x7e\xe3\x94\x7b\xe4\x77\x20\xc9\x22\x9b\x44\x7d\x93\x71\xce\xfc\x39\x1a\x86\x2b\x64\x33\xce\x89\x2d\xc8\x99\x3d\x14\xf3\x88\x05\x4e\x26\x1a\x37\x72\xea\x7b\x7a\x4a\xcc\x5e\x06\x59\x57\xcc\xd7\x1e\x99\x23\xca\xb1\x47\x2d\xd1\xe9\x0e\xf3\x3e\x7a\x4b\xe6\xc8\x20\xfe\x41\x2e\x02\xda\x74\x8f\xf8\x72\x4a\x3e\x8e\xae\x4a\xcb\x9b\xa3\x67\xcb\xa5\x7e\x80\xe4\x2a\x9f\xe9\x72\xb8\xd8\xc7\x5e\x2e\xa6\x20\x69\x73\x34\x93\x12\x16\x27\x30\xd9\x24\x2c\xf7\xee\x4d\x7e\x38\x40\xb0\xfa\xbe\xc2\xf4\x90\xc5\x14\x44\x64\x8e\x22\xe2\x61\x60\x57\xc7\xbe\xa6\x64\x39\x0b\xe7\xa8\xaf\x4d\x60\x15\xa2\xd1\x84\x39\x4b\xc6\xf5\x35\x23\xeb\xa0\xbe\x23\x3a\x80\xb1\x19\xd3\xe3\x6b\x47\xc8\xc5\x3c\x62\x8c\x83\x48\xc0\xde\x2c\x3d\x76\x33\x47\xe9\xf6\x0b\x92\xa9\xac\x2b\xe7\x64\x48\x7c\x34\xd6\xc3\x95\xe8\x75\x61\x33\xfb\x3e\xbb\xed\x9b\x6c\x05\xe7\x90\x06\xce\x1c\x81\xa0\x91\x80\x43\xdb\x01\x6a\xed\x28\x8b\x5c\x18\x91\xd2\x14\x70\xc2\x99\x18\xc2\x62\x70\x0e\xaf\x4c\x1b\x4e\x04\xe9\xa1\x18\xfb\x61\x55\x2b\xf8\x2c\x60\x71\x88\x2d\xd2\xcb\x3f\x1d\xa8\x2c\x1f\x4a\x36\x98\x09\xe7\x70\x0c\x69\x10\x26\xbc\x87\x58\xc8\xe5\x89\x8d\x89\x47\x2c\x0e\xe2\xbe\xe2\x38\x22\x18\xdd\x65\xfb\x4c\x03\x97\x44\x94\xa7\xe4\x94\xaf\x19\x3b\x74\x85\x70\x69\x01\xd7\x34\xa6\xa6\x47\x4a\xe3\xa6\xc3\xc0\xbe\x81\x5a\x12\x07\x65\xc9\x22\x5f\x39\x52\x19\xa4\xd0\x7d\x62\x9a\xbf\xf1\x75\x48\x7e\x4c\xdb\x7f\x97\x53\x4f\xdb\x22\x12\x13\x5e\x6e\x8a\x13\xd3\xa7\x1c\x0e\x51\xa6\x00\x71\x18\x12\x1c\xe1\xc0\x22\x73\x94\x12\x39\x40\x56\x12\xc5\xb0\xb6\x90\xd1\x80\x93\x48\x19\xf8\x37\x9b\xc6\xd8\xf4\x88\xfd\x7b\x69\x0a\x79\x2b\xba\xcb\x91\x6d\xb2\xc4\x89\xc7\x15\xe4\xf9\x5c\x48\xc2\x92\x59\x49\xdc\xa7\x41\x00\x2a\x51\x4c\xb7\xde\x51\x16\xc6\x10\xdb\xb6\x10\x9c\x94\x99\x02\x07\xdd\x95\xcf\x48\x6a\x19\x8a\xfe\x74\xbd\x96\x4b\xac\x2b\x93\xad\x2a\x8c\xc1\x36\x65\xc0\x04\x55\xf6\x72\xad\xb2\x6a\x1e\x30\x45\x0d\x12\xdf\x24\xd1\xef\xf3\x79\xc6\x3f\xb1\x8e\x7e\x1c\xd2\xa0\x9f\xed\xcd\x26\x70\x96\xf0\x32\x38\xba\xcb\x75\x6b\x2e\xd2\x0a\x81\x98\xe0\xc8\x72\xdb\x36\x0c\xc4\x71\x49\x89\x67\x1f\x74\x1c\xb2\x0c\xb9\x15\xa0\xb5\xa3\x71\x3a\xc5\x7a\xd2\x86\xbe\x05\xf3\xf1\x9a\x38\xd0\x86\x61\x13\x8b\x45\x18\x54\x5a\xcb\xda\x72\x91\x17\x0b\x04\xaf\xa1\x90\x09\x50\xf9\x31\xf3\xa8\x8d\x62\xea\x5d\x93\x48\x39\x6e\xc8\x08\xd5\x0d\xd4\x46\x13\xe2\x23\x6d\x0a\x9a\x0e\x69\x7b\x99\xbe\xf3\x88\x43\x02\x7b\xa3\x94\x29\x47\xbd\x41\xeb\x64\xaa\xa1\xcd\xc8\x70\x38\x25\x39\x7d\x30\x7d\x1e\x0e\x63\x02\xcc\x4d\x3f\x1d\x64\x5d\xa0\x8d\xd4\x61\xed\x1e\xe2\x2e\xba\xab\x4e\x67\xb0\x8b\x4e\x23\x6a\xd3\xd8\x47\x87\x28\xa6\x7e\x08\x4e\x48\x44\xe2\x90\x05\x71\x6a\x3c\x03\x1b\xb9\x38\xb0\xd7\xe8\xf8\xe2\x02\x39\x11\xb5\x91\xb9\x46\x3f\x59\xcc\x5c\x03\x92\xcb\x79\x18\xcf\x07\x03\x87\x72\x37\x31\x35\x8b\xf9\x03\xd9\x35\x70\x24\xdd\xdd\xc1\xd6\x60\x17\x9d\x83\xda\x88\xae\x09\x8a\x99\x4f\xc0\x9f\xa2\x7c\x0d\xde\x96\x06\x60\x3d\xa4\x25\x01\xe5\xe8\xae\x51\xa2\xe4\x8a\x84\xdc\xd4\xe4\x51\xed\x6c\x6b\x4f\xd7\x79\x41\x38\x4a\xc2\x74\xfc\x28\xf1\x48\x8c\x38\x43\x0e\x6c\x42\x80\xb8\x4b\xd2\xb5\x65\x33\x6a\x70\x95\x2c\x8f\xe0\x08\x08\x73\x57\x90\x14\x33\xcf\x27\xbe\xf4\x18\xe6\x73\xe4\x91\x25\x3f\x40\x37\xd4\xe6\x6e\xe6\xf3\xe5\x1c\x1f\x66\xe6\x6b\xb0\x8b\x3e\xb8\x34\x46\x24\x88\x93\x08\xe6\xe1\x12\x70\x4d\x48\x84\x9c\x84\x73\x12\xc5\x08\x47\x04\x91\x7f\x24\xd8\x83\x39\xc2\xe4\x5e\xd8\x2c\x01\x55\xb8\x83\x84\x66\xc8\x00\xb5\x62\xc2\x62\x22\xf3\x25\x8d\x62\xde\xb7\x5c\xea\xd9\xc5\x66\xf7\x61\x56\x73\x64\x64\xe3\xab\x08\x1e\x6e\x80\x8f\x52\xa9\xcb\x11\x06\xbb\xe8\x1d\x89\x39\xb1\x05\x93\x62\x84\xbd\x88\x60\x7b\x8d\x5c\x7c\x4d\x32\x24\xc4\x5d\x96\x38\x6e\x0f\xc5\x0c\x79\x84\xff\xef\x7f\xff\x4f\x8c\x82\xe4\x8a\x20\xca\xc5\x24\x61\x7a\xf7\x98\x64\x2a\x96\x2a\xf4\xa6\x19\x96\xa0\x61\x6e\x25\xda\x7f\xcb\x77\x27\x43\x13\x8e\x4b\x1d\xc9\xc3\x1b\x70\x32\xbf\x26\x3f\x30\x6f\x09\x47\x21\x61\xa1\x47\xd2\x05\xc2\xfe\xc8\xfd\x18\x48\x24\x64\x31\x38\x4a\x9c\x78\x6b\x44\x03\x84\x91\xc5\x12\x80\x67\x4b\x74\x83\xd7\xb1\x60\x48\xc0\xfa\x12\x2b\x1d\x52\x1e\x02\xb5\x3d\x9f\x04\x68\xa0\xbf\x50\x3f\x64\x11\xc7\xd2\xbf\x1d\xec\xa2\x4f\x11\x0e\x43\x18\x0d\x73\x84\x91\x8f\x57\xd4\x4f\xfc\x54\xf8\x10\x8d\xc1\xcf\xa0\x2c\xc0\x9e\x18\xed\x26\xc2\x21\xca\x0e\x1b\xfc\x93\xb6\x80\x83\xb5\xea\x4b\x79\xdd\xdf\x9b\x85\x2b\x55\xe9\xe5\x7a\x09\x06\x03\x18\x64\x79\x38\x8e\x09\x48\x40\xcc\xd2\xed\x8f\x5d\x16\x71\xd0\x10\xe0\x38\xc6\x94\x05\xb0\xed\x60\xcd\x88\x8d\x70\x8c\x96\x11\xb8\xe1\xd0\xfa\x8a\x45\x88\xac\x30\xf0\x65\x8e\x96\x2c\x42\x58\x48\x13\x82\xb5\xa3\xe1\x60\x84\x5e\xb0\x00\x98\x49\x23\x7b\x07\xb1\xa5\x38\x15\xa9\x3f\x9f\x2e\xa9\x97\xea\xa7\x35\xc2\x21\xfc\x57\xcc\xe4\xc7\xed\x9b\xfe\xb0\x3f\xda\xce\x8e\x09\xf1\x88\x4f\x02\xae\x1e\x8b\x1b\x97\x81\x4e\xcb\xbe\xf5\x87\x7d\xf0\xc5\x4b\x27\xb4\x38\x12\x2e\xf6\x96\x25\x58\xa3\x80\x9d\x94\x41\x59\x40\xfa\x62\xb2\x25\xf8\x51\x01\x3f\x1a\x69\xa3\xd1\xc8\x28\x21\xf1\x1b\x96\x22\xc5\x0a\x96\xa1\x62\x4d\xa7\xda\x74\x3a\x9d\x94\xb0\x60\xa8\x7f\x24\x38\x12\xb7\x3e\xa5\x6d\xc9\x92\x88\xbb\x79\x13\xb0\x62\x5c\x50\x32\xca\x44\xb8\x1b\x91\x9c\x4c\x31\x7c\xda\x9c\x52\x52\x27\x35\x52\x49\xed\x95\x49\xc1\x7c\x96\x74\x59\x19\x7a\xa2\x0c\x5d\x66\x15\xac\x5a\xc0\xab\x03\x18\x2a\xc2\xb8\x82\x90\x4e\xaa\x8a\x32\x52\x51\xa6\x65\x14\x58\x41\x1d\x63\xac\x62\xcc\xca\x18\x0e\xf3\x6c\x12\xf4\xc5\x45\x4a\x41\x71\xfa\x71\x81\x32\x9a\x69\xc6\xde\x70\xfa\x43\x76\x08\x4e\x05\x4e\x76\xb1\x9e\x23\x81\x4c\x22\x14\x52\x62\x11\x45\xe8\x24\x6d\x0f\x47\x8e\x2a\x7b\x4e\xdf\x2b\x64\x6f\x3a\xd4\xf6\x8c\xd9\xa8\x95\xb6\x40\x56\x48\x0f\x76\xd1\x31\x98\xa0\x25\x5d\x21\xbc\xe4\x24\x42\xe4\x9a\x44\x6b\x49\x3d\x1f\xfb\x0e\xed\xde\x32\xd0\x57\xc3\x62\xb1\x73\x93\x2c\x59\x94\xcd\x64\x9e\x62\x2b\x16\x4e\xb8\x15\x07\x99\x87\x36\x47\xdb\xdb\xf5\xab\x62\x4e\x2b\xc3\xae\xda\xc3\xc1\x2e\xfa\x95\x53\x0f\xec\x7a\xa6\x25\x60\x52\x22\x3a\xd0\xb7\x08\xb8\xfc\xd9\x15\x44\xde\x2c\xd3\xc6\x94\xb4\x68\x12\x36\xa0\x02\x04\x4d\x2a\x88\xb0\x4d\x15\x18\xd1\x96\x02\x85\x89\xe7\x65\x64\x4a\x36\x39\xef\xcc\x08\xc8\xde\x02\x77\xb0\x8b\x0e\x21\xfc\x11\x92\x88\xaf\xa5\x86\x32\x09\x68\x68\x14\x91\xc0\x26\x11\xa8\x5a\xb6\x44\xd4\xc7\x0e\x89\x41\xaf\x83\xea\x8a\xe1\x76\x06\x6a\x2b\x06\xd5\x8e\x4c\xea\x38\x42\x20\x2c\x2e\x6c\x3b\x58\x72\x88\x10\xa1\x88\xc0\xa5\xd0\x46\x34\xd5\x6c\x80\x8a\x4c\x62\x31\x9f\xc4\xb9\x18\xe5\x76\x32\xbd\x38\x2b\xaa\x39\x57\x54\x83\x5d\xf4\x3e\x77\xcf\xd0\x05\x4f\x96\x4b\x90\xbb\x9f\x7c\x62\x53\x8c\x62\x2b\x22\x24\x10\x4e\xdb\x0b\x05\x7b\x32\x9d\x85\x2b\xb8\x6b\x83\x1b\xc4\xb1\x75\x85\x70\xb0\xe6\x2e\xac\x87\xbb\x98\x23\x1a\x07\xff\xfb\xdf\xff\xc3\xd1\x12\x98\x27\x70\x10\x0b\xf2\x59\xa5\x44\x63\x24\xc8\xda\x8c\xc4\xc1\x73\x0e\x7c\x82\xf8\x90\x58\x4b\xc0\xfa\x31\x50\x05\x0b\xcb\x82\xbe\xcf\x4c\x0a\xee\x95\x90\x02\x98\x1c\x92\x72\x07\xd7\x7e\xad\x19\x78\x47\x31\xbc\xca\x92\x4b\x46\xaf\xec\x28\xa4\xce\x49\xc5\x1f\xc8\x3c\x16\x24\x89\xc9\x93\x57\xb1\xe8\xd2\xd3\x68\xc0\xcf\xd1\x81\x51\xcc\x27\x9c\xfa\x24\xee\xa1\x35\x4b\xd2\x4d\xbc\xc1\x01\x07\x5b\x63\x12\x64\xd3\xe5\x92\x08\xeb\x94\xb1\x4a\x72\x3f\x5b\x72\x2a\xdd\x2a\x43\xca\x42\x2b\x8f\x44\xd9\xac\x23\xa4\xb9\xd4\x26\x65\xac\xfc\x9c\xc2\xe5\xa5\xec\x07\xa0\x6f\xe0\xda\xbe\x5c\x85\xb0\x37\xb0\x17\xc2\xaa\x63\x64\x52\xd8\xcd\x88\xbb\x24\x82\xad\x94\xba\x44\x99\x60\x93\xc0\xd0\x20\x17\xb7\xe1\x4c\x4f\x25\x46\xbb\xa1\x36\x89\x2a\x9e\x83\x68\x2a\xcb\xa7\x40\x68\xf0\x1d\xd0\xb7\x2d\xcd\xa5\x8e\xeb\xc9\x53\x07\x5a\x8c\xf9\x60\xa4\xd1\xee\x00\xc1\xdc\xa3\x88\x45\xf2\xf3\x29\x09\x48\x44\x2d\xf9\xed\xef\x64\x7d\xc3\x22\x5b\x7e\x7b\x4b\x39\x89\x84\x33\x03\xdf\xde\x61\x9f\xc8\x8f\x3f\x87\x24\xc2\x3c\xa7\xf1\xb3\x58\x73\xda\x75\x9e\x04\x16\x4f\xd2\xeb\xe0\xee\x40\x19\x5b\x3b\x4b\x3c\xd0\x55\x01\xa9\xb4\x9f\x47\x24\x8c\x98\x55\x69\xbd\xa0\x81\xe3\x55\x41\x2f\x42\x62\x51\xec\x95\xa7\xae\x9d\x10\x70\xfb\xec\x4a\xeb\x4b\x3f\x74\xab\x4d\xf5\x75\x6b\xaf\x09\x06\x01\xad\xb4\x2e\x82\x98\x44\x75\x9a\x3f\x27\x5c\x84\x93\xe0\x9a\x40\x97\x94\xd8\xe8\x86\x72\x17\xc5\x21\x0e\x40\x44\xc3\x88\x5c\x03\x97\x85\xb7\x04\x44\x85\x8a\x12\xa1\xc9\xcc\x5b\x3a\x65\xc8\xc3\x81\x93\x60\x30\x52\x31\x21\xe8\xd9\x70\x38\x19\x69\x95\x71\xce\x23\xe6\x87\xbc\xd2\x78\x91\xc6\x42\x2b\x8d\x89\xe9\x36\xae\xe0\x43\x84\x2d\x02\x21\xdb\xf2\xce\x6a\xc7\x2c\x88\x41\xf2\x2b\xcd\x27\xc4\xf2\xb0\xbc\xc5\x97\x7b\x60\xdb\xe1\x66\x4b\x2a\xed\xe7\x31\x49\x6c\x56\x69\x7c\x9f\xde\x38\xed\x4a\xf3\x87\x75\x48\xca\x32\xa5\x9d\x60\x5e\x6d\x7a\x27\x42\x2c\x95\xc6\x0b\x1e\x15\x8b\x83\xb9\x68\x87\x9c\x47\xd4\x4c\x72\x74\xd1\x78\x94\x50\x8f\xd3\x6c\xee\xa2\xe9\x58\xea\xc2\xa2\xa1\xbc\x74\xd1\x76\x92\x06\x2f\x72\xb1\x10\x8d\x2f\x03\x0e\x26\x55\x6d\x59\x59\x24\x54\x98\x23\x1a\x5f\x81\xa8\x57\xda\xde\x62\x93\xa8\x07\xa6\xc6\x3e\xf8\xae\xa9\x07\x46\x34\x9c\x67\x26\x50\x69\xfb\x80\x4b\xcb\xfe\x88\x23\x0a\x3e\x43\xe5\x08\x6a\x9f\x8a\xf3\xfa\x81\xac\xb8\xf6\xc9\xa5\xbc\x34\x62\x99\xbd\xda\x2b\xb0\xc1\xcd\x5d\xaf\xc9\xaa\xb9\x63\x11\x70\xe2\x90\xa8\xb9\xf3\x67\xab\x4a\x2e\xdd\x33\xed\x08\x5b\x57\x9c\x5a\x57\xcd\xbd\xc7\x2e\x8e\x9a\x7b\x4e\x98\xd5\xd6\x91\x14\x0c\xa8\xf4\xbd\x8c\x2d\x1c\xb6\xf4\xbd\x86\x9b\x51\x1b\x51\x58\x5b\x14\x32\xaf\xb9\x57\xdd\xa9\x4a\xd7\x7b\xe2\x90\x55\x73\x57\x49\x79\x55\xfb\xd6\xbe\xc9\xbc\x06\xd9\x2d\x9f\xa8\xd2\x9e\xd7\x65\x39\xef\x39\xf5\x98\x89\xbd\x46\xa4\x85\x10\x77\x8b\x6c\xdc\x53\xed\xad\x54\x2a\x25\xa3\xa1\xb9\x9e\x57\xca\x89\x64\x29\x9f\x67\xcb\xe5\x72\x69\x59\x07\x65\x1b\xa3\x59\x45\x18\xfd\xd9\x6c\xcf\x22\xc4\xac\x42\x90\x28\x52\x60\x80\xca\x72\x59\x85\x71\x3a\x21\xae\x54\x08\x9d\x4c\x67\xb5\x99\x78\x9d\x34\x82\x4e\x08\xd6\x09\xb1\xea\x84\x08\x3b\x21\x2c\xbf\x93\x69\x96\x4a\xc5\xb2\x27\xd6\xa4\xce\xf9\x61\x37\x95\xb8\x13\xc4\xb1\x15\x10\x5d\xd7\x75\x4b\x3f\x28\x87\x43\x4d\x06\xb1\xe9\x06\x81\xd0\xf5\x99\x3e\xd3\xab\x02\xe1\x10\x85\xa0\x25\x09\x0a\x57\xac\x08\x17\xcf\x51\x02\x2e\x7e\x96\xe8\x2a\xa1\xab\xc2\x62\xe9\xd6\x03\xe6\x03\x83\xe9\xf5\xf9\xb8\x9d\x9c\x74\x68\xc3\x96\x35\x2e\x38\x5d\x4d\x09\x1b\x5c\x00\xcd\x51\x05\x07\xdb\xf6\x8c\x4c\xef\x3b\xeb\xb1\x0d\x7f\xb5\x29\x75\x4b\x91\x13\x77\x83\x24\xdd\x6b\xe7\x4f\xcc\xef\x2b\x55\x23\x34\x9f\xd4\x2b\xbb\x1b\x24\xe8\x06\x09\xbb\x41\xa2\x6e\x10\x75\xfd\xa6\x6d\xee\x4d\x6b\x47\xc4\xb3\x3b\x19\xed\x77\x42\x74\x6f\x56\x80\xbb\x41\xcc\x6e\x10\xab\x1b\xa4\xac\xe5\xb0\x8e\x6b\x9b\x18\x74\x2f\x39\x28\x1f\x1a\x9b\xe0\x9a\x10\x07\xa4\x9b\xca\xb2\x1b\xe4\x1e\x8a\xbd\x5b\xb3\x07\xf7\x50\xdc\xeb\x6e\x2a\xbc\x53\xa0\x82\x6b\x05\x64\x7f\xb6\x34\xf7\x67\x55\x10\x76\xd3\x39\x50\x37\x84\xdf\xcd\x39\xdf\xed\x06\xa1\xdd\x20\xac\x13\x24\xee\x96\xcb\xb8\x5b\x2e\xe3\x6e\xa1\x8b\x8d\x6e\x90\x6e\xa1\x8b\xbb\xf9\x12\x77\xf3\x25\xee\x96\xa8\x38\xea\x06\x19\x76\x83\x74\xeb\x0e\xb3\xdb\x5c\x5c\x5b\x9d\x72\x79\xed\x74\x83\xd0\x4e\x10\xda\x7d\x5e\x4d\x1c\xbb\xf2\xa4\x64\x39\xc8\x24\x86\x3c\xaa\xa8\x9d\xc8\xf2\xc6\x22\xfd\xd8\xd8\x1e\x37\x82\xb3\xa6\xd6\x86\x26\x11\xd8\xfb\xcb\x16\xda\x45\xe8\x15\x0b\x38\x3a\xbc\x21\x22\x4f\x39\xd6\x0c\x4d\x17\x09\x57\x1b\x5f\x13\x47\xe4\x61\xfb\x22\xe9\x3a\x1f\x0c\xc0\x24\xe2\x14\x50\xa3\x0c\xf5\xd1\x4f\x4a\x8b\xa0\x95\x15\xba\xb5\xa0\x0c\x3c\xd9\xff\x02\x06\x9d\xa3\x8b\xc5\x5b\xf4\xf3\xab\xb7\x68\xa8\x0d\x7b\x90\xee\x9d\xab\xd5\x72\x3b\x5b\x10\xd7\xfa\x49\x16\xc0\x58\xa4\x5a\x0c\xf3\x1c\x68\xc8\x79\x3f\x3f\x40\x71\x64\xcd\x51\x12\x79\x2f\xb6\x35\x4d\x0c\x1b\xab\x83\x03\x8b\xe1\xab\x46\x18\xff\x8f\xeb\x1f\xc5\x32\xb7\x77\x1e\x84\xf5\x8c\x92\x25\x5d\xfd\x7f\x39\x32\x82\x92\x16\xcc\x5f\x6c\x13\xdf\x24\xb6\x4d\xec\x3e\x0b\x49\x00\xd5\x0e\xdb\x3b\xbd\x7b\xd0\xbc\x61\xcb\xe5\x7f\xd4\xa9\x41\xf3\xfd\x08\x70\xde\x84\xcf\xa3\x84\xdc\x7f\x12\xf1\xb5\x93\xd1\x78\xa6\xf4\x47\xc4\x49\x3c\x1c\x29\x64\xe3\x6b\x67\x7b\xa7\xe2\x15\x65\xb5\x2a\x6a\x0d\x5a\x51\xbf\xa2\x2d\x71\x7b\x2d\x1f\xa0\x64\xc0\x95\x7f\x86\xe3\x70\x35\x18\x22\x65\x77\xb3\x11\x44\xd1\x59\x5e\x99\x24\xfc\xe9\x3c\x4e\x9e\xd5\x34\x64\x67\x29\xc5\xf0\x19\x13\x51\xe7\x39\xc2\x01\xa7\xd8\xa3\x38\x26\x59\x91\x09\x8b\x57\x35\x28\x27\xc2\xeb\xd8\xc2\xb2\x9c\x49\x5b\xe2\xbe\x47\x83\x2b\x99\xcd\x40\x77\x4a\xc2\xe2\x3f\x97\xba\x35\xdc\xce\xc1\x42\x12\x58\xd4\x6b\x01\x1c\xeb\xdb\x59\x28\x3d\xbb\x9e\xc6\x32\xc1\x72\x84\x63\xb8\xa1\x6e\xed\xfe\x81\xa5\x08\xb2\x00\x14\xd6\x3a\x47\x63\x5d\x17\xa5\x6d\x6f\x31\x67\x3d\xf4\xfc\x35\xf1\xae\x09\x54\x55\xa2\x77\x24\x21\xcf\x7b\x28\x6f\xe8\xa1\xc3\x88\x62\xaf\x57\x2a\x45\xcd\x54\x9a\x28\x0a\x6c\x70\x87\x85\x15\x97\xc3\x8b\xd4\xf7\x24\xaf\x70\x79\xa6\xeb\x7b\xfb\xa3\x71\xa5\x86\xc7\xc5\x36\xd4\xa3\xd0\x00\x0a\x63\x74\x34\x0a\x57\x68\xa4\x87\x2b\x14\x39\x26\x7e\xa1\xf7\x90\xfc\x7f\x6d\xb4\xa3\xae\xff\x21\x58\x0f\x1f\x46\xce\x0f\xf8\xd5\x5f\x12\x0c\x69\x95\x7e\x4c\x38\xa7\x81\x13\xcf\xd1\xf6\x15\x89\x82\x6d\x34\x94\xf3\xe9\x86\x62\xdd\x30\xf7\x03\x80\x6f\x42\x28\x8a\x33\xb6\xa5\x99\x11\xc1\x57\x79\xd9\x62\x5e\x4c\x3a\x92\x05\x42\x9a\x48\x9a\x35\x14\x97\xe4\x7d\x79\x72\xae\x10\x59\xb4\x7d\x50\x83\x57\x12\x73\xa5\x3c\x9c\x28\xff\x13\x49\xb8\x52\x39\x25\xa4\x33\x88\xcc\xc3\x43\x89\xb3\xac\x1c\x96\xb9\xc6\xac\xa4\x78\x63\x19\x4b\x43\xba\x0f\xc2\xd5\x24\x2a\x08\x22\x77\x98\x55\x25\x43\x01\x72\xbb\xba\xf9\xb6\xb5\x05\xfd\x89\x57\x24\x65\xc4\x52\xf2\xec\x01\x80\x00\x84\x47\x37\x12\xd1\xa0\xe4\xb9\x0f\x80\x39\xc7\x53\x31\x37\xd2\x3c\x4e\xd1\xef\xd1\x02\x44\xe6\x7a\x86\x7a\x03\x10\x2e\x1d\x7c\x71\x70\x22\x6c\xd3\x24\x9e\xa3\x49\x58\x1c\xfa\x7a\x47\x53\x5b\x49\x3b\xef\xeb\x7a\xb9\x38\x74\xac\xe4\x9f\xe6\x48\x87\x6a\x5a\x50\x05\x52\x93\x66\x67\x24\x75\x19\xaa\x85\x9b\x49\x18\x92\xc8\xc2\x31\x29\xce\x87\xe8\x95\xc5\xbc\x50\x1a\xac\x6b\xc6\x24\x96\x13\x6e\xeb\x63\x6d\x3d\x2d\xcd\x55\x66\xe5\x85\xd5\x0d\xba\xc7\x98\xc0\x5f\x31\xbf\xa6\x83\x0f\x05\x77\xa3\x86\x73\x3f\xd9\xe9\xc9\x5e\x3d\xd5\x09\xc6\x64\xd2\x43\xc5\x7f\x74\x6d\xb8\x49\x01\xfd\x73\x64\x9f\x9e\x62\x99\x6d\x9a\x95\x44\x22\x6f\xd8\x5c\xc7\x9e\x2b\xe6\xec\xfb\xd2\x36\xf1\xd0\xe8\x66\x64\xdb\x0c\x8a\x39\x4e\x1a\x57\xd0\xc1\xc8\xc7\x93\x7d\x7a\x8a\xa0\x25\x63\x06\x59\xb7\x7e\x9a\xbe\xac\x66\xf1\xe5\x09\x97\x19\x62\x38\x4c\xdf\x2a\x18\x58\x8b\x5d\x1c\x91\xa8\xa4\x79\xc2\x15\x1a\x37\x1c\x62\xd1\x96\x6d\xc3\x68\x34\xda\x40\xad\x50\x2f\x73\x94\x29\x9f\x0a\x24\x38\xcd\x26\x63\xb5\xf2\xfe\xc9\x74\x6f\xb8\x54\x8c\x79\x76\x39\xa9\xa0\xf3\x1b\x2a\x4a\x11\xee\x87\xbd\xa5\xa5\xe9\xe3\x7e\xae\x67\x8b\x6a\xd3\xcc\x2e\x94\xd4\x7b\xae\x64\x65\x2d\xc8\xb7\x12\x05\xbc\xd1\x34\x34\x08\xf1\x52\x9d\x93\x3e\xd2\x0f\x1a\x52\xdf\x1b\xd5\x5a\x4d\x53\xd6\x95\x69\xbe\x7f\xa9\x66\xce\x8f\x47\x5d\x11\x4b\x01\xaf\x77\x34\xb5\x95\x57\x5e\xb6\x40\x82\x39\x7d\x8b\x78\x5e\xce\x02\xa3\x54\xe3\x39\x0b\x57\xc8\xa8\x53\xe9\x3c\xf3\x23\xfd\xfb\x81\x6f\x3e\xf0\x59\xb1\x2c\x3c\x90\x01\x55\x8d\x16\x94\xaa\x91\x78\x8d\x8e\x48\x80\x8e\xb0\x27\x8a\xe2\x7c\x66\xa7\x29\x70\x73\x8d\xce\x71\x74\x45\x22\x74\xc6\xc0\xf5\x87\x2a\x9e\xaf\xe4\x6a\xed\x79\x91\xa9\x59\xcc\xcf\x2e\xc2\x26\x09\x34\x53\x20\x43\xeb\xc0\xd0\x87\xe3\x81\x3e\x1a\x0c\x47\x83\x10\x8a\x7b\xfa\x38\xb0\x5c\x16\x89\xeb\x46\x3c\x80\x22\x97\xa0\x18\x03\x68\x3a\x22\xd3\x15\x40\x3e\x55\x63\x11\xe4\xac\xb6\xb4\xd4\xf3\x11\x38\xe8\xae\xf1\xd1\x1a\x59\x78\x02\xa6\xfe\x00\x31\xa8\xd0\xe6\xeb\x39\x2a\xfb\x05\xba\x36\x23\x7e\xb1\xf9\xaa\x25\x96\x18\x60\xa4\x63\x44\x70\x4c\xfa\x34\xe8\xb3\x84\x23\x5d\x1b\x36\x99\xfa\x2e\x78\xf6\x10\xe8\x07\x80\x82\xfa\xf1\x98\xc3\x0a\x97\x2b\x5d\xf8\x48\x96\x13\xbb\x86\x74\x1c\x54\x96\xf5\x90\x3b\x6a\x6e\x1e\x37\x37\x4f\x9a\x9b\xa7\x0d\xcd\xe8\xae\xe0\x76\x5a\x16\x27\xcb\x5f\xd4\x22\xa9\xbd\xac\x48\xaa\x30\xd6\x89\x57\xa9\xd9\xc9\x8a\xc5\xd0\xb7\x26\x0a\xb3\x91\x5e\xa5\xa0\xc5\x2e\xbb\x69\xa9\xe6\x49\x7d\x62\x59\xf5\x53\x60\x74\xd4\xff\xe4\x45\x3f\xaf\xc4\x63\x80\x20\x79\xb9\xf7\xde\xa0\x59\x8c\x21\xfc\x95\x24\x6c\x38\x55\x2b\x9f\xb2\x2b\xca\x44\x35\x76\x59\xd6\x45\x6e\x9f\x70\xaa\xb3\xa7\xaf\xe4\x68\x95\xd4\x80\xda\x23\x77\x20\x2d\x62\x2b\x33\x5e\x82\x94\x6a\xf9\xc2\x4d\x3e\xbe\x44\x48\x69\xb5\x40\x35\x1d\x35\x31\x65\xa5\x9c\x59\x8a\xa0\x58\x65\x3e\x25\x6d\xa6\xa8\xee\xa1\x6a\x45\xda\xa4\xbd\xe3\x94\x6d\x3c\x54\xed\x67\xa8\x4d\x24\xf3\xba\xbd\x8c\x6d\x79\xd1\x31\x0a\xeb\x97\x4c\x91\x32\x41\xd9\x5d\x4e\xa9\x35\x56\x81\x05\x5f\xfa\xb2\x4e\x2e\xad\x1e\x5b\xc0\xf3\x99\x20\x47\x1a\x15\x9f\x2a\x05\xf0\x60\xe5\x85\x12\x97\x48\x19\x58\xfe\x90\x8a\x94\x2a\xe2\x57\x8a\x46\x87\xea\x43\x8e\x7a\x79\x8d\x45\xa9\xd9\x64\xba\x2f\x0f\x4d\x3b\x59\x29\xf1\x87\x26\x28\x3b\x59\x64\x00\x95\x07\xb2\xc2\x14\x9a\xfb\x4e\x80\xb4\x10\x07\xa4\xf9\x18\x10\xa2\x5c\x95\x2a\x56\x1f\x96\x27\xf7\xb5\xb1\xa7\xb1\xb1\xe1\x92\xd6\x24\x86\x25\x1e\x02\x0b\x8a\xd9\xba\x46\x65\xa5\xda\xb8\x60\x58\xba\x4f\xf2\x01\xc2\x02\x27\xec\xa1\xe2\x0b\x6e\xe2\x94\x32\x40\xb1\xeb\xf3\xf4\x19\x25\x59\xc6\x0b\x9c\x84\x47\x3c\x3d\x08\x0c\xff\x1a\x4b\x8b\x06\xcf\xb5\x20\x9b\x59\xb1\x28\xf3\x0c\xc8\x8d\x60\xae\x7c\x30\xf9\x5f\xc4\xd4\x9c\x5b\x46\xa5\x98\x50\x52\xcb\x87\x2a\xfc\x8c\x34\x7a\x34\x6c\x8a\x1e\x35\xde\x11\x3b\xe0\x1f\x00\xaa\xb2\x7e\x1a\xae\xca\xf2\x5d\x9c\xe1\x71\x56\x49\x99\xb1\x52\x8d\x7a\x48\xad\x54\x1c\xc7\x4d\x4a\xa0\xd8\x8b\x9c\x31\x15\x24\x1a\xd4\x8c\x59\x81\x94\x33\x17\x14\xb9\x78\x96\x56\x44\xe9\x4a\x0b\x31\x86\xd9\x44\x34\x9b\x59\x7d\x1b\xea\xe2\x4a\x42\xa6\x6b\x7b\x20\x66\x52\x02\x9c\x88\xac\x65\x6d\x76\x18\xe6\xd0\xa5\x1b\x59\x37\xee\x60\x17\xbd\x07\x3b\xd0\x87\x47\xdf\x45\xdc\xa8\x8f\x12\x90\x4a\x73\xdd\x26\x94\x02\xb2\x60\xa2\x38\x2b\x23\x79\xc0\xd2\x4e\x77\x8c\xee\x1e\x7f\xbd\xd8\x2b\x5d\x2f\x74\x29\x80\x92\x5d\xf2\x80\xa6\x3b\xd7\x4f\xdb\x5a\x0c\x93\x14\xf2\x4c\x41\x17\xcf\x17\x3e\xd3\xf5\xe1\x74\xb6\x5f\xcc\xb7\x41\x28\x74\xb5\xb7\xf2\xcc\x94\xaa\x24\x14\x38\x71\x59\xf1\x68\x2c\xc3\xff\x7d\x48\x3c\xe4\xda\x49\x12\xcf\xad\x85\x26\x83\x92\x39\x6a\x55\x9f\x08\xa1\x6e\x52\x6a\x05\x4e\x71\xaf\x69\x8a\xb5\x6f\xe7\x1c\xa8\x3f\xa3\xdf\xfa\x39\xd5\x2a\x12\x4f\x66\x31\x04\xd3\xf2\x46\x29\xe2\x70\x30\xf3\x5b\x98\x5e\x0a\x89\x16\x93\xc6\x66\xcc\xbc\x84\x67\x0e\x81\x7e\x80\x4a\xfb\x96\x6a\xc3\x13\x66\x25\x50\x91\x9c\x55\x9c\x0a\xe1\x8f\xd5\xf3\x46\x83\xdc\xaa\xcd\x74\x89\x99\x02\x69\x72\xb9\xea\x01\x93\x4f\x4b\xc8\xe8\xaa\x8c\x4f\x36\xdd\x7f\xb3\x0d\x57\xfc\xab\x26\x7e\x17\xaa\x37\x4d\xa9\x69\xda\x80\xfa\xce\x40\xce\xaf\x9f\x1a\x7c\x2d\x0c\x9c\x1d\x58\xa5\xa4\x8e\x02\xd6\x8f\x48\x48\xb0\xf2\xd4\xb8\x38\xf4\xc2\xf8\x4b\x8d\xfa\xad\x3c\xcb\xbf\x21\x9b\x5e\x17\x15\xf8\xe3\x7d\x6d\xf2\x43\x0d\x08\xf7\x50\xe9\x3b\x54\x1b\x55\x7d\xc1\x9a\x18\x75\x1d\xc5\xe6\x93\x07\xb7\xe9\xa1\x91\xcf\xf6\xc9\xae\xf8\x83\xdd\xfa\x23\xbf\x62\xe2\xbb\x83\x16\x23\xd3\x16\x00\x1c\xed\xf4\x4a\x57\xdd\x61\xb8\x6a\xbd\xec\x36\x5a\xa3\xa7\x21\xfc\x87\xd0\xac\x1b\xfc\xbd\x29\xfc\xd5\x25\x42\x3a\xfc\x15\x21\x68\xf0\x18\x66\xb3\x59\x0d\x5b\x0b\xc8\x8a\x57\x64\x4a\x83\xea\xf5\x96\x4b\x74\x13\x7a\x9a\x35\x69\x22\x52\xe8\x25\x45\x26\x47\x53\xf5\xb2\x63\x18\x46\x45\x0a\x45\xac\xbe\x55\x89\xf4\xf7\xc2\xd5\xa6\x69\xa8\x3a\xf0\xf9\x7f\x1a\xfa\xe8\xf0\xf9\x01\xaa\x26\x1d\x36\x4c\xb4\x8c\xbc\xff\x3c\xd3\x59\xed\xb8\x8d\x0b\xcf\xf6\x24\x53\x32\xf2\x0e\xde\x48\xa4\x69\x0b\xa0\xad\x46\x44\x2e\xa3\x99\x4a\xf6\xbe\x06\xf5\xd6\xa7\x4d\x0e\x1a\xdf\xdd\xa0\x51\x1f\x1e\x21\xaa\x99\x71\xa3\xc8\x9c\xa8\xfa\xe3\x5b\x81\xa0\x5e\x3c\xf7\xf7\x85\x0d\x4d\x15\x36\x4c\x42\xde\x9a\x8b\x57\x5e\x64\x90\x22\x45\xda\xf0\x30\xd5\x3b\xe9\x53\xb8\xc3\x6c\x2d\xe8\xae\xc9\x07\xcf\x5e\xd1\x83\x5c\xa3\xcb\x16\x6f\x69\x21\x83\x7e\xcc\x89\xc3\xa2\x75\x0f\xa5\xdf\x7d\xc2\xf1\xfd\xdf\xa6\xe3\x53\xdb\x86\xbc\x77\x35\x28\xa8\x70\x26\x0d\x14\xd5\xc6\x6b\x1f\xa3\xc9\x73\x91\xcc\x9f\xa3\xa9\x54\xb3\x68\xa6\x34\x4b\xd8\xc9\x26\xf5\xab\x83\x02\x16\xff\xd3\x9b\xd5\x70\x09\x60\x53\x5f\xd3\x69\x6f\x57\xc7\x93\x7b\x68\xb8\xb6\xc8\xa3\xa1\xc0\xf5\x15\xc0\x12\xa9\x56\x7d\xfd\x2f\x18\xf9\xff\xcd\xa0\x75\x85\xbd\x4f\x8c\xd9\xd0\x28\x75\x89\xc7\x1f\x53\x47\xc4\xc6\x1c\xcf\xc5\xf7\x41\x7c\xed\xfc\x75\xe5\x7b\x07\xf0\xb6\xa0\xe9\xb8\x77\x7e\xb2\x3f\x36\x3f\xdd\x38\xb6\xff\x71\x6d\x19\xde\xb5\xf9\x55\xa7\x67\x17\xe3\x9b\x05\x3d\x1c\x9c\x3b\x6c\x66\x8d\x2e\x83\xc5\x6b\x97\x9b\xa7\x93\xdb\xf3\x8b\x37\xcc\x7e\xfd\xfe\xe6\x67\x3a\xbb\xb6\x47\xf6\xe8\x6d\x60\xdd\xbe\xf5\xf7\xd7\x97\xeb\xd9\xfa\xec\xe4\xf0\xe6\xed\xe8\x9d\x71\xb9\x5e\x38\xb6\xe1\x5d\xd9\xa7\xce\xfe\xe2\xeb\xcb\x9b\xb3\xe3\x5f\xe9\xe2\xd4\xf5\xf0\x27\x9b\xd9\x27\x3a\x3d\xfb\x70\x78\xf3\xe6\x62\xe1\xd8\xbe\xe7\xd9\xfa\x9b\x6b\x02\x6d\xc7\x87\x37\x8b\x93\x97\xce\xd9\xc5\xc2\xb1\x5e\xbf\xf1\x2c\xe3\xe3\xda\xf6\x3f\x1e\x59\xa3\x23\xef\xcb\xe8\xfd\xc5\x97\xcf\xef\x43\xf3\x56\xa7\xa6\xbf\x9f\x5c\x5e\x2c\xfe\x7a\x4c\x0f\x9d\xf3\xd3\x55\x68\xfa\x1f\x5d\xeb\xca\x5e\x7f\xf9\xf4\x3e\xbc\xfc\x34\xd1\x17\xa7\xde\xd5\xf9\xc5\x9b\xc0\xf2\x5f\x5d\xbd\xfd\xfc\xf1\xeb\xa5\x61\xf1\x4b\xe3\x63\x72\xf9\xf9\x8d\x6b\x9f\x7e\xbc\x5a\xd0\x23\xd1\x87\x3f\x7d\x4c\xec\x57\x1f\x13\xfc\xf9\x3d\xac\x69\x08\xe3\xfd\x3a\x3a\x72\xbf\x18\x1f\xcf\x4d\xef\xe3\xed\xe5\xc5\xc2\x21\x27\x2f\xf7\x17\x5f\x0f\xbd\x05\x3d\x9a\x9c\x7d\xd0\xa9\x58\xc7\x6b\x67\x7d\x7e\xb1\x10\xf3\x27\x1f\x16\xf9\xfa\xce\x1d\xe6\x2c\x8e\x0f\x9d\xf3\xd7\xef\x74\x73\x74\xe8\x98\xc6\xa5\x6f\x19\x1f\xf5\x0c\xd6\x1a\xbd\xbf\xb6\x8e\x87\x5f\x4d\x63\x75\x6d\x7d\xd5\xe9\xe2\xd6\xf3\xce\xbe\x3a\xab\x33\x9a\xf7\x5d\x5b\xa7\xaf\xbe\xe2\xcf\xef\x27\xe7\x17\x8b\xd5\x82\xce\xc4\x1a\x17\xc7\x87\xb3\xb4\xff\xe8\xfa\xd2\xbf\xbc\xbd\xfc\xfc\x4b\xc1\xd3\x74\x2c\xfe\xc5\xd8\x8f\xcd\xd1\x62\x7f\x41\xcf\x8c\xcb\xaf\x47\x57\x67\xa7\xbf\xe4\x7d\xa6\x58\x93\xa7\x13\x98\xff\xc5\xe2\x5a\xcc\xf3\xe4\xe6\xda\x3c\xf5\x92\xcb\x4f\xaf\xd6\xef\x47\x6f\xdc\xcb\x53\xcf\x33\x83\x5f\xc4\x78\xe7\xaf\xdf\x78\x5f\x46\xbf\x38\xe9\x9e\x2c\x1c\x81\x77\x5c\xda\xcf\xf2\x5e\x5e\x2c\x9c\x4b\xdf\x8b\xcd\x13\x9d\xda\x9f\xdf\xc4\x7f\x3f\x7e\xd7\xca\xfb\xbf\x5f\x2c\x9c\xb7\xb7\xe3\xbf\x9f\x1f\xef\xdf\xda\xbe\xf5\xd7\x9d\x26\x51\xcd\x74\x88\x03\x1a\x89\x04\xfc\x05\x28\x45\x1c\xf5\x84\x5d\x05\x97\x59\x7e\x4a\xaf\x46\x3d\xb4\x8c\x98\xff\x42\xe6\x61\x77\x7a\x88\x33\xf8\x62\xd8\xc3\xd1\xce\x46\xf2\x29\xd5\x62\x14\x41\x58\x92\x41\xfa\x0f\x3d\x24\xa9\x08\xfb\xd3\x4c\xc9\x67\xb7\x4f\x41\x86\x3d\x01\x91\x3a\x05\xf9\x86\xaf\x8d\x74\x96\x14\x72\x2a\x73\x78\x3c\xd8\xa1\xf6\xfc\xe4\xf3\x02\xb4\xc3\x87\xcc\xf1\xd7\xce\xa8\x15\xb1\x98\x2d\xb9\x96\x13\x46\x31\xc7\x11\x3f\x06\x3b\x17\xf3\xe8\xc7\xe7\x92\xfa\xf3\x1e\x22\x81\x5d\x6a\x9e\x4e\x0d\xeb\x79\xef\x54\x22\xc2\x73\x7c\x3f\xea\x48\x64\x89\xca\x16\xb0\xc1\x95\xda\xde\x56\xcd\x4b\xd5\xa1\x4b\x3d\xab\xfc\xda\x2d\x6d\x94\xd4\x81\xa5\xcb\xa9\xae\x4f\x75\x63\x3f\xff\x57\xe9\xbb\xd7\x9d\x75\xd2\x7a\x65\x2d\xcc\xb8\xbc\x4f\xb6\x3f\x02\xae\x79\xe2\xb9\xbe\x4a\x46\xf6\x41\x37\xac\x6f\x5b\x1b\x23\x4d\x65\x76\xb6\xf9\x90\x1d\x91\xa7\x1a\x91\xb2\xfb\x31\xc9\x89\x68\xf8\x1a\x73\x1c\xb5\x57\xde\x8c\xda\x2a\x6f\x46\x0d\x95\x37\xa3\x0d\xf1\x98\x36\x27\x2b\x67\xbe\xf4\xd1\xe4\x72\xe7\x99\x63\x92\x59\xc3\xe9\xb4\xfa\x0c\xcd\x54\x72\x33\x5d\x2c\x44\xc0\x32\x77\x0f\x27\xdc\x65\x51\x75\xd9\x99\x0f\xbf\xa5\x89\x60\x56\xe6\x54\xfe\xb5\x16\xdc\x93\x81\xfa\x8d\x17\xdf\x3c\x30\x35\xd4\xdb\x38\x54\x01\xb9\x57\xaf\x18\x59\xb9\x18\x4f\x26\x2d\x65\x3c\xb9\x33\xf1\xcc\x58\xc2\x5f\x3e\x85\x4d\x20\x9b\x7b\xd3\x80\xcc\x31\xe4\x7a\x5f\x67\xc5\xd9\xe9\x23\xb7\x5b\xe2\x35\x7c\x22\x0b\x7c\x87\x6e\xe0\xb9\xd2\xbe\x78\xb0\x14\x74\x0d\x69\xdd\x71\x25\x52\x0a\x9e\x73\x1a\xed\x1b\x0c\xc1\x75\x46\x67\x24\xf0\x58\x0f\xc1\x43\xc8\xcc\x83\x57\x5f\x6e\x1f\xb3\x24\xa2\x24\x42\xef\xc8\xcd\x36\x74\x88\x6f\x3d\xb4\xfd\x96\x9a\x44\x3e\x8f\x7c\xc6\x02\xb6\xdd\x43\xca\x0b\xff\x72\x61\xd1\xb3\xcc\xee\xb7\xad\x8d\x07\x43\x5d\x48\xfd\xa2\x53\xaa\x4b\xef\xa1\x10\xfd\x0d\x16\x98\x7e\x80\xd5\xa7\x9f\x02\x66\x46\x79\x83\x47\xf3\x8f\xee\x24\xff\xa8\x05\x8c\x13\xf9\xad\x39\x7e\x6f\x98\xf0\xa7\xdc\x31\x96\x4b\x75\xa3\xff\xc9\x20\x4b\x93\xc4\xa8\xce\x6a\x63\x60\x7d\xb4\xd3\xeb\xf0\x65\x87\x3b\xbd\x42\x6e\x1e\x5c\xdc\xf0\x47\x0d\xfa\xaf\x1e\x4f\x68\x10\xd8\xdf\xf6\xdd\x6d\x89\xc1\x54\xa9\x19\x3b\x95\x8b\xa1\xa1\x4d\x94\x30\xb7\xbc\xf0\xcb\xc6\x7a\x9d\xc2\xb7\x2d\x45\x5c\x73\xed\x25\xd2\x81\xa5\xb7\xdf\x09\x46\x54\xaf\xe0\x0d\x6f\xc0\x83\xb2\xd2\x0f\x67\x6f\xd1\xcb\xf4\x4d\x4e\xf2\x6e\xde\x43\xae\x01\x05\x02\x3d\xe4\x8e\x7b\xc8\x9d\xf4\x90\x3b\x55\x06\xd3\xf3\xf7\xc2\x56\x8b\xe8\x6a\x4f\x79\xca\x07\x33\xa4\x6c\xb6\xd4\x39\xd6\x72\xbc\x6a\x1f\x6b\xeb\x69\x69\xfe\xb6\x55\x8f\x8f\xd9\x78\xdf\xd2\x0d\x31\xeb\xcd\x6f\x95\x0d\xab\x2f\xa4\x1c\xe6\x2a\x46\x6b\x7b\xcb\x4d\xeb\x0b\x6e\x4a\x59\xf7\x72\x44\xba\x06\x24\x89\xab\x30\xc5\x08\xf7\x7b\xa7\x8f\x92\x67\x14\x5b\xaa\xa4\x13\x61\x37\xf3\xcf\x23\xe5\xf3\x58\xf9\x3c\x51\x3e\x4f\xeb\xa2\xf5\xad\xa0\x36\x6e\xd8\xf9\xa2\x3b\xf1\x90\x47\x2b\xba\x96\xf8\x75\x08\x35\x5f\x5a\xa2\x9f\x76\x0b\x8b\xf2\x8f\x04\x0e\x9d\x32\x17\x29\xd6\x15\xe0\x62\xe6\x4c\x0e\xae\x10\x6e\xde\x4f\x05\xbf\x04\x5d\x98\x17\x80\xda\xca\x65\xbf\xba\x9a\x96\x37\x0f\x97\x66\x2d\xd5\xb4\x3c\xe7\x85\x85\xdf\xdb\xdb\xcb\x07\xca\xb4\x80\x92\x1a\x2c\x13\x55\x07\x9e\x85\xab\x8a\x43\x34\x91\x82\x0b\x65\x5b\xf9\x93\x15\xd9\x6b\x2b\x4b\x87\xbf\x41\x65\x09\x4f\x23\x5b\xbb\x26\x33\xd6\xb2\x53\x98\x7c\xd5\xaa\xfc\x01\xe6\x69\x53\xe8\x3c\x27\x7f\x5f\xf0\xfb\x43\x7e\x93\xef\xf5\x54\x15\x58\x16\xef\x83\x77\x77\x42\x25\x53\xdd\x3b\xe6\x2c\x14\x7b\xd5\xc5\x03\xf0\x24\x95\xe2\x97\xcd\xd8\xf5\x21\x84\x66\xb8\xcf\x18\xd2\x52\x54\x07\x69\xc0\xef\xb0\x50\xcf\x46\x18\xfe\x9a\x2e\xa5\xdf\x83\x4e\xff\xf7\x82\x4e\xef\xdc\x33\xe3\xe5\xed\x97\x8b\x27\x0d\x3a\xad\x2e\x3f\xbc\xf2\xce\x3e\xfd\xfa\x67\x0b\x3a\x81\xe0\x8f\xb0\x0c\x3a\x0d\x09\xfc\x3d\x26\xe8\x04\x44\x46\x38\x0d\x3a\x01\x8d\x21\x79\x54\xd0\xe9\x41\x64\xd8\x13\x10\xd9\x14\x74\x6a\xa7\xf3\x14\x41\x27\xa0\x3d\xc2\xb5\xa0\x13\x0c\x33\x24\xcd\x41\xa7\x54\x2f\xa7\x6f\x55\xbe\x67\xe6\x42\xbd\x1a\xb4\xfb\xfe\xb9\x86\x7d\x38\xe2\x63\x70\x8a\x95\x54\x32\x5b\x0d\xd6\xe0\xa9\x0d\x8e\x32\xb6\x87\x37\x0f\xfd\x07\x18\x22\x30\xae\xca\x5b\x85\xa5\xcf\x51\xbc\xcf\x9b\xdb\x75\xa7\x70\x4b\x6c\x78\x57\xb4\xad\xa1\xd2\xb6\x4c\xbd\x64\x03\x81\xaa\x78\x2c\x94\x17\x2f\x0f\xaf\x85\x61\xf6\xc6\xf0\x77\xd0\xd2\x5d\xf5\x2b\x86\xca\xa3\x93\x8f\x11\x0a\xc9\xda\x87\x23\x3e\x0a\x27\x57\x04\xdf\x4d\xfc\xff\x41\x13\x6f\x5c\xfa\xe2\xaf\x39\xaf\x74\xf3\xf6\xeb\x4b\x0a\xe6\x10\x68\x2e\x4e\x56\xb7\xf6\xe9\xfe\xcd\xe2\x74\xdf\xbf\x0c\xde\x79\x2a\x0f\x1f\x4a\x37\x73\x1d\xce\x8f\xf7\x63\xfc\x69\xe2\x7d\xf9\xfc\xe6\x75\xce\x8f\x13\x31\xde\xcc\xf2\x3f\x7e\xb5\x8f\x8f\xc6\x29\xfc\x91\xc4\x3b\x1a\xe1\x4f\xef\x75\x2c\xcd\x3a\x3e\xfd\x18\x5e\x1a\x2e\xe4\xcd\x56\x0b\x7a\xe4\xe3\x4f\xab\x58\xf0\xd1\xbf\x61\x0b\x03\xf6\xe3\x17\x6e\x7f\x7a\x17\x5c\xae\x87\xc1\xe5\xa7\x89\x67\xf9\xaf\xf4\xcb\x4f\xbf\x84\x0b\x7a\x08\xb9\xad\x99\x90\x93\xdb\xf1\x8f\x8d\x16\x2b\x3b\x63\x0f\x32\xf3\x6d\x27\x21\xb5\xfb\x8d\xbd\x3b\x8f\x70\x03\xda\x86\x11\x36\xb4\x79\x94\xc7\xb8\x09\x4f\x3b\x0c\xfb\x17\x0c\xb2\xc1\xcd\xf8\x27\xc6\x79\x0a\x37\x64\x88\x97\xe2\xff\x1a\xb2\x5f\x59\x47\xa3\x2b\x92\x59\x8f\x6a\xf5\xbc\x2c\x9e\xc9\xad\x4b\x98\x05\x0c\x55\xa0\x71\x0a\xb4\x05\x5d\x1a\x93\xbf\x36\xc5\x5d\x94\x7f\x16\x1d\xe9\xf2\xc4\xcf\x48\xc8\xcf\xca\x75\x75\xa2\x1f\x65\xa9\x90\x14\xd8\xc3\x8e\x04\x15\x9f\x44\x23\x93\x6f\x2c\x85\xe6\xf4\xb3\x42\x41\x1f\xef\x9f\x1c\xbf\xac\xcf\x43\x21\x57\x9a\x86\x42\xb1\xfe\x28\x84\x7c\x04\x0f\x72\x09\xef\x20\x60\x03\x85\xb0\xd9\x5b\xf7\x4b\xf1\x9b\x91\x8c\xdf\x94\x73\x53\x23\xbd\x64\xb7\x45\xc1\x64\xfa\x1f\xf5\xa1\xed\xef\xc5\x31\x7f\xf2\xe2\x98\x3d\x32\xb5\xc7\xdf\x8b\x63\xfe\x4d\x8a\x63\x6c\xef\x9d\xff\x8b\xfe\xc4\xc5\x31\xc3\x2f\xb7\xef\xbd\xb3\xdb\x3f\x5d\x9c\x22\x15\x7d\x19\xa7\x98\x58\x63\x32\x9a\x3c\x26\x4e\x91\x92\x11\xb6\x55\x52\x79\x8c\x03\xf2\x30\x32\xec\x09\x88\x6c\x70\x20\x36\xd0\x79\x0a\x07\x21\xa5\x5e\x73\x0f\xd2\x09\x37\x3b\x07\x9b\x4b\x3d\x4a\x06\x31\x4b\xf7\xf5\xef\x59\xe2\x51\x46\xae\x57\x76\x40\xff\x1f\x58\x8d\x63\x18\x86\x28\xdf\x56\x5b\x73\x84\xc7\xd6\xe1\xa4\x79\x73\xc8\xb3\xa4\x9f\x2a\x11\x80\xca\xc5\x3d\x83\x6e\xc9\xd3\xb5\xbd\x02\xa6\xf6\x76\xae\x7c\xa8\x12\xc6\xb8\xf2\xd2\x18\x19\x9a\x68\x7d\x96\x47\xcc\xa6\xb1\x0a\x7d\xaa\x4f\x9e\x1f\xd4\x92\x64\xad\x9b\x30\x1c\x17\x7b\x30\x1c\x57\x9f\x12\xab\xa7\x7d\x64\xbe\x52\x8c\x8f\x70\xe3\xe3\x20\x19\xe8\x4c\x05\xdd\xfc\x0a\xa0\x1c\x66\xd3\x7b\x80\xea\x23\x95\x12\xd4\x4d\xc4\x21\x83\x98\xfd\x4f\xd6\xef\xc0\x6b\xf2\xa9\x95\xfd\x72\x9c\x72\xe2\x33\xf2\xfa\x68\xb6\x8f\xbf\x27\x25\xfe\x4d\x92\x12\x87\xb7\x3f\x9f\x78\xee\x13\x27\x25\x6e\xce\x4e\xbe\xac\xde\x19\x5f\xfe\x6c\xc6\x3e\x15\x7d\x69\xec\x75\x7d\x3a\xdc\x5b\x3e\xc6\xd8\xa7\x64\xb2\xaa\x51\xa0\xf2\x28\x63\xff\x20\x32\xec\x09\x88\x6c\x32\xf6\xed\x74\x9e\xc2\xd8\xa7\xd4\x1b\x62\x01\x30\x50\x8b\xb1\xd7\x3c\x6a\x46\x58\x14\x5c\x36\x68\xb1\xef\xf5\xfc\xdf\xeb\xf9\xff\xa4\xf5\xfc\xa9\xe8\x4b\x2d\x36\x5d\xea\xb6\x6e\x3f\x46\x8b\xa5\x64\xd2\x13\x9f\x52\x79\x94\x16\x7b\x10\x19\xf6\x04\x44\x36\x69\xb1\x76\x3a\x4f\xa1\xc5\x52\xea\x35\x2d\x96\x0e\xd4\xa6\xc5\x92\x20\x22\x1e\xbc\xe3\xc8\x6e\x56\x64\x96\xbd\x6f\x8c\xf6\xbf\x2b\xb2\x7f\x0b\x45\x66\xbc\xbb\xfa\xf9\xc3\xe2\xf6\xe7\x8b\xa7\x54\x64\xef\xdc\xb3\xaf\xd6\xf0\xec\xab\xf3\x67\x53\x64\xa9\xe8\x4b\x45\x86\x8d\xbd\x89\x31\x7b\x8c\x22\x4b\xc9\xa4\x87\x3e\xa5\xf2\x28\x45\xf6\x20\x32\xec\x09\x88\x6c\x52\x64\xed\x74\x9e\x42\x91\xa5\xd4\x6b\x8a\x2c\x1d\xa8\x4d\x91\x29\x17\xcb\x22\x12\x22\xa3\x11\x4d\x31\x0d\xe9\x45\x66\xff\x96\x22\x1b\x8a\x7b\x77\x2f\x5a\x52\x97\x67\x3a\xbd\x46\xab\x50\xb2\xf7\x23\x37\x1d\xef\x0d\xf7\xf3\x7f\x6b\xe4\xd4\xa5\x36\xc5\x20\x0e\x27\xc7\x4a\x0c\x42\xdc\xd7\xdb\x03\x10\x13\x25\x00\x31\xb9\x77\x00\xa2\xe0\x4f\xd3\x04\xf6\xf4\x97\x4f\x33\x81\x91\x51\x9b\xc0\x58\xd7\x6b\x4c\x6d\x9a\xc3\x74\xdf\x50\xe6\x60\x98\x06\x1e\x1a\x1b\xa6\x31\x2b\x66\x51\x9b\x44\x3b\x17\xca\xbf\x12\xca\xf3\x42\xdc\x86\x48\x60\x11\xcc\x93\xef\xab\x84\xd7\xc1\xf4\x63\x2b\x62\xe2\xc7\x3f\x8b\x17\x87\xf6\x57\x73\xf8\xb9\x48\xe6\x79\x45\x20\x45\x47\x7d\xf9\xda\x92\x3c\xca\x22\x9f\x25\x12\x0f\x76\x6d\x78\xf9\x9d\x7c\x95\xdd\x56\xe3\x0f\x51\x36\xc5\x29\x37\xd0\x12\x35\xce\xe5\xdf\xc5\xdc\x42\x08\x6f\xc4\x49\x63\x48\x4d\x3f\xa6\xf9\x9a\x78\x21\x89\xe4\xaf\x97\x72\x7c\x45\x02\xe1\x40\xa2\x23\xc6\x78\xcc\x23\x1c\x6a\xe8\x35\xb5\xe1\xa7\x5d\x83\xec\xf7\xa6\xe1\x07\x15\xa1\xe2\xdf\x26\xd7\xd4\x22\x31\x22\xe2\x17\xfa\xb2\x35\xc1\x6f\xa5\x93\x28\xd6\xb6\x20\xff\x18\x47\x7d\x16\x78\x6b\x74\xd7\xb8\xe5\x72\xd5\xe2\x2d\x4c\x79\x24\x31\x5c\x35\x47\xb1\x20\x43\x76\xd0\xf0\x5e\x57\xcb\xa3\x21\x64\x09\x2d\xae\xe4\xb0\x76\xb2\x10\xe8\x1c\xe9\x07\xe8\xdb\xd6\xff\x3f\x00\xa3\x2c\x66\x44\x35\x89\x00\x00"),
 		},
 		"/templates": &vfsgen۰DirInfo{
 			name:    "templates",
-			modTime: time.Date(2020, 12, 18, 15, 5, 15, 830595775, time.UTC),
+			modTime: time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 		},
 		"/templates/doc_api.html": &vfsgen۰CompressedFileInfo{
 			name:             "doc_api.html",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
-			uncompressedSize: 847,
+			modTime:          time.Date(2026, 10, 16, 21, 17, 1, 115399812, time.UTC),
+			uncompressedSize: 2310,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x93\x6f\x8b\xd3\x40\x10\xc6\xdf\xdf\xa7\x78\x08\x9c\x55\x72\x36\xda\x83\x53\x30\x8d\x54\x2c\xc7\x09\xe7\x15\x5b\xf0\xf5\x34\x3b\x4d\x06\x93\xdd\xbd\xdd\x4d\x8f\x52\xfb\xdd\x25\x31\xfd\xe7\xa9\xaf\x32\xd9\x19\x7e\xf3\x3c\x33\xbb\xdb\x2d\x14\xaf\x44\x33\xa2\xdc\xe8\xc0\x3a\x44\xd8\xed\x2e\x52\xcf\x79\x10\xa3\x91\x57\xe4\xfd\x38\xb2\xe4\x3c\x3b\x90\x95\x28\xbb\x00\x80\x54\xc9\x7a\x9f\x2c\x9c\xa8\xfe\xf8\x3c\xd1\x68\x09\x78\x2a\x4d\xc5\x87\x34\x90\x96\x23\x88\x1a\x47\x1d\x6b\x62\x6d\x25\x39\x75\xad\x66\xce\x14\x8e\xea\x5a\x74\x81\x3b\x1d\xd8\xad\x28\x67\xbc\x9c\xcc\xee\x5e\xa5\x49\x39\xca\x2e\x8e\x08\x9b\x7d\xe7\xe5\xeb\x25\x79\x56\xe8\xa5\x79\x76\x6b\xc9\x19\xa2\xf3\xaa\x51\xec\x41\xf8\x36\x9d\x2f\x56\x4d\x05\x39\xc0\x82\xe9\xca\x45\x17\x07\xd6\xaa\xd1\x9d\x53\xaa\xa4\x0a\x9b\x21\xf0\xc9\x84\x12\xb7\xd3\x05\x48\x2b\xcc\x1e\xe6\x0b\xd4\x1c\x4a\xa3\x3c\xc8\x31\x7c\x63\xad\x71\x81\xd5\x30\x4d\xec\xa9\xa4\xf2\xba\x73\x55\x70\x88\xb2\xdb\xe9\x22\x4d\xca\xeb\x73\xc5\x87\x18\x98\x58\xcb\x5a\x81\xb0\x66\x17\x24\xa7\x0a\x55\xbb\x01\xcf\x96\x1c\x05\x56\x20\xe7\x68\x03\xb3\x82\x0f\x4e\x74\xe1\x5b\xe1\x1b\xd3\x38\x28\x53\x93\x68\x34\xae\x1a\x9e\xf0\xee\xe9\x47\xab\xcc\x31\x42\x49\x01\x83\x17\x54\xdb\x0f\x03\x88\x46\x28\x19\x9a\x6a\xfe\x2d\x9e\x7d\x4e\xb6\xc5\x7b\x0c\x2e\x47\x37\x83\xab\x13\x46\xeb\xd6\x5b\xca\xff\x52\x1a\x0f\x8e\xcd\xfe\x70\x7d\x66\x2b\xcd\x8d\xe2\x2c\x21\x2b\x1f\x1f\xc7\x93\xc6\xc7\xcb\xc6\xff\xec\xbf\xf1\xe7\x61\x7c\x39\xba\x89\xef\x87\x57\xf1\xdb\xf7\xef\xde\xa4\x49\x57\xfc\x2f\x6e\x3f\x4d\x6b\x7c\x88\xb2\x76\x09\xcf\xe7\x79\xec\xd6\xb3\xfe\x27\xed\x49\x42\x09\xc7\x8f\x0d\xfb\x80\xa5\x51\xdd\x70\xbf\xcc\x1f\xbe\x3e\x1b\xf5\xb9\xa2\x7d\xa8\x64\xdd\xdf\xfb\x7d\x98\x26\xfd\x13\x69\x7f\xb6\x5b\xb4\x0b\xdd\xed\x7e\x05\x00\x00\xff\xff\x67\x0d\xbe\x80\x4f\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4d\x6f\xdb\x46\x10\xbd\xfb\x57\x3c\x10\x48\x65\x43\x2a\xe5\x38\x45\x5a\xa0\xb2\x02\xa5\x35\x82\x14\xf9\x42\x62\xa0\x87\x20\x87\xe1\x72\x48\x2e\xbc\xdc\xdd\xec\x87\x54\xd5\xf5\x7f\x2f\x96\xa2\x24\xca\x72\x1c\xb4\x17\x7b\x39\xb3\xfb\x66\xe6\xcd\x9b\xb1\x6f\x6f\x51\x72\x25\x35\x23\x13\x46\x07\xd6\x21\xc3\xdd\xdd\xc9\xcc\xb3\x08\xd2\x68\x08\x45\xde\x5f\x66\x96\x9c\x67\x07\xb2\x32\x9b\x9f\x00\xc0\xac\x94\xcb\xad\xb3\x76\xb2\xec\xcd\x87\x8e\xa8\x65\xc0\xaa\x31\x8a\x77\x6e\x60\xd6\x5c\x40\x96\x97\x59\x87\xb5\xb0\x56\x49\x41\x5d\xa8\x0f\xce\xd4\x8e\xda\x56\xea\x1a\xaf\x75\x60\x57\x91\x60\x9c\x2e\x3e\xbc\x3e\x9b\x4d\x9b\x8b\xf9\xc9\x1e\xc2\xce\xff\xe4\xe2\xc7\x82\x3c\x97\xe8\x53\xf3\xec\x96\x52\x30\xa4\x16\x2a\x96\xec\x41\xf8\x78\xf5\xe9\xba\x8a\x0a\x72\x07\x16\x4c\x77\x5d\xea\x7a\x87\x55\x45\xdd\x55\x4a\x4a\xaa\xb0\xce\x81\x97\x26\x34\x78\x75\x75\x0d\xd2\x25\x3e\xbc\xff\x74\x8d\x96\x43\x63\x4a\x0f\x72\x0c\x1f\xad\x35\x2e\x70\x99\xcf\xa6\x76\x98\x52\xf3\xac\xab\xaa\xe6\x90\xcd\x5f\x5d\x5d\xcf\xa6\xcd\xb3\xc3\x8c\x77\x67\x60\x61\x2d\xeb\x12\x84\x25\xbb\x20\x05\x29\xa8\xd4\x01\xcf\x96\x1c\x05\x2e\x41\xce\xd1\x1a\xa6\x82\x0f\x4e\xea\xda\x23\x18\xac\x4d\x74\x28\x4d\x4b\x52\x23\x3a\x95\x0f\xf0\xde\xd2\x4d\xca\xcc\x31\x42\x43\x01\xa3\x1f\xa8\xb5\xbf\x8e\x20\x35\x42\xc3\xd0\xd4\x26\x3a\x1c\x83\xbd\x20\x9b\xe0\x3d\x46\x4f\x2e\x9e\x8f\x26\x03\x8c\x54\xad\xb7\x24\x1e\xb8\x3a\x1e\xed\x83\xdd\xab\xfa\xa0\xac\x99\x30\x25\xcf\xa7\x64\xe5\x8b\xaf\x97\x8b\xe8\xc7\x45\xf4\xff\xf4\xbf\xc7\xbf\xe7\xe3\x27\x17\xcf\xc7\x6f\xf3\xc9\xf8\xe9\x2f\x3f\x9f\xcf\xa6\xdd\xe5\x6f\xe1\xf6\x6c\x5a\xe3\x43\x36\x4f\x4d\x38\xe6\x73\x1f\xad\xc7\x7a\x2c\xb5\x95\x0c\x0d\x1c\x7f\x8d\xec\x03\x0a\x53\x76\xe4\xfe\xf1\xe9\xfd\xbb\x23\xaa\xbf\x93\x51\x8a\x94\xcd\xdf\x99\x96\xb5\x50\x14\xa2\x23\x85\x64\x7b\xb4\xdf\x9d\xa4\x76\x2a\x12\x82\x6d\x00\x69\x18\xbb\x11\x1e\x36\xa5\xa4\x1f\x7d\x29\x49\xa5\xd4\x72\x60\x97\xe3\x75\x18\x20\x09\xd2\x28\x18\x46\x73\x4a\x79\xf3\xee\x6f\x63\x94\xa9\x93\x8c\xfa\xd7\x93\xde\x51\x98\x40\x7a\x68\x1f\x00\xf5\x37\x48\x04\x76\xf2\xe8\xa5\x88\x2a\xc8\x65\x92\xe2\x3d\xc7\x52\xba\xe8\x7b\xdb\x00\xcd\xb8\xde\x4f\x7a\xdd\x7b\x71\x5a\x72\x45\x51\x85\xb3\x1c\xd7\x0d\x77\x24\xc1\x73\x08\x8a\x3d\xbc\x69\x19\xd4\x16\xb2\x8e\x32\x48\xf6\x43\xa8\x6a\x3b\xa2\x13\x54\xc6\x81\xff\xa2\xd6\x2a\x86\xac\x40\x58\x19\x57\x26\x59\x5b\x72\xac\x43\xc3\x3e\xa9\xb5\x0a\x69\x33\xa1\x66\x1d\x3d\xe4\x10\x8b\xe0\x63\xb1\xb1\x1b\x97\x18\xa7\x18\x1a\xe3\x72\x7c\xdc\x28\xc1\x6f\x74\x41\x1a\x51\xdf\x68\xb3\xd2\x9b\x2c\x6b\x0e\x69\x70\x06\x40\x9b\xe2\x7e\x3a\x3f\xc7\x4b\x2a\xb7\xaf\xb7\x85\x3a\xf6\xd6\x68\xcf\xff\x73\x48\x4e\x5f\x46\x7f\x36\x16\xd1\x77\x53\x9b\x20\x2f\x8f\x3a\xfa\x2d\xe4\x9d\x28\xdb\xc4\x48\x36\xff\xad\x3b\x48\x6f\x74\xe2\xb1\x1b\xfc\x47\x85\x79\x78\x3f\xac\x4c\xbf\x2c\x7c\x63\x56\x3e\x51\x1e\x1a\x5e\xa3\xa1\x25\x43\x96\xac\x37\xbb\x4a\x90\x36\x9d\xac\x06\x40\x95\x71\xad\x9f\x24\xd6\xe0\xa9\x65\xf8\xc0\xe9\xbb\x94\x55\xc5\x0e\x46\xab\x35\x8a\x35\x1c\xe9\x1b\xb4\xe4\x6e\xd8\x75\x1d\x29\x58\x19\x5d\x23\x98\x01\xd2\x1e\xc2\xb2\x90\xec\x27\xdd\x1e\xde\xa4\x22\x5d\xdf\x41\xdf\x48\xeb\x41\xb5\x63\xce\x71\xb5\x64\xb7\x1e\x20\x88\x7d\x51\x0d\xa5\xbf\x03\x5e\x18\xc7\xa8\x9c\x69\x71\x9e\x36\xe9\xd3\xff\xda\xa9\x69\x4f\xf0\x0b\xda\xae\xb5\xf1\xe9\x9b\xfc\x6c\xfc\x56\x2a\x95\x77\x6d\x2b\x06\x0e\xa9\x35\x71\xea\x69\x72\xb3\xfb\x4e\x0b\x0f\x42\xa6\x4d\x97\x32\x3c\x0e\xdd\xa3\xf4\xdb\x23\x55\x75\xb8\xbd\x2c\x49\x77\x6f\x8c\xba\x56\x4e\xa0\xe4\xcd\xb1\x94\x3f\x7f\xce\x16\xd1\xa3\x88\x1e\x6f\xf2\x6c\x82\xfd\x57\x9f\x7d\xf6\xe5\x4b\x1f\x72\x4f\x16\xb0\x40\x4b\x2a\xf5\x9a\xcb\x7e\x8b\xa6\xc9\x4b\xb1\xbb\x49\x32\x31\xa4\x79\x15\x41\xad\x07\x62\xaa\x39\x0c\x33\x1b\x0d\x46\x68\xf4\xc0\xf0\x3c\xbc\x2e\x93\x2c\x1e\x5b\x93\xbb\xe7\x1d\xbd\xdb\x63\x29\x97\xf3\x93\x83\xe3\x6c\xda\xff\x67\x93\xec\xb7\xb7\x60\x5d\xe2\xee\xee\xdf\x01\x00\x83\x3e\x22\x41\x06\x09\x00\x00"),
 		},
 		"/templates/home.html": &vfsgen۰CompressedFileInfo{
 			name:             "home.html",
-			modTime:          time.Date(2020, 12, 18, 14, 33, 4, 630710004, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 598,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x90\xbb\x6e\xb4\x30\x14\x84\xfb\x7d\x8a\x91\x9b\xd3\xfc\x5a\x9a\xad\x7e\x61\xa4\x94\xe9\xa2\xbc\x81\x83\x0f\x8b\x25\xb0\x1d\x5f\x36\x89\x2c\xde\x3d\x82\x65\x59\x72\x51\x2a\xcc\x1c\x9f\xf1\xcc\x57\x0a\x34\x77\xc6\x32\x44\xeb\x6c\x62\x9b\x04\xa6\xe9\x50\x47\x6e\x93\x71\x16\xed\xa0\x62\x94\xe4\x55\x88\x1c\xa8\x39\x00\xb5\x36\x97\x9b\x7c\x0e\x46\x2f\xe2\x57\x39\x5b\x93\xf0\xd6\xbb\x81\xd7\x21\x50\x77\x2e\x8c\x50\x8b\xa9\xa4\x8a\x30\x72\xea\x9d\x96\x74\xe6\xb4\x5d\x02\xea\xc4\xef\x49\x05\x56\x50\x39\xb9\xce\xb5\x39\xc2\x68\x49\x56\x8d\x1c\x09\xf3\x47\xd2\x2b\xc1\x0f\xaa\xe5\xde\x0d\x9a\x83\xa4\x07\xad\x97\x49\xfc\x07\x67\x19\x9e\x03\x06\x63\x99\x9a\x52\x8e\x8f\xd6\xe7\x34\x4d\x75\x75\x33\xde\xbd\x65\xe6\x19\xd2\x87\x67\x49\x31\xbf\x8c\x26\x11\x2e\x6a\xc8\x2c\xe9\x69\xee\x7b\x0f\x5f\xcd\xe9\xd7\x9e\x95\x36\x97\x85\xc3\xf5\x50\x57\x2b\xaa\xe6\x50\x0a\x4c\x87\xe3\xb2\xab\x7f\xa1\x28\xae\x14\x11\x38\xe6\x21\x45\xf1\x8d\xa6\x98\x69\x8a\x1f\x34\xc5\x9d\xa6\xd8\x02\xf5\xa7\xe6\xf9\xea\xf2\xbf\xae\xfa\xd3\x4d\x2f\x05\x41\xd9\x33\xef\x43\xac\x1b\x7e\x57\xbc\x75\x9a\x9b\x52\x70\xc4\x4c\x66\xf9\xdb\x9a\xfa\x9d\x17\xdb\xcd\xe1\xef\xda\xeb\xc5\xed\xf4\x19\x00\x00\xff\xff\x36\x43\x3e\x0c\x56\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xcd\x8e\xa3\x30\x10\x84\xef\x79\x8a\x12\x97\xbe\xac\xc2\x25\xa7\x95\x8d\xb4\xc7\xbd\xad\xf6\x0d\x3c\xb8\x09\x96\xc0\x66\x6c\x93\x99\x51\x8b\x77\x1f\x41\x4c\x92\xf9\xd1\x9c\x30\x55\xe5\x72\xf7\x27\x02\xcb\x9d\xf3\x8c\xaa\x0d\x3e\xb3\xcf\x15\x96\xe5\xa0\x12\xb7\xd9\x05\x8f\x76\x30\x29\x69\x9a\x4c\x4c\x1c\xa9\x39\x00\xca\xba\xcb\x2e\x9f\xa3\xb3\x9b\xf8\x51\x9e\xbd\xcb\x78\xe9\xc3\xc0\xc5\x04\x54\x17\xe2\x08\xb3\x95\x6a\xaa\x09\x23\xe7\x3e\x58\x4d\x67\xce\xb7\x10\xa0\x32\xbf\x66\x13\xd9\xc0\xcc\x39\x74\xa1\x9d\x13\x9c\xd5\xe4\xcd\xc8\x89\xb0\x7e\x34\x3d\x13\xa6\xc1\xb4\xdc\x87\xc1\x72\xd4\xf4\xc7\xda\xcd\x49\xbf\x10\x3c\x63\xe2\x88\xc1\x79\xa6\x46\xe4\xf8\xd7\x4f\x73\x5e\x16\x55\xef\xc5\x0f\x6f\xb9\xd5\x43\x7e\x9b\x58\x53\x9a\x9f\x46\x97\x09\x17\x33\xcc\xac\xe9\xdf\xba\xef\x7d\xf8\x7a\x9d\xbe\xec\x59\x5b\x77\x59\x8f\xe5\xa0\xea\x82\xaa\x39\x88\xc0\x75\x38\x6e\x77\xed\x37\x14\xab\x2b\x45\x44\x4e\xf3\x90\x53\xf5\x89\x66\xb5\xd2\xac\xbe\xd0\xac\xee\x34\x8b\x09\xa8\xfe\xd4\xfc\xbf\xb6\xfc\x56\x75\x7f\xda\x75\x11\x44\xe3\xcf\xfc\x38\x44\xb9\x31\xed\x19\x40\xb5\xc1\x72\x23\x82\x23\x56\x32\xdb\xdf\x1e\xab\x6f\x39\x11\xb0\xbf\x35\xfc\xbc\x76\x09\x8a\x80\xbd\xc5\xb2\xbc\x0f\x00\x36\x43\x3e\x0c\x56\x02\x00\x00"),
 		},
 		"/templates/layout.html": &vfsgen۰CompressedFileInfo{
 			name:             "layout.html",
-			modTime:          time.Date(2020, 12, 18, 15, 5, 15, 830595775, time.UTC),
+			modTime:          time.Date(2020, 12, 18, 21, 38, 37, 0, time.UTC),
 			uncompressedSize: 2694,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x56\x5f\x8f\xeb\xb4\x13\x7d\xef\xa7\x98\x5f\x7f\x0f\x06\x89\xc6\x5a\x74\x25\xd0\xca\x89\xb4\x62\x97\x05\xe9\xb2\x54\xdc\x05\x89\x47\xd7\x99\x26\x03\x8e\x1d\xec\x69\x4b\x55\xf5\xbb\x23\x27\xcd\x36\xdd\xed\xfe\xb9\x68\x25\xfa\xd2\x8c\xff\xcc\x1c\x1f\x9f\x93\xcc\x6e\x07\x25\x2e\xc9\x21\x4c\xad\xde\xfa\x15\x4f\x61\xbf\x9f\xa8\xff\x5d\xff\xfc\xdd\xfd\xef\xf3\x1b\xa8\xb9\xb1\xc5\x44\xf5\x7f\x13\x55\xa3\x2e\x8b\x09\x80\x6a\x90\x35\x98\x5a\x87\x88\x9c\x8b\x5f\xef\xbf\x9f\x7d\x2b\xba\x09\x26\xb6\x58\xdc\xde\xcd\xd3\x54\x50\xb2\x8f\x8f\x5b\xbc\x63\x74\x9c\x8b\x0d\x95\x5c\xe7\x25\xae\xc9\xe0\xac\x0b\xbe\x22\x47\x4c\xda\xce\xa2\xd1\x16\xf3\x0b\x01\x4e\x37\x98\x8b\x35\xe1\xa6\xf5\x81\xfb\xfc\x96\xdc\x9f\x50\x07\x5c\xe6\x42\x46\xde\x5a\x8c\x32\x9a\x80\xe8\x32\x13\xa3\x80\x80\x36\x17\xfd\x78\x8d\xf8\xfc\x9e\xb6\x83\xf7\xc6\x3d\xd4\xe8\x0a\xa3\x5c\xea\x35\x19\xef\x32\x32\xfe\xb0\x29\x85\x02\x78\xdb\x62\x2e\xba\x45\xf2\xef\x59\x37\x56\x4c\x94\xec\xb9\x9a\xa8\x85\x2f\xb7\x60\xac\x8e\x31\x17\x9b\xa0\xdb\x3e\x7f\x9a\xc5\x00\xc1\x5b\xcc\xc5\x42\x3b\x87\xa1\x9b\x00\x50\x4e\xaf\x87\xf5\x8d\x5f\x90\xc5\x59\x1a\x89\xb5\xdf\xcc\xbc\x9b\xf5\x43\xf1\xb0\x18\x40\xad\xec\xf0\x08\xb0\xdb\x01\x2d\x21\xfb\xc1\x37\x38\xd7\x15\xc2\x7e\x9f\x4e\x32\x64\x33\xab\x10\xd0\xb1\x28\xd2\x3a\xb4\x71\x98\xef\x63\x57\xa6\xab\x87\x87\x9f\xd2\xc3\xf9\x45\x31\xdc\xa6\x3e\x96\x52\xd2\xd2\x6b\x85\x1f\x17\x3a\x0f\xe4\xf9\xc2\xa5\x37\x52\xb7\x24\x8a\xab\xf9\x8f\x2f\x14\x57\xe3\x60\xb4\xbf\x66\x6e\xe3\xa5\x94\x15\x71\xbd\x5a\x64\xc6\x37\xb2\x4a\x9a\x8a\xb2\x72\xbd\x00\xe4\xc2\xfa\x85\x6c\x74\x64\x0c\xf2\x97\x9b\xab\xeb\x9f\x6e\xb2\xa6\x14\x85\x8a\xad\x76\x03\xda\x9a\x4a\x3c\x61\xfe\xda\x1b\xf0\x4e\xc9\xb4\x68\x5c\x18\xe0\x96\xf8\xa3\x5e\xfc\x4b\xa8\x09\xa9\xf5\x0b\x6d\x3b\x8c\x99\x0f\x95\xd4\x6d\x1b\x45\x31\x0f\xfe\x0f\x34\x1c\x9f\xcd\xab\xe4\x20\x02\x25\x9d\x5e\x1f\x1e\x4b\x7a\xd0\x51\x15\xa8\x3c\x2a\x66\x34\xb1\x72\xc4\xe0\x1d\xce\xfe\x5a\xe9\xc0\x18\xc0\xa0\x63\x0c\xe7\x84\x76\xba\xd1\xfa\xca\x8b\xe2\xe5\x5b\x3b\xa1\xe6\x84\xd2\x98\x2a\xd8\xad\x28\x6e\xbb\x03\xdf\xa5\x03\x9f\xe3\x53\x51\x53\x81\xb6\x9c\x8b\xdb\xbb\x2b\xf8\x98\x6a\x42\x0c\xe6\xe8\xc9\xca\xe9\x2c\xae\x2b\x01\xfd\xfb\x44\x7c\xf3\xf5\x29\xa8\x53\xc6\x4a\x5a\x1f\x29\x1b\x07\x63\xcb\x69\x72\x9d\xe1\x3a\x6a\xb8\x0e\xf8\x40\x4e\x84\x27\x52\x38\x26\x1f\xdb\xf0\x7d\x8d\xf8\xaa\x15\x1f\x8b\xec\x7d\xed\xf8\x26\x43\x3e\x85\xf0\x48\xe9\xff\xa9\x2d\xcf\x1a\xf3\x73\x21\x7f\x9e\x3d\x9f\x18\x5f\x1e\x05\x32\x36\xe9\x20\xc3\xfe\x7b\x81\xa1\x7b\x8e\x68\x98\xfc\xc3\x49\xc9\x71\x18\xcc\xf6\x76\x57\x6f\x6a\x6f\xf1\x65\x3f\xd7\x17\x07\x03\x42\xe7\x40\xe8\xb5\x75\xb0\x6a\xf7\x45\xcc\xa7\xc6\x5b\x1f\x2e\xff\xaf\xb5\x9e\x16\x5f\xec\x76\x90\xfd\x86\x21\x26\x70\xfb\xfd\x97\x07\xae\x95\xac\x2f\xc6\x59\x3f\x14\x9f\x0c\xa1\x63\x5a\x92\x39\x64\x26\x07\xd7\xc8\x9a\xac\x92\xf5\x87\x33\x1e\x1c\xf1\x70\x38\x7c\x0a\x76\x3b\x60\x6c\x5a\xab\x19\x61\x7a\x68\x1d\xa6\x90\x25\x7d\x9e\xa1\x69\xe9\x3d\x1f\xbf\xa2\xef\xc0\xd3\x7d\x4d\x11\x28\x82\x06\x87\x9b\xc4\x0b\x36\xc5\x20\x51\x25\xb1\x29\x80\x9a\xd6\x62\x83\x8e\x75\x02\x32\x19\xf4\x32\x7d\x5d\xe2\xd3\x62\x13\x88\x19\x5d\xa2\xe6\xd6\x27\xed\x64\x93\x7b\x0f\xda\x18\x8c\x11\x5e\xca\x34\x7a\x67\x5e\x05\x53\x13\xa3\xe1\x55\xc0\x51\xea\xc9\x53\xa4\xa3\x6a\x9f\x8c\xb6\x3a\x15\x04\x0e\x5b\xe0\x9a\xe2\x29\xf0\x4b\x29\x53\x0b\xa6\xb3\x43\x93\xf4\x48\xf5\xd3\x62\x83\x8b\x59\xc4\x90\x5a\xb7\x1e\xf7\x9b\xae\x53\xc9\xd4\x09\xa5\x8e\x48\xf6\xed\xe4\xf1\x65\xf3\x4f\x00\x00\x00\xff\xff\xb5\x87\x74\x62\x86\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x5f\x8f\xeb\xb4\x13\x7d\xcf\xa7\x98\x5f\x7f\x0f\x06\x89\xc6\x5a\x74\x25\xd0\xca\x89\xb4\x62\x97\x82\x74\x59\x2a\xee\x82\xc4\xa3\xeb\x4c\x13\x83\x63\x07\x7b\xda\x52\x45\xfd\xee\xc8\xf9\xb3\x4d\xbb\xdd\x3f\x17\xad\xc4\x53\x6d\x8f\x3d\x73\x7c\x7c\x4e\x33\x6d\x0b\x05\xae\xb5\x45\x98\x19\xb9\x77\x1b\x9a\xc1\xe1\x90\x88\xff\xdd\xfe\xfc\xdd\xc3\xef\xcb\x3b\xa8\xa8\x36\x79\x22\xfa\x9f\x44\x54\x28\x8b\x3c\x01\x10\x35\x92\x04\x55\x49\x1f\x90\x32\xf6\xeb\xc3\xf7\xf3\x6f\x59\x17\x20\x4d\x06\xf3\xc5\xfd\x32\x86\xbc\xe0\xfd\xfc\x78\xc4\x59\x42\x4b\x19\xdb\xe9\x82\xaa\xac\xc0\xad\x56\x38\xef\x26\x5f\x69\xab\x49\x4b\x33\x0f\x4a\x1a\xcc\xae\x18\x58\x59\x63\xc6\xb6\x1a\x77\x8d\xf3\xd4\xe7\x37\xda\xfe\x09\x95\xc7\x75\xc6\x78\xa0\xbd\xc1\xc0\x83\xf2\x88\x36\x55\x21\x30\xf0\x68\x32\xd6\xaf\x57\x88\xcf\x9f\x69\x3a\x78\x6f\x3c\xa3\x6b\x59\x62\xe0\x6b\xb9\xd5\xca\xd9\x54\x2b\x37\x1c\x8a\x53\x06\xb4\x6f\x30\x63\xdd\x26\xfe\xf7\xbc\x5b\xcb\x13\xc1\x7b\xae\x12\xb1\x72\xc5\x1e\x94\x91\x21\x64\x6c\xe7\x65\xd3\x63\x8a\x51\xf4\xe0\x9d\xc1\x8c\xad\xa4\xb5\xe8\xbb\x00\x80\xb0\x72\x3b\xee\xaf\xdd\x4a\x1b\x9c\xc7\x95\x50\xb9\xdd\xdc\xd9\x79\xbf\x14\x86\xcd\x00\x62\x63\xc6\x21\x40\xdb\x82\x5e\x43\xfa\x83\xab\x71\x29\x4b\x84\xc3\x21\x32\x36\x66\x53\x1b\xef\xd1\x12\xcb\xe3\x3e\x34\x61\x8c\xf7\x73\x5b\xc4\xa7\x1f\x33\x01\x08\x39\xde\x9f\xe5\xe3\x6b\xca\x63\x29\xc1\x8d\x7e\xad\xf0\x79\xa1\xcb\x40\x9e\x2f\x5c\x38\xc5\x65\xa3\x59\x7e\xb3\xfc\xf1\x85\xe2\x62\x3a\x99\x9c\xaf\x88\x9a\x70\xcd\x79\xa9\xa9\xda\xac\x52\xe5\x6a\x5e\x46\x4d\x05\x5e\xda\x5e\x00\x7c\x65\xdc\x8a\xd7\x32\x10\x7a\xfe\xcb\xdd\xcd\xed\x4f\x77\x69\x5d\xb0\x5c\x84\x46\xda\x11\x6d\xa5\x0b\x3c\x61\xfe\xd6\x29\x70\x56\xf0\xb8\x69\x5a\x18\x60\xa1\xe9\xa3\x5c\xfd\x4b\xa8\x11\xa9\x71\x2b\x69\x3a\x8c\xa9\xf3\x25\x97\x4d\x13\x58\xbe\xf4\xee\x0f\x54\x14\x9e\xcd\x2b\xf8\x28\x02\xc1\xad\xdc\x0e\xc3\x42\x3f\xea\xa8\xf4\xba\x38\x2a\x66\x12\xd8\x58\x4d\xe0\x2c\xce\xff\xda\x48\x4f\xe8\x41\xa1\x25\xf4\x97\x84\x76\x7a\xd0\xb8\xd2\xb1\x8b\x57\x39\xbe\xda\x24\x0a\x70\x42\x69\x88\x15\xcc\x9e\xe5\x8b\xee\xc2\xf7\xf1\xc2\x97\xf8\x14\xba\x2e\x41\x1a\xca\xd8\xe2\xfe\x06\x3e\xc6\x9a\x10\xbc\x3a\x7a\xb2\xb4\x32\x0d\xdb\x92\x41\xf7\x17\x92\xb1\x6f\xbe\x3e\x05\x75\xca\x58\xa1\x07\x6e\xce\x27\x53\xcb\x49\x6d\x3b\xc3\x75\xd4\x50\xe5\xf1\x91\x9c\x00\x4f\xa4\x30\x24\x38\xb3\xe1\xfb\x1a\xf1\x55\x2b\x9e\x8b\xec\x7d\xed\xf8\x26\x43\x3e\x85\x70\xa6\xf4\xff\xd4\x96\x17\x8d\xf9\xb9\x90\x3f\xcf\x9e\xe7\xd9\x8f\x16\x3d\x35\xe9\x28\xc3\xfe\x7b\x81\xbe\x1b\x07\x54\xa4\xdd\xe3\x4d\xb5\x25\x3f\x9a\xed\xed\xae\xde\x55\xce\xe0\xcb\x7e\xae\xae\x06\x03\x42\xe7\x40\xe8\xb5\x35\x58\xb5\xfb\x8a\x66\x33\xe5\x8c\xf3\xd7\xff\x97\x52\xce\xf2\x2f\xda\x16\xd2\xdf\xd0\x87\x08\xee\x70\xf8\x72\xe0\x5a\xf0\xea\x6a\x9a\xf5\x43\xfe\x49\x69\xb4\xa4\xd7\x5a\x0d\x99\xb5\x85\x5b\x24\xa9\x8d\xe0\xd5\x87\x0b\x1e\x9c\xf0\x30\x5c\x3e\x6e\x6a\x5b\x20\xac\x1b\x23\x09\x61\x36\xb4\x0e\x33\x48\xa3\x3e\x2f\xd0\xb4\x76\x8e\xd0\xbf\x23\x4f\x0f\x95\x0e\xa0\x03\x48\xb0\xb8\x8b\xbc\x60\x9d\x8f\x12\x15\x1c\xeb\x1c\x74\xdd\x18\xac\xd1\x92\x8c\xef\x95\x8c\x7a\x99\xbd\x2e\xf1\x59\xbe\xf3\x9a\x08\x2d\x68\x0b\x0b\x17\x95\x99\x26\x0f\x0e\xa4\x52\x18\x02\xbc\x94\x69\xf2\x9f\x79\xe3\x55\xa5\x09\x15\x6d\x3c\x4e\x52\x27\x4f\x91\x4e\xaa\x7d\x52\xd2\xc8\x58\x10\xc8\xef\x81\x2a\x1d\x4e\x81\x5f\x73\x1e\x5b\x30\x99\x0e\x4d\xd2\x99\xea\x67\xf9\x0e\x57\xf3\x80\x3e\xb6\x6e\x31\x4d\xfa\xb6\xe7\x14\x3c\x76\x42\xb1\x23\xe2\x7d\x3b\xd9\xb6\x80\xb6\x80\xc3\x21\xf9\x67\x00\xb5\x87\x74\x62\x86\x0a\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/README.md"].(os.FileInfo),
+		fs["/authors_abbr.txt"].(os.FileInfo),
 		fs["/bacteria_genera.txt"].(os.FileInfo),
 		fs["/bacteria_genera_homonyms.txt"].(os.FileInfo),
 		fs["/genera_auth_icn.txt"].(os.FileInfo),
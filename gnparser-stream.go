@@ -1,63 +1,338 @@
 package gnparser
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/gnames/gnparser/pb"
 )
 
+// orderWindowPerJob sets how many names per worker can be parsed ahead of
+// the oldest name that still waits for its result, when the order of the
+// input is preserved.
+const orderWindowPerJob = 8
+
 // ParseResult structure contains parsing output and/or error generated
 // by the parser.
 type ParseResult struct {
+	// Idx is the position of the input in the stream, starting from 0.
+	Idx    int
 	Input  string
 	Output string
 	Error  error
 }
 
+// parseJob is a name-string with its position in the input stream.
+type parseJob struct {
+	idx  int
+	name string
+}
+
+// StreamError aggregates errors that happened during stream parsing,
+// including the error of a cancelled context.
+type StreamError struct {
+	Errors []error
+}
+
+// Error returns messages of all collected errors.
+func (e *StreamError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors during stream parsing: %s",
+		len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Is reports if any of collected errors matches the target, so
+// errors.Is(err, context.Canceled) works on aggregated errors.
+func (e *StreamError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if err == target {
+			return true
+		}
+	}
+	return false
+}
+
+// newStreamError returns nil if there were no errors and the context was
+// not cancelled.
+func newStreamError(ctx context.Context, errs []error) error {
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &StreamError{Errors: errs}
+}
+
 // ParseStream function takes input/output channels to do concurrent
-// parsing jobs. Output is pushed as ParseResult objects.
+// parsing jobs. Output is pushed as ParseResult objects. If OptPreserveOrder
+// is set to true, the output keeps the order of the input.
 func ParseStream(jobs int, in <-chan string, out chan<- *ParseResult,
 	opts ...Option) {
+	_ = ParseStreamContext(context.Background(), jobs, in, out, opts...)
+}
+
+// ParseStreamContext works like ParseStream, but stops parsing when the
+// context is cancelled or its deadline is exceeded. Every name-string read
+// from the input gets exactly one ParseResult, unless parsing is stopped.
+// If jobs is less than 1, one job is used.
+// The output channel is closed before the function returns. The returned
+// error is a *StreamError with parsing errors and the error of the context,
+// or nil. After cancellation the input channel is not read anymore, so the
+// sender should watch ctx.Done() as well.
+func ParseStreamContext(ctx context.Context, jobs int, in <-chan string,
+	out chan<- *ParseResult, opts ...Option) error {
+	gnp := NewGNparser(opts...)
+	if jobs < 1 {
+		jobs = 1
+	}
+	window := newOrderWindow(gnp, jobs)
+	jobsCh := make(chan parseJob)
+	resCh := make(chan *ParseResult)
 	var wg sync.WaitGroup
 	wg.Add(jobs)
+	go dispatchJobs(ctx, in, jobsCh, window)
 	for i := 0; i < jobs; i++ {
-		go parserWorker(i, in, out, &wg, opts...)
+		go parserWorker(ctx, gnp, jobsCh, resCh, &wg)
+	}
+	go func() {
+		wg.Wait()
+		close(resCh)
+	}()
+	var errs []error
+	if window == nil {
+		errs = forwardResults(ctx, resCh, out)
+	} else {
+		errs = reorderResults(ctx, resCh, out, window)
+	}
+	for range resCh {
 	}
-	wg.Wait()
 	close(out)
+	return newStreamError(ctx, errs)
 }
 
-func parserWorker(i int, in <-chan string, out chan<- *ParseResult,
-	wg *sync.WaitGroup, opts ...Option) {
-	gnp := NewGNparser(opts...)
+func parserWorker(ctx context.Context, gnp GNparser, in <-chan parseJob,
+	out chan<- *ParseResult, wg *sync.WaitGroup) {
 	defer wg.Done()
-	for s := range in {
-		res, err := gnp.ParseAndFormat(s)
-		if err != nil {
-			out <- &ParseResult{Input: s, Output: "", Error: err}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j, ok := <-in:
+			if !ok {
+				return
+			}
+			res := &ParseResult{Idx: j.idx, Input: j.name}
+			res.Output, res.Error = gnp.ParseAndFormat(j.name)
+			select {
+			case out <- res:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// forwardResults sends results to the output as they come and collects
+// parsing errors.
+func forwardResults(ctx context.Context, in <-chan *ParseResult,
+	out chan<- *ParseResult) []error {
+	var errs []error
+	for r := range in {
+		if r.Error != nil {
+			errs = append(errs, r.Error)
+		}
+		select {
+		case out <- r:
+		case <-ctx.Done():
+			return errs
 		}
-		out <- &ParseResult{Input: s, Output: res, Error: nil}
 	}
+	return errs
+}
+
+// reorderResults sends results to the output in the order of their indices
+// and collects parsing errors. Results that arrive too early wait in
+// a buffer, the size of which is limited by the window.
+func reorderResults(ctx context.Context, in <-chan *ParseResult,
+	out chan<- *ParseResult, window chan struct{}) []error {
+	var errs []error
+	next := 0
+	buf := make(map[int]*ParseResult)
+	for r := range in {
+		buf[r.Idx] = r
+		for {
+			r, ok := buf[next]
+			if !ok {
+				break
+			}
+			delete(buf, next)
+			if r.Error != nil {
+				errs = append(errs, r.Error)
+			}
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return errs
+			}
+			<-window
+			next++
+		}
+	}
+	return errs
 }
 
 // ParseStreamToObjects function takes input/output channels to do concurrent
 // parsing to object jobs. Output is pushed as ParseObjectResult objects.
+// If OptPreserveOrder is set to true, the output keeps the order of the input.
 func ParseStreamToObjects(jobs int, in <-chan string,
 	out chan<- *pb.Parsed, opts ...Option) {
+	_ = ParseStreamToObjectsContext(context.Background(), jobs, in, out,
+		opts...)
+}
+
+// ParseStreamToObjectsContext works like ParseStreamToObjects, but stops
+// parsing when the context is cancelled or its deadline is exceeded.
+// The output channel is closed before the function returns. The returned
+// error is a *StreamError with the error of the context, or nil.
+func ParseStreamToObjectsContext(ctx context.Context, jobs int,
+	in <-chan string, out chan<- *pb.Parsed, opts ...Option) error {
+	gnp := NewGNparser(opts...)
+	if jobs < 1 {
+		jobs = 1
+	}
+	window := newOrderWindow(gnp, jobs)
+	jobsCh := make(chan parseJob)
+	resCh := make(chan *pb.Parsed)
 	var wg sync.WaitGroup
 	wg.Add(jobs)
+	go dispatchJobs(ctx, in, jobsCh, window)
 	for i := 0; i < jobs; i++ {
-		go parserObjectWorker(i, in, out, &wg, opts...)
+		go parserObjectWorker(ctx, gnp, jobsCh, resCh, &wg)
+	}
+	go func() {
+		wg.Wait()
+		close(resCh)
+	}()
+	if window == nil {
+		forwardObjects(ctx, resCh, out)
+	} else {
+		reorderObjects(ctx, resCh, out, window)
+	}
+	for range resCh {
 	}
-	wg.Wait()
 	close(out)
+	return newStreamError(ctx, nil)
 }
 
-func parserObjectWorker(i int, in <-chan string,
-	out chan<- *pb.Parsed, wg *sync.WaitGroup, opts ...Option) {
-	gnp := NewGNparser(opts...)
+func parserObjectWorker(ctx context.Context, gnp GNparser,
+	in <-chan parseJob, out chan<- *pb.Parsed, wg *sync.WaitGroup) {
 	defer wg.Done()
-	for s := range in {
-		out <- gnp.ParseToObject(s)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j, ok := <-in:
+			if !ok {
+				return
+			}
+			res := gnp.ParseToObject(j.name)
+			res.Idx = int32(j.idx)
+			select {
+			case out <- res:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// forwardObjects sends parsed objects to the output as they come.
+func forwardObjects(ctx context.Context, in <-chan *pb.Parsed,
+	out chan<- *pb.Parsed) {
+	for r := range in {
+		select {
+		case out <- r:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reorderObjects sends parsed objects to the output in the order of their
+// indices.
+func reorderObjects(ctx context.Context, in <-chan *pb.Parsed,
+	out chan<- *pb.Parsed, window chan struct{}) {
+	var next int32
+	buf := make(map[int32]*pb.Parsed)
+	for r := range in {
+		buf[r.Idx] = r
+		for {
+			r, ok := buf[next]
+			if !ok {
+				break
+			}
+			delete(buf, next)
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return
+			}
+			<-window
+			next++
+		}
+	}
+}
+
+// newOrderWindow returns a channel that limits how far parsing can go ahead
+// of the results that are not sent yet. It returns nil if the order of the
+// input does not need to be preserved.
+func newOrderWindow(gnp GNparser, jobs int) chan struct{} {
+	if !gnp.preserveOrder {
+		return nil
+	}
+	return make(chan struct{}, jobs*orderWindowPerJob)
+}
+
+// dispatchJobs assigns an index to every name-string from the input and
+// sends it to workers. If window is given, a name is not sent until there is
+// a free slot in the window. It stops reading the input when the context
+// is done.
+func dispatchJobs(ctx context.Context, in <-chan string,
+	out chan<- parseJob, window chan struct{}) {
+	defer close(out)
+	idx := 0
+	for {
+		var s string
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case s, ok = <-in:
+			if !ok {
+				return
+			}
+		}
+		if window != nil {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		select {
+		case out <- parseJob{idx: idx, name: s}:
+		case <-ctx.Done():
+			return
+		}
+		idx++
 	}
 }
@@ -3,8 +3,12 @@ package gnparser
 import (
 	"bytes"
 	"fmt"
+	"log"
 	"runtime"
+	"sync"
+	"sync/atomic"
 
+	"github.com/gnames/gnparser/dict"
 	"github.com/gnames/gnparser/pb"
 	"github.com/gnames/gnparser/preprocess"
 
@@ -18,15 +22,31 @@ type GNparser struct {
 	Format
 	// workersNum defines the number of goroutines running parser in parallel.
 	workersNum int
+	// preserveOrder indicates that stream parsing returns results in the same
+	// order as the input.
+	preserveOrder bool
 	// removeHTML indicates that HTML tags have to be removed.
 	removeHTML bool
-	// nameString keeps parsed string
-	nameString string
+	// code is a nomenclatural code that settles ambiguous cases of parsing.
+	code grammar.Code
+	// authorAbbr contains full names of authors for their abbreviations. If
+	// it is nil, the default dictionary is used.
+	authorAbbr dict.AuthorAbbr
+	// expandAuthors indicates that the output has to contain the authorship
+	// with full names of authors instead of their abbreviations.
+	expandAuthors bool
+	// inferRank indicates that ranks of uninomials have to be inferred from
+	// their suffixes.
+	inferRank bool
 	// isTest indicates that parsing is done for test purposes, so instead of
 	// real version of the paraser output will contain "test_version" phrase.
 	isTest bool
-	// parser keeps parsing engine
-	parser *grammar.Engine
+	// engines keeps a pool of parsing engines, so GNparser can be used by
+	// many goroutines at once.
+	engines *sync.Pool
+	// last keeps the last parsed name-string for deprecated ToJSON,
+	// ToPrettyJSON, ToSlice and ParsedName methods.
+	last *atomic.Value
 }
 
 // Option is a function that creates a new option for GNparser.
@@ -47,6 +67,15 @@ func OptFormat(f string) Option {
 	}
 }
 
+// OptPreserveOrder Option is true or false. When true, stream parsing
+// returns results in the same order as the names were received. When false,
+// results are returned as soon as they are ready, which is faster.
+func OptPreserveOrder(po bool) Option {
+	return func(gnp *GNparser) {
+		gnp.preserveOrder = po
+	}
+}
+
 // OptIsTest Option to substitute real version of the parser with 'test_version'
 // string.
 func OptIsTest() Option {
@@ -63,6 +92,86 @@ func OptRemoveHTML(r bool) Option {
 	}
 }
 
+// OptCode Option sets a nomenclatural code of names: "zoological",
+// "botanical", "bacterial", "cultivated", "virus" or "any". The code settles
+// cases that are ambiguous otherwise, for example if a word in parentheses
+// after a genus is a subgenus or an author. The default is "any".
+func OptCode(c string) Option {
+	return func(gnp *GNparser) {
+		code, err := grammar.NewCode(c)
+		if err != nil {
+			log.Printf("%s, using default '%s' code", err, code)
+		}
+		gnp.code = code
+	}
+}
+
+// OptAuthorAbbrFiles Option adds abbreviations of authors' names from
+// user-supplied files to the default dictionary. Every line of a file has
+// an abbreviation, a full name and a surname separated by tabs, like
+// 'L.<TAB>Carl Linnaeus<TAB>Linnaeus'. Files that cannot be loaded are
+// skipped with a log message, use LoadAuthorAbbrFiles and OptAuthorAbbr
+// to handle such errors.
+func OptAuthorAbbrFiles(paths []string) Option {
+	return func(gnp *GNparser) {
+		if len(paths) == 0 {
+			return
+		}
+		abbr := dict.Dict.AuthorAbbr.Copy()
+		for _, v := range paths {
+			if err := abbr.AddFile(v); err != nil {
+				log.Printf("%s, skipping the file", err)
+			}
+		}
+		gnp.authorAbbr = abbr
+	}
+}
+
+// OptAuthorAbbr Option sets the dictionary of abbreviations of authors'
+// names, for example the one created by LoadAuthorAbbrFiles.
+func OptAuthorAbbr(abbr dict.AuthorAbbr) Option {
+	return func(gnp *GNparser) {
+		gnp.authorAbbr = abbr
+	}
+}
+
+// LoadAuthorAbbrFiles function returns the default dictionary of
+// abbreviations of authors' names with abbreviations from user-supplied
+// files added to it. It returns an error if a file is missing or has a
+// malformed line.
+func LoadAuthorAbbrFiles(paths []string) (dict.AuthorAbbr, error) {
+	abbr := dict.Dict.AuthorAbbr.Copy()
+	for _, v := range paths {
+		if err := abbr.AddFile(v); err != nil {
+			return nil, err
+		}
+	}
+	return abbr, nil
+}
+
+// OptExpandAuthors Option is true or false. When true, the output contains
+// the authorship with full names of authors instead of their standard
+// abbreviations.
+func OptExpandAuthors(ea bool) Option {
+	return func(gnp *GNparser) {
+		gnp.expandAuthors = ea
+	}
+}
+
+// OptInferRank Option is true or false. When true, uninomials without
+// explicit ranks get ranks inferred from their suffixes, like 'family' for
+// 'Hominidae', with confidence and the nomenclatural code of the rank.
+func OptInferRank(ir bool) Option {
+	return func(gnp *GNparser) {
+		gnp.inferRank = ir
+	}
+}
+
+// AvailableCodes function returns names of supported nomenclatural codes.
+func AvailableCodes() []string {
+	return grammar.AvailableCodes()
+}
+
 // NewGNparser constructor function takes options and returns
 // configured GNparser.
 func NewGNparser(opts ...Option) GNparser {
@@ -70,137 +179,241 @@ func NewGNparser(opts ...Option) GNparser {
 	for _, opt := range opts {
 		opt(&gnp)
 	}
-	e := &grammar.Engine{Buffer: ""}
-	e.Init()
-	gnp.parser = e
+	gnp.last = &atomic.Value{}
+	gnp.engines = &sync.Pool{
+		New: func() interface{} {
+			e := &grammar.Engine{Buffer: ""}
+			e.Code = gnp.code
+			e.AuthorAbbr = gnp.authorAbbr
+			e.InferRank = gnp.inferRank
+			e.Init()
+			return e
+		},
+	}
 	return gnp
 }
 
+// engine takes a parsing engine from the pool. It has to be returned back
+// with putEngine after use.
+func (gnp GNparser) engine() *grammar.Engine {
+	return gnp.engines.Get().(*grammar.Engine)
+}
+
+func (gnp GNparser) putEngine(e *grammar.Engine) {
+	gnp.engines.Put(e)
+}
+
 // WorkersNum returns the number of workers for concurrent parsing.
 func (gnp *GNparser) WorkersNum() int {
 	return gnp.workersNum
 }
 
-// Parse function parses input using GNparser's supplied options.
-// The abstract syntax tree formed by the parser is stored in an
-// `gnp.parser.SN` field.
-func (gnp GNparser) Parse(s string) {
-	gnp.nameString = s
+// Code returns the name of the nomenclatural code used by the parser.
+func (gnp *GNparser) Code() string {
+	return gnp.code.String()
+}
+
+// PreserveOrder returns true if stream parsing keeps the order of the input.
+func (gnp *GNparser) PreserveOrder() bool {
+	return gnp.preserveOrder
+}
+
+// Parse function parses input using GNparser's supplied options and
+// returns the abstract syntax tree of the name-string. It is safe to call
+// Parse from many goroutines.
+func (gnp GNparser) Parse(s string) *grammar.ScientificNameNode {
+	e := gnp.engine()
+	defer gnp.putEngine(e)
+	gnp.last.Store(s)
+	return gnp.parse(e, s)
+}
+
+// preprocess prepares a name-string for parsing according to the settings
+// of the parser. It also returns true if HTML tags or entities were removed.
+func (gnp GNparser) preprocess(s string) (*preprocess.Preprocessor, bool) {
+	nameString := s
 	tagsOrEntities := false
 	if gnp.removeHTML {
-		orig := gnp.nameString
-		gnp.nameString = preprocess.StripTags(gnp.nameString)
-		if orig != gnp.nameString {
+		nameString = preprocess.StripTags(s)
+		if nameString != s {
 			tagsOrEntities = true
 		}
 	}
-	preproc := preprocess.Preprocess([]byte(gnp.nameString))
+	if gnp.code == grammar.VirusCode {
+		return &preprocess.Preprocessor{Virus: true, NoParse: true},
+			tagsOrEntities
+	}
+	if gnp.code == grammar.CultivatedCode {
+		return preprocess.PreprocessCultivars([]byte(nameString)), tagsOrEntities
+	}
+	return preprocess.Preprocess([]byte(nameString)), tagsOrEntities
+}
+
+// parse does parsing with a given engine. The engine keeps its state
+// until the next parsing.
+func (gnp GNparser) parse(e *grammar.Engine, s string) *grammar.ScientificNameNode {
+	preproc, tagsOrEntities := gnp.preprocess(s)
 	if preproc.NoParse {
-		gnp.parser.NewNotParsedScientificNameNode(preproc)
+		e.NewNotParsedScientificNameNode(preproc)
 	}
-	gnp.parser.Buffer = string(preproc.Body)
-	gnp.parser.FullReset()
+	e.Buffer = string(preproc.Body)
+	e.FullReset()
+	e.GapStart, e.GapLen = preproc.GapStart, preproc.GapLen
 	if tagsOrEntities {
-		gnp.parser.AddWarn(grammar.HTMLTagsEntitiesWarn)
+		e.AddWarn(grammar.HTMLTagsEntitiesWarn)
 	}
 	if len(preproc.Tail) > 0 {
-		gnp.parser.AddWarn(grammar.TailWarn)
+		e.AddWarn(grammar.TailWarn)
 	}
 	if preproc.Underscore {
-		gnp.parser.AddWarn(grammar.SpaceNonStandardWarn)
+		e.AddWarn(grammar.SpaceNonStandardWarn)
 	}
-	err := gnp.parser.Parse()
+	err := e.Parse()
 	if err != nil {
-		gnp.parser.Error = err
-		gnp.parser.NewNotParsedScientificNameNode(preproc)
+		e.Error = err
+		e.NewNotParsedScientificNameNode(preproc)
 	} else {
-		gnp.parser.OutputAST()
-		gnp.parser.NewScientificNameNode()
+		e.OutputAST()
+		e.NewScientificNameNode()
 		if len(preproc.Tail) > 0 {
-			gnp.parser.SN.Tail += string(preproc.Tail)
+			e.SN.Tail += string(preproc.Tail)
 		}
+		e.SN.NomAnnotations = preproc.NomAnnotations
 	}
-	gnp.parser.SN.AddVerbatim(s)
-	gnp.parser.SN.ParserVersion = gnp.Version()
+	e.SN.AddVerbatim(s)
+	e.SN.ParserVersion = gnp.Version()
+	e.SN.Code = gnp.code
+	e.SN.ExpandAuthors = gnp.expandAuthors
+	return e.SN
+}
+
+// ParseName parses a name-string and returns a self-contained result of
+// parsing, that can be formatted later by FormatOutput. Name-strings that
+// cannot be parsed are not errors, their output has Parsed set to false.
+func (gnp GNparser) ParseName(s string) *output.Output {
+	sn := gnp.Parse(s)
+	return output.NewOutput(sn)
+}
+
+// ParseNames parses a batch of name-strings concurrently using the
+// number of workers of GNparser. Results keep the order of the input.
+func (gnp GNparser) ParseNames(names []string) []*output.Output {
+	res := make([]*output.Output, len(names))
+	jobs := gnp.workersNum
+	if jobs < 1 {
+		jobs = 1
+	}
+	idxCh := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+	for i := 0; i < jobs; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				res[idx] = output.NewOutput(gnp.Parse(names[idx]))
+			}
+		}()
+	}
+	for i := range names {
+		idxCh <- i
+	}
+	close(idxCh)
+	wg.Wait()
+	return res
 }
 
 // ParseAndFormat function parses input and formats results according
 // to format setting of GNparser.
 func (gnp GNparser) ParseAndFormat(s string) (string, error) {
-	var err error
 	if gnp.Format == Debug {
 		bs := gnp.Debug(s)
 		return string(bs), nil
 	}
-	gnp.Parse(s)
-	var bs []byte
-	switch gnp.Format {
-	case Compact:
-		bs, err = gnp.ToJSON()
-		if err != nil {
-			return "", err
-		}
-		s = string(bs)
-	case Pretty:
-		bs, err = gnp.ToPrettyJSON()
-		if err != nil {
-			return "", err
-		}
-		s = string(bs)
-	case CSV:
-		s = output.ToCSV(gnp.ToSlice())
-	}
-	return s, nil
+	o := gnp.ParseName(s)
+	return gnp.FormatOutput(o)
 }
 
 // ParseToObject function parses input and
 // returns result as output.
 func (gnp GNparser) ParseToObject(s string) *pb.Parsed {
-	gnp.Parse(s)
-	return pb.ToPB(output.NewOutput(gnp.parser.SN))
-}
-
-// ToPrettyJSON function creates pretty JSON output out of parsed results.
-func (gnp GNparser) ToPrettyJSON() ([]byte, error) {
-	o := output.NewOutput(gnp.parser.SN)
-	return o.ToJSON(true)
-}
-
-// ToJSON function creates a 'compact' output out of parsed results.
-func (gnp GNparser) ToJSON() ([]byte, error) {
-	o := output.NewOutput(gnp.parser.SN)
-	return o.ToJSON(false)
-}
-
-// ToSlice function creates a flat simplified output of parsed results.
-func (gnp GNparser) ToSlice() []string {
-	so := output.NewSimpleOutput(gnp.parser.SN)
-	return so.ToSlice()
+	sn := gnp.Parse(s)
+	return pb.ToPB(output.NewOutput(sn))
 }
 
 // Debug returns byte representation of complete and 'output' syntax trees.
 func (gnp GNparser) Debug(s string) []byte {
-	ppr := preprocess.Preprocess([]byte(s))
+	ppr, _ := gnp.preprocess(s)
 	var b bytes.Buffer
 	if ppr.NoParse || ppr.Virus {
 		b.WriteString("\n*** Preprocessing: NO PARSE ***\n")
 		b.WriteString(fmt.Sprintf("\n%s\n", s))
 		return b.Bytes()
 	}
-	gnp.parser.Buffer = string(ppr.Body)
-	gnp.parser.FullReset()
-	_ = gnp.parser.Parse()
-	gnp.parser.OutputAST()
+	e := gnp.engine()
+	defer gnp.putEngine(e)
+	e.Buffer = string(ppr.Body)
+	e.FullReset()
+	_ = e.Parse()
+	e.OutputAST()
 	b.WriteString("\n*** Complete Syntax Tree ***\n")
-	gnp.parser.AST().PrettyPrint(&b, gnp.parser.Buffer)
+	e.AST().PrettyPrint(&b, e.Buffer)
 	b.WriteString("\n*** Output Syntax Tree ***\n")
-	gnp.parser.PrintOutputSyntaxTree(&b)
+	e.PrintOutputSyntaxTree(&b)
 	return b.Bytes()
 }
 
-// ParsedName returns the string of parsed result without a tail.
+// ParsedNameString parses a name-string and returns its parsed part
+// without a tail.
+func (gnp GNparser) ParsedNameString(s string) string {
+	e := gnp.engine()
+	defer gnp.putEngine(e)
+	gnp.parse(e, s)
+	return e.ParsedName()
+}
+
+// ToPrettyJSON function creates pretty JSON output out of the last parsed
+// name-string.
+//
+// Deprecated: the last parsed name-string is shared by all goroutines that
+// use the GNparser, use ParseName and output.Output.ToJSON instead.
+func (gnp GNparser) ToPrettyJSON() ([]byte, error) {
+	return gnp.ParseName(gnp.lastName()).ToJSON(true)
+}
+
+// ToJSON function creates a 'compact' output out of the last parsed
+// name-string.
+//
+// Deprecated: the last parsed name-string is shared by all goroutines that
+// use the GNparser, use ParseName and output.Output.ToJSON instead.
+func (gnp GNparser) ToJSON() ([]byte, error) {
+	return gnp.ParseName(gnp.lastName()).ToJSON(false)
+}
+
+// ToSlice function creates a flat simplified output of the last parsed
+// name-string.
+//
+// Deprecated: the last parsed name-string is shared by all goroutines that
+// use the GNparser, use ParseName and output.Output.ToSlice instead.
+func (gnp GNparser) ToSlice() []string {
+	return gnp.ParseName(gnp.lastName()).ToSlice()
+}
+
+// ParsedName returns the parsed part of the last parsed name-string
+// without a tail.
+//
+// Deprecated: the last parsed name-string is shared by all goroutines that
+// use the GNparser, use ParsedNameString instead.
 func (gnp GNparser) ParsedName() string {
-	return gnp.parser.ParsedName()
+	return gnp.ParsedNameString(gnp.lastName())
+}
+
+// lastName returns the last parsed name-string, or an empty string if
+// nothing was parsed yet.
+func (gnp GNparser) lastName() string {
+	if s, ok := gnp.last.Load().(string); ok {
+		return s
+	}
+	return ""
 }
 
 // Version function returns version number of `gnparser`.
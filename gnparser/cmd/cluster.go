@@ -0,0 +1,163 @@
+// Copyright © 2019 Dmitry Mozzherin <dmozzherin@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/cluster"
+	"github.com/gnames/gnparser/output"
+	"github.com/spf13/cobra"
+)
+
+// clusterCmd groups names from a file or STDIN by their stems.
+var clusterCmd = &cobra.Command{
+	Use:   "cluster names.txt",
+	Short: "Groups names with the same stemmed canonical form.",
+	Long: `
+Groups names with the same stemmed canonical form. Such groups contain
+duplicates and orthographic variants of names. Every group shows its
+spelling variants, authorship variants and a representative name with
+the best quality of parsing. Names are sorted in temporary files, so
+large inputs do not need much memory.
+
+To group names from a file:
+gnparser cluster names.txt > groups.csv
+
+To group names from STDIN in JSON format:
+cat names.txt | gnparser cluster -f compact > groups.json
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := []gnparser.Option{
+			gnparser.OptWorkersNum(workersNumFlag(cmd)),
+			gnparser.OptFormat(formatFlag(cmd)),
+			gnparser.OptCode(codeFlag(cmd)),
+		}
+		gnp := gnparser.NewGNparser(opts...)
+		c := cluster.NewClusterer(tmpDirFlag(cmd), 0)
+		if len(args) == 0 {
+			if !checkStdin() {
+				_ = cmd.Help()
+				return
+			}
+			if err := clusterNames(gnp, c, os.Stdin); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		err = clusterNames(gnp, c, f)
+		f.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(clusterCmd)
+
+	gnp := gnparser.NewGNparser()
+	formatHelp := "sets output format. Can be one of:\n compact, pretty, csv."
+	clusterCmd.Flags().StringP("format", "f", gnp.OutputFormat(), formatHelp)
+
+	codes := strings.Join(gnparser.AvailableCodes(), ", ")
+	codeHelp := fmt.Sprintf("sets nomenclatural code of names. Can be one of:\n %s.", codes)
+	clusterCmd.Flags().StringP("code", "c", gnp.Code(), codeHelp)
+
+	clusterCmd.Flags().IntP("jobs", "j", runtime.NumCPU(),
+		"nubmer of threads to run. CPU's threads number is the default.")
+
+	clusterCmd.Flags().StringP("tmp_dir", "t", "",
+		"directory for temporary files. The system default is used if empty.")
+}
+
+func tmpDirFlag(cmd *cobra.Command) string {
+	str, err := cmd.Flags().GetString("tmp_dir")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return str
+}
+
+// clusterNames parses names in batches, adds them to the clusterer and
+// prints groups of names. Temporary files of the clusterer are removed
+// before it returns, also in case of an error.
+func clusterNames(gnp gnparser.GNparser, c *cluster.Clusterer,
+	r io.Reader) error {
+	defer c.Close()
+	batch := make([]string, 0, batchSize)
+	count := 0
+	add := func() error {
+		for _, o := range gnp.ParseNames(batch) {
+			count++
+			if err := c.Add(count, o); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		batch = append(batch, sc.Text())
+		if len(batch) == batchSize {
+			if err := add(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if err := add(); err != nil {
+		return err
+	}
+
+	isJSON := gnp.Format == gnparser.Compact || gnp.Format == gnparser.Pretty
+	if !isJSON {
+		fmt.Println(output.ToCSV(cluster.CSVHeader()))
+	}
+	return c.Groups(func(g cluster.Group) error {
+		if !isJSON {
+			fmt.Println(output.ToCSV(g.ToSlice()))
+			return nil
+		}
+		bs, err := g.ToJSON(gnp.Format == gnparser.Pretty)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bs))
+		return nil
+	})
+}
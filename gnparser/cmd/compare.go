@@ -0,0 +1,105 @@
+// Copyright © 2019 Dmitry Mozzherin <dmozzherin@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gnames/gnparser"
+	"github.com/spf13/cobra"
+)
+
+// compareCmd compares pairs of names from a file or STDIN.
+var compareCmd = &cobra.Command{
+	Use:   "compare pairs.tsv",
+	Short: "Compares pairs of scientific names.",
+	Long: `
+Compares pairs of scientific names. Every line of the input contains two
+names separated by a tab. The output is tab-separated and shows if names
+have identical canonical forms, the same stems, differ only by rank
+markers or belong to the same species, and if their authorships agree.
+Every comparison has a score from 0 to 1.
+
+To compare names from a file:
+gnparser compare pairs.tsv > comparisons.tsv
+
+To compare names from STDIN:
+cat pairs.tsv | gnparser compare > comparisons.tsv
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := codeFlag(cmd)
+		gnp := gnparser.NewGNparser(gnparser.OptCode(code))
+		if len(args) == 0 {
+			if !checkStdin() {
+				_ = cmd.Help()
+				return
+			}
+			if err := comparePairs(gnp, os.Stdin); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		err = comparePairs(gnp, f)
+		f.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	gnp := gnparser.NewGNparser()
+	codes := strings.Join(gnparser.AvailableCodes(), ", ")
+	codeHelp := fmt.Sprintf("sets nomenclatural code of names. Can be one of:\n %s.", codes)
+	compareCmd.Flags().StringP("code", "c", gnp.Code(), codeHelp)
+}
+
+// comparePairs reads tab-separated pairs of names and prints results of
+// their comparison. Lines without a pair of names are skipped. It returns
+// an error if the input cannot be read.
+func comparePairs(gnp gnparser.GNparser, r io.Reader) error {
+	fmt.Println(strings.Join(gnparser.CompareHeader(), "\t"))
+	sc := bufio.NewScanner(r)
+	count := 0
+	for sc.Scan() {
+		count++
+		names := strings.Split(sc.Text(), "\t")
+		if len(names) < 2 {
+			log.Printf("Line %d does not have a pair of names, skipping", count)
+			continue
+		}
+		c := gnp.Compare(names[0], names[1])
+		fmt.Println(strings.Join(c.ToSlice(), "\t"))
+	}
+	return sc.Err()
+}
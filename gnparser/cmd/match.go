@@ -0,0 +1,179 @@
+// Copyright © 2019 Dmitry Mozzherin <dmozzherin@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/output"
+	"github.com/gnames/gnparser/reconcile"
+	"github.com/spf13/cobra"
+)
+
+// batchSize is the number of input names parsed at once. Batches keep
+// memory usage low for large inputs.
+const batchSize = 10000
+
+// matchCmd matches names from a file or STDIN to a reference checklist.
+var matchCmd = &cobra.Command{
+	Use:   "match --reference checklist.txt names.txt",
+	Short: "Matches names to a reference checklist.",
+	Long: `
+Matches names to names of a local reference checklist. Both files contain
+one name per line. The reference is indexed by canonical forms, stems and
+authorships of its names. Every input name gets the best match from the
+reference, its type (exact, canonical, fuzzyStem, partialGenus or none) and
+IDs of other candidates. IDs are line numbers of names in the reference.
+
+To match names from a file:
+gnparser match -r checklist.txt names.txt > matches.csv
+
+To match names from STDIN in JSON format:
+cat names.txt | gnparser match -r checklist.txt -f compact > matches.json
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		refPath := referenceFlag(cmd)
+		if refPath == "" {
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+		opts := []gnparser.Option{
+			gnparser.OptWorkersNum(workersNumFlag(cmd)),
+			gnparser.OptFormat(formatFlag(cmd)),
+			gnparser.OptCode(codeFlag(cmd)),
+		}
+		gnp := gnparser.NewGNparser(opts...)
+		ref := readReference(gnp, refPath)
+		if len(args) == 0 {
+			if !checkStdin() {
+				_ = cmd.Help()
+				return
+			}
+			matchNames(gnp, ref, os.Stdin)
+			return
+		}
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		matchNames(gnp, ref, f)
+		f.Close()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(matchCmd)
+
+	gnp := gnparser.NewGNparser()
+	matchCmd.Flags().StringP("reference", "r", "",
+		"file with names of a reference checklist, one name per line.")
+
+	formatHelp := "sets output format. Can be one of:\n compact, pretty, csv."
+	matchCmd.Flags().StringP("format", "f", gnp.OutputFormat(), formatHelp)
+
+	codes := strings.Join(gnparser.AvailableCodes(), ", ")
+	codeHelp := fmt.Sprintf("sets nomenclatural code of names. Can be one of:\n %s.", codes)
+	matchCmd.Flags().StringP("code", "c", gnp.Code(), codeHelp)
+
+	matchCmd.Flags().IntP("jobs", "j", runtime.NumCPU(),
+		"nubmer of threads to run. CPU's threads number is the default.")
+}
+
+func referenceFlag(cmd *cobra.Command) string {
+	str, err := cmd.Flags().GetString("reference")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return str
+}
+
+// readReference parses names of the reference checklist and creates their
+// index.
+func readReference(gnp gnparser.GNparser, path string) *reconcile.Reference {
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer f.Close()
+	var names []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		names = append(names, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	ref := reconcile.NewReference(gnp.ParseNames(names))
+	log.Printf("Indexed %d names of the reference", ref.Len())
+	return ref
+}
+
+// matchNames parses input names in batches and prints their matches in
+// the order of the input.
+func matchNames(gnp gnparser.GNparser, ref *reconcile.Reference,
+	r io.Reader) {
+	if gnp.Format != gnparser.Compact && gnp.Format != gnparser.Pretty {
+		fmt.Println(output.ToCSV(reconcile.CSVHeader()))
+	}
+	batch := make([]string, 0, batchSize)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		batch = append(batch, sc.Text())
+		if len(batch) == batchSize {
+			matchBatch(gnp, ref, batch)
+			batch = batch[:0]
+		}
+	}
+	matchBatch(gnp, ref, batch)
+	if err := sc.Err(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func matchBatch(gnp gnparser.GNparser, ref *reconcile.Reference,
+	names []string) {
+	for _, o := range gnp.ParseNames(names) {
+		res := ref.Match(o)
+		switch gnp.Format {
+		case gnparser.Compact, gnparser.Pretty:
+			bs, err := res.ToJSON(gnp.Format == gnparser.Pretty)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			fmt.Println(string(bs))
+		default:
+			fmt.Println(output.ToCSV(res.ToSlice()))
+		}
+	}
+}
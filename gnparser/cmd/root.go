@@ -31,6 +31,8 @@ import (
 	"sync"
 
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/dict"
+	"github.com/gnames/gnparser/grammar"
 	"github.com/gnames/gnparser/output"
 	"github.com/gnames/gnparser/rpc"
 	"github.com/gnames/gnparser/web"
@@ -41,6 +43,7 @@ import (
 var rootCmd = &cobra.Command{
 	Use:   "gnparser file_or_name",
 	Short: "Parses scientific names into their semantic elements.",
+	Args:  cobra.ArbitraryArgs,
 	Long: `
 Parses scientific names into their semantic elements.
 
@@ -63,6 +66,26 @@ gnparser names.txt [flags] > parsed_names.txt
 To leave HTML tags and entities intact when parsing (faster)
 gnparser names.txt -n > parsed_names.txt
 
+To return results in the order they are ready instead of the order of
+the input (faster)
+gnparser names.txt -u > parsed_names.txt
+
+To add authorship with full names of authors, using an additional
+file with abbreviations of authors' names
+gnparser names.txt -e -a my_authors.txt > parsed_names.txt
+
+To compare pairs of names from a tab-separated file:
+gnparser compare pairs.tsv > comparisons.tsv
+
+To match names to a reference checklist:
+gnparser match -r checklist.txt names.txt > matches.csv
+
+To group names with the same stemmed canonical form:
+gnparser cluster names.txt > groups.csv
+
+To generate spelling variants of a name:
+gnparser variants "Aus albus L."
+
 To start gRPC parsing service on port 3355 with a limit
 of 10 concurrent jobs per request:
 gnparser -j 10 -g 3355
@@ -78,6 +101,7 @@ gnparser -j 5 -g 8080
 		wn := workersNumFlag(cmd)
 
 		nocleanup := skipCleanupFlag(cmd)
+		unordered := unorderedFlag(cmd)
 
 		grpcPort := grpcFlag(cmd)
 		if grpcPort != 0 {
@@ -97,10 +121,21 @@ gnparser -j 5 -g 8080
 			os.Exit(0)
 		}
 		f := formatFlag(cmd)
+		code := codeFlag(cmd)
+		expand := expandAuthorsFlag(cmd)
+		abbr := authorsAbbrFlag(cmd)
+		inferRank := inferRankFlag(cmd)
 		opts := []gnparser.Option{
 			gnparser.OptWorkersNum(wn),
 			gnparser.OptFormat(f),
 			gnparser.OptRemoveHTML(!nocleanup),
+			gnparser.OptPreserveOrder(!unordered),
+			gnparser.OptCode(code),
+			gnparser.OptExpandAuthors(expand),
+			gnparser.OptInferRank(inferRank),
+		}
+		if abbr != nil {
+			opts = append(opts, gnparser.OptAuthorAbbr(abbr))
 		}
 		if len(args) == 0 {
 			processStdin(cmd, wn, opts)
@@ -130,11 +165,28 @@ func init() {
 	formatHelp := fmt.Sprintf("sets output format. Can be one of:\n %s.", formats)
 	rootCmd.Flags().StringP("format", "f", df, formatHelp)
 
+	codes := strings.Join(gnparser.AvailableCodes(), ", ")
+	codeHelp := fmt.Sprintf("sets nomenclatural code of names. Can be one of:\n %s.", codes)
+	rootCmd.Flags().StringP("code", "c", gnp.Code(), codeHelp)
+
 	rootCmd.Flags().IntP("jobs", "j", runtime.NumCPU(),
 		"nubmer of threads to run. CPU's threads number is the default.")
 
 	rootCmd.Flags().BoolP("nocleanup", "n", false, "keep HTML entities and tags when parsing.")
 
+	rootCmd.Flags().BoolP("unordered", "u", false,
+		"return results as soon as they are ready, ignoring the input order.")
+
+	rootCmd.Flags().BoolP("expand_authors", "e", false,
+		"add authorship with full names of authors instead of abbreviations.")
+
+	rootCmd.Flags().StringSliceP("authors_abbr", "a", nil,
+		"files with abbreviations of authors' names, one per line:\n"+
+			"abbreviation<TAB>full name<TAB>surname.")
+
+	rootCmd.Flags().BoolP("infer_rank", "i", false,
+		"infer ranks of uninomials from their suffixes, like family for -idae.")
+
 	rootCmd.Flags().IntP("grpc_port", "g", 0, "starts gRPC server on the port.")
 
 	rootCmd.Flags().IntP("web_port", "w", 0,
@@ -164,6 +216,52 @@ func skipCleanupFlag(cmd *cobra.Command) bool {
 	return nocleanup
 }
 
+func unorderedFlag(cmd *cobra.Command) bool {
+	unordered, err := cmd.Flags().GetBool("unordered")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return unordered
+}
+
+func expandAuthorsFlag(cmd *cobra.Command) bool {
+	expand, err := cmd.Flags().GetBool("expand_authors")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return expand
+}
+
+func inferRankFlag(cmd *cobra.Command) bool {
+	infer, err := cmd.Flags().GetBool("infer_rank")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return infer
+}
+
+// authorsAbbrFlag loads files with abbreviations of authors' names. It
+// returns nil if there are no such files.
+func authorsAbbrFlag(cmd *cobra.Command) dict.AuthorAbbr {
+	files, err := cmd.Flags().GetStringSlice("authors_abbr")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	abbr, err := gnparser.LoadAuthorAbbrFiles(files)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return abbr
+}
+
 func grpcFlag(cmd *cobra.Command) int {
 	grpcPort, err := cmd.Flags().GetInt("grpc_port")
 	if err != nil {
@@ -192,6 +290,19 @@ func formatFlag(cmd *cobra.Command) string {
 	return str
 }
 
+func codeFlag(cmd *cobra.Command) string {
+	str, err := cmd.Flags().GetString("code")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if _, err = grammar.NewCode(str); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return str
+}
+
 func workersNumFlag(cmd *cobra.Command) int {
 	i, err := cmd.Flags().GetInt("jobs")
 	if err != nil {
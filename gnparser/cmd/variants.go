@@ -0,0 +1,132 @@
+// Copyright © 2019 Dmitry Mozzherin <dmozzherin@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/output"
+	"github.com/gnames/gnparser/variants"
+	"github.com/spf13/cobra"
+)
+
+// variantsCmd generates spelling variants of names.
+var variantsCmd = &cobra.Command{
+	Use:   "variants file_or_name",
+	Short: "Generates spelling variants of canonical forms of names.",
+	Long: `
+Generates orthographic variants of simple canonical forms of names for
+search and matching. Variants change gender endings, genitive endings,
+'ae' and 'oe' diphthongs, 'j' and 'i', 'v' and 'u' letters, and restore
+ligatures and diacritics. Every variant is tagged with its rule.
+
+To generate variants of one name:
+gnparser variants "Aus albus L."
+
+To generate variants of names from a file or STDIN:
+gnparser variants names.txt > variants.csv
+cat names.txt | gnparser variants -f compact > variants.json
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := []gnparser.Option{
+			gnparser.OptFormat(formatFlag(cmd)),
+			gnparser.OptCode(codeFlag(cmd)),
+		}
+		gnp := gnparser.NewGNparser(opts...)
+		if gnp.Format != gnparser.Compact && gnp.Format != gnparser.Pretty {
+			fmt.Println(output.ToCSV(variantsHeader()))
+		}
+		if len(args) == 0 {
+			if !checkStdin() {
+				_ = cmd.Help()
+				return
+			}
+			if err := nameVariants(gnp, os.Stdin); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+		if !fileExists(args[0]) {
+			if err := nameVariants(gnp, strings.NewReader(args[0])); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		err = nameVariants(gnp, f)
+		f.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(variantsCmd)
+
+	gnp := gnparser.NewGNparser()
+	formatHelp := "sets output format. Can be one of:\n compact, pretty, csv."
+	variantsCmd.Flags().StringP("format", "f", gnp.OutputFormat(), formatHelp)
+
+	codes := strings.Join(gnparser.AvailableCodes(), ", ")
+	codeHelp := fmt.Sprintf("sets nomenclatural code of names. Can be one of:\n %s.", codes)
+	variantsCmd.Flags().StringP("code", "c", gnp.Code(), codeHelp)
+}
+
+func variantsHeader() []string {
+	return []string{"Name", "Canonical", "Variant", "Rule"}
+}
+
+// nameVariants prints variants of every name, one variant per line for CSV
+// format, or one name per line for JSON formats. It returns an error if
+// the input cannot be read.
+func nameVariants(gnp gnparser.GNparser, r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		o := gnp.ParseName(sc.Text())
+		res := variants.Generate(o)
+		switch gnp.Format {
+		case gnparser.Compact, gnparser.Pretty:
+			bs, err := res.ToJSON(gnp.Format == gnparser.Pretty)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			fmt.Println(string(bs))
+		default:
+			for _, v := range res.Variants {
+				row := []string{res.Name, res.Canonical, v.Canonical, v.Rule.String()}
+				fmt.Println(output.ToCSV(row))
+			}
+		}
+	}
+	return sc.Err()
+}
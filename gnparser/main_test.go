@@ -1,6 +1,9 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo"
@@ -52,6 +55,40 @@ var _ = Describe("Main", func() {
 					To(HavePrefix(`Id,Verbatim,Cardinality,CanonicalFull`))
 			})
 	})
+	Describe("--authors_abbr flag", func() {
+		It("exits with an error for a missing file", func() {
+			c := testcli.Command("gnparser", "Aus bus Mill.", "-a",
+				"/no/such/file.txt")
+			c.Run()
+			Expect(c.Success()).To(BeFalse())
+			Expect(c.Stdout()).To(ContainSubstring("no such file"))
+		})
+
+		It("exits with an error for a malformed file", func() {
+			path := filepath.Join(os.TempDir(), "gnparser_authors_abbr.txt")
+			err := ioutil.WriteFile(path, []byte("Smi.\n"), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(path)
+			c := testcli.Command("gnparser", "Aus bus Mill.", "-a", path)
+			c.Run()
+			Expect(c.Success()).To(BeFalse())
+			Expect(c.Stdout()).To(ContainSubstring("line 1"))
+		})
+
+		It("expands authors from a file", func() {
+			path := filepath.Join(os.TempDir(), "gnparser_authors_abbr.txt")
+			err := ioutil.WriteFile(path, []byte("Smi.\tJohn Smith\n"), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(path)
+			c := testcli.Command("gnparser", "Aus bus Smi.", "-e", "-a", path,
+				"-f", "compact")
+			c.Run()
+			Expect(c.Success()).To(BeTrue())
+			Expect(c.Stdout()).
+				To(ContainSubstring(`"expandedAuthorship":"John Smith"`))
+		})
+	})
+
 	Describe("Stdin", func() {
 		It("takes data from Stdin", func() {
 			c := testcli.Command("gnparser", "-f", "simple")
@@ -68,5 +105,112 @@ var _ = Describe("Main", func() {
 			Expect(c.Stdout()).To(ContainSubstring(",Plantago,"))
 			Expect(c.Stdout()).To(ContainSubstring(",Bubo,"))
 		})
+		It("keeps the order of names from Stdin", func() {
+			c := testcli.Command("gnparser", "-f", "csv", "-j", "8")
+			names := []string{"Plantago", "Bubo L.", "Homo sapiens", "Pomatomus"}
+			c.SetStdin(strings.NewReader(strings.Join(names, "\n")))
+			c.Run()
+			Expect(c.Success()).To(BeTrue())
+			lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+			Expect(len(lines)).To(Equal(len(names) + 1))
+			for i, v := range names {
+				Expect(lines[i+1]).To(ContainSubstring("," + v + ","))
+			}
+		})
+	})
+
+	Describe("compare command", func() {
+		It("compares pairs of names from Stdin", func() {
+			c := testcli.Command("gnparser", "compare")
+			c.SetStdin(strings.NewReader(
+				"Aus bus L.\tAus bus Linnaeus\nAus bus\tCus dus\n"))
+			c.Run()
+			Expect(c.Success()).To(BeTrue())
+			lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+			Expect(len(lines)).To(Equal(3))
+			Expect(lines[0]).To(HavePrefix("NameA\tNameB\tNameMatch"))
+			Expect(lines[1]).To(ContainSubstring("\tcanonical\t1\tcompatible\t"))
+			Expect(lines[2]).To(ContainSubstring("\tnone\t0\t"))
+		})
+
+		It("exits with an error if input cannot be read", func() {
+			c := testcli.Command("gnparser", "compare")
+			long := "Aus bus\t" + strings.Repeat("a", 70000)
+			c.SetStdin(strings.NewReader("Aus bus\tAus bus L.\n" + long + "\n"))
+			c.Run()
+			Expect(c.Success()).To(BeFalse())
+			Expect(c.Stdout()).To(ContainSubstring("\tcanonical\t1\t"))
+			Expect(c.Stderr()).To(ContainSubstring("token too long"))
+		})
+	})
+
+	Describe("match command", func() {
+		It("matches names to a reference", func() {
+			ref := filepath.Join(os.TempDir(), "gnparser_reference.txt")
+			err := ioutil.WriteFile(ref,
+				[]byte("Aus bus (L.) Mill.\nAus cus L.\n"), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(ref)
+			c := testcli.Command("gnparser", "match", "-r", ref)
+			c.SetStdin(strings.NewReader("Aus bus (Linnaeus) Miller\nCus dus\n"))
+			c.Run()
+			Expect(c.Success()).To(BeTrue())
+			lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+			Expect(len(lines)).To(Equal(3))
+			Expect(lines[0]).To(HavePrefix("Name,MatchType,MatchID"))
+			Expect(lines[1]).To(HavePrefix("Aus bus (Linnaeus) Miller,exact,1,"))
+			Expect(lines[2]).To(HavePrefix("Cus dus,none,"))
+		})
+
+		It("exits with an error if input cannot be read", func() {
+			ref := filepath.Join(os.TempDir(), "gnparser_reference.txt")
+			err := ioutil.WriteFile(ref, []byte("Aus bus (L.) Mill.\n"), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(ref)
+			c := testcli.Command("gnparser", "match", "-r", ref)
+			long := "Aus bus " + strings.Repeat("a", 70000)
+			c.SetStdin(strings.NewReader("Aus bus\n" + long + "\n"))
+			c.Run()
+			Expect(c.Success()).To(BeFalse())
+			Expect(c.Stdout()).To(ContainSubstring("Aus bus,canonical,1,"))
+			Expect(c.Stdout()).To(ContainSubstring("token too long"))
+		})
+	})
+
+	Describe("cluster command", func() {
+		It("groups names by stems", func() {
+			c := testcli.Command("gnparser", "cluster")
+			c.SetStdin(strings.NewReader("Aus albus L.\nBus cus\nAus alba\n"))
+			c.Run()
+			Expect(c.Success()).To(BeTrue())
+			lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+			Expect(len(lines)).To(Equal(3))
+			Expect(lines[0]).To(HavePrefix("Stem,Size,RepresentativeID"))
+			Expect(lines[1]).To(Equal(
+				"Aus alb,2,1,Aus albus L.,1,Aus albus; Aus alba,L."))
+			Expect(lines[2]).To(HavePrefix("Bus cus,1,2,"))
+		})
+	})
+
+	Describe("variants command", func() {
+		It("generates variants of a name", func() {
+			c := testcli.Command("gnparser", "variants", "Aus albus L.")
+			c.Run()
+			Expect(c.Success()).To(BeTrue())
+			lines := strings.Split(strings.TrimSpace(c.Stdout()), "\n")
+			Expect(lines[0]).To(Equal("Name,Canonical,Variant,Rule"))
+			Expect(lines[1]).
+				To(Equal("Aus albus L.,Aus albus,Aus alba,genderEnding"))
+		})
+
+		It("exits with an error if input cannot be read", func() {
+			c := testcli.Command("gnparser", "variants")
+			long := "Aus bus " + strings.Repeat("a", 70000)
+			c.SetStdin(strings.NewReader("Aus albus L.\n" + long + "\n"))
+			c.Run()
+			Expect(c.Success()).To(BeFalse())
+			Expect(c.Stdout()).To(ContainSubstring("Aus albus L.,Aus albus,"))
+			Expect(c.Stderr()).To(ContainSubstring("token too long"))
+		})
 	})
 })
@@ -2,11 +2,19 @@ package gnparser
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/gnames/gnparser/authorship"
+	"github.com/gnames/gnparser/grammar"
 	"github.com/gnames/gnparser/output"
 	"github.com/gnames/gnparser/pb"
 	"github.com/gnames/gnparser/preprocess"
@@ -23,14 +31,1284 @@ var _ = Describe("grammar", func() {
 	)
 })
 
+var _ = Describe("Tree", func() {
+	It("covers all words of parsed names", func() {
+		tests, err := testData()
+		Expect(err).To(BeNil())
+		gnp := NewGNparser()
+		for _, v := range tests {
+			sn := gnp.Parse(v.NameString)
+			if sn.Name == nil {
+				Expect(sn.Tree()).To(BeNil())
+				continue
+			}
+			var pos []grammar.Pos
+			grammar.Inspect(sn.Tree(), func(n *grammar.TreeNode) bool {
+				// positions of names do not include ex and emend words
+				if n != nil && n.Word != nil &&
+					n.Word.Type != grammar.AuthorWordExType &&
+					n.Word.Type != grammar.AuthorWordEmendType {
+					p := grammar.Pos{Type: n.Word.Type, Start: n.Word.Start,
+						End: n.Word.End}
+					pos = append(pos, p)
+				}
+				return true
+			})
+			sort.Slice(pos, func(i, j int) bool {
+				return pos[i].Start < pos[j].Start
+			})
+			Expect(pos).To(Equal(sn.Pos()), v.NameString)
+		}
+	})
+
+	It("visits nodes of a name", func() {
+		gnp := NewGNparser()
+		sn := gnp.Parse("Aus (Bus) cus var. dus (Smith, 1888) Jones ex Brown")
+		v := &kindsVisitor{}
+		grammar.Walk(v, sn.Tree())
+		Expect(v.kinds[grammar.GenusKind]).To(Equal([]string{"Aus"}))
+		Expect(v.kinds[grammar.SubGenusKind]).To(Equal([]string{"Bus"}))
+		Expect(v.kinds[grammar.SpEpithetKind]).To(Equal([]string{"cus"}))
+		Expect(v.kinds[grammar.RankKind]).To(Equal([]string{"var."}))
+		Expect(v.kinds[grammar.YearKind]).To(Equal([]string{"1888"}))
+		Expect(v.kinds[grammar.AuthorKind]).
+			To(Equal([]string{"Smith", "Jones", "Brown"}))
+		Expect(v.kinds[grammar.ExAuthorsTeamKind]).To(Equal([]string{"Brown"}))
+		Expect(v.kinds[grammar.InfraSpEpithetKind]).
+			To(Equal([]string{"var. dus (Smith 1888) Jones ex Brown"}))
+	})
+
+	It("visits hybrid elements", func() {
+		gnp := NewGNparser()
+		sn := gnp.Parse("Aus bus × Cus dus")
+		v := &kindsVisitor{}
+		grammar.Walk(v, sn.Tree())
+		Expect(v.kinds[grammar.HybridFormulaKind]).To(HaveLen(1))
+		Expect(v.kinds[grammar.HybridCharKind]).To(Equal([]string{"×"}))
+		Expect(v.kinds[grammar.GenusKind]).To(Equal([]string{"Aus", "Cus"}))
+	})
+})
+
+type kindsVisitor struct {
+	kinds map[grammar.NodeKind][]string
+}
+
+func (v *kindsVisitor) Visit(n *grammar.TreeNode) grammar.Visitor {
+	if n == nil {
+		return nil
+	}
+	if v.kinds == nil {
+		v.kinds = make(map[grammar.NodeKind][]string)
+	}
+	v.kinds[n.Kind] = append(v.kinds[n.Kind], n.Value)
+	return v
+}
+
 var _ = Describe("GNparser", func() {
 	DescribeTable("full stack input to output",
-		func(compactRes, compact, simpleRes, simple string) {
+		func(compactRes, compact, simpleRes, simple, outputRes string) {
 			Expect(compactRes).To(Equal(compact))
+			o, err := output.FromJSON([]byte(compact))
+			Expect(err).To(BeNil())
+			roundTrip, err := o.ToJSON(false)
+			Expect(err).To(BeNil())
+			Expect(string(roundTrip)).To(Equal(compact))
 			Expect(simpleRes).To(Equal(simple))
+			Expect(outputRes).To(Equal(simple))
 		}, outputEntries()...,
 	)
 
+	Describe("Parse", func() {
+		It("is safe for concurrent use", func() {
+			names := []string{
+				"Homo sapiens Linnaeus, 1758", "Bubo bubo (Linnaeus, 1758)",
+				"Pomatomus saltatrix", "Abarema clypearia (Jack) Kosterm., p.p.",
+			}
+			gnp := NewGNparser()
+			expected := make([]string, len(names))
+			for i, v := range names {
+				expected[i] = gnp.Parse(v).Verbatim
+			}
+			var wg sync.WaitGroup
+			res := make([][]string, 8)
+			for i := range res {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					for j := 0; j < 50; j++ {
+						for _, v := range names {
+							res[i] = append(res[i], gnp.Parse(v).Verbatim)
+						}
+					}
+				}(i)
+			}
+			wg.Wait()
+			for _, r := range res {
+				for j, v := range r {
+					Expect(v).To(Equal(expected[j%len(names)]))
+				}
+			}
+		})
+
+		It("returns independent results", func() {
+			gnp := NewGNparser()
+			sn1 := gnp.Parse("Homo sapiens")
+			sn2 := gnp.Parse("Bubo bubo")
+			Expect(sn1.Verbatim).To(Equal("Homo sapiens"))
+			Expect(sn2.Verbatim).To(Equal("Bubo bubo"))
+			Expect(gnp.ParsedNameString("Goggia gemmula 1996")).
+				To(Equal("Goggia gemmula"))
+		})
+
+		It("keeps deprecated methods for the last parsed name", func() {
+			gnp := NewGNparser()
+			gnp.Parse("Goggia gemmula 1996")
+			Expect(gnp.ParsedName()).To(Equal("Goggia gemmula"))
+			Expect(gnp.ToSlice()).
+				To(Equal(gnp.ParseName("Goggia gemmula 1996").ToSlice()))
+			bs, err := gnp.ToJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(bs)).To(ContainSubstring(`"verbatim":"Goggia gemmula 1996"`))
+			bs, err = gnp.ToPrettyJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(bs)).To(ContainSubstring(`"verbatim": "Goggia gemmula 1996"`))
+		})
+	})
+
+	Describe("ParseName", func() {
+		It("returns independent outputs", func() {
+			gnp := NewGNparser()
+			o1 := gnp.ParseName("Homo sapiens Linnaeus, 1758")
+			o2 := gnp.ParseName("Bubo bubo")
+			Expect(o1.CanonicalName.Simple).To(Equal("Homo sapiens"))
+			Expect(o1.Authorship).To(Equal("Linnaeus 1758"))
+			Expect(o2.CanonicalName.Simple).To(Equal("Bubo bubo"))
+		})
+
+		It("returns typed details", func() {
+			gnp := NewGNparser()
+			o := gnp.ParseName("Aus bus var. cus Smith")
+			Expect(o.Details.DetailsType()).To(Equal(grammar.SpeciesDetails))
+			sp := o.Details.(*grammar.SpeciesOutput)
+			Expect(sp.Genus.Value).To(Equal("Aus"))
+			Expect(sp.InfraSpecies[0].Rank).To(Equal("var."))
+
+			o = gnp.ParseName("Aus bus × Cus dus")
+			Expect(o.Details.DetailsType()).
+				To(Equal(grammar.HybridFormulaDetails))
+			hf := o.Details.(*grammar.HybridFormulaOutput)
+			Expect(len(hf.Elements)).To(Equal(2))
+
+			bs, _ := o.ToJSON(true)
+			o2, err := output.FromJSON(bs)
+			Expect(err).To(BeNil())
+			Expect(o2.Details).To(Equal(o.Details))
+
+			o = gnp.ParseName("Aus cf. bus")
+			Expect(o.Details.DetailsType()).To(Equal(grammar.ComparisonDetails))
+			o = gnp.ParseName("Aus sp.")
+			Expect(o.Details.DetailsType()).To(Equal(grammar.ApproxDetails))
+			o = gnp.ParseName("Aus")
+			Expect(o.Details.DetailsType()).To(Equal(grammar.UninomialDetails))
+		})
+
+		It("decodes details by their type", func() {
+			gnp := NewGNparser()
+			for _, v := range []string{"Aus cf. bus", "Aus bus sp. nr. cus", "Aus"} {
+				o := gnp.ParseName(v)
+				bs, _ := o.ToJSON(false)
+				Expect(string(bs)).To(ContainSubstring(
+					`"detailsType":"` + o.Details.DetailsType().String() + `"`))
+				o2, err := output.FromJSON(bs)
+				Expect(err).To(BeNil())
+				Expect(o2.Details).To(Equal(o.Details))
+			}
+			_, err := output.FromJSON([]byte(
+				`{"parsed":true,"details":[{"genus":{"value":"Aus"}}]}`))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("decodes details of JSON without detailsType", func() {
+			path := filepath.Join("testdata", "details_baseline.txt")
+			bs, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			lines := strings.Split(strings.TrimSpace(string(bs)), "\n")
+			types := []grammar.DetailsType{
+				grammar.UninomialDetails,
+				grammar.SpeciesDetails,
+				grammar.ComparisonDetails,
+				grammar.ComparisonDetails,
+				grammar.ApproxDetails,
+				grammar.HybridFormulaDetails,
+				grammar.HybridFormulaDetails,
+			}
+			Expect(len(lines)).To(Equal(len(types)))
+			for i, v := range lines {
+				o, err := output.FromJSON([]byte(v))
+				Expect(err).To(BeNil())
+				Expect(o.Details.DetailsType()).To(Equal(types[i]))
+				o2 := NewGNparser().ParseName(o.Verbatim)
+				Expect(o.Details.DetailsType()).
+					To(Equal(o2.Details.DetailsType()))
+			}
+			o, _ := output.FromJSON([]byte(lines[6]))
+			hf := o.Details.(*grammar.HybridFormulaOutput)
+			Expect(len(hf.Elements)).To(Equal(3))
+			sp := hf.Elements[2].(*grammar.SpeciesOutput)
+			Expect(sp.SpecEpithet.Value).To(Equal("tigrinum"))
+		})
+
+		It("formats outputs later", func() {
+			o := NewGNparser().ParseName("Homo sapiens Linnaeus, 1758")
+			for _, f := range []string{"compact", "pretty", "csv"} {
+				gnp := NewGNparser(OptFormat(f))
+				res, err := gnp.FormatOutput(o)
+				Expect(err).To(BeNil())
+				expected, _ := gnp.ParseAndFormat("Homo sapiens Linnaeus, 1758")
+				Expect(res).To(Equal(expected))
+			}
+			res, _ := NewGNparser(OptFormat("csv")).FormatOutput(o)
+			Expect(res).To(HaveSuffix(",Linnaeus 1758,1758,1,zoological,,"))
+		})
+	})
+
+	Describe("ParseNames", func() {
+		It("keeps order of the input", func() {
+			names := []string{"Homo sapiens", "Pomatomus", "Bubo bubo", "Homo"}
+			gnp := NewGNparser(OptWorkersNum(3))
+			res := gnp.ParseNames(names)
+			Expect(len(res)).To(Equal(len(names)))
+			for i, v := range names {
+				Expect(res[i].Verbatim).To(Equal(v))
+			}
+		})
+	})
+
+	Describe("OptCode", func() {
+		It("treats words in parentheses according to the code", func() {
+			o := NewGNparser(OptCode("zoological")).ParseName("Aus (Bus) cus")
+			sp := o.Details.(*grammar.SpeciesOutput)
+			Expect(sp.SubGenus.Value).To(Equal("Bus"))
+			Expect(o.Code).To(Equal("zoological"))
+
+			o = NewGNparser(OptCode("botanical")).ParseName("Aus (Bus) cus")
+			sp = o.Details.(*grammar.SpeciesOutput)
+			Expect(sp.SubGenus).To(BeNil())
+			Expect(o.Normalized).To(Equal("Aus cus"))
+			Expect(o.Code).To(Equal("botanical"))
+		})
+
+		It("keeps 'f.' as filius before ranks with botanical code", func() {
+			gnp := NewGNparser(OptCode("botanical"))
+			o := gnp.ParseName("Aus bus L. f. var. cus")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.CanonicalName.Full).To(Equal("Aus bus var. cus"))
+			Expect(o.Normalized).To(Equal("Aus bus L. fil. var. cus"))
+
+			o = gnp.ParseName("Aus bus L. f. subsp. cus")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.CanonicalName.Full).To(Equal("Aus bus subsp. cus"))
+
+			o = gnp.ParseName("Aus bus Thunb. f. ssp. cus")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.CanonicalName.Full).To(Equal("Aus bus subsp. cus"))
+		})
+
+		It("treats 'f.' according to the code", func() {
+			o := NewGNparser(OptCode("botanical")).ParseName("Aus bus L. f. cus")
+			Expect(o.CanonicalName.Full).To(Equal("Aus bus f. cus"))
+			Expect(o.Quality).To(Equal(1))
+
+			o = NewGNparser(OptCode("zoological")).ParseName("Aus bus L. f. cus")
+			Expect(o.CanonicalName.Full).To(Equal("Aus bus cus"))
+			Expect(o.Normalized).To(Equal("Aus bus L. fil. cus"))
+			Expect(o.Quality).To(Equal(1))
+
+			o = NewGNparser().ParseName("Aus bus L. f. cus")
+			Expect(o.Quality).To(Equal(2))
+			Expect(o.Code).To(Equal(""))
+		})
+
+		It("treats years in parentheses according to the code", func() {
+			name := "Aus bus Smith (1888)"
+			o := NewGNparser().ParseName(name)
+			Expect(o.Positions[3].Type).To(Equal("approximateYear"))
+			o = NewGNparser(OptCode("botanical")).ParseName(name)
+			Expect(o.Positions[3].Type).To(Equal("year"))
+			Expect(o.Quality).To(Equal(1))
+		})
+
+		It("sets bacteria and virus flags according to the code", func() {
+			o := NewGNparser(OptCode("bacterial")).ParseName("Aus bus")
+			Expect(o.Bacteria).To(BeTrue())
+			o = NewGNparser(OptCode("zoological")).ParseName("Escherichia coli")
+			Expect(o.Bacteria).To(BeFalse())
+			o = NewGNparser(OptCode("virus")).ParseName("Aus bus")
+			Expect(o.Virus).To(BeTrue())
+			Expect(o.Parsed).To(BeFalse())
+		})
+
+		It("uses any code for unknown codes", func() {
+			gnp := NewGNparser(OptCode("unknown"))
+			Expect(gnp.Code()).To(Equal("any"))
+			po := NewGNparser(OptCode("bacterial")).ParseToObject("Aus bus")
+			Expect(po.Code).To(Equal("bacterial"))
+		})
+
+		It("preprocesses names for Debug according to the code", func() {
+			res := NewGNparser(OptCode("virus")).Debug("Aus bus")
+			Expect(string(res)).To(ContainSubstring("NO PARSE"))
+			res = NewGNparser().Debug("Aus bus")
+			Expect(string(res)).NotTo(ContainSubstring("NO PARSE"))
+			res = NewGNparser(OptCode("cultivated")).Debug("Rosa 'Peace'")
+			Expect(string(res)).To(ContainSubstring("Cultivar"))
+		})
+	})
+
+	Describe("Cultivars", func() {
+		DescribeTable("parses cultivated plants with cultivated code",
+			func(name, full, simple string, cultivars []string) {
+				gnp := NewGNparser(OptCode("cultivated"))
+				o := gnp.ParseName(name)
+				Expect(o.Quality).To(Equal(1))
+				Expect(o.CanonicalName.Full).To(Equal(full))
+				Expect(o.CanonicalName.Simple).To(Equal(simple))
+				Expect(o.CanonicalName.IncludesCultivars).To(BeTrue())
+				Expect(o.Details.DetailsType()).To(Equal(grammar.CultivarDetails))
+				co := o.Details.(*grammar.CultivarOutput)
+				var res []string
+				for _, v := range co.Cultivars {
+					res = append(res, v.Type+":"+v.Value)
+				}
+				Expect(res).To(Equal(cultivars))
+
+				bs, _ := o.ToJSON(false)
+				o2, err := output.FromJSON(bs)
+				Expect(err).To(BeNil())
+				Expect(o2.Details).To(Equal(o.Details))
+			},
+			Entry("quoted cultivar", "Rosa 'Peace'", "Rosa 'Peace'", "Rosa",
+				[]string{"cultivar:Peace"}),
+			Entry("cv.", "Acer palmatum cv. Atropurpureum",
+				"Acer palmatum 'Atropurpureum'", "Acer palmatum",
+				[]string{"cultivar:Atropurpureum"}),
+			Entry("authorship", "Acer palmatum Thunb. ‘Bloodgood’",
+				"Acer palmatum 'Bloodgood'", "Acer palmatum",
+				[]string{"cultivar:Bloodgood"}),
+			Entry("group", "Hosta Tardiana Group", "Hosta Tardiana Group",
+				"Hosta", []string{"cultivarGroup:Tardiana"}),
+			Entry("group and cultivar", "Hosta (Tardiana Group) 'Halcyon'",
+				"Hosta Tardiana Group 'Halcyon'", "Hosta",
+				[]string{"cultivarGroup:Tardiana", "cultivar:Halcyon"}),
+			Entry("grex", "Cymbidium Alexanderi grex",
+				"Cymbidium Alexanderi grex", "Cymbidium",
+				[]string{"grex:Alexanderi"}),
+			Entry("quoted group", "Aus 'x' Group", "Aus x Group", "Aus",
+				[]string{"cultivarGroup:x"}),
+			Entry("group in parentheses", "Aus bus (Foo Group)",
+				"Aus bus Foo Group", "Aus bus", []string{"cultivarGroup:Foo"}),
+			Entry("group after cultivar", "Aus bus 'Foo' (Bar Group)",
+				"Aus bus 'Foo' Bar Group", "Aus bus",
+				[]string{"cultivar:Foo", "cultivarGroup:Bar"}),
+			Entry("quoted grex", "Aus bus 'Foo' gx", "Aus bus Foo grex",
+				"Aus bus", []string{"grex:Foo"}),
+			Entry("gx", "Orchis Foo gx", "Orchis Foo grex", "Orchis",
+				[]string{"grex:Foo"}),
+		)
+
+		DescribeTable("does not take groups and grexes for authors",
+			func(name, tail string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Authorship).To(Equal(""))
+				Expect(o.Tail).To(Equal(tail))
+				Expect(o.Quality).To(Equal(3))
+			},
+			Entry("group", "Hosta Tardiana Group", " Tardiana Group"),
+			Entry("group in parentheses", "Aus bus (Foo Group)",
+				" (Foo Group)"),
+			Entry("grex", "Orchis Foo gx", " Foo gx"),
+		)
+
+		It("keeps previous output without cultivated code", func() {
+			o := NewGNparser().ParseName("Rosa 'Peace'")
+			Expect(o.Tail).To(Equal(" 'Peace'"))
+			Expect(o.CanonicalName.IncludesCultivars).To(BeFalse())
+			o = NewGNparser(OptCode("botanical")).
+				ParseName("Acer palmatum cv. Atropurpureum")
+			Expect(o.CanonicalName.Full).To(Equal("Acer palmatum"))
+		})
+
+		It("has cultivars in positions, tree and protobuf", func() {
+			gnp := NewGNparser(OptCode("cultivated"))
+			o := gnp.ParseName("Acer palmatum 'Bloodgood'")
+			Expect(o.Positions[2].Type).To(Equal("cultivar"))
+			Expect(o.Authorship).To(Equal(""))
+
+			sn := gnp.Parse("Hosta Tardiana Group 'Halcyon'")
+			v := &kindsVisitor{}
+			grammar.Walk(v, sn.Tree())
+			Expect(v.kinds[grammar.CultivarKind]).
+				To(Equal([]string{"Tardiana Group", "'Halcyon'"}))
+
+			po := gnp.ParseToObject("Acer palmatum L. 'Bloodgood'")
+			Expect(po.Canonical.IncludesCultivars).To(BeTrue())
+			Expect(po.Cultivars[0].Value).To(Equal("Bloodgood"))
+			Expect(po.Details.(*pb.Parsed_Species).Species.Genus).To(Equal("Acer"))
+			Expect(po.Authorship.Value).To(Equal("L."))
+		})
+
+		It("does not take comparisons as names of cultivars", func() {
+			gnp := NewGNparser(OptCode("cultivated"))
+			name := "Aus cf. bus Smith 'X'"
+			o := gnp.ParseName(name)
+			Expect(o.Details.DetailsType()).To(Equal(grammar.ComparisonDetails))
+			Expect(o.ToSlice()[6]).To(Equal("Smith"))
+			Expect(gnp.Compare(name, name).Score).To(Equal(1.0))
+			po := gnp.ParseToObject(name)
+			Expect(po.Cultivars).To(BeEmpty())
+		})
+	})
+
+	Describe("NomenclaturalCode", func() {
+		DescribeTable("infers code from the parsed name",
+			func(name, code string, evidence []string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.NomenclaturalCode.Code).To(Equal(code))
+				Expect(o.NomenclaturalCode.Evidence).To(Equal(evidence))
+			},
+			Entry("comma year", "Homo sapiens Linnaeus, 1758", "zoological",
+				[]string{"year after comma"}),
+			Entry("combination authors", "Aus bus (L.) Smith", "botanical",
+				[]string{"basionym and combination authors"}),
+			Entry("ex authors", "Aus bus Smith ex Jones", "any",
+				[]string{"ex authors"}),
+			Entry("emend authors", "Aus bus Smith emend. Jones", "any",
+				[]string{"emend authors"}),
+			Entry("zoological ex authors", "Aus bus Smith ex Jones, 1888",
+				"zoological", []string{"ex authors", "year after comma"}),
+			Entry("botanical emend authors", "Aus bus (L.) Smith emend. Jones",
+				"botanical",
+				[]string{"emend authors", "basionym and combination authors"}),
+			Entry("bacterial ex authors",
+				"Escherichia coli Migula ex Castellani", "bacterial",
+				[]string{"ex authors", "bacterial genus"}),
+			Entry("sanctioning authors", "Boletus edulis Bull. : Fr.", "botanical",
+				[]string{"sanctioning authors"}),
+			Entry("zoological rank", "Aus bus ab. cus", "zoological",
+				[]string{"zoological rank"}),
+			Entry("bacterial genus",
+				"Escherichia coli (Migula 1895) Castellani & Chalmers 1919",
+				"bacterial",
+				[]string{"basionym and combination authors", "bacterial genus"}),
+			Entry("conflicting evidence", "Aus bus (Smith) Jones, 1888", "any",
+				[]string{"basionym and combination authors", "year after comma"}),
+		)
+
+		It("has confidence", func() {
+			gnp := NewGNparser()
+			o := gnp.ParseName("Escherichia coli (Migula) Castellani")
+			Expect(o.NomenclaturalCode.Confidence).To(Equal(0.67))
+			o = gnp.ParseName("Aus bus (Smith) Jones, 1888")
+			Expect(o.NomenclaturalCode.Confidence).To(Equal(0.0))
+			o = gnp.ParseName("Aus bus Smith ex Jones, 1888")
+			Expect(o.NomenclaturalCode.Confidence).To(Equal(1.0))
+			o = gnp.ParseName("Aus bus Smith ex Jones")
+			Expect(o.NomenclaturalCode.Confidence).To(Equal(0.0))
+			o = gnp.ParseName("Aus bus")
+			Expect(o.NomenclaturalCode).To(BeNil())
+			o = NewGNparser(OptCode("botanical")).ParseName("Aus bus")
+			Expect(o.NomenclaturalCode.Code).To(Equal("botanical"))
+			Expect(o.NomenclaturalCode.Confidence).To(Equal(1.0))
+		})
+
+		It("is in CSV and protobuf outputs", func() {
+			gnp := NewGNparser()
+			o := gnp.ParseName("Aus bus (L.) Smith")
+			Expect(o.ToSlice()[9]).To(Equal("botanical"))
+			po := gnp.ParseToObject("Aus bus (L.) Smith")
+			Expect(po.NomenclaturalCode.Code).To(Equal("botanical"))
+			Expect(po.NomenclaturalCode.Evidence).
+				To(Equal([]string{"basionym and combination authors"}))
+		})
+	})
+
+	Describe("Annotations", func() {
+		It("returns nomenclatural annotations instead of a tail", func() {
+			gnp := NewGNparser()
+			o := gnp.ParseName("Abutilon avicennae Gaertn., nom. illeg.")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Authorship).To(Equal("Gaertn."))
+			Expect(len(o.Annotations)).To(Equal(1))
+			a := o.Annotations[0]
+			Expect(a.Type).To(Equal("status"))
+			Expect(a.Value).To(Equal("nom. illeg."))
+			Expect(a.Verbatim).To(Equal("nom. illeg."))
+			Expect(o.Verbatim[a.Start:a.End]).To(Equal("nom. illeg."))
+
+			o = gnp.ParseName("Aus bus (L.) Smith comb. nov. foo")
+			Expect(o.Annotations).To(BeNil())
+			Expect(o.Quality).To(Equal(3))
+		})
+
+		It("joins annotations the same way in both CSV outputs", func() {
+			name := "Aus bus Smith, nom. illeg., comb. nov."
+			gnp := NewGNparser(OptFormat("csv"))
+			o := gnp.ParseName(name)
+			Expect(o.ToSlice()[10]).To(Equal("nom. illeg.; comb. nov."))
+			res, _ := gnp.ParseAndFormat(name)
+			Expect(res).To(Equal(output.ToCSV(o.ToSlice())))
+		})
+
+		It("finds annotations followed by a taxon concept or a year", func() {
+			gnp := NewGNparser()
+			o := gnp.ParseName("Aus bus Smith nom. nov. sensu Jones")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Authorship).To(Equal("Smith"))
+			Expect(len(o.Annotations)).To(Equal(1))
+			a := o.Annotations[0]
+			Expect(a.Value).To(Equal("nom. nov."))
+			Expect(o.Verbatim[a.Start:a.End]).To(Equal("nom. nov."))
+			tc := o.TaxonConcept
+			Expect(tc.Type).To(Equal("sensu"))
+			Expect(o.Verbatim[tc.Start:tc.End]).To(Equal("sensu Jones"))
+			for _, v := range o.Positions {
+				Expect(v.End <= a.Start || v.Start >= a.End).To(BeTrue())
+			}
+
+			o = gnp.ParseName("Aus bus Smith nom. nud. 1888")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Authorship).To(Equal("Smith 1888"))
+			Expect(len(o.Annotations)).To(Equal(1))
+			a = o.Annotations[0]
+			Expect(a.Value).To(Equal("nom. nud."))
+			Expect(o.Verbatim[a.Start:a.End]).To(Equal("nom. nud."))
+			pos := o.Positions[len(o.Positions)-1]
+			Expect(pos.Type).To(Equal("year"))
+			Expect(o.Verbatim[pos.Start:pos.End]).To(Equal("1888"))
+		})
+
+		It("is in JSON, CSV and protobuf outputs", func() {
+			gnp := NewGNparser()
+			name := "Akeratidae Nomen Nudum, sp. n."
+			o := gnp.ParseName(name)
+			bs, _ := o.ToJSON(false)
+			o2, err := output.FromJSON(bs)
+			Expect(err).To(BeNil())
+			Expect(o2.Annotations).To(Equal(o.Annotations))
+			Expect(o.ToSlice()[10]).To(Equal("nom. nud.; sp. nov."))
+			po := gnp.ParseToObject(name)
+			Expect(po.Annotations[1].Type).To(Equal("act"))
+			Expect(po.Annotations[1].Value).To(Equal("sp. nov."))
+			Expect(po.Annotations[1].Start).To(Equal(int32(24)))
+		})
+	})
+
+	Describe("TaxonConcept", func() {
+		DescribeTable("parses taxon concept qualifiers",
+			func(name, tp, value, authorship string, excluded []string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Quality).To(Equal(1))
+				Expect(o.Tail).To(Equal(""))
+				tc := o.TaxonConcept
+				Expect(tc.Type).To(Equal(tp))
+				Expect(tc.Value).To(Equal(value))
+				if authorship == "" {
+					Expect(tc.Authorship).To(BeNil())
+				} else {
+					Expect(tc.Authorship.Value).To(Equal(authorship))
+				}
+				var res []string
+				for _, v := range tc.ExcludedAuthorship {
+					res = append(res, v.Value)
+				}
+				Expect(res).To(Equal(excluded))
+				end := len(o.Verbatim)
+				if o.Verbatim[end-1] == ')' {
+					end--
+				}
+				Expect(tc.End).To(Equal(end))
+			},
+			Entry("sensu", "Velutina haliotoides (Linnaeus, 1758), sensu Fabricius, 1780",
+				"sensu", "sensu Fabricius 1780", "Fabricius 1780", nil),
+			Entry("sec.", "Abramis Cuvier 1816 sec. Dybowski 1862",
+				"sec", "sec. Dybowski 1862", "Dybowski 1862", nil),
+			Entry("auct.", "Puya acris Auct non L.", "auct", "auct. non L.", "",
+				[]string{"L."}),
+			Entry("sensu auct.", "Abarema scutifera sensu auct., non (Blanco)Kosterm.",
+				"auct", "auct. non (Blanco) Kosterm.", "",
+				[]string{"(Blanco) Kosterm."}),
+			Entry("s. l.", "Asplenium anisophyllum Kunze, s.l.", "sensuLato",
+				"s. l.", "", nil),
+			Entry("sensu lato", "Aus bus L. sensu lato", "sensuLato", "s. l.", "",
+				nil),
+			Entry("s. str.", "Amaurorhinus bewichianus (Wollaston,1860) (s.str.)",
+				"sensuStricto", "s. str.", "", nil),
+			Entry("pro parte", "Galium tricorne Stokes,pro parte", "proParte",
+				"p. p.", "", nil),
+			Entry("sensu in parentheses", "Aus bus (sensu Smith)", "sensu",
+				"sensu Smith", "Smith", nil),
+			Entry("sensu with year in parentheses",
+				"Aus bus L. (sensu Smith 1990)", "sensu", "sensu Smith 1990",
+				"Smith 1990", nil),
+			Entry("auct. non in parentheses", "Aus bus Smith (auct. non L.)",
+				"auct", "auct. non L.", "", []string{"L."}),
+			Entry("sensu non in parentheses", "Aus bus (sensu Smith non Jones)",
+				"sensu", "sensu Smith non Jones", "Smith", []string{"Jones"}),
+		)
+
+		It("keeps name and authorship without qualifier", func() {
+			o := NewGNparser().ParseName("Aus bus L. sensu Smith 1990")
+			Expect(o.Normalized).To(Equal("Aus bus L."))
+			Expect(o.Authorship).To(Equal("L."))
+			Expect(o.CanonicalName.Full).To(Equal("Aus bus"))
+			Expect(o.Positions[3].Type).To(Equal("taxonConcept"))
+			Expect(o.Positions[4].Type).To(Equal("authorWord"))
+			Expect(o.Positions[5].Type).To(Equal("year"))
+			tc := o.TaxonConcept
+			Expect(o.Verbatim[tc.Start:tc.End]).To(Equal("sensu Smith 1990"))
+
+			o = NewGNparser().ParseName("Lachenalia tricolor var. nelsonii (auct.) Baker")
+			Expect(o.TaxonConcept).To(BeNil())
+		})
+
+		DescribeTable("gives the same span in details and positions",
+			func(name string, start, end int) {
+				o := NewGNparser().ParseName(name)
+				tc := o.TaxonConcept
+				Expect(tc.Start).To(Equal(start))
+				Expect(tc.End).To(Equal(end))
+				i := 0
+				for o.Positions[i].Type != "taxonConcept" {
+					i++
+				}
+				Expect(o.Positions[i].Start).To(Equal(tc.Start))
+				conceptTypes := map[string]bool{"taxonConcept": true,
+					"exclusion": true, "authorWord": true, "year": true}
+				for i < len(o.Positions)-1 && conceptTypes[o.Positions[i+1].Type] {
+					i++
+				}
+				Expect(o.Positions[i].End).To(Equal(tc.End))
+			},
+			Entry("s. l. in parentheses", "Aus bus (s.l.)", 9, 13),
+			Entry("s. str. between epithets",
+				"Ammodramus caudacutus (s.s.) diversus", 23, 27),
+			Entry("sensu with authors", "Aus bus sensu Smith non Jones", 8, 29),
+			Entry("sensu in parentheses", "Aus bus L. (sensu Smith 1990)", 12, 28),
+			Entry("auct. in parentheses", "Aus bus Smith (auct. non L.)", 15, 27),
+		)
+
+		DescribeTable("parses infraspecific epithets after s. l. or s. str.",
+			func(name, canonical, tp string, posType string, start, end int) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Quality).To(Equal(1))
+				Expect(o.Tail).To(Equal(""))
+				Expect(o.Cardinality).To(Equal(3))
+				Expect(o.CanonicalName.Full).To(Equal(canonical))
+				Expect(o.TaxonConcept.Type).To(Equal(tp))
+				Expect(o.Positions[3].Type).To(Equal(posType))
+				Expect(o.Positions[3].Start).To(Equal(start))
+				Expect(o.Positions[3].End).To(Equal(end))
+			},
+			Entry("s. str.", "Ammodramus caudacutus (s.s.) diversus",
+				"Ammodramus caudacutus diversus", "sensuStricto",
+				"infraspecificEpithet", 29, 37),
+			Entry("s. l.", "Aus bus (s. l.) var. cus", "Aus bus var. cus",
+				"sensuLato", "rank", 16, 20),
+		)
+
+		It("gives exclusion type to 'non' and 'nec' in a taxon concept", func() {
+			o := NewGNparser().ParseName("Aus bus sensu Smith non Jones")
+			Expect(o.Positions[4].Type).To(Equal("exclusion"))
+			Expect(o.Positions[4].Start).To(Equal(20))
+			Expect(o.Positions[4].End).To(Equal(23))
+			o = NewGNparser().ParseName("Aus bus Smith nec Jones")
+			Expect(o.Positions[3].Type).To(Equal("exclusion"))
+		})
+
+		DescribeTable("keeps initials 'P. P.' in authorship",
+			func(name, authorship string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Quality).To(Equal(1))
+				Expect(o.Authorship).To(Equal(authorship))
+				Expect(o.TaxonConcept).To(BeNil())
+			},
+			Entry("initials", "Aus bus P. P. Smith", "P. P. Smith"),
+			Entry("initials without space", "Aus bus P.P. Smith", "P. P. Smith"),
+			Entry("three initials", "Aus bus A. P. P. Smith", "A. P. P. Smith"),
+			Entry("second author", "Aus bus Smith & P. P. Jones",
+				"Smith & P. P. Jones"),
+			Entry("basionym author", "Aus bus (P. P. Smith) Jones",
+				"(P. P. Smith) Jones"),
+		)
+
+		It("finds pro parte at the end of a name", func() {
+			o := NewGNparser().ParseName("Aus bus Smith, P. P.")
+			Expect(o.Authorship).To(Equal("Smith"))
+			Expect(o.TaxonConcept.Type).To(Equal("proParte"))
+		})
+
+		It("is in tree, JSON, CSV and protobuf outputs", func() {
+			gnp := NewGNparser()
+			name := "Senecio legionensis sensu Samp., non Lange"
+			sn := gnp.Parse(name)
+			v := &kindsVisitor{}
+			grammar.Walk(v, sn.Tree())
+			Expect(v.kinds[grammar.TaxonConceptKind]).
+				To(Equal([]string{"sensu Samp. non Lange"}))
+			Expect(v.kinds[grammar.QualifierKind]).
+				To(Equal([]string{"sensu", "non"}))
+
+			o := gnp.ParseName(name)
+			bs, _ := o.ToJSON(false)
+			o2, err := output.FromJSON(bs)
+			Expect(err).To(BeNil())
+			Expect(o2.TaxonConcept).To(Equal(o.TaxonConcept))
+			Expect(o.ToSlice()[11]).To(Equal("sensu Samp. non Lange"))
+
+			po := gnp.ParseToObject(name)
+			Expect(po.TaxonConcept.Type).To(Equal("sensu"))
+			Expect(po.TaxonConcept.Authorship.Value).To(Equal("Samp."))
+			Expect(po.TaxonConcept.ExcludedAuthorship[0].Value).To(Equal("Lange"))
+		})
+	})
+
+	Describe("AuthorDetails", func() {
+		DescribeTable("parses structured names of authors",
+			func(name string, ao grammar.AuthorOutput) {
+				o := NewGNparser().ParseName(name)
+				sp := o.Details.(*grammar.SpeciesOutput)
+				au := sp.SpecEpithet.Authorship.Original
+				Expect(*au.AuthorDetails[0]).To(Equal(ao))
+			},
+			Entry("filius", "Aus bus L. f.", grammar.AuthorOutput{
+				Value: "L. fil.", Surname: "Linnaeus", Filius: true,
+				Expanded: "Carl Linnaeus the Younger", Key: "linnaeus f",
+			}),
+			Entry("prefix", "Aus bus van der Hoeven", grammar.AuthorOutput{
+				Value: "van der Hoeven", Surname: "Hoeven", Prefix: "van der",
+				Key: "van der hoeven",
+			}),
+			Entry("initials", "Aus bus A.P.de Candolle", grammar.AuthorOutput{
+				Value: "A. P. de Candolle", Surname: "Candolle",
+				Initials: "A. P.", Prefix: "de", Key: "de candolle",
+			}),
+			Entry("abbreviation", "Aus bus DC.", grammar.AuthorOutput{
+				Value: "DC.", Surname: "Candolle", Prefix: "de",
+				Expanded: "Augustin Pyramus de Candolle", Key: "de candolle",
+			}),
+			Entry("abbreviation with filius", "Aus bus Hook.f.",
+				grammar.AuthorOutput{
+					Value: "Hook. fil.", Surname: "Hooker", Filius: true,
+					Expanded: "Joseph Dalton Hooker", Key: "hooker f",
+				}),
+			Entry("et al.", "Aus bus Smith et al.", grammar.AuthorOutput{
+				Value: "Smith et al.", Surname: "Smith", EtAl: true, Key: "smith",
+			}),
+			Entry("unknown", "Aus bus anon.", grammar.AuthorOutput{
+				Value: "anon.", Unknown: true,
+			}),
+		)
+
+		It("keeps details of every group of authors", func() {
+			gnp := NewGNparser()
+			name := "Aus bus (Ch. Darwin) Th. Huxley ex DC."
+			o := gnp.ParseName(name)
+			sp := o.Details.(*grammar.SpeciesOutput)
+			au := sp.SpecEpithet.Authorship
+			Expect(au.Original.AuthorDetails[0].Initials).To(Equal("Ch."))
+			Expect(au.Combination.AuthorDetails[0].Surname).To(Equal("Huxley"))
+			Expect(au.Combination.ExAuthors.AuthorDetails[0].Surname).
+				To(Equal("Candolle"))
+
+			po := gnp.ParseToObject(name)
+			pa := po.Details.(*pb.Parsed_Species).Species.SpeciesAuthorship
+			Expect(pa.Original.AuthorDetails[0].Surname).To(Equal("Darwin"))
+			Expect(pa.Combination.ExAuthors.AuthorDetails[0].Value).
+				To(Equal("DC."))
+		})
+	})
+
+	Describe("OptExpandAuthors", func() {
+		It("gives full names of authors", func() {
+			name := "Aus bus (L.) Hook. f."
+			o := NewGNparser().ParseName(name)
+			Expect(o.ExpandedAuthorship).To(Equal(""))
+			sp := o.Details.(*grammar.SpeciesOutput)
+			au := sp.SpecEpithet.Authorship
+			Expect(au.Original.AuthorDetails[0].Expanded).
+				To(Equal("Carl Linnaeus"))
+			Expect(au.Combination.AuthorDetails[0].Key).To(Equal("hooker f"))
+
+			gnp := NewGNparser(OptExpandAuthors(true))
+			o = gnp.ParseName(name)
+			Expect(o.ExpandedAuthorship).
+				To(Equal("(Carl Linnaeus) Joseph Dalton Hooker"))
+			Expect(gnp.ParseToObject(name).ExpandedAuthorship).
+				To(Equal("(Carl Linnaeus) Joseph Dalton Hooker"))
+		})
+
+		It("has the same key for abbreviated and full names", func() {
+			gnp := NewGNparser()
+			keys := make([]string, 2)
+			for i, v := range []string{"Aus bus Mill.", "Aus bus Miller"} {
+				o := gnp.ParseName(v)
+				sp := o.Details.(*grammar.SpeciesOutput)
+				keys[i] = sp.SpecEpithet.Authorship.Original.AuthorDetails[0].Key
+			}
+			Expect(keys).To(Equal([]string{"miller", "miller"}))
+		})
+
+		It("does not expand bare surnames", func() {
+			o := NewGNparser(OptExpandAuthors(true)).ParseName("Aus bus Baker")
+			Expect(o.ExpandedAuthorship).To(Equal("Baker"))
+			sp := o.Details.(*grammar.SpeciesOutput)
+			au := sp.SpecEpithet.Authorship.Original.AuthorDetails[0]
+			Expect(au.Expanded).To(Equal(""))
+			Expect(au.Key).To(Equal("baker"))
+		})
+	})
+
+	Describe("OptAuthorAbbrFiles", func() {
+		It("adds abbreviations from user files", func() {
+			path := filepath.Join(os.TempDir(), "gnparser_authors_abbr.txt")
+			data := "# my authors\nSmi.\tJohn Smith\nMill.\tPhilip Miller Jr.\tMiller\n"
+			err := ioutil.WriteFile(path, []byte(data), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(path)
+
+			gnp := NewGNparser(OptAuthorAbbrFiles([]string{path}),
+				OptExpandAuthors(true))
+			o := gnp.ParseName("Aus bus (Smi.) Mill.")
+			Expect(o.ExpandedAuthorship).
+				To(Equal("(John Smith) Philip Miller Jr."))
+			sp := o.Details.(*grammar.SpeciesOutput)
+			Expect(sp.SpecEpithet.Authorship.Original.AuthorDetails[0].Key).
+				To(Equal("smith"))
+
+			o = NewGNparser(OptExpandAuthors(true)).ParseName("Aus bus Mill.")
+			Expect(o.ExpandedAuthorship).To(Equal("Philip Miller"))
+		})
+	})
+
+	Describe("LoadAuthorAbbrFiles", func() {
+		It("loads abbreviations from user files", func() {
+			path := filepath.Join(os.TempDir(), "gnparser_authors_abbr.txt")
+			err := ioutil.WriteFile(path, []byte("Smi.\tJohn Smith\n"), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(path)
+
+			abbr, err := LoadAuthorAbbrFiles([]string{path})
+			Expect(err).To(BeNil())
+			gnp := NewGNparser(OptAuthorAbbr(abbr), OptExpandAuthors(true))
+			o := gnp.ParseName("Aus bus (Smi.) Mill.")
+			Expect(o.ExpandedAuthorship).To(Equal("(John Smith) Philip Miller"))
+		})
+
+		It("returns an error for missing or malformed files", func() {
+			_, err := LoadAuthorAbbrFiles([]string{"/no/such/file.txt"})
+			Expect(err).To(HaveOccurred())
+
+			path := filepath.Join(os.TempDir(), "gnparser_authors_abbr.txt")
+			err = ioutil.WriteFile(path, []byte("Smi.\n"), 0644)
+			Expect(err).To(BeNil())
+			defer os.Remove(path)
+			_, err = LoadAuthorAbbrFiles([]string{path})
+			Expect(err).To(MatchError(ContainSubstring("line 1")))
+		})
+	})
+
+	Describe("OptInferRank", func() {
+		DescribeTable("infers ranks of uninomials from suffixes",
+			func(name, code, rank, rankCode string) {
+				gnp := NewGNparser(OptInferRank(true), OptCode(code))
+				o := gnp.ParseName(name)
+				u := o.Details.(*grammar.UninomialOutput).Uninomial
+				if rank == "" {
+					Expect(u.InferredRank).To(BeNil())
+					return
+				}
+				Expect(u.InferredRank.Rank).To(Equal(rank))
+				Expect(u.InferredRank.Code).To(Equal(rankCode))
+				Expect(u.InferredRank.Confidence).To(BeNumerically(">", 0))
+			},
+			Entry("zoological family", "Felidae", "", "family", "zoological"),
+			Entry("botanical family", "Rosaceae L.", "", "family", "botanical"),
+			Entry("subclass with botanical code", "Magnoliidae", "botanical",
+				"subclass", "botanical"),
+			Entry("division", "Pinophyta", "", "division", "botanical"),
+			Entry("superfamily", "Apoidea", "", "superfamily", "zoological"),
+			Entry("subtribe with botanical code", "Carabinae", "botanical",
+				"subtribe", "botanical"),
+			Entry("genus", "Homo", "", "", ""),
+			Entry("botanical family with zoological code", "Rosaceae",
+				"zoological", "", ""),
+			Entry("order with zoological code", "Poales", "zoological", "", ""),
+			Entry("tribe with bacterial code", "Oryzeae", "bacterial", "", ""),
+			Entry("family with bacterial code", "Enterobacteriaceae",
+				"bacterial", "family", "bacterial"),
+		)
+
+		It("does not infer ranks by default", func() {
+			o := NewGNparser().ParseName("Felidae")
+			u := o.Details.(*grammar.UninomialOutput).Uninomial
+			Expect(u.InferredRank).To(BeNil())
+			bs, _ := o.ToJSON(false)
+			Expect(string(bs)).NotTo(ContainSubstring("inferredRank"))
+		})
+
+		It("adds inferred ranks to protobuf output", func() {
+			gnp := NewGNparser(OptInferRank(true))
+			po := gnp.ParseToObject("Felidae")
+			ir := po.GetUninomial().InferredRank
+			Expect(ir.Rank).To(Equal("family"))
+			Expect(ir.Code).To(Equal("zoological"))
+		})
+	})
+
+	Describe("NormalizedRank", func() {
+		DescribeTable("normalizes rank markers",
+			func(marker, rank string, level int) {
+				Expect(grammar.NormalizeRank(marker)).To(Equal(rank))
+				Expect(grammar.RankLevel(rank)).To(Equal(level))
+			},
+			Entry("ssp", "ssp", "subspecies", 110),
+			Entry("subspec", "subspec.", "subspecies", 110),
+			Entry("var in brackets", "[var.]", "variety", 120),
+			Entry("greek letter", "β", "variety", 120),
+			Entry("fma", "fma", "form", 130),
+			Entry("fo", "fo.", "form", 130),
+			Entry("subvar", "subvar.", "subvariety", 125),
+			Entry("ab. n.", "ab. n.", "aberration", 140),
+			Entry("notho", "nothosubsp.", "nothosubspecies", 110),
+			Entry("agamo", "agamovar.", "agamovariety", 120),
+			Entry("subgenus", "subg.", "subgenus", 80),
+			Entry("proles", "prol.", "proles", 112),
+			Entry("lusus", "lusus", "lusus", 137),
+			Entry("monstrosity", "monstr.", "monstrosity", 139),
+			Entry("serovar", "serovar", "serovar", 120),
+			Entry("cohort", "cohors", "cohort", 38),
+			Entry("infraorder", "infraord.", "infraorder", 47),
+			Entry("asterisk", "*", "infraspecies", 0),
+			Entry("unknown", "cv.", "", 0),
+		)
+
+		It("adds normalized ranks and levels to details", func() {
+			gnp := NewGNparser()
+			o := gnp.ParseName("Aus bus ssp. cus fma dus")
+			sp := o.Details.(*grammar.SpeciesOutput)
+			var levels []int
+			for _, v := range sp.InfraSpecies {
+				levels = append(levels, v.RankLevel)
+			}
+			Expect(sp.InfraSpecies[1].NormalizedRank).To(Equal("form"))
+			Expect(levels).To(Equal([]int{110, 130}))
+
+			o = gnp.ParseName("Aus sect. Bus")
+			u := o.Details.(*grammar.UninomialOutput).Uninomial
+			Expect(u.NormalizedRank).To(Equal("section"))
+			Expect(u.RankLevel).To(Equal(85))
+		})
+
+		DescribeTable("warns about historic and non-standard ranks",
+			func(name, warning string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Tail).To(Equal(""))
+				var ws []string
+				for _, v := range o.Warnings {
+					ws = append(ws, v.Message)
+				}
+				if warning == "" {
+					Expect(ws).To(BeEmpty())
+					return
+				}
+				Expect(ws).To(ContainElement(warning))
+			},
+			Entry("proles", "Aus bus prol. cus", "Historic rank"),
+			Entry("sublusus", "Aus bus sublusus cus", "Historic rank"),
+			Entry("modification", "Aus bus modif. cus", "Historic rank"),
+			Entry("cohort", "Aus cohors. Bus", "Historic rank"),
+			Entry("cultivar", "Aus bus cultivar. cus", "Non-standard rank"),
+			Entry("biovar", "Aus bus biovar cus", "Non-standard rank"),
+			Entry("microgenus", "Aus microgen. Bus", "Non-standard rank"),
+			Entry("subspecies", "Aus bus subsp. cus", ""),
+		)
+
+		DescribeTable("parses rare rank words as epithets without a next epithet",
+			func(name, canonical string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Quality).To(Equal(1))
+				Expect(o.Tail).To(Equal(""))
+				Expect(o.CanonicalName.Full).To(Equal(canonical))
+			},
+			Entry("lusus", "Aus bus lusus", "Aus bus lusus"),
+			Entry("proles", "Aus bus proles", "Aus bus proles"),
+			Entry("cultivar after author", "Aus bus Smith cultivar",
+				"Aus bus cultivar"),
+			Entry("serovar", "Aus bus serovar", "Aus bus serovar"),
+			Entry("cohors", "Aus cohors", "Aus cohors"),
+			Entry("cohors before epithet", "Aus cohors bus", "Aus cohors bus"),
+		)
+
+		DescribeTable("parses cohors as a rank before a capitalized uninomial",
+			func(name, canonical, rank string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Tail).To(Equal(""))
+				Expect(o.CanonicalName.Full).To(Equal(canonical))
+				u := o.Details.(*grammar.UninomialOutput).Uninomial
+				Expect(u.Value).To(Equal("Bus"))
+				Expect(u.Parent).To(Equal("Aus"))
+				Expect(u.Rank).To(Equal(rank))
+				Expect(u.NormalizedRank).To(Equal("cohort"))
+			},
+			Entry("cohors.", "Aus cohors. Bus", "Aus cohors. Bus", "cohors."),
+			Entry("cohors", "Aus cohors Bus", "Aus cohors Bus", "cohors"),
+			Entry("cohors with authorship", "Aus cohors Bus Smith, 1888",
+				"Aus cohors Bus", "cohors"),
+		)
+
+		It("adds normalized ranks and levels to protobuf output", func() {
+			gnp := NewGNparser()
+			po := gnp.ParseToObject("Aus bus var. cus")
+			inf := po.GetSpecies().InfraSpecies[0]
+			Expect(inf.NormalizedRank).To(Equal("variety"))
+			Expect(inf.RankLevel).To(Equal(int32(120)))
+			po = gnp.ParseToObject("Aus subgen. Bus")
+			Expect(po.GetUninomial().NormalizedRank).To(Equal("subgenus"))
+		})
+	})
+
+	Describe("InfraspecificDepth", func() {
+		It("parses any number of infraspecific epithets", func() {
+			name := "Aus bus subsp. cus L. var. dus Mill. subvar. eus Pers. " +
+				"fma fus DC. subf. gus Smith"
+			o := NewGNparser().ParseName(name)
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Cardinality).To(Equal(7))
+			Expect(o.CanonicalName.Simple).To(Equal("Aus bus cus dus eus fus gus"))
+			sp := o.Details.(*grammar.SpeciesOutput)
+			var ranks, authors []string
+			for _, v := range sp.InfraSpecies {
+				ranks = append(ranks, v.Rank)
+				authors = append(authors, v.Authorship.Value)
+			}
+			Expect(ranks).To(Equal([]string{"subsp.", "var.", "subvar.", "f.", "subf."}))
+			Expect(authors).To(Equal([]string{"L.", "Mill.", "Pers.", "DC.", "Smith"}))
+		})
+	})
+
+	Describe("SanctioningAuthors", func() {
+		It("parses sanctioning authors of fungi", func() {
+			o := NewGNparser().ParseName("Boletus edulis Bull. : Fr.")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Authorship).To(Equal("Bull. : Fr."))
+			Expect(o.Positions[2].Type).To(Equal("authorWord"))
+			Expect(o.Positions[3].Type).To(Equal("authorWordSanctioning"))
+			sp := o.Details.(*grammar.SpeciesOutput)
+			au := sp.SpecEpithet.Authorship.Original
+			Expect(au.Authors).To(Equal([]string{"Bull."}))
+			Expect(au.SanctioningAuthors.Authors).To(Equal([]string{"Fr."}))
+		})
+
+		It("parses sanctioning authors of basionym", func() {
+			gnp := NewGNparser()
+			name := "Agaricus muscarius (L. : Fr.) Lam."
+			o := gnp.ParseName(name)
+			Expect(o.Normalized).To(Equal(name))
+			bs, _ := o.ToJSON(false)
+			o2, err := output.FromJSON(bs)
+			Expect(err).To(BeNil())
+			Expect(o2.Details).To(Equal(o.Details))
+
+			v := &kindsVisitor{}
+			grammar.Walk(v, gnp.Parse(name).Tree())
+			Expect(v.kinds[grammar.SanctioningAuthorsTeamKind]).
+				To(Equal([]string{"Fr."}))
+
+			po := gnp.ParseToObject(name)
+			sa := po.Authorship.Original.SanctioningAuthors
+			Expect(sa.Authors).To(Equal([]string{"Fr."}))
+		})
+	})
+
+	Describe("ExcludedAuthorship", func() {
+		It("parses authors after 'non' and 'nec'", func() {
+			name := "Aus bus Smith 1900 nec Jones 1850, non Brown"
+			o := NewGNparser().ParseName(name)
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Warnings[0].Message).To(Equal(
+				"Authors after `non` or `nec` belong to a homonym or misapplication",
+			))
+			Expect(o.Normalized).To(Equal("Aus bus Smith 1900"))
+			Expect(o.Authorship).To(Equal("Smith 1900"))
+			Expect(o.Cardinality).To(Equal(2))
+			ex := o.ExcludedAuthorship
+			Expect(len(ex)).To(Equal(2))
+			Expect(ex[0].Value).To(Equal("Jones 1850"))
+			Expect(ex[0].Original.Year.Value).To(Equal("1850"))
+			Expect(ex[1].Original.Authors).To(Equal([]string{"Brown"}))
+			Expect(o.Positions[4].Type).To(Equal("exclusion"))
+		})
+
+		It("is in tree, JSON and protobuf outputs", func() {
+			gnp := NewGNparser()
+			name := "Aus bus (Smith) Brown non Jones, sensu Black"
+			sn := gnp.Parse(name)
+			v := &kindsVisitor{}
+			grammar.Walk(v, sn.Tree())
+			Expect(v.kinds[grammar.ExcludedAuthorshipKind]).
+				To(Equal([]string{"non Jones"}))
+
+			o := gnp.ParseName(name)
+			Expect(o.TaxonConcept.Value).To(Equal("sensu Black"))
+			bs, _ := o.ToJSON(false)
+			o2, err := output.FromJSON(bs)
+			Expect(err).To(BeNil())
+			Expect(o2.ExcludedAuthorship).To(Equal(o.ExcludedAuthorship))
+
+			po := gnp.ParseToObject(name)
+			Expect(po.ExcludedAuthorship[0].Value).To(Equal("Jones"))
+		})
+	})
+
+	Describe("Publication", func() {
+		It("parses publication after authorship", func() {
+			name := "Homo sapiens Linnaeus, Syst. Nat. ed. 10, 1: 20. 1758"
+			o := NewGNparser().ParseName(name)
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Tail).To(Equal(""))
+			Expect(o.Authorship).To(Equal("Linnaeus"))
+			Expect(o.Normalized).To(Equal("Homo sapiens Linnaeus"))
+			pub := o.Publication
+			Expect(pub.Value).To(Equal("Syst. Nat. ed. 10, 1: 20. 1758"))
+			Expect(pub.Title).To(Equal("Syst. Nat."))
+			Expect(pub.Edition).To(Equal("10"))
+			Expect(pub.Volume).To(Equal("1"))
+			Expect(pub.Pages).To(Equal("20"))
+			Expect(pub.Year).To(Equal("1758"))
+			Expect(name[pub.Start:pub.End]).
+				To(Equal("Syst. Nat. ed. 10, 1: 20. 1758"))
+			Expect(o.ToSlice()[7]).To(Equal(""))
+		})
+
+		It("parses publication after 'in'", func() {
+			o := NewGNparser().ParseName("Aus bus Smith in J. Bot. 12(2): 3-5 (1890)")
+			Expect(o.Quality).To(Equal(1))
+			Expect(o.Authorship).To(Equal("Smith"))
+			pub := o.Publication
+			Expect(pub.Value).To(Equal("J. Bot. 12(2): 3-5. 1890"))
+			Expect(pub.Issue).To(Equal("2"))
+			Expect(pub.Pages).To(Equal("3-5"))
+		})
+
+		It("warns if years of publication and authorship differ", func() {
+			o := NewGNparser().ParseName("Aus bus Smith 1889 in J. Bot. 12: 3 (1890)")
+			Expect(o.Quality).To(Equal(2))
+			Expect(o.Warnings[0].Message).
+				To(Equal("Publication year differs from authorship year"))
+			Expect(o.ToSlice()[7]).To(Equal("1889"))
+		})
+
+		DescribeTable("keeps the last author before a publication",
+			func(name, authorship, title string) {
+				o := NewGNparser().ParseName(name)
+				Expect(o.Quality).To(Equal(1))
+				Expect(o.Tail).To(Equal(""))
+				Expect(o.Authorship).To(Equal(authorship))
+				Expect(o.Publication.Title).To(Equal(title))
+			},
+			Entry("volume", "Pinus sylvestris L. Sp. Pl. 2: 1000. 1753", "L.",
+				"Sp. Pl."),
+			Entry("title with initial", "Aus bus Smith J. Bot. 12: 3 (1890)",
+				"Smith", "J. Bot."),
+			Entry("edition", "Aus bus Mill. Gard. Dict. ed. 8: 1. 1768", "Mill.",
+				"Gard. Dict."),
+			Entry("pages only", "Aus bus Fr. Sp. Pl.: 1000", "Fr.", "Sp. Pl."),
+			Entry("several authors",
+				"Aus bus Smith & Jones Sp. Pl. 2: 1000. 1753", "Smith & Jones",
+				"Sp. Pl."),
+			Entry("combination author",
+				"Aus bus (L.) Smith Sp. Pl. 2: 1000. 1753", "(L.) Smith", "Sp. Pl."),
+			Entry("after 'in'", "Aus bus L. in Sp. Pl. 2: 1000. 1753", "L.",
+				"Sp. Pl."),
+		)
+
+		It("does not take page after year for publication", func() {
+			o := NewGNparser().ParseName("Acontias lineatus WAGLER 1830: 196")
+			Expect(o.Publication).To(BeNil())
+		})
+
+		It("is in tree, JSON and protobuf outputs", func() {
+			gnp := NewGNparser()
+			name := "Pinus sylvestris L., Sp. Pl. 2: 1000. 1753"
+			sn := gnp.Parse(name)
+			v := &kindsVisitor{}
+			grammar.Walk(v, sn.Tree())
+			Expect(v.kinds[grammar.PublicationKind]).
+				To(Equal([]string{"Sp. Pl. 2: 1000. 1753"}))
+			Expect(v.kinds[grammar.PublicationPartKind]).
+				To(Equal([]string{"Sp. Pl.", "2", "1000", "1753"}))
+
+			o := gnp.ParseName(name)
+			bs, _ := o.ToJSON(false)
+			o2, err := output.FromJSON(bs)
+			Expect(err).To(BeNil())
+			Expect(o2.Publication).To(Equal(o.Publication))
+
+			po := gnp.ParseToObject(name)
+			Expect(po.Publication.Title).To(Equal("Sp. Pl."))
+			Expect(po.Publication.Year).To(Equal("1753"))
+		})
+	})
+
+	Describe("Compare", func() {
+		gnp := NewGNparser()
+		DescribeTable("finds relation of names",
+			func(a, b string, nm NameMatch, score float64) {
+				c := gnp.Compare(a, b)
+				Expect(c.NameMatch).To(Equal(nm))
+				Expect(c.Score).To(Equal(score))
+			},
+			Entry("canonical", "Aus bus (L.) Mill.", "Aus bus (L.)Mill",
+				CanonicalMatch, 1.0),
+			Entry("rank", "Aus bus var. cus L.", "Aus bus subsp. cus L.",
+				RankMatch, 0.92),
+			Entry("stem", "Aus alba", "Aus albus", StemMatch, 0.84),
+			Entry("species", "Aus bus cus", "Aus bus", SpeciesMatch, 0.6),
+			Entry("none", "Aus bus", "Cus dus", NoMatch, 0.0),
+			Entry("not parsed", "Aus bus", "aus bus", NoMatch, 0.0),
+		)
+
+		It("compares authorships", func() {
+			c := gnp.Compare("Aus bus (L.) Mill.", "Aus bus (Linnaeus) Miller")
+			Expect(c.NameScore).To(Equal(1.0))
+			Expect(c.Authorship.Match).To(Equal(authorship.Compatible))
+			Expect(c.Authorship.Reasons).
+				To(Equal([]authorship.Reason{authorship.Abbreviation}))
+			Expect(c.AuthorshipScore).To(Equal(0.5))
+			Expect(c.Score).To(Equal(0.9))
+			Expect(c.ToSlice()).To(Equal([]string{
+				"Aus bus (L.) Mill.", "Aus bus (Linnaeus) Miller", "canonical", "1",
+				"compatible", "0.5", "abbreviation vs full name", "0.9",
+			}))
+			Expect(len(CompareHeader())).To(Equal(len(c.ToSlice())))
+		})
+
+		It("compares parsed outputs", func() {
+			outs := gnp.ParseNames([]string{"Aus bus L. 1753", "Aus bus L. 1758"})
+			c := CompareOutputs(outs[0], outs[1])
+			Expect(c.NameMatch).To(Equal(CanonicalMatch))
+			Expect(c.Authorship.Match).To(Equal(authorship.Conflicting))
+			Expect(c.Score).To(Equal(0.8))
+		})
+	})
+
 	Describe("ParseToObject", func() {
 		It("returns output", func() {
 			gnp := NewGNparser()
@@ -68,6 +1346,169 @@ var _ = Describe("GNparser", func() {
 	})
 })
 
+var _ = Describe("ParseStream", func() {
+	names := []string{
+		"Homo sapiens", "Bubo bubo", "Pomatomus", "Homo sapiens",
+		"Abarema clypearia (Jack) Kosterm., p.p.", "Parus major Linnaeus, 1788",
+	}
+
+	It("keeps order of the input", func() {
+		for _, n := range []int{1, 4, 100} {
+			in := make(chan string)
+			out := make(chan *ParseResult)
+			opts := []Option{OptFormat("csv"), OptPreserveOrder(true)}
+			go ParseStream(n, in, out, opts...)
+			go func() {
+				for i := 0; i < 50; i++ {
+					for _, v := range names {
+						in <- v
+					}
+				}
+				close(in)
+			}()
+			i := 0
+			for r := range out {
+				Expect(r.Idx).To(Equal(i))
+				Expect(r.Input).To(Equal(names[i%len(names)]))
+				i++
+			}
+			Expect(i).To(Equal(50 * len(names)))
+		}
+	})
+
+	It("keeps order of the input for objects", func() {
+		in := make(chan string)
+		out := make(chan *pb.Parsed)
+		go ParseStreamToObjects(8, in, out, OptPreserveOrder(true))
+		go func() {
+			for _, v := range names {
+				in <- v
+			}
+			close(in)
+		}()
+		i := 0
+		for r := range out {
+			Expect(int(r.Idx)).To(Equal(i))
+			Expect(r.Verbatim).To(Equal(names[i]))
+			i++
+		}
+		Expect(i).To(Equal(len(names)))
+	})
+
+	It("assigns indices when order is not preserved", func() {
+		in := make(chan string)
+		out := make(chan *ParseResult)
+		go ParseStream(4, in, out)
+		go func() {
+			for _, v := range names {
+				in <- v
+			}
+			close(in)
+		}()
+		idx := make(map[int]string)
+		for r := range out {
+			idx[r.Idx] = r.Input
+		}
+		Expect(len(idx)).To(Equal(len(names)))
+		for i, v := range names {
+			Expect(idx[i]).To(Equal(v))
+		}
+	})
+
+	It("sends one result per input with context", func() {
+		in := make(chan string)
+		out := make(chan *ParseResult)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- ParseStreamContext(context.Background(), 4, in, out)
+		}()
+		go func() {
+			for _, v := range names {
+				in <- v
+			}
+			close(in)
+		}()
+		count := 0
+		for range out {
+			count++
+		}
+		Expect(count).To(Equal(len(names)))
+		Expect(<-errCh).To(BeNil())
+	})
+
+	It("uses one job if number of jobs is less than 1", func() {
+		for _, jobs := range []int{0, -1} {
+			in := make(chan string)
+			out := make(chan *pb.Parsed)
+			errCh := make(chan error, 1)
+			opts := []Option{OptPreserveOrder(true)}
+			go func() {
+				errCh <- ParseStreamToObjectsContext(context.Background(), jobs,
+					in, out, opts...)
+			}()
+			go func() {
+				for _, v := range names {
+					in <- v
+				}
+				close(in)
+			}()
+			count := 0
+			for range out {
+				count++
+			}
+			Expect(count).To(Equal(len(names)))
+			Expect(<-errCh).To(BeNil())
+		}
+	})
+
+	It("stops parsing when context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		in := make(chan string)
+		out := make(chan *ParseResult)
+		errCh := make(chan error, 1)
+		opts := []Option{OptPreserveOrder(true)}
+		go func() {
+			errCh <- ParseStreamContext(ctx, 4, in, out, opts...)
+		}()
+		go func() {
+			defer close(in)
+			for {
+				select {
+				case in <- "Homo sapiens":
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		count := 0
+		for range out {
+			count++
+			if count == 10 {
+				cancel()
+			}
+		}
+		err := <-errCh
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+
+	It("stops parsing objects when deadline is exceeded", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+		in := make(chan string)
+		out := make(chan *pb.Parsed)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- ParseStreamToObjectsContext(ctx, 4, in, out)
+		}()
+		for range out {
+		}
+		err := <-errCh
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+})
+
 func outputEntries() []TableEntry {
 	var entries []TableEntry
 	tests, err := testData()
@@ -76,18 +1517,19 @@ func outputEntries() []TableEntry {
 	}
 	gnp := NewGNparser(OptIsTest())
 	for i, v := range tests {
-		gnp.Parse(v.NameString)
-		res, err := gnp.ToJSON()
+		o := gnp.ParseName(v.NameString)
+		res, err := o.ToJSON(false)
 		if err != nil {
 			fmt.Println(v.NameString)
 			panic(err)
 		}
 		json := string(res)
 
-		gnp.Parse(v.NameString)
-		simple := output.ToCSV(gnp.ToSlice())
+		so := output.NewSimpleOutput(gnp.Parse(v.NameString))
+		simple := output.ToCSV(so.ToSlice())
+		outputSimple := output.ToCSV(o.ToSlice())
 		testName := fmt.Sprintf("%000d: |%s|", i+1, v.NameString)
-		te := Entry(testName, json, v.Compact, simple, v.Simple)
+		te := Entry(testName, json, v.Compact, simple, v.Simple, outputSimple)
 		entries = append(entries, te)
 	}
 	return entries
@@ -109,10 +1551,12 @@ func astEntries() []TableEntry {
 			entries = append(entries, te)
 			continue
 		}
-		gnp.parser.Buffer = string(ppr.Body)
-		gnp.parser.FullReset()
-		gnp.parser.Error = gnp.parser.Parse()
-		parsedStr := gnp.parser.ParsedName()
+		e := gnp.engine()
+		e.Buffer = string(ppr.Body)
+		e.FullReset()
+		e.Error = e.Parse()
+		parsedStr := e.ParsedName()
+		gnp.putEngine(e)
 		te := Entry(testName, parsedStr, v.Parsed)
 		entries = append(entries, te)
 	}
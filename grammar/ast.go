@@ -2,6 +2,7 @@ package grammar
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -23,29 +24,61 @@ type ScientificNameNode struct {
 	Surrogate     bool
 	Tail          string
 	ParserVersion string
+	Code          Code
+	CodeEvidence  []CodeEvidence
 	Warnings      []Warning
+	// ExpandAuthors is true if the expanded authorship has to be given
+	// in the output.
+	ExpandAuthors bool
+	// Pub is a reference to a publication that follows the authorship.
+	Pub *publicationNode
+	// Excluded are authorships after 'non' or 'nec' that follow the name.
+	Excluded []*excludedNode
+	// Concept is a taxon concept qualifier that follows the name.
+	Concept *taxonConceptNode
+	// NomAnnotations are nomenclatural status and act annotations found
+	// at the end of a name-string.
+	NomAnnotations []preprocess.NomAnnotation
 }
 
 func (p *Engine) NewScientificNameNode() {
 	n := p.root.up
 	var name Name
+	var tc *taxonConceptNode
+	var pub *publicationNode
+	var excl []*excludedNode
 	var tail string
 
 	for n != nil {
 		switch n.token32.pegRule {
 		case ruleName:
 			name = p.newName(n)
+		case rulePublication:
+			pub = p.newPublicationNode(n)
+		case ruleExcludedAuthorship:
+			excl = append(excl, p.newExcludedNode(n))
+		case ruleTaxonConcept:
+			tc = p.newTaxonConceptNode(n)
 		case ruleTail:
 			tail = p.tailValue(n)
 		}
 		n = n.next
 	}
+	if tc == nil {
+		tc = p.concept
+	}
+	p.reconcileYear(pub, name)
 	warns := make([]Warning, len(p.Warnings))
 	i := 0
 	for k := range p.Warnings {
 		warns[i] = k
 		i++
 	}
+	evs := make([]CodeEvidence, 0, len(p.Evidence))
+	for k := range p.Evidence {
+		evs = append(evs, k)
+	}
+	sort.Slice(evs, func(i, j int) bool { return evs[i] < evs[j] })
 	if str.IsBoldSurrogate(tail) {
 		p.Cardinality = 0
 		p.Surrogate = true
@@ -54,13 +87,17 @@ func (p *Engine) NewScientificNameNode() {
 		tail = p.Tail
 	}
 	sn := ScientificNameNode{
-		Name:        name,
-		Cardinality: p.Cardinality,
-		Hybrid:      p.Hybrid,
-		Surrogate:   p.Surrogate,
-		Bacteria:    p.Bacteria,
-		Tail:        tail,
-		Warnings:    warns,
+		Name:         name,
+		Cardinality:  p.Cardinality,
+		Hybrid:       p.Hybrid,
+		Surrogate:    p.Surrogate,
+		Bacteria:     p.Bacteria,
+		Tail:         tail,
+		Pub:          pub,
+		Excluded:     excl,
+		Concept:      tc,
+		CodeEvidence: evs,
+		Warnings:     warns,
 	}
 	p.SN = &sn
 }
@@ -94,6 +131,8 @@ func (p *Engine) newName(n *node32) Name {
 		name = p.newNamedGenusHybridNode(n)
 	case ruleNamedSpeciesHybrid:
 		name = p.newNamedSpeciesHybridNode(n)
+	case ruleNameCultivar:
+		name = p.newCultivarNameNode(n)
 	case ruleSingleName:
 		name = p.newSingleName(n)
 	}
@@ -243,6 +282,8 @@ func (p *Engine) newNamedSpeciesHybridNode(n *node32) *namedSpeciesHybridNode {
 			hybrid = p.newWordNode(n, HybridCharType)
 		case ruleSpeciesEpithet:
 			sp = p.newSpeciesEpithetNode(n)
+		case ruleTaxonConcept:
+			p.concept = p.newTaxonConceptNode(n)
 		case ruleInfraspGroup:
 			infs = p.newInfraspeciesGroup(n)
 		}
@@ -275,11 +316,22 @@ func (p *Engine) botanicalUninomial(n *node32) bool {
 		return false
 	}
 	w := p.newWordNode(n, UnknownType)
+	return p.authorNotSubgenus(w)
+}
 
-	if _, ok := dict.Dict.AuthorICN[w.NormValue]; ok {
+// authorNotSubgenus decides if a word in parentheses after a genus is an
+// author instead of a subgenus. Botanical names do not use subgenera this
+// way, zoological and bacterial names do. If the code is not known,
+// a dictionary of botanical authors is used.
+func (p *Engine) authorNotSubgenus(w *wordNode) bool {
+	switch {
+	case p.Code.isBotanical():
 		return true
+	case p.Code == ZoologicalCode || p.Code == BacterialCode:
+		return false
 	}
-	return false
+	_, ok := dict.Dict.AuthorICN[w.NormValue]
+	return ok
 }
 
 func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
@@ -303,6 +355,9 @@ func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
 	}
 	authorship := &authorshipNode{OriginalAuthors: ag, CombinationAuthors: at2}
 	u := &uninomialNode{Word: w, Authorship: authorship}
+	if p.InferRank {
+		u.InferredRank = p.inferRank(w)
+	}
 	p.AddWarn(BotanyAuthorNotSubgenWarn)
 	p.Cardinality = 1
 	return u
@@ -430,7 +485,7 @@ func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
 		switch n.token32.pegRule {
 		case ruleSubGenus:
 			w := p.newWordNode(n.up, SubGenusType)
-			if _, ok := dict.Dict.AuthorICN[w.NormValue]; ok {
+			if p.authorNotSubgenus(w) {
 				p.AddWarn(BotanyAuthorNotSubgenWarn)
 			} else {
 				sg = w
@@ -439,6 +494,8 @@ func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
 			p.AddWarn(SuperSpeciesWarn)
 		case ruleSpeciesEpithet:
 			sp = p.newSpeciesEpithetNode(n)
+		case ruleTaxonConcept:
+			p.concept = p.newTaxonConceptNode(n)
 		case ruleInfraspGroup:
 			infs = p.newInfraspeciesGroup(n)
 		}
@@ -452,7 +509,7 @@ func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
 		InfraSpecies: infs,
 	}
 	if len(infs) > 0 && infs[0].Rank == nil && sp.Authorship != nil &&
-		sp.Authorship.TerminalFilius {
+		sp.Authorship.TerminalFilius && p.Code != ZoologicalCode {
 		p.AddWarn(AuthAmbiguousFiliusWarn)
 	}
 	return &sn
@@ -494,7 +551,8 @@ func (p *Engine) newInfraspeciesGroup(n *node32) []*infraspEpithetNode {
 		inf := p.newInfraspEpithetNode(n)
 		if len(infs) > 0 && inf.Rank == nil {
 			infPrev := infs[len(infs)-1]
-			if infPrev.Authorship != nil && infPrev.Authorship.TerminalFilius {
+			if infPrev.Authorship != nil && infPrev.Authorship.TerminalFilius &&
+				p.Code != ZoologicalCode {
 				p.AddWarn(AuthAmbiguousFiliusWarn)
 			}
 		}
@@ -540,6 +598,8 @@ type rankNode struct {
 func (p *Engine) newRankNode(n *node32) *rankNode {
 	if n.up == nil {
 		w := p.newWordNode(n, RankType)
+		p.rankEvidence(w.Value)
+		p.rankWarn(w.Value)
 		r := rankNode{Word: w}
 		return &r
 	}
@@ -555,13 +615,35 @@ func (p *Engine) newRankNode(n *node32) *rankNode {
 	case ruleRankOtherUncommon:
 		p.AddWarn(RankUncommonWarn)
 	}
+	p.rankEvidence(w.Value)
+	p.rankWarn(w.Value)
 	r := rankNode{Word: w}
 	return &r
 }
 
+// rankEvidence saves ranks that are used only by some nomenclatural codes.
+func (p *Engine) rankEvidence(rank string) {
+	switch {
+	case strings.HasPrefix(rank, "ab."), strings.HasPrefix(rank, "morph"),
+		strings.HasPrefix(rank, "nat"), strings.HasPrefix(rank, "race"),
+		strings.HasPrefix(rank, "mut."):
+		p.AddEvidence(ZoologicalRankEvidence)
+	case strings.HasPrefix(rank, "convar"), strings.HasPrefix(rank, "subvar"),
+		strings.HasPrefix(rank, "subf"), strings.HasPrefix(rank, "f.sp"),
+		strings.HasPrefix(rank, "agamo"), strings.HasPrefix(rank, "notho"):
+		p.AddEvidence(BotanicalRankEvidence)
+	case strings.HasPrefix(rank, "pv"), strings.HasPrefix(rank, "pathovar"),
+		strings.HasPrefix(rank, "serovar"), strings.HasPrefix(rank, "biovar"):
+		p.AddEvidence(BacterialRankEvidence)
+	}
+}
+
 type uninomialNode struct {
 	Word       *wordNode
 	Authorship *authorshipNode
+	// InferredRank is a rank implied by the suffix of the uninomial. It is
+	// found only if the engine is asked to infer ranks.
+	InferredRank *suffixRank
 }
 
 func (p *Engine) newUninomialNode(n *node32) *uninomialNode {
@@ -575,6 +657,9 @@ func (p *Engine) newUninomialNode(n *node32) *uninomialNode {
 		Word:       w,
 		Authorship: au,
 	}
+	if p.InferRank {
+		un.InferredRank = p.inferRank(w)
+	}
 	p.Cardinality = 1
 	return &un
 }
@@ -634,6 +719,7 @@ func (p *Engine) newRankUninomialNode(n *node32) *rankUninomialNode {
 	case strings.HasPrefix(run.Word.Value, "fam"):
 		run.Word.NormValue = "fam."
 	}
+	p.rankWarn(run.Word.Value)
 	return &run
 }
 
@@ -679,6 +765,9 @@ func (p *Engine) newAuthorshipNode(n *node32) *authorshipNode {
 	fil = oa.TerminalFilius && !oa.Parens
 	if ca != nil {
 		fil = ca.TerminalFilius
+		if oa.Parens {
+			p.AddEvidence(CombinationAuthorsEvidence)
+		}
 	}
 
 	a = &authorshipNode{
@@ -693,57 +782,77 @@ type authorsGroupNode struct {
 	Team1          *authorsTeamNode
 	Team2Type      *wordNode
 	Team2          *authorsTeamNode
+	Sanctioning    *authorsTeamNode
 	Parens         bool
 	TerminalFilius bool
 }
 
 func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
-	var t1, t2 *authorsTeamNode
-	var t2t *wordNode
+	var t1 *authorsTeamNode
 	n = n.up
 	t1 = p.newAuthorTeam(n)
 	fil := t1.TerminalFilius
 	ag := authorsGroupNode{
 		Team1:          t1,
-		Team2Type:      t2t,
-		Team2:          t2,
 		TerminalFilius: fil,
 	}
-	n = n.next
-	if n == nil {
-		return &ag
-	}
-	switch n.token32.pegRule {
-	case ruleAuthorEx:
-		p.AddWarn(AuthExWarn)
-		t2t = p.newWordNode(n, AuthorWordExType)
-		ex := strings.TrimSpace(t2t.Value)
-		if ex[len(ex)-1] == '.' {
-			p.AddWarn(AuthExWithDotWarn)
+	for n = n.next; n != nil; n = n.next {
+		var t2t *wordNode
+		switch n.token32.pegRule {
+		case ruleAuthorEx:
+			p.AddWarn(AuthExWarn)
+			p.AddEvidence(ExAuthorsEvidence)
+			t2t = p.newWordNode(n, AuthorWordExType)
+			ex := strings.TrimSpace(t2t.Value)
+			if ex[len(ex)-1] == '.' {
+				p.AddWarn(AuthExWithDotWarn)
+			}
+			t2t.NormValue = "ex"
+		case ruleAuthorEmend:
+			p.AddWarn(AuthEmendWarn)
+			p.AddEvidence(EmendAuthorsEvidence)
+			t2t = p.newWordNode(n, AuthorWordEmendType)
+			emend := strings.TrimSpace(t2t.Value)
+			if emend[len(emend)-1] != '.' {
+				p.AddWarn(AuthEmendWithoutDotWarn)
+			}
+			t2t.NormValue = "emend."
+		case ruleAuthorSanctioning:
+			n = n.next
+			if n == nil || n.token32.pegRule != ruleAuthorsTeam {
+				return &ag
+			}
+			p.AddEvidence(SanctioningAuthorsEvidence)
+			ag.Sanctioning = p.newSanctioningTeam(n)
+			continue
+		default:
+			return &ag
 		}
-		t2t.NormValue = "ex"
-	case ruleAuthorEmend:
-		p.AddWarn(AuthEmendWarn)
-		t2t = p.newWordNode(n, AuthorWordEmendType)
-		emend := strings.TrimSpace(t2t.Value)
-		if emend[len(emend)-1] != '.' {
-			p.AddWarn(AuthEmendWithoutDotWarn)
+		n = n.next
+		if n == nil || n.token32.pegRule != ruleAuthorsTeam {
+			return &ag
 		}
-		t2t.NormValue = "emend."
-	default:
-		return &ag
+		ag.Team2Type = t2t
+		ag.Team2 = p.newAuthorTeam(n)
+		ag.TerminalFilius = ag.Team2.TerminalFilius
 	}
-	n = n.next
-	if n == nil || n.token32.pegRule != ruleAuthorsTeam {
-		return &ag
-	}
-	t2 = p.newAuthorTeam(n)
-	ag.Team2Type = t2t
-	ag.Team2 = t2
-	ag.TerminalFilius = ag.Team2.TerminalFilius
 	return &ag
 }
 
+// newSanctioningTeam creates a team of authors who sanctioned a name of
+// fungi, like 'Fr.' in 'Boletus edulis Bull. : Fr.'.
+func (p *Engine) newSanctioningTeam(n *node32) *authorsTeamNode {
+	at := p.newAuthorTeam(n)
+	for _, au := range at.Authors {
+		for _, w := range au.Words {
+			if w.Pos.Type == AuthorWordType {
+				w.Pos.Type = AuthorWordSanctioningType
+			}
+		}
+	}
+	return at
+}
+
 type authorsTeamNode struct {
 	Authors        []*authorNode
 	TerminalFilius bool
@@ -754,15 +863,21 @@ func (p *Engine) newAuthorTeam(n *node32) *authorsTeamNode {
 	var anodes []*node32
 	var seps []string
 	var yr *yearNode
+	var end uint32
 	n = n.up
 	for n != nil {
 		switch n.token32.pegRule {
 		case ruleAuthor:
 			anodes = append(anodes, n)
+			end = n.token32.end
 		case ruleAuthorSep:
 			seps = append(seps, p.nodeValue(n))
 		case ruleYear:
 			yr = p.newYearNode(n)
+			sep := string([]rune(p.Buffer)[end:n.token32.begin])
+			if strings.Contains(sep, ",") {
+				p.AddEvidence(CommaYearEvidence)
+			}
 		}
 		n = n.next
 	}
@@ -790,16 +905,28 @@ func (p *Engine) newAuthorTeam(n *node32) *authorsTeamNode {
 }
 
 type authorNode struct {
-	Value  string
-	Sep    string
-	Words  []*wordNode
-	Filius bool
+	Value    string
+	Sep      string
+	Words    []*wordNode
+	Filius   bool
+	Surname  string
+	Initials string
+	Prefix   string
+	EtAl     bool
+	Unknown  bool
+	// Expanded is the full name of the author if the name is a known
+	// abbreviation, like 'Carl Linnaeus' for 'L.'.
+	Expanded string
+	// Key is a normalized name of the author for comparison with other
+	// authors.
+	Key string
 }
 
 func (p *Engine) newAuthorNode(n *node32) *authorNode {
 	var w *wordNode
-	var fil bool
-	var ws []*wordNode
+	var fil, etAl, unknown bool
+	var ws, names []*wordNode
+	var prefixes []bool
 	val := ""
 	rawVal := ""
 	n = n.up
@@ -816,8 +943,22 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 				p.AddWarn(AuthQuestionWarn)
 			}
 			w.NormValue = "anon."
+			unknown = true
 		default:
 			w = p.authorWord(n)
+			if n.token32.pegRule != ruleAuthorWord {
+				break
+			}
+			var r pegRule
+			if n.up != nil {
+				r = n.up.token32.pegRule
+			}
+			if r == ruleAuthorEtAl {
+				etAl = true
+				break
+			}
+			names = append(names, w)
+			prefixes = append(prefixes, r == ruleAuthorPrefix)
 		}
 		ws = append(ws, w)
 		val = str.JoinStrings(val, w.NormValue, " ")
@@ -828,13 +969,101 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 		p.AddWarn(AuthShortWarn)
 	}
 	au := authorNode{
-		Value:  val,
-		Words:  ws,
-		Filius: fil,
-	}
+		Value:   val,
+		Words:   ws,
+		Filius:  fil,
+		EtAl:    etAl,
+		Unknown: unknown,
+	}
+	au.setNameParts(names, prefixes)
+	p.expandAuthor(&au)
 	return &au
 }
 
+// expandAuthor finds the full name of the author by its abbreviation and
+// creates the key of the author from its surname. The surname and prefix
+// of an expanded author are taken from the dictionary.
+func (p *Engine) expandAuthor(au *authorNode) {
+	abbr := p.AuthorAbbr
+	if abbr == nil {
+		abbr = dict.Dict.AuthorAbbr
+	}
+	k := dict.AbbrKey(au.Value)
+	an, ok := abbr[k]
+	// abbreviations often lose their final period, like 'Mill' for 'Mill.'
+	if !ok && !strings.HasSuffix(k, ".") {
+		an, ok = abbr[k+"."]
+	}
+	if ok {
+		au.Expanded = an.FullName
+		au.Key = authorKey(an.Surname)
+		au.setDictSurname(an.Surname)
+		return
+	}
+	if au.Unknown {
+		return
+	}
+	sn := str.JoinStrings(au.Prefix, au.Surname, " ")
+	if au.Filius {
+		sn += " f."
+	}
+	au.Key = authorKey(sn)
+}
+
+// setDictSurname sets the surname of an author from a surname of the
+// dictionary, like 'de Candolle' or 'Hooker f.'. Its lowercase words are
+// the prefix, 'f.' at the end means filius.
+func (au *authorNode) setDictSurname(s string) {
+	ws := strings.Fields(s)
+	if len(ws) > 1 && ws[len(ws)-1] == "f." {
+		au.Filius = true
+		ws = ws[:len(ws)-1]
+	}
+	i := 0
+	for i < len(ws)-1 && ws[i] == strings.ToLower(ws[i]) {
+		i++
+	}
+	au.Prefix = strings.Join(ws[:i], " ")
+	au.Surname = strings.Join(ws[i:], " ")
+}
+
+// authorKey converts a surname of an author to lower case ASCII without
+// periods, like 'de candolle' for 'de Candolle' or 'hooker f' for
+// 'Hooker f.'.
+func authorKey(s string) string {
+	bs, _ := str.ToASCII([]byte(s), str.Transliterations)
+	s = strings.Replace(string(bs), ".", " ", -1)
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// initialRe matches words that look like initials of given names, like
+// 'L.', 'Ch.' or 'J.-P.'.
+var initialRe = regexp.MustCompile(
+	`^(\p{Lu}\p{Ll}{0,2}\.|\p{Lu})(-?(\p{Lu}\p{Ll}{0,2}\.|\p{Lu}))*$`,
+)
+
+// setNameParts splits words of an author's name into initials, a prefix
+// (particle) and a surname. Initials start the name, a prefix follows them,
+// the rest of the words are the surname. The last word always belongs to
+// the surname, so 'L.' is a surname and not an initial.
+func (au *authorNode) setNameParts(names []*wordNode, prefixes []bool) {
+	var initials, prefix, surname []string
+	for i, v := range names {
+		switch {
+		case len(surname) == 0 && prefixes[i]:
+			prefix = append(prefix, v.NormValue)
+		case len(surname) == 0 && len(prefix) == 0 && i < len(names)-1 &&
+			initialRe.MatchString(v.NormValue):
+			initials = append(initials, v.NormValue)
+		default:
+			surname = append(surname, v.NormValue)
+		}
+	}
+	au.Initials = strings.Join(initials, " ")
+	au.Prefix = strings.Join(prefix, " ")
+	au.Surname = strings.Join(surname, " ")
+}
+
 func (p *Engine) authorWord(n *node32) *wordNode {
 	w := p.newWordNode(n, AuthorWordType)
 	if n.up != nil && n.up.token32.pegRule == ruleAllCapsAuthorWord {
@@ -869,8 +1098,11 @@ func (p *Engine) newYearNode(nd *node32) *yearNode {
 			p.AddWarn(YearRangeWarn)
 			appr = true
 		case ruleYearWithParens:
-			p.AddWarn(YearParensWarn)
-			appr = true
+			// botanical names cite years of publication in parentheses
+			if !p.Code.isBotanical() {
+				p.AddWarn(YearParensWarn)
+				appr = true
+			}
 		case ruleYearApprox:
 			p.AddWarn(YearSqBraketsWarn)
 			appr = true
@@ -928,7 +1160,8 @@ type wordNode struct {
 func (p *Engine) newWordNode(n *node32, wt WordType) *wordNode {
 	t := n.token32
 	val := p.nodeValue(n)
-	pos := Pos{Type: wt, Start: int(t.begin), End: int(t.end)}
+	start, end := p.span(t)
+	pos := Pos{Type: wt, Start: start, End: end}
 	wrd := wordNode{Value: val, NormValue: val, Pos: pos}
 	children := n.flatChildren()
 	var canApostrophe bool
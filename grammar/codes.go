@@ -0,0 +1,161 @@
+package grammar
+
+import "fmt"
+
+// Code is a nomenclatural code that rules a name. When the code is known,
+// the parser uses its rules for ambiguous cases.
+type Code int
+
+const (
+	// AnyCode means that the code is not known, and the parser guesses.
+	AnyCode Code = iota
+	// ZoologicalCode is International Code of Zoological Nomenclature.
+	ZoologicalCode
+	// BotanicalCode is International Code of Nomenclature for algae, fungi,
+	// and plants.
+	BotanicalCode
+	// BacterialCode is International Code of Nomenclature of Prokaryotes.
+	BacterialCode
+	// CultivatedCode is International Code of Nomenclature for Cultivated
+	// Plants.
+	CultivatedCode
+	// VirusCode is International Code of Virus Classification and
+	// Nomenclature.
+	VirusCode
+)
+
+var codes = []string{
+	"any", "zoological", "botanical", "bacterial", "cultivated", "virus",
+}
+
+func (c Code) String() string {
+	return codes[c]
+}
+
+// NewCode converts a name of a nomenclatural code to Code. It returns
+// AnyCode and an error for unknown names.
+func NewCode(s string) (Code, error) {
+	for i, v := range codes {
+		if v == s {
+			return Code(i), nil
+		}
+	}
+	return AnyCode, fmt.Errorf("unknown nomenclatural code '%s'", s)
+}
+
+// AvailableCodes returns names of supported nomenclatural codes.
+func AvailableCodes() []string {
+	return codes
+}
+
+// isBotanical is true for codes that follow botanical rules.
+func (c Code) isBotanical() bool {
+	return c == BotanicalCode || c == CultivatedCode
+}
+
+// CodeEvidence is a feature of a parsed name that points to a particular
+// nomenclatural code.
+type CodeEvidence int
+
+const (
+	// ExAuthorsEvidence is an authorship with 'ex' authors.
+	ExAuthorsEvidence CodeEvidence = iota
+	// EmendAuthorsEvidence is an authorship with 'emend.' authors.
+	EmendAuthorsEvidence
+	// CombinationAuthorsEvidence is an authorship with both basionym and
+	// combination authors.
+	CombinationAuthorsEvidence
+	// SanctioningAuthorsEvidence is an authorship with sanctioning authors
+	// of fungi.
+	SanctioningAuthorsEvidence
+	// BacterialGenusEvidence is a genus known only from bacteria.
+	BacterialGenusEvidence
+	// CommaYearEvidence is a year separated from authors by a comma.
+	CommaYearEvidence
+	// ZoologicalRankEvidence is a rank used by zoologists.
+	ZoologicalRankEvidence
+	// BotanicalRankEvidence is a rank used by botanists.
+	BotanicalRankEvidence
+	// BacterialRankEvidence is a rank used by bacteriologists.
+	BacterialRankEvidence
+)
+
+// codeEvidence keeps the code supported by each kind of evidence, its
+// weight and description. Evidence that belongs to only one code weighs more.
+// 'ex' and 'emend.' authors are used under several codes, so they are
+// recorded, but do not vote for any code.
+var codeEvidence = []struct {
+	code   Code
+	weight int
+	msg    string
+}{
+	{AnyCode, 0, "ex authors"},
+	{AnyCode, 0, "emend authors"},
+	{BotanicalCode, 1, "basionym and combination authors"},
+	{BotanicalCode, 2, "sanctioning authors"},
+	{BacterialCode, 2, "bacterial genus"},
+	{ZoologicalCode, 1, "year after comma"},
+	{ZoologicalCode, 2, "zoological rank"},
+	{BotanicalCode, 2, "botanical rank"},
+	{BacterialCode, 2, "bacterial rank"},
+}
+
+func (ev CodeEvidence) String() string {
+	return codeEvidence[ev].msg
+}
+
+// Code returns the nomenclatural code supported by the evidence. It is
+// AnyCode for evidence that does not vote for a code.
+func (ev CodeEvidence) Code() Code {
+	return codeEvidence[ev].code
+}
+
+// CodeInference is a nomenclatural code inferred from a parsed name.
+type CodeInference struct {
+	// Code is the inferred code. It is AnyCode if evidence supports
+	// different codes equally or does not vote for any code.
+	Code Code
+	// Confidence is the weighted share of evidence that supports the code.
+	// It is 1 if the code was given to the parser.
+	Confidence float64
+	// Evidence are features of the name that point to a code.
+	Evidence []CodeEvidence
+}
+
+// InferCode returns a nomenclatural code inferred from the evidence found
+// during parsing, or the code given to the parser. It returns nil if
+// the code is not given and there is no evidence.
+func (sn *ScientificNameNode) InferCode() *CodeInference {
+	if sn.Code != AnyCode {
+		return &CodeInference{Code: sn.Code, Confidence: 1,
+			Evidence: sn.CodeEvidence}
+	}
+	if len(sn.CodeEvidence) == 0 {
+		return nil
+	}
+	votes := make(map[Code]int)
+	var total int
+	for _, v := range sn.CodeEvidence {
+		w := codeEvidence[v].weight
+		if w == 0 {
+			continue
+		}
+		votes[v.Code()] += w
+		total += w
+	}
+	res := CodeInference{Evidence: sn.CodeEvidence}
+	var max int
+	for c, n := range votes {
+		switch {
+		case n > max:
+			max = n
+			res.Code = c
+		case n == max:
+			res.Code = AnyCode
+		}
+	}
+	if res.Code != AnyCode {
+		res.Confidence = float64(max) / float64(total)
+	}
+	return &res
+}
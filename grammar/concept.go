@@ -0,0 +1,111 @@
+package grammar
+
+import (
+	"strings"
+
+	"github.com/gnames/gnparser/str"
+)
+
+// taxonConceptNode is a taxon concept qualifier that follows a name, like
+// 'sensu Smith 1990', 'auct. non L.', 's. l.' or 'pro parte'.
+type taxonConceptNode struct {
+	Type       string
+	Qualifier  *wordNode
+	Authorship *authorshipNode
+	Excluded   []*excludedNode
+	Start      int
+	End        int
+}
+
+// taxonConceptTypes contain types and normalized values of qualifiers
+// according to parsing rules.
+var taxonConceptTypes = map[pegRule][2]string{
+	ruleTaxonConceptLato:     {"sensuLato", "s. l."},
+	ruleTaxonConceptStricto:  {"sensuStricto", "s. str."},
+	ruleTaxonConceptAuct:     {"auct", "auct."},
+	ruleTaxonConceptProParte: {"proParte", "p. p."},
+}
+
+// newTaxonConceptNode creates a taxon concept out of its node. Start and End
+// of the concept do not include parentheses around it, so they agree with
+// its positions.
+func (p *Engine) newTaxonConceptNode(n *node32) *taxonConceptNode {
+	var tc taxonConceptNode
+	n = n.up
+	tc.Start, _ = p.span(n.token32)
+	for ; n != nil; n = n.next {
+		_, tc.End = p.span(n.token32)
+		switch n.token32.pegRule {
+		case ruleTaxonConceptSensu:
+			tc.Type, tc.Qualifier = "sensu", p.newWordNode(n, TaxonConceptType)
+			tc.Qualifier.NormValue = "sensu"
+			if strings.HasPrefix(strings.ToLower(tc.Qualifier.Value), "sec") {
+				tc.Type, tc.Qualifier.NormValue = "sec", "sec."
+			}
+		case ruleAuthorship, ruleAuthorshipInParens:
+			tc.Authorship = p.newAuthorshipNode(n)
+		case ruleExcludedAuthorship, ruleExcludedAuthorshipInParens:
+			tc.Excluded = append(tc.Excluded, p.newExcludedNode(n))
+		default:
+			if v, ok := taxonConceptTypes[n.token32.pegRule]; ok {
+				tc.Type, tc.Qualifier = v[0], p.newWordNode(n, TaxonConceptType)
+				tc.Qualifier.NormValue = v[1]
+			}
+		}
+	}
+	return &tc
+}
+
+func (tc *taxonConceptNode) value() string {
+	if tc == nil {
+		return ""
+	}
+	res := str.JoinStrings(tc.Qualifier.NormValue, tc.Authorship.value(), " ")
+	for _, v := range tc.Excluded {
+		res = str.JoinStrings(res, v.value(), " ")
+	}
+	return res
+}
+
+func (tc *taxonConceptNode) pos() []Pos {
+	if tc == nil {
+		return nil
+	}
+	pos := append([]Pos{tc.Qualifier.Pos}, tc.Authorship.pos()...)
+	for _, v := range tc.Excluded {
+		pos = append(pos, v.pos()...)
+	}
+	return pos
+}
+
+func (tc *taxonConceptNode) details() *TaxonConceptOutput {
+	if tc == nil {
+		return nil
+	}
+	tco := TaxonConceptOutput{
+		Type:       tc.Type,
+		Value:      tc.value(),
+		Authorship: tc.Authorship.details(),
+		Start:      tc.Start,
+		End:        tc.End,
+	}
+	for _, v := range tc.Excluded {
+		tco.ExcludedAuthorship = append(tco.ExcludedAuthorship,
+			v.Authorship.details())
+	}
+	return &tco
+}
+
+func (tc *taxonConceptNode) tree() *TreeNode {
+	if tc == nil {
+		return nil
+	}
+	t := TreeNode{Kind: TaxonConceptKind, Value: tc.value()}
+	t.Children = treeNodes(tc.Qualifier.tree(QualifierKind),
+		tc.Authorship.tree())
+	for _, v := range tc.Excluded {
+		t.Children = append(t.Children,
+			treeNodes(v.Word.tree(QualifierKind), v.Authorship.tree())...)
+	}
+	return &t
+}
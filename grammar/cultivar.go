@@ -0,0 +1,130 @@
+package grammar
+
+import (
+	"strings"
+
+	"github.com/gnames/gnparser/str"
+)
+
+// cultivarNameNode is a name of a cultivated plant according to ICNCP.
+// It consists of a botanical name followed by cultivar epithets, cultivar
+// groups or grex names.
+type cultivarNameNode struct {
+	Name      Name
+	Cultivars []*cultivarNode
+}
+
+// cultivarNode is a cultivar epithet, a cultivar group or a grex. The type
+// of its word tells which one.
+type cultivarNode struct {
+	Word *wordNode
+}
+
+func (p *Engine) newCultivarNameNode(n *node32) *cultivarNameNode {
+	n = n.up
+	cn := cultivarNameNode{Name: p.newSingleName(n)}
+	for n = n.next; n != nil; n = n.next {
+		cn.Cultivars = append(cn.Cultivars, p.newCultivarNode(n))
+	}
+	return &cn
+}
+
+func (p *Engine) newCultivarNode(n *node32) *cultivarNode {
+	var wt WordType
+	switch n.token32.pegRule {
+	case ruleCultivarEpithet:
+		wt = CultivarType
+	case ruleCultivarGroup:
+		wt = CultivarGroupType
+	case ruleGrex:
+		wt = GrexType
+	}
+	w := p.newWordNode(n.up, wt)
+	w.NormValue = strings.Join(strings.Fields(w.Value), " ")
+	return &cultivarNode{Word: w}
+}
+
+// value returns the cultivar part normalized according to ICNCP.
+func (c *cultivarNode) value() string {
+	switch c.Word.Pos.Type {
+	case CultivarType:
+		return "'" + c.Word.NormValue + "'"
+	case CultivarGroupType:
+		return c.Word.NormValue + " Group"
+	default:
+		return c.Word.NormValue + " grex"
+	}
+}
+
+func (c *cultivarNode) typeName() string {
+	switch c.Word.Pos.Type {
+	case CultivarType:
+		return "cultivar"
+	case CultivarGroupType:
+		return "cultivarGroup"
+	default:
+		return "grex"
+	}
+}
+
+func (cn *cultivarNameNode) value() string {
+	res := cn.Name.value()
+	for _, v := range cn.Cultivars {
+		res = str.JoinStrings(res, v.value(), " ")
+	}
+	return res
+}
+
+// canonical adds cultivar parts only to the ranked canonical form, so
+// the simple canonical form stays the same as for the botanical name.
+func (cn *cultivarNameNode) canonical() *Canonical {
+	c := *cn.Name.canonical()
+	for _, v := range cn.Cultivars {
+		c.ValueRanked = str.JoinStrings(c.ValueRanked, v.value(), " ")
+	}
+	c.IncludesCultivars = true
+	return &c
+}
+
+func (cn *cultivarNameNode) pos() []Pos {
+	pos := cn.Name.pos()
+	for _, v := range cn.Cultivars {
+		pos = append(pos, v.Word.Pos)
+	}
+	return pos
+}
+
+func (cn *cultivarNameNode) lastAuthorship() *authorshipNode {
+	return cn.Name.lastAuthorship()
+}
+
+func (cn *cultivarNameNode) details() []Details {
+	co := CultivarOutput{}
+	for _, v := range cn.Name.details() {
+		switch d := v.(type) {
+		case *UninomialOutput:
+			co.UninomialOutput = d
+		case *SpeciesOutput:
+			co.SpeciesOutput = d
+		}
+	}
+	for _, v := range cn.Cultivars {
+		c := CultivarEpithetOutput{Value: v.Word.NormValue, Type: v.typeName()}
+		co.Cultivars = append(co.Cultivars, &c)
+	}
+	return []Details{&co}
+}
+
+func (cn *cultivarNameNode) tree() *TreeNode {
+	if cn == nil {
+		return nil
+	}
+	t := TreeNode{Kind: CultivarNameKind, Value: cn.value()}
+	t.Children = treeNodes(cn.Name.tree())
+	for _, v := range cn.Cultivars {
+		c := v.Word.tree(CultivarKind)
+		c.Value = v.value()
+		t.Children = append(t.Children, c)
+	}
+	return &t
+}
@@ -0,0 +1,57 @@
+package grammar
+
+// DetailsType designates the kind of details of a parsed name.
+type DetailsType int
+
+const (
+	UninomialDetails DetailsType = iota
+	SpeciesDetails
+	ComparisonDetails
+	ApproxDetails
+	HybridFormulaDetails
+	CultivarDetails
+)
+
+var detailsTypes = []string{
+	"uninomial", "species", "comparison", "approximation", "hybridFormula",
+	"cultivar",
+}
+
+func (dt DetailsType) String() string {
+	return detailsTypes[dt]
+}
+
+// Details is implemented by all types of details of a parsed name. Its
+// DetailsType method tells which type is used.
+type Details interface {
+	DetailsType() DetailsType
+}
+
+// HybridFormulaOutput keeps details of every element of a hybrid formula.
+type HybridFormulaOutput struct {
+	Elements []Details `json:"elements"`
+}
+
+func (*UninomialOutput) DetailsType() DetailsType {
+	return UninomialDetails
+}
+
+func (*SpeciesOutput) DetailsType() DetailsType {
+	return SpeciesDetails
+}
+
+func (*ComparisonOutput) DetailsType() DetailsType {
+	return ComparisonDetails
+}
+
+func (*ApproxOutput) DetailsType() DetailsType {
+	return ApproxDetails
+}
+
+func (*HybridFormulaOutput) DetailsType() DetailsType {
+	return HybridFormulaDetails
+}
+
+func (*CultivarOutput) DetailsType() DetailsType {
+	return CultivarDetails
+}
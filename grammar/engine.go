@@ -7,6 +7,15 @@ import (
 )
 
 type BaseEngine struct {
+	// Code is a nomenclatural code used for ambiguous cases. It is kept
+	// after FullReset.
+	Code Code
+	// AuthorAbbr contains full names of authors for their abbreviations. If
+	// it is nil, the default dictionary is used. It is kept after FullReset.
+	AuthorAbbr dict.AuthorAbbr
+	// InferRank is true if ranks of uninomials have to be inferred from
+	// their suffixes. It is kept after FullReset.
+	InferRank   bool
 	SN          *ScientificNameNode
 	root        *node32
 	Cardinality int
@@ -15,7 +24,16 @@ type BaseEngine struct {
 	Surrogate   bool
 	Bacteria    bool
 	Warnings    map[Warning]struct{}
+	Evidence    map[CodeEvidence]struct{}
 	Tail        string
+	// concept is a taxon concept qualifier in parentheses between
+	// a specific and an infraspecific epithet, like '(s. l.)'.
+	concept *taxonConceptNode
+	// GapStart and GapLen describe a part of the name-string removed before
+	// parsing. Positions after GapStart are shifted by GapLen, so they
+	// point to the original name-string. They are reset by FullReset.
+	GapStart int
+	GapLen   int
 }
 
 func (p *Engine) FullReset() {
@@ -26,10 +44,31 @@ func (p *Engine) FullReset() {
 	p.Bacteria = false
 	var warnReset map[Warning]struct{}
 	p.Warnings = warnReset
+	var evidenceReset map[CodeEvidence]struct{}
+	p.Evidence = evidenceReset
 	p.Tail = ""
+	p.concept = nil
+	p.GapStart = 0
+	p.GapLen = 0
 	p.Reset()
 }
 
+// span converts start and end of a token in the parsed buffer to positions
+// in the original name-string.
+func (p *Engine) span(t token32) (int, int) {
+	start, end := int(t.begin), int(t.end)
+	if p.GapLen == 0 {
+		return start, end
+	}
+	if start >= p.GapStart {
+		start += p.GapLen
+	}
+	if end > p.GapStart {
+		end += p.GapLen
+	}
+	return start, end
+}
+
 func (p *Engine) AddWarn(w Warning) {
 	if p.Warnings == nil {
 		p.Warnings = make(map[Warning]struct{})
@@ -39,12 +78,30 @@ func (p *Engine) AddWarn(w Warning) {
 	}
 }
 
+// AddEvidence saves a feature of the name that points to a nomenclatural
+// code.
+func (p *Engine) AddEvidence(ev CodeEvidence) {
+	if p.Evidence == nil {
+		p.Evidence = make(map[CodeEvidence]struct{})
+	}
+	p.Evidence[ev] = struct{}{}
+}
+
 func (p *Engine) IsBacteria(gen string) {
+	switch p.Code {
+	case BacterialCode:
+		p.Bacteria = true
+		return
+	case AnyCode:
+	default:
+		return
+	}
 	if hom, ok := dict.Dict.Bacteria[gen]; ok {
 		if hom {
 			p.AddWarn(BacteriaMaybeWarn)
 		} else {
 			p.Bacteria = true
+			p.AddEvidence(BacterialGenusEvidence)
 		}
 	}
 }
@@ -140,10 +197,33 @@ var nodeRules = map[pegRule]struct{}{
 	ruleSciName:                         struct{}{},
 	ruleName:                            struct{}{},
 	ruleTail:                            struct{}{},
+	rulePublication:                     struct{}{},
+	rulePublicationIn:                   struct{}{},
+	rulePubTitle:                        struct{}{},
+	rulePubEdition:                      struct{}{},
+	rulePubVolume:                       struct{}{},
+	rulePubIssue:                        struct{}{},
+	rulePubPages:                        struct{}{},
+	rulePubYear:                         struct{}{},
+	ruleTaxonConcept:                    struct{}{},
+	ruleTaxonConceptLato:                struct{}{},
+	ruleTaxonConceptStricto:             struct{}{},
+	ruleTaxonConceptAuct:                struct{}{},
+	ruleTaxonConceptProParte:            struct{}{},
+	ruleTaxonConceptSensu:               struct{}{},
+	ruleExcludedAuthorship:              struct{}{},
+	ruleExcludedAuthorshipInParens:      struct{}{},
+	ruleExcludedWord:                    struct{}{},
 	ruleHybridFormula:                   struct{}{},
 	ruleNamedSpeciesHybrid:              struct{}{},
 	ruleNamedGenusHybrid:                struct{}{},
 	ruleSingleName:                      struct{}{},
+	ruleNameCultivar:                    struct{}{},
+	ruleCultivarEpithet:                 struct{}{},
+	ruleCultivarGroup:                   struct{}{},
+	ruleGrex:                            struct{}{},
+	ruleCultivarWords:                   struct{}{},
+	ruleCultivarText:                    struct{}{},
 	ruleNameApprox:                      struct{}{},
 	ruleNameComp:                        struct{}{},
 	ruleNameSpecies:                     struct{}{},
@@ -171,6 +251,7 @@ var nodeRules = map[pegRule]struct{}{
 	ruleApproxNameIgnored:               struct{}{},
 	ruleApproximation:                   struct{}{},
 	ruleAuthorship:                      struct{}{},
+	ruleAuthorshipInParens:              struct{}{},
 	ruleOriginalAuthorship:              struct{}{},
 	ruleOriginalAuthorshipComb:          struct{}{},
 	ruleCombinationAuthorship:           struct{}{},
@@ -182,6 +263,7 @@ var nodeRules = map[pegRule]struct{}{
 	ruleAuthorSep:                       struct{}{},
 	ruleAuthorEx:                        struct{}{},
 	ruleAuthorEmend:                     struct{}{},
+	ruleAuthorSanctioning:               struct{}{},
 	ruleAuthor:                          struct{}{},
 	ruleUnknownAuthor:                   struct{}{},
 	ruleAuthorWord:                      struct{}{},
@@ -0,0 +1,36 @@
+package grammar
+
+import "github.com/gnames/gnparser/str"
+
+// excludedNode is an authorship after 'non' or 'nec'. It belongs to
+// a homonym or a misapplication of a name, like 'Jones 1850' in
+// 'Aus bus Smith non Jones 1850', and is excluded from the name.
+type excludedNode struct {
+	Word       *wordNode
+	Authorship *authorshipNode
+}
+
+func (p *Engine) newExcludedNode(n *node32) *excludedNode {
+	p.AddWarn(ExcludedAuthorshipWarn)
+	n = n.up
+	ex := excludedNode{
+		Word:       p.newWordNode(n, ExclusionType),
+		Authorship: p.newAuthorshipNode(n.next),
+	}
+	return &ex
+}
+
+func (ex *excludedNode) value() string {
+	return str.JoinStrings(ex.Word.NormValue, ex.Authorship.value(), " ")
+}
+
+func (ex *excludedNode) pos() []Pos {
+	return append([]Pos{ex.Word.Pos}, ex.Authorship.pos()...)
+}
+
+func (ex *excludedNode) tree() *TreeNode {
+	t := TreeNode{Kind: ExcludedAuthorshipKind, Value: ex.value()}
+	t.Word = ex.Word.tree(ExcludedAuthorshipKind).Word
+	t.Children = treeNodes(ex.Authorship.tree())
+	return &t
+}
@@ -20,6 +20,45 @@ const (
 	ruleSciName
 	ruleTail
 	ruleName
+	rulePublicationSep
+	rulePublicationIn
+	rulePublicationAhead
+	rulePublicationStart
+	rulePublication
+	rulePubTitle
+	rulePubTitleWord
+	rulePubEdition
+	rulePubVolumeIssue
+	rulePubVolume
+	rulePubIssue
+	rulePubPages
+	rulePubYear
+	ruleTaxonConceptSep
+	ruleTaxonConcept
+	ruleTaxonConceptBody
+	ruleTaxonConceptBodyInParens
+	ruleTaxonConceptQualifier
+	ruleTaxonConceptLato
+	ruleTaxonConceptStricto
+	ruleTaxonConceptAuct
+	ruleTaxonConceptProParte
+	ruleTaxonConceptSensu
+	ruleExcludedAuthorship
+	ruleExcludedAuthorshipInParens
+	ruleExcludedWord
+	ruleExcludedAhead
+	ruleTaxonConceptAhead
+	ruleNameCultivar
+	ruleCultivar
+	ruleCultivarEpithet
+	ruleCultivarGroup
+	ruleCultivarGroupWord
+	ruleGrex
+	ruleCultivarGroupName
+	ruleCultivarWords
+	ruleCultivarWord
+	ruleCultivarText
+	ruleCultivarQuote
 	ruleHybridFormula
 	ruleHybridFormulaFull
 	ruleHybridFormulaPart
@@ -39,6 +78,7 @@ const (
 	ruleRank
 	ruleRankNotho
 	ruleRankOtherUncommon
+	ruleRankOtherRare
 	ruleRankOther
 	ruleRankVar
 	ruleRankForma
@@ -72,6 +112,8 @@ const (
 	ruleApproxNameIgnored
 	ruleApproximation
 	ruleAuthorship
+	ruleAuthorshipInParens
+	ruleCultivarAhead
 	ruleAuthorshipCombo
 	ruleOriginalAuthorship
 	ruleOriginalAuthorshipComb
@@ -91,6 +133,7 @@ const (
 	ruleAuthorSepSpanish
 	ruleAuthorEx
 	ruleAuthorEmend
+	ruleAuthorSanctioning
 	ruleAuthor
 	ruleAuthor1
 	ruleAuthor2
@@ -103,6 +146,7 @@ const (
 	ruleCapAuthorWord
 	ruleAllCapsAuthorWord
 	ruleFilius
+	ruleFiliusF
 	ruleAuthorSuffix
 	ruleAuthorPrefixGlued
 	ruleAuthorPrefix
@@ -147,6 +191,45 @@ var rul3s = [...]string{
 	"SciName",
 	"Tail",
 	"Name",
+	"PublicationSep",
+	"PublicationIn",
+	"PublicationAhead",
+	"PublicationStart",
+	"Publication",
+	"PubTitle",
+	"PubTitleWord",
+	"PubEdition",
+	"PubVolumeIssue",
+	"PubVolume",
+	"PubIssue",
+	"PubPages",
+	"PubYear",
+	"TaxonConceptSep",
+	"TaxonConcept",
+	"TaxonConceptBody",
+	"TaxonConceptBodyInParens",
+	"TaxonConceptQualifier",
+	"TaxonConceptLato",
+	"TaxonConceptStricto",
+	"TaxonConceptAuct",
+	"TaxonConceptProParte",
+	"TaxonConceptSensu",
+	"ExcludedAuthorship",
+	"ExcludedAuthorshipInParens",
+	"ExcludedWord",
+	"ExcludedAhead",
+	"TaxonConceptAhead",
+	"NameCultivar",
+	"Cultivar",
+	"CultivarEpithet",
+	"CultivarGroup",
+	"CultivarGroupWord",
+	"Grex",
+	"CultivarGroupName",
+	"CultivarWords",
+	"CultivarWord",
+	"CultivarText",
+	"CultivarQuote",
 	"HybridFormula",
 	"HybridFormulaFull",
 	"HybridFormulaPart",
@@ -166,6 +249,7 @@ var rul3s = [...]string{
 	"Rank",
 	"RankNotho",
 	"RankOtherUncommon",
+	"RankOtherRare",
 	"RankOther",
 	"RankVar",
 	"RankForma",
@@ -199,6 +283,8 @@ var rul3s = [...]string{
 	"ApproxNameIgnored",
 	"Approximation",
 	"Authorship",
+	"AuthorshipInParens",
+	"CultivarAhead",
 	"AuthorshipCombo",
 	"OriginalAuthorship",
 	"OriginalAuthorshipComb",
@@ -218,6 +304,7 @@ var rul3s = [...]string{
 	"AuthorSepSpanish",
 	"AuthorEx",
 	"AuthorEmend",
+	"AuthorSanctioning",
 	"Author",
 	"Author1",
 	"Author2",
@@ -230,6 +317,7 @@ var rul3s = [...]string{
 	"CapAuthorWord",
 	"AllCapsAuthorWord",
 	"Filius",
+	"FiliusF",
 	"AuthorSuffix",
 	"AuthorPrefixGlued",
 	"AuthorPrefix",
@@ -383,7 +471,7 @@ type Engine struct {
 
 	Buffer string
 	buffer []rune
-	rules  [124]func() bool
+	rules  [168]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -570,7 +658,7 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 SciName <- <(_? Name Tail !.)> */
+		/* 0 SciName <- <(_? Name (PublicationSep Publication)? (TaxonConceptSep ExcludedAuthorship)* (TaxonConceptSep TaxonConcept)? Tail !.)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
@@ -588,17 +676,56 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 				if !_rules[ruleName]() {
 					goto l0
 				}
+				{
+					position4, tokenIndex4 := position, tokenIndex
+					if !_rules[rulePublicationSep]() {
+						goto l4
+					}
+					if !_rules[rulePublication]() {
+						goto l4
+					}
+					goto l5
+				l4:
+					position, tokenIndex = position4, tokenIndex4
+				}
+			l5:
+			l6:
+				{
+					position7, tokenIndex7 := position, tokenIndex
+					if !_rules[ruleTaxonConceptSep]() {
+						goto l7
+					}
+					if !_rules[ruleExcludedAuthorship]() {
+						goto l7
+					}
+					goto l6
+				l7:
+					position, tokenIndex = position7, tokenIndex7
+				}
+				{
+					position8, tokenIndex8 := position, tokenIndex
+					if !_rules[ruleTaxonConceptSep]() {
+						goto l8
+					}
+					if !_rules[ruleTaxonConcept]() {
+						goto l8
+					}
+					goto l9
+				l8:
+					position, tokenIndex = position8, tokenIndex8
+				}
+			l9:
 				if !_rules[ruleTail]() {
 					goto l0
 				}
 				{
-					position4, tokenIndex4 := position, tokenIndex
+					position10, tokenIndex10 := position, tokenIndex
 					if !matchDot() {
-						goto l4
+						goto l10
 					}
 					goto l0
-				l4:
-					position, tokenIndex = position4, tokenIndex4
+				l10:
+					position, tokenIndex = position10, tokenIndex10
 				}
 				add(ruleSciName, position1)
 			}
@@ -610,8385 +737,11851 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 		/* 1 Tail <- <((_ / ';' / ',') .*)?> */
 		func() bool {
 			{
-				position6 := position
+				position12 := position
 				{
-					position7, tokenIndex7 := position, tokenIndex
+					position13, tokenIndex13 := position, tokenIndex
 					{
-						position9, tokenIndex9 := position, tokenIndex
+						position15, tokenIndex15 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l10
+							goto l16
 						}
-						goto l9
-					l10:
-						position, tokenIndex = position9, tokenIndex9
+						goto l15
+					l16:
+						position, tokenIndex = position15, tokenIndex15
 						if buffer[position] != rune(';') {
-							goto l11
+							goto l17
 						}
 						position++
-						goto l9
-					l11:
-						position, tokenIndex = position9, tokenIndex9
+						goto l15
+					l17:
+						position, tokenIndex = position15, tokenIndex15
 						if buffer[position] != rune(',') {
-							goto l7
+							goto l13
 						}
 						position++
 					}
-				l9:
-				l12:
+				l15:
+				l18:
 					{
-						position13, tokenIndex13 := position, tokenIndex
+						position19, tokenIndex19 := position, tokenIndex
 						if !matchDot() {
-							goto l13
+							goto l19
 						}
-						goto l12
-					l13:
-						position, tokenIndex = position13, tokenIndex13
+						goto l18
+					l19:
+						position, tokenIndex = position19, tokenIndex19
 					}
-					goto l8
-				l7:
-					position, tokenIndex = position7, tokenIndex7
+					goto l14
+				l13:
+					position, tokenIndex = position13, tokenIndex13
 				}
-			l8:
-				add(ruleTail, position6)
+			l14:
+				add(ruleTail, position12)
 			}
 			return true
 		},
-		/* 2 Name <- <(NamedHybrid / HybridFormula / SingleName)> */
+		/* 2 Name <- <(NamedHybrid / HybridFormula / NameCultivar / SingleName)> */
 		func() bool {
-			position14, tokenIndex14 := position, tokenIndex
+			position20, tokenIndex20 := position, tokenIndex
 			{
-				position15 := position
+				position21 := position
 				{
-					position16, tokenIndex16 := position, tokenIndex
+					position22, tokenIndex22 := position, tokenIndex
 					if !_rules[ruleNamedHybrid]() {
-						goto l17
+						goto l23
 					}
-					goto l16
-				l17:
-					position, tokenIndex = position16, tokenIndex16
+					goto l22
+				l23:
+					position, tokenIndex = position22, tokenIndex22
 					if !_rules[ruleHybridFormula]() {
-						goto l18
+						goto l24
 					}
-					goto l16
-				l18:
-					position, tokenIndex = position16, tokenIndex16
+					goto l22
+				l24:
+					position, tokenIndex = position22, tokenIndex22
+					if !_rules[ruleNameCultivar]() {
+						goto l25
+					}
+					goto l22
+				l25:
+					position, tokenIndex = position22, tokenIndex22
 					if !_rules[ruleSingleName]() {
-						goto l14
+						goto l20
 					}
 				}
-			l16:
-				add(ruleName, position15)
+			l22:
+				add(ruleName, position21)
 			}
 			return true
-		l14:
-			position, tokenIndex = position14, tokenIndex14
+		l20:
+			position, tokenIndex = position20, tokenIndex20
 			return false
 		},
-		/* 3 HybridFormula <- <(SingleName (_ (HybridFormulaPart / HybridFormulaFull))+)> */
+		/* 3 PublicationSep <- <((_? ',' _?) / (_ PublicationIn _) / (_ &PublicationStart))> */
 		func() bool {
-			position19, tokenIndex19 := position, tokenIndex
+			position26, tokenIndex26 := position, tokenIndex
 			{
-				position20 := position
-				if !_rules[ruleSingleName]() {
-					goto l19
-				}
-				if !_rules[rule_]() {
-					goto l19
-				}
+				position27 := position
 				{
-					position23, tokenIndex23 := position, tokenIndex
-					if !_rules[ruleHybridFormulaPart]() {
-						goto l24
+					position28, tokenIndex28 := position, tokenIndex
+					{
+						position30, tokenIndex30 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l30
+						}
+						goto l31
+					l30:
+						position, tokenIndex = position30, tokenIndex30
 					}
-					goto l23
-				l24:
-					position, tokenIndex = position23, tokenIndex23
-					if !_rules[ruleHybridFormulaFull]() {
-						goto l19
+				l31:
+					if buffer[position] != rune(',') {
+						goto l29
 					}
-				}
-			l23:
-			l21:
-				{
-					position22, tokenIndex22 := position, tokenIndex
+					position++
+					{
+						position32, tokenIndex32 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l32
+						}
+						goto l33
+					l32:
+						position, tokenIndex = position32, tokenIndex32
+					}
+				l33:
+					goto l28
+				l29:
+					position, tokenIndex = position28, tokenIndex28
+					if !_rules[rule_]() {
+						goto l34
+					}
+					if !_rules[rulePublicationIn]() {
+						goto l34
+					}
+					if !_rules[rule_]() {
+						goto l34
+					}
+					goto l28
+				l34:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[rule_]() {
-						goto l22
+						goto l26
 					}
 					{
-						position25, tokenIndex25 := position, tokenIndex
-						if !_rules[ruleHybridFormulaPart]() {
+						position35, tokenIndex35 := position, tokenIndex
+						if !_rules[rulePublicationStart]() {
 							goto l26
 						}
-						goto l25
-					l26:
-						position, tokenIndex = position25, tokenIndex25
-						if !_rules[ruleHybridFormulaFull]() {
-							goto l22
-						}
+						position, tokenIndex = position35, tokenIndex35
 					}
-				l25:
-					goto l21
-				l22:
-					position, tokenIndex = position22, tokenIndex22
 				}
-				add(ruleHybridFormula, position20)
+			l28:
+				add(rulePublicationSep, position27)
 			}
 			return true
-		l19:
-			position, tokenIndex = position19, tokenIndex19
+		l26:
+			position, tokenIndex = position26, tokenIndex26
 			return false
 		},
-		/* 4 HybridFormulaFull <- <(HybridChar (_ SingleName)?)> */
+		/* 4 PublicationIn <- <((('i' 'n') / ('I' 'n')) ':'?)> */
 		func() bool {
-			position27, tokenIndex27 := position, tokenIndex
+			position36, tokenIndex36 := position, tokenIndex
 			{
-				position28 := position
-				if !_rules[ruleHybridChar]() {
-					goto l27
-				}
+				position37 := position
 				{
-					position29, tokenIndex29 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l29
+					position38, tokenIndex38 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l39
 					}
-					if !_rules[ruleSingleName]() {
-						goto l29
+					position++
+					if buffer[position] != rune('n') {
+						goto l39
 					}
-					goto l30
-				l29:
-					position, tokenIndex = position29, tokenIndex29
-				}
-			l30:
-				add(ruleHybridFormulaFull, position28)
-			}
-			return true
-		l27:
-			position, tokenIndex = position27, tokenIndex27
-			return false
-		},
-		/* 5 HybridFormulaPart <- <(HybridChar _ SpeciesEpithet (_ InfraspGroup)?)> */
-		func() bool {
-			position31, tokenIndex31 := position, tokenIndex
-			{
-				position32 := position
-				if !_rules[ruleHybridChar]() {
-					goto l31
-				}
-				if !_rules[rule_]() {
-					goto l31
-				}
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l31
-				}
-				{
-					position33, tokenIndex33 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l33
+					position++
+					goto l38
+				l39:
+					position, tokenIndex = position38, tokenIndex38
+					if buffer[position] != rune('I') {
+						goto l36
 					}
-					if !_rules[ruleInfraspGroup]() {
-						goto l33
+					position++
+					if buffer[position] != rune('n') {
+						goto l36
 					}
-					goto l34
-				l33:
-					position, tokenIndex = position33, tokenIndex33
+					position++
 				}
-			l34:
-				add(ruleHybridFormulaPart, position32)
-			}
-			return true
-		l31:
-			position, tokenIndex = position31, tokenIndex31
-			return false
-		},
-		/* 6 NamedHybrid <- <(NamedGenusHybrid / NamedSpeciesHybrid)> */
-		func() bool {
-			position35, tokenIndex35 := position, tokenIndex
-			{
-				position36 := position
+			l38:
 				{
-					position37, tokenIndex37 := position, tokenIndex
-					if !_rules[ruleNamedGenusHybrid]() {
-						goto l38
-					}
-					goto l37
-				l38:
-					position, tokenIndex = position37, tokenIndex37
-					if !_rules[ruleNamedSpeciesHybrid]() {
-						goto l35
+					position40, tokenIndex40 := position, tokenIndex
+					if buffer[position] != rune(':') {
+						goto l40
 					}
+					position++
+					goto l41
+				l40:
+					position, tokenIndex = position40, tokenIndex40
 				}
-			l37:
-				add(ruleNamedHybrid, position36)
+			l41:
+				add(rulePublicationIn, position37)
 			}
 			return true
-		l35:
-			position, tokenIndex = position35, tokenIndex35
+		l36:
+			position, tokenIndex = position36, tokenIndex36
 			return false
 		},
-		/* 7 NamedSpeciesHybrid <- <(GenusWord (_ SubGenus)? (_ Comparison)? _ HybridChar _? SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 5 PublicationAhead <- <(PublicationIn _ Publication)> */
 		func() bool {
-			position39, tokenIndex39 := position, tokenIndex
+			position42, tokenIndex42 := position, tokenIndex
 			{
-				position40 := position
-				if !_rules[ruleGenusWord]() {
-					goto l39
-				}
-				{
-					position41, tokenIndex41 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l41
-					}
-					if !_rules[ruleSubGenus]() {
-						goto l41
-					}
+				position43 := position
+				if !_rules[rulePublicationIn]() {
 					goto l42
-				l41:
-					position, tokenIndex = position41, tokenIndex41
-				}
-			l42:
-				{
-					position43, tokenIndex43 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l43
-					}
-					if !_rules[ruleComparison]() {
-						goto l43
-					}
-					goto l44
-				l43:
-					position, tokenIndex = position43, tokenIndex43
 				}
-			l44:
 				if !_rules[rule_]() {
-					goto l39
-				}
-				if !_rules[ruleHybridChar]() {
-					goto l39
-				}
-				{
-					position45, tokenIndex45 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l45
-					}
-					goto l46
-				l45:
-					position, tokenIndex = position45, tokenIndex45
-				}
-			l46:
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l39
+					goto l42
 				}
-				{
-					position47, tokenIndex47 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l47
-					}
-					if !_rules[ruleInfraspGroup]() {
-						goto l47
-					}
-					goto l48
-				l47:
-					position, tokenIndex = position47, tokenIndex47
+				if !_rules[rulePublication]() {
+					goto l42
 				}
-			l48:
-				add(ruleNamedSpeciesHybrid, position40)
+				add(rulePublicationAhead, position43)
 			}
 			return true
-		l39:
-			position, tokenIndex = position39, tokenIndex39
+		l42:
+			position, tokenIndex = position42, tokenIndex42
 			return false
 		},
-		/* 8 NamedGenusHybrid <- <(HybridChar _? SingleName)> */
+		/* 6 PublicationStart <- <(PubTitle (_? ','? _? PubEdition)? _? ','? _? (PubVolume / ':'))> */
 		func() bool {
-			position49, tokenIndex49 := position, tokenIndex
+			position44, tokenIndex44 := position, tokenIndex
 			{
-				position50 := position
-				if !_rules[ruleHybridChar]() {
-					goto l49
+				position45 := position
+				if !_rules[rulePubTitle]() {
+					goto l44
 				}
 				{
-					position51, tokenIndex51 := position, tokenIndex
-					if !_rules[rule_]() {
+					position46, tokenIndex46 := position, tokenIndex
+					{
+						position48, tokenIndex48 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l48
+						}
+						goto l49
+					l48:
+						position, tokenIndex = position48, tokenIndex48
+					}
+				l49:
+					{
+						position50, tokenIndex50 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l50
+						}
+						position++
 						goto l51
+					l50:
+						position, tokenIndex = position50, tokenIndex50
 					}
-					goto l52
 				l51:
-					position, tokenIndex = position51, tokenIndex51
-				}
-			l52:
-				if !_rules[ruleSingleName]() {
-					goto l49
-				}
-				add(ruleNamedGenusHybrid, position50)
-			}
-			return true
-		l49:
-			position, tokenIndex = position49, tokenIndex49
-			return false
-		},
-		/* 9 SingleName <- <(NameComp / NameApprox / NameSpecies / NameUninomial)> */
-		func() bool {
-			position53, tokenIndex53 := position, tokenIndex
-			{
-				position54 := position
-				{
-					position55, tokenIndex55 := position, tokenIndex
-					if !_rules[ruleNameComp]() {
-						goto l56
+					{
+						position52, tokenIndex52 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l52
+						}
+						goto l53
+					l52:
+						position, tokenIndex = position52, tokenIndex52
 					}
-					goto l55
-				l56:
-					position, tokenIndex = position55, tokenIndex55
-					if !_rules[ruleNameApprox]() {
-						goto l57
+				l53:
+					if !_rules[rulePubEdition]() {
+						goto l46
 					}
-					goto l55
-				l57:
-					position, tokenIndex = position55, tokenIndex55
-					if !_rules[ruleNameSpecies]() {
-						goto l58
+					goto l47
+				l46:
+					position, tokenIndex = position46, tokenIndex46
+				}
+			l47:
+				{
+					position54, tokenIndex54 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l54
 					}
 					goto l55
-				l58:
-					position, tokenIndex = position55, tokenIndex55
-					if !_rules[ruleNameUninomial]() {
-						goto l53
-					}
+				l54:
+					position, tokenIndex = position54, tokenIndex54
 				}
 			l55:
-				add(ruleSingleName, position54)
-			}
-			return true
-		l53:
-			position, tokenIndex = position53, tokenIndex53
-			return false
-		},
-		/* 10 NameUninomial <- <(UninomialCombo / Uninomial)> */
-		func() bool {
-			position59, tokenIndex59 := position, tokenIndex
-			{
-				position60 := position
 				{
-					position61, tokenIndex61 := position, tokenIndex
-					if !_rules[ruleUninomialCombo]() {
-						goto l62
-					}
-					goto l61
-				l62:
-					position, tokenIndex = position61, tokenIndex61
-					if !_rules[ruleUninomial]() {
-						goto l59
+					position56, tokenIndex56 := position, tokenIndex
+					if buffer[position] != rune(',') {
+						goto l56
 					}
+					position++
+					goto l57
+				l56:
+					position, tokenIndex = position56, tokenIndex56
 				}
-			l61:
-				add(ruleNameUninomial, position60)
-			}
-			return true
-		l59:
-			position, tokenIndex = position59, tokenIndex59
-			return false
-		},
-		/* 11 NameApprox <- <(GenusWord (_ SpeciesEpithet)? _ Approximation ApproxNameIgnored)> */
-		func() bool {
-			position63, tokenIndex63 := position, tokenIndex
-			{
-				position64 := position
-				if !_rules[ruleGenusWord]() {
-					goto l63
-				}
+			l57:
 				{
-					position65, tokenIndex65 := position, tokenIndex
+					position58, tokenIndex58 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l65
-					}
-					if !_rules[ruleSpeciesEpithet]() {
-						goto l65
+						goto l58
 					}
-					goto l66
-				l65:
-					position, tokenIndex = position65, tokenIndex65
-				}
-			l66:
-				if !_rules[rule_]() {
-					goto l63
-				}
-				if !_rules[ruleApproximation]() {
-					goto l63
-				}
-				if !_rules[ruleApproxNameIgnored]() {
-					goto l63
-				}
-				add(ruleNameApprox, position64)
-			}
-			return true
-		l63:
-			position, tokenIndex = position63, tokenIndex63
-			return false
-		},
-		/* 12 NameComp <- <(GenusWord _ Comparison (_ SpeciesEpithet)?)> */
-		func() bool {
-			position67, tokenIndex67 := position, tokenIndex
-			{
-				position68 := position
-				if !_rules[ruleGenusWord]() {
-					goto l67
-				}
-				if !_rules[rule_]() {
-					goto l67
-				}
-				if !_rules[ruleComparison]() {
-					goto l67
+					goto l59
+				l58:
+					position, tokenIndex = position58, tokenIndex58
 				}
+			l59:
 				{
-					position69, tokenIndex69 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l69
+					position60, tokenIndex60 := position, tokenIndex
+					if !_rules[rulePubVolume]() {
+						goto l61
 					}
-					if !_rules[ruleSpeciesEpithet]() {
-						goto l69
+					goto l60
+				l61:
+					position, tokenIndex = position60, tokenIndex60
+					if buffer[position] != rune(':') {
+						goto l44
 					}
-					goto l70
-				l69:
-					position, tokenIndex = position69, tokenIndex69
+					position++
 				}
-			l70:
-				add(ruleNameComp, position68)
+			l60:
+				add(rulePublicationStart, position45)
 			}
 			return true
-		l67:
-			position, tokenIndex = position67, tokenIndex67
+		l44:
+			position, tokenIndex = position44, tokenIndex44
 			return false
 		},
-		/* 13 NameSpecies <- <(GenusWord (_? (SubGenus / SubGenusOrSuperspecies))? _ SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 7 Publication <- <(PubTitle (_? ','? _? PubEdition)? (_? ','? _? PubVolumeIssue)? _? ':' _? PubPages (_? ('.' / ',')? _? PubYear)? &(SpaceCharEOI / ',' / ';'))> */
 		func() bool {
-			position71, tokenIndex71 := position, tokenIndex
+			position62, tokenIndex62 := position, tokenIndex
 			{
-				position72 := position
-				if !_rules[ruleGenusWord]() {
-					goto l71
+				position63 := position
+				if !_rules[rulePubTitle]() {
+					goto l62
 				}
 				{
-					position73, tokenIndex73 := position, tokenIndex
+					position64, tokenIndex64 := position, tokenIndex
 					{
-						position75, tokenIndex75 := position, tokenIndex
+						position66, tokenIndex66 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l75
+							goto l66
 						}
-						goto l76
-					l75:
-						position, tokenIndex = position75, tokenIndex75
+						goto l67
+					l66:
+						position, tokenIndex = position66, tokenIndex66
 					}
-				l76:
+				l67:
 					{
-						position77, tokenIndex77 := position, tokenIndex
-						if !_rules[ruleSubGenus]() {
-							goto l78
+						position68, tokenIndex68 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l68
 						}
-						goto l77
-					l78:
-						position, tokenIndex = position77, tokenIndex77
-						if !_rules[ruleSubGenusOrSuperspecies]() {
-							goto l73
+						position++
+						goto l69
+					l68:
+						position, tokenIndex = position68, tokenIndex68
+					}
+				l69:
+					{
+						position70, tokenIndex70 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l70
 						}
+						goto l71
+					l70:
+						position, tokenIndex = position70, tokenIndex70
 					}
-				l77:
-					goto l74
-				l73:
-					position, tokenIndex = position73, tokenIndex73
-				}
-			l74:
-				if !_rules[rule_]() {
-					goto l71
-				}
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l71
+				l71:
+					if !_rules[rulePubEdition]() {
+						goto l64
+					}
+					goto l65
+				l64:
+					position, tokenIndex = position64, tokenIndex64
 				}
+			l65:
 				{
-					position79, tokenIndex79 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l79
+					position72, tokenIndex72 := position, tokenIndex
+					{
+						position74, tokenIndex74 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l74
+						}
+						goto l75
+					l74:
+						position, tokenIndex = position74, tokenIndex74
 					}
-					if !_rules[ruleInfraspGroup]() {
+				l75:
+					{
+						position76, tokenIndex76 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l76
+						}
+						position++
+						goto l77
+					l76:
+						position, tokenIndex = position76, tokenIndex76
+					}
+				l77:
+					{
+						position78, tokenIndex78 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l78
+						}
 						goto l79
+					l78:
+						position, tokenIndex = position78, tokenIndex78
 					}
-					goto l80
 				l79:
-					position, tokenIndex = position79, tokenIndex79
-				}
-			l80:
-				add(ruleNameSpecies, position72)
-			}
-			return true
-		l71:
-			position, tokenIndex = position71, tokenIndex71
-			return false
-		},
-		/* 14 GenusWord <- <((AbbrGenus / UninomialWord) !(_ AuthorWord))> */
-		func() bool {
-			position81, tokenIndex81 := position, tokenIndex
-			{
-				position82 := position
-				{
-					position83, tokenIndex83 := position, tokenIndex
-					if !_rules[ruleAbbrGenus]() {
-						goto l84
-					}
-					goto l83
-				l84:
-					position, tokenIndex = position83, tokenIndex83
-					if !_rules[ruleUninomialWord]() {
-						goto l81
+					if !_rules[rulePubVolumeIssue]() {
+						goto l72
 					}
+					goto l73
+				l72:
+					position, tokenIndex = position72, tokenIndex72
 				}
-			l83:
+			l73:
 				{
-					position85, tokenIndex85 := position, tokenIndex
+					position80, tokenIndex80 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l85
-					}
-					if !_rules[ruleAuthorWord]() {
-						goto l85
+						goto l80
 					}
 					goto l81
-				l85:
-					position, tokenIndex = position85, tokenIndex85
+				l80:
+					position, tokenIndex = position80, tokenIndex80
 				}
-				add(ruleGenusWord, position82)
-			}
-			return true
-		l81:
-			position, tokenIndex = position81, tokenIndex81
-			return false
-		},
-		/* 15 InfraspGroup <- <(InfraspEpithet (_ InfraspEpithet)? (_ InfraspEpithet)?)> */
-		func() bool {
-			position86, tokenIndex86 := position, tokenIndex
-			{
-				position87 := position
-				if !_rules[ruleInfraspEpithet]() {
-					goto l86
+			l81:
+				if buffer[position] != rune(':') {
+					goto l62
 				}
+				position++
 				{
-					position88, tokenIndex88 := position, tokenIndex
+					position82, tokenIndex82 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l88
+						goto l82
 					}
-					if !_rules[ruleInfraspEpithet]() {
-						goto l88
-					}
-					goto l89
-				l88:
-					position, tokenIndex = position88, tokenIndex88
+					goto l83
+				l82:
+					position, tokenIndex = position82, tokenIndex82
+				}
+			l83:
+				if !_rules[rulePubPages]() {
+					goto l62
 				}
-			l89:
 				{
-					position90, tokenIndex90 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l90
+					position84, tokenIndex84 := position, tokenIndex
+					{
+						position86, tokenIndex86 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l86
+						}
+						goto l87
+					l86:
+						position, tokenIndex = position86, tokenIndex86
 					}
-					if !_rules[ruleInfraspEpithet]() {
-						goto l90
+				l87:
+					{
+						position88, tokenIndex88 := position, tokenIndex
+						{
+							position90, tokenIndex90 := position, tokenIndex
+							if buffer[position] != rune('.') {
+								goto l91
+							}
+							position++
+							goto l90
+						l91:
+							position, tokenIndex = position90, tokenIndex90
+							if buffer[position] != rune(',') {
+								goto l88
+							}
+							position++
+						}
+					l90:
+						goto l89
+					l88:
+						position, tokenIndex = position88, tokenIndex88
+					}
+				l89:
+					{
+						position92, tokenIndex92 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l92
+						}
+						goto l93
+					l92:
+						position, tokenIndex = position92, tokenIndex92
+					}
+				l93:
+					if !_rules[rulePubYear]() {
+						goto l84
 					}
-					goto l91
-				l90:
-					position, tokenIndex = position90, tokenIndex90
+					goto l85
+				l84:
+					position, tokenIndex = position84, tokenIndex84
 				}
-			l91:
-				add(ruleInfraspGroup, position87)
-			}
-			return true
-		l86:
-			position, tokenIndex = position86, tokenIndex86
-			return false
-		},
-		/* 16 InfraspEpithet <- <((Rank _?)? !AuthorEx Word (_ Authorship)?)> */
-		func() bool {
-			position92, tokenIndex92 := position, tokenIndex
-			{
-				position93 := position
+			l85:
 				{
 					position94, tokenIndex94 := position, tokenIndex
-					if !_rules[ruleRank]() {
-						goto l94
-					}
 					{
-						position96, tokenIndex96 := position, tokenIndex
-						if !_rules[rule_]() {
+						position95, tokenIndex95 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
 							goto l96
 						}
-						goto l97
+						goto l95
 					l96:
-						position, tokenIndex = position96, tokenIndex96
+						position, tokenIndex = position95, tokenIndex95
+						if buffer[position] != rune(',') {
+							goto l97
+						}
+						position++
+						goto l95
+					l97:
+						position, tokenIndex = position95, tokenIndex95
+						if buffer[position] != rune(';') {
+							goto l62
+						}
+						position++
 					}
-				l97:
-					goto l95
-				l94:
+				l95:
 					position, tokenIndex = position94, tokenIndex94
 				}
-			l95:
-				{
-					position98, tokenIndex98 := position, tokenIndex
-					if !_rules[ruleAuthorEx]() {
-						goto l98
-					}
-					goto l92
-				l98:
-					position, tokenIndex = position98, tokenIndex98
-				}
-				if !_rules[ruleWord]() {
-					goto l92
+				add(rulePublication, position63)
+			}
+			return true
+		l62:
+			position, tokenIndex = position62, tokenIndex62
+			return false
+		},
+		/* 8 PubTitle <- <(PubTitleWord (_? PubTitleWord)*)> */
+		func() bool {
+			position98, tokenIndex98 := position, tokenIndex
+			{
+				position99 := position
+				if !_rules[rulePubTitleWord]() {
+					goto l98
 				}
+			l100:
 				{
-					position99, tokenIndex99 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l99
+					position101, tokenIndex101 := position, tokenIndex
+					{
+						position102, tokenIndex102 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l102
+						}
+						goto l103
+					l102:
+						position, tokenIndex = position102, tokenIndex102
 					}
-					if !_rules[ruleAuthorship]() {
-						goto l99
+				l103:
+					if !_rules[rulePubTitleWord]() {
+						goto l101
 					}
 					goto l100
-				l99:
-					position, tokenIndex = position99, tokenIndex99
+				l101:
+					position, tokenIndex = position101, tokenIndex101
 				}
-			l100:
-				add(ruleInfraspEpithet, position93)
+				add(rulePubTitle, position99)
 			}
 			return true
-		l92:
-			position, tokenIndex = position92, tokenIndex92
+		l98:
+			position, tokenIndex = position98, tokenIndex98
 			return false
 		},
-		/* 17 SpeciesEpithet <- <(!AuthorEx Word (_? Authorship)?)> */
+		/* 9 PubTitleWord <- <(!PubEdition ((AuthorUpperChar (AuthorLowerChar / AuthorUpperChar)* '.'?) / (AuthorLowerChar+ '.') / '&'))> */
 		func() bool {
-			position101, tokenIndex101 := position, tokenIndex
+			position104, tokenIndex104 := position, tokenIndex
 			{
-				position102 := position
+				position105 := position
 				{
-					position103, tokenIndex103 := position, tokenIndex
-					if !_rules[ruleAuthorEx]() {
-						goto l103
+					position106, tokenIndex106 := position, tokenIndex
+					if !_rules[rulePubEdition]() {
+						goto l106
 					}
-					goto l101
-				l103:
-					position, tokenIndex = position103, tokenIndex103
-				}
-				if !_rules[ruleWord]() {
-					goto l101
+					goto l104
+				l106:
+					position, tokenIndex = position106, tokenIndex106
 				}
 				{
-					position104, tokenIndex104 := position, tokenIndex
+					position107, tokenIndex107 := position, tokenIndex
+					if !_rules[ruleAuthorUpperChar]() {
+						goto l108
+					}
+				l109:
 					{
-						position106, tokenIndex106 := position, tokenIndex
-						if !_rules[rule_]() {
-							goto l106
+						position110, tokenIndex110 := position, tokenIndex
+						{
+							position111, tokenIndex111 := position, tokenIndex
+							if !_rules[ruleAuthorLowerChar]() {
+								goto l112
+							}
+							goto l111
+						l112:
+							position, tokenIndex = position111, tokenIndex111
+							if !_rules[ruleAuthorUpperChar]() {
+								goto l110
+							}
 						}
-						goto l107
-					l106:
-						position, tokenIndex = position106, tokenIndex106
+					l111:
+						goto l109
+					l110:
+						position, tokenIndex = position110, tokenIndex110
 					}
-				l107:
-					if !_rules[ruleAuthorship]() {
+					{
+						position113, tokenIndex113 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l113
+						}
+						position++
+						goto l114
+					l113:
+						position, tokenIndex = position113, tokenIndex113
+					}
+				l114:
+					goto l107
+				l108:
+					position, tokenIndex = position107, tokenIndex107
+					if !_rules[ruleAuthorLowerChar]() {
+						goto l115
+					}
+				l116:
+					{
+						position117, tokenIndex117 := position, tokenIndex
+						if !_rules[ruleAuthorLowerChar]() {
+							goto l117
+						}
+						goto l116
+					l117:
+						position, tokenIndex = position117, tokenIndex117
+					}
+					if buffer[position] != rune('.') {
+						goto l115
+					}
+					position++
+					goto l107
+				l115:
+					position, tokenIndex = position107, tokenIndex107
+					if buffer[position] != rune('&') {
 						goto l104
 					}
-					goto l105
-				l104:
-					position, tokenIndex = position104, tokenIndex104
+					position++
 				}
-			l105:
-				add(ruleSpeciesEpithet, position102)
+			l107:
+				add(rulePubTitleWord, position105)
 			}
 			return true
-		l101:
-			position, tokenIndex = position101, tokenIndex101
+		l104:
+			position, tokenIndex = position104, tokenIndex104
 			return false
 		},
-		/* 18 Comparison <- <('c' 'f' '.'?)> */
+		/* 10 PubEdition <- <('e' 'd' '.' _? Nums+)> */
 		func() bool {
-			position108, tokenIndex108 := position, tokenIndex
+			position118, tokenIndex118 := position, tokenIndex
 			{
-				position109 := position
-				if buffer[position] != rune('c') {
-					goto l108
+				position119 := position
+				if buffer[position] != rune('e') {
+					goto l118
 				}
 				position++
-				if buffer[position] != rune('f') {
-					goto l108
+				if buffer[position] != rune('d') {
+					goto l118
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l118
 				}
 				position++
 				{
-					position110, tokenIndex110 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l110
+					position120, tokenIndex120 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l120
 					}
-					position++
-					goto l111
-				l110:
-					position, tokenIndex = position110, tokenIndex110
+					goto l121
+				l120:
+					position, tokenIndex = position120, tokenIndex120
+				}
+			l121:
+				if !_rules[ruleNums]() {
+					goto l118
+				}
+			l122:
+				{
+					position123, tokenIndex123 := position, tokenIndex
+					if !_rules[ruleNums]() {
+						goto l123
+					}
+					goto l122
+				l123:
+					position, tokenIndex = position123, tokenIndex123
 				}
-			l111:
-				add(ruleComparison, position109)
+				add(rulePubEdition, position119)
 			}
 			return true
-		l108:
-			position, tokenIndex = position108, tokenIndex108
+		l118:
+			position, tokenIndex = position118, tokenIndex118
 			return false
 		},
-		/* 19 Rank <- <((RankForma / RankVar / RankSsp / RankOther / RankOtherUncommon / RankAgamo / RankNotho) (_? LowerGreek ('.' / &SpaceCharEOI))?)> */
+		/* 11 PubVolumeIssue <- <(PubVolume (_? '(' _? PubIssue _? ')')?)> */
 		func() bool {
-			position112, tokenIndex112 := position, tokenIndex
+			position124, tokenIndex124 := position, tokenIndex
 			{
-				position113 := position
-				{
-					position114, tokenIndex114 := position, tokenIndex
-					if !_rules[ruleRankForma]() {
-						goto l115
-					}
-					goto l114
-				l115:
-					position, tokenIndex = position114, tokenIndex114
-					if !_rules[ruleRankVar]() {
-						goto l116
-					}
-					goto l114
-				l116:
-					position, tokenIndex = position114, tokenIndex114
-					if !_rules[ruleRankSsp]() {
-						goto l117
-					}
-					goto l114
-				l117:
-					position, tokenIndex = position114, tokenIndex114
-					if !_rules[ruleRankOther]() {
-						goto l118
-					}
-					goto l114
-				l118:
-					position, tokenIndex = position114, tokenIndex114
-					if !_rules[ruleRankOtherUncommon]() {
-						goto l119
-					}
-					goto l114
-				l119:
-					position, tokenIndex = position114, tokenIndex114
-					if !_rules[ruleRankAgamo]() {
-						goto l120
-					}
-					goto l114
-				l120:
-					position, tokenIndex = position114, tokenIndex114
-					if !_rules[ruleRankNotho]() {
-						goto l112
-					}
+				position125 := position
+				if !_rules[rulePubVolume]() {
+					goto l124
 				}
-			l114:
 				{
-					position121, tokenIndex121 := position, tokenIndex
+					position126, tokenIndex126 := position, tokenIndex
 					{
-						position123, tokenIndex123 := position, tokenIndex
+						position128, tokenIndex128 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l123
+							goto l128
 						}
-						goto l124
-					l123:
-						position, tokenIndex = position123, tokenIndex123
+						goto l129
+					l128:
+						position, tokenIndex = position128, tokenIndex128
 					}
-				l124:
-					if !_rules[ruleLowerGreek]() {
-						goto l121
+				l129:
+					if buffer[position] != rune('(') {
+						goto l126
 					}
+					position++
 					{
-						position125, tokenIndex125 := position, tokenIndex
-						if buffer[position] != rune('.') {
-							goto l126
+						position130, tokenIndex130 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l130
 						}
-						position++
-						goto l125
-					l126:
-						position, tokenIndex = position125, tokenIndex125
-						{
-							position127, tokenIndex127 := position, tokenIndex
-							if !_rules[ruleSpaceCharEOI]() {
-								goto l121
-							}
-							position, tokenIndex = position127, tokenIndex127
+						goto l131
+					l130:
+						position, tokenIndex = position130, tokenIndex130
+					}
+				l131:
+					if !_rules[rulePubIssue]() {
+						goto l126
+					}
+					{
+						position132, tokenIndex132 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l132
 						}
+						goto l133
+					l132:
+						position, tokenIndex = position132, tokenIndex132
 					}
-				l125:
-					goto l122
-				l121:
-					position, tokenIndex = position121, tokenIndex121
+				l133:
+					if buffer[position] != rune(')') {
+						goto l126
+					}
+					position++
+					goto l127
+				l126:
+					position, tokenIndex = position126, tokenIndex126
 				}
-			l122:
-				add(ruleRank, position113)
+			l127:
+				add(rulePubVolumeIssue, position125)
 			}
 			return true
-		l112:
-			position, tokenIndex = position112, tokenIndex112
+		l124:
+			position, tokenIndex = position124, tokenIndex124
 			return false
 		},
-		/* 20 RankNotho <- <((('n' 'o' 't' 'h' 'o' (('v' 'a' 'r') / ('f' 'o') / 'f' / ('s' 'u' 'b' 's' 'p') / ('s' 's' 'p') / ('s' 'p') / ('m' 'o' 'r' 't' 'h') / ('s' 'u' 'p' 's' 'p') / ('s' 'u'))) / ('n' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 12 PubVolume <- <(!YearNum Nums+)> */
 		func() bool {
-			position128, tokenIndex128 := position, tokenIndex
+			position134, tokenIndex134 := position, tokenIndex
 			{
-				position129 := position
+				position135 := position
 				{
-					position130, tokenIndex130 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l131
+					position136, tokenIndex136 := position, tokenIndex
+					if !_rules[ruleYearNum]() {
+						goto l136
 					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l131
+					goto l134
+				l136:
+					position, tokenIndex = position136, tokenIndex136
+				}
+				if !_rules[ruleNums]() {
+					goto l134
+				}
+			l137:
+				{
+					position138, tokenIndex138 := position, tokenIndex
+					if !_rules[ruleNums]() {
+						goto l138
 					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l131
+					goto l137
+				l138:
+					position, tokenIndex = position138, tokenIndex138
+				}
+				add(rulePubVolume, position135)
+			}
+			return true
+		l134:
+			position, tokenIndex = position134, tokenIndex134
+			return false
+		},
+		/* 13 PubIssue <- <(Nums+ (Dash Nums+)?)> */
+		func() bool {
+			position139, tokenIndex139 := position, tokenIndex
+			{
+				position140 := position
+				if !_rules[ruleNums]() {
+					goto l139
+				}
+			l141:
+				{
+					position142, tokenIndex142 := position, tokenIndex
+					if !_rules[ruleNums]() {
+						goto l142
 					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l131
+					goto l141
+				l142:
+					position, tokenIndex = position142, tokenIndex142
+				}
+				{
+					position143, tokenIndex143 := position, tokenIndex
+					if !_rules[ruleDash]() {
+						goto l143
 					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l131
+					if !_rules[ruleNums]() {
+						goto l143
 					}
-					position++
+				l145:
 					{
-						position132, tokenIndex132 := position, tokenIndex
-						if buffer[position] != rune('v') {
-							goto l133
+						position146, tokenIndex146 := position, tokenIndex
+						if !_rules[ruleNums]() {
+							goto l146
 						}
-						position++
-						if buffer[position] != rune('a') {
-							goto l133
-						}
-						position++
-						if buffer[position] != rune('r') {
-							goto l133
-						}
-						position++
-						goto l132
-					l133:
-						position, tokenIndex = position132, tokenIndex132
-						if buffer[position] != rune('f') {
-							goto l134
+						goto l145
+					l146:
+						position, tokenIndex = position146, tokenIndex146
+					}
+					goto l144
+				l143:
+					position, tokenIndex = position143, tokenIndex143
+				}
+			l144:
+				add(rulePubIssue, position140)
+			}
+			return true
+		l139:
+			position, tokenIndex = position139, tokenIndex139
+			return false
+		},
+		/* 14 PubPages <- <(Nums+ (Dash Nums+)?)> */
+		func() bool {
+			position147, tokenIndex147 := position, tokenIndex
+			{
+				position148 := position
+				if !_rules[ruleNums]() {
+					goto l147
+				}
+			l149:
+				{
+					position150, tokenIndex150 := position, tokenIndex
+					if !_rules[ruleNums]() {
+						goto l150
+					}
+					goto l149
+				l150:
+					position, tokenIndex = position150, tokenIndex150
+				}
+				{
+					position151, tokenIndex151 := position, tokenIndex
+					if !_rules[ruleDash]() {
+						goto l151
+					}
+					if !_rules[ruleNums]() {
+						goto l151
+					}
+				l153:
+					{
+						position154, tokenIndex154 := position, tokenIndex
+						if !_rules[ruleNums]() {
+							goto l154
 						}
-						position++
-						if buffer[position] != rune('o') {
-							goto l134
+						goto l153
+					l154:
+						position, tokenIndex = position154, tokenIndex154
+					}
+					goto l152
+				l151:
+					position, tokenIndex = position151, tokenIndex151
+				}
+			l152:
+				add(rulePubPages, position148)
+			}
+			return true
+		l147:
+			position, tokenIndex = position147, tokenIndex147
+			return false
+		},
+		/* 15 PubYear <- <(('(' _? YearNum _? ')') / YearNum)> */
+		func() bool {
+			position155, tokenIndex155 := position, tokenIndex
+			{
+				position156 := position
+				{
+					position157, tokenIndex157 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l158
+					}
+					position++
+					{
+						position159, tokenIndex159 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l159
 						}
-						position++
-						goto l132
-					l134:
-						position, tokenIndex = position132, tokenIndex132
-						if buffer[position] != rune('f') {
-							goto l135
+						goto l160
+					l159:
+						position, tokenIndex = position159, tokenIndex159
+					}
+				l160:
+					if !_rules[ruleYearNum]() {
+						goto l158
+					}
+					{
+						position161, tokenIndex161 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l161
 						}
-						position++
-						goto l132
-					l135:
-						position, tokenIndex = position132, tokenIndex132
-						if buffer[position] != rune('s') {
-							goto l136
+						goto l162
+					l161:
+						position, tokenIndex = position161, tokenIndex161
+					}
+				l162:
+					if buffer[position] != rune(')') {
+						goto l158
+					}
+					position++
+					goto l157
+				l158:
+					position, tokenIndex = position157, tokenIndex157
+					if !_rules[ruleYearNum]() {
+						goto l155
+					}
+				}
+			l157:
+				add(rulePubYear, position156)
+			}
+			return true
+		l155:
+			position, tokenIndex = position155, tokenIndex155
+			return false
+		},
+		/* 16 TaxonConceptSep <- <((_? ',' _?) / _)> */
+		func() bool {
+			position163, tokenIndex163 := position, tokenIndex
+			{
+				position164 := position
+				{
+					position165, tokenIndex165 := position, tokenIndex
+					{
+						position167, tokenIndex167 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l167
 						}
-						position++
-						if buffer[position] != rune('u') {
-							goto l136
+						goto l168
+					l167:
+						position, tokenIndex = position167, tokenIndex167
+					}
+				l168:
+					if buffer[position] != rune(',') {
+						goto l166
+					}
+					position++
+					{
+						position169, tokenIndex169 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l169
 						}
-						position++
-						if buffer[position] != rune('b') {
-							goto l136
+						goto l170
+					l169:
+						position, tokenIndex = position169, tokenIndex169
+					}
+				l170:
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
+					if !_rules[rule_]() {
+						goto l163
+					}
+				}
+			l165:
+				add(ruleTaxonConceptSep, position164)
+			}
+			return true
+		l163:
+			position, tokenIndex = position163, tokenIndex163
+			return false
+		},
+		/* 17 TaxonConcept <- <(('(' _? TaxonConceptBodyInParens _? ')') / TaxonConceptBody)> */
+		func() bool {
+			position171, tokenIndex171 := position, tokenIndex
+			{
+				position172 := position
+				{
+					position173, tokenIndex173 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l174
+					}
+					position++
+					{
+						position175, tokenIndex175 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l175
+						}
+						goto l176
+					l175:
+						position, tokenIndex = position175, tokenIndex175
+					}
+				l176:
+					if !_rules[ruleTaxonConceptBodyInParens]() {
+						goto l174
+					}
+					{
+						position177, tokenIndex177 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l177
+						}
+						goto l178
+					l177:
+						position, tokenIndex = position177, tokenIndex177
+					}
+				l178:
+					if buffer[position] != rune(')') {
+						goto l174
+					}
+					position++
+					goto l173
+				l174:
+					position, tokenIndex = position173, tokenIndex173
+					if !_rules[ruleTaxonConceptBody]() {
+						goto l171
+					}
+				}
+			l173:
+				add(ruleTaxonConcept, position172)
+			}
+			return true
+		l171:
+			position, tokenIndex = position171, tokenIndex171
+			return false
+		},
+		/* 18 TaxonConceptBody <- <((TaxonConceptQualifier / (TaxonConceptSensu _? Authorship)) (TaxonConceptSep ExcludedAuthorship)*)> */
+		func() bool {
+			position179, tokenIndex179 := position, tokenIndex
+			{
+				position180 := position
+				{
+					position181, tokenIndex181 := position, tokenIndex
+					if !_rules[ruleTaxonConceptQualifier]() {
+						goto l182
+					}
+					goto l181
+				l182:
+					position, tokenIndex = position181, tokenIndex181
+					if !_rules[ruleTaxonConceptSensu]() {
+						goto l179
+					}
+					{
+						position183, tokenIndex183 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l183
+						}
+						goto l184
+					l183:
+						position, tokenIndex = position183, tokenIndex183
+					}
+				l184:
+					if !_rules[ruleAuthorship]() {
+						goto l179
+					}
+				}
+			l181:
+			l185:
+				{
+					position186, tokenIndex186 := position, tokenIndex
+					if !_rules[ruleTaxonConceptSep]() {
+						goto l186
+					}
+					if !_rules[ruleExcludedAuthorship]() {
+						goto l186
+					}
+					goto l185
+				l186:
+					position, tokenIndex = position186, tokenIndex186
+				}
+				add(ruleTaxonConceptBody, position180)
+			}
+			return true
+		l179:
+			position, tokenIndex = position179, tokenIndex179
+			return false
+		},
+		/* 19 TaxonConceptBodyInParens <- <((TaxonConceptQualifier / (TaxonConceptSensu _? AuthorshipInParens)) (TaxonConceptSep ExcludedAuthorshipInParens)*)> */
+		func() bool {
+			position187, tokenIndex187 := position, tokenIndex
+			{
+				position188 := position
+				{
+					position189, tokenIndex189 := position, tokenIndex
+					if !_rules[ruleTaxonConceptQualifier]() {
+						goto l190
+					}
+					goto l189
+				l190:
+					position, tokenIndex = position189, tokenIndex189
+					if !_rules[ruleTaxonConceptSensu]() {
+						goto l187
+					}
+					{
+						position191, tokenIndex191 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l191
+						}
+						goto l192
+					l191:
+						position, tokenIndex = position191, tokenIndex191
+					}
+				l192:
+					if !_rules[ruleAuthorshipInParens]() {
+						goto l187
+					}
+				}
+			l189:
+			l193:
+				{
+					position194, tokenIndex194 := position, tokenIndex
+					if !_rules[ruleTaxonConceptSep]() {
+						goto l194
+					}
+					if !_rules[ruleExcludedAuthorshipInParens]() {
+						goto l194
+					}
+					goto l193
+				l194:
+					position, tokenIndex = position194, tokenIndex194
+				}
+				add(ruleTaxonConceptBodyInParens, position188)
+			}
+			return true
+		l187:
+			position, tokenIndex = position187, tokenIndex187
+			return false
+		},
+		/* 20 TaxonConceptQualifier <- <((TaxonConceptLato / TaxonConceptStricto / TaxonConceptAuct / TaxonConceptProParte) &(SpaceCharEOI / ',' / ';' / ')'))> */
+		func() bool {
+			position195, tokenIndex195 := position, tokenIndex
+			{
+				position196 := position
+				{
+					position197, tokenIndex197 := position, tokenIndex
+					if !_rules[ruleTaxonConceptLato]() {
+						goto l198
+					}
+					goto l197
+				l198:
+					position, tokenIndex = position197, tokenIndex197
+					if !_rules[ruleTaxonConceptStricto]() {
+						goto l199
+					}
+					goto l197
+				l199:
+					position, tokenIndex = position197, tokenIndex197
+					if !_rules[ruleTaxonConceptAuct]() {
+						goto l200
+					}
+					goto l197
+				l200:
+					position, tokenIndex = position197, tokenIndex197
+					if !_rules[ruleTaxonConceptProParte]() {
+						goto l195
+					}
+				}
+			l197:
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					{
+						position202, tokenIndex202 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l203
+						}
+						goto l202
+					l203:
+						position, tokenIndex = position202, tokenIndex202
+						if buffer[position] != rune(',') {
+							goto l204
 						}
 						position++
-						if buffer[position] != rune('s') {
-							goto l136
+						goto l202
+					l204:
+						position, tokenIndex = position202, tokenIndex202
+						if buffer[position] != rune(';') {
+							goto l205
 						}
 						position++
-						if buffer[position] != rune('p') {
-							goto l136
+						goto l202
+					l205:
+						position, tokenIndex = position202, tokenIndex202
+						if buffer[position] != rune(')') {
+							goto l195
 						}
 						position++
-						goto l132
-					l136:
-						position, tokenIndex = position132, tokenIndex132
+					}
+				l202:
+					position, tokenIndex = position201, tokenIndex201
+				}
+				add(ruleTaxonConceptQualifier, position196)
+			}
+			return true
+		l195:
+			position, tokenIndex = position195, tokenIndex195
+			return false
+		},
+		/* 21 TaxonConceptLato <- <(((('s' 'e' 'n' 's' 'u') / ('S' 'e' 'n' 's' 'u')) _ (('l' 'a' 't' 'o') / ('l' 'a' 't' '.'))) / ('s' '.' _? (('l' 'a' 't' '.') / ('l' '.'))))> */
+		func() bool {
+			position206, tokenIndex206 := position, tokenIndex
+			{
+				position207 := position
+				{
+					position208, tokenIndex208 := position, tokenIndex
+					{
+						position210, tokenIndex210 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l137
+							goto l211
 						}
 						position++
-						if buffer[position] != rune('s') {
-							goto l137
+						if buffer[position] != rune('e') {
+							goto l211
 						}
 						position++
-						if buffer[position] != rune('p') {
-							goto l137
+						if buffer[position] != rune('n') {
+							goto l211
 						}
 						position++
-						goto l132
-					l137:
-						position, tokenIndex = position132, tokenIndex132
 						if buffer[position] != rune('s') {
-							goto l138
+							goto l211
 						}
 						position++
-						if buffer[position] != rune('p') {
-							goto l138
+						if buffer[position] != rune('u') {
+							goto l211
 						}
 						position++
-						goto l132
-					l138:
-						position, tokenIndex = position132, tokenIndex132
-						if buffer[position] != rune('m') {
-							goto l139
+						goto l210
+					l211:
+						position, tokenIndex = position210, tokenIndex210
+						if buffer[position] != rune('S') {
+							goto l209
 						}
 						position++
-						if buffer[position] != rune('o') {
-							goto l139
+						if buffer[position] != rune('e') {
+							goto l209
 						}
 						position++
-						if buffer[position] != rune('r') {
-							goto l139
+						if buffer[position] != rune('n') {
+							goto l209
 						}
 						position++
-						if buffer[position] != rune('t') {
-							goto l139
+						if buffer[position] != rune('s') {
+							goto l209
 						}
 						position++
-						if buffer[position] != rune('h') {
-							goto l139
+						if buffer[position] != rune('u') {
+							goto l209
 						}
 						position++
-						goto l132
-					l139:
-						position, tokenIndex = position132, tokenIndex132
-						if buffer[position] != rune('s') {
-							goto l140
+					}
+				l210:
+					if !_rules[rule_]() {
+						goto l209
+					}
+					{
+						position212, tokenIndex212 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l213
 						}
 						position++
-						if buffer[position] != rune('u') {
-							goto l140
+						if buffer[position] != rune('a') {
+							goto l213
 						}
 						position++
-						if buffer[position] != rune('p') {
-							goto l140
+						if buffer[position] != rune('t') {
+							goto l213
 						}
 						position++
-						if buffer[position] != rune('s') {
-							goto l140
+						if buffer[position] != rune('o') {
+							goto l213
 						}
 						position++
-						if buffer[position] != rune('p') {
-							goto l140
+						goto l212
+					l213:
+						position, tokenIndex = position212, tokenIndex212
+						if buffer[position] != rune('l') {
+							goto l209
 						}
 						position++
-						goto l132
-					l140:
-						position, tokenIndex = position132, tokenIndex132
-						if buffer[position] != rune('s') {
-							goto l131
+						if buffer[position] != rune('a') {
+							goto l209
 						}
 						position++
-						if buffer[position] != rune('u') {
-							goto l131
+						if buffer[position] != rune('t') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l209
 						}
 						position++
 					}
-				l132:
-					goto l130
-				l131:
-					position, tokenIndex = position130, tokenIndex130
-					if buffer[position] != rune('n') {
-						goto l128
+				l212:
+					goto l208
+				l209:
+					position, tokenIndex = position208, tokenIndex208
+					if buffer[position] != rune('s') {
+						goto l206
 					}
 					position++
-					if buffer[position] != rune('v') {
-						goto l128
+					if buffer[position] != rune('.') {
+						goto l206
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l128
+					{
+						position214, tokenIndex214 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l214
+						}
+						goto l215
+					l214:
+						position, tokenIndex = position214, tokenIndex214
 					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l128
+				l215:
+					{
+						position216, tokenIndex216 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l217
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l217
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l217
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l217
+						}
+						position++
+						goto l216
+					l217:
+						position, tokenIndex = position216, tokenIndex216
+						if buffer[position] != rune('l') {
+							goto l206
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l206
+						}
+						position++
 					}
-					position++
+				l216:
 				}
-			l130:
+			l208:
+				add(ruleTaxonConceptLato, position207)
+			}
+			return true
+		l206:
+			position, tokenIndex = position206, tokenIndex206
+			return false
+		},
+		/* 22 TaxonConceptStricto <- <(((('s' 'e' 'n' 's' 'u') / ('S' 'e' 'n' 's' 'u')) _ (('s' 't' 'r' 'i' 'c' 't' 'o') / ('s' 't' 'r' '.'))) / ('s' '.' _? (('s' 't' 'r' '.') / ('s' '.'))))> */
+		func() bool {
+			position218, tokenIndex218 := position, tokenIndex
+			{
+				position219 := position
 				{
-					position141, tokenIndex141 := position, tokenIndex
+					position220, tokenIndex220 := position, tokenIndex
+					{
+						position222, tokenIndex222 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l223
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l223
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l223
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l223
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l223
+						}
+						position++
+						goto l222
+					l223:
+						position, tokenIndex = position222, tokenIndex222
+						if buffer[position] != rune('S') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l221
+						}
+						position++
+					}
+				l222:
+					if !_rules[rule_]() {
+						goto l221
+					}
+					{
+						position224, tokenIndex224 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l225
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l225
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l225
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l225
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l225
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l225
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l225
+						}
+						position++
+						goto l224
+					l225:
+						position, tokenIndex = position224, tokenIndex224
+						if buffer[position] != rune('s') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l221
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l221
+						}
+						position++
+					}
+				l224:
+					goto l220
+				l221:
+					position, tokenIndex = position220, tokenIndex220
+					if buffer[position] != rune('s') {
+						goto l218
+					}
+					position++
 					if buffer[position] != rune('.') {
-						goto l142
+						goto l218
 					}
 					position++
-					goto l141
-				l142:
-					position, tokenIndex = position141, tokenIndex141
 					{
-						position143, tokenIndex143 := position, tokenIndex
-						if !_rules[ruleSpaceCharEOI]() {
-							goto l128
+						position226, tokenIndex226 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l226
+						}
+						goto l227
+					l226:
+						position, tokenIndex = position226, tokenIndex226
+					}
+				l227:
+					{
+						position228, tokenIndex228 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l229
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l229
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l229
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l229
+						}
+						position++
+						goto l228
+					l229:
+						position, tokenIndex = position228, tokenIndex228
+						if buffer[position] != rune('s') {
+							goto l218
 						}
-						position, tokenIndex = position143, tokenIndex143
+						position++
+						if buffer[position] != rune('.') {
+							goto l218
+						}
+						position++
 					}
+				l228:
 				}
-			l141:
-				add(ruleRankNotho, position129)
+			l220:
+				add(ruleTaxonConceptStricto, position219)
 			}
 			return true
-		l128:
-			position, tokenIndex = position128, tokenIndex128
+		l218:
+			position, tokenIndex = position218, tokenIndex218
 			return false
 		},
-		/* 21 RankOtherUncommon <- <(('*' / ('n' 'a' 't' 'i' 'o') / ('n' 'a' 't' '.') / ('n' 'a' 't') / ('f' '.' 's' 'p') / 'α' / ('β' 'β') / 'β' / 'γ' / 'δ' / 'ε' / 'φ' / 'θ' / 'μ' / ('a' '.') / ('b' '.') / ('c' '.') / ('d' '.') / ('e' '.') / ('g' '.') / ('k' '.') / ('m' 'u' 't' '.')) &SpaceCharEOI)> */
+		/* 23 TaxonConceptAuct <- <(((('s' 'e' 'n' 's' 'u') / ('S' 'e' 'n' 's' 'u')) _)? (('a' 'u' 'c' 't' 'o' 'r' 'u' 'm') / ('a' 'u' 'c' 't' 't') / ('a' 'u' 'c' 't') / ('A' 'u' 'c' 't' 'o' 'r' 'u' 'm') / ('A' 'u' 'c' 't' 't') / ('A' 'u' 'c' 't')) '.'?)> */
 		func() bool {
-			position144, tokenIndex144 := position, tokenIndex
+			position230, tokenIndex230 := position, tokenIndex
 			{
-				position145 := position
+				position231 := position
 				{
-					position146, tokenIndex146 := position, tokenIndex
-					if buffer[position] != rune('*') {
-						goto l147
+					position232, tokenIndex232 := position, tokenIndex
+					{
+						position234, tokenIndex234 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l235
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l235
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l235
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l235
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l235
+						}
+						position++
+						goto l234
+					l235:
+						position, tokenIndex = position234, tokenIndex234
+						if buffer[position] != rune('S') {
+							goto l232
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l232
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l232
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l232
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l232
+						}
+						position++
+					}
+				l234:
+					if !_rules[rule_]() {
+						goto l232
+					}
+					goto l233
+				l232:
+					position, tokenIndex = position232, tokenIndex232
+				}
+			l233:
+				{
+					position236, tokenIndex236 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l237
 					}
 					position++
-					goto l146
-				l147:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('n') {
-						goto l148
+					if buffer[position] != rune('u') {
+						goto l237
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l148
+					if buffer[position] != rune('c') {
+						goto l237
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l148
+						goto l237
 					}
 					position++
-					if buffer[position] != rune('i') {
-						goto l148
+					if buffer[position] != rune('o') {
+						goto l237
 					}
 					position++
-					if buffer[position] != rune('o') {
-						goto l148
+					if buffer[position] != rune('r') {
+						goto l237
 					}
 					position++
-					goto l146
-				l148:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('n') {
-						goto l149
+					if buffer[position] != rune('u') {
+						goto l237
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l149
+					if buffer[position] != rune('m') {
+						goto l237
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l149
+					goto l236
+				l237:
+					position, tokenIndex = position236, tokenIndex236
+					if buffer[position] != rune('a') {
+						goto l238
 					}
 					position++
-					if buffer[position] != rune('.') {
-						goto l149
+					if buffer[position] != rune('u') {
+						goto l238
 					}
 					position++
-					goto l146
-				l149:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('n') {
-						goto l150
+					if buffer[position] != rune('c') {
+						goto l238
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l150
+					if buffer[position] != rune('t') {
+						goto l238
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l150
+						goto l238
 					}
 					position++
-					goto l146
-				l150:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('f') {
-						goto l151
+					goto l236
+				l238:
+					position, tokenIndex = position236, tokenIndex236
+					if buffer[position] != rune('a') {
+						goto l239
 					}
 					position++
-					if buffer[position] != rune('.') {
-						goto l151
+					if buffer[position] != rune('u') {
+						goto l239
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l151
+					if buffer[position] != rune('c') {
+						goto l239
 					}
 					position++
-					if buffer[position] != rune('p') {
-						goto l151
+					if buffer[position] != rune('t') {
+						goto l239
 					}
 					position++
-					goto l146
-				l151:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('α') {
-						goto l152
+					goto l236
+				l239:
+					position, tokenIndex = position236, tokenIndex236
+					if buffer[position] != rune('A') {
+						goto l240
 					}
 					position++
-					goto l146
-				l152:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('β') {
-						goto l153
+					if buffer[position] != rune('u') {
+						goto l240
 					}
 					position++
-					if buffer[position] != rune('β') {
-						goto l153
+					if buffer[position] != rune('c') {
+						goto l240
 					}
 					position++
-					goto l146
-				l153:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('β') {
-						goto l154
+					if buffer[position] != rune('t') {
+						goto l240
 					}
 					position++
-					goto l146
-				l154:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('γ') {
-						goto l155
+					if buffer[position] != rune('o') {
+						goto l240
 					}
 					position++
-					goto l146
-				l155:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('δ') {
-						goto l156
+					if buffer[position] != rune('r') {
+						goto l240
 					}
 					position++
-					goto l146
-				l156:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('ε') {
-						goto l157
+					if buffer[position] != rune('u') {
+						goto l240
 					}
 					position++
-					goto l146
-				l157:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('φ') {
-						goto l158
+					if buffer[position] != rune('m') {
+						goto l240
 					}
 					position++
-					goto l146
-				l158:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('θ') {
-						goto l159
+					goto l236
+				l240:
+					position, tokenIndex = position236, tokenIndex236
+					if buffer[position] != rune('A') {
+						goto l241
 					}
 					position++
-					goto l146
-				l159:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('μ') {
-						goto l160
+					if buffer[position] != rune('u') {
+						goto l241
 					}
 					position++
-					goto l146
-				l160:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('a') {
-						goto l161
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l161
-					}
-					position++
-					goto l146
-				l161:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('b') {
-						goto l162
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l162
-					}
-					position++
-					goto l146
-				l162:
-					position, tokenIndex = position146, tokenIndex146
 					if buffer[position] != rune('c') {
-						goto l163
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l163
-					}
-					position++
-					goto l146
-				l163:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('d') {
-						goto l164
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l164
-					}
-					position++
-					goto l146
-				l164:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('e') {
-						goto l165
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l165
-					}
-					position++
-					goto l146
-				l165:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('g') {
-						goto l166
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l166
+						goto l241
 					}
 					position++
-					goto l146
-				l166:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('k') {
-						goto l167
+					if buffer[position] != rune('t') {
+						goto l241
 					}
 					position++
-					if buffer[position] != rune('.') {
-						goto l167
+					if buffer[position] != rune('t') {
+						goto l241
 					}
 					position++
-					goto l146
-				l167:
-					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('m') {
-						goto l144
+					goto l236
+				l241:
+					position, tokenIndex = position236, tokenIndex236
+					if buffer[position] != rune('A') {
+						goto l230
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l144
+						goto l230
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l144
+					if buffer[position] != rune('c') {
+						goto l230
 					}
 					position++
-					if buffer[position] != rune('.') {
-						goto l144
+					if buffer[position] != rune('t') {
+						goto l230
 					}
 					position++
 				}
-			l146:
+			l236:
 				{
-					position168, tokenIndex168 := position, tokenIndex
-					if !_rules[ruleSpaceCharEOI]() {
-						goto l144
+					position242, tokenIndex242 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l242
 					}
-					position, tokenIndex = position168, tokenIndex168
+					position++
+					goto l243
+				l242:
+					position, tokenIndex = position242, tokenIndex242
 				}
-				add(ruleRankOtherUncommon, position145)
+			l243:
+				add(ruleTaxonConceptAuct, position231)
 			}
 			return true
-		l144:
-			position, tokenIndex = position144, tokenIndex144
+		l230:
+			position, tokenIndex = position230, tokenIndex230
 			return false
 		},
-		/* 22 RankOther <- <((('m' 'o' 'r' 'p' 'h') / ('c' 'o' 'n' 'v' 'a' 'r') / ('p' 's' 'e' 'u' 'd' 'o' 'v' 'a' 'r') / ('s' 'e' 'c' 't') / ('s' 'e' 'r') / ('s' 'u' 'b' 'v' 'a' 'r') / ('s' 'u' 'b' 'f') / ('r' 'a' 'c' 'e') / ('p' 'v') / ('p' 'a' 't' 'h' 'o' 'v' 'a' 'r') / ('a' 'b' '.' (_? ('n' '.'))?) / ('s' 't')) ('.' / &SpaceCharEOI))> */
+		/* 24 TaxonConceptProParte <- <(('p' 'r' 'o' _ ('p' 'a' 'r' 't' 'e')) / ((('p' '.') / ('P' '.')) _? (('p' '.') / ('P' '.')) !(_? AuthorWord)))> */
 		func() bool {
-			position169, tokenIndex169 := position, tokenIndex
+			position244, tokenIndex244 := position, tokenIndex
 			{
-				position170 := position
+				position245 := position
 				{
-					position171, tokenIndex171 := position, tokenIndex
-					if buffer[position] != rune('m') {
-						goto l172
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l172
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l172
-					}
-					position++
+					position246, tokenIndex246 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l172
-					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l172
+						goto l247
 					}
 					position++
-					goto l171
-				l172:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('c') {
-						goto l173
+					if buffer[position] != rune('r') {
+						goto l247
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l173
+						goto l247
 					}
 					position++
-					if buffer[position] != rune('n') {
-						goto l173
+					if !_rules[rule_]() {
+						goto l247
 					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l173
+					if buffer[position] != rune('p') {
+						goto l247
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l173
+						goto l247
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l173
-					}
-					position++
-					goto l171
-				l173:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('p') {
-						goto l174
+						goto l247
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l174
+					if buffer[position] != rune('t') {
+						goto l247
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l174
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l174
+						goto l247
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l174
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l174
+					goto l246
+				l247:
+					position, tokenIndex = position246, tokenIndex246
+					{
+						position248, tokenIndex248 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l249
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l249
+						}
+						position++
+						goto l248
+					l249:
+						position, tokenIndex = position248, tokenIndex248
+						if buffer[position] != rune('P') {
+							goto l244
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l244
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l174
+				l248:
+					{
+						position250, tokenIndex250 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l250
+						}
+						goto l251
+					l250:
+						position, tokenIndex = position250, tokenIndex250
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l174
+				l251:
+					{
+						position252, tokenIndex252 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l253
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l253
+						}
+						position++
+						goto l252
+					l253:
+						position, tokenIndex = position252, tokenIndex252
+						if buffer[position] != rune('P') {
+							goto l244
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l244
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l174
+				l252:
+					{
+						position254, tokenIndex254 := position, tokenIndex
+						{
+							position255, tokenIndex255 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l255
+							}
+							goto l256
+						l255:
+							position, tokenIndex = position255, tokenIndex255
+						}
+					l256:
+						if !_rules[ruleAuthorWord]() {
+							goto l254
+						}
+						goto l244
+					l254:
+						position, tokenIndex = position254, tokenIndex254
 					}
-					position++
-					goto l171
-				l174:
-					position, tokenIndex = position171, tokenIndex171
+				}
+			l246:
+				add(ruleTaxonConceptProParte, position245)
+			}
+			return true
+		l244:
+			position, tokenIndex = position244, tokenIndex244
+			return false
+		},
+		/* 25 TaxonConceptSensu <- <(('s' 'e' 'n' 's' 'u') / ('S' 'e' 'n' 's' 'u') / ((('s' 'e' 'c') / ('S' 'e' 'c')) '.'?))> */
+		func() bool {
+			position257, tokenIndex257 := position, tokenIndex
+			{
+				position258 := position
+				{
+					position259, tokenIndex259 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l175
+						goto l260
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l175
+						goto l260
 					}
 					position++
-					if buffer[position] != rune('c') {
-						goto l175
+					if buffer[position] != rune('n') {
+						goto l260
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l175
+					if buffer[position] != rune('s') {
+						goto l260
 					}
 					position++
-					goto l171
-				l175:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('s') {
-						goto l176
+					if buffer[position] != rune('u') {
+						goto l260
+					}
+					position++
+					goto l259
+				l260:
+					position, tokenIndex = position259, tokenIndex259
+					if buffer[position] != rune('S') {
+						goto l261
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l176
+						goto l261
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l176
+					if buffer[position] != rune('n') {
+						goto l261
 					}
 					position++
-					goto l171
-				l176:
-					position, tokenIndex = position171, tokenIndex171
 					if buffer[position] != rune('s') {
-						goto l177
+						goto l261
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l177
+						goto l261
 					}
 					position++
-					if buffer[position] != rune('b') {
-						goto l177
+					goto l259
+				l261:
+					position, tokenIndex = position259, tokenIndex259
+					{
+						position262, tokenIndex262 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l263
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l263
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l263
+						}
+						position++
+						goto l262
+					l263:
+						position, tokenIndex = position262, tokenIndex262
+						if buffer[position] != rune('S') {
+							goto l257
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l257
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l257
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l177
+				l262:
+					{
+						position264, tokenIndex264 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l264
+						}
+						position++
+						goto l265
+					l264:
+						position, tokenIndex = position264, tokenIndex264
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l177
+				l265:
+				}
+			l259:
+				add(ruleTaxonConceptSensu, position258)
+			}
+			return true
+		l257:
+			position, tokenIndex = position257, tokenIndex257
+			return false
+		},
+		/* 26 ExcludedAuthorship <- <(ExcludedWord _ Authorship)> */
+		func() bool {
+			position266, tokenIndex266 := position, tokenIndex
+			{
+				position267 := position
+				if !_rules[ruleExcludedWord]() {
+					goto l266
+				}
+				if !_rules[rule_]() {
+					goto l266
+				}
+				if !_rules[ruleAuthorship]() {
+					goto l266
+				}
+				add(ruleExcludedAuthorship, position267)
+			}
+			return true
+		l266:
+			position, tokenIndex = position266, tokenIndex266
+			return false
+		},
+		/* 27 ExcludedAuthorshipInParens <- <(ExcludedWord _ AuthorshipInParens)> */
+		func() bool {
+			position268, tokenIndex268 := position, tokenIndex
+			{
+				position269 := position
+				if !_rules[ruleExcludedWord]() {
+					goto l268
+				}
+				if !_rules[rule_]() {
+					goto l268
+				}
+				if !_rules[ruleAuthorshipInParens]() {
+					goto l268
+				}
+				add(ruleExcludedAuthorshipInParens, position269)
+			}
+			return true
+		l268:
+			position, tokenIndex = position268, tokenIndex268
+			return false
+		},
+		/* 28 ExcludedWord <- <((('n' 'o' 'n') / ('n' 'e' 'c')) &_)> */
+		func() bool {
+			position270, tokenIndex270 := position, tokenIndex
+			{
+				position271 := position
+				{
+					position272, tokenIndex272 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l273
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l177
+					if buffer[position] != rune('o') {
+						goto l273
 					}
 					position++
-					goto l171
-				l177:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('s') {
-						goto l178
+					if buffer[position] != rune('n') {
+						goto l273
 					}
 					position++
-					if buffer[position] != rune('u') {
-						goto l178
+					goto l272
+				l273:
+					position, tokenIndex = position272, tokenIndex272
+					if buffer[position] != rune('n') {
+						goto l270
 					}
 					position++
-					if buffer[position] != rune('b') {
-						goto l178
+					if buffer[position] != rune('e') {
+						goto l270
 					}
 					position++
-					if buffer[position] != rune('f') {
-						goto l178
+					if buffer[position] != rune('c') {
+						goto l270
 					}
 					position++
-					goto l171
-				l178:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('r') {
-						goto l179
+				}
+			l272:
+				{
+					position274, tokenIndex274 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l270
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l179
+					position, tokenIndex = position274, tokenIndex274
+				}
+				add(ruleExcludedWord, position271)
+			}
+			return true
+		l270:
+			position, tokenIndex = position270, tokenIndex270
+			return false
+		},
+		/* 29 ExcludedAhead <- <(ExcludedWord _ Author)> */
+		func() bool {
+			position275, tokenIndex275 := position, tokenIndex
+			{
+				position276 := position
+				if !_rules[ruleExcludedWord]() {
+					goto l275
+				}
+				if !_rules[rule_]() {
+					goto l275
+				}
+				if !_rules[ruleAuthor]() {
+					goto l275
+				}
+				add(ruleExcludedAhead, position276)
+			}
+			return true
+		l275:
+			position, tokenIndex = position275, tokenIndex275
+			return false
+		},
+		/* 30 TaxonConceptAhead <- <((TaxonConceptQualifier !')') / (TaxonConceptSensu SpaceCharEOI))> */
+		func() bool {
+			position277, tokenIndex277 := position, tokenIndex
+			{
+				position278 := position
+				{
+					position279, tokenIndex279 := position, tokenIndex
+					if !_rules[ruleTaxonConceptQualifier]() {
+						goto l280
 					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l179
+					{
+						position281, tokenIndex281 := position, tokenIndex
+						if buffer[position] != rune(')') {
+							goto l281
+						}
+						position++
+						goto l280
+					l281:
+						position, tokenIndex = position281, tokenIndex281
 					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l179
+					goto l279
+				l280:
+					position, tokenIndex = position279, tokenIndex279
+					if !_rules[ruleTaxonConceptSensu]() {
+						goto l277
 					}
-					position++
-					goto l171
-				l179:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('p') {
-						goto l180
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l277
 					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l180
+				}
+			l279:
+				add(ruleTaxonConceptAhead, position278)
+			}
+			return true
+		l277:
+			position, tokenIndex = position277, tokenIndex277
+			return false
+		},
+		/* 31 NameCultivar <- <(&{ p.Code == CultivatedCode } !(NameComp / NameApprox) SingleName (_ Cultivar)+)> */
+		func() bool {
+			position282, tokenIndex282 := position, tokenIndex
+			{
+				position283 := position
+				if !(p.Code == CultivatedCode) {
+					goto l282
+				}
+				{
+					position284, tokenIndex284 := position, tokenIndex
+					{
+						position285, tokenIndex285 := position, tokenIndex
+						if !_rules[ruleNameComp]() {
+							goto l286
+						}
+						goto l285
+					l286:
+						position, tokenIndex = position285, tokenIndex285
+						if !_rules[ruleNameApprox]() {
+							goto l284
+						}
 					}
-					position++
-					goto l171
-				l180:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('p') {
-						goto l181
+				l285:
+					goto l282
+				l284:
+					position, tokenIndex = position284, tokenIndex284
+				}
+				if !_rules[ruleSingleName]() {
+					goto l282
+				}
+				if !_rules[rule_]() {
+					goto l282
+				}
+				if !_rules[ruleCultivar]() {
+					goto l282
+				}
+			l287:
+				{
+					position288, tokenIndex288 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l288
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l181
+					if !_rules[ruleCultivar]() {
+						goto l288
 					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l181
+					goto l287
+				l288:
+					position, tokenIndex = position288, tokenIndex288
+				}
+				add(ruleNameCultivar, position283)
+			}
+			return true
+		l282:
+			position, tokenIndex = position282, tokenIndex282
+			return false
+		},
+		/* 32 Cultivar <- <(CultivarGroup / Grex / CultivarEpithet)> */
+		func() bool {
+			position289, tokenIndex289 := position, tokenIndex
+			{
+				position290 := position
+				{
+					position291, tokenIndex291 := position, tokenIndex
+					if !_rules[ruleCultivarGroup]() {
+						goto l292
+					}
+					goto l291
+				l292:
+					position, tokenIndex = position291, tokenIndex291
+					if !_rules[ruleGrex]() {
+						goto l293
+					}
+					goto l291
+				l293:
+					position, tokenIndex = position291, tokenIndex291
+					if !_rules[ruleCultivarEpithet]() {
+						goto l289
 					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l181
+				}
+			l291:
+				add(ruleCultivar, position290)
+			}
+			return true
+		l289:
+			position, tokenIndex = position289, tokenIndex289
+			return false
+		},
+		/* 33 CultivarEpithet <- <((('c' 'v' '.' _?)? CultivarQuote CultivarText CultivarQuote) / ('c' 'v' '.' _ CultivarWords))> */
+		func() bool {
+			position294, tokenIndex294 := position, tokenIndex
+			{
+				position295 := position
+				{
+					position296, tokenIndex296 := position, tokenIndex
+					{
+						position298, tokenIndex298 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l298
+						}
+						position++
+						if buffer[position] != rune('v') {
+							goto l298
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l298
+						}
+						position++
+						{
+							position300, tokenIndex300 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l300
+							}
+							goto l301
+						l300:
+							position, tokenIndex = position300, tokenIndex300
+						}
+					l301:
+						goto l299
+					l298:
+						position, tokenIndex = position298, tokenIndex298
 					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l181
+				l299:
+					if !_rules[ruleCultivarQuote]() {
+						goto l297
 					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l181
+					if !_rules[ruleCultivarText]() {
+						goto l297
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l181
+					if !_rules[ruleCultivarQuote]() {
+						goto l297
 					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l181
+					goto l296
+				l297:
+					position, tokenIndex = position296, tokenIndex296
+					if buffer[position] != rune('c') {
+						goto l294
 					}
 					position++
-					goto l171
-				l181:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('a') {
-						goto l182
+					if buffer[position] != rune('v') {
+						goto l294
 					}
 					position++
-					if buffer[position] != rune('b') {
-						goto l182
+					if buffer[position] != rune('.') {
+						goto l294
 					}
 					position++
-					if buffer[position] != rune('.') {
-						goto l182
+					if !_rules[rule_]() {
+						goto l294
+					}
+					if !_rules[ruleCultivarWords]() {
+						goto l294
+					}
+				}
+			l296:
+				add(ruleCultivarEpithet, position295)
+			}
+			return true
+		l294:
+			position, tokenIndex = position294, tokenIndex294
+			return false
+		},
+		/* 34 CultivarGroup <- <(('(' _? CultivarGroupName _ CultivarGroupWord _? ')') / (CultivarGroupName _ CultivarGroupWord))> */
+		func() bool {
+			position302, tokenIndex302 := position, tokenIndex
+			{
+				position303 := position
+				{
+					position304, tokenIndex304 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l305
 					}
 					position++
 					{
-						position183, tokenIndex183 := position, tokenIndex
-						{
-							position185, tokenIndex185 := position, tokenIndex
-							if !_rules[rule_]() {
-								goto l185
-							}
-							goto l186
-						l185:
-							position, tokenIndex = position185, tokenIndex185
-						}
-					l186:
-						if buffer[position] != rune('n') {
-							goto l183
+						position306, tokenIndex306 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l306
 						}
-						position++
-						if buffer[position] != rune('.') {
-							goto l183
+						goto l307
+					l306:
+						position, tokenIndex = position306, tokenIndex306
+					}
+				l307:
+					if !_rules[ruleCultivarGroupName]() {
+						goto l305
+					}
+					if !_rules[rule_]() {
+						goto l305
+					}
+					if !_rules[ruleCultivarGroupWord]() {
+						goto l305
+					}
+					{
+						position308, tokenIndex308 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l308
 						}
-						position++
-						goto l184
-					l183:
-						position, tokenIndex = position183, tokenIndex183
+						goto l309
+					l308:
+						position, tokenIndex = position308, tokenIndex308
 					}
-				l184:
-					goto l171
-				l182:
-					position, tokenIndex = position171, tokenIndex171
-					if buffer[position] != rune('s') {
-						goto l169
+				l309:
+					if buffer[position] != rune(')') {
+						goto l305
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l169
+					goto l304
+				l305:
+					position, tokenIndex = position304, tokenIndex304
+					if !_rules[ruleCultivarGroupName]() {
+						goto l302
 					}
-					position++
+					if !_rules[rule_]() {
+						goto l302
+					}
+					if !_rules[ruleCultivarGroupWord]() {
+						goto l302
+					}
+				}
+			l304:
+				add(ruleCultivarGroup, position303)
+			}
+			return true
+		l302:
+			position, tokenIndex = position302, tokenIndex302
+			return false
+		},
+		/* 35 CultivarGroupWord <- <('G' 'r' 'o' 'u' 'p' &(SpaceCharEOI / ')'))> */
+		func() bool {
+			position310, tokenIndex310 := position, tokenIndex
+			{
+				position311 := position
+				if buffer[position] != rune('G') {
+					goto l310
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l310
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l310
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l310
 				}
-			l171:
+				position++
+				if buffer[position] != rune('p') {
+					goto l310
+				}
+				position++
 				{
-					position187, tokenIndex187 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l188
-					}
-					position++
-					goto l187
-				l188:
-					position, tokenIndex = position187, tokenIndex187
+					position312, tokenIndex312 := position, tokenIndex
 					{
-						position189, tokenIndex189 := position, tokenIndex
+						position313, tokenIndex313 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l169
+							goto l314
+						}
+						goto l313
+					l314:
+						position, tokenIndex = position313, tokenIndex313
+						if buffer[position] != rune(')') {
+							goto l310
 						}
-						position, tokenIndex = position189, tokenIndex189
+						position++
 					}
+				l313:
+					position, tokenIndex = position312, tokenIndex312
 				}
-			l187:
-				add(ruleRankOther, position170)
+				add(ruleCultivarGroupWord, position311)
 			}
 			return true
-		l169:
-			position, tokenIndex = position169, tokenIndex169
+		l310:
+			position, tokenIndex = position310, tokenIndex310
 			return false
 		},
-		/* 23 RankVar <- <((('v' 'a' 'r' 'i' 'e' 't' 'y') / ('[' 'v' 'a' 'r' '.' ']') / ('v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 36 Grex <- <(CultivarGroupName _ (('g' 'r' 'e' 'x') / ('g' 'x')) &SpaceCharEOI)> */
 		func() bool {
-			position190, tokenIndex190 := position, tokenIndex
+			position315, tokenIndex315 := position, tokenIndex
 			{
-				position191 := position
+				position316 := position
+				if !_rules[ruleCultivarGroupName]() {
+					goto l315
+				}
+				if !_rules[rule_]() {
+					goto l315
+				}
 				{
-					position192, tokenIndex192 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l193
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l193
+					position317, tokenIndex317 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l318
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l193
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l193
+						goto l318
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l193
+						goto l318
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l193
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l193
+					if buffer[position] != rune('x') {
+						goto l318
 					}
 					position++
-					goto l192
-				l193:
-					position, tokenIndex = position192, tokenIndex192
-					if buffer[position] != rune('[') {
-						goto l194
+					goto l317
+				l318:
+					position, tokenIndex = position317, tokenIndex317
+					if buffer[position] != rune('g') {
+						goto l315
 					}
 					position++
-					if buffer[position] != rune('v') {
-						goto l194
+					if buffer[position] != rune('x') {
+						goto l315
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l194
+				}
+			l317:
+				{
+					position319, tokenIndex319 := position, tokenIndex
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l315
 					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l194
+					position, tokenIndex = position319, tokenIndex319
+				}
+				add(ruleGrex, position316)
+			}
+			return true
+		l315:
+			position, tokenIndex = position315, tokenIndex315
+			return false
+		},
+		/* 37 CultivarGroupName <- <((CultivarQuote CultivarText CultivarQuote) / CultivarWords)> */
+		func() bool {
+			position320, tokenIndex320 := position, tokenIndex
+			{
+				position321 := position
+				{
+					position322, tokenIndex322 := position, tokenIndex
+					if !_rules[ruleCultivarQuote]() {
+						goto l323
 					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l194
+					if !_rules[ruleCultivarText]() {
+						goto l323
 					}
-					position++
-					if buffer[position] != rune(']') {
-						goto l194
+					if !_rules[ruleCultivarQuote]() {
+						goto l323
 					}
-					position++
-					goto l192
-				l194:
-					position, tokenIndex = position192, tokenIndex192
-					if buffer[position] != rune('v') {
-						goto l190
+					goto l322
+				l323:
+					position, tokenIndex = position322, tokenIndex322
+					if !_rules[ruleCultivarWords]() {
+						goto l320
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l190
+				}
+			l322:
+				add(ruleCultivarGroupName, position321)
+			}
+			return true
+		l320:
+			position, tokenIndex = position320, tokenIndex320
+			return false
+		},
+		/* 38 CultivarWords <- <(CultivarWord (_ CultivarWord)*)> */
+		func() bool {
+			position324, tokenIndex324 := position, tokenIndex
+			{
+				position325 := position
+				if !_rules[ruleCultivarWord]() {
+					goto l324
+				}
+			l326:
+				{
+					position327, tokenIndex327 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l327
 					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l190
+					if !_rules[ruleCultivarWord]() {
+						goto l327
 					}
-					position++
+					goto l326
+				l327:
+					position, tokenIndex = position327, tokenIndex327
 				}
-			l192:
+				add(ruleCultivarWords, position325)
+			}
+			return true
+		l324:
+			position, tokenIndex = position324, tokenIndex324
+			return false
+		},
+		/* 39 CultivarWord <- <(!CultivarGroupWord NameUpperChar (!(SpaceCharEOI / CultivarQuote / '(' / ')') .)*)> */
+		func() bool {
+			position328, tokenIndex328 := position, tokenIndex
+			{
+				position329 := position
 				{
-					position195, tokenIndex195 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l196
+					position330, tokenIndex330 := position, tokenIndex
+					if !_rules[ruleCultivarGroupWord]() {
+						goto l330
 					}
-					position++
-					goto l195
-				l196:
-					position, tokenIndex = position195, tokenIndex195
+					goto l328
+				l330:
+					position, tokenIndex = position330, tokenIndex330
+				}
+				if !_rules[ruleNameUpperChar]() {
+					goto l328
+				}
+			l331:
+				{
+					position332, tokenIndex332 := position, tokenIndex
 					{
-						position197, tokenIndex197 := position, tokenIndex
-						if !_rules[ruleSpaceCharEOI]() {
-							goto l190
+						position333, tokenIndex333 := position, tokenIndex
+						{
+							position334, tokenIndex334 := position, tokenIndex
+							if !_rules[ruleSpaceCharEOI]() {
+								goto l335
+							}
+							goto l334
+						l335:
+							position, tokenIndex = position334, tokenIndex334
+							if !_rules[ruleCultivarQuote]() {
+								goto l336
+							}
+							goto l334
+						l336:
+							position, tokenIndex = position334, tokenIndex334
+							if buffer[position] != rune('(') {
+								goto l337
+							}
+							position++
+							goto l334
+						l337:
+							position, tokenIndex = position334, tokenIndex334
+							if buffer[position] != rune(')') {
+								goto l333
+							}
+							position++
 						}
-						position, tokenIndex = position197, tokenIndex197
+					l334:
+						goto l332
+					l333:
+						position, tokenIndex = position333, tokenIndex333
+					}
+					if !matchDot() {
+						goto l332
 					}
+					goto l331
+				l332:
+					position, tokenIndex = position332, tokenIndex332
 				}
-			l195:
-				add(ruleRankVar, position191)
+				add(ruleCultivarWord, position329)
 			}
 			return true
-		l190:
-			position, tokenIndex = position190, tokenIndex190
+		l328:
+			position, tokenIndex = position328, tokenIndex328
 			return false
 		},
-		/* 24 RankForma <- <((('f' 'o' 'r' 'm' 'a') / ('f' 'm' 'a') / ('f' 'o' 'r' 'm') / ('f' 'o') / 'f') ('.' / &SpaceCharEOI))> */
+		/* 40 CultivarText <- <(!CultivarQuote .)+> */
 		func() bool {
-			position198, tokenIndex198 := position, tokenIndex
+			position338, tokenIndex338 := position, tokenIndex
 			{
-				position199 := position
+				position339 := position
 				{
-					position200, tokenIndex200 := position, tokenIndex
-					if buffer[position] != rune('f') {
-						goto l201
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l201
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l201
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l201
+					position342, tokenIndex342 := position, tokenIndex
+					if !_rules[ruleCultivarQuote]() {
+						goto l342
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l201
-					}
-					position++
-					goto l200
-				l201:
-					position, tokenIndex = position200, tokenIndex200
-					if buffer[position] != rune('f') {
-						goto l202
+					goto l338
+				l342:
+					position, tokenIndex = position342, tokenIndex342
+				}
+				if !matchDot() {
+					goto l338
+				}
+			l340:
+				{
+					position341, tokenIndex341 := position, tokenIndex
+					{
+						position343, tokenIndex343 := position, tokenIndex
+						if !_rules[ruleCultivarQuote]() {
+							goto l343
+						}
+						goto l341
+					l343:
+						position, tokenIndex = position343, tokenIndex343
 					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l202
+					if !matchDot() {
+						goto l341
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l202
+					goto l340
+				l341:
+					position, tokenIndex = position341, tokenIndex341
+				}
+				add(ruleCultivarText, position339)
+			}
+			return true
+		l338:
+			position, tokenIndex = position338, tokenIndex338
+			return false
+		},
+		/* 41 CultivarQuote <- <('\'' / '‘' / '’' / '"' / '“' / '”')> */
+		func() bool {
+			position344, tokenIndex344 := position, tokenIndex
+			{
+				position345 := position
+				{
+					position346, tokenIndex346 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						goto l347
 					}
 					position++
-					goto l200
-				l202:
-					position, tokenIndex = position200, tokenIndex200
-					if buffer[position] != rune('f') {
-						goto l203
+					goto l346
+				l347:
+					position, tokenIndex = position346, tokenIndex346
+					if buffer[position] != rune('‘') {
+						goto l348
 					}
 					position++
-					if buffer[position] != rune('o') {
-						goto l203
+					goto l346
+				l348:
+					position, tokenIndex = position346, tokenIndex346
+					if buffer[position] != rune('’') {
+						goto l349
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l203
+					goto l346
+				l349:
+					position, tokenIndex = position346, tokenIndex346
+					if buffer[position] != rune('"') {
+						goto l350
 					}
 					position++
-					if buffer[position] != rune('m') {
-						goto l203
+					goto l346
+				l350:
+					position, tokenIndex = position346, tokenIndex346
+					if buffer[position] != rune('“') {
+						goto l351
 					}
 					position++
-					goto l200
-				l203:
-					position, tokenIndex = position200, tokenIndex200
-					if buffer[position] != rune('f') {
-						goto l204
+					goto l346
+				l351:
+					position, tokenIndex = position346, tokenIndex346
+					if buffer[position] != rune('”') {
+						goto l344
 					}
 					position++
-					if buffer[position] != rune('o') {
-						goto l204
+				}
+			l346:
+				add(ruleCultivarQuote, position345)
+			}
+			return true
+		l344:
+			position, tokenIndex = position344, tokenIndex344
+			return false
+		},
+		/* 42 HybridFormula <- <(SingleName (_ (HybridFormulaPart / HybridFormulaFull))+)> */
+		func() bool {
+			position352, tokenIndex352 := position, tokenIndex
+			{
+				position353 := position
+				if !_rules[ruleSingleName]() {
+					goto l352
+				}
+				if !_rules[rule_]() {
+					goto l352
+				}
+				{
+					position356, tokenIndex356 := position, tokenIndex
+					if !_rules[ruleHybridFormulaPart]() {
+						goto l357
 					}
-					position++
-					goto l200
-				l204:
-					position, tokenIndex = position200, tokenIndex200
-					if buffer[position] != rune('f') {
-						goto l198
+					goto l356
+				l357:
+					position, tokenIndex = position356, tokenIndex356
+					if !_rules[ruleHybridFormulaFull]() {
+						goto l352
 					}
-					position++
 				}
-			l200:
+			l356:
+			l354:
 				{
-					position205, tokenIndex205 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l206
+					position355, tokenIndex355 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l355
 					}
-					position++
-					goto l205
-				l206:
-					position, tokenIndex = position205, tokenIndex205
 					{
-						position207, tokenIndex207 := position, tokenIndex
-						if !_rules[ruleSpaceCharEOI]() {
-							goto l198
+						position358, tokenIndex358 := position, tokenIndex
+						if !_rules[ruleHybridFormulaPart]() {
+							goto l359
+						}
+						goto l358
+					l359:
+						position, tokenIndex = position358, tokenIndex358
+						if !_rules[ruleHybridFormulaFull]() {
+							goto l355
 						}
-						position, tokenIndex = position207, tokenIndex207
 					}
+				l358:
+					goto l354
+				l355:
+					position, tokenIndex = position355, tokenIndex355
 				}
-			l205:
-				add(ruleRankForma, position199)
+				add(ruleHybridFormula, position353)
 			}
 			return true
-		l198:
-			position, tokenIndex = position198, tokenIndex198
+		l352:
+			position, tokenIndex = position352, tokenIndex352
 			return false
 		},
-		/* 25 RankSsp <- <((('s' 's' 'p') / ('s' 'u' 'b' 's' 'p' 'e' 'c') / ('s' 'u' 'b' 's' 'p')) ('.' / &SpaceCharEOI))> */
+		/* 43 HybridFormulaFull <- <(HybridChar (_ SingleName)?)> */
 		func() bool {
-			position208, tokenIndex208 := position, tokenIndex
+			position360, tokenIndex360 := position, tokenIndex
 			{
-				position209 := position
+				position361 := position
+				if !_rules[ruleHybridChar]() {
+					goto l360
+				}
 				{
-					position210, tokenIndex210 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l211
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l211
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l211
-					}
-					position++
-					goto l210
-				l211:
-					position, tokenIndex = position210, tokenIndex210
-					if buffer[position] != rune('s') {
-						goto l212
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l212
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l212
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l212
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l212
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l212
-					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l212
-					}
-					position++
-					goto l210
-				l212:
-					position, tokenIndex = position210, tokenIndex210
-					if buffer[position] != rune('s') {
-						goto l208
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l208
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l208
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l208
+					position362, tokenIndex362 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l362
 					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l208
+					if !_rules[ruleSingleName]() {
+						goto l362
 					}
-					position++
+					goto l363
+				l362:
+					position, tokenIndex = position362, tokenIndex362
+				}
+			l363:
+				add(ruleHybridFormulaFull, position361)
+			}
+			return true
+		l360:
+			position, tokenIndex = position360, tokenIndex360
+			return false
+		},
+		/* 44 HybridFormulaPart <- <(HybridChar _ SpeciesEpithet (_ InfraspGroup)?)> */
+		func() bool {
+			position364, tokenIndex364 := position, tokenIndex
+			{
+				position365 := position
+				if !_rules[ruleHybridChar]() {
+					goto l364
+				}
+				if !_rules[rule_]() {
+					goto l364
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l364
 				}
-			l210:
 				{
-					position213, tokenIndex213 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l214
+					position366, tokenIndex366 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l366
 					}
-					position++
-					goto l213
-				l214:
-					position, tokenIndex = position213, tokenIndex213
-					{
-						position215, tokenIndex215 := position, tokenIndex
-						if !_rules[ruleSpaceCharEOI]() {
-							goto l208
-						}
-						position, tokenIndex = position215, tokenIndex215
+					if !_rules[ruleInfraspGroup]() {
+						goto l366
 					}
+					goto l367
+				l366:
+					position, tokenIndex = position366, tokenIndex366
 				}
-			l213:
-				add(ruleRankSsp, position209)
+			l367:
+				add(ruleHybridFormulaPart, position365)
 			}
 			return true
-		l208:
-			position, tokenIndex = position208, tokenIndex208
+		l364:
+			position, tokenIndex = position364, tokenIndex364
 			return false
 		},
-		/* 26 RankAgamo <- <((('a' 'g' 'a' 'm' 'o' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 's' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 45 NamedHybrid <- <(NamedGenusHybrid / NamedSpeciesHybrid)> */
 		func() bool {
-			position216, tokenIndex216 := position, tokenIndex
+			position368, tokenIndex368 := position, tokenIndex
 			{
-				position217 := position
+				position369 := position
 				{
-					position218, tokenIndex218 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l219
-					}
-					position++
-					if buffer[position] != rune('g') {
-						goto l219
+					position370, tokenIndex370 := position, tokenIndex
+					if !_rules[ruleNamedGenusHybrid]() {
+						goto l371
 					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l219
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l219
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l219
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l219
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l219
-					}
-					position++
-					goto l218
-				l219:
-					position, tokenIndex = position218, tokenIndex218
-					if buffer[position] != rune('a') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('g') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l220
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l220
-					}
-					position++
-					goto l218
-				l220:
-					position, tokenIndex = position218, tokenIndex218
-					if buffer[position] != rune('a') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('g') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l216
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l216
-					}
-					position++
-				}
-			l218:
-				{
-					position221, tokenIndex221 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l222
-					}
-					position++
-					goto l221
-				l222:
-					position, tokenIndex = position221, tokenIndex221
-					{
-						position223, tokenIndex223 := position, tokenIndex
-						if !_rules[ruleSpaceCharEOI]() {
-							goto l216
-						}
-						position, tokenIndex = position223, tokenIndex223
+					goto l370
+				l371:
+					position, tokenIndex = position370, tokenIndex370
+					if !_rules[ruleNamedSpeciesHybrid]() {
+						goto l368
 					}
 				}
-			l221:
-				add(ruleRankAgamo, position217)
+			l370:
+				add(ruleNamedHybrid, position369)
 			}
 			return true
-		l216:
-			position, tokenIndex = position216, tokenIndex216
+		l368:
+			position, tokenIndex = position368, tokenIndex368
 			return false
 		},
-		/* 27 SubGenusOrSuperspecies <- <('(' _? NameLowerChar+ _? ')')> */
+		/* 46 NamedSpeciesHybrid <- <(GenusWord (_ SubGenus)? (_ Comparison)? _ HybridChar _? SpeciesEpithet (_ InfraspGroup)?)> */
 		func() bool {
-			position224, tokenIndex224 := position, tokenIndex
+			position372, tokenIndex372 := position, tokenIndex
 			{
-				position225 := position
-				if buffer[position] != rune('(') {
-					goto l224
+				position373 := position
+				if !_rules[ruleGenusWord]() {
+					goto l372
 				}
-				position++
 				{
-					position226, tokenIndex226 := position, tokenIndex
+					position374, tokenIndex374 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l226
+						goto l374
 					}
-					goto l227
-				l226:
-					position, tokenIndex = position226, tokenIndex226
-				}
-			l227:
-				if !_rules[ruleNameLowerChar]() {
-					goto l224
+					if !_rules[ruleSubGenus]() {
+						goto l374
+					}
+					goto l375
+				l374:
+					position, tokenIndex = position374, tokenIndex374
 				}
-			l228:
+			l375:
 				{
-					position229, tokenIndex229 := position, tokenIndex
-					if !_rules[ruleNameLowerChar]() {
-						goto l229
+					position376, tokenIndex376 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l376
+					}
+					if !_rules[ruleComparison]() {
+						goto l376
 					}
-					goto l228
-				l229:
-					position, tokenIndex = position229, tokenIndex229
+					goto l377
+				l376:
+					position, tokenIndex = position376, tokenIndex376
+				}
+			l377:
+				if !_rules[rule_]() {
+					goto l372
+				}
+				if !_rules[ruleHybridChar]() {
+					goto l372
 				}
 				{
-					position230, tokenIndex230 := position, tokenIndex
+					position378, tokenIndex378 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l230
+						goto l378
 					}
-					goto l231
-				l230:
-					position, tokenIndex = position230, tokenIndex230
+					goto l379
+				l378:
+					position, tokenIndex = position378, tokenIndex378
 				}
-			l231:
-				if buffer[position] != rune(')') {
-					goto l224
+			l379:
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l372
 				}
-				position++
-				add(ruleSubGenusOrSuperspecies, position225)
+				{
+					position380, tokenIndex380 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l380
+					}
+					if !_rules[ruleInfraspGroup]() {
+						goto l380
+					}
+					goto l381
+				l380:
+					position, tokenIndex = position380, tokenIndex380
+				}
+			l381:
+				add(ruleNamedSpeciesHybrid, position373)
 			}
 			return true
-		l224:
-			position, tokenIndex = position224, tokenIndex224
+		l372:
+			position, tokenIndex = position372, tokenIndex372
 			return false
 		},
-		/* 28 SubGenus <- <('(' _? UninomialWord _? ')')> */
+		/* 47 NamedGenusHybrid <- <(HybridChar _? SingleName)> */
 		func() bool {
-			position232, tokenIndex232 := position, tokenIndex
+			position382, tokenIndex382 := position, tokenIndex
 			{
-				position233 := position
-				if buffer[position] != rune('(') {
-					goto l232
-				}
-				position++
-				{
-					position234, tokenIndex234 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l234
-					}
-					goto l235
-				l234:
-					position, tokenIndex = position234, tokenIndex234
-				}
-			l235:
-				if !_rules[ruleUninomialWord]() {
-					goto l232
+				position383 := position
+				if !_rules[ruleHybridChar]() {
+					goto l382
 				}
 				{
-					position236, tokenIndex236 := position, tokenIndex
+					position384, tokenIndex384 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l236
+						goto l384
 					}
-					goto l237
-				l236:
-					position, tokenIndex = position236, tokenIndex236
+					goto l385
+				l384:
+					position, tokenIndex = position384, tokenIndex384
 				}
-			l237:
-				if buffer[position] != rune(')') {
-					goto l232
+			l385:
+				if !_rules[ruleSingleName]() {
+					goto l382
 				}
-				position++
-				add(ruleSubGenus, position233)
+				add(ruleNamedGenusHybrid, position383)
 			}
 			return true
-		l232:
-			position, tokenIndex = position232, tokenIndex232
+		l382:
+			position, tokenIndex = position382, tokenIndex382
 			return false
 		},
-		/* 29 UninomialCombo <- <(UninomialCombo1 / UninomialCombo2)> */
+		/* 48 SingleName <- <(NameComp / NameApprox / NameSpecies / NameUninomial)> */
 		func() bool {
-			position238, tokenIndex238 := position, tokenIndex
+			position386, tokenIndex386 := position, tokenIndex
 			{
-				position239 := position
+				position387 := position
 				{
-					position240, tokenIndex240 := position, tokenIndex
-					if !_rules[ruleUninomialCombo1]() {
-						goto l241
+					position388, tokenIndex388 := position, tokenIndex
+					if !_rules[ruleNameComp]() {
+						goto l389
 					}
-					goto l240
-				l241:
-					position, tokenIndex = position240, tokenIndex240
-					if !_rules[ruleUninomialCombo2]() {
-						goto l238
+					goto l388
+				l389:
+					position, tokenIndex = position388, tokenIndex388
+					if !_rules[ruleNameApprox]() {
+						goto l390
+					}
+					goto l388
+				l390:
+					position, tokenIndex = position388, tokenIndex388
+					if !_rules[ruleNameSpecies]() {
+						goto l391
+					}
+					goto l388
+				l391:
+					position, tokenIndex = position388, tokenIndex388
+					if !_rules[ruleNameUninomial]() {
+						goto l386
 					}
 				}
-			l240:
-				add(ruleUninomialCombo, position239)
+			l388:
+				add(ruleSingleName, position387)
 			}
 			return true
-		l238:
-			position, tokenIndex = position238, tokenIndex238
+		l386:
+			position, tokenIndex = position386, tokenIndex386
 			return false
 		},
-		/* 30 UninomialCombo1 <- <(UninomialWord _? SubGenus (_? Authorship)?)> */
+		/* 49 NameUninomial <- <(UninomialCombo / Uninomial)> */
 		func() bool {
-			position242, tokenIndex242 := position, tokenIndex
+			position392, tokenIndex392 := position, tokenIndex
 			{
-				position243 := position
-				if !_rules[ruleUninomialWord]() {
-					goto l242
-				}
-				{
-					position244, tokenIndex244 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l244
-					}
-					goto l245
-				l244:
-					position, tokenIndex = position244, tokenIndex244
-				}
-			l245:
-				if !_rules[ruleSubGenus]() {
-					goto l242
-				}
+				position393 := position
 				{
-					position246, tokenIndex246 := position, tokenIndex
-					{
-						position248, tokenIndex248 := position, tokenIndex
-						if !_rules[rule_]() {
-							goto l248
-						}
-						goto l249
-					l248:
-						position, tokenIndex = position248, tokenIndex248
+					position394, tokenIndex394 := position, tokenIndex
+					if !_rules[ruleUninomialCombo]() {
+						goto l395
 					}
-				l249:
-					if !_rules[ruleAuthorship]() {
-						goto l246
+					goto l394
+				l395:
+					position, tokenIndex = position394, tokenIndex394
+					if !_rules[ruleUninomial]() {
+						goto l392
 					}
-					goto l247
-				l246:
-					position, tokenIndex = position246, tokenIndex246
 				}
-			l247:
-				add(ruleUninomialCombo1, position243)
+			l394:
+				add(ruleNameUninomial, position393)
 			}
 			return true
-		l242:
-			position, tokenIndex = position242, tokenIndex242
+		l392:
+			position, tokenIndex = position392, tokenIndex392
 			return false
 		},
-		/* 31 UninomialCombo2 <- <(Uninomial _ RankUninomial _ Uninomial)> */
+		/* 50 NameApprox <- <(GenusWord (_ SpeciesEpithet)? _ Approximation ApproxNameIgnored)> */
 		func() bool {
-			position250, tokenIndex250 := position, tokenIndex
+			position396, tokenIndex396 := position, tokenIndex
 			{
-				position251 := position
-				if !_rules[ruleUninomial]() {
-					goto l250
-				}
-				if !_rules[rule_]() {
-					goto l250
+				position397 := position
+				if !_rules[ruleGenusWord]() {
+					goto l396
 				}
-				if !_rules[ruleRankUninomial]() {
-					goto l250
+				{
+					position398, tokenIndex398 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l398
+					}
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l398
+					}
+					goto l399
+				l398:
+					position, tokenIndex = position398, tokenIndex398
 				}
+			l399:
 				if !_rules[rule_]() {
-					goto l250
+					goto l396
 				}
-				if !_rules[ruleUninomial]() {
-					goto l250
+				if !_rules[ruleApproximation]() {
+					goto l396
+				}
+				if !_rules[ruleApproxNameIgnored]() {
+					goto l396
 				}
-				add(ruleUninomialCombo2, position251)
+				add(ruleNameApprox, position397)
 			}
 			return true
-		l250:
-			position, tokenIndex = position250, tokenIndex250
+		l396:
+			position, tokenIndex = position396, tokenIndex396
 			return false
 		},
-		/* 32 RankUninomial <- <(RankUninomialPlain / RankUninomialNotho)> */
+		/* 51 NameComp <- <(GenusWord _ Comparison (_ SpeciesEpithet)?)> */
 		func() bool {
-			position252, tokenIndex252 := position, tokenIndex
+			position400, tokenIndex400 := position, tokenIndex
 			{
-				position253 := position
+				position401 := position
+				if !_rules[ruleGenusWord]() {
+					goto l400
+				}
+				if !_rules[rule_]() {
+					goto l400
+				}
+				if !_rules[ruleComparison]() {
+					goto l400
+				}
 				{
-					position254, tokenIndex254 := position, tokenIndex
-					if !_rules[ruleRankUninomialPlain]() {
-						goto l255
+					position402, tokenIndex402 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l402
 					}
-					goto l254
-				l255:
-					position, tokenIndex = position254, tokenIndex254
-					if !_rules[ruleRankUninomialNotho]() {
-						goto l252
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l402
 					}
+					goto l403
+				l402:
+					position, tokenIndex = position402, tokenIndex402
 				}
-			l254:
-				add(ruleRankUninomial, position253)
+			l403:
+				add(ruleNameComp, position401)
 			}
 			return true
-		l252:
-			position, tokenIndex = position252, tokenIndex252
+		l400:
+			position, tokenIndex = position400, tokenIndex400
 			return false
 		},
-		/* 33 RankUninomialPlain <- <((('s' 'e' 'c' 't') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('t' 'r' 'i' 'b') / ('s' 'u' 'b' 't' 'r' 'i' 'b') / ('s' 'u' 'b' 's' 'e' 'r') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('f' 'a' 'm') / ('s' 'u' 'b' 'f' 'a' 'm') / ('s' 'u' 'p' 'e' 'r' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
+		/* 52 NameSpecies <- <(GenusWord (_? (SubGenus / SubGenusOrSuperspecies))? _ SpeciesEpithet (_ &('(' _? (TaxonConceptLato / TaxonConceptStricto) _? ')' _ InfraspGroup) TaxonConcept)? (_ InfraspGroup)?)> */
 		func() bool {
-			position256, tokenIndex256 := position, tokenIndex
+			position404, tokenIndex404 := position, tokenIndex
 			{
-				position257 := position
+				position405 := position
+				if !_rules[ruleGenusWord]() {
+					goto l404
+				}
 				{
-					position258, tokenIndex258 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l259
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l259
-					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l259
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l259
-					}
-					position++
-					goto l258
-				l259:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l260
+					position406, tokenIndex406 := position, tokenIndex
+					{
+						position408, tokenIndex408 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l408
+						}
+						goto l409
+					l408:
+						position, tokenIndex = position408, tokenIndex408
 					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l260
+				l409:
+					{
+						position410, tokenIndex410 := position, tokenIndex
+						if !_rules[ruleSubGenus]() {
+							goto l411
+						}
+						goto l410
+					l411:
+						position, tokenIndex = position410, tokenIndex410
+						if !_rules[ruleSubGenusOrSuperspecies]() {
+							goto l406
+						}
 					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l260
+				l410:
+					goto l407
+				l406:
+					position, tokenIndex = position406, tokenIndex406
+				}
+			l407:
+				if !_rules[rule_]() {
+					goto l404
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l404
+				}
+				{
+					position412, tokenIndex412 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l412
 					}
-					position++
+					{
+						position414, tokenIndex414 := position, tokenIndex
+						if buffer[position] != rune('(') {
+							goto l412
+						}
+						position++
+						{
+							position415, tokenIndex415 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l415
+							}
+							goto l416
+						l415:
+							position, tokenIndex = position415, tokenIndex415
+						}
+					l416:
+						{
+							position417, tokenIndex417 := position, tokenIndex
+							if !_rules[ruleTaxonConceptLato]() {
+								goto l418
+							}
+							goto l417
+						l418:
+							position, tokenIndex = position417, tokenIndex417
+							if !_rules[ruleTaxonConceptStricto]() {
+								goto l412
+							}
+						}
+					l417:
+						{
+							position419, tokenIndex419 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l419
+							}
+							goto l420
+						l419:
+							position, tokenIndex = position419, tokenIndex419
+						}
+					l420:
+						if buffer[position] != rune(')') {
+							goto l412
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l412
+						}
+						if !_rules[ruleInfraspGroup]() {
+							goto l412
+						}
+						position, tokenIndex = position414, tokenIndex414
+					}
+					if !_rules[ruleTaxonConcept]() {
+						goto l412
+					}
+					goto l413
+				l412:
+					position, tokenIndex = position412, tokenIndex412
+				}
+			l413:
+				{
+					position421, tokenIndex421 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l421
+					}
+					if !_rules[ruleInfraspGroup]() {
+						goto l421
+					}
+					goto l422
+				l421:
+					position, tokenIndex = position421, tokenIndex421
+				}
+			l422:
+				add(ruleNameSpecies, position405)
+			}
+			return true
+		l404:
+			position, tokenIndex = position404, tokenIndex404
+			return false
+		},
+		/* 53 GenusWord <- <((AbbrGenus / UninomialWord) !(_ AuthorWord))> */
+		func() bool {
+			position423, tokenIndex423 := position, tokenIndex
+			{
+				position424 := position
+				{
+					position425, tokenIndex425 := position, tokenIndex
+					if !_rules[ruleAbbrGenus]() {
+						goto l426
+					}
+					goto l425
+				l426:
+					position, tokenIndex = position425, tokenIndex425
+					if !_rules[ruleUninomialWord]() {
+						goto l423
+					}
+				}
+			l425:
+				{
+					position427, tokenIndex427 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l427
+					}
+					if !_rules[ruleAuthorWord]() {
+						goto l427
+					}
+					goto l423
+				l427:
+					position, tokenIndex = position427, tokenIndex427
+				}
+				add(ruleGenusWord, position424)
+			}
+			return true
+		l423:
+			position, tokenIndex = position423, tokenIndex423
+			return false
+		},
+		/* 54 InfraspGroup <- <(InfraspEpithet (_ InfraspEpithet)*)> */
+		func() bool {
+			position428, tokenIndex428 := position, tokenIndex
+			{
+				position429 := position
+				if !_rules[ruleInfraspEpithet]() {
+					goto l428
+				}
+			l430:
+				{
+					position431, tokenIndex431 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l431
+					}
+					if !_rules[ruleInfraspEpithet]() {
+						goto l431
+					}
+					goto l430
+				l431:
+					position, tokenIndex = position431, tokenIndex431
+				}
+				add(ruleInfraspGroup, position429)
+			}
+			return true
+		l428:
+			position, tokenIndex = position428, tokenIndex428
+			return false
+		},
+		/* 55 InfraspEpithet <- <((Rank _?)? !AuthorEx Word (_ Authorship)?)> */
+		func() bool {
+			position432, tokenIndex432 := position, tokenIndex
+			{
+				position433 := position
+				{
+					position434, tokenIndex434 := position, tokenIndex
+					if !_rules[ruleRank]() {
+						goto l434
+					}
+					{
+						position436, tokenIndex436 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l436
+						}
+						goto l437
+					l436:
+						position, tokenIndex = position436, tokenIndex436
+					}
+				l437:
+					goto l435
+				l434:
+					position, tokenIndex = position434, tokenIndex434
+				}
+			l435:
+				{
+					position438, tokenIndex438 := position, tokenIndex
+					if !_rules[ruleAuthorEx]() {
+						goto l438
+					}
+					goto l432
+				l438:
+					position, tokenIndex = position438, tokenIndex438
+				}
+				if !_rules[ruleWord]() {
+					goto l432
+				}
+				{
+					position439, tokenIndex439 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l439
+					}
+					if !_rules[ruleAuthorship]() {
+						goto l439
+					}
+					goto l440
+				l439:
+					position, tokenIndex = position439, tokenIndex439
+				}
+			l440:
+				add(ruleInfraspEpithet, position433)
+			}
+			return true
+		l432:
+			position, tokenIndex = position432, tokenIndex432
+			return false
+		},
+		/* 56 SpeciesEpithet <- <(!AuthorEx Word (_? Authorship)?)> */
+		func() bool {
+			position441, tokenIndex441 := position, tokenIndex
+			{
+				position442 := position
+				{
+					position443, tokenIndex443 := position, tokenIndex
+					if !_rules[ruleAuthorEx]() {
+						goto l443
+					}
+					goto l441
+				l443:
+					position, tokenIndex = position443, tokenIndex443
+				}
+				if !_rules[ruleWord]() {
+					goto l441
+				}
+				{
+					position444, tokenIndex444 := position, tokenIndex
+					{
+						position446, tokenIndex446 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l446
+						}
+						goto l447
+					l446:
+						position, tokenIndex = position446, tokenIndex446
+					}
+				l447:
+					if !_rules[ruleAuthorship]() {
+						goto l444
+					}
+					goto l445
+				l444:
+					position, tokenIndex = position444, tokenIndex444
+				}
+			l445:
+				add(ruleSpeciesEpithet, position442)
+			}
+			return true
+		l441:
+			position, tokenIndex = position441, tokenIndex441
+			return false
+		},
+		/* 57 Comparison <- <('c' 'f' '.'?)> */
+		func() bool {
+			position448, tokenIndex448 := position, tokenIndex
+			{
+				position449 := position
+				if buffer[position] != rune('c') {
+					goto l448
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l448
+				}
+				position++
+				{
+					position450, tokenIndex450 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l450
+					}
+					position++
+					goto l451
+				l450:
+					position, tokenIndex = position450, tokenIndex450
+				}
+			l451:
+				add(ruleComparison, position449)
+			}
+			return true
+		l448:
+			position, tokenIndex = position448, tokenIndex448
+			return false
+		},
+		/* 58 Rank <- <((RankForma / RankVar / RankSsp / RankOtherRare / RankOther / RankOtherUncommon / RankAgamo / RankNotho) (_? LowerGreek ('.' / &SpaceCharEOI))?)> */
+		func() bool {
+			position452, tokenIndex452 := position, tokenIndex
+			{
+				position453 := position
+				{
+					position454, tokenIndex454 := position, tokenIndex
+					if !_rules[ruleRankForma]() {
+						goto l455
+					}
+					goto l454
+				l455:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankVar]() {
+						goto l456
+					}
+					goto l454
+				l456:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankSsp]() {
+						goto l457
+					}
+					goto l454
+				l457:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankOtherRare]() {
+						goto l458
+					}
+					goto l454
+				l458:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankOther]() {
+						goto l459
+					}
+					goto l454
+				l459:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankOtherUncommon]() {
+						goto l460
+					}
+					goto l454
+				l460:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankAgamo]() {
+						goto l461
+					}
+					goto l454
+				l461:
+					position, tokenIndex = position454, tokenIndex454
+					if !_rules[ruleRankNotho]() {
+						goto l452
+					}
+				}
+			l454:
+				{
+					position462, tokenIndex462 := position, tokenIndex
+					{
+						position464, tokenIndex464 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l464
+						}
+						goto l465
+					l464:
+						position, tokenIndex = position464, tokenIndex464
+					}
+				l465:
+					if !_rules[ruleLowerGreek]() {
+						goto l462
+					}
+					{
+						position466, tokenIndex466 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l467
+						}
+						position++
+						goto l466
+					l467:
+						position, tokenIndex = position466, tokenIndex466
+						{
+							position468, tokenIndex468 := position, tokenIndex
+							if !_rules[ruleSpaceCharEOI]() {
+								goto l462
+							}
+							position, tokenIndex = position468, tokenIndex468
+						}
+					}
+				l466:
+					goto l463
+				l462:
+					position, tokenIndex = position462, tokenIndex462
+				}
+			l463:
+				add(ruleRank, position453)
+			}
+			return true
+		l452:
+			position, tokenIndex = position452, tokenIndex452
+			return false
+		},
+		/* 59 RankNotho <- <((('n' 'o' 't' 'h' 'o' (('v' 'a' 'r') / ('f' 'o') / 'f' / ('s' 'u' 'b' 's' 'p') / ('s' 's' 'p') / ('s' 'p') / ('m' 'o' 'r' 't' 'h') / ('s' 'u' 'p' 's' 'p') / ('s' 'u'))) / ('n' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		func() bool {
+			position469, tokenIndex469 := position, tokenIndex
+			{
+				position470 := position
+				{
+					position471, tokenIndex471 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l472
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l472
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l472
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l472
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l472
+					}
+					position++
+					{
+						position473, tokenIndex473 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l474
+						}
+						position++
+						goto l473
+					l474:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('f') {
+							goto l475
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l475
+						}
+						position++
+						goto l473
+					l475:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('f') {
+							goto l476
+						}
+						position++
+						goto l473
+					l476:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('s') {
+							goto l477
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l477
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l477
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l477
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l477
+						}
+						position++
+						goto l473
+					l477:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('s') {
+							goto l478
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l478
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l478
+						}
+						position++
+						goto l473
+					l478:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('s') {
+							goto l479
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l479
+						}
+						position++
+						goto l473
+					l479:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('m') {
+							goto l480
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l480
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l480
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l480
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l480
+						}
+						position++
+						goto l473
+					l480:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('s') {
+							goto l481
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l481
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l481
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l481
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l481
+						}
+						position++
+						goto l473
+					l481:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('s') {
+							goto l472
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l472
+						}
+						position++
+					}
+				l473:
+					goto l471
+				l472:
+					position, tokenIndex = position471, tokenIndex471
+					if buffer[position] != rune('n') {
+						goto l469
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l469
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l469
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l469
+					}
+					position++
+				}
+			l471:
+				{
+					position482, tokenIndex482 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l483
+					}
+					position++
+					goto l482
+				l483:
+					position, tokenIndex = position482, tokenIndex482
+					{
+						position484, tokenIndex484 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l469
+						}
+						position, tokenIndex = position484, tokenIndex484
+					}
+				}
+			l482:
+				add(ruleRankNotho, position470)
+			}
+			return true
+		l469:
+			position, tokenIndex = position469, tokenIndex469
+			return false
+		},
+		/* 60 RankOtherUncommon <- <(('*' / ('n' 'a' 't' 'i' 'o') / ('n' 'a' 't' '.') / ('n' 'a' 't') / ('f' '.' 's' 'p') / 'α' / ('β' 'β') / 'β' / 'γ' / 'δ' / 'ε' / 'φ' / 'θ' / 'μ' / ('a' '.') / ('b' '.') / ('c' '.') / ('d' '.') / ('e' '.') / ('g' '.') / ('k' '.') / ('m' 'u' 't' '.')) &SpaceCharEOI)> */
+		func() bool {
+			position485, tokenIndex485 := position, tokenIndex
+			{
+				position486 := position
+				{
+					position487, tokenIndex487 := position, tokenIndex
+					if buffer[position] != rune('*') {
+						goto l488
+					}
+					position++
+					goto l487
+				l488:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('n') {
+						goto l489
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l489
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l489
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l489
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l489
+					}
+					position++
+					goto l487
+				l489:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('n') {
+						goto l490
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l490
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l490
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l490
+					}
+					position++
+					goto l487
+				l490:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('n') {
+						goto l491
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l491
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l491
+					}
+					position++
+					goto l487
+				l491:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('f') {
+						goto l492
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l492
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l492
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l492
+					}
+					position++
+					goto l487
+				l492:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('α') {
+						goto l493
+					}
+					position++
+					goto l487
+				l493:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('β') {
+						goto l494
+					}
+					position++
+					if buffer[position] != rune('β') {
+						goto l494
+					}
+					position++
+					goto l487
+				l494:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('β') {
+						goto l495
+					}
+					position++
+					goto l487
+				l495:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('γ') {
+						goto l496
+					}
+					position++
+					goto l487
+				l496:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('δ') {
+						goto l497
+					}
+					position++
+					goto l487
+				l497:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('ε') {
+						goto l498
+					}
+					position++
+					goto l487
+				l498:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('φ') {
+						goto l499
+					}
+					position++
+					goto l487
+				l499:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('θ') {
+						goto l500
+					}
+					position++
+					goto l487
+				l500:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('μ') {
+						goto l501
+					}
+					position++
+					goto l487
+				l501:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('a') {
+						goto l502
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l502
+					}
+					position++
+					goto l487
+				l502:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('b') {
+						goto l503
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l503
+					}
+					position++
+					goto l487
+				l503:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('c') {
+						goto l504
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l504
+					}
+					position++
+					goto l487
+				l504:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('d') {
+						goto l505
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l505
+					}
+					position++
+					goto l487
+				l505:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('e') {
+						goto l506
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l506
+					}
+					position++
+					goto l487
+				l506:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('g') {
+						goto l507
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l507
+					}
+					position++
+					goto l487
+				l507:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('k') {
+						goto l508
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l508
+					}
+					position++
+					goto l487
+				l508:
+					position, tokenIndex = position487, tokenIndex487
+					if buffer[position] != rune('m') {
+						goto l485
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l485
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l485
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l485
+					}
+					position++
+				}
+			l487:
+				{
+					position509, tokenIndex509 := position, tokenIndex
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l485
+					}
+					position, tokenIndex = position509, tokenIndex509
+				}
+				add(ruleRankOtherUncommon, position486)
+			}
+			return true
+		l485:
+			position, tokenIndex = position485, tokenIndex485
+			return false
+		},
+		/* 61 RankOtherRare <- <((('s' 'e' 'r' 'o' 'v' 'a' 'r') / ('s' 'u' 'b' 'r' 'a' 'c' 'e') / ('b' 'i' 'o' 'v' 'a' 'r') / ('p' 'r' 'o' 'l' 'e' 's') / ('p' 'r' 'o' 'l') / ('s' 'u' 'b' 'p' 'r' 'o' 'l') / ('s' 'u' 'b' 'l' 'u' 's' 'u' 's') / ('l' 'u' 's' 'u' 's') / ('c' 'u' 'l' 't' 'i' 'v' 'a' 'r') / ('m' 'o' 'd' 'i' 'f') / ('m' 'o' 'n' 's' 't' 'r')) ('.' / &(_ Word)))> */
+		func() bool {
+			position510, tokenIndex510 := position, tokenIndex
+			{
+				position511 := position
+				{
+					position512, tokenIndex512 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l513
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l513
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l513
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l513
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l513
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l513
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l513
+					}
+					position++
+					goto l512
+				l513:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('s') {
+						goto l514
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l514
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l514
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l514
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l514
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l514
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l514
+					}
+					position++
+					goto l512
+				l514:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('b') {
+						goto l515
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l515
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l515
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l515
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l515
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l515
+					}
+					position++
+					goto l512
+				l515:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('p') {
+						goto l516
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l516
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l516
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l516
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l516
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l516
+					}
+					position++
+					goto l512
+				l516:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('p') {
+						goto l517
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l517
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l517
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l517
+					}
+					position++
+					goto l512
+				l517:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('s') {
+						goto l518
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l518
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l518
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l518
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l518
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l518
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l518
+					}
+					position++
+					goto l512
+				l518:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('s') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l519
+					}
+					position++
+					goto l512
+				l519:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('l') {
+						goto l520
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l520
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l520
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l520
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l520
+					}
+					position++
+					goto l512
+				l520:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('c') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l521
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l521
+					}
+					position++
+					goto l512
+				l521:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('m') {
+						goto l522
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l522
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l522
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l522
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l522
+					}
+					position++
+					goto l512
+				l522:
+					position, tokenIndex = position512, tokenIndex512
+					if buffer[position] != rune('m') {
+						goto l510
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l510
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l510
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l510
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l510
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l510
+					}
+					position++
+				}
+			l512:
+				{
+					position523, tokenIndex523 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l524
+					}
+					position++
+					goto l523
+				l524:
+					position, tokenIndex = position523, tokenIndex523
+					{
+						position525, tokenIndex525 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l510
+						}
+						if !_rules[ruleWord]() {
+							goto l510
+						}
+						position, tokenIndex = position525, tokenIndex525
+					}
+				}
+			l523:
+				add(ruleRankOtherRare, position511)
+			}
+			return true
+		l510:
+			position, tokenIndex = position510, tokenIndex510
+			return false
+		},
+		/* 62 RankOther <- <((('m' 'o' 'r' 'p' 'h') / ('c' 'o' 'n' 'v' 'a' 'r') / ('p' 's' 'e' 'u' 'd' 'o' 'v' 'a' 'r') / ('s' 'e' 'c' 't') / ('s' 'e' 'r') / ('s' 'u' 'b' 'v' 'a' 'r') / ('s' 'u' 'b' 'f') / ('r' 'a' 'c' 'e') / ('p' 'v') / ('p' 'a' 't' 'h' 'o' 'v' 'a' 'r') / ('a' 'b' '.' (_? ('n' '.'))?) / ('s' 't')) ('.' / &SpaceCharEOI))> */
+		func() bool {
+			position526, tokenIndex526 := position, tokenIndex
+			{
+				position527 := position
+				{
+					position528, tokenIndex528 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l529
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l529
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l529
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l529
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l529
+					}
+					position++
+					goto l528
+				l529:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('c') {
+						goto l530
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l530
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l530
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l530
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l530
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l530
+					}
+					position++
+					goto l528
+				l530:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('p') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l531
+					}
+					position++
+					goto l528
+				l531:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('s') {
+						goto l532
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l532
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l532
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l532
+					}
+					position++
+					goto l528
+				l532:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('s') {
+						goto l533
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l533
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l533
+					}
+					position++
+					goto l528
+				l533:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('s') {
+						goto l534
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l534
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l534
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l534
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l534
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l534
+					}
+					position++
+					goto l528
+				l534:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('s') {
+						goto l535
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l535
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l535
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l535
+					}
+					position++
+					goto l528
+				l535:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('r') {
+						goto l536
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l536
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l536
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l536
+					}
+					position++
+					goto l528
+				l536:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('p') {
+						goto l537
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l537
+					}
+					position++
+					goto l528
+				l537:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('p') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l538
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l538
+					}
+					position++
+					goto l528
+				l538:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('a') {
+						goto l539
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l539
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l539
+					}
+					position++
+					{
+						position540, tokenIndex540 := position, tokenIndex
+						{
+							position542, tokenIndex542 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l542
+							}
+							goto l543
+						l542:
+							position, tokenIndex = position542, tokenIndex542
+						}
+					l543:
+						if buffer[position] != rune('n') {
+							goto l540
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l540
+						}
+						position++
+						goto l541
+					l540:
+						position, tokenIndex = position540, tokenIndex540
+					}
+				l541:
+					goto l528
+				l539:
+					position, tokenIndex = position528, tokenIndex528
+					if buffer[position] != rune('s') {
+						goto l526
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l526
+					}
+					position++
+				}
+			l528:
+				{
+					position544, tokenIndex544 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l545
+					}
+					position++
+					goto l544
+				l545:
+					position, tokenIndex = position544, tokenIndex544
+					{
+						position546, tokenIndex546 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l526
+						}
+						position, tokenIndex = position546, tokenIndex546
+					}
+				}
+			l544:
+				add(ruleRankOther, position527)
+			}
+			return true
+		l526:
+			position, tokenIndex = position526, tokenIndex526
+			return false
+		},
+		/* 63 RankVar <- <((('v' 'a' 'r' 'i' 'e' 't' 'y') / ('[' 'v' 'a' 'r' '.' ']') / ('v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		func() bool {
+			position547, tokenIndex547 := position, tokenIndex
+			{
+				position548 := position
+				{
+					position549, tokenIndex549 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l550
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l550
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l550
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l550
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l550
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l550
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l550
+					}
+					position++
+					goto l549
+				l550:
+					position, tokenIndex = position549, tokenIndex549
+					if buffer[position] != rune('[') {
+						goto l551
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l551
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l551
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l551
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l551
+					}
+					position++
+					if buffer[position] != rune(']') {
+						goto l551
+					}
+					position++
+					goto l549
+				l551:
+					position, tokenIndex = position549, tokenIndex549
+					if buffer[position] != rune('v') {
+						goto l547
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l547
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l547
+					}
+					position++
+				}
+			l549:
+				{
+					position552, tokenIndex552 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l553
+					}
+					position++
+					goto l552
+				l553:
+					position, tokenIndex = position552, tokenIndex552
+					{
+						position554, tokenIndex554 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l547
+						}
+						position, tokenIndex = position554, tokenIndex554
+					}
+				}
+			l552:
+				add(ruleRankVar, position548)
+			}
+			return true
+		l547:
+			position, tokenIndex = position547, tokenIndex547
+			return false
+		},
+		/* 64 RankForma <- <((('f' 'o' 'r' 'm' 'a') / ('f' 'm' 'a') / ('f' 'o' 'r' 'm') / ('f' 'o') / 'f') ('.' / &SpaceCharEOI))> */
+		func() bool {
+			position555, tokenIndex555 := position, tokenIndex
+			{
+				position556 := position
+				{
+					position557, tokenIndex557 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l558
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l558
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l558
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l558
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l558
+					}
+					position++
+					goto l557
+				l558:
+					position, tokenIndex = position557, tokenIndex557
+					if buffer[position] != rune('f') {
+						goto l559
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l559
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l559
+					}
+					position++
+					goto l557
+				l559:
+					position, tokenIndex = position557, tokenIndex557
+					if buffer[position] != rune('f') {
+						goto l560
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l560
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l560
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l560
+					}
+					position++
+					goto l557
+				l560:
+					position, tokenIndex = position557, tokenIndex557
+					if buffer[position] != rune('f') {
+						goto l561
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l561
+					}
+					position++
+					goto l557
+				l561:
+					position, tokenIndex = position557, tokenIndex557
+					if buffer[position] != rune('f') {
+						goto l555
+					}
+					position++
+				}
+			l557:
+				{
+					position562, tokenIndex562 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l563
+					}
+					position++
+					goto l562
+				l563:
+					position, tokenIndex = position562, tokenIndex562
+					{
+						position564, tokenIndex564 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l555
+						}
+						position, tokenIndex = position564, tokenIndex564
+					}
+				}
+			l562:
+				add(ruleRankForma, position556)
+			}
+			return true
+		l555:
+			position, tokenIndex = position555, tokenIndex555
+			return false
+		},
+		/* 65 RankSsp <- <((('s' 's' 'p') / ('s' 'u' 'b' 's' 'p' 'e' 'c') / ('s' 'u' 'b' 's' 'p')) ('.' / &SpaceCharEOI))> */
+		func() bool {
+			position565, tokenIndex565 := position, tokenIndex
+			{
+				position566 := position
+				{
+					position567, tokenIndex567 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l568
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l568
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l568
+					}
+					position++
+					goto l567
+				l568:
+					position, tokenIndex = position567, tokenIndex567
+					if buffer[position] != rune('s') {
+						goto l569
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l569
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l569
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l569
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l569
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l569
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l569
+					}
+					position++
+					goto l567
+				l569:
+					position, tokenIndex = position567, tokenIndex567
+					if buffer[position] != rune('s') {
+						goto l565
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l565
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l565
+					}
+					position++
 					if buffer[position] != rune('s') {
-						goto l260
+						goto l565
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l565
+					}
+					position++
+				}
+			l567:
+				{
+					position570, tokenIndex570 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l571
+					}
+					position++
+					goto l570
+				l571:
+					position, tokenIndex = position570, tokenIndex570
+					{
+						position572, tokenIndex572 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l565
+						}
+						position, tokenIndex = position572, tokenIndex572
+					}
+				}
+			l570:
+				add(ruleRankSsp, position566)
+			}
+			return true
+		l565:
+			position, tokenIndex = position565, tokenIndex565
+			return false
+		},
+		/* 66 RankAgamo <- <((('a' 'g' 'a' 'm' 'o' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 's' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		func() bool {
+			position573, tokenIndex573 := position, tokenIndex
+			{
+				position574 := position
+				{
+					position575, tokenIndex575 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l576
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l576
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l576
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l576
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l576
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l576
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l576
+					}
+					position++
+					goto l575
+				l576:
+					position, tokenIndex = position575, tokenIndex575
+					if buffer[position] != rune('a') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l577
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l577
+					}
+					position++
+					goto l575
+				l577:
+					position, tokenIndex = position575, tokenIndex575
+					if buffer[position] != rune('a') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l573
+					}
+					position++
+				}
+			l575:
+				{
+					position578, tokenIndex578 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l579
+					}
+					position++
+					goto l578
+				l579:
+					position, tokenIndex = position578, tokenIndex578
+					{
+						position580, tokenIndex580 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l573
+						}
+						position, tokenIndex = position580, tokenIndex580
+					}
+				}
+			l578:
+				add(ruleRankAgamo, position574)
+			}
+			return true
+		l573:
+			position, tokenIndex = position573, tokenIndex573
+			return false
+		},
+		/* 67 SubGenusOrSuperspecies <- <('(' _? NameLowerChar+ _? ')')> */
+		func() bool {
+			position581, tokenIndex581 := position, tokenIndex
+			{
+				position582 := position
+				if buffer[position] != rune('(') {
+					goto l581
+				}
+				position++
+				{
+					position583, tokenIndex583 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l583
+					}
+					goto l584
+				l583:
+					position, tokenIndex = position583, tokenIndex583
+				}
+			l584:
+				if !_rules[ruleNameLowerChar]() {
+					goto l581
+				}
+			l585:
+				{
+					position586, tokenIndex586 := position, tokenIndex
+					if !_rules[ruleNameLowerChar]() {
+						goto l586
+					}
+					goto l585
+				l586:
+					position, tokenIndex = position586, tokenIndex586
+				}
+				{
+					position587, tokenIndex587 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l587
+					}
+					goto l588
+				l587:
+					position, tokenIndex = position587, tokenIndex587
+				}
+			l588:
+				if buffer[position] != rune(')') {
+					goto l581
+				}
+				position++
+				add(ruleSubGenusOrSuperspecies, position582)
+			}
+			return true
+		l581:
+			position, tokenIndex = position581, tokenIndex581
+			return false
+		},
+		/* 68 SubGenus <- <('(' _? UninomialWord _? ')')> */
+		func() bool {
+			position589, tokenIndex589 := position, tokenIndex
+			{
+				position590 := position
+				if buffer[position] != rune('(') {
+					goto l589
+				}
+				position++
+				{
+					position591, tokenIndex591 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l591
+					}
+					goto l592
+				l591:
+					position, tokenIndex = position591, tokenIndex591
+				}
+			l592:
+				if !_rules[ruleUninomialWord]() {
+					goto l589
+				}
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l593
+					}
+					goto l594
+				l593:
+					position, tokenIndex = position593, tokenIndex593
+				}
+			l594:
+				if buffer[position] != rune(')') {
+					goto l589
+				}
+				position++
+				add(ruleSubGenus, position590)
+			}
+			return true
+		l589:
+			position, tokenIndex = position589, tokenIndex589
+			return false
+		},
+		/* 69 UninomialCombo <- <(UninomialCombo1 / UninomialCombo2)> */
+		func() bool {
+			position595, tokenIndex595 := position, tokenIndex
+			{
+				position596 := position
+				{
+					position597, tokenIndex597 := position, tokenIndex
+					if !_rules[ruleUninomialCombo1]() {
+						goto l598
+					}
+					goto l597
+				l598:
+					position, tokenIndex = position597, tokenIndex597
+					if !_rules[ruleUninomialCombo2]() {
+						goto l595
+					}
+				}
+			l597:
+				add(ruleUninomialCombo, position596)
+			}
+			return true
+		l595:
+			position, tokenIndex = position595, tokenIndex595
+			return false
+		},
+		/* 70 UninomialCombo1 <- <(UninomialWord _? SubGenus (_? Authorship)?)> */
+		func() bool {
+			position599, tokenIndex599 := position, tokenIndex
+			{
+				position600 := position
+				if !_rules[ruleUninomialWord]() {
+					goto l599
+				}
+				{
+					position601, tokenIndex601 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l601
+					}
+					goto l602
+				l601:
+					position, tokenIndex = position601, tokenIndex601
+				}
+			l602:
+				if !_rules[ruleSubGenus]() {
+					goto l599
+				}
+				{
+					position603, tokenIndex603 := position, tokenIndex
+					{
+						position605, tokenIndex605 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l605
+						}
+						goto l606
+					l605:
+						position, tokenIndex = position605, tokenIndex605
+					}
+				l606:
+					if !_rules[ruleAuthorship]() {
+						goto l603
+					}
+					goto l604
+				l603:
+					position, tokenIndex = position603, tokenIndex603
+				}
+			l604:
+				add(ruleUninomialCombo1, position600)
+			}
+			return true
+		l599:
+			position, tokenIndex = position599, tokenIndex599
+			return false
+		},
+		/* 71 UninomialCombo2 <- <(Uninomial _ RankUninomial _ Uninomial)> */
+		func() bool {
+			position607, tokenIndex607 := position, tokenIndex
+			{
+				position608 := position
+				if !_rules[ruleUninomial]() {
+					goto l607
+				}
+				if !_rules[rule_]() {
+					goto l607
+				}
+				if !_rules[ruleRankUninomial]() {
+					goto l607
+				}
+				if !_rules[rule_]() {
+					goto l607
+				}
+				if !_rules[ruleUninomial]() {
+					goto l607
+				}
+				add(ruleUninomialCombo2, position608)
+			}
+			return true
+		l607:
+			position, tokenIndex = position607, tokenIndex607
+			return false
+		},
+		/* 72 RankUninomial <- <(RankUninomialPlain / RankUninomialNotho)> */
+		func() bool {
+			position609, tokenIndex609 := position, tokenIndex
+			{
+				position610 := position
+				{
+					position611, tokenIndex611 := position, tokenIndex
+					if !_rules[ruleRankUninomialPlain]() {
+						goto l612
+					}
+					goto l611
+				l612:
+					position, tokenIndex = position611, tokenIndex611
+					if !_rules[ruleRankUninomialNotho]() {
+						goto l609
+					}
+				}
+			l611:
+				add(ruleRankUninomial, position610)
+			}
+			return true
+		l609:
+			position, tokenIndex = position609, tokenIndex609
+			return false
+		},
+		/* 73 RankUninomialPlain <- <(((('s' 'e' 'c' 't') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('t' 'r' 'i' 'b') / ('s' 'u' 'b' 't' 'r' 'i' 'b') / ('s' 'u' 'b' 's' 'e' 'r') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('f' 'a' 'm') / ('s' 'u' 'b' 'f' 'a' 'm') / ('s' 'u' 'p' 'e' 'r' 'f' 'a' 'm') / ('s' 'u' 'p' 'e' 'r' 't' 'r' 'i' 'b') / ('m' 'i' 'c' 'r' 'o' 'g' 'e' 'n') / ('i' 'n' 'f' 'r' 'a' 'o' 'r' 'd')) ('.' / &SpaceCharEOI)) / ('c' 'o' 'h' 'o' 'r' 's' ('.' / &(_ UninomialWord))))> */
+		func() bool {
+			position613, tokenIndex613 := position, tokenIndex
+			{
+				position614 := position
+				{
+					position615, tokenIndex615 := position, tokenIndex
+					{
+						position617, tokenIndex617 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l618
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l618
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l618
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l618
+						}
+						position++
+						goto l617
+					l618:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l619
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l619
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l619
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l619
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l619
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l619
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l619
+						}
+						position++
+						goto l617
+					l619:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('t') {
+							goto l620
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l620
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l620
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l620
+						}
+						position++
+						goto l617
+					l620:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l621
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l621
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l621
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l621
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l621
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l621
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l621
+						}
+						position++
+						goto l617
+					l621:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l622
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l622
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l622
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l622
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l622
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l622
+						}
+						position++
+						goto l617
+					l622:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l623
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l623
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l623
+						}
+						position++
+						goto l617
+					l623:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l624
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l624
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l624
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l624
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l624
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l624
+						}
+						position++
+						goto l617
+					l624:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l625
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l625
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l625
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l625
+						}
+						position++
+						goto l617
+					l625:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('f') {
+							goto l626
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l626
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l626
+						}
+						position++
+						goto l617
+					l626:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l627
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l627
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l627
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l627
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l627
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l627
+						}
+						position++
+						goto l617
+					l627:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l628
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l628
+						}
+						position++
+						goto l617
+					l628:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('s') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l629
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l629
+						}
+						position++
+						goto l617
+					l629:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('m') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l630
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l630
+						}
+						position++
+						goto l617
+					l630:
+						position, tokenIndex = position617, tokenIndex617
+						if buffer[position] != rune('i') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l616
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l616
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l260
+				l617:
+					{
+						position631, tokenIndex631 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l632
+						}
+						position++
+						goto l631
+					l632:
+						position, tokenIndex = position631, tokenIndex631
+						{
+							position633, tokenIndex633 := position, tokenIndex
+							if !_rules[ruleSpaceCharEOI]() {
+								goto l616
+							}
+							position, tokenIndex = position633, tokenIndex633
+						}
 					}
-					position++
+				l631:
+					goto l615
+				l616:
+					position, tokenIndex = position615, tokenIndex615
 					if buffer[position] != rune('c') {
-						goto l260
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l260
-					}
-					position++
-					goto l258
-				l260:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('t') {
-						goto l261
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l261
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l261
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l261
-					}
-					position++
-					goto l258
-				l261:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l262
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l262
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l262
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l262
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l262
+						goto l613
 					}
 					position++
-					if buffer[position] != rune('i') {
-						goto l262
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l262
-					}
-					position++
-					goto l258
-				l262:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l263
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l263
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l263
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l263
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l263
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l263
+					if buffer[position] != rune('o') {
+						goto l613
 					}
 					position++
-					goto l258
-				l263:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l264
+					if buffer[position] != rune('h') {
+						goto l613
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l264
+					if buffer[position] != rune('o') {
+						goto l613
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l264
-					}
-					position++
-					goto l258
-				l264:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l265
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l265
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l265
-					}
-					position++
-					if buffer[position] != rune('g') {
-						goto l265
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l265
-					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l265
-					}
-					position++
-					goto l258
-				l265:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l266
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l266
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l266
+						goto l613
 					}
 					position++
-					if buffer[position] != rune('g') {
-						goto l266
-					}
-					position++
-					goto l258
-				l266:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('f') {
-						goto l267
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l267
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l267
-					}
-					position++
-					goto l258
-				l267:
-					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('s') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('f') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('m') {
-						goto l268
-					}
-					position++
-					goto l258
-				l268:
-					position, tokenIndex = position258, tokenIndex258
 					if buffer[position] != rune('s') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l256
+						goto l613
 					}
 					position++
-					if buffer[position] != rune('i') {
-						goto l256
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l256
-					}
-					position++
-				}
-			l258:
-				{
-					position269, tokenIndex269 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l270
-					}
-					position++
-					goto l269
-				l270:
-					position, tokenIndex = position269, tokenIndex269
 					{
-						position271, tokenIndex271 := position, tokenIndex
-						if !_rules[ruleSpaceCharEOI]() {
-							goto l256
+						position634, tokenIndex634 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l635
+						}
+						position++
+						goto l634
+					l635:
+						position, tokenIndex = position634, tokenIndex634
+						{
+							position636, tokenIndex636 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l613
+							}
+							if !_rules[ruleUninomialWord]() {
+								goto l613
+							}
+							position, tokenIndex = position636, tokenIndex636
 						}
-						position, tokenIndex = position271, tokenIndex271
 					}
+				l634:
 				}
-			l269:
-				add(ruleRankUninomialPlain, position257)
+			l615:
+				add(ruleRankUninomialPlain, position614)
 			}
 			return true
-		l256:
-			position, tokenIndex = position256, tokenIndex256
+		l613:
+			position, tokenIndex = position613, tokenIndex613
 			return false
 		},
-		/* 34 RankUninomialNotho <- <('n' 'o' 't' 'h' 'o' _? (('s' 'e' 'c' 't') / ('g' 'e' 'n') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'e' 'n') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('s' 'u' 'b' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
+		/* 74 RankUninomialNotho <- <('n' 'o' 't' 'h' 'o' _? (('s' 'e' 'c' 't') / ('g' 'e' 'n') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'e' 'n') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('s' 'u' 'b' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position272, tokenIndex272 := position, tokenIndex
+			position637, tokenIndex637 := position, tokenIndex
 			{
-				position273 := position
+				position638 := position
 				if buffer[position] != rune('n') {
-					goto l272
+					goto l637
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l272
+					goto l637
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l272
+					goto l637
 				}
 				position++
 				if buffer[position] != rune('h') {
-					goto l272
+					goto l637
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l272
+					goto l637
 				}
 				position++
 				{
-					position274, tokenIndex274 := position, tokenIndex
+					position639, tokenIndex639 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l274
+						goto l639
 					}
-					goto l275
-				l274:
-					position, tokenIndex = position274, tokenIndex274
+					goto l640
+				l639:
+					position, tokenIndex = position639, tokenIndex639
 				}
-			l275:
+			l640:
 				{
-					position276, tokenIndex276 := position, tokenIndex
+					position641, tokenIndex641 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l277
+						goto l642
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l277
+						goto l642
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l277
+						goto l642
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l277
+						goto l642
 					}
 					position++
-					goto l276
-				l277:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l642:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('g') {
-						goto l278
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l278
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l278
+						goto l643
 					}
 					position++
-					goto l276
-				l278:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l643:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l279
+						goto l644
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l279
+						goto l644
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l279
+						goto l644
 					}
 					position++
-					goto l276
-				l279:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l644:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l280
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l280
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l280
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l280
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l280
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l280
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l280
+						goto l645
 					}
 					position++
-					goto l276
-				l280:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l645:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l281
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l281
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l281
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l281
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l281
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l281
+						goto l646
 					}
 					position++
-					goto l276
-				l281:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l646:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l282
+						goto l647
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l282
+						goto l647
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l282
+						goto l647
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l282
+						goto l647
 					}
 					position++
-					goto l276
-				l282:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l647:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l283
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l283
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l283
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l283
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l283
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l283
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l283
+						goto l648
 					}
 					position++
-					goto l276
-				l283:
-					position, tokenIndex = position276, tokenIndex276
+					goto l641
+				l648:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l272
+						goto l637
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l272
+						goto l637
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l272
+						goto l637
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l272
+						goto l637
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l272
+						goto l637
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l272
+						goto l637
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l272
+						goto l637
 					}
 					position++
 				}
-			l276:
+			l641:
 				{
-					position284, tokenIndex284 := position, tokenIndex
+					position649, tokenIndex649 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l285
+						goto l650
 					}
 					position++
-					goto l284
-				l285:
-					position, tokenIndex = position284, tokenIndex284
+					goto l649
+				l650:
+					position, tokenIndex = position649, tokenIndex649
 					{
-						position286, tokenIndex286 := position, tokenIndex
+						position651, tokenIndex651 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l272
+							goto l637
 						}
-						position, tokenIndex = position286, tokenIndex286
+						position, tokenIndex = position651, tokenIndex651
 					}
 				}
-			l284:
-				add(ruleRankUninomialNotho, position273)
+			l649:
+				add(ruleRankUninomialNotho, position638)
 			}
 			return true
-		l272:
-			position, tokenIndex = position272, tokenIndex272
+		l637:
+			position, tokenIndex = position637, tokenIndex637
 			return false
 		},
-		/* 35 Uninomial <- <(UninomialWord (_ Authorship)?)> */
+		/* 75 Uninomial <- <(UninomialWord (_ Authorship)?)> */
 		func() bool {
-			position287, tokenIndex287 := position, tokenIndex
+			position652, tokenIndex652 := position, tokenIndex
 			{
-				position288 := position
+				position653 := position
 				if !_rules[ruleUninomialWord]() {
-					goto l287
+					goto l652
 				}
 				{
-					position289, tokenIndex289 := position, tokenIndex
+					position654, tokenIndex654 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l289
+						goto l654
 					}
 					if !_rules[ruleAuthorship]() {
-						goto l289
+						goto l654
 					}
-					goto l290
-				l289:
-					position, tokenIndex = position289, tokenIndex289
+					goto l655
+				l654:
+					position, tokenIndex = position654, tokenIndex654
 				}
-			l290:
-				add(ruleUninomial, position288)
+			l655:
+				add(ruleUninomial, position653)
 			}
 			return true
-		l287:
-			position, tokenIndex = position287, tokenIndex287
+		l652:
+			position, tokenIndex = position652, tokenIndex652
 			return false
 		},
-		/* 36 UninomialWord <- <(CapWord / TwoLetterGenus)> */
+		/* 76 UninomialWord <- <(CapWord / TwoLetterGenus)> */
 		func() bool {
-			position291, tokenIndex291 := position, tokenIndex
+			position656, tokenIndex656 := position, tokenIndex
 			{
-				position292 := position
+				position657 := position
 				{
-					position293, tokenIndex293 := position, tokenIndex
+					position658, tokenIndex658 := position, tokenIndex
 					if !_rules[ruleCapWord]() {
-						goto l294
+						goto l659
 					}
-					goto l293
-				l294:
-					position, tokenIndex = position293, tokenIndex293
+					goto l658
+				l659:
+					position, tokenIndex = position658, tokenIndex658
 					if !_rules[ruleTwoLetterGenus]() {
-						goto l291
+						goto l656
 					}
 				}
-			l293:
-				add(ruleUninomialWord, position292)
+			l658:
+				add(ruleUninomialWord, position657)
 			}
 			return true
-		l291:
-			position, tokenIndex = position291, tokenIndex291
+		l656:
+			position, tokenIndex = position656, tokenIndex656
 			return false
 		},
-		/* 37 AbbrGenus <- <(UpperChar LowerChar? '.')> */
+		/* 77 AbbrGenus <- <(UpperChar LowerChar? '.')> */
 		func() bool {
-			position295, tokenIndex295 := position, tokenIndex
+			position660, tokenIndex660 := position, tokenIndex
 			{
-				position296 := position
+				position661 := position
 				if !_rules[ruleUpperChar]() {
-					goto l295
+					goto l660
 				}
 				{
-					position297, tokenIndex297 := position, tokenIndex
+					position662, tokenIndex662 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l297
+						goto l662
 					}
-					goto l298
-				l297:
-					position, tokenIndex = position297, tokenIndex297
+					goto l663
+				l662:
+					position, tokenIndex = position662, tokenIndex662
 				}
-			l298:
+			l663:
 				if buffer[position] != rune('.') {
-					goto l295
+					goto l660
 				}
 				position++
-				add(ruleAbbrGenus, position296)
+				add(ruleAbbrGenus, position661)
 			}
 			return true
-		l295:
-			position, tokenIndex = position295, tokenIndex295
+		l660:
+			position, tokenIndex = position660, tokenIndex660
 			return false
 		},
-		/* 38 CapWord <- <(CapWordWithDash / CapWord1)> */
+		/* 78 CapWord <- <(CapWordWithDash / CapWord1)> */
 		func() bool {
-			position299, tokenIndex299 := position, tokenIndex
+			position664, tokenIndex664 := position, tokenIndex
 			{
-				position300 := position
+				position665 := position
 				{
-					position301, tokenIndex301 := position, tokenIndex
+					position666, tokenIndex666 := position, tokenIndex
 					if !_rules[ruleCapWordWithDash]() {
-						goto l302
+						goto l667
 					}
-					goto l301
-				l302:
-					position, tokenIndex = position301, tokenIndex301
+					goto l666
+				l667:
+					position, tokenIndex = position666, tokenIndex666
 					if !_rules[ruleCapWord1]() {
-						goto l299
+						goto l664
 					}
 				}
-			l301:
-				add(ruleCapWord, position300)
+			l666:
+				add(ruleCapWord, position665)
 			}
 			return true
-		l299:
-			position, tokenIndex = position299, tokenIndex299
+		l664:
+			position, tokenIndex = position664, tokenIndex664
 			return false
 		},
-		/* 39 CapWord1 <- <(NameUpperChar NameLowerChar NameLowerChar+ '?'?)> */
+		/* 79 CapWord1 <- <(NameUpperChar NameLowerChar NameLowerChar+ '?'?)> */
 		func() bool {
-			position303, tokenIndex303 := position, tokenIndex
+			position668, tokenIndex668 := position, tokenIndex
 			{
-				position304 := position
+				position669 := position
 				if !_rules[ruleNameUpperChar]() {
-					goto l303
+					goto l668
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l303
+					goto l668
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l303
+					goto l668
 				}
-			l305:
+			l670:
 				{
-					position306, tokenIndex306 := position, tokenIndex
+					position671, tokenIndex671 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l306
+						goto l671
 					}
-					goto l305
-				l306:
-					position, tokenIndex = position306, tokenIndex306
+					goto l670
+				l671:
+					position, tokenIndex = position671, tokenIndex671
 				}
 				{
-					position307, tokenIndex307 := position, tokenIndex
+					position672, tokenIndex672 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l307
+						goto l672
 					}
 					position++
-					goto l308
-				l307:
-					position, tokenIndex = position307, tokenIndex307
+					goto l673
+				l672:
+					position, tokenIndex = position672, tokenIndex672
 				}
-			l308:
-				add(ruleCapWord1, position304)
+			l673:
+				add(ruleCapWord1, position669)
 			}
 			return true
-		l303:
-			position, tokenIndex = position303, tokenIndex303
+		l668:
+			position, tokenIndex = position668, tokenIndex668
 			return false
 		},
-		/* 40 CapWordWithDash <- <(CapWord1 Dash (UpperAfterDash / LowerAfterDash))> */
+		/* 80 CapWordWithDash <- <(CapWord1 Dash (UpperAfterDash / LowerAfterDash))> */
 		func() bool {
-			position309, tokenIndex309 := position, tokenIndex
+			position674, tokenIndex674 := position, tokenIndex
 			{
-				position310 := position
+				position675 := position
 				if !_rules[ruleCapWord1]() {
-					goto l309
+					goto l674
 				}
 				if !_rules[ruleDash]() {
-					goto l309
+					goto l674
 				}
 				{
-					position311, tokenIndex311 := position, tokenIndex
+					position676, tokenIndex676 := position, tokenIndex
 					if !_rules[ruleUpperAfterDash]() {
-						goto l312
+						goto l677
 					}
-					goto l311
-				l312:
-					position, tokenIndex = position311, tokenIndex311
+					goto l676
+				l677:
+					position, tokenIndex = position676, tokenIndex676
 					if !_rules[ruleLowerAfterDash]() {
-						goto l309
+						goto l674
 					}
 				}
-			l311:
-				add(ruleCapWordWithDash, position310)
+			l676:
+				add(ruleCapWordWithDash, position675)
 			}
 			return true
-		l309:
-			position, tokenIndex = position309, tokenIndex309
+		l674:
+			position, tokenIndex = position674, tokenIndex674
 			return false
 		},
-		/* 41 UpperAfterDash <- <CapWord1> */
+		/* 81 UpperAfterDash <- <CapWord1> */
 		func() bool {
-			position313, tokenIndex313 := position, tokenIndex
+			position678, tokenIndex678 := position, tokenIndex
 			{
-				position314 := position
+				position679 := position
 				if !_rules[ruleCapWord1]() {
-					goto l313
+					goto l678
 				}
-				add(ruleUpperAfterDash, position314)
+				add(ruleUpperAfterDash, position679)
 			}
 			return true
-		l313:
-			position, tokenIndex = position313, tokenIndex313
+		l678:
+			position, tokenIndex = position678, tokenIndex678
 			return false
 		},
-		/* 42 LowerAfterDash <- <Word1> */
+		/* 82 LowerAfterDash <- <Word1> */
 		func() bool {
-			position315, tokenIndex315 := position, tokenIndex
+			position680, tokenIndex680 := position, tokenIndex
 			{
-				position316 := position
+				position681 := position
 				if !_rules[ruleWord1]() {
-					goto l315
+					goto l680
 				}
-				add(ruleLowerAfterDash, position316)
+				add(ruleLowerAfterDash, position681)
 			}
 			return true
-		l315:
-			position, tokenIndex = position315, tokenIndex315
+		l680:
+			position, tokenIndex = position680, tokenIndex680
 			return false
 		},
-		/* 43 TwoLetterGenus <- <(('C' 'a') / ('E' 'a') / ('G' 'e') / ('I' 'a') / ('I' 'o') / ('I' 'x') / ('L' 'o') / ('O' 'a') / ('R' 'a') / ('T' 'y') / ('U' 'a') / ('A' 'a') / ('J' 'a') / ('Z' 'u') / ('L' 'a') / ('Q' 'u') / ('A' 's') / ('B' 'a'))> */
+		/* 83 TwoLetterGenus <- <(('C' 'a') / ('E' 'a') / ('G' 'e') / ('I' 'a') / ('I' 'o') / ('I' 'x') / ('L' 'o') / ('O' 'a') / ('R' 'a') / ('T' 'y') / ('U' 'a') / ('A' 'a') / ('J' 'a') / ('Z' 'u') / ('L' 'a') / ('Q' 'u') / ('A' 's') / ('B' 'a'))> */
 		func() bool {
-			position317, tokenIndex317 := position, tokenIndex
+			position682, tokenIndex682 := position, tokenIndex
 			{
-				position318 := position
+				position683 := position
 				{
-					position319, tokenIndex319 := position, tokenIndex
+					position684, tokenIndex684 := position, tokenIndex
 					if buffer[position] != rune('C') {
-						goto l320
+						goto l685
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l320
+						goto l685
 					}
 					position++
-					goto l319
-				l320:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l685:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('E') {
-						goto l321
+						goto l686
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l321
+						goto l686
 					}
 					position++
-					goto l319
-				l321:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l686:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('G') {
-						goto l322
+						goto l687
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l322
+						goto l687
 					}
 					position++
-					goto l319
-				l322:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l687:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('I') {
-						goto l323
+						goto l688
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l323
+						goto l688
 					}
 					position++
-					goto l319
-				l323:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l688:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('I') {
-						goto l324
+						goto l689
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l324
+						goto l689
 					}
 					position++
-					goto l319
-				l324:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l689:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('I') {
-						goto l325
+						goto l690
 					}
 					position++
 					if buffer[position] != rune('x') {
-						goto l325
+						goto l690
 					}
 					position++
-					goto l319
-				l325:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l690:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('L') {
-						goto l326
+						goto l691
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l326
+						goto l691
 					}
 					position++
-					goto l319
-				l326:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l691:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('O') {
-						goto l327
+						goto l692
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l327
+						goto l692
 					}
 					position++
-					goto l319
-				l327:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l692:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l693
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l328
+						goto l693
 					}
 					position++
-					goto l319
-				l328:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l693:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('T') {
-						goto l329
+						goto l694
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l329
+						goto l694
 					}
 					position++
-					goto l319
-				l329:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l694:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('U') {
-						goto l330
+						goto l695
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l330
+						goto l695
 					}
 					position++
-					goto l319
-				l330:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l695:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('A') {
-						goto l331
+						goto l696
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l331
+						goto l696
 					}
 					position++
-					goto l319
-				l331:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l696:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('J') {
-						goto l332
+						goto l697
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l332
+						goto l697
 					}
 					position++
-					goto l319
-				l332:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l697:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('Z') {
-						goto l333
+						goto l698
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l333
+						goto l698
 					}
 					position++
-					goto l319
-				l333:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l698:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('L') {
-						goto l334
+						goto l699
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l334
+						goto l699
 					}
 					position++
-					goto l319
-				l334:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l699:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('Q') {
-						goto l335
+						goto l700
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l335
+						goto l700
 					}
 					position++
-					goto l319
-				l335:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l700:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('A') {
-						goto l336
+						goto l701
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l336
+						goto l701
 					}
 					position++
-					goto l319
-				l336:
-					position, tokenIndex = position319, tokenIndex319
+					goto l684
+				l701:
+					position, tokenIndex = position684, tokenIndex684
 					if buffer[position] != rune('B') {
-						goto l317
+						goto l682
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l317
+						goto l682
 					}
 					position++
 				}
-			l319:
-				add(ruleTwoLetterGenus, position318)
+			l684:
+				add(ruleTwoLetterGenus, position683)
 			}
 			return true
-		l317:
-			position, tokenIndex = position317, tokenIndex317
+		l682:
+			position, tokenIndex = position682, tokenIndex682
 			return false
 		},
-		/* 44 Word <- <(!((AuthorPrefix / RankUninomial / Approximation / Word4) SpaceCharEOI) (WordApostr / WordStartsWithDigit / MultiDashedWord / Word2 / Word1) &(SpaceCharEOI / '('))> */
+		/* 84 Word <- <(!((AuthorPrefix / RankUninomial / Approximation / Word4) SpaceCharEOI) !TaxonConceptAhead !PublicationAhead !ExcludedAhead (WordApostr / WordStartsWithDigit / MultiDashedWord / Word2 / Word1) &(SpaceCharEOI / '('))> */
 		func() bool {
-			position337, tokenIndex337 := position, tokenIndex
+			position702, tokenIndex702 := position, tokenIndex
 			{
-				position338 := position
+				position703 := position
 				{
-					position339, tokenIndex339 := position, tokenIndex
+					position704, tokenIndex704 := position, tokenIndex
 					{
-						position340, tokenIndex340 := position, tokenIndex
+						position705, tokenIndex705 := position, tokenIndex
 						if !_rules[ruleAuthorPrefix]() {
-							goto l341
+							goto l706
 						}
-						goto l340
-					l341:
-						position, tokenIndex = position340, tokenIndex340
+						goto l705
+					l706:
+						position, tokenIndex = position705, tokenIndex705
 						if !_rules[ruleRankUninomial]() {
-							goto l342
+							goto l707
 						}
-						goto l340
-					l342:
-						position, tokenIndex = position340, tokenIndex340
+						goto l705
+					l707:
+						position, tokenIndex = position705, tokenIndex705
 						if !_rules[ruleApproximation]() {
-							goto l343
+							goto l708
 						}
-						goto l340
-					l343:
-						position, tokenIndex = position340, tokenIndex340
+						goto l705
+					l708:
+						position, tokenIndex = position705, tokenIndex705
 						if !_rules[ruleWord4]() {
-							goto l339
+							goto l704
 						}
 					}
-				l340:
+				l705:
 					if !_rules[ruleSpaceCharEOI]() {
-						goto l339
+						goto l704
 					}
-					goto l337
-				l339:
-					position, tokenIndex = position339, tokenIndex339
+					goto l702
+				l704:
+					position, tokenIndex = position704, tokenIndex704
 				}
 				{
-					position344, tokenIndex344 := position, tokenIndex
+					position709, tokenIndex709 := position, tokenIndex
+					if !_rules[ruleTaxonConceptAhead]() {
+						goto l709
+					}
+					goto l702
+				l709:
+					position, tokenIndex = position709, tokenIndex709
+				}
+				{
+					position710, tokenIndex710 := position, tokenIndex
+					if !_rules[rulePublicationAhead]() {
+						goto l710
+					}
+					goto l702
+				l710:
+					position, tokenIndex = position710, tokenIndex710
+				}
+				{
+					position711, tokenIndex711 := position, tokenIndex
+					if !_rules[ruleExcludedAhead]() {
+						goto l711
+					}
+					goto l702
+				l711:
+					position, tokenIndex = position711, tokenIndex711
+				}
+				{
+					position712, tokenIndex712 := position, tokenIndex
 					if !_rules[ruleWordApostr]() {
-						goto l345
+						goto l713
 					}
-					goto l344
-				l345:
-					position, tokenIndex = position344, tokenIndex344
+					goto l712
+				l713:
+					position, tokenIndex = position712, tokenIndex712
 					if !_rules[ruleWordStartsWithDigit]() {
-						goto l346
+						goto l714
 					}
-					goto l344
-				l346:
-					position, tokenIndex = position344, tokenIndex344
+					goto l712
+				l714:
+					position, tokenIndex = position712, tokenIndex712
 					if !_rules[ruleMultiDashedWord]() {
-						goto l347
+						goto l715
 					}
-					goto l344
-				l347:
-					position, tokenIndex = position344, tokenIndex344
+					goto l712
+				l715:
+					position, tokenIndex = position712, tokenIndex712
 					if !_rules[ruleWord2]() {
-						goto l348
+						goto l716
 					}
-					goto l344
-				l348:
-					position, tokenIndex = position344, tokenIndex344
+					goto l712
+				l716:
+					position, tokenIndex = position712, tokenIndex712
 					if !_rules[ruleWord1]() {
-						goto l337
+						goto l702
 					}
 				}
-			l344:
+			l712:
 				{
-					position349, tokenIndex349 := position, tokenIndex
+					position717, tokenIndex717 := position, tokenIndex
 					{
-						position350, tokenIndex350 := position, tokenIndex
+						position718, tokenIndex718 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l351
+							goto l719
 						}
-						goto l350
-					l351:
-						position, tokenIndex = position350, tokenIndex350
+						goto l718
+					l719:
+						position, tokenIndex = position718, tokenIndex718
 						if buffer[position] != rune('(') {
-							goto l337
+							goto l702
 						}
 						position++
 					}
-				l350:
-					position, tokenIndex = position349, tokenIndex349
+				l718:
+					position, tokenIndex = position717, tokenIndex717
 				}
-				add(ruleWord, position338)
+				add(ruleWord, position703)
 			}
 			return true
-		l337:
-			position, tokenIndex = position337, tokenIndex337
+		l702:
+			position, tokenIndex = position702, tokenIndex702
 			return false
 		},
-		/* 45 Word1 <- <((LowerASCII Dash)? NameLowerChar NameLowerChar+)> */
+		/* 85 Word1 <- <((LowerASCII Dash)? NameLowerChar NameLowerChar+)> */
 		func() bool {
-			position352, tokenIndex352 := position, tokenIndex
+			position720, tokenIndex720 := position, tokenIndex
 			{
-				position353 := position
+				position721 := position
 				{
-					position354, tokenIndex354 := position, tokenIndex
+					position722, tokenIndex722 := position, tokenIndex
 					if !_rules[ruleLowerASCII]() {
-						goto l354
+						goto l722
 					}
 					if !_rules[ruleDash]() {
-						goto l354
+						goto l722
 					}
-					goto l355
-				l354:
-					position, tokenIndex = position354, tokenIndex354
+					goto l723
+				l722:
+					position, tokenIndex = position722, tokenIndex722
 				}
-			l355:
+			l723:
 				if !_rules[ruleNameLowerChar]() {
-					goto l352
+					goto l720
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l352
+					goto l720
 				}
-			l356:
+			l724:
 				{
-					position357, tokenIndex357 := position, tokenIndex
+					position725, tokenIndex725 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l357
+						goto l725
 					}
-					goto l356
-				l357:
-					position, tokenIndex = position357, tokenIndex357
+					goto l724
+				l725:
+					position, tokenIndex = position725, tokenIndex725
 				}
-				add(ruleWord1, position353)
+				add(ruleWord1, position721)
 			}
 			return true
-		l352:
-			position, tokenIndex = position352, tokenIndex352
+		l720:
+			position, tokenIndex = position720, tokenIndex720
 			return false
 		},
-		/* 46 WordStartsWithDigit <- <(('1' / '2' / '3' / '4' / '5' / '6' / '7' / '8' / '9') Nums? ('.' / Dash)? NameLowerChar NameLowerChar NameLowerChar NameLowerChar+)> */
+		/* 86 WordStartsWithDigit <- <(('1' / '2' / '3' / '4' / '5' / '6' / '7' / '8' / '9') Nums? ('.' / Dash)? NameLowerChar NameLowerChar NameLowerChar NameLowerChar+)> */
 		func() bool {
-			position358, tokenIndex358 := position, tokenIndex
+			position726, tokenIndex726 := position, tokenIndex
 			{
-				position359 := position
+				position727 := position
 				{
-					position360, tokenIndex360 := position, tokenIndex
+					position728, tokenIndex728 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l361
+						goto l729
 					}
 					position++
-					goto l360
-				l361:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l729:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('2') {
-						goto l362
+						goto l730
 					}
 					position++
-					goto l360
-				l362:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l730:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('3') {
-						goto l363
+						goto l731
 					}
 					position++
-					goto l360
-				l363:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l731:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('4') {
-						goto l364
+						goto l732
 					}
 					position++
-					goto l360
-				l364:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l732:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('5') {
-						goto l365
+						goto l733
 					}
 					position++
-					goto l360
-				l365:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l733:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('6') {
-						goto l366
+						goto l734
 					}
 					position++
-					goto l360
-				l366:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l734:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('7') {
-						goto l367
+						goto l735
 					}
 					position++
-					goto l360
-				l367:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l735:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('8') {
-						goto l368
+						goto l736
 					}
 					position++
-					goto l360
-				l368:
-					position, tokenIndex = position360, tokenIndex360
+					goto l728
+				l736:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('9') {
-						goto l358
+						goto l726
 					}
 					position++
 				}
-			l360:
+			l728:
 				{
-					position369, tokenIndex369 := position, tokenIndex
+					position737, tokenIndex737 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l369
+						goto l737
 					}
-					goto l370
-				l369:
-					position, tokenIndex = position369, tokenIndex369
+					goto l738
+				l737:
+					position, tokenIndex = position737, tokenIndex737
 				}
-			l370:
+			l738:
 				{
-					position371, tokenIndex371 := position, tokenIndex
+					position739, tokenIndex739 := position, tokenIndex
 					{
-						position373, tokenIndex373 := position, tokenIndex
+						position741, tokenIndex741 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l374
+							goto l742
 						}
 						position++
-						goto l373
-					l374:
-						position, tokenIndex = position373, tokenIndex373
+						goto l741
+					l742:
+						position, tokenIndex = position741, tokenIndex741
 						if !_rules[ruleDash]() {
-							goto l371
+							goto l739
 						}
 					}
-				l373:
-					goto l372
-				l371:
-					position, tokenIndex = position371, tokenIndex371
+				l741:
+					goto l740
+				l739:
+					position, tokenIndex = position739, tokenIndex739
 				}
-			l372:
+			l740:
 				if !_rules[ruleNameLowerChar]() {
-					goto l358
+					goto l726
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l358
+					goto l726
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l358
+					goto l726
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l358
+					goto l726
 				}
-			l375:
+			l743:
 				{
-					position376, tokenIndex376 := position, tokenIndex
+					position744, tokenIndex744 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l376
+						goto l744
 					}
-					goto l375
-				l376:
-					position, tokenIndex = position376, tokenIndex376
+					goto l743
+				l744:
+					position, tokenIndex = position744, tokenIndex744
 				}
-				add(ruleWordStartsWithDigit, position359)
+				add(ruleWordStartsWithDigit, position727)
 			}
 			return true
-		l358:
-			position, tokenIndex = position358, tokenIndex358
+		l726:
+			position, tokenIndex = position726, tokenIndex726
 			return false
 		},
-		/* 47 Word2 <- <(NameLowerChar+ Dash? NameLowerChar+)> */
+		/* 87 Word2 <- <(NameLowerChar+ Dash? NameLowerChar+)> */
 		func() bool {
-			position377, tokenIndex377 := position, tokenIndex
+			position745, tokenIndex745 := position, tokenIndex
 			{
-				position378 := position
+				position746 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l377
+					goto l745
 				}
-			l379:
+			l747:
 				{
-					position380, tokenIndex380 := position, tokenIndex
+					position748, tokenIndex748 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l380
+						goto l748
 					}
-					goto l379
-				l380:
-					position, tokenIndex = position380, tokenIndex380
+					goto l747
+				l748:
+					position, tokenIndex = position748, tokenIndex748
 				}
 				{
-					position381, tokenIndex381 := position, tokenIndex
+					position749, tokenIndex749 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l381
+						goto l749
 					}
-					goto l382
-				l381:
-					position, tokenIndex = position381, tokenIndex381
+					goto l750
+				l749:
+					position, tokenIndex = position749, tokenIndex749
 				}
-			l382:
+			l750:
 				if !_rules[ruleNameLowerChar]() {
-					goto l377
+					goto l745
 				}
-			l383:
+			l751:
 				{
-					position384, tokenIndex384 := position, tokenIndex
+					position752, tokenIndex752 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l384
+						goto l752
 					}
-					goto l383
-				l384:
-					position, tokenIndex = position384, tokenIndex384
+					goto l751
+				l752:
+					position, tokenIndex = position752, tokenIndex752
 				}
-				add(ruleWord2, position378)
+				add(ruleWord2, position746)
 			}
 			return true
-		l377:
-			position, tokenIndex = position377, tokenIndex377
+		l745:
+			position, tokenIndex = position745, tokenIndex745
 			return false
 		},
-		/* 48 WordApostr <- <(NameLowerChar NameLowerChar* Apostrophe Word1)> */
+		/* 88 WordApostr <- <(NameLowerChar NameLowerChar* Apostrophe Word1)> */
 		func() bool {
-			position385, tokenIndex385 := position, tokenIndex
+			position753, tokenIndex753 := position, tokenIndex
 			{
-				position386 := position
+				position754 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l385
+					goto l753
 				}
-			l387:
+			l755:
 				{
-					position388, tokenIndex388 := position, tokenIndex
+					position756, tokenIndex756 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l388
+						goto l756
 					}
-					goto l387
-				l388:
-					position, tokenIndex = position388, tokenIndex388
+					goto l755
+				l756:
+					position, tokenIndex = position756, tokenIndex756
 				}
 				if !_rules[ruleApostrophe]() {
-					goto l385
+					goto l753
 				}
 				if !_rules[ruleWord1]() {
-					goto l385
+					goto l753
 				}
-				add(ruleWordApostr, position386)
+				add(ruleWordApostr, position754)
 			}
 			return true
-		l385:
-			position, tokenIndex = position385, tokenIndex385
+		l753:
+			position, tokenIndex = position753, tokenIndex753
 			return false
 		},
-		/* 49 Word4 <- <(NameLowerChar+ '.' NameLowerChar)> */
+		/* 89 Word4 <- <(NameLowerChar+ '.' NameLowerChar)> */
 		func() bool {
-			position389, tokenIndex389 := position, tokenIndex
+			position757, tokenIndex757 := position, tokenIndex
 			{
-				position390 := position
+				position758 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l389
+					goto l757
 				}
-			l391:
+			l759:
 				{
-					position392, tokenIndex392 := position, tokenIndex
+					position760, tokenIndex760 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l392
+						goto l760
 					}
-					goto l391
-				l392:
-					position, tokenIndex = position392, tokenIndex392
+					goto l759
+				l760:
+					position, tokenIndex = position760, tokenIndex760
 				}
 				if buffer[position] != rune('.') {
-					goto l389
+					goto l757
 				}
 				position++
 				if !_rules[ruleNameLowerChar]() {
-					goto l389
+					goto l757
 				}
-				add(ruleWord4, position390)
+				add(ruleWord4, position758)
 			}
 			return true
-		l389:
-			position, tokenIndex = position389, tokenIndex389
+		l757:
+			position, tokenIndex = position757, tokenIndex757
 			return false
 		},
-		/* 50 MultiDashedWord <- <(NameLowerChar+ Dash NameLowerChar+ Dash NameLowerChar+ (Dash NameLowerChar+)?)> */
+		/* 90 MultiDashedWord <- <(NameLowerChar+ Dash NameLowerChar+ Dash NameLowerChar+ (Dash NameLowerChar+)?)> */
 		func() bool {
-			position393, tokenIndex393 := position, tokenIndex
+			position761, tokenIndex761 := position, tokenIndex
 			{
-				position394 := position
+				position762 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l393
+					goto l761
 				}
-			l395:
+			l763:
 				{
-					position396, tokenIndex396 := position, tokenIndex
+					position764, tokenIndex764 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l396
+						goto l764
 					}
-					goto l395
-				l396:
-					position, tokenIndex = position396, tokenIndex396
+					goto l763
+				l764:
+					position, tokenIndex = position764, tokenIndex764
 				}
 				if !_rules[ruleDash]() {
-					goto l393
+					goto l761
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l393
+					goto l761
 				}
-			l397:
+			l765:
 				{
-					position398, tokenIndex398 := position, tokenIndex
+					position766, tokenIndex766 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l398
+						goto l766
 					}
-					goto l397
-				l398:
-					position, tokenIndex = position398, tokenIndex398
+					goto l765
+				l766:
+					position, tokenIndex = position766, tokenIndex766
 				}
 				if !_rules[ruleDash]() {
-					goto l393
+					goto l761
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l393
+					goto l761
 				}
-			l399:
+			l767:
 				{
-					position400, tokenIndex400 := position, tokenIndex
+					position768, tokenIndex768 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l400
+						goto l768
 					}
-					goto l399
-				l400:
-					position, tokenIndex = position400, tokenIndex400
+					goto l767
+				l768:
+					position, tokenIndex = position768, tokenIndex768
 				}
 				{
-					position401, tokenIndex401 := position, tokenIndex
+					position769, tokenIndex769 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l401
+						goto l769
 					}
 					if !_rules[ruleNameLowerChar]() {
-						goto l401
+						goto l769
 					}
-				l403:
+				l771:
 					{
-						position404, tokenIndex404 := position, tokenIndex
+						position772, tokenIndex772 := position, tokenIndex
 						if !_rules[ruleNameLowerChar]() {
-							goto l404
+							goto l772
 						}
-						goto l403
-					l404:
-						position, tokenIndex = position404, tokenIndex404
+						goto l771
+					l772:
+						position, tokenIndex = position772, tokenIndex772
 					}
-					goto l402
-				l401:
-					position, tokenIndex = position401, tokenIndex401
+					goto l770
+				l769:
+					position, tokenIndex = position769, tokenIndex769
 				}
-			l402:
-				add(ruleMultiDashedWord, position394)
+			l770:
+				add(ruleMultiDashedWord, position762)
 			}
 			return true
-		l393:
-			position, tokenIndex = position393, tokenIndex393
+		l761:
+			position, tokenIndex = position761, tokenIndex761
 			return false
 		},
-		/* 51 HybridChar <- <'×'> */
+		/* 91 HybridChar <- <'×'> */
 		func() bool {
-			position405, tokenIndex405 := position, tokenIndex
+			position773, tokenIndex773 := position, tokenIndex
 			{
-				position406 := position
+				position774 := position
 				if buffer[position] != rune('×') {
-					goto l405
+					goto l773
 				}
 				position++
-				add(ruleHybridChar, position406)
+				add(ruleHybridChar, position774)
 			}
 			return true
-		l405:
-			position, tokenIndex = position405, tokenIndex405
+		l773:
+			position, tokenIndex = position773, tokenIndex773
 			return false
 		},
-		/* 52 ApproxNameIgnored <- <.*> */
+		/* 92 ApproxNameIgnored <- <(!(TaxonConceptSep TaxonConcept) .)*> */
 		func() bool {
 			{
-				position408 := position
-			l409:
+				position776 := position
+			l777:
 				{
-					position410, tokenIndex410 := position, tokenIndex
+					position778, tokenIndex778 := position, tokenIndex
+					{
+						position779, tokenIndex779 := position, tokenIndex
+						if !_rules[ruleTaxonConceptSep]() {
+							goto l779
+						}
+						if !_rules[ruleTaxonConcept]() {
+							goto l779
+						}
+						goto l778
+					l779:
+						position, tokenIndex = position779, tokenIndex779
+					}
 					if !matchDot() {
-						goto l410
+						goto l778
 					}
-					goto l409
-				l410:
-					position, tokenIndex = position410, tokenIndex410
+					goto l777
+				l778:
+					position, tokenIndex = position778, tokenIndex778
 				}
-				add(ruleApproxNameIgnored, position408)
+				add(ruleApproxNameIgnored, position776)
 			}
 			return true
 		},
-		/* 53 Approximation <- <(('s' 'p' '.' _? ('n' 'r' '.')) / ('s' 'p' '.' _? ('a' 'f' 'f' '.')) / ('m' 'o' 'n' 's' 't' '.') / '?' / ((('s' 'p' 'p') / ('n' 'r') / ('s' 'p') / ('a' 'f' 'f') / ('s' 'p' 'e' 'c' 'i' 'e' 's')) (&SpaceCharEOI / '.')))> */
+		/* 93 Approximation <- <(('s' 'p' '.' _? ('n' 'r' '.')) / ('s' 'p' '.' _? ('a' 'f' 'f' '.')) / ('m' 'o' 'n' 's' 't' '.') / '?' / ((('s' 'p' 'p') / ('n' 'r') / ('s' 'p') / ('a' 'f' 'f') / ('s' 'p' 'e' 'c' 'i' 'e' 's')) (&SpaceCharEOI / '.')))> */
 		func() bool {
-			position411, tokenIndex411 := position, tokenIndex
+			position780, tokenIndex780 := position, tokenIndex
 			{
-				position412 := position
+				position781 := position
 				{
-					position413, tokenIndex413 := position, tokenIndex
+					position782, tokenIndex782 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l414
+						goto l783
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l414
+						goto l783
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l414
+						goto l783
 					}
 					position++
 					{
-						position415, tokenIndex415 := position, tokenIndex
+						position784, tokenIndex784 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l415
+							goto l784
 						}
-						goto l416
-					l415:
-						position, tokenIndex = position415, tokenIndex415
+						goto l785
+					l784:
+						position, tokenIndex = position784, tokenIndex784
 					}
-				l416:
+				l785:
 					if buffer[position] != rune('n') {
-						goto l414
+						goto l783
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l414
+						goto l783
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l414
+						goto l783
 					}
 					position++
-					goto l413
-				l414:
-					position, tokenIndex = position413, tokenIndex413
+					goto l782
+				l783:
+					position, tokenIndex = position782, tokenIndex782
 					if buffer[position] != rune('s') {
-						goto l417
+						goto l786
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l417
+						goto l786
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l417
+						goto l786
 					}
 					position++
 					{
-						position418, tokenIndex418 := position, tokenIndex
+						position787, tokenIndex787 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l418
+							goto l787
 						}
-						goto l419
-					l418:
-						position, tokenIndex = position418, tokenIndex418
+						goto l788
+					l787:
+						position, tokenIndex = position787, tokenIndex787
 					}
-				l419:
+				l788:
 					if buffer[position] != rune('a') {
-						goto l417
+						goto l786
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l417
+						goto l786
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l417
+						goto l786
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l417
+						goto l786
 					}
 					position++
-					goto l413
-				l417:
-					position, tokenIndex = position413, tokenIndex413
+					goto l782
+				l786:
+					position, tokenIndex = position782, tokenIndex782
 					if buffer[position] != rune('m') {
-						goto l420
+						goto l789
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l420
+						goto l789
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l420
+						goto l789
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l420
+						goto l789
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l420
+						goto l789
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l420
+						goto l789
 					}
 					position++
-					goto l413
-				l420:
-					position, tokenIndex = position413, tokenIndex413
+					goto l782
+				l789:
+					position, tokenIndex = position782, tokenIndex782
 					if buffer[position] != rune('?') {
-						goto l421
+						goto l790
 					}
 					position++
-					goto l413
-				l421:
-					position, tokenIndex = position413, tokenIndex413
+					goto l782
+				l790:
+					position, tokenIndex = position782, tokenIndex782
 					{
-						position422, tokenIndex422 := position, tokenIndex
+						position791, tokenIndex791 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l423
+							goto l792
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l423
+							goto l792
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l423
+							goto l792
 						}
 						position++
-						goto l422
-					l423:
-						position, tokenIndex = position422, tokenIndex422
+						goto l791
+					l792:
+						position, tokenIndex = position791, tokenIndex791
 						if buffer[position] != rune('n') {
-							goto l424
+							goto l793
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l424
+							goto l793
 						}
 						position++
-						goto l422
-					l424:
-						position, tokenIndex = position422, tokenIndex422
+						goto l791
+					l793:
+						position, tokenIndex = position791, tokenIndex791
 						if buffer[position] != rune('s') {
-							goto l425
+							goto l794
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l425
+							goto l794
 						}
 						position++
-						goto l422
-					l425:
-						position, tokenIndex = position422, tokenIndex422
+						goto l791
+					l794:
+						position, tokenIndex = position791, tokenIndex791
 						if buffer[position] != rune('a') {
-							goto l426
+							goto l795
 						}
 						position++
 						if buffer[position] != rune('f') {
-							goto l426
+							goto l795
 						}
 						position++
 						if buffer[position] != rune('f') {
-							goto l426
+							goto l795
 						}
 						position++
-						goto l422
-					l426:
-						position, tokenIndex = position422, tokenIndex422
+						goto l791
+					l795:
+						position, tokenIndex = position791, tokenIndex791
 						if buffer[position] != rune('s') {
-							goto l411
+							goto l780
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l411
+							goto l780
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l411
+							goto l780
 						}
 						position++
 						if buffer[position] != rune('c') {
-							goto l411
+							goto l780
 						}
 						position++
 						if buffer[position] != rune('i') {
-							goto l411
+							goto l780
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l411
+							goto l780
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l411
+							goto l780
 						}
 						position++
 					}
-				l422:
+				l791:
 					{
-						position427, tokenIndex427 := position, tokenIndex
+						position796, tokenIndex796 := position, tokenIndex
 						{
-							position429, tokenIndex429 := position, tokenIndex
+							position798, tokenIndex798 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l428
+								goto l797
 							}
-							position, tokenIndex = position429, tokenIndex429
+							position, tokenIndex = position798, tokenIndex798
 						}
-						goto l427
-					l428:
-						position, tokenIndex = position427, tokenIndex427
+						goto l796
+					l797:
+						position, tokenIndex = position796, tokenIndex796
 						if buffer[position] != rune('.') {
-							goto l411
+							goto l780
 						}
 						position++
 					}
-				l427:
+				l796:
 				}
-			l413:
-				add(ruleApproximation, position412)
+			l782:
+				add(ruleApproximation, position781)
 			}
 			return true
-		l411:
-			position, tokenIndex = position411, tokenIndex411
+		l780:
+			position, tokenIndex = position780, tokenIndex780
 			return false
 		},
-		/* 54 Authorship <- <((AuthorshipCombo / OriginalAuthorship) &(SpaceCharEOI / ';' / ','))> */
+		/* 94 Authorship <- <(!CultivarAhead (AuthorshipCombo / OriginalAuthorship) &(SpaceCharEOI / ';' / ','))> */
 		func() bool {
-			position430, tokenIndex430 := position, tokenIndex
+			position799, tokenIndex799 := position, tokenIndex
 			{
-				position431 := position
+				position800 := position
+				{
+					position801, tokenIndex801 := position, tokenIndex
+					if !_rules[ruleCultivarAhead]() {
+						goto l801
+					}
+					goto l799
+				l801:
+					position, tokenIndex = position801, tokenIndex801
+				}
 				{
-					position432, tokenIndex432 := position, tokenIndex
+					position802, tokenIndex802 := position, tokenIndex
 					if !_rules[ruleAuthorshipCombo]() {
-						goto l433
+						goto l803
 					}
-					goto l432
-				l433:
-					position, tokenIndex = position432, tokenIndex432
+					goto l802
+				l803:
+					position, tokenIndex = position802, tokenIndex802
 					if !_rules[ruleOriginalAuthorship]() {
-						goto l430
+						goto l799
 					}
 				}
-			l432:
+			l802:
 				{
-					position434, tokenIndex434 := position, tokenIndex
+					position804, tokenIndex804 := position, tokenIndex
 					{
-						position435, tokenIndex435 := position, tokenIndex
+						position805, tokenIndex805 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l436
+							goto l806
 						}
-						goto l435
-					l436:
-						position, tokenIndex = position435, tokenIndex435
+						goto l805
+					l806:
+						position, tokenIndex = position805, tokenIndex805
 						if buffer[position] != rune(';') {
-							goto l437
+							goto l807
 						}
 						position++
-						goto l435
-					l437:
-						position, tokenIndex = position435, tokenIndex435
+						goto l805
+					l807:
+						position, tokenIndex = position805, tokenIndex805
 						if buffer[position] != rune(',') {
-							goto l430
+							goto l799
 						}
 						position++
 					}
-				l435:
-					position, tokenIndex = position434, tokenIndex434
+				l805:
+					position, tokenIndex = position804, tokenIndex804
+				}
+				add(ruleAuthorship, position800)
+			}
+			return true
+		l799:
+			position, tokenIndex = position799, tokenIndex799
+			return false
+		},
+		/* 95 AuthorshipInParens <- <(OriginalAuthorship &(SpaceCharEOI / ';' / ',' / ')'))> */
+		func() bool {
+			position808, tokenIndex808 := position, tokenIndex
+			{
+				position809 := position
+				if !_rules[ruleOriginalAuthorship]() {
+					goto l808
+				}
+				{
+					position810, tokenIndex810 := position, tokenIndex
+					{
+						position811, tokenIndex811 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l812
+						}
+						goto l811
+					l812:
+						position, tokenIndex = position811, tokenIndex811
+						if buffer[position] != rune(';') {
+							goto l813
+						}
+						position++
+						goto l811
+					l813:
+						position, tokenIndex = position811, tokenIndex811
+						if buffer[position] != rune(',') {
+							goto l814
+						}
+						position++
+						goto l811
+					l814:
+						position, tokenIndex = position811, tokenIndex811
+						if buffer[position] != rune(')') {
+							goto l808
+						}
+						position++
+					}
+				l811:
+					position, tokenIndex = position810, tokenIndex810
+				}
+				add(ruleAuthorshipInParens, position809)
+			}
+			return true
+		l808:
+			position, tokenIndex = position808, tokenIndex808
+			return false
+		},
+		/* 96 CultivarAhead <- <(CultivarGroup / Grex)> */
+		func() bool {
+			position815, tokenIndex815 := position, tokenIndex
+			{
+				position816 := position
+				{
+					position817, tokenIndex817 := position, tokenIndex
+					if !_rules[ruleCultivarGroup]() {
+						goto l818
+					}
+					goto l817
+				l818:
+					position, tokenIndex = position817, tokenIndex817
+					if !_rules[ruleGrex]() {
+						goto l815
+					}
 				}
-				add(ruleAuthorship, position431)
+			l817:
+				add(ruleCultivarAhead, position816)
 			}
 			return true
-		l430:
-			position, tokenIndex = position430, tokenIndex430
+		l815:
+			position, tokenIndex = position815, tokenIndex815
 			return false
 		},
-		/* 55 AuthorshipCombo <- <(OriginalAuthorshipComb (_? CombinationAuthorship)?)> */
+		/* 97 AuthorshipCombo <- <(OriginalAuthorshipComb (_? CombinationAuthorship)?)> */
 		func() bool {
-			position438, tokenIndex438 := position, tokenIndex
+			position819, tokenIndex819 := position, tokenIndex
 			{
-				position439 := position
+				position820 := position
 				if !_rules[ruleOriginalAuthorshipComb]() {
-					goto l438
+					goto l819
 				}
 				{
-					position440, tokenIndex440 := position, tokenIndex
+					position821, tokenIndex821 := position, tokenIndex
 					{
-						position442, tokenIndex442 := position, tokenIndex
+						position823, tokenIndex823 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l442
+							goto l823
 						}
-						goto l443
-					l442:
-						position, tokenIndex = position442, tokenIndex442
+						goto l824
+					l823:
+						position, tokenIndex = position823, tokenIndex823
 					}
-				l443:
+				l824:
 					if !_rules[ruleCombinationAuthorship]() {
-						goto l440
+						goto l821
 					}
-					goto l441
-				l440:
-					position, tokenIndex = position440, tokenIndex440
+					goto l822
+				l821:
+					position, tokenIndex = position821, tokenIndex821
 				}
-			l441:
-				add(ruleAuthorshipCombo, position439)
+			l822:
+				add(ruleAuthorshipCombo, position820)
 			}
 			return true
-		l438:
-			position, tokenIndex = position438, tokenIndex438
+		l819:
+			position, tokenIndex = position819, tokenIndex819
 			return false
 		},
-		/* 56 OriginalAuthorship <- <AuthorsGroup> */
+		/* 98 OriginalAuthorship <- <AuthorsGroup> */
 		func() bool {
-			position444, tokenIndex444 := position, tokenIndex
+			position825, tokenIndex825 := position, tokenIndex
 			{
-				position445 := position
+				position826 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l444
+					goto l825
 				}
-				add(ruleOriginalAuthorship, position445)
+				add(ruleOriginalAuthorship, position826)
 			}
 			return true
-		l444:
-			position, tokenIndex = position444, tokenIndex444
+		l825:
+			position, tokenIndex = position825, tokenIndex825
 			return false
 		},
-		/* 57 OriginalAuthorshipComb <- <(BasionymAuthorshipYearMisformed / BasionymAuthorship / BasionymAuthorshipMissingParens)> */
+		/* 99 OriginalAuthorshipComb <- <(BasionymAuthorshipYearMisformed / BasionymAuthorship / BasionymAuthorshipMissingParens)> */
 		func() bool {
-			position446, tokenIndex446 := position, tokenIndex
+			position827, tokenIndex827 := position, tokenIndex
 			{
-				position447 := position
+				position828 := position
 				{
-					position448, tokenIndex448 := position, tokenIndex
+					position829, tokenIndex829 := position, tokenIndex
 					if !_rules[ruleBasionymAuthorshipYearMisformed]() {
-						goto l449
+						goto l830
 					}
-					goto l448
-				l449:
-					position, tokenIndex = position448, tokenIndex448
+					goto l829
+				l830:
+					position, tokenIndex = position829, tokenIndex829
 					if !_rules[ruleBasionymAuthorship]() {
-						goto l450
+						goto l831
 					}
-					goto l448
-				l450:
-					position, tokenIndex = position448, tokenIndex448
+					goto l829
+				l831:
+					position, tokenIndex = position829, tokenIndex829
 					if !_rules[ruleBasionymAuthorshipMissingParens]() {
-						goto l446
+						goto l827
 					}
 				}
-			l448:
-				add(ruleOriginalAuthorshipComb, position447)
+			l829:
+				add(ruleOriginalAuthorshipComb, position828)
 			}
 			return true
-		l446:
-			position, tokenIndex = position446, tokenIndex446
+		l827:
+			position, tokenIndex = position827, tokenIndex827
 			return false
 		},
-		/* 58 CombinationAuthorship <- <AuthorsGroup> */
+		/* 100 CombinationAuthorship <- <AuthorsGroup> */
 		func() bool {
-			position451, tokenIndex451 := position, tokenIndex
+			position832, tokenIndex832 := position, tokenIndex
 			{
-				position452 := position
+				position833 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l451
+					goto l832
 				}
-				add(ruleCombinationAuthorship, position452)
+				add(ruleCombinationAuthorship, position833)
 			}
 			return true
-		l451:
-			position, tokenIndex = position451, tokenIndex451
+		l832:
+			position, tokenIndex = position832, tokenIndex832
 			return false
 		},
-		/* 59 BasionymAuthorshipMissingParens <- <(MissingParensStart / MissingParensEnd)> */
+		/* 101 BasionymAuthorshipMissingParens <- <(MissingParensStart / MissingParensEnd)> */
 		func() bool {
-			position453, tokenIndex453 := position, tokenIndex
+			position834, tokenIndex834 := position, tokenIndex
 			{
-				position454 := position
+				position835 := position
 				{
-					position455, tokenIndex455 := position, tokenIndex
+					position836, tokenIndex836 := position, tokenIndex
 					if !_rules[ruleMissingParensStart]() {
-						goto l456
+						goto l837
 					}
-					goto l455
-				l456:
-					position, tokenIndex = position455, tokenIndex455
+					goto l836
+				l837:
+					position, tokenIndex = position836, tokenIndex836
 					if !_rules[ruleMissingParensEnd]() {
-						goto l453
+						goto l834
 					}
 				}
-			l455:
-				add(ruleBasionymAuthorshipMissingParens, position454)
+			l836:
+				add(ruleBasionymAuthorshipMissingParens, position835)
 			}
 			return true
-		l453:
-			position, tokenIndex = position453, tokenIndex453
+		l834:
+			position, tokenIndex = position834, tokenIndex834
 			return false
 		},
-		/* 60 MissingParensStart <- <('(' _? AuthorsGroup)> */
+		/* 102 MissingParensStart <- <('(' _? AuthorsGroup)> */
 		func() bool {
-			position457, tokenIndex457 := position, tokenIndex
+			position838, tokenIndex838 := position, tokenIndex
 			{
-				position458 := position
+				position839 := position
 				if buffer[position] != rune('(') {
-					goto l457
+					goto l838
 				}
 				position++
 				{
-					position459, tokenIndex459 := position, tokenIndex
+					position840, tokenIndex840 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l459
+						goto l840
 					}
-					goto l460
-				l459:
-					position, tokenIndex = position459, tokenIndex459
+					goto l841
+				l840:
+					position, tokenIndex = position840, tokenIndex840
 				}
-			l460:
+			l841:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l457
+					goto l838
 				}
-				add(ruleMissingParensStart, position458)
+				add(ruleMissingParensStart, position839)
 			}
 			return true
-		l457:
-			position, tokenIndex = position457, tokenIndex457
+		l838:
+			position, tokenIndex = position838, tokenIndex838
 			return false
 		},
-		/* 61 MissingParensEnd <- <(AuthorsGroup _? ')')> */
+		/* 103 MissingParensEnd <- <(AuthorsGroup _? ')')> */
 		func() bool {
-			position461, tokenIndex461 := position, tokenIndex
+			position842, tokenIndex842 := position, tokenIndex
 			{
-				position462 := position
+				position843 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l461
+					goto l842
 				}
 				{
-					position463, tokenIndex463 := position, tokenIndex
+					position844, tokenIndex844 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l463
+						goto l844
 					}
-					goto l464
-				l463:
-					position, tokenIndex = position463, tokenIndex463
+					goto l845
+				l844:
+					position, tokenIndex = position844, tokenIndex844
 				}
-			l464:
+			l845:
 				if buffer[position] != rune(')') {
-					goto l461
+					goto l842
 				}
 				position++
-				add(ruleMissingParensEnd, position462)
+				add(ruleMissingParensEnd, position843)
 			}
 			return true
-		l461:
-			position, tokenIndex = position461, tokenIndex461
+		l842:
+			position, tokenIndex = position842, tokenIndex842
 			return false
 		},
-		/* 62 BasionymAuthorshipYearMisformed <- <('(' _? AuthorsGroup _? ')' (_? ',')? _? Year)> */
+		/* 104 BasionymAuthorshipYearMisformed <- <('(' _? AuthorsGroup _? ')' (_? ',')? _? Year)> */
 		func() bool {
-			position465, tokenIndex465 := position, tokenIndex
+			position846, tokenIndex846 := position, tokenIndex
 			{
-				position466 := position
+				position847 := position
 				if buffer[position] != rune('(') {
-					goto l465
+					goto l846
 				}
 				position++
 				{
-					position467, tokenIndex467 := position, tokenIndex
+					position848, tokenIndex848 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l467
+						goto l848
 					}
-					goto l468
-				l467:
-					position, tokenIndex = position467, tokenIndex467
+					goto l849
+				l848:
+					position, tokenIndex = position848, tokenIndex848
 				}
-			l468:
+			l849:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l465
+					goto l846
 				}
 				{
-					position469, tokenIndex469 := position, tokenIndex
+					position850, tokenIndex850 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l469
+						goto l850
 					}
-					goto l470
-				l469:
-					position, tokenIndex = position469, tokenIndex469
+					goto l851
+				l850:
+					position, tokenIndex = position850, tokenIndex850
 				}
-			l470:
+			l851:
 				if buffer[position] != rune(')') {
-					goto l465
+					goto l846
 				}
 				position++
 				{
-					position471, tokenIndex471 := position, tokenIndex
+					position852, tokenIndex852 := position, tokenIndex
 					{
-						position473, tokenIndex473 := position, tokenIndex
+						position854, tokenIndex854 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l473
+							goto l854
 						}
-						goto l474
-					l473:
-						position, tokenIndex = position473, tokenIndex473
+						goto l855
+					l854:
+						position, tokenIndex = position854, tokenIndex854
 					}
-				l474:
+				l855:
 					if buffer[position] != rune(',') {
-						goto l471
+						goto l852
 					}
 					position++
-					goto l472
-				l471:
-					position, tokenIndex = position471, tokenIndex471
+					goto l853
+				l852:
+					position, tokenIndex = position852, tokenIndex852
 				}
-			l472:
+			l853:
 				{
-					position475, tokenIndex475 := position, tokenIndex
+					position856, tokenIndex856 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l475
+						goto l856
 					}
-					goto l476
-				l475:
-					position, tokenIndex = position475, tokenIndex475
+					goto l857
+				l856:
+					position, tokenIndex = position856, tokenIndex856
 				}
-			l476:
+			l857:
 				if !_rules[ruleYear]() {
-					goto l465
+					goto l846
 				}
-				add(ruleBasionymAuthorshipYearMisformed, position466)
+				add(ruleBasionymAuthorshipYearMisformed, position847)
 			}
 			return true
-		l465:
-			position, tokenIndex = position465, tokenIndex465
+		l846:
+			position, tokenIndex = position846, tokenIndex846
 			return false
 		},
-		/* 63 BasionymAuthorship <- <(BasionymAuthorship1 / BasionymAuthorship2Parens)> */
+		/* 105 BasionymAuthorship <- <(BasionymAuthorship1 / BasionymAuthorship2Parens)> */
 		func() bool {
-			position477, tokenIndex477 := position, tokenIndex
+			position858, tokenIndex858 := position, tokenIndex
 			{
-				position478 := position
+				position859 := position
 				{
-					position479, tokenIndex479 := position, tokenIndex
+					position860, tokenIndex860 := position, tokenIndex
 					if !_rules[ruleBasionymAuthorship1]() {
-						goto l480
+						goto l861
 					}
-					goto l479
-				l480:
-					position, tokenIndex = position479, tokenIndex479
+					goto l860
+				l861:
+					position, tokenIndex = position860, tokenIndex860
 					if !_rules[ruleBasionymAuthorship2Parens]() {
-						goto l477
+						goto l858
 					}
 				}
-			l479:
-				add(ruleBasionymAuthorship, position478)
+			l860:
+				add(ruleBasionymAuthorship, position859)
 			}
 			return true
-		l477:
-			position, tokenIndex = position477, tokenIndex477
+		l858:
+			position, tokenIndex = position858, tokenIndex858
 			return false
 		},
-		/* 64 BasionymAuthorship1 <- <('(' _? AuthorsGroup _? ')')> */
+		/* 106 BasionymAuthorship1 <- <('(' _? AuthorsGroup _? ')')> */
 		func() bool {
-			position481, tokenIndex481 := position, tokenIndex
+			position862, tokenIndex862 := position, tokenIndex
 			{
-				position482 := position
+				position863 := position
 				if buffer[position] != rune('(') {
-					goto l481
+					goto l862
 				}
 				position++
 				{
-					position483, tokenIndex483 := position, tokenIndex
+					position864, tokenIndex864 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l483
+						goto l864
 					}
-					goto l484
-				l483:
-					position, tokenIndex = position483, tokenIndex483
+					goto l865
+				l864:
+					position, tokenIndex = position864, tokenIndex864
 				}
-			l484:
+			l865:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l481
+					goto l862
 				}
 				{
-					position485, tokenIndex485 := position, tokenIndex
+					position866, tokenIndex866 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l485
+						goto l866
 					}
-					goto l486
-				l485:
-					position, tokenIndex = position485, tokenIndex485
+					goto l867
+				l866:
+					position, tokenIndex = position866, tokenIndex866
 				}
-			l486:
+			l867:
 				if buffer[position] != rune(')') {
-					goto l481
+					goto l862
 				}
 				position++
-				add(ruleBasionymAuthorship1, position482)
+				add(ruleBasionymAuthorship1, position863)
 			}
 			return true
-		l481:
-			position, tokenIndex = position481, tokenIndex481
+		l862:
+			position, tokenIndex = position862, tokenIndex862
 			return false
 		},
-		/* 65 BasionymAuthorship2Parens <- <('(' _? '(' _? AuthorsGroup _? ')' _? ')')> */
+		/* 107 BasionymAuthorship2Parens <- <('(' _? '(' _? AuthorsGroup _? ')' _? ')')> */
 		func() bool {
-			position487, tokenIndex487 := position, tokenIndex
+			position868, tokenIndex868 := position, tokenIndex
 			{
-				position488 := position
+				position869 := position
 				if buffer[position] != rune('(') {
-					goto l487
+					goto l868
 				}
 				position++
 				{
-					position489, tokenIndex489 := position, tokenIndex
+					position870, tokenIndex870 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l489
+						goto l870
 					}
-					goto l490
-				l489:
-					position, tokenIndex = position489, tokenIndex489
+					goto l871
+				l870:
+					position, tokenIndex = position870, tokenIndex870
 				}
-			l490:
+			l871:
 				if buffer[position] != rune('(') {
-					goto l487
+					goto l868
 				}
 				position++
 				{
-					position491, tokenIndex491 := position, tokenIndex
+					position872, tokenIndex872 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l491
+						goto l872
 					}
-					goto l492
-				l491:
-					position, tokenIndex = position491, tokenIndex491
+					goto l873
+				l872:
+					position, tokenIndex = position872, tokenIndex872
 				}
-			l492:
+			l873:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l487
+					goto l868
 				}
 				{
-					position493, tokenIndex493 := position, tokenIndex
+					position874, tokenIndex874 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l493
+						goto l874
 					}
-					goto l494
-				l493:
-					position, tokenIndex = position493, tokenIndex493
+					goto l875
+				l874:
+					position, tokenIndex = position874, tokenIndex874
 				}
-			l494:
+			l875:
 				if buffer[position] != rune(')') {
-					goto l487
+					goto l868
 				}
 				position++
 				{
-					position495, tokenIndex495 := position, tokenIndex
+					position876, tokenIndex876 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l495
+						goto l876
 					}
-					goto l496
-				l495:
-					position, tokenIndex = position495, tokenIndex495
+					goto l877
+				l876:
+					position, tokenIndex = position876, tokenIndex876
 				}
-			l496:
+			l877:
 				if buffer[position] != rune(')') {
-					goto l487
+					goto l868
 				}
 				position++
-				add(ruleBasionymAuthorship2Parens, position488)
+				add(ruleBasionymAuthorship2Parens, position869)
 			}
 			return true
-		l487:
-			position, tokenIndex = position487, tokenIndex487
+		l868:
+			position, tokenIndex = position868, tokenIndex868
 			return false
 		},
-		/* 66 AuthorsGroup <- <(AuthorsTeam (_ (AuthorEmend / AuthorEx) AuthorsTeam)?)> */
+		/* 108 AuthorsGroup <- <(AuthorsTeam (_ !PublicationAhead (AuthorEmend / AuthorEx) AuthorsTeam)? (_? AuthorSanctioning AuthorsTeam)?)> */
 		func() bool {
-			position497, tokenIndex497 := position, tokenIndex
+			position878, tokenIndex878 := position, tokenIndex
 			{
-				position498 := position
+				position879 := position
 				if !_rules[ruleAuthorsTeam]() {
-					goto l497
+					goto l878
 				}
 				{
-					position499, tokenIndex499 := position, tokenIndex
+					position880, tokenIndex880 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l499
+						goto l880
+					}
+					{
+						position882, tokenIndex882 := position, tokenIndex
+						if !_rules[rulePublicationAhead]() {
+							goto l882
+						}
+						goto l880
+					l882:
+						position, tokenIndex = position882, tokenIndex882
 					}
 					{
-						position501, tokenIndex501 := position, tokenIndex
+						position883, tokenIndex883 := position, tokenIndex
 						if !_rules[ruleAuthorEmend]() {
-							goto l502
+							goto l884
 						}
-						goto l501
-					l502:
-						position, tokenIndex = position501, tokenIndex501
+						goto l883
+					l884:
+						position, tokenIndex = position883, tokenIndex883
 						if !_rules[ruleAuthorEx]() {
-							goto l499
+							goto l880
 						}
 					}
-				l501:
+				l883:
 					if !_rules[ruleAuthorsTeam]() {
-						goto l499
+						goto l880
 					}
-					goto l500
-				l499:
-					position, tokenIndex = position499, tokenIndex499
+					goto l881
+				l880:
+					position, tokenIndex = position880, tokenIndex880
+				}
+			l881:
+				{
+					position885, tokenIndex885 := position, tokenIndex
+					{
+						position887, tokenIndex887 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l887
+						}
+						goto l888
+					l887:
+						position, tokenIndex = position887, tokenIndex887
+					}
+				l888:
+					if !_rules[ruleAuthorSanctioning]() {
+						goto l885
+					}
+					if !_rules[ruleAuthorsTeam]() {
+						goto l885
+					}
+					goto l886
+				l885:
+					position, tokenIndex = position885, tokenIndex885
 				}
-			l500:
-				add(ruleAuthorsGroup, position498)
+			l886:
+				add(ruleAuthorsGroup, position879)
 			}
 			return true
-		l497:
-			position, tokenIndex = position497, tokenIndex497
+		l878:
+			position, tokenIndex = position878, tokenIndex878
 			return false
 		},
-		/* 67 AuthorsTeam <- <(Author (AuthorSep Author)* (_? ','? _? Year)?)> */
+		/* 109 AuthorsTeam <- <(Author (AuthorSep Author)* (_? ','? _? Year)?)> */
 		func() bool {
-			position503, tokenIndex503 := position, tokenIndex
+			position889, tokenIndex889 := position, tokenIndex
 			{
-				position504 := position
+				position890 := position
 				if !_rules[ruleAuthor]() {
-					goto l503
+					goto l889
 				}
-			l505:
+			l891:
 				{
-					position506, tokenIndex506 := position, tokenIndex
+					position892, tokenIndex892 := position, tokenIndex
 					if !_rules[ruleAuthorSep]() {
-						goto l506
+						goto l892
 					}
 					if !_rules[ruleAuthor]() {
-						goto l506
+						goto l892
 					}
-					goto l505
-				l506:
-					position, tokenIndex = position506, tokenIndex506
+					goto l891
+				l892:
+					position, tokenIndex = position892, tokenIndex892
 				}
 				{
-					position507, tokenIndex507 := position, tokenIndex
+					position893, tokenIndex893 := position, tokenIndex
 					{
-						position509, tokenIndex509 := position, tokenIndex
+						position895, tokenIndex895 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l509
+							goto l895
 						}
-						goto l510
-					l509:
-						position, tokenIndex = position509, tokenIndex509
+						goto l896
+					l895:
+						position, tokenIndex = position895, tokenIndex895
 					}
-				l510:
+				l896:
 					{
-						position511, tokenIndex511 := position, tokenIndex
+						position897, tokenIndex897 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l511
+							goto l897
 						}
 						position++
-						goto l512
-					l511:
-						position, tokenIndex = position511, tokenIndex511
+						goto l898
+					l897:
+						position, tokenIndex = position897, tokenIndex897
 					}
-				l512:
+				l898:
 					{
-						position513, tokenIndex513 := position, tokenIndex
+						position899, tokenIndex899 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l513
+							goto l899
 						}
-						goto l514
-					l513:
-						position, tokenIndex = position513, tokenIndex513
+						goto l900
+					l899:
+						position, tokenIndex = position899, tokenIndex899
 					}
-				l514:
+				l900:
 					if !_rules[ruleYear]() {
-						goto l507
+						goto l893
 					}
-					goto l508
-				l507:
-					position, tokenIndex = position507, tokenIndex507
+					goto l894
+				l893:
+					position, tokenIndex = position893, tokenIndex893
 				}
-			l508:
-				add(ruleAuthorsTeam, position504)
+			l894:
+				add(ruleAuthorsTeam, position890)
 			}
 			return true
-		l503:
-			position, tokenIndex = position503, tokenIndex503
+		l889:
+			position, tokenIndex = position889, tokenIndex889
 			return false
 		},
-		/* 68 AuthorSep <- <(AuthorSep1 / AuthorSep2)> */
+		/* 110 AuthorSep <- <(AuthorSep1 / AuthorSep2)> */
 		func() bool {
-			position515, tokenIndex515 := position, tokenIndex
+			position901, tokenIndex901 := position, tokenIndex
 			{
-				position516 := position
+				position902 := position
 				{
-					position517, tokenIndex517 := position, tokenIndex
+					position903, tokenIndex903 := position, tokenIndex
 					if !_rules[ruleAuthorSep1]() {
-						goto l518
+						goto l904
 					}
-					goto l517
-				l518:
-					position, tokenIndex = position517, tokenIndex517
+					goto l903
+				l904:
+					position, tokenIndex = position903, tokenIndex903
 					if !_rules[ruleAuthorSep2]() {
-						goto l515
+						goto l901
 					}
 				}
-			l517:
-				add(ruleAuthorSep, position516)
+			l903:
+				add(ruleAuthorSep, position902)
 			}
 			return true
-		l515:
-			position, tokenIndex = position515, tokenIndex515
+		l901:
+			position, tokenIndex = position901, tokenIndex901
 			return false
 		},
-		/* 69 AuthorSep1 <- <(_? (',' _)? ('&' / AuthorSepSpanish / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd')) _?)> */
+		/* 111 AuthorSep1 <- <(_? (',' _)? ('&' / AuthorSepSpanish / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd')) _?)> */
 		func() bool {
-			position519, tokenIndex519 := position, tokenIndex
+			position905, tokenIndex905 := position, tokenIndex
 			{
-				position520 := position
+				position906 := position
 				{
-					position521, tokenIndex521 := position, tokenIndex
+					position907, tokenIndex907 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l521
+						goto l907
 					}
-					goto l522
-				l521:
-					position, tokenIndex = position521, tokenIndex521
+					goto l908
+				l907:
+					position, tokenIndex = position907, tokenIndex907
 				}
-			l522:
+			l908:
 				{
-					position523, tokenIndex523 := position, tokenIndex
+					position909, tokenIndex909 := position, tokenIndex
 					if buffer[position] != rune(',') {
-						goto l523
+						goto l909
 					}
 					position++
 					if !_rules[rule_]() {
-						goto l523
+						goto l909
 					}
-					goto l524
-				l523:
-					position, tokenIndex = position523, tokenIndex523
+					goto l910
+				l909:
+					position, tokenIndex = position909, tokenIndex909
 				}
-			l524:
+			l910:
 				{
-					position525, tokenIndex525 := position, tokenIndex
+					position911, tokenIndex911 := position, tokenIndex
 					if buffer[position] != rune('&') {
-						goto l526
+						goto l912
 					}
 					position++
-					goto l525
-				l526:
-					position, tokenIndex = position525, tokenIndex525
+					goto l911
+				l912:
+					position, tokenIndex = position911, tokenIndex911
 					if !_rules[ruleAuthorSepSpanish]() {
-						goto l527
+						goto l913
 					}
-					goto l525
-				l527:
-					position, tokenIndex = position525, tokenIndex525
+					goto l911
+				l913:
+					position, tokenIndex = position911, tokenIndex911
 					if buffer[position] != rune('e') {
-						goto l528
+						goto l914
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l528
+						goto l914
 					}
 					position++
-					goto l525
-				l528:
-					position, tokenIndex = position525, tokenIndex525
+					goto l911
+				l914:
+					position, tokenIndex = position911, tokenIndex911
 					if buffer[position] != rune('a') {
-						goto l529
+						goto l915
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l529
+						goto l915
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l529
+						goto l915
 					}
 					position++
-					goto l525
-				l529:
-					position, tokenIndex = position525, tokenIndex525
+					goto l911
+				l915:
+					position, tokenIndex = position911, tokenIndex911
 					if buffer[position] != rune('a') {
-						goto l519
+						goto l905
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l519
+						goto l905
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l519
+						goto l905
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l519
+						goto l905
 					}
 					position++
 				}
-			l525:
+			l911:
 				{
-					position530, tokenIndex530 := position, tokenIndex
+					position916, tokenIndex916 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l530
+						goto l916
 					}
-					goto l531
-				l530:
-					position, tokenIndex = position530, tokenIndex530
+					goto l917
+				l916:
+					position, tokenIndex = position916, tokenIndex916
 				}
-			l531:
-				add(ruleAuthorSep1, position520)
+			l917:
+				add(ruleAuthorSep1, position906)
 			}
 			return true
-		l519:
-			position, tokenIndex = position519, tokenIndex519
+		l905:
+			position, tokenIndex = position905, tokenIndex905
 			return false
 		},
-		/* 70 AuthorSep2 <- <(_? ',' _?)> */
+		/* 112 AuthorSep2 <- <(_? ',' !(_? PublicationStart) _?)> */
 		func() bool {
-			position532, tokenIndex532 := position, tokenIndex
+			position918, tokenIndex918 := position, tokenIndex
 			{
-				position533 := position
+				position919 := position
 				{
-					position534, tokenIndex534 := position, tokenIndex
+					position920, tokenIndex920 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l534
+						goto l920
 					}
-					goto l535
-				l534:
-					position, tokenIndex = position534, tokenIndex534
+					goto l921
+				l920:
+					position, tokenIndex = position920, tokenIndex920
 				}
-			l535:
+			l921:
 				if buffer[position] != rune(',') {
-					goto l532
+					goto l918
 				}
 				position++
 				{
-					position536, tokenIndex536 := position, tokenIndex
+					position922, tokenIndex922 := position, tokenIndex
+					{
+						position923, tokenIndex923 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l923
+						}
+						goto l924
+					l923:
+						position, tokenIndex = position923, tokenIndex923
+					}
+				l924:
+					if !_rules[rulePublicationStart]() {
+						goto l922
+					}
+					goto l918
+				l922:
+					position, tokenIndex = position922, tokenIndex922
+				}
+				{
+					position925, tokenIndex925 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l536
+						goto l925
 					}
-					goto l537
-				l536:
-					position, tokenIndex = position536, tokenIndex536
+					goto l926
+				l925:
+					position, tokenIndex = position925, tokenIndex925
 				}
-			l537:
-				add(ruleAuthorSep2, position533)
+			l926:
+				add(ruleAuthorSep2, position919)
 			}
 			return true
-		l532:
-			position, tokenIndex = position532, tokenIndex532
+		l918:
+			position, tokenIndex = position918, tokenIndex918
 			return false
 		},
-		/* 71 AuthorSepSpanish <- <(_? 'y' _?)> */
+		/* 113 AuthorSepSpanish <- <(_? 'y' _?)> */
 		func() bool {
-			position538, tokenIndex538 := position, tokenIndex
+			position927, tokenIndex927 := position, tokenIndex
 			{
-				position539 := position
+				position928 := position
 				{
-					position540, tokenIndex540 := position, tokenIndex
+					position929, tokenIndex929 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l540
+						goto l929
 					}
-					goto l541
-				l540:
-					position, tokenIndex = position540, tokenIndex540
+					goto l930
+				l929:
+					position, tokenIndex = position929, tokenIndex929
 				}
-			l541:
+			l930:
 				if buffer[position] != rune('y') {
-					goto l538
+					goto l927
 				}
 				position++
 				{
-					position542, tokenIndex542 := position, tokenIndex
+					position931, tokenIndex931 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l542
+						goto l931
 					}
-					goto l543
-				l542:
-					position, tokenIndex = position542, tokenIndex542
+					goto l932
+				l931:
+					position, tokenIndex = position931, tokenIndex931
 				}
-			l543:
-				add(ruleAuthorSepSpanish, position539)
+			l932:
+				add(ruleAuthorSepSpanish, position928)
 			}
 			return true
-		l538:
-			position, tokenIndex = position538, tokenIndex538
+		l927:
+			position, tokenIndex = position927, tokenIndex927
 			return false
 		},
-		/* 72 AuthorEx <- <((('e' 'x' '.'?) / ('i' 'n')) _)> */
+		/* 114 AuthorEx <- <((('e' 'x' '.'?) / ('i' 'n')) _)> */
 		func() bool {
-			position544, tokenIndex544 := position, tokenIndex
+			position933, tokenIndex933 := position, tokenIndex
 			{
-				position545 := position
+				position934 := position
 				{
-					position546, tokenIndex546 := position, tokenIndex
+					position935, tokenIndex935 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l547
+						goto l936
 					}
 					position++
 					if buffer[position] != rune('x') {
-						goto l547
+						goto l936
 					}
 					position++
 					{
-						position548, tokenIndex548 := position, tokenIndex
+						position937, tokenIndex937 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l548
+							goto l937
 						}
 						position++
-						goto l549
-					l548:
-						position, tokenIndex = position548, tokenIndex548
+						goto l938
+					l937:
+						position, tokenIndex = position937, tokenIndex937
 					}
-				l549:
-					goto l546
-				l547:
-					position, tokenIndex = position546, tokenIndex546
+				l938:
+					goto l935
+				l936:
+					position, tokenIndex = position935, tokenIndex935
 					if buffer[position] != rune('i') {
-						goto l544
+						goto l933
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l544
+						goto l933
 					}
 					position++
 				}
-			l546:
+			l935:
 				if !_rules[rule_]() {
-					goto l544
+					goto l933
 				}
-				add(ruleAuthorEx, position545)
+				add(ruleAuthorEx, position934)
 			}
 			return true
-		l544:
-			position, tokenIndex = position544, tokenIndex544
+		l933:
+			position, tokenIndex = position933, tokenIndex933
 			return false
 		},
-		/* 73 AuthorEmend <- <('e' 'm' 'e' 'n' 'd' '.'? _)> */
+		/* 115 AuthorEmend <- <('e' 'm' 'e' 'n' 'd' '.'? _)> */
 		func() bool {
-			position550, tokenIndex550 := position, tokenIndex
+			position939, tokenIndex939 := position, tokenIndex
 			{
-				position551 := position
+				position940 := position
 				if buffer[position] != rune('e') {
-					goto l550
+					goto l939
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l550
+					goto l939
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l550
+					goto l939
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l550
+					goto l939
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l550
+					goto l939
 				}
 				position++
 				{
-					position552, tokenIndex552 := position, tokenIndex
+					position941, tokenIndex941 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l552
+						goto l941
 					}
 					position++
-					goto l553
-				l552:
-					position, tokenIndex = position552, tokenIndex552
+					goto l942
+				l941:
+					position, tokenIndex = position941, tokenIndex941
 				}
-			l553:
+			l942:
 				if !_rules[rule_]() {
-					goto l550
+					goto l939
+				}
+				add(ruleAuthorEmend, position940)
+			}
+			return true
+		l939:
+			position, tokenIndex = position939, tokenIndex939
+			return false
+		},
+		/* 116 AuthorSanctioning <- <(':' _?)> */
+		func() bool {
+			position943, tokenIndex943 := position, tokenIndex
+			{
+				position944 := position
+				if buffer[position] != rune(':') {
+					goto l943
+				}
+				position++
+				{
+					position945, tokenIndex945 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l945
+					}
+					goto l946
+				l945:
+					position, tokenIndex = position945, tokenIndex945
 				}
-				add(ruleAuthorEmend, position551)
+			l946:
+				add(ruleAuthorSanctioning, position944)
 			}
 			return true
-		l550:
-			position, tokenIndex = position550, tokenIndex550
+		l943:
+			position, tokenIndex = position943, tokenIndex943
 			return false
 		},
-		/* 74 Author <- <(Author1 / Author2 / UnknownAuthor)> */
+		/* 117 Author <- <(!TaxonConceptAhead (Author1 / Author2 / UnknownAuthor))> */
 		func() bool {
-			position554, tokenIndex554 := position, tokenIndex
+			position947, tokenIndex947 := position, tokenIndex
 			{
-				position555 := position
+				position948 := position
+				{
+					position949, tokenIndex949 := position, tokenIndex
+					if !_rules[ruleTaxonConceptAhead]() {
+						goto l949
+					}
+					goto l947
+				l949:
+					position, tokenIndex = position949, tokenIndex949
+				}
 				{
-					position556, tokenIndex556 := position, tokenIndex
+					position950, tokenIndex950 := position, tokenIndex
 					if !_rules[ruleAuthor1]() {
-						goto l557
+						goto l951
 					}
-					goto l556
-				l557:
-					position, tokenIndex = position556, tokenIndex556
+					goto l950
+				l951:
+					position, tokenIndex = position950, tokenIndex950
 					if !_rules[ruleAuthor2]() {
-						goto l558
+						goto l952
 					}
-					goto l556
-				l558:
-					position, tokenIndex = position556, tokenIndex556
+					goto l950
+				l952:
+					position, tokenIndex = position950, tokenIndex950
 					if !_rules[ruleUnknownAuthor]() {
-						goto l554
+						goto l947
 					}
 				}
-			l556:
-				add(ruleAuthor, position555)
+			l950:
+				add(ruleAuthor, position948)
 			}
 			return true
-		l554:
-			position, tokenIndex = position554, tokenIndex554
+		l947:
+			position, tokenIndex = position947, tokenIndex947
 			return false
 		},
-		/* 75 Author1 <- <(Author2 _? (Filius / AuthorSuffix))> */
+		/* 118 Author1 <- <(Author2 _? (Filius / AuthorSuffix))> */
 		func() bool {
-			position559, tokenIndex559 := position, tokenIndex
+			position953, tokenIndex953 := position, tokenIndex
 			{
-				position560 := position
+				position954 := position
 				if !_rules[ruleAuthor2]() {
-					goto l559
+					goto l953
 				}
 				{
-					position561, tokenIndex561 := position, tokenIndex
+					position955, tokenIndex955 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l561
+						goto l955
 					}
-					goto l562
-				l561:
-					position, tokenIndex = position561, tokenIndex561
+					goto l956
+				l955:
+					position, tokenIndex = position955, tokenIndex955
 				}
-			l562:
+			l956:
 				{
-					position563, tokenIndex563 := position, tokenIndex
+					position957, tokenIndex957 := position, tokenIndex
 					if !_rules[ruleFilius]() {
-						goto l564
+						goto l958
 					}
-					goto l563
-				l564:
-					position, tokenIndex = position563, tokenIndex563
+					goto l957
+				l958:
+					position, tokenIndex = position957, tokenIndex957
 					if !_rules[ruleAuthorSuffix]() {
-						goto l559
+						goto l953
 					}
 				}
-			l563:
-				add(ruleAuthor1, position560)
+			l957:
+				add(ruleAuthor1, position954)
 			}
 			return true
-		l559:
-			position, tokenIndex = position559, tokenIndex559
+		l953:
+			position, tokenIndex = position953, tokenIndex953
 			return false
 		},
-		/* 76 Author2 <- <(AuthorWord (_? AuthorWord)*)> */
+		/* 119 Author2 <- <(AuthorWord (!(_ PublicationStart) _? AuthorWord)*)> */
 		func() bool {
-			position565, tokenIndex565 := position, tokenIndex
+			position959, tokenIndex959 := position, tokenIndex
 			{
-				position566 := position
+				position960 := position
 				if !_rules[ruleAuthorWord]() {
-					goto l565
+					goto l959
 				}
-			l567:
+			l961:
 				{
-					position568, tokenIndex568 := position, tokenIndex
+					position962, tokenIndex962 := position, tokenIndex
+					{
+						position963, tokenIndex963 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l963
+						}
+						if !_rules[rulePublicationStart]() {
+							goto l963
+						}
+						goto l962
+					l963:
+						position, tokenIndex = position963, tokenIndex963
+					}
 					{
-						position569, tokenIndex569 := position, tokenIndex
+						position964, tokenIndex964 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l569
+							goto l964
 						}
-						goto l570
-					l569:
-						position, tokenIndex = position569, tokenIndex569
+						goto l965
+					l964:
+						position, tokenIndex = position964, tokenIndex964
 					}
-				l570:
+				l965:
 					if !_rules[ruleAuthorWord]() {
-						goto l568
+						goto l962
 					}
-					goto l567
-				l568:
-					position, tokenIndex = position568, tokenIndex568
+					goto l961
+				l962:
+					position, tokenIndex = position962, tokenIndex962
 				}
-				add(ruleAuthor2, position566)
+				add(ruleAuthor2, position960)
 			}
 			return true
-		l565:
-			position, tokenIndex = position565, tokenIndex565
+		l959:
+			position, tokenIndex = position959, tokenIndex959
 			return false
 		},
-		/* 77 UnknownAuthor <- <('?' / ((('a' 'u' 'c' 't') / ('a' 'n' 'o' 'n')) (&SpaceCharEOI / '.')))> */
+		/* 120 UnknownAuthor <- <('?' / ((('a' 'u' 'c' 't') / ('a' 'n' 'o' 'n')) (&SpaceCharEOI / '.')))> */
 		func() bool {
-			position571, tokenIndex571 := position, tokenIndex
+			position966, tokenIndex966 := position, tokenIndex
 			{
-				position572 := position
+				position967 := position
 				{
-					position573, tokenIndex573 := position, tokenIndex
+					position968, tokenIndex968 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l574
+						goto l969
 					}
 					position++
-					goto l573
-				l574:
-					position, tokenIndex = position573, tokenIndex573
+					goto l968
+				l969:
+					position, tokenIndex = position968, tokenIndex968
 					{
-						position575, tokenIndex575 := position, tokenIndex
+						position970, tokenIndex970 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l576
+							goto l971
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l576
+							goto l971
 						}
 						position++
 						if buffer[position] != rune('c') {
-							goto l576
+							goto l971
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l576
+							goto l971
 						}
 						position++
-						goto l575
-					l576:
-						position, tokenIndex = position575, tokenIndex575
+						goto l970
+					l971:
+						position, tokenIndex = position970, tokenIndex970
 						if buffer[position] != rune('a') {
-							goto l571
+							goto l966
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l571
+							goto l966
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l571
+							goto l966
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l571
+							goto l966
 						}
 						position++
 					}
-				l575:
+				l970:
 					{
-						position577, tokenIndex577 := position, tokenIndex
+						position972, tokenIndex972 := position, tokenIndex
 						{
-							position579, tokenIndex579 := position, tokenIndex
+							position974, tokenIndex974 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l578
+								goto l973
 							}
-							position, tokenIndex = position579, tokenIndex579
+							position, tokenIndex = position974, tokenIndex974
 						}
-						goto l577
-					l578:
-						position, tokenIndex = position577, tokenIndex577
+						goto l972
+					l973:
+						position, tokenIndex = position972, tokenIndex972
 						if buffer[position] != rune('.') {
-							goto l571
+							goto l966
 						}
 						position++
 					}
-				l577:
+				l972:
 				}
-			l573:
-				add(ruleUnknownAuthor, position572)
+			l968:
+				add(ruleUnknownAuthor, position967)
 			}
 			return true
-		l571:
-			position, tokenIndex = position571, tokenIndex571
+		l966:
+			position, tokenIndex = position966, tokenIndex966
 			return false
 		},
-		/* 78 AuthorWord <- <(!(('b' / 'B') ('o' / 'O') ('l' / 'L') ('d' / 'D') ':') (AuthorEtAl / AuthorWord2 / AuthorWord3 / AuthorPrefix))> */
+		/* 121 AuthorWord <- <(!((('b' / 'B') ('o' / 'O') ('l' / 'L') ('d' / 'D') ':') / TaxonConceptAhead) (AuthorEtAl / AuthorWord2 / AuthorWord3 / AuthorPrefix))> */
 		func() bool {
-			position580, tokenIndex580 := position, tokenIndex
+			position975, tokenIndex975 := position, tokenIndex
 			{
-				position581 := position
+				position976 := position
 				{
-					position582, tokenIndex582 := position, tokenIndex
-					{
-						position583, tokenIndex583 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l584
-						}
-						position++
-						goto l583
-					l584:
-						position, tokenIndex = position583, tokenIndex583
-						if buffer[position] != rune('B') {
-							goto l582
-						}
-						position++
-					}
-				l583:
+					position977, tokenIndex977 := position, tokenIndex
 					{
-						position585, tokenIndex585 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l586
+						position978, tokenIndex978 := position, tokenIndex
+						{
+							position980, tokenIndex980 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l981
+							}
+							position++
+							goto l980
+						l981:
+							position, tokenIndex = position980, tokenIndex980
+							if buffer[position] != rune('B') {
+								goto l979
+							}
+							position++
 						}
-						position++
-						goto l585
-					l586:
-						position, tokenIndex = position585, tokenIndex585
-						if buffer[position] != rune('O') {
-							goto l582
+					l980:
+						{
+							position982, tokenIndex982 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l983
+							}
+							position++
+							goto l982
+						l983:
+							position, tokenIndex = position982, tokenIndex982
+							if buffer[position] != rune('O') {
+								goto l979
+							}
+							position++
 						}
-						position++
-					}
-				l585:
-					{
-						position587, tokenIndex587 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l588
+					l982:
+						{
+							position984, tokenIndex984 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l985
+							}
+							position++
+							goto l984
+						l985:
+							position, tokenIndex = position984, tokenIndex984
+							if buffer[position] != rune('L') {
+								goto l979
+							}
+							position++
 						}
-						position++
-						goto l587
-					l588:
-						position, tokenIndex = position587, tokenIndex587
-						if buffer[position] != rune('L') {
-							goto l582
+					l984:
+						{
+							position986, tokenIndex986 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l987
+							}
+							position++
+							goto l986
+						l987:
+							position, tokenIndex = position986, tokenIndex986
+							if buffer[position] != rune('D') {
+								goto l979
+							}
+							position++
 						}
-						position++
-					}
-				l587:
-					{
-						position589, tokenIndex589 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l590
+					l986:
+						if buffer[position] != rune(':') {
+							goto l979
 						}
 						position++
-						goto l589
-					l590:
-						position, tokenIndex = position589, tokenIndex589
-						if buffer[position] != rune('D') {
-							goto l582
+						goto l978
+					l979:
+						position, tokenIndex = position978, tokenIndex978
+						if !_rules[ruleTaxonConceptAhead]() {
+							goto l977
 						}
-						position++
-					}
-				l589:
-					if buffer[position] != rune(':') {
-						goto l582
 					}
-					position++
-					goto l580
-				l582:
-					position, tokenIndex = position582, tokenIndex582
+				l978:
+					goto l975
+				l977:
+					position, tokenIndex = position977, tokenIndex977
 				}
 				{
-					position591, tokenIndex591 := position, tokenIndex
+					position988, tokenIndex988 := position, tokenIndex
 					if !_rules[ruleAuthorEtAl]() {
-						goto l592
+						goto l989
 					}
-					goto l591
-				l592:
-					position, tokenIndex = position591, tokenIndex591
+					goto l988
+				l989:
+					position, tokenIndex = position988, tokenIndex988
 					if !_rules[ruleAuthorWord2]() {
-						goto l593
+						goto l990
 					}
-					goto l591
-				l593:
-					position, tokenIndex = position591, tokenIndex591
+					goto l988
+				l990:
+					position, tokenIndex = position988, tokenIndex988
 					if !_rules[ruleAuthorWord3]() {
-						goto l594
+						goto l991
 					}
-					goto l591
-				l594:
-					position, tokenIndex = position591, tokenIndex591
+					goto l988
+				l991:
+					position, tokenIndex = position988, tokenIndex988
 					if !_rules[ruleAuthorPrefix]() {
-						goto l580
+						goto l975
 					}
 				}
-			l591:
-				add(ruleAuthorWord, position581)
+			l988:
+				add(ruleAuthorWord, position976)
 			}
 			return true
-		l580:
-			position, tokenIndex = position580, tokenIndex580
+		l975:
+			position, tokenIndex = position975, tokenIndex975
 			return false
 		},
-		/* 79 AuthorEtAl <- <(('a' 'r' 'g' '.') / ('e' 't' ' ' 'a' 'l' '.' '{' '?' '}') / ((('e' 't') / '&') (' ' 'a' 'l') '.'?))> */
+		/* 122 AuthorEtAl <- <(('a' 'r' 'g' '.') / ('e' 't' ' ' 'a' 'l' '.' '{' '?' '}') / ((('e' 't') / '&') (' ' 'a' 'l') '.'?))> */
 		func() bool {
-			position595, tokenIndex595 := position, tokenIndex
+			position992, tokenIndex992 := position, tokenIndex
 			{
-				position596 := position
+				position993 := position
 				{
-					position597, tokenIndex597 := position, tokenIndex
+					position994, tokenIndex994 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l598
+						goto l995
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l598
+						goto l995
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l598
+						goto l995
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l598
+						goto l995
 					}
 					position++
-					goto l597
-				l598:
-					position, tokenIndex = position597, tokenIndex597
+					goto l994
+				l995:
+					position, tokenIndex = position994, tokenIndex994
 					if buffer[position] != rune('e') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune(' ') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('{') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('?') {
-						goto l599
+						goto l996
 					}
 					position++
 					if buffer[position] != rune('}') {
-						goto l599
+						goto l996
 					}
 					position++
-					goto l597
-				l599:
-					position, tokenIndex = position597, tokenIndex597
+					goto l994
+				l996:
+					position, tokenIndex = position994, tokenIndex994
 					{
-						position600, tokenIndex600 := position, tokenIndex
+						position997, tokenIndex997 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l601
+							goto l998
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l601
+							goto l998
 						}
 						position++
-						goto l600
-					l601:
-						position, tokenIndex = position600, tokenIndex600
+						goto l997
+					l998:
+						position, tokenIndex = position997, tokenIndex997
 						if buffer[position] != rune('&') {
-							goto l595
+							goto l992
 						}
 						position++
 					}
-				l600:
+				l997:
 					if buffer[position] != rune(' ') {
-						goto l595
+						goto l992
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l595
+						goto l992
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l595
+						goto l992
 					}
 					position++
 					{
-						position602, tokenIndex602 := position, tokenIndex
+						position999, tokenIndex999 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l602
+							goto l999
 						}
 						position++
-						goto l603
-					l602:
-						position, tokenIndex = position602, tokenIndex602
+						goto l1000
+					l999:
+						position, tokenIndex = position999, tokenIndex999
 					}
-				l603:
+				l1000:
 				}
-			l597:
-				add(ruleAuthorEtAl, position596)
+			l994:
+				add(ruleAuthorEtAl, position993)
 			}
 			return true
-		l595:
-			position, tokenIndex = position595, tokenIndex595
+		l992:
+			position, tokenIndex = position992, tokenIndex992
 			return false
 		},
-		/* 80 AuthorWord2 <- <(AuthorWord3 Dash AuthorWordSoft)> */
+		/* 123 AuthorWord2 <- <(AuthorWord3 Dash AuthorWordSoft)> */
 		func() bool {
-			position604, tokenIndex604 := position, tokenIndex
+			position1001, tokenIndex1001 := position, tokenIndex
 			{
-				position605 := position
+				position1002 := position
 				if !_rules[ruleAuthorWord3]() {
-					goto l604
+					goto l1001
 				}
 				if !_rules[ruleDash]() {
-					goto l604
+					goto l1001
 				}
 				if !_rules[ruleAuthorWordSoft]() {
-					goto l604
+					goto l1001
 				}
-				add(ruleAuthorWord2, position605)
+				add(ruleAuthorWord2, position1002)
 			}
 			return true
-		l604:
-			position, tokenIndex = position604, tokenIndex604
+		l1001:
+			position, tokenIndex = position1001, tokenIndex1001
 			return false
 		},
-		/* 81 AuthorWord3 <- <(AuthorPrefixGlued? (AllCapsAuthorWord / CapAuthorWord) '.'?)> */
+		/* 124 AuthorWord3 <- <(AuthorPrefixGlued? (AllCapsAuthorWord / CapAuthorWord) '.'?)> */
 		func() bool {
-			position606, tokenIndex606 := position, tokenIndex
+			position1003, tokenIndex1003 := position, tokenIndex
 			{
-				position607 := position
+				position1004 := position
 				{
-					position608, tokenIndex608 := position, tokenIndex
+					position1005, tokenIndex1005 := position, tokenIndex
 					if !_rules[ruleAuthorPrefixGlued]() {
-						goto l608
+						goto l1005
 					}
-					goto l609
-				l608:
-					position, tokenIndex = position608, tokenIndex608
+					goto l1006
+				l1005:
+					position, tokenIndex = position1005, tokenIndex1005
 				}
-			l609:
+			l1006:
 				{
-					position610, tokenIndex610 := position, tokenIndex
+					position1007, tokenIndex1007 := position, tokenIndex
 					if !_rules[ruleAllCapsAuthorWord]() {
-						goto l611
+						goto l1008
 					}
-					goto l610
-				l611:
-					position, tokenIndex = position610, tokenIndex610
+					goto l1007
+				l1008:
+					position, tokenIndex = position1007, tokenIndex1007
 					if !_rules[ruleCapAuthorWord]() {
-						goto l606
+						goto l1003
 					}
 				}
-			l610:
+			l1007:
 				{
-					position612, tokenIndex612 := position, tokenIndex
+					position1009, tokenIndex1009 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l612
+						goto l1009
 					}
 					position++
-					goto l613
-				l612:
-					position, tokenIndex = position612, tokenIndex612
+					goto l1010
+				l1009:
+					position, tokenIndex = position1009, tokenIndex1009
 				}
-			l613:
-				add(ruleAuthorWord3, position607)
+			l1010:
+				add(ruleAuthorWord3, position1004)
 			}
 			return true
-		l606:
-			position, tokenIndex = position606, tokenIndex606
+		l1003:
+			position, tokenIndex = position1003, tokenIndex1003
 			return false
 		},
-		/* 82 AuthorWordSoft <- <(((AuthorUpperChar (AuthorUpperChar+ / AuthorLowerChar+)) / AuthorLowerChar+) '.'?)> */
+		/* 125 AuthorWordSoft <- <(((AuthorUpperChar (AuthorUpperChar+ / AuthorLowerChar+)) / AuthorLowerChar+) '.'?)> */
 		func() bool {
-			position614, tokenIndex614 := position, tokenIndex
+			position1011, tokenIndex1011 := position, tokenIndex
 			{
-				position615 := position
+				position1012 := position
 				{
-					position616, tokenIndex616 := position, tokenIndex
+					position1013, tokenIndex1013 := position, tokenIndex
 					if !_rules[ruleAuthorUpperChar]() {
-						goto l617
+						goto l1014
 					}
 					{
-						position618, tokenIndex618 := position, tokenIndex
+						position1015, tokenIndex1015 := position, tokenIndex
 						if !_rules[ruleAuthorUpperChar]() {
-							goto l619
+							goto l1016
 						}
-					l620:
+					l1017:
 						{
-							position621, tokenIndex621 := position, tokenIndex
+							position1018, tokenIndex1018 := position, tokenIndex
 							if !_rules[ruleAuthorUpperChar]() {
-								goto l621
+								goto l1018
 							}
-							goto l620
-						l621:
-							position, tokenIndex = position621, tokenIndex621
+							goto l1017
+						l1018:
+							position, tokenIndex = position1018, tokenIndex1018
 						}
-						goto l618
-					l619:
-						position, tokenIndex = position618, tokenIndex618
+						goto l1015
+					l1016:
+						position, tokenIndex = position1015, tokenIndex1015
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l617
+							goto l1014
 						}
-					l622:
+					l1019:
 						{
-							position623, tokenIndex623 := position, tokenIndex
+							position1020, tokenIndex1020 := position, tokenIndex
 							if !_rules[ruleAuthorLowerChar]() {
-								goto l623
+								goto l1020
 							}
-							goto l622
-						l623:
-							position, tokenIndex = position623, tokenIndex623
+							goto l1019
+						l1020:
+							position, tokenIndex = position1020, tokenIndex1020
 						}
 					}
-				l618:
-					goto l616
-				l617:
-					position, tokenIndex = position616, tokenIndex616
+				l1015:
+					goto l1013
+				l1014:
+					position, tokenIndex = position1013, tokenIndex1013
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l614
+						goto l1011
 					}
-				l624:
+				l1021:
 					{
-						position625, tokenIndex625 := position, tokenIndex
+						position1022, tokenIndex1022 := position, tokenIndex
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l625
+							goto l1022
 						}
-						goto l624
-					l625:
-						position, tokenIndex = position625, tokenIndex625
+						goto l1021
+					l1022:
+						position, tokenIndex = position1022, tokenIndex1022
 					}
 				}
-			l616:
+			l1013:
 				{
-					position626, tokenIndex626 := position, tokenIndex
+					position1023, tokenIndex1023 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l626
+						goto l1023
 					}
 					position++
-					goto l627
-				l626:
-					position, tokenIndex = position626, tokenIndex626
+					goto l1024
+				l1023:
+					position, tokenIndex = position1023, tokenIndex1023
 				}
-			l627:
-				add(ruleAuthorWordSoft, position615)
+			l1024:
+				add(ruleAuthorWordSoft, position1012)
 			}
 			return true
-		l614:
-			position, tokenIndex = position614, tokenIndex614
+		l1011:
+			position, tokenIndex = position1011, tokenIndex1011
 			return false
 		},
-		/* 83 CapAuthorWord <- <(AuthorUpperChar AuthorLowerChar*)> */
+		/* 126 CapAuthorWord <- <(AuthorUpperChar AuthorLowerChar*)> */
 		func() bool {
-			position628, tokenIndex628 := position, tokenIndex
+			position1025, tokenIndex1025 := position, tokenIndex
 			{
-				position629 := position
+				position1026 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l628
+					goto l1025
 				}
-			l630:
+			l1027:
 				{
-					position631, tokenIndex631 := position, tokenIndex
+					position1028, tokenIndex1028 := position, tokenIndex
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l631
+						goto l1028
 					}
-					goto l630
-				l631:
-					position, tokenIndex = position631, tokenIndex631
+					goto l1027
+				l1028:
+					position, tokenIndex = position1028, tokenIndex1028
 				}
-				add(ruleCapAuthorWord, position629)
+				add(ruleCapAuthorWord, position1026)
 			}
 			return true
-		l628:
-			position, tokenIndex = position628, tokenIndex628
+		l1025:
+			position, tokenIndex = position1025, tokenIndex1025
 			return false
 		},
-		/* 84 AllCapsAuthorWord <- <(AuthorUpperChar AuthorUpperChar+)> */
+		/* 127 AllCapsAuthorWord <- <(AuthorUpperChar AuthorUpperChar+)> */
 		func() bool {
-			position632, tokenIndex632 := position, tokenIndex
+			position1029, tokenIndex1029 := position, tokenIndex
 			{
-				position633 := position
+				position1030 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l632
+					goto l1029
 				}
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l632
+					goto l1029
 				}
-			l634:
+			l1031:
 				{
-					position635, tokenIndex635 := position, tokenIndex
+					position1032, tokenIndex1032 := position, tokenIndex
 					if !_rules[ruleAuthorUpperChar]() {
-						goto l635
+						goto l1032
 					}
-					goto l634
-				l635:
-					position, tokenIndex = position635, tokenIndex635
+					goto l1031
+				l1032:
+					position, tokenIndex = position1032, tokenIndex1032
 				}
-				add(ruleAllCapsAuthorWord, position633)
+				add(ruleAllCapsAuthorWord, position1030)
 			}
 			return true
-		l632:
-			position, tokenIndex = position632, tokenIndex632
+		l1029:
+			position, tokenIndex = position1029, tokenIndex1029
 			return false
 		},
-		/* 85 Filius <- <(('f' '.') / ('f' 'i' 'l' '.') / ('f' 'i' 'l' 'i' 'u' 's'))> */
+		/* 128 Filius <- <(FiliusF / ('f' 'i' 'l' '.') / ('f' 'i' 'l' 'i' 'u' 's'))> */
 		func() bool {
-			position636, tokenIndex636 := position, tokenIndex
+			position1033, tokenIndex1033 := position, tokenIndex
 			{
-				position637 := position
+				position1034 := position
 				{
-					position638, tokenIndex638 := position, tokenIndex
-					if buffer[position] != rune('f') {
-						goto l639
-					}
-					position++
-					if buffer[position] != rune('.') {
-						goto l639
+					position1035, tokenIndex1035 := position, tokenIndex
+					if !_rules[ruleFiliusF]() {
+						goto l1036
 					}
-					position++
-					goto l638
-				l639:
-					position, tokenIndex = position638, tokenIndex638
+					goto l1035
+				l1036:
+					position, tokenIndex = position1035, tokenIndex1035
 					if buffer[position] != rune('f') {
-						goto l640
+						goto l1037
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l640
+						goto l1037
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l640
+						goto l1037
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l640
+						goto l1037
 					}
 					position++
-					goto l638
-				l640:
-					position, tokenIndex = position638, tokenIndex638
+					goto l1035
+				l1037:
+					position, tokenIndex = position1035, tokenIndex1035
 					if buffer[position] != rune('f') {
-						goto l636
+						goto l1033
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l636
+						goto l1033
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l636
+						goto l1033
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l636
+						goto l1033
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l636
+						goto l1033
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l636
+						goto l1033
 					}
 					position++
 				}
-			l638:
-				add(ruleFilius, position637)
+			l1035:
+				add(ruleFilius, position1034)
+			}
+			return true
+		l1033:
+			position, tokenIndex = position1033, tokenIndex1033
+			return false
+		},
+		/* 129 FiliusF <- <('f' '.' !(&{ p.Code.isBotanical() } _ !(AuthorEx / AuthorEmend / Rank) LowerASCII))> */
+		func() bool {
+			position1038, tokenIndex1038 := position, tokenIndex
+			{
+				position1039 := position
+				if buffer[position] != rune('f') {
+					goto l1038
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l1038
+				}
+				position++
+				{
+					position1040, tokenIndex1040 := position, tokenIndex
+					if !(p.Code.isBotanical()) {
+						goto l1040
+					}
+					if !_rules[rule_]() {
+						goto l1040
+					}
+					{
+						position1041, tokenIndex1041 := position, tokenIndex
+						{
+							position1042, tokenIndex1042 := position, tokenIndex
+							if !_rules[ruleAuthorEx]() {
+								goto l1043
+							}
+							goto l1042
+						l1043:
+							position, tokenIndex = position1042, tokenIndex1042
+							if !_rules[ruleAuthorEmend]() {
+								goto l1044
+							}
+							goto l1042
+						l1044:
+							position, tokenIndex = position1042, tokenIndex1042
+							if !_rules[ruleRank]() {
+								goto l1041
+							}
+						}
+					l1042:
+						goto l1040
+					l1041:
+						position, tokenIndex = position1041, tokenIndex1041
+					}
+					if !_rules[ruleLowerASCII]() {
+						goto l1040
+					}
+					goto l1038
+				l1040:
+					position, tokenIndex = position1040, tokenIndex1040
+				}
+				add(ruleFiliusF, position1039)
 			}
 			return true
-		l636:
-			position, tokenIndex = position636, tokenIndex636
+		l1038:
+			position, tokenIndex = position1038, tokenIndex1038
 			return false
 		},
-		/* 86 AuthorSuffix <- <('b' 'i' 's')> */
+		/* 130 AuthorSuffix <- <('b' 'i' 's')> */
 		func() bool {
-			position641, tokenIndex641 := position, tokenIndex
+			position1045, tokenIndex1045 := position, tokenIndex
 			{
-				position642 := position
+				position1046 := position
 				if buffer[position] != rune('b') {
-					goto l641
+					goto l1045
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l641
+					goto l1045
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l641
+					goto l1045
 				}
 				position++
-				add(ruleAuthorSuffix, position642)
+				add(ruleAuthorSuffix, position1046)
 			}
 			return true
-		l641:
-			position, tokenIndex = position641, tokenIndex641
+		l1045:
+			position, tokenIndex = position1045, tokenIndex1045
 			return false
 		},
-		/* 87 AuthorPrefixGlued <- <(('d' / 'O' / 'L' / ('M' 'c') / 'M') Apostrophe)> */
+		/* 131 AuthorPrefixGlued <- <(('d' / 'O' / 'L' / ('M' 'c') / 'M') Apostrophe)> */
 		func() bool {
-			position643, tokenIndex643 := position, tokenIndex
+			position1047, tokenIndex1047 := position, tokenIndex
 			{
-				position644 := position
+				position1048 := position
 				{
-					position645, tokenIndex645 := position, tokenIndex
+					position1049, tokenIndex1049 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l646
+						goto l1050
 					}
 					position++
-					goto l645
-				l646:
-					position, tokenIndex = position645, tokenIndex645
+					goto l1049
+				l1050:
+					position, tokenIndex = position1049, tokenIndex1049
 					if buffer[position] != rune('O') {
-						goto l647
+						goto l1051
 					}
 					position++
-					goto l645
-				l647:
-					position, tokenIndex = position645, tokenIndex645
+					goto l1049
+				l1051:
+					position, tokenIndex = position1049, tokenIndex1049
 					if buffer[position] != rune('L') {
-						goto l648
+						goto l1052
 					}
 					position++
-					goto l645
-				l648:
-					position, tokenIndex = position645, tokenIndex645
+					goto l1049
+				l1052:
+					position, tokenIndex = position1049, tokenIndex1049
 					if buffer[position] != rune('M') {
-						goto l649
+						goto l1053
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l649
+						goto l1053
 					}
 					position++
-					goto l645
-				l649:
-					position, tokenIndex = position645, tokenIndex645
+					goto l1049
+				l1053:
+					position, tokenIndex = position1049, tokenIndex1049
 					if buffer[position] != rune('M') {
-						goto l643
+						goto l1047
 					}
 					position++
 				}
-			l645:
+			l1049:
 				if !_rules[ruleApostrophe]() {
-					goto l643
+					goto l1047
 				}
-				add(ruleAuthorPrefixGlued, position644)
+				add(ruleAuthorPrefixGlued, position1048)
 			}
 			return true
-		l643:
-			position, tokenIndex = position643, tokenIndex643
+		l1047:
+			position, tokenIndex = position1047, tokenIndex1047
 			return false
 		},
-		/* 88 AuthorPrefix <- <(AuthorPrefix1 / AuthorPrefix2)> */
+		/* 132 AuthorPrefix <- <(AuthorPrefix1 / AuthorPrefix2)> */
 		func() bool {
-			position650, tokenIndex650 := position, tokenIndex
+			position1054, tokenIndex1054 := position, tokenIndex
 			{
-				position651 := position
+				position1055 := position
 				{
-					position652, tokenIndex652 := position, tokenIndex
+					position1056, tokenIndex1056 := position, tokenIndex
 					if !_rules[ruleAuthorPrefix1]() {
-						goto l653
+						goto l1057
 					}
-					goto l652
-				l653:
-					position, tokenIndex = position652, tokenIndex652
+					goto l1056
+				l1057:
+					position, tokenIndex = position1056, tokenIndex1056
 					if !_rules[ruleAuthorPrefix2]() {
-						goto l650
+						goto l1054
 					}
 				}
-			l652:
-				add(ruleAuthorPrefix, position651)
+			l1056:
+				add(ruleAuthorPrefix, position1055)
 			}
 			return true
-		l650:
-			position, tokenIndex = position650, tokenIndex650
+		l1054:
+			position, tokenIndex = position1054, tokenIndex1054
 			return false
 		},
-		/* 89 AuthorPrefix2 <- <(('v' '.' (_? ('d' '.'))?) / (Apostrophe 't'))> */
+		/* 133 AuthorPrefix2 <- <(('v' '.' (_? ('d' '.'))?) / (Apostrophe 't'))> */
 		func() bool {
-			position654, tokenIndex654 := position, tokenIndex
+			position1058, tokenIndex1058 := position, tokenIndex
 			{
-				position655 := position
+				position1059 := position
 				{
-					position656, tokenIndex656 := position, tokenIndex
+					position1060, tokenIndex1060 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l657
+						goto l1061
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l657
+						goto l1061
 					}
 					position++
 					{
-						position658, tokenIndex658 := position, tokenIndex
+						position1062, tokenIndex1062 := position, tokenIndex
 						{
-							position660, tokenIndex660 := position, tokenIndex
+							position1064, tokenIndex1064 := position, tokenIndex
 							if !_rules[rule_]() {
-								goto l660
+								goto l1064
 							}
-							goto l661
-						l660:
-							position, tokenIndex = position660, tokenIndex660
+							goto l1065
+						l1064:
+							position, tokenIndex = position1064, tokenIndex1064
 						}
-					l661:
+					l1065:
 						if buffer[position] != rune('d') {
-							goto l658
+							goto l1062
 						}
 						position++
 						if buffer[position] != rune('.') {
-							goto l658
+							goto l1062
 						}
 						position++
-						goto l659
-					l658:
-						position, tokenIndex = position658, tokenIndex658
+						goto l1063
+					l1062:
+						position, tokenIndex = position1062, tokenIndex1062
 					}
-				l659:
-					goto l656
-				l657:
-					position, tokenIndex = position656, tokenIndex656
+				l1063:
+					goto l1060
+				l1061:
+					position, tokenIndex = position1060, tokenIndex1060
 					if !_rules[ruleApostrophe]() {
-						goto l654
+						goto l1058
 					}
 					if buffer[position] != rune('t') {
-						goto l654
+						goto l1058
 					}
 					position++
 				}
-			l656:
-				add(ruleAuthorPrefix2, position655)
+			l1060:
+				add(ruleAuthorPrefix2, position1059)
 			}
 			return true
-		l654:
-			position, tokenIndex = position654, tokenIndex654
+		l1058:
+			position, tokenIndex = position1058, tokenIndex1058
 			return false
 		},
-		/* 90 AuthorPrefix1 <- <((('a' 'b') / ('a' 'f') / ('b' 'i' 's') / ('d' 'a') / ('d' 'e' 'r') / ('d' 'e' 's') / ('d' 'e' 'n') / ('d' 'e' 'l') / ('d' 'e' 'l' 'l' 'a') / ('d' 'e' 'l' 'a') / ('d' 'e') / ('d' 'i') / ('d' 'u') / ('e' 'l') / ('l' 'a') / ('l' 'e') / ('t' 'e' 'r') / ('v' 'a' 'n') / ('d' Apostrophe) / ('i' 'n' Apostrophe 't') / ('z' 'u' 'r') / ('z' 'u') / ('v' 'o' 'n' (_ (('d' '.') / ('d' 'e' 'm')))?) / ('v' (_ 'd')?)) &_)> */
+		/* 134 AuthorPrefix1 <- <((('a' 'b') / ('a' 'f') / ('b' 'i' 's') / ('d' 'a') / ('d' 'e' 'r') / ('d' 'e' 's') / ('d' 'e' 'n') / ('d' 'e' 'l') / ('d' 'e' 'l' 'l' 'a') / ('d' 'e' 'l' 'a') / ('d' 'e') / ('d' 'i') / ('d' 'u') / ('e' 'l') / ('l' 'a') / ('l' 'e') / ('t' 'e' 'r') / ('v' 'a' 'n') / ('d' Apostrophe) / ('i' 'n' Apostrophe 't') / ('z' 'u' 'r') / ('z' 'u') / ('v' 'o' 'n' (_ (('d' '.') / ('d' 'e' 'm')))?) / ('v' (_ 'd')?)) &_)> */
 		func() bool {
-			position662, tokenIndex662 := position, tokenIndex
+			position1066, tokenIndex1066 := position, tokenIndex
 			{
-				position663 := position
+				position1067 := position
 				{
-					position664, tokenIndex664 := position, tokenIndex
+					position1068, tokenIndex1068 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l665
+						goto l1069
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l665
+						goto l1069
 					}
 					position++
-					goto l664
-				l665:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1069:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('a') {
-						goto l666
+						goto l1070
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l666
+						goto l1070
 					}
 					position++
-					goto l664
-				l666:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1070:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('b') {
-						goto l667
+						goto l1071
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l667
+						goto l1071
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l667
+						goto l1071
 					}
 					position++
-					goto l664
-				l667:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1071:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l668
+						goto l1072
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l668
+						goto l1072
 					}
 					position++
-					goto l664
-				l668:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1072:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l669
+						goto l1073
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l669
+						goto l1073
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l669
+						goto l1073
 					}
 					position++
-					goto l664
-				l669:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1073:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l670
+						goto l1074
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l670
+						goto l1074
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l670
+						goto l1074
 					}
 					position++
-					goto l664
-				l670:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1074:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l671
+						goto l1075
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l671
+						goto l1075
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l671
+						goto l1075
 					}
 					position++
-					goto l664
-				l671:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1075:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l672
+						goto l1076
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l672
+						goto l1076
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l672
+						goto l1076
 					}
 					position++
-					goto l664
-				l672:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1076:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l673
+						goto l1077
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l673
+						goto l1077
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l673
+						goto l1077
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l673
+						goto l1077
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l673
+						goto l1077
 					}
 					position++
-					goto l664
-				l673:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1077:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l674
+						goto l1078
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l674
+						goto l1078
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l674
+						goto l1078
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l674
+						goto l1078
 					}
 					position++
-					goto l664
-				l674:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1078:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l675
+						goto l1079
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l675
+						goto l1079
 					}
 					position++
-					goto l664
-				l675:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1079:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l676
+						goto l1080
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l676
+						goto l1080
 					}
 					position++
-					goto l664
-				l676:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1080:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l677
+						goto l1081
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l677
+						goto l1081
 					}
 					position++
-					goto l664
-				l677:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1081:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('e') {
-						goto l678
+						goto l1082
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l678
+						goto l1082
 					}
 					position++
-					goto l664
-				l678:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1082:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('l') {
-						goto l679
+						goto l1083
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l679
+						goto l1083
 					}
 					position++
-					goto l664
-				l679:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1083:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('l') {
-						goto l680
+						goto l1084
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l680
+						goto l1084
 					}
 					position++
-					goto l664
-				l680:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1084:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('t') {
-						goto l681
+						goto l1085
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l681
+						goto l1085
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l681
+						goto l1085
 					}
 					position++
-					goto l664
-				l681:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1085:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('v') {
-						goto l682
+						goto l1086
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l682
+						goto l1086
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l682
+						goto l1086
 					}
 					position++
-					goto l664
-				l682:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1086:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('d') {
-						goto l683
+						goto l1087
 					}
 					position++
 					if !_rules[ruleApostrophe]() {
-						goto l683
+						goto l1087
 					}
-					goto l664
-				l683:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1087:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('i') {
-						goto l684
+						goto l1088
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l684
+						goto l1088
 					}
 					position++
 					if !_rules[ruleApostrophe]() {
-						goto l684
+						goto l1088
 					}
 					if buffer[position] != rune('t') {
-						goto l684
+						goto l1088
 					}
 					position++
-					goto l664
-				l684:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1088:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('z') {
-						goto l685
+						goto l1089
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l685
+						goto l1089
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l685
+						goto l1089
 					}
 					position++
-					goto l664
-				l685:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1089:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('z') {
-						goto l686
+						goto l1090
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l686
+						goto l1090
 					}
 					position++
-					goto l664
-				l686:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1068
+				l1090:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('v') {
-						goto l687
+						goto l1091
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l687
+						goto l1091
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l687
+						goto l1091
 					}
 					position++
 					{
-						position688, tokenIndex688 := position, tokenIndex
+						position1092, tokenIndex1092 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l688
+							goto l1092
 						}
 						{
-							position690, tokenIndex690 := position, tokenIndex
+							position1094, tokenIndex1094 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l691
+								goto l1095
 							}
 							position++
 							if buffer[position] != rune('.') {
-								goto l691
+								goto l1095
 							}
 							position++
-							goto l690
-						l691:
-							position, tokenIndex = position690, tokenIndex690
+							goto l1094
+						l1095:
+							position, tokenIndex = position1094, tokenIndex1094
 							if buffer[position] != rune('d') {
-								goto l688
+								goto l1092
 							}
 							position++
 							if buffer[position] != rune('e') {
-								goto l688
+								goto l1092
 							}
 							position++
 							if buffer[position] != rune('m') {
-								goto l688
+								goto l1092
 							}
 							position++
 						}
-					l690:
-						goto l689
-					l688:
-						position, tokenIndex = position688, tokenIndex688
+					l1094:
+						goto l1093
+					l1092:
+						position, tokenIndex = position1092, tokenIndex1092
 					}
-				l689:
-					goto l664
-				l687:
-					position, tokenIndex = position664, tokenIndex664
+				l1093:
+					goto l1068
+				l1091:
+					position, tokenIndex = position1068, tokenIndex1068
 					if buffer[position] != rune('v') {
-						goto l662
+						goto l1066
 					}
 					position++
 					{
-						position692, tokenIndex692 := position, tokenIndex
+						position1096, tokenIndex1096 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l692
+							goto l1096
 						}
 						if buffer[position] != rune('d') {
-							goto l692
+							goto l1096
 						}
 						position++
-						goto l693
-					l692:
-						position, tokenIndex = position692, tokenIndex692
+						goto l1097
+					l1096:
+						position, tokenIndex = position1096, tokenIndex1096
 					}
-				l693:
+				l1097:
 				}
-			l664:
+			l1068:
 				{
-					position694, tokenIndex694 := position, tokenIndex
+					position1098, tokenIndex1098 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l662
+						goto l1066
 					}
-					position, tokenIndex = position694, tokenIndex694
+					position, tokenIndex = position1098, tokenIndex1098
 				}
-				add(ruleAuthorPrefix1, position663)
+				add(ruleAuthorPrefix1, position1067)
 			}
 			return true
-		l662:
-			position, tokenIndex = position662, tokenIndex662
+		l1066:
+			position, tokenIndex = position1066, tokenIndex1066
 			return false
 		},
-		/* 91 AuthorUpperChar <- <(UpperASCII / MiscodedChar / ('À' / 'Á' / 'Â' / 'Ã' / 'Ä' / 'Å' / 'Æ' / 'Ç' / 'È' / 'É' / 'Ê' / 'Ë' / 'Ì' / 'Í' / 'Î' / 'Ï' / 'Ð' / 'Ñ' / 'Ò' / 'Ó' / 'Ô' / 'Õ' / 'Ö' / 'Ø' / 'Ù' / 'Ú' / 'Û' / 'Ü' / 'Ý' / 'Ć' / 'Č' / 'Ď' / 'İ' / 'Ķ' / 'Ĺ' / 'ĺ' / 'Ľ' / 'ľ' / 'Ł' / 'ł' / 'Ņ' / 'Ō' / 'Ő' / 'Œ' / 'Ř' / 'Ś' / 'Ŝ' / 'Ş' / 'Š' / 'Ÿ' / 'Ź' / 'Ż' / 'Ž' / 'ƒ' / 'Ǿ' / 'Ș' / 'Ț'))> */
+		/* 135 AuthorUpperChar <- <(UpperASCII / MiscodedChar / ('À' / 'Á' / 'Â' / 'Ã' / 'Ä' / 'Å' / 'Æ' / 'Ç' / 'È' / 'É' / 'Ê' / 'Ë' / 'Ì' / 'Í' / 'Î' / 'Ï' / 'Ð' / 'Ñ' / 'Ò' / 'Ó' / 'Ô' / 'Õ' / 'Ö' / 'Ø' / 'Ù' / 'Ú' / 'Û' / 'Ü' / 'Ý' / 'Ć' / 'Č' / 'Ď' / 'İ' / 'Ķ' / 'Ĺ' / 'ĺ' / 'Ľ' / 'ľ' / 'Ł' / 'ł' / 'Ņ' / 'Ō' / 'Ő' / 'Œ' / 'Ř' / 'Ś' / 'Ŝ' / 'Ş' / 'Š' / 'Ÿ' / 'Ź' / 'Ż' / 'Ž' / 'ƒ' / 'Ǿ' / 'Ș' / 'Ț'))> */
 		func() bool {
-			position695, tokenIndex695 := position, tokenIndex
+			position1099, tokenIndex1099 := position, tokenIndex
 			{
-				position696 := position
+				position1100 := position
 				{
-					position697, tokenIndex697 := position, tokenIndex
+					position1101, tokenIndex1101 := position, tokenIndex
 					if !_rules[ruleUpperASCII]() {
-						goto l698
+						goto l1102
 					}
-					goto l697
-				l698:
-					position, tokenIndex = position697, tokenIndex697
+					goto l1101
+				l1102:
+					position, tokenIndex = position1101, tokenIndex1101
 					if !_rules[ruleMiscodedChar]() {
-						goto l699
+						goto l1103
 					}
-					goto l697
-				l699:
-					position, tokenIndex = position697, tokenIndex697
+					goto l1101
+				l1103:
+					position, tokenIndex = position1101, tokenIndex1101
 					{
-						position700, tokenIndex700 := position, tokenIndex
+						position1104, tokenIndex1104 := position, tokenIndex
 						if buffer[position] != rune('À') {
-							goto l701
+							goto l1105
 						}
 						position++
-						goto l700
-					l701:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1105:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Á') {
-							goto l702
+							goto l1106
 						}
 						position++
-						goto l700
-					l702:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1106:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Â') {
-							goto l703
+							goto l1107
 						}
 						position++
-						goto l700
-					l703:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1107:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ã') {
-							goto l704
+							goto l1108
 						}
 						position++
-						goto l700
-					l704:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1108:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ä') {
-							goto l705
+							goto l1109
 						}
 						position++
-						goto l700
-					l705:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1109:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Å') {
-							goto l706
+							goto l1110
 						}
 						position++
-						goto l700
-					l706:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1110:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Æ') {
-							goto l707
+							goto l1111
 						}
 						position++
-						goto l700
-					l707:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1111:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ç') {
-							goto l708
+							goto l1112
 						}
 						position++
-						goto l700
-					l708:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1112:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('È') {
-							goto l709
+							goto l1113
 						}
 						position++
-						goto l700
-					l709:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1113:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('É') {
-							goto l710
+							goto l1114
 						}
 						position++
-						goto l700
-					l710:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1114:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ê') {
-							goto l711
+							goto l1115
 						}
 						position++
-						goto l700
-					l711:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1115:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ë') {
-							goto l712
+							goto l1116
 						}
 						position++
-						goto l700
-					l712:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1116:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ì') {
-							goto l713
+							goto l1117
 						}
 						position++
-						goto l700
-					l713:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1117:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Í') {
-							goto l714
+							goto l1118
 						}
 						position++
-						goto l700
-					l714:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1118:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Î') {
-							goto l715
+							goto l1119
 						}
 						position++
-						goto l700
-					l715:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1119:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ï') {
-							goto l716
+							goto l1120
 						}
 						position++
-						goto l700
-					l716:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1120:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ð') {
-							goto l717
+							goto l1121
 						}
 						position++
-						goto l700
-					l717:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1121:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ñ') {
-							goto l718
+							goto l1122
 						}
 						position++
-						goto l700
-					l718:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1122:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ò') {
-							goto l719
+							goto l1123
 						}
 						position++
-						goto l700
-					l719:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1123:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ó') {
-							goto l720
+							goto l1124
 						}
 						position++
-						goto l700
-					l720:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1124:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ô') {
-							goto l721
+							goto l1125
 						}
 						position++
-						goto l700
-					l721:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1125:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Õ') {
-							goto l722
+							goto l1126
 						}
 						position++
-						goto l700
-					l722:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1126:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ö') {
-							goto l723
+							goto l1127
 						}
 						position++
-						goto l700
-					l723:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1127:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ø') {
-							goto l724
+							goto l1128
 						}
 						position++
-						goto l700
-					l724:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1128:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ù') {
-							goto l725
+							goto l1129
 						}
 						position++
-						goto l700
-					l725:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1129:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ú') {
-							goto l726
+							goto l1130
 						}
 						position++
-						goto l700
-					l726:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1130:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Û') {
-							goto l727
+							goto l1131
 						}
 						position++
-						goto l700
-					l727:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1131:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ü') {
-							goto l728
+							goto l1132
 						}
 						position++
-						goto l700
-					l728:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1132:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ý') {
-							goto l729
+							goto l1133
 						}
 						position++
-						goto l700
-					l729:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1133:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ć') {
-							goto l730
+							goto l1134
 						}
 						position++
-						goto l700
-					l730:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1134:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Č') {
-							goto l731
+							goto l1135
 						}
 						position++
-						goto l700
-					l731:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1135:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ď') {
-							goto l732
+							goto l1136
 						}
 						position++
-						goto l700
-					l732:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1136:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('İ') {
-							goto l733
+							goto l1137
 						}
 						position++
-						goto l700
-					l733:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1137:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ķ') {
-							goto l734
+							goto l1138
 						}
 						position++
-						goto l700
-					l734:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1138:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ĺ') {
-							goto l735
+							goto l1139
 						}
 						position++
-						goto l700
-					l735:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1139:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('ĺ') {
-							goto l736
+							goto l1140
 						}
 						position++
-						goto l700
-					l736:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1140:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ľ') {
-							goto l737
+							goto l1141
 						}
 						position++
-						goto l700
-					l737:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1141:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('ľ') {
-							goto l738
+							goto l1142
 						}
 						position++
-						goto l700
-					l738:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1142:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ł') {
-							goto l739
+							goto l1143
 						}
 						position++
-						goto l700
-					l739:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1143:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('ł') {
-							goto l740
+							goto l1144
 						}
 						position++
-						goto l700
-					l740:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1144:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ņ') {
-							goto l741
+							goto l1145
 						}
 						position++
-						goto l700
-					l741:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1145:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ō') {
-							goto l742
+							goto l1146
 						}
 						position++
-						goto l700
-					l742:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1146:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ő') {
-							goto l743
+							goto l1147
 						}
 						position++
-						goto l700
-					l743:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1147:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Œ') {
-							goto l744
+							goto l1148
 						}
 						position++
-						goto l700
-					l744:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1148:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ř') {
-							goto l745
+							goto l1149
 						}
 						position++
-						goto l700
-					l745:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1149:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ś') {
-							goto l746
+							goto l1150
 						}
 						position++
-						goto l700
-					l746:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1150:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ŝ') {
-							goto l747
+							goto l1151
 						}
 						position++
-						goto l700
-					l747:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1151:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ş') {
-							goto l748
+							goto l1152
 						}
 						position++
-						goto l700
-					l748:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1152:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Š') {
-							goto l749
+							goto l1153
 						}
 						position++
-						goto l700
-					l749:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1153:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ÿ') {
-							goto l750
+							goto l1154
 						}
 						position++
-						goto l700
-					l750:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1154:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ź') {
-							goto l751
+							goto l1155
 						}
 						position++
-						goto l700
-					l751:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1155:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ż') {
-							goto l752
+							goto l1156
 						}
 						position++
-						goto l700
-					l752:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1156:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ž') {
-							goto l753
+							goto l1157
 						}
 						position++
-						goto l700
-					l753:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1157:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('ƒ') {
-							goto l754
+							goto l1158
 						}
 						position++
-						goto l700
-					l754:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1158:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ǿ') {
-							goto l755
+							goto l1159
 						}
 						position++
-						goto l700
-					l755:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1159:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ș') {
-							goto l756
+							goto l1160
 						}
 						position++
-						goto l700
-					l756:
-						position, tokenIndex = position700, tokenIndex700
+						goto l1104
+					l1160:
+						position, tokenIndex = position1104, tokenIndex1104
 						if buffer[position] != rune('Ț') {
-							goto l695
+							goto l1099
 						}
 						position++
 					}
-				l700:
+				l1104:
 				}
-			l697:
-				add(ruleAuthorUpperChar, position696)
+			l1101:
+				add(ruleAuthorUpperChar, position1100)
 			}
 			return true
-		l695:
-			position, tokenIndex = position695, tokenIndex695
+		l1099:
+			position, tokenIndex = position1099, tokenIndex1099
 			return false
 		},
-		/* 92 AuthorLowerChar <- <(LowerASCII / MiscodedChar / ('à' / 'á' / 'â' / 'ã' / 'ä' / 'å' / 'æ' / 'ç' / 'è' / 'é' / 'ê' / 'ë' / 'ì' / 'í' / 'î' / 'ï' / 'ð' / 'ñ' / 'ò' / 'ó' / 'ó' / 'ô' / 'õ' / 'ö' / 'ø' / 'ù' / 'ú' / 'û' / 'ü' / 'ý' / 'ÿ' / 'ā' / 'ă' / 'ą' / 'ć' / 'ĉ' / 'č' / 'ď' / 'đ' / '\'' / 'ē' / 'ĕ' / 'ė' / 'ę' / 'ě' / 'ğ' / 'ī' / 'ĭ' / 'İ' / 'ı' / 'ĺ' / 'ľ' / 'ł' / 'ń' / 'ņ' / 'ň' / 'ŏ' / 'ő' / 'œ' / 'ŕ' / 'ř' / 'ś' / 'ş' / 'š' / 'ţ' / 'ť' / 'ũ' / 'ū' / 'ŭ' / 'ů' / 'ű' / 'ź' / 'ż' / 'ž' / 'ſ' / 'ǎ' / 'ǔ' / 'ǧ' / 'ș' / 'ț' / 'ȳ' / 'ß'))> */
+		/* 136 AuthorLowerChar <- <(LowerASCII / MiscodedChar / ('à' / 'á' / 'â' / 'ã' / 'ä' / 'å' / 'æ' / 'ç' / 'è' / 'é' / 'ê' / 'ë' / 'ì' / 'í' / 'î' / 'ï' / 'ð' / 'ñ' / 'ò' / 'ó' / 'ó' / 'ô' / 'õ' / 'ö' / 'ø' / 'ù' / 'ú' / 'û' / 'ü' / 'ý' / 'ÿ' / 'ā' / 'ă' / 'ą' / 'ć' / 'ĉ' / 'č' / 'ď' / 'đ' / '\'' / 'ē' / 'ĕ' / 'ė' / 'ę' / 'ě' / 'ğ' / 'ī' / 'ĭ' / 'İ' / 'ı' / 'ĺ' / 'ľ' / 'ł' / 'ń' / 'ņ' / 'ň' / 'ŏ' / 'ő' / 'œ' / 'ŕ' / 'ř' / 'ś' / 'ş' / 'š' / 'ţ' / 'ť' / 'ũ' / 'ū' / 'ŭ' / 'ů' / 'ű' / 'ź' / 'ż' / 'ž' / 'ſ' / 'ǎ' / 'ǔ' / 'ǧ' / 'ș' / 'ț' / 'ȳ' / 'ß'))> */
 		func() bool {
-			position757, tokenIndex757 := position, tokenIndex
+			position1161, tokenIndex1161 := position, tokenIndex
 			{
-				position758 := position
+				position1162 := position
 				{
-					position759, tokenIndex759 := position, tokenIndex
+					position1163, tokenIndex1163 := position, tokenIndex
 					if !_rules[ruleLowerASCII]() {
-						goto l760
+						goto l1164
 					}
-					goto l759
-				l760:
-					position, tokenIndex = position759, tokenIndex759
+					goto l1163
+				l1164:
+					position, tokenIndex = position1163, tokenIndex1163
 					if !_rules[ruleMiscodedChar]() {
-						goto l761
+						goto l1165
 					}
-					goto l759
-				l761:
-					position, tokenIndex = position759, tokenIndex759
+					goto l1163
+				l1165:
+					position, tokenIndex = position1163, tokenIndex1163
 					{
-						position762, tokenIndex762 := position, tokenIndex
+						position1166, tokenIndex1166 := position, tokenIndex
 						if buffer[position] != rune('à') {
-							goto l763
+							goto l1167
 						}
 						position++
-						goto l762
-					l763:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1167:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('á') {
-							goto l764
+							goto l1168
 						}
 						position++
-						goto l762
-					l764:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1168:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('â') {
-							goto l765
+							goto l1169
 						}
 						position++
-						goto l762
-					l765:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1169:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ã') {
-							goto l766
+							goto l1170
 						}
 						position++
-						goto l762
-					l766:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1170:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ä') {
-							goto l767
+							goto l1171
 						}
 						position++
-						goto l762
-					l767:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1171:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('å') {
-							goto l768
+							goto l1172
 						}
 						position++
-						goto l762
-					l768:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1172:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('æ') {
-							goto l769
+							goto l1173
 						}
 						position++
-						goto l762
-					l769:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1173:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ç') {
-							goto l770
+							goto l1174
 						}
 						position++
-						goto l762
-					l770:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1174:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('è') {
-							goto l771
+							goto l1175
 						}
 						position++
-						goto l762
-					l771:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1175:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('é') {
-							goto l772
+							goto l1176
 						}
 						position++
-						goto l762
-					l772:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1176:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ê') {
-							goto l773
+							goto l1177
 						}
 						position++
-						goto l762
-					l773:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1177:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ë') {
-							goto l774
+							goto l1178
 						}
 						position++
-						goto l762
-					l774:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1178:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ì') {
-							goto l775
+							goto l1179
 						}
 						position++
-						goto l762
-					l775:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1179:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('í') {
-							goto l776
+							goto l1180
 						}
 						position++
-						goto l762
-					l776:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1180:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('î') {
-							goto l777
+							goto l1181
 						}
 						position++
-						goto l762
-					l777:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1181:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ï') {
-							goto l778
+							goto l1182
 						}
 						position++
-						goto l762
-					l778:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1182:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ð') {
-							goto l779
+							goto l1183
 						}
 						position++
-						goto l762
-					l779:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1183:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ñ') {
-							goto l780
+							goto l1184
 						}
 						position++
-						goto l762
-					l780:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1184:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ò') {
-							goto l781
+							goto l1185
 						}
 						position++
-						goto l762
-					l781:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1185:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ó') {
-							goto l782
+							goto l1186
 						}
 						position++
-						goto l762
-					l782:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1186:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ó') {
-							goto l783
+							goto l1187
 						}
 						position++
-						goto l762
-					l783:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1187:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ô') {
-							goto l784
+							goto l1188
 						}
 						position++
-						goto l762
-					l784:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1188:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('õ') {
-							goto l785
+							goto l1189
 						}
 						position++
-						goto l762
-					l785:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1189:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ö') {
-							goto l786
+							goto l1190
 						}
 						position++
-						goto l762
-					l786:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1190:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ø') {
-							goto l787
+							goto l1191
 						}
 						position++
-						goto l762
-					l787:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1191:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ù') {
-							goto l788
+							goto l1192
 						}
 						position++
-						goto l762
-					l788:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1192:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ú') {
-							goto l789
+							goto l1193
 						}
 						position++
-						goto l762
-					l789:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1193:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('û') {
-							goto l790
+							goto l1194
 						}
 						position++
-						goto l762
-					l790:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1194:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ü') {
-							goto l791
+							goto l1195
 						}
 						position++
-						goto l762
-					l791:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1195:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ý') {
-							goto l792
+							goto l1196
 						}
 						position++
-						goto l762
-					l792:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1196:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ÿ') {
-							goto l793
+							goto l1197
 						}
 						position++
-						goto l762
-					l793:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1197:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ā') {
-							goto l794
+							goto l1198
 						}
 						position++
-						goto l762
-					l794:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1198:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ă') {
-							goto l795
+							goto l1199
 						}
 						position++
-						goto l762
-					l795:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1199:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ą') {
-							goto l796
+							goto l1200
 						}
 						position++
-						goto l762
-					l796:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1200:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ć') {
-							goto l797
+							goto l1201
 						}
 						position++
-						goto l762
-					l797:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1201:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ĉ') {
-							goto l798
+							goto l1202
 						}
 						position++
-						goto l762
-					l798:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1202:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('č') {
-							goto l799
+							goto l1203
 						}
 						position++
-						goto l762
-					l799:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1203:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ď') {
-							goto l800
+							goto l1204
 						}
 						position++
-						goto l762
-					l800:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1204:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('đ') {
-							goto l801
+							goto l1205
 						}
 						position++
-						goto l762
-					l801:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1205:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('\'') {
-							goto l802
+							goto l1206
 						}
 						position++
-						goto l762
-					l802:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1206:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ē') {
-							goto l803
+							goto l1207
 						}
 						position++
-						goto l762
-					l803:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1207:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ĕ') {
-							goto l804
+							goto l1208
 						}
 						position++
-						goto l762
-					l804:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1208:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ė') {
-							goto l805
+							goto l1209
 						}
 						position++
-						goto l762
-					l805:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1209:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ę') {
-							goto l806
+							goto l1210
 						}
 						position++
-						goto l762
-					l806:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1210:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ě') {
-							goto l807
+							goto l1211
 						}
 						position++
-						goto l762
-					l807:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1211:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ğ') {
-							goto l808
+							goto l1212
 						}
 						position++
-						goto l762
-					l808:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1212:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ī') {
-							goto l809
+							goto l1213
 						}
 						position++
-						goto l762
-					l809:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1213:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ĭ') {
-							goto l810
+							goto l1214
 						}
 						position++
-						goto l762
-					l810:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1214:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('İ') {
-							goto l811
+							goto l1215
 						}
 						position++
-						goto l762
-					l811:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1215:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ı') {
-							goto l812
+							goto l1216
 						}
 						position++
-						goto l762
-					l812:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1216:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ĺ') {
-							goto l813
+							goto l1217
 						}
 						position++
-						goto l762
-					l813:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1217:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ľ') {
-							goto l814
+							goto l1218
 						}
 						position++
-						goto l762
-					l814:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1218:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ł') {
-							goto l815
+							goto l1219
 						}
 						position++
-						goto l762
-					l815:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1219:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ń') {
-							goto l816
+							goto l1220
 						}
 						position++
-						goto l762
-					l816:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1220:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ņ') {
-							goto l817
+							goto l1221
 						}
 						position++
-						goto l762
-					l817:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1221:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ň') {
-							goto l818
+							goto l1222
 						}
 						position++
-						goto l762
-					l818:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1222:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ŏ') {
-							goto l819
+							goto l1223
 						}
 						position++
-						goto l762
-					l819:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1223:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ő') {
-							goto l820
+							goto l1224
 						}
 						position++
-						goto l762
-					l820:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1224:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('œ') {
-							goto l821
+							goto l1225
 						}
 						position++
-						goto l762
-					l821:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1225:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ŕ') {
-							goto l822
+							goto l1226
 						}
 						position++
-						goto l762
-					l822:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1226:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ř') {
-							goto l823
+							goto l1227
 						}
 						position++
-						goto l762
-					l823:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1227:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ś') {
-							goto l824
+							goto l1228
 						}
 						position++
-						goto l762
-					l824:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1228:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ş') {
-							goto l825
+							goto l1229
 						}
 						position++
-						goto l762
-					l825:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1229:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('š') {
-							goto l826
+							goto l1230
 						}
 						position++
-						goto l762
-					l826:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1230:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ţ') {
-							goto l827
+							goto l1231
 						}
 						position++
-						goto l762
-					l827:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1231:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ť') {
-							goto l828
+							goto l1232
 						}
 						position++
-						goto l762
-					l828:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1232:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ũ') {
-							goto l829
+							goto l1233
 						}
 						position++
-						goto l762
-					l829:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1233:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ū') {
-							goto l830
+							goto l1234
 						}
 						position++
-						goto l762
-					l830:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1234:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ŭ') {
-							goto l831
+							goto l1235
 						}
 						position++
-						goto l762
-					l831:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1235:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ů') {
-							goto l832
+							goto l1236
 						}
 						position++
-						goto l762
-					l832:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1236:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ű') {
-							goto l833
+							goto l1237
 						}
 						position++
-						goto l762
-					l833:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1237:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ź') {
-							goto l834
+							goto l1238
 						}
 						position++
-						goto l762
-					l834:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1238:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ż') {
-							goto l835
+							goto l1239
 						}
 						position++
-						goto l762
-					l835:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1239:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ž') {
-							goto l836
+							goto l1240
 						}
 						position++
-						goto l762
-					l836:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1240:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ſ') {
-							goto l837
+							goto l1241
 						}
 						position++
-						goto l762
-					l837:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1241:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ǎ') {
-							goto l838
+							goto l1242
 						}
 						position++
-						goto l762
-					l838:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1242:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ǔ') {
-							goto l839
+							goto l1243
 						}
 						position++
-						goto l762
-					l839:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1243:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ǧ') {
-							goto l840
+							goto l1244
 						}
 						position++
-						goto l762
-					l840:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1244:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ș') {
-							goto l841
+							goto l1245
 						}
 						position++
-						goto l762
-					l841:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1245:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ț') {
-							goto l842
+							goto l1246
 						}
 						position++
-						goto l762
-					l842:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1246:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ȳ') {
-							goto l843
+							goto l1247
 						}
 						position++
-						goto l762
-					l843:
-						position, tokenIndex = position762, tokenIndex762
+						goto l1166
+					l1247:
+						position, tokenIndex = position1166, tokenIndex1166
 						if buffer[position] != rune('ß') {
-							goto l757
+							goto l1161
 						}
 						position++
 					}
-				l762:
+				l1166:
 				}
-			l759:
-				add(ruleAuthorLowerChar, position758)
+			l1163:
+				add(ruleAuthorLowerChar, position1162)
 			}
 			return true
-		l757:
-			position, tokenIndex = position757, tokenIndex757
+		l1161:
+			position, tokenIndex = position1161, tokenIndex1161
 			return false
 		},
-		/* 93 Year <- <(YearRange / YearApprox / YearWithParens / YearWithPage / YearWithDot / YearWithChar / YearNum)> */
+		/* 137 Year <- <(YearRange / YearApprox / YearWithParens / YearWithPage / YearWithDot / YearWithChar / YearNum)> */
 		func() bool {
-			position844, tokenIndex844 := position, tokenIndex
+			position1248, tokenIndex1248 := position, tokenIndex
 			{
-				position845 := position
+				position1249 := position
 				{
-					position846, tokenIndex846 := position, tokenIndex
+					position1250, tokenIndex1250 := position, tokenIndex
 					if !_rules[ruleYearRange]() {
-						goto l847
+						goto l1251
 					}
-					goto l846
-				l847:
-					position, tokenIndex = position846, tokenIndex846
+					goto l1250
+				l1251:
+					position, tokenIndex = position1250, tokenIndex1250
 					if !_rules[ruleYearApprox]() {
-						goto l848
+						goto l1252
 					}
-					goto l846
-				l848:
-					position, tokenIndex = position846, tokenIndex846
+					goto l1250
+				l1252:
+					position, tokenIndex = position1250, tokenIndex1250
 					if !_rules[ruleYearWithParens]() {
-						goto l849
+						goto l1253
 					}
-					goto l846
-				l849:
-					position, tokenIndex = position846, tokenIndex846
+					goto l1250
+				l1253:
+					position, tokenIndex = position1250, tokenIndex1250
 					if !_rules[ruleYearWithPage]() {
-						goto l850
+						goto l1254
 					}
-					goto l846
-				l850:
-					position, tokenIndex = position846, tokenIndex846
+					goto l1250
+				l1254:
+					position, tokenIndex = position1250, tokenIndex1250
 					if !_rules[ruleYearWithDot]() {
-						goto l851
+						goto l1255
 					}
-					goto l846
-				l851:
-					position, tokenIndex = position846, tokenIndex846
+					goto l1250
+				l1255:
+					position, tokenIndex = position1250, tokenIndex1250
 					if !_rules[ruleYearWithChar]() {
-						goto l852
+						goto l1256
 					}
-					goto l846
-				l852:
-					position, tokenIndex = position846, tokenIndex846
+					goto l1250
+				l1256:
+					position, tokenIndex = position1250, tokenIndex1250
 					if !_rules[ruleYearNum]() {
-						goto l844
+						goto l1248
 					}
 				}
-			l846:
-				add(ruleYear, position845)
+			l1250:
+				add(ruleYear, position1249)
 			}
 			return true
-		l844:
-			position, tokenIndex = position844, tokenIndex844
+		l1248:
+			position, tokenIndex = position1248, tokenIndex1248
 			return false
 		},
-		/* 94 YearRange <- <(YearNum (Dash / Slash) (Nums+ ('a' / 'b' / 'c' / 'd' / 'e' / 'f' / 'g' / 'h' / 'i' / 'j' / 'k' / 'l' / 'm' / 'n' / 'o' / 'p' / 'q' / 'r' / 's' / 't' / 'u' / 'v' / 'w' / 'x' / 'y' / 'z' / '?')*))> */
+		/* 138 YearRange <- <(YearNum (Dash / Slash) (Nums+ ('a' / 'b' / 'c' / 'd' / 'e' / 'f' / 'g' / 'h' / 'i' / 'j' / 'k' / 'l' / 'm' / 'n' / 'o' / 'p' / 'q' / 'r' / 's' / 't' / 'u' / 'v' / 'w' / 'x' / 'y' / 'z' / '?')*))> */
 		func() bool {
-			position853, tokenIndex853 := position, tokenIndex
+			position1257, tokenIndex1257 := position, tokenIndex
 			{
-				position854 := position
+				position1258 := position
 				if !_rules[ruleYearNum]() {
-					goto l853
+					goto l1257
 				}
 				{
-					position855, tokenIndex855 := position, tokenIndex
+					position1259, tokenIndex1259 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l856
+						goto l1260
 					}
-					goto l855
-				l856:
-					position, tokenIndex = position855, tokenIndex855
+					goto l1259
+				l1260:
+					position, tokenIndex = position1259, tokenIndex1259
 					if !_rules[ruleSlash]() {
-						goto l853
+						goto l1257
 					}
 				}
-			l855:
+			l1259:
 				if !_rules[ruleNums]() {
-					goto l853
+					goto l1257
 				}
-			l857:
+			l1261:
 				{
-					position858, tokenIndex858 := position, tokenIndex
+					position1262, tokenIndex1262 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l858
+						goto l1262
 					}
-					goto l857
-				l858:
-					position, tokenIndex = position858, tokenIndex858
+					goto l1261
+				l1262:
+					position, tokenIndex = position1262, tokenIndex1262
 				}
-			l859:
+			l1263:
 				{
-					position860, tokenIndex860 := position, tokenIndex
+					position1264, tokenIndex1264 := position, tokenIndex
 					{
-						position861, tokenIndex861 := position, tokenIndex
+						position1265, tokenIndex1265 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l862
+							goto l1266
 						}
 						position++
-						goto l861
-					l862:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1266:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('b') {
-							goto l863
+							goto l1267
 						}
 						position++
-						goto l861
-					l863:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1267:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('c') {
-							goto l864
+							goto l1268
 						}
 						position++
-						goto l861
-					l864:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1268:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('d') {
-							goto l865
+							goto l1269
 						}
 						position++
-						goto l861
-					l865:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1269:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('e') {
-							goto l866
+							goto l1270
 						}
 						position++
-						goto l861
-					l866:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1270:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('f') {
-							goto l867
+							goto l1271
 						}
 						position++
-						goto l861
-					l867:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1271:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('g') {
-							goto l868
+							goto l1272
 						}
 						position++
-						goto l861
-					l868:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1272:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('h') {
-							goto l869
+							goto l1273
 						}
 						position++
-						goto l861
-					l869:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1273:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('i') {
-							goto l870
+							goto l1274
 						}
 						position++
-						goto l861
-					l870:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1274:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('j') {
-							goto l871
+							goto l1275
 						}
 						position++
-						goto l861
-					l871:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1275:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('k') {
-							goto l872
+							goto l1276
 						}
 						position++
-						goto l861
-					l872:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1276:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('l') {
-							goto l873
+							goto l1277
 						}
 						position++
-						goto l861
-					l873:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1277:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('m') {
-							goto l874
+							goto l1278
 						}
 						position++
-						goto l861
-					l874:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1278:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('n') {
-							goto l875
+							goto l1279
 						}
 						position++
-						goto l861
-					l875:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1279:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('o') {
-							goto l876
+							goto l1280
 						}
 						position++
-						goto l861
-					l876:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1280:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('p') {
-							goto l877
+							goto l1281
 						}
 						position++
-						goto l861
-					l877:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1281:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('q') {
-							goto l878
+							goto l1282
 						}
 						position++
-						goto l861
-					l878:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1282:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('r') {
-							goto l879
+							goto l1283
 						}
 						position++
-						goto l861
-					l879:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1283:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('s') {
-							goto l880
+							goto l1284
 						}
 						position++
-						goto l861
-					l880:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1284:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('t') {
-							goto l881
+							goto l1285
 						}
 						position++
-						goto l861
-					l881:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1285:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('u') {
-							goto l882
+							goto l1286
 						}
 						position++
-						goto l861
-					l882:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1286:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('v') {
-							goto l883
+							goto l1287
 						}
 						position++
-						goto l861
-					l883:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1287:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('w') {
-							goto l884
+							goto l1288
 						}
 						position++
-						goto l861
-					l884:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1288:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('x') {
-							goto l885
+							goto l1289
 						}
 						position++
-						goto l861
-					l885:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1289:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('y') {
-							goto l886
+							goto l1290
 						}
 						position++
-						goto l861
-					l886:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1290:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('z') {
-							goto l887
+							goto l1291
 						}
 						position++
-						goto l861
-					l887:
-						position, tokenIndex = position861, tokenIndex861
+						goto l1265
+					l1291:
+						position, tokenIndex = position1265, tokenIndex1265
 						if buffer[position] != rune('?') {
-							goto l860
+							goto l1264
 						}
 						position++
 					}
-				l861:
-					goto l859
-				l860:
-					position, tokenIndex = position860, tokenIndex860
+				l1265:
+					goto l1263
+				l1264:
+					position, tokenIndex = position1264, tokenIndex1264
 				}
-				add(ruleYearRange, position854)
+				add(ruleYearRange, position1258)
 			}
 			return true
-		l853:
-			position, tokenIndex = position853, tokenIndex853
+		l1257:
+			position, tokenIndex = position1257, tokenIndex1257
 			return false
 		},
-		/* 95 YearWithDot <- <(YearNum '.')> */
+		/* 139 YearWithDot <- <(YearNum '.')> */
 		func() bool {
-			position888, tokenIndex888 := position, tokenIndex
+			position1292, tokenIndex1292 := position, tokenIndex
 			{
-				position889 := position
+				position1293 := position
 				if !_rules[ruleYearNum]() {
-					goto l888
+					goto l1292
 				}
 				if buffer[position] != rune('.') {
-					goto l888
+					goto l1292
 				}
 				position++
-				add(ruleYearWithDot, position889)
+				add(ruleYearWithDot, position1293)
 			}
 			return true
-		l888:
-			position, tokenIndex = position888, tokenIndex888
+		l1292:
+			position, tokenIndex = position1292, tokenIndex1292
 			return false
 		},
-		/* 96 YearApprox <- <('[' _? YearNum _? ']')> */
+		/* 140 YearApprox <- <('[' _? YearNum _? ']')> */
 		func() bool {
-			position890, tokenIndex890 := position, tokenIndex
+			position1294, tokenIndex1294 := position, tokenIndex
 			{
-				position891 := position
+				position1295 := position
 				if buffer[position] != rune('[') {
-					goto l890
+					goto l1294
 				}
 				position++
 				{
-					position892, tokenIndex892 := position, tokenIndex
+					position1296, tokenIndex1296 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l892
+						goto l1296
 					}
-					goto l893
-				l892:
-					position, tokenIndex = position892, tokenIndex892
+					goto l1297
+				l1296:
+					position, tokenIndex = position1296, tokenIndex1296
 				}
-			l893:
+			l1297:
 				if !_rules[ruleYearNum]() {
-					goto l890
+					goto l1294
 				}
 				{
-					position894, tokenIndex894 := position, tokenIndex
+					position1298, tokenIndex1298 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l894
+						goto l1298
 					}
-					goto l895
-				l894:
-					position, tokenIndex = position894, tokenIndex894
+					goto l1299
+				l1298:
+					position, tokenIndex = position1298, tokenIndex1298
 				}
-			l895:
+			l1299:
 				if buffer[position] != rune(']') {
-					goto l890
+					goto l1294
 				}
 				position++
-				add(ruleYearApprox, position891)
+				add(ruleYearApprox, position1295)
 			}
 			return true
-		l890:
-			position, tokenIndex = position890, tokenIndex890
+		l1294:
+			position, tokenIndex = position1294, tokenIndex1294
 			return false
 		},
-		/* 97 YearWithPage <- <((YearWithChar / YearNum) _? ':' _? Nums+)> */
+		/* 141 YearWithPage <- <((YearWithChar / YearNum) _? ':' _? Nums+)> */
 		func() bool {
-			position896, tokenIndex896 := position, tokenIndex
+			position1300, tokenIndex1300 := position, tokenIndex
 			{
-				position897 := position
+				position1301 := position
 				{
-					position898, tokenIndex898 := position, tokenIndex
+					position1302, tokenIndex1302 := position, tokenIndex
 					if !_rules[ruleYearWithChar]() {
-						goto l899
+						goto l1303
 					}
-					goto l898
-				l899:
-					position, tokenIndex = position898, tokenIndex898
+					goto l1302
+				l1303:
+					position, tokenIndex = position1302, tokenIndex1302
 					if !_rules[ruleYearNum]() {
-						goto l896
+						goto l1300
 					}
 				}
-			l898:
+			l1302:
 				{
-					position900, tokenIndex900 := position, tokenIndex
+					position1304, tokenIndex1304 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l900
+						goto l1304
 					}
-					goto l901
-				l900:
-					position, tokenIndex = position900, tokenIndex900
+					goto l1305
+				l1304:
+					position, tokenIndex = position1304, tokenIndex1304
 				}
-			l901:
+			l1305:
 				if buffer[position] != rune(':') {
-					goto l896
+					goto l1300
 				}
 				position++
 				{
-					position902, tokenIndex902 := position, tokenIndex
+					position1306, tokenIndex1306 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l902
+						goto l1306
 					}
-					goto l903
-				l902:
-					position, tokenIndex = position902, tokenIndex902
+					goto l1307
+				l1306:
+					position, tokenIndex = position1306, tokenIndex1306
 				}
-			l903:
+			l1307:
 				if !_rules[ruleNums]() {
-					goto l896
+					goto l1300
 				}
-			l904:
+			l1308:
 				{
-					position905, tokenIndex905 := position, tokenIndex
+					position1309, tokenIndex1309 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l905
+						goto l1309
 					}
-					goto l904
-				l905:
-					position, tokenIndex = position905, tokenIndex905
+					goto l1308
+				l1309:
+					position, tokenIndex = position1309, tokenIndex1309
 				}
-				add(ruleYearWithPage, position897)
+				add(ruleYearWithPage, position1301)
 			}
 			return true
-		l896:
-			position, tokenIndex = position896, tokenIndex896
+		l1300:
+			position, tokenIndex = position1300, tokenIndex1300
 			return false
 		},
-		/* 98 YearWithParens <- <('(' (YearWithChar / YearNum) ')')> */
+		/* 142 YearWithParens <- <('(' (YearWithChar / YearNum) ')')> */
 		func() bool {
-			position906, tokenIndex906 := position, tokenIndex
+			position1310, tokenIndex1310 := position, tokenIndex
 			{
-				position907 := position
+				position1311 := position
 				if buffer[position] != rune('(') {
-					goto l906
+					goto l1310
 				}
 				position++
 				{
-					position908, tokenIndex908 := position, tokenIndex
+					position1312, tokenIndex1312 := position, tokenIndex
 					if !_rules[ruleYearWithChar]() {
-						goto l909
+						goto l1313
 					}
-					goto l908
-				l909:
-					position, tokenIndex = position908, tokenIndex908
+					goto l1312
+				l1313:
+					position, tokenIndex = position1312, tokenIndex1312
 					if !_rules[ruleYearNum]() {
-						goto l906
+						goto l1310
 					}
 				}
-			l908:
+			l1312:
 				if buffer[position] != rune(')') {
-					goto l906
+					goto l1310
 				}
 				position++
-				add(ruleYearWithParens, position907)
+				add(ruleYearWithParens, position1311)
 			}
 			return true
-		l906:
-			position, tokenIndex = position906, tokenIndex906
+		l1310:
+			position, tokenIndex = position1310, tokenIndex1310
 			return false
 		},
-		/* 99 YearWithChar <- <(YearNum LowerASCII Action0)> */
+		/* 143 YearWithChar <- <(YearNum LowerASCII Action0)> */
 		func() bool {
-			position910, tokenIndex910 := position, tokenIndex
+			position1314, tokenIndex1314 := position, tokenIndex
 			{
-				position911 := position
+				position1315 := position
 				if !_rules[ruleYearNum]() {
-					goto l910
+					goto l1314
 				}
 				if !_rules[ruleLowerASCII]() {
-					goto l910
+					goto l1314
 				}
 				if !_rules[ruleAction0]() {
-					goto l910
+					goto l1314
 				}
-				add(ruleYearWithChar, position911)
+				add(ruleYearWithChar, position1315)
 			}
 			return true
-		l910:
-			position, tokenIndex = position910, tokenIndex910
+		l1314:
+			position, tokenIndex = position1314, tokenIndex1314
 			return false
 		},
-		/* 100 YearNum <- <(('1' / '2') ('0' / '7' / '8' / '9') Nums (Nums / '?') '?'*)> */
+		/* 144 YearNum <- <(('1' / '2') ('0' / '7' / '8' / '9') Nums (Nums / '?') '?'*)> */
 		func() bool {
-			position912, tokenIndex912 := position, tokenIndex
+			position1316, tokenIndex1316 := position, tokenIndex
 			{
-				position913 := position
+				position1317 := position
 				{
-					position914, tokenIndex914 := position, tokenIndex
+					position1318, tokenIndex1318 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l915
+						goto l1319
 					}
 					position++
-					goto l914
-				l915:
-					position, tokenIndex = position914, tokenIndex914
+					goto l1318
+				l1319:
+					position, tokenIndex = position1318, tokenIndex1318
 					if buffer[position] != rune('2') {
-						goto l912
+						goto l1316
 					}
 					position++
 				}
-			l914:
+			l1318:
 				{
-					position916, tokenIndex916 := position, tokenIndex
+					position1320, tokenIndex1320 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l917
+						goto l1321
 					}
 					position++
-					goto l916
-				l917:
-					position, tokenIndex = position916, tokenIndex916
+					goto l1320
+				l1321:
+					position, tokenIndex = position1320, tokenIndex1320
 					if buffer[position] != rune('7') {
-						goto l918
+						goto l1322
 					}
 					position++
-					goto l916
-				l918:
-					position, tokenIndex = position916, tokenIndex916
+					goto l1320
+				l1322:
+					position, tokenIndex = position1320, tokenIndex1320
 					if buffer[position] != rune('8') {
-						goto l919
+						goto l1323
 					}
 					position++
-					goto l916
-				l919:
-					position, tokenIndex = position916, tokenIndex916
+					goto l1320
+				l1323:
+					position, tokenIndex = position1320, tokenIndex1320
 					if buffer[position] != rune('9') {
-						goto l912
+						goto l1316
 					}
 					position++
 				}
-			l916:
+			l1320:
 				if !_rules[ruleNums]() {
-					goto l912
+					goto l1316
 				}
 				{
-					position920, tokenIndex920 := position, tokenIndex
+					position1324, tokenIndex1324 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l921
+						goto l1325
 					}
-					goto l920
-				l921:
-					position, tokenIndex = position920, tokenIndex920
+					goto l1324
+				l1325:
+					position, tokenIndex = position1324, tokenIndex1324
 					if buffer[position] != rune('?') {
-						goto l912
+						goto l1316
 					}
 					position++
 				}
-			l920:
-			l922:
+			l1324:
+			l1326:
 				{
-					position923, tokenIndex923 := position, tokenIndex
+					position1327, tokenIndex1327 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l923
+						goto l1327
 					}
 					position++
-					goto l922
-				l923:
-					position, tokenIndex = position923, tokenIndex923
+					goto l1326
+				l1327:
+					position, tokenIndex = position1327, tokenIndex1327
 				}
-				add(ruleYearNum, position913)
+				add(ruleYearNum, position1317)
 			}
 			return true
-		l912:
-			position, tokenIndex = position912, tokenIndex912
+		l1316:
+			position, tokenIndex = position1316, tokenIndex1316
 			return false
 		},
-		/* 101 NameUpperChar <- <(UpperChar / UpperCharExtended)> */
+		/* 145 NameUpperChar <- <(UpperChar / UpperCharExtended)> */
 		func() bool {
-			position924, tokenIndex924 := position, tokenIndex
+			position1328, tokenIndex1328 := position, tokenIndex
 			{
-				position925 := position
+				position1329 := position
 				{
-					position926, tokenIndex926 := position, tokenIndex
+					position1330, tokenIndex1330 := position, tokenIndex
 					if !_rules[ruleUpperChar]() {
-						goto l927
+						goto l1331
 					}
-					goto l926
-				l927:
-					position, tokenIndex = position926, tokenIndex926
+					goto l1330
+				l1331:
+					position, tokenIndex = position1330, tokenIndex1330
 					if !_rules[ruleUpperCharExtended]() {
-						goto l924
+						goto l1328
 					}
 				}
-			l926:
-				add(ruleNameUpperChar, position925)
+			l1330:
+				add(ruleNameUpperChar, position1329)
 			}
 			return true
-		l924:
-			position, tokenIndex = position924, tokenIndex924
+		l1328:
+			position, tokenIndex = position1328, tokenIndex1328
 			return false
 		},
-		/* 102 UpperCharExtended <- <('Æ' / 'Œ' / 'Ö')> */
+		/* 146 UpperCharExtended <- <('Æ' / 'Œ' / 'Ö')> */
 		func() bool {
-			position928, tokenIndex928 := position, tokenIndex
+			position1332, tokenIndex1332 := position, tokenIndex
 			{
-				position929 := position
+				position1333 := position
 				{
-					position930, tokenIndex930 := position, tokenIndex
+					position1334, tokenIndex1334 := position, tokenIndex
 					if buffer[position] != rune('Æ') {
-						goto l931
+						goto l1335
 					}
 					position++
-					goto l930
-				l931:
-					position, tokenIndex = position930, tokenIndex930
+					goto l1334
+				l1335:
+					position, tokenIndex = position1334, tokenIndex1334
 					if buffer[position] != rune('Œ') {
-						goto l932
+						goto l1336
 					}
 					position++
-					goto l930
-				l932:
-					position, tokenIndex = position930, tokenIndex930
+					goto l1334
+				l1336:
+					position, tokenIndex = position1334, tokenIndex1334
 					if buffer[position] != rune('Ö') {
-						goto l928
+						goto l1332
 					}
 					position++
 				}
-			l930:
-				add(ruleUpperCharExtended, position929)
+			l1334:
+				add(ruleUpperCharExtended, position1333)
 			}
 			return true
-		l928:
-			position, tokenIndex = position928, tokenIndex928
+		l1332:
+			position, tokenIndex = position1332, tokenIndex1332
 			return false
 		},
-		/* 103 UpperChar <- <UpperASCII> */
+		/* 147 UpperChar <- <UpperASCII> */
 		func() bool {
-			position933, tokenIndex933 := position, tokenIndex
+			position1337, tokenIndex1337 := position, tokenIndex
 			{
-				position934 := position
+				position1338 := position
 				if !_rules[ruleUpperASCII]() {
-					goto l933
+					goto l1337
 				}
-				add(ruleUpperChar, position934)
+				add(ruleUpperChar, position1338)
 			}
 			return true
-		l933:
-			position, tokenIndex = position933, tokenIndex933
+		l1337:
+			position, tokenIndex = position1337, tokenIndex1337
 			return false
 		},
-		/* 104 NameLowerChar <- <(LowerChar / LowerCharExtended / MiscodedChar)> */
+		/* 148 NameLowerChar <- <(LowerChar / LowerCharExtended / MiscodedChar)> */
 		func() bool {
-			position935, tokenIndex935 := position, tokenIndex
+			position1339, tokenIndex1339 := position, tokenIndex
 			{
-				position936 := position
+				position1340 := position
 				{
-					position937, tokenIndex937 := position, tokenIndex
+					position1341, tokenIndex1341 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l938
+						goto l1342
 					}
-					goto l937
-				l938:
-					position, tokenIndex = position937, tokenIndex937
+					goto l1341
+				l1342:
+					position, tokenIndex = position1341, tokenIndex1341
 					if !_rules[ruleLowerCharExtended]() {
-						goto l939
+						goto l1343
 					}
-					goto l937
-				l939:
-					position, tokenIndex = position937, tokenIndex937
+					goto l1341
+				l1343:
+					position, tokenIndex = position1341, tokenIndex1341
 					if !_rules[ruleMiscodedChar]() {
-						goto l935
+						goto l1339
 					}
 				}
-			l937:
-				add(ruleNameLowerChar, position936)
+			l1341:
+				add(ruleNameLowerChar, position1340)
 			}
 			return true
-		l935:
-			position, tokenIndex = position935, tokenIndex935
+		l1339:
+			position, tokenIndex = position1339, tokenIndex1339
 			return false
 		},
-		/* 105 MiscodedChar <- <'�'> */
+		/* 149 MiscodedChar <- <'�'> */
 		func() bool {
-			position940, tokenIndex940 := position, tokenIndex
+			position1344, tokenIndex1344 := position, tokenIndex
 			{
-				position941 := position
+				position1345 := position
 				if buffer[position] != rune('�') {
-					goto l940
+					goto l1344
 				}
 				position++
-				add(ruleMiscodedChar, position941)
+				add(ruleMiscodedChar, position1345)
 			}
 			return true
-		l940:
-			position, tokenIndex = position940, tokenIndex940
+		l1344:
+			position, tokenIndex = position1344, tokenIndex1344
 			return false
 		},
-		/* 106 LowerCharExtended <- <('æ' / 'œ' / 'à' / 'â' / 'å' / 'ã' / 'ä' / 'á' / 'ç' / 'č' / 'é' / 'è' / 'ë' / 'í' / 'ì' / 'ï' / 'ň' / 'ñ' / 'ñ' / 'ó' / 'ò' / 'ô' / 'ø' / 'õ' / 'ö' / 'ú' / 'ù' / 'ü' / 'ŕ' / 'ř' / 'ŗ' / 'ſ' / 'š' / 'š' / 'ş' / 'ß' / 'ž')> */
+		/* 150 LowerCharExtended <- <('æ' / 'œ' / 'à' / 'â' / 'å' / 'ã' / 'ä' / 'á' / 'ç' / 'č' / 'é' / 'è' / 'ë' / 'í' / 'ì' / 'ï' / 'ň' / 'ñ' / 'ñ' / 'ó' / 'ò' / 'ô' / 'ø' / 'õ' / 'ö' / 'ú' / 'ù' / 'ü' / 'ŕ' / 'ř' / 'ŗ' / 'ſ' / 'š' / 'š' / 'ş' / 'ß' / 'ž')> */
 		func() bool {
-			position942, tokenIndex942 := position, tokenIndex
+			position1346, tokenIndex1346 := position, tokenIndex
 			{
-				position943 := position
+				position1347 := position
 				{
-					position944, tokenIndex944 := position, tokenIndex
+					position1348, tokenIndex1348 := position, tokenIndex
 					if buffer[position] != rune('æ') {
-						goto l945
+						goto l1349
 					}
 					position++
-					goto l944
-				l945:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1349:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('œ') {
-						goto l946
+						goto l1350
 					}
 					position++
-					goto l944
-				l946:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1350:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('à') {
-						goto l947
+						goto l1351
 					}
 					position++
-					goto l944
-				l947:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1351:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('â') {
-						goto l948
+						goto l1352
 					}
 					position++
-					goto l944
-				l948:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1352:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('å') {
-						goto l949
+						goto l1353
 					}
 					position++
-					goto l944
-				l949:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1353:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ã') {
-						goto l950
+						goto l1354
 					}
 					position++
-					goto l944
-				l950:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1354:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ä') {
-						goto l951
+						goto l1355
 					}
 					position++
-					goto l944
-				l951:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1355:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('á') {
-						goto l952
+						goto l1356
 					}
 					position++
-					goto l944
-				l952:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1356:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ç') {
-						goto l953
+						goto l1357
 					}
 					position++
-					goto l944
-				l953:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1357:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('č') {
-						goto l954
+						goto l1358
 					}
 					position++
-					goto l944
-				l954:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1358:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('é') {
-						goto l955
+						goto l1359
 					}
 					position++
-					goto l944
-				l955:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1359:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('è') {
-						goto l956
+						goto l1360
 					}
 					position++
-					goto l944
-				l956:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1360:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ë') {
-						goto l957
+						goto l1361
 					}
 					position++
-					goto l944
-				l957:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1361:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('í') {
-						goto l958
+						goto l1362
 					}
 					position++
-					goto l944
-				l958:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1362:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ì') {
-						goto l959
+						goto l1363
 					}
 					position++
-					goto l944
-				l959:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1363:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ï') {
-						goto l960
+						goto l1364
 					}
 					position++
-					goto l944
-				l960:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1364:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ň') {
-						goto l961
+						goto l1365
 					}
 					position++
-					goto l944
-				l961:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1365:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ñ') {
-						goto l962
+						goto l1366
 					}
 					position++
-					goto l944
-				l962:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1366:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ñ') {
-						goto l963
+						goto l1367
 					}
 					position++
-					goto l944
-				l963:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1367:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ó') {
-						goto l964
+						goto l1368
 					}
 					position++
-					goto l944
-				l964:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1368:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ò') {
-						goto l965
+						goto l1369
 					}
 					position++
-					goto l944
-				l965:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1369:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ô') {
-						goto l966
+						goto l1370
 					}
 					position++
-					goto l944
-				l966:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1370:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ø') {
-						goto l967
+						goto l1371
 					}
 					position++
-					goto l944
-				l967:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1371:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('õ') {
-						goto l968
+						goto l1372
 					}
 					position++
-					goto l944
-				l968:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1372:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ö') {
-						goto l969
+						goto l1373
 					}
 					position++
-					goto l944
-				l969:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1373:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ú') {
-						goto l970
+						goto l1374
 					}
 					position++
-					goto l944
-				l970:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1374:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ù') {
-						goto l971
+						goto l1375
 					}
 					position++
-					goto l944
-				l971:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1375:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ü') {
-						goto l972
+						goto l1376
 					}
 					position++
-					goto l944
-				l972:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1376:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ŕ') {
-						goto l973
+						goto l1377
 					}
 					position++
-					goto l944
-				l973:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1377:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ř') {
-						goto l974
+						goto l1378
 					}
 					position++
-					goto l944
-				l974:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1378:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ŗ') {
-						goto l975
+						goto l1379
 					}
 					position++
-					goto l944
-				l975:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1379:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ſ') {
-						goto l976
+						goto l1380
 					}
 					position++
-					goto l944
-				l976:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1380:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('š') {
-						goto l977
+						goto l1381
 					}
 					position++
-					goto l944
-				l977:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1381:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('š') {
-						goto l978
+						goto l1382
 					}
 					position++
-					goto l944
-				l978:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1382:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ş') {
-						goto l979
+						goto l1383
 					}
 					position++
-					goto l944
-				l979:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1383:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ß') {
-						goto l980
+						goto l1384
 					}
 					position++
-					goto l944
-				l980:
-					position, tokenIndex = position944, tokenIndex944
+					goto l1348
+				l1384:
+					position, tokenIndex = position1348, tokenIndex1348
 					if buffer[position] != rune('ž') {
-						goto l942
+						goto l1346
 					}
 					position++
 				}
-			l944:
-				add(ruleLowerCharExtended, position943)
+			l1348:
+				add(ruleLowerCharExtended, position1347)
 			}
 			return true
-		l942:
-			position, tokenIndex = position942, tokenIndex942
+		l1346:
+			position, tokenIndex = position1346, tokenIndex1346
 			return false
 		},
-		/* 107 LowerChar <- <LowerASCII> */
+		/* 151 LowerChar <- <LowerASCII> */
 		func() bool {
-			position981, tokenIndex981 := position, tokenIndex
+			position1385, tokenIndex1385 := position, tokenIndex
 			{
-				position982 := position
+				position1386 := position
 				if !_rules[ruleLowerASCII]() {
-					goto l981
+					goto l1385
 				}
-				add(ruleLowerChar, position982)
+				add(ruleLowerChar, position1386)
 			}
 			return true
-		l981:
-			position, tokenIndex = position981, tokenIndex981
+		l1385:
+			position, tokenIndex = position1385, tokenIndex1385
 			return false
 		},
-		/* 108 SpaceCharEOI <- <(_ / !.)> */
+		/* 152 SpaceCharEOI <- <(_ / !.)> */
 		func() bool {
-			position983, tokenIndex983 := position, tokenIndex
+			position1387, tokenIndex1387 := position, tokenIndex
 			{
-				position984 := position
+				position1388 := position
 				{
-					position985, tokenIndex985 := position, tokenIndex
+					position1389, tokenIndex1389 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l986
+						goto l1390
 					}
-					goto l985
-				l986:
-					position, tokenIndex = position985, tokenIndex985
+					goto l1389
+				l1390:
+					position, tokenIndex = position1389, tokenIndex1389
 					{
-						position987, tokenIndex987 := position, tokenIndex
+						position1391, tokenIndex1391 := position, tokenIndex
 						if !matchDot() {
-							goto l987
+							goto l1391
 						}
-						goto l983
-					l987:
-						position, tokenIndex = position987, tokenIndex987
+						goto l1387
+					l1391:
+						position, tokenIndex = position1391, tokenIndex1391
 					}
 				}
-			l985:
-				add(ruleSpaceCharEOI, position984)
+			l1389:
+				add(ruleSpaceCharEOI, position1388)
 			}
 			return true
-		l983:
-			position, tokenIndex = position983, tokenIndex983
+		l1387:
+			position, tokenIndex = position1387, tokenIndex1387
 			return false
 		},
-		/* 109 Nums <- <[0-9]> */
+		/* 153 Nums <- <[0-9]> */
 		func() bool {
-			position988, tokenIndex988 := position, tokenIndex
+			position1392, tokenIndex1392 := position, tokenIndex
 			{
-				position989 := position
+				position1393 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l988
+					goto l1392
 				}
 				position++
-				add(ruleNums, position989)
+				add(ruleNums, position1393)
 			}
 			return true
-		l988:
-			position, tokenIndex = position988, tokenIndex988
+		l1392:
+			position, tokenIndex = position1392, tokenIndex1392
 			return false
 		},
-		/* 110 LowerGreek <- <[α-ω]> */
+		/* 154 LowerGreek <- <[α-ω]> */
 		func() bool {
-			position990, tokenIndex990 := position, tokenIndex
+			position1394, tokenIndex1394 := position, tokenIndex
 			{
-				position991 := position
+				position1395 := position
 				if c := buffer[position]; c < rune('α') || c > rune('ω') {
-					goto l990
+					goto l1394
 				}
 				position++
-				add(ruleLowerGreek, position991)
+				add(ruleLowerGreek, position1395)
 			}
 			return true
-		l990:
-			position, tokenIndex = position990, tokenIndex990
+		l1394:
+			position, tokenIndex = position1394, tokenIndex1394
 			return false
 		},
-		/* 111 LowerASCII <- <[a-z]> */
+		/* 155 LowerASCII <- <[a-z]> */
 		func() bool {
-			position992, tokenIndex992 := position, tokenIndex
+			position1396, tokenIndex1396 := position, tokenIndex
 			{
-				position993 := position
+				position1397 := position
 				if c := buffer[position]; c < rune('a') || c > rune('z') {
-					goto l992
+					goto l1396
 				}
 				position++
-				add(ruleLowerASCII, position993)
+				add(ruleLowerASCII, position1397)
 			}
 			return true
-		l992:
-			position, tokenIndex = position992, tokenIndex992
+		l1396:
+			position, tokenIndex = position1396, tokenIndex1396
 			return false
 		},
-		/* 112 UpperASCII <- <[A-Z]> */
+		/* 156 UpperASCII <- <[A-Z]> */
 		func() bool {
-			position994, tokenIndex994 := position, tokenIndex
+			position1398, tokenIndex1398 := position, tokenIndex
 			{
-				position995 := position
+				position1399 := position
 				if c := buffer[position]; c < rune('A') || c > rune('Z') {
-					goto l994
+					goto l1398
 				}
 				position++
-				add(ruleUpperASCII, position995)
+				add(ruleUpperASCII, position1399)
 			}
 			return true
-		l994:
-			position, tokenIndex = position994, tokenIndex994
+		l1398:
+			position, tokenIndex = position1398, tokenIndex1398
 			return false
 		},
-		/* 113 Apostrophe <- <(ApostrOther / ApostrASCII)> */
+		/* 157 Apostrophe <- <(ApostrOther / ApostrASCII)> */
 		func() bool {
-			position996, tokenIndex996 := position, tokenIndex
+			position1400, tokenIndex1400 := position, tokenIndex
 			{
-				position997 := position
+				position1401 := position
 				{
-					position998, tokenIndex998 := position, tokenIndex
+					position1402, tokenIndex1402 := position, tokenIndex
 					if !_rules[ruleApostrOther]() {
-						goto l999
+						goto l1403
 					}
-					goto l998
-				l999:
-					position, tokenIndex = position998, tokenIndex998
+					goto l1402
+				l1403:
+					position, tokenIndex = position1402, tokenIndex1402
 					if !_rules[ruleApostrASCII]() {
-						goto l996
+						goto l1400
 					}
 				}
-			l998:
-				add(ruleApostrophe, position997)
+			l1402:
+				add(ruleApostrophe, position1401)
 			}
 			return true
-		l996:
-			position, tokenIndex = position996, tokenIndex996
+		l1400:
+			position, tokenIndex = position1400, tokenIndex1400
 			return false
 		},
-		/* 114 ApostrASCII <- <'\''> */
+		/* 158 ApostrASCII <- <'\''> */
 		func() bool {
-			position1000, tokenIndex1000 := position, tokenIndex
+			position1404, tokenIndex1404 := position, tokenIndex
 			{
-				position1001 := position
+				position1405 := position
 				if buffer[position] != rune('\'') {
-					goto l1000
+					goto l1404
 				}
 				position++
-				add(ruleApostrASCII, position1001)
+				add(ruleApostrASCII, position1405)
 			}
 			return true
-		l1000:
-			position, tokenIndex = position1000, tokenIndex1000
+		l1404:
+			position, tokenIndex = position1404, tokenIndex1404
 			return false
 		},
-		/* 115 ApostrOther <- <('‘' / '’')> */
+		/* 159 ApostrOther <- <('‘' / '’')> */
 		func() bool {
-			position1002, tokenIndex1002 := position, tokenIndex
+			position1406, tokenIndex1406 := position, tokenIndex
 			{
-				position1003 := position
+				position1407 := position
 				{
-					position1004, tokenIndex1004 := position, tokenIndex
+					position1408, tokenIndex1408 := position, tokenIndex
 					if buffer[position] != rune('‘') {
-						goto l1005
+						goto l1409
 					}
 					position++
-					goto l1004
-				l1005:
-					position, tokenIndex = position1004, tokenIndex1004
+					goto l1408
+				l1409:
+					position, tokenIndex = position1408, tokenIndex1408
 					if buffer[position] != rune('’') {
-						goto l1002
+						goto l1406
 					}
 					position++
 				}
-			l1004:
-				add(ruleApostrOther, position1003)
+			l1408:
+				add(ruleApostrOther, position1407)
 			}
 			return true
-		l1002:
-			position, tokenIndex = position1002, tokenIndex1002
+		l1406:
+			position, tokenIndex = position1406, tokenIndex1406
 			return false
 		},
-		/* 116 Dash <- <'-'> */
+		/* 160 Dash <- <'-'> */
 		func() bool {
-			position1006, tokenIndex1006 := position, tokenIndex
+			position1410, tokenIndex1410 := position, tokenIndex
 			{
-				position1007 := position
+				position1411 := position
 				if buffer[position] != rune('-') {
-					goto l1006
+					goto l1410
 				}
 				position++
-				add(ruleDash, position1007)
+				add(ruleDash, position1411)
 			}
 			return true
-		l1006:
-			position, tokenIndex = position1006, tokenIndex1006
+		l1410:
+			position, tokenIndex = position1410, tokenIndex1410
 			return false
 		},
-		/* 117 Slash <- <'/'> */
+		/* 161 Slash <- <'/'> */
 		func() bool {
-			position1008, tokenIndex1008 := position, tokenIndex
+			position1412, tokenIndex1412 := position, tokenIndex
 			{
-				position1009 := position
+				position1413 := position
 				if buffer[position] != rune('/') {
-					goto l1008
+					goto l1412
 				}
 				position++
-				add(ruleSlash, position1009)
+				add(ruleSlash, position1413)
 			}
 			return true
-		l1008:
-			position, tokenIndex = position1008, tokenIndex1008
+		l1412:
+			position, tokenIndex = position1412, tokenIndex1412
 			return false
 		},
-		/* 118 _ <- <(MultipleSpace / SingleSpace)> */
+		/* 162 _ <- <(MultipleSpace / SingleSpace)> */
 		func() bool {
-			position1010, tokenIndex1010 := position, tokenIndex
+			position1414, tokenIndex1414 := position, tokenIndex
 			{
-				position1011 := position
+				position1415 := position
 				{
-					position1012, tokenIndex1012 := position, tokenIndex
+					position1416, tokenIndex1416 := position, tokenIndex
 					if !_rules[ruleMultipleSpace]() {
-						goto l1013
+						goto l1417
 					}
-					goto l1012
-				l1013:
-					position, tokenIndex = position1012, tokenIndex1012
+					goto l1416
+				l1417:
+					position, tokenIndex = position1416, tokenIndex1416
 					if !_rules[ruleSingleSpace]() {
-						goto l1010
+						goto l1414
 					}
 				}
-			l1012:
-				add(rule_, position1011)
+			l1416:
+				add(rule_, position1415)
 			}
 			return true
-		l1010:
-			position, tokenIndex = position1010, tokenIndex1010
+		l1414:
+			position, tokenIndex = position1414, tokenIndex1414
 			return false
 		},
-		/* 119 MultipleSpace <- <(SingleSpace SingleSpace+)> */
+		/* 163 MultipleSpace <- <(SingleSpace SingleSpace+)> */
 		func() bool {
-			position1014, tokenIndex1014 := position, tokenIndex
+			position1418, tokenIndex1418 := position, tokenIndex
 			{
-				position1015 := position
+				position1419 := position
 				if !_rules[ruleSingleSpace]() {
-					goto l1014
+					goto l1418
 				}
 				if !_rules[ruleSingleSpace]() {
-					goto l1014
+					goto l1418
 				}
-			l1016:
+			l1420:
 				{
-					position1017, tokenIndex1017 := position, tokenIndex
+					position1421, tokenIndex1421 := position, tokenIndex
 					if !_rules[ruleSingleSpace]() {
-						goto l1017
+						goto l1421
 					}
-					goto l1016
-				l1017:
-					position, tokenIndex = position1017, tokenIndex1017
+					goto l1420
+				l1421:
+					position, tokenIndex = position1421, tokenIndex1421
 				}
-				add(ruleMultipleSpace, position1015)
+				add(ruleMultipleSpace, position1419)
 			}
 			return true
-		l1014:
-			position, tokenIndex = position1014, tokenIndex1014
+		l1418:
+			position, tokenIndex = position1418, tokenIndex1418
 			return false
 		},
-		/* 120 SingleSpace <- <(' ' / OtherSpace)> */
+		/* 164 SingleSpace <- <(' ' / OtherSpace)> */
 		func() bool {
-			position1018, tokenIndex1018 := position, tokenIndex
+			position1422, tokenIndex1422 := position, tokenIndex
 			{
-				position1019 := position
+				position1423 := position
 				{
-					position1020, tokenIndex1020 := position, tokenIndex
+					position1424, tokenIndex1424 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l1021
+						goto l1425
 					}
 					position++
-					goto l1020
-				l1021:
-					position, tokenIndex = position1020, tokenIndex1020
+					goto l1424
+				l1425:
+					position, tokenIndex = position1424, tokenIndex1424
 					if !_rules[ruleOtherSpace]() {
-						goto l1018
+						goto l1422
 					}
 				}
-			l1020:
-				add(ruleSingleSpace, position1019)
+			l1424:
+				add(ruleSingleSpace, position1423)
 			}
 			return true
-		l1018:
-			position, tokenIndex = position1018, tokenIndex1018
+		l1422:
+			position, tokenIndex = position1422, tokenIndex1422
 			return false
 		},
-		/* 121 OtherSpace <- <('\u3000' / '\u00a0' / '\t' / '\r' / '\n' / '\f' / '\v')> */
+		/* 165 OtherSpace <- <('\u3000' / '\u00a0' / '\t' / '\r' / '\n' / '\f' / '\v')> */
 		func() bool {
-			position1022, tokenIndex1022 := position, tokenIndex
+			position1426, tokenIndex1426 := position, tokenIndex
 			{
-				position1023 := position
+				position1427 := position
 				{
-					position1024, tokenIndex1024 := position, tokenIndex
+					position1428, tokenIndex1428 := position, tokenIndex
 					if buffer[position] != rune('\u3000') {
-						goto l1025
+						goto l1429
 					}
 					position++
-					goto l1024
-				l1025:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1428
+				l1429:
+					position, tokenIndex = position1428, tokenIndex1428
 					if buffer[position] != rune('\u00a0') {
-						goto l1026
+						goto l1430
 					}
 					position++
-					goto l1024
-				l1026:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1428
+				l1430:
+					position, tokenIndex = position1428, tokenIndex1428
 					if buffer[position] != rune('\t') {
-						goto l1027
+						goto l1431
 					}
 					position++
-					goto l1024
-				l1027:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1428
+				l1431:
+					position, tokenIndex = position1428, tokenIndex1428
 					if buffer[position] != rune('\r') {
-						goto l1028
+						goto l1432
 					}
 					position++
-					goto l1024
-				l1028:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1428
+				l1432:
+					position, tokenIndex = position1428, tokenIndex1428
 					if buffer[position] != rune('\n') {
-						goto l1029
+						goto l1433
 					}
 					position++
-					goto l1024
-				l1029:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1428
+				l1433:
+					position, tokenIndex = position1428, tokenIndex1428
 					if buffer[position] != rune('\f') {
-						goto l1030
+						goto l1434
 					}
 					position++
-					goto l1024
-				l1030:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1428
+				l1434:
+					position, tokenIndex = position1428, tokenIndex1428
 					if buffer[position] != rune('\v') {
-						goto l1022
+						goto l1426
 					}
 					position++
 				}
-			l1024:
-				add(ruleOtherSpace, position1023)
+			l1428:
+				add(ruleOtherSpace, position1427)
 			}
 			return true
-		l1022:
-			position, tokenIndex = position1022, tokenIndex1022
+		l1426:
+			position, tokenIndex = position1426, tokenIndex1426
 			return false
 		},
-		/* 123 Action0 <- <{ p.AddWarn(YearCharWarn) }> */
+		/* 167 Action0 <- <{ p.AddWarn(YearCharWarn) }> */
 		func() bool {
 			{
 				add(ruleAction0, position)
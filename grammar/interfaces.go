@@ -25,7 +25,12 @@ type AuthorFinder interface {
 
 type Outputter interface {
 	// details creates a details structure for JSON-based outputs
-	details() []interface{}
+	details() []Details
+}
+
+type TreeMaker interface {
+	// tree function creates a read-only tree of a node for visitors.
+	tree() *TreeNode
 }
 
 type Name interface {
@@ -34,4 +39,5 @@ type Name interface {
 	Poser
 	AuthorFinder
 	Outputter
+	TreeMaker
 }
@@ -2,61 +2,123 @@ package grammar
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/gnames/gnparser/str"
 )
 
 type UninomialOutput struct {
-	Uninomial *uniDetails `json:"uninomial"`
+	Uninomial *UniDetails `json:"uninomial"`
 }
 
 type SpeciesOutput struct {
-	Genus        *genusOutput            `json:"genus"`
-	SpecEpithet  *specEpithetOutput      `json:"specificEpithet"`
-	SubGenus     *subGenusOutput         `json:"infragenericEpithet,omitempty"`
+	Genus        *GenusOutput            `json:"genus"`
+	SpecEpithet  *SpecEpithetOutput      `json:"specificEpithet"`
+	SubGenus     *SubGenusOutput         `json:"infragenericEpithet,omitempty"`
 	InfraSpecies []*InfraSpEpithetOutput `json:"infraspecificEpithets,omitempty"`
 }
 
 type ApproxOutput struct {
-	Genus       *genusOutput       `json:"genus"`
-	SpecEpithet *specEpithetOutput `json:"specificEpithet,omitempty"`
+	Genus       *GenusOutput       `json:"genus"`
+	SpecEpithet *SpecEpithetOutput `json:"specificEpithet,omitempty"`
 	Approx      string             `json:"annotationIdentification"`
-	Ignored     *ignoredOutput     `json:"ignored,omitempty"`
+	Ignored     *IgnoredOutput     `json:"ignored,omitempty"`
 }
 
 type ComparisonOutput struct {
-	Genus       *genusOutput       `json:"genus"`
-	SpecEpithet *specEpithetOutput `json:"specificEpithet"`
+	Genus       *GenusOutput       `json:"genus"`
+	SpecEpithet *SpecEpithetOutput `json:"specificEpithet"`
 	Comparison  string             `json:"annotationIdentification"`
 }
 
-type ignoredOutput struct {
+// CultivarOutput keeps details of a name of a cultivated plant. Its
+// botanical part is either a uninomial or a species.
+type CultivarOutput struct {
+	*UninomialOutput
+	*SpeciesOutput
+	Cultivars []*CultivarEpithetOutput `json:"cultivars"`
+}
+
+// CultivarEpithetOutput is a cultivar epithet, a cultivar group or a grex
+// name. Type is "cultivar", "cultivarGroup" or "grex".
+type CultivarEpithetOutput struct {
+	Value string `json:"value"`
+	Type  string `json:"type"`
+}
+
+// TaxonConceptOutput is a taxon concept qualifier of a name. Type is
+// "sensu", "sec", "auct", "sensuLato", "sensuStricto" or "proParte".
+// Start and End are offsets of the qualifier with its authors.
+type TaxonConceptOutput struct {
+	Type               string              `json:"type"`
+	Value              string              `json:"value"`
+	Authorship         *AuthorshipOutput   `json:"authorship,omitempty"`
+	ExcludedAuthorship []*AuthorshipOutput `json:"excludedAuthorship,omitempty"`
+	Start              int                 `json:"start"`
+	End                int                 `json:"end"`
+}
+
+// PublicationOutput is a short reference to a publication of a name.
+// Start and End are offsets of the reference in the name-string.
+type PublicationOutput struct {
+	Value   string `json:"value"`
+	Title   string `json:"title"`
+	Edition string `json:"edition,omitempty"`
+	Volume  string `json:"volume,omitempty"`
+	Issue   string `json:"issue,omitempty"`
+	Pages   string `json:"pages"`
+	Year    string `json:"year,omitempty"`
+	Start   int    `json:"start"`
+	End     int    `json:"end"`
+}
+
+type IgnoredOutput struct {
 	Value string `json:"value"`
 }
 
-type genusOutput struct {
+type GenusOutput struct {
 	Value string `json:"value"`
 }
 
-type subGenusOutput struct {
+type SubGenusOutput struct {
 	Value string `json:"value"`
 }
-type specEpithetOutput struct {
+type SpecEpithetOutput struct {
 	Value      string            `json:"value"`
 	Authorship *AuthorshipOutput `json:"authorship,omitempty"`
 }
 
 type InfraSpEpithetOutput struct {
-	Value      string            `json:"value"`
-	Rank       string            `json:"rank,omitempty"`
+	Value string `json:"value"`
+	Rank  string `json:"rank,omitempty"`
+	// NormalizedRank is the rank from the controlled vocabulary, like
+	// 'subspecies' for 'ssp.'.
+	NormalizedRank string `json:"normalizedRank,omitempty"`
+	// RankLevel is the position of the rank in the hierarchy of ranks,
+	// lower ranks have bigger levels.
+	RankLevel  int               `json:"rankLevel,omitempty"`
 	Authorship *AuthorshipOutput `json:"authorship,omitempty"`
 }
 
-type uniDetails struct {
-	Value      string            `json:"value"`
-	Rank       string            `json:"rank,omitempty"`
-	Parent     string            `json:"parent,omitempty"`
-	Authorship *AuthorshipOutput `json:"authorship,omitempty"`
+type UniDetails struct {
+	Value          string            `json:"value"`
+	Rank           string            `json:"rank,omitempty"`
+	NormalizedRank string            `json:"normalizedRank,omitempty"`
+	RankLevel      int               `json:"rankLevel,omitempty"`
+	Parent         string            `json:"parent,omitempty"`
+	Authorship     *AuthorshipOutput `json:"authorship,omitempty"`
+	// InferredRank is a rank implied by the suffix of the uninomial, like
+	// 'family' for 'Hominidae'. It is given only on request.
+	InferredRank *InferredRankOutput `json:"inferredRank,omitempty"`
+}
+
+// InferredRankOutput is a rank of a uninomial inferred from its suffix with
+// the confidence of the inference and the nomenclatural code that uses
+// the suffix for the rank.
+type InferredRankOutput struct {
+	Rank       string  `json:"rank"`
+	Confidence float64 `json:"confidence"`
+	Code       string  `json:"code"`
 }
 
 type AuthorshipOutput struct {
@@ -66,18 +128,45 @@ type AuthorshipOutput struct {
 }
 
 type AuthGroupOutput struct {
-	Authors      []string       `json:"authors"`
-	Year         *yearOutput    `json:"year,omitempty"`
-	ExAuthors    *AuthorsOutput `json:"exAuthors,omitempty"`
-	EmendAuthors *AuthorsOutput `json:"emendAuthors,omitempty"`
+	Authors            []string        `json:"authors"`
+	AuthorDetails      []*AuthorOutput `json:"authorDetails,omitempty"`
+	Year               *YearOutput     `json:"year,omitempty"`
+	ExAuthors          *AuthorsOutput  `json:"exAuthors,omitempty"`
+	EmendAuthors       *AuthorsOutput  `json:"emendAuthors,omitempty"`
+	SanctioningAuthors *AuthorsOutput  `json:"sanctioningAuthors,omitempty"`
 }
 
 type AuthorsOutput struct {
-	Authors []string    `json:"authors"`
-	Year    *yearOutput `json:"year,omitempty"`
+	Authors       []string        `json:"authors"`
+	AuthorDetails []*AuthorOutput `json:"authorDetails,omitempty"`
+	Year          *YearOutput     `json:"year,omitempty"`
 }
 
-type yearOutput struct {
+// AuthorOutput is a structured name of an author.
+type AuthorOutput struct {
+	// Value is the normalized name of the author, like 'L. fil.'.
+	Value string `json:"value"`
+	// Surname of the author, often abbreviated, like 'L.' or 'Hoeven'.
+	Surname string `json:"surname,omitempty"`
+	// Initials of given names of the author, like 'D. M.'.
+	Initials string `json:"initials,omitempty"`
+	// Prefix is a particle before the surname, like 'van der'.
+	Prefix string `json:"prefix,omitempty"`
+	// Filius is true if the author is a son of an author with the same name.
+	Filius bool `json:"filius,omitempty"`
+	// EtAl is true if the name is followed by 'et al.'.
+	EtAl bool `json:"etAl,omitempty"`
+	// Unknown is true for unknown authors, like 'anon.' or '?'.
+	Unknown bool `json:"unknown,omitempty"`
+	// Expanded is the full name of the author if the name is a known
+	// abbreviation, like 'Carl Linnaeus' for 'L.'.
+	Expanded string `json:"expanded,omitempty"`
+	// Key is a normalized surname of the author for comparison with other
+	// authors, like 'linnaeus' for 'L.' and 'Linnaeus'.
+	Key string `json:"key,omitempty"`
+}
+
+type YearOutput struct {
 	Value       string `json:"value,omitempty"`
 	Approximate bool   `json:"approximate,omitempty"`
 }
@@ -85,6 +174,9 @@ type yearOutput struct {
 type Canonical struct {
 	Value       string
 	ValueRanked string
+	// IncludesCultivars is true if ValueRanked contains cultivar epithets,
+	// cultivar groups or grex names.
+	IncludesCultivars bool
 }
 
 func appendCanonical(c1 *Canonical, c2 *Canonical, sep string) *Canonical {
@@ -95,7 +187,16 @@ func appendCanonical(c1 *Canonical, c2 *Canonical, sep string) *Canonical {
 }
 
 func (sn *ScientificNameNode) Pos() []Pos {
-	return sn.Name.pos()
+	pos := append(sn.Name.pos(), sn.Pub.pos()...)
+	for _, v := range sn.Excluded {
+		pos = append(pos, v.pos()...)
+	}
+	pos = append(pos, sn.Concept.pos()...)
+	// A taxon concept can be found between epithets of a name.
+	sort.SliceStable(pos, func(i, j int) bool {
+		return pos[i].Start < pos[j].Start
+	})
+	return pos
 }
 
 func (sn *ScientificNameNode) Value() string {
@@ -113,11 +214,54 @@ func (sn *ScientificNameNode) Canonical() *Canonical {
 	return sn.Name.canonical()
 }
 
-func (sn *ScientificNameNode) Details() []interface{} {
+// Details returns typed details of a parsed name. Names with several
+// elements in details are hybrid formulas. It returns nil if a name was not
+// parsed.
+func (sn *ScientificNameNode) Details() Details {
 	if sn.Name == nil {
-		return []interface{}{}
+		return nil
+	}
+	ds := sn.Name.details()
+	switch len(ds) {
+	case 0:
+		return nil
+	case 1:
+		return ds[0]
+	default:
+		return &HybridFormulaOutput{Elements: ds}
 	}
-	return sn.Name.details()
+}
+
+// Publication returns the reference to a publication that follows
+// the authorship of a name. It returns nil if there is no such reference.
+func (sn *ScientificNameNode) Publication() *PublicationOutput {
+	return sn.Pub.details()
+}
+
+// ExcludedAuthorship returns authorships after 'non' or 'nec' that follow
+// a name. They belong to homonyms or misapplications of the name.
+func (sn *ScientificNameNode) ExcludedAuthorship() []*AuthorshipOutput {
+	var res []*AuthorshipOutput
+	for _, v := range sn.Excluded {
+		res = append(res, v.Authorship.details())
+	}
+	return res
+}
+
+// TaxonConcept returns the taxon concept qualifier of a name. It returns
+// nil if there is no such qualifier.
+func (sn *ScientificNameNode) TaxonConcept() *TaxonConceptOutput {
+	return sn.Concept.details()
+}
+
+// ExpandedAuthorship returns the authorship of the last element of a name
+// where abbreviations of authors' names are substituted by their full
+// names.
+func (sn *ScientificNameNode) ExpandedAuthorship() string {
+	if sn.Name == nil {
+		return ""
+	}
+	return sn.Name.lastAuthorship().expandedValue()
 }
 
 func (sn *ScientificNameNode) LastAuthorship() *AuthorshipOutput {
@@ -175,7 +319,7 @@ func (nf *hybridFormulaNode) lastAuthorship() *authorshipNode {
 	return au
 }
 
-func (nf *hybridFormulaNode) details() []interface{} {
+func (nf *hybridFormulaNode) details() []Details {
 	ds := nf.FirstSpecies.details()
 	for _, v := range nf.HybridElements {
 		if v.Species != nil {
@@ -208,7 +352,7 @@ func (nh *namedGenusHybridNode) canonical() *Canonical {
 	return c
 }
 
-func (nh *namedGenusHybridNode) details() []interface{} {
+func (nh *namedGenusHybridNode) details() []Details {
 	d := nh.Name.details()
 	return d
 }
@@ -263,15 +407,15 @@ func (nh *namedSpeciesHybridNode) lastAuthorship() *authorshipNode {
 	return nh.InfraSpecies[len(nh.InfraSpecies)-1].Authorship
 }
 
-func (nh *namedSpeciesHybridNode) details() []interface{} {
-	g := &genusOutput{Value: nh.Genus.NormValue}
+func (nh *namedSpeciesHybridNode) details() []Details {
+	g := &GenusOutput{Value: nh.Genus.NormValue}
 	sp := nh.SpEpithet.details()
 	so := &SpeciesOutput{
 		Genus:       g,
 		SpecEpithet: sp,
 	}
 	if len(nh.InfraSpecies) == 0 {
-		return []interface{}{so}
+		return []Details{so}
 	}
 	infs := make([]*InfraSpEpithetOutput, len(nh.InfraSpecies))
 	for i, v := range nh.InfraSpecies {
@@ -279,7 +423,7 @@ func (nh *namedSpeciesHybridNode) details() []interface{} {
 	}
 	so.InfraSpecies = infs
 
-	return []interface{}{so}
+	return []Details{so}
 }
 
 func (apr *approxNode) pos() []Pos {
@@ -329,27 +473,27 @@ func (apr *approxNode) lastAuthorship() *authorshipNode {
 	return apr.SpEpithet.Authorship
 }
 
-func (apr *approxNode) details() []interface{} {
+func (apr *approxNode) details() []Details {
 	if apr == nil {
-		return []interface{}{}
+		return []Details{}
 	}
 	g := apr.Genus.NormValue
 	ao := &ApproxOutput{
-		Genus:   &genusOutput{Value: g},
+		Genus:   &GenusOutput{Value: g},
 		Approx:  apr.Approx.NormValue,
-		Ignored: &ignoredOutput{Value: apr.Ignored},
+		Ignored: &IgnoredOutput{Value: apr.Ignored},
 	}
 	if apr.SpEpithet == nil {
-		return []interface{}{ao}
+		return []Details{ao}
 	}
-	se := &specEpithetOutput{
+	se := &SpecEpithetOutput{
 		Value: apr.SpEpithet.Word.NormValue,
 	}
 	if apr.SpEpithet.Authorship != nil {
 		se.Authorship = apr.SpEpithet.Authorship.details()
 	}
 	ao.SpecEpithet = se
-	return []interface{}{ao}
+	return []Details{ao}
 }
 
 func (comp *comparisonNode) pos() []Pos {
@@ -398,21 +542,21 @@ func (comp *comparisonNode) lastAuthorship() *authorshipNode {
 	return comp.SpEpithet.Authorship
 }
 
-func (comp *comparisonNode) details() []interface{} {
+func (comp *comparisonNode) details() []Details {
 	if comp == nil {
-		return []interface{}{}
+		return []Details{}
 	}
-	var se *specEpithetOutput
+	var se *SpecEpithetOutput
 	if comp.SpEpithet != nil {
 		se = comp.SpEpithet.details()
 	}
 
 	co := &ComparisonOutput{
-		Genus:       &genusOutput{Value: comp.Genus.NormValue},
+		Genus:       &GenusOutput{Value: comp.Genus.NormValue},
 		Comparison:  comp.Comparison.NormValue,
 		SpecEpithet: se,
 	}
-	return []interface{}{co}
+	return []Details{co}
 }
 
 func (sp *speciesNode) pos() []Pos {
@@ -461,8 +605,8 @@ func (sp *speciesNode) lastAuthorship() *authorshipNode {
 	return sp.InfraSpecies[len(sp.InfraSpecies)-1].Authorship
 }
 
-func (sp *speciesNode) details() []interface{} {
-	se := specEpithetOutput{
+func (sp *speciesNode) details() []Details {
+	se := SpecEpithetOutput{
 		Value: sp.SpEpithet.Word.NormValue,
 	}
 	if sp.SpEpithet.Authorship != nil {
@@ -471,16 +615,16 @@ func (sp *speciesNode) details() []interface{} {
 
 	g := sp.Genus.NormValue
 	so := SpeciesOutput{
-		Genus:       &genusOutput{Value: g},
+		Genus:       &GenusOutput{Value: g},
 		SpecEpithet: &se,
 	}
 
 	if sp.SubGenus != nil {
 		sg := sp.SubGenus.NormValue
-		so.SubGenus = &subGenusOutput{Value: sg}
+		so.SubGenus = &SubGenusOutput{Value: sg}
 	}
 	if len(sp.InfraSpecies) == 0 {
-		return []interface{}{&so}
+		return []Details{&so}
 	}
 	infs := make([]*InfraSpEpithetOutput, len(sp.InfraSpecies))
 	for i, v := range sp.InfraSpecies {
@@ -488,7 +632,7 @@ func (sp *speciesNode) details() []interface{} {
 	}
 	so.InfraSpecies = infs
 
-	return []interface{}{&so}
+	return []Details{&so}
 }
 
 func (sep *spEpithetNode) pos() []Pos {
@@ -508,10 +652,10 @@ func (sep *spEpithetNode) canonical() *Canonical {
 	return c
 }
 
-func (sep *spEpithetNode) details() *specEpithetOutput {
+func (sep *spEpithetNode) details() *SpecEpithetOutput {
 	val := sep.Word.NormValue
 	au := sep.Authorship.details()
-	seo := specEpithetOutput{
+	seo := SpecEpithetOutput{
 		Value:      val,
 		Authorship: au,
 	}
@@ -566,10 +710,13 @@ func (inf *infraspEpithetNode) details() *InfraSpEpithetOutput {
 	if inf.Rank != nil && inf.Rank.Word != nil {
 		rank = inf.Rank.Word.NormValue
 	}
+	nr := NormalizeRank(rank)
 	info = InfraSpEpithetOutput{
-		Value:      inf.Word.NormValue,
-		Rank:       rank,
-		Authorship: inf.Authorship.details(),
+		Value:          inf.Word.NormValue,
+		Rank:           rank,
+		NormalizedRank: nr,
+		RankLevel:      RankLevel(nr),
+		Authorship:     inf.Authorship.details(),
 	}
 	return &info
 }
@@ -593,13 +740,16 @@ func (u *uninomialNode) lastAuthorship() *authorshipNode {
 	return u.Authorship
 }
 
-func (u *uninomialNode) details() []interface{} {
-	ud := uniDetails{Value: u.Word.NormValue}
+func (u *uninomialNode) details() []Details {
+	ud := UniDetails{
+		Value:        u.Word.NormValue,
+		InferredRank: u.InferredRank.details(),
+	}
 	if u.Authorship != nil {
 		ud.Authorship = u.Authorship.details()
 	}
 	uo := UninomialOutput{Uninomial: &ud}
-	return []interface{}{&uo}
+	return []Details{&uo}
 }
 
 func (u *uninomialComboNode) pos() []Pos {
@@ -635,17 +785,20 @@ func (u *uninomialComboNode) lastAuthorship() *authorshipNode {
 	return u.Uninomial2.Authorship
 }
 
-func (u *uninomialComboNode) details() []interface{} {
-	ud := uniDetails{
-		Value:  u.Uninomial2.Word.NormValue,
-		Rank:   u.Rank.Word.NormValue,
-		Parent: u.Uninomial1.Word.NormValue,
+func (u *uninomialComboNode) details() []Details {
+	nr := NormalizeRank(u.Rank.Word.NormValue)
+	ud := UniDetails{
+		Value:          u.Uninomial2.Word.NormValue,
+		Rank:           u.Rank.Word.NormValue,
+		NormalizedRank: nr,
+		RankLevel:      RankLevel(nr),
+		Parent:         u.Uninomial1.Word.NormValue,
 	}
 	if u.Uninomial2.Authorship != nil {
 		ud.Authorship = u.Uninomial2.Authorship.details()
 	}
 	uo := UninomialOutput{Uninomial: &ud}
-	return []interface{}{&uo}
+	return []Details{&uo}
 }
 
 func (au *authorshipNode) details() *AuthorshipOutput {
@@ -669,26 +822,21 @@ func authGroupDetail(ag *authorsGroupNode) *AuthGroupOutput {
 	}
 	aus, yr := ag.Team1.details()
 	ago = AuthGroupOutput{
-		Authors: aus,
-		Year:    yr,
+		Authors:       aus,
+		AuthorDetails: ag.Team1.authorDetails(),
+		Year:          yr,
+	}
+	if ag.Sanctioning != nil {
+		ago.SanctioningAuthors = ag.Sanctioning.authorsOutput()
 	}
 	if ag.Team2 == nil {
 		return &ago
 	}
-	aus, yr = ag.Team2.details()
 	switch ag.Team2Type.Pos.Type {
 	case AuthorWordExType:
-		eao := AuthorsOutput{
-			Authors: aus,
-			Year:    yr,
-		}
-		ago.ExAuthors = &eao
+		ago.ExAuthors = ag.Team2.authorsOutput()
 	case AuthorWordEmendType:
-		eao := AuthorsOutput{
-			Authors: aus,
-			Year:    yr,
-		}
-		ago.EmendAuthors = &eao
+		ago.EmendAuthors = ag.Team2.authorsOutput()
 	}
 	return &ago
 }
@@ -703,31 +851,48 @@ func (a *authorshipNode) pos() []Pos {
 }
 
 func (a *authorshipNode) value() string {
+	return a.nameValue(authorValue)
+}
+
+// expandedValue returns the authorship where abbreviations of authors'
+// names are substituted by their full names.
+func (a *authorshipNode) expandedValue() string {
+	return a.nameValue(authorExpandedValue)
+}
+
+func (a *authorshipNode) nameValue(name func(*authorNode) string) string {
 	if a == nil || a.OriginalAuthors == nil {
 		return ""
 	}
 
-	v := a.OriginalAuthors.value()
+	v := a.OriginalAuthors.nameValue(name)
 	if a.OriginalAuthors.Parens {
 		v = fmt.Sprintf("(%s)", v)
 	}
 	if a.CombinationAuthors == nil {
 		return v
 	}
-	cav := a.CombinationAuthors.value()
+	cav := a.CombinationAuthors.nameValue(name)
 	v = v + " " + cav
 	return v
 }
 
 func (ag *authorsGroupNode) value() string {
+	return ag.nameValue(authorValue)
+}
+
+func (ag *authorsGroupNode) nameValue(name func(*authorNode) string) string {
 	if ag == nil || ag.Team1 == nil {
 		return ""
 	}
-	v := ag.Team1.value()
-	if ag.Team2 == nil {
-		return v
+	v := ag.Team1.nameValue(name)
+	if ag.Team2 != nil {
+		v = fmt.Sprintf("%s %s %s", v, ag.Team2Type.NormValue,
+			ag.Team2.nameValue(name))
+	}
+	if ag.Sanctioning != nil {
+		v = fmt.Sprintf("%s : %s", v, ag.Sanctioning.nameValue(name))
 	}
-	v = fmt.Sprintf("%s %s %s", v, ag.Team2Type.NormValue, ag.Team2.value())
 	return v
 }
 
@@ -737,10 +902,15 @@ func (ag *authorsGroupNode) pos() []Pos {
 		return p
 	}
 	p := ag.Team1.pos()
-	return append(p, ag.Team2.pos()...)
+	p = append(p, ag.Team2.pos()...)
+	return append(p, ag.Sanctioning.pos()...)
 }
 
 func (aut *authorsTeamNode) value() string {
+	return aut.nameValue(authorValue)
+}
+
+func (aut *authorsTeamNode) nameValue(name func(*authorNode) string) string {
 	if aut == nil {
 		return ""
 	}
@@ -748,10 +918,10 @@ func (aut *authorsTeamNode) value() string {
 	if len(values) == 0 {
 		return ""
 	}
-	value := aut.Authors[0].Value
+	value := name(aut.Authors[0])
 	sep := aut.Authors[0].Sep
 	for _, v := range aut.Authors[1:] {
-		value = str.JoinStrings(value, v.Value, sep)
+		value = str.JoinStrings(value, name(v), sep)
 		sep = v.Sep
 	}
 	if aut.Year == nil {
@@ -766,8 +936,19 @@ func (aut *authorsTeamNode) value() string {
 	return value
 }
 
-func (at *authorsTeamNode) details() ([]string, *yearOutput) {
-	var yr *yearOutput
+func authorValue(aun *authorNode) string {
+	return aun.Value
+}
+
+func authorExpandedValue(aun *authorNode) string {
+	if aun.Expanded == "" {
+		return aun.Value
+	}
+	return aun.Expanded
+}
+
+func (at *authorsTeamNode) details() ([]string, *YearOutput) {
+	var yr *YearOutput
 	var aus []string
 	if at == nil {
 		return aus, yr
@@ -779,13 +960,30 @@ func (at *authorsTeamNode) details() ([]string, *yearOutput) {
 	if at.Year == nil {
 		return aus, yr
 	}
-	yr = &yearOutput{
+	yr = &YearOutput{
 		Value:       at.Year.Word.NormValue,
 		Approximate: at.Year.Approximate,
 	}
 	return aus, yr
 }
 
+func (at *authorsTeamNode) authorsOutput() *AuthorsOutput {
+	aus, yr := at.details()
+	return &AuthorsOutput{
+		Authors:       aus,
+		AuthorDetails: at.authorDetails(),
+		Year:          yr,
+	}
+}
+
+func (at *authorsTeamNode) authorDetails() []*AuthorOutput {
+	res := make([]*AuthorOutput, len(at.Authors))
+	for i, v := range at.Authors {
+		res[i] = v.details()
+	}
+	return res
+}
+
 func (aut *authorsTeamNode) pos() []Pos {
 	var res []Pos
 	if aut == nil {
@@ -800,6 +998,20 @@ func (aut *authorsTeamNode) pos() []Pos {
 	return res
 }
 
+func (aun *authorNode) details() *AuthorOutput {
+	return &AuthorOutput{
+		Value:    aun.Value,
+		Surname:  aun.Surname,
+		Initials: aun.Initials,
+		Prefix:   aun.Prefix,
+		Filius:   aun.Filius,
+		EtAl:     aun.EtAl,
+		Unknown:  aun.Unknown,
+		Expanded: aun.Expanded,
+		Key:      aun.Key,
+	}
+}
+
 func (aun *authorNode) pos() []Pos {
 	p := make([]Pos, len(aun.Words))
 	for i, v := range aun.Words {
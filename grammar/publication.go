@@ -0,0 +1,151 @@
+package grammar
+
+import (
+	"strings"
+)
+
+// publicationNode is a short reference to a publication of a name that
+// follows its authorship, like 'Syst. Nat. ed. 10, 1: 20. 1758'.
+type publicationNode struct {
+	Title   *wordNode
+	Edition *wordNode
+	Volume  *wordNode
+	Issue   *wordNode
+	Pages   *wordNode
+	Year    *wordNode
+	Start   int
+	End     int
+}
+
+func (p *Engine) newPublicationNode(n *node32) *publicationNode {
+	t := n.token32
+	start, end := p.span(t)
+	pub := publicationNode{Start: start, End: end}
+	for n = n.up; n != nil; n = n.next {
+		switch n.token32.pegRule {
+		case rulePubTitle:
+			pub.Title = p.newWordNode(n, PubTitleType)
+			pub.Title.NormValue = strings.Join(strings.Fields(pub.Title.Value), " ")
+		case rulePubEdition:
+			pub.Edition = p.newWordNode(n, PubEditionType)
+			pub.Edition.NormValue = strings.TrimSpace(pub.Edition.Value[3:])
+		case rulePubVolume:
+			pub.Volume = p.newWordNode(n, PubVolumeType)
+		case rulePubIssue:
+			pub.Issue = p.newWordNode(n, PubIssueType)
+		case rulePubPages:
+			pub.Pages = p.newWordNode(n, PubPagesType)
+		case rulePubYear:
+			pub.Year = p.newWordNode(n, PubYearType)
+			pub.Year.NormValue = p.nodeValue(n.up)
+		}
+	}
+	return &pub
+}
+
+// reconcileYear compares the year of the publication with the year of
+// the authorship it follows. A warning is issued if the years differ.
+func (p *Engine) reconcileYear(pub *publicationNode, name Name) {
+	if pub == nil || pub.Year == nil || name == nil {
+		return
+	}
+	au := name.lastAuthorship()
+	if au == nil {
+		return
+	}
+	ag := au.OriginalAuthors
+	if au.CombinationAuthors != nil {
+		ag = au.CombinationAuthors
+	}
+	if ag == nil || ag.Team1.Year == nil {
+		return
+	}
+	if ag.Team1.Year.Word.NormValue != pub.Year.NormValue {
+		p.AddWarn(PublicationYearWarn)
+	}
+}
+
+func (pub *publicationNode) value() string {
+	if pub == nil {
+		return ""
+	}
+	res := pub.Title.NormValue
+	if pub.Edition != nil {
+		res += " ed. " + pub.Edition.NormValue
+		if pub.Volume != nil {
+			res += ","
+		}
+	}
+	if pub.Volume != nil {
+		res += " " + pub.Volume.NormValue
+		if pub.Issue != nil {
+			res += "(" + pub.Issue.NormValue + ")"
+		}
+	}
+	res += ": " + pub.Pages.NormValue
+	if pub.Year != nil {
+		res += ". " + pub.Year.NormValue
+	}
+	return res
+}
+
+func (pub *publicationNode) words() []*wordNode {
+	var res []*wordNode
+	ws := []*wordNode{
+		pub.Title, pub.Edition, pub.Volume, pub.Issue, pub.Pages, pub.Year,
+	}
+	for _, v := range ws {
+		if v != nil {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func (pub *publicationNode) pos() []Pos {
+	if pub == nil {
+		return nil
+	}
+	var pos []Pos
+	for _, v := range pub.words() {
+		pos = append(pos, v.Pos)
+	}
+	return pos
+}
+
+func (pub *publicationNode) details() *PublicationOutput {
+	if pub == nil {
+		return nil
+	}
+	po := PublicationOutput{
+		Value: pub.value(),
+		Title: pub.Title.NormValue,
+		Start: pub.Start,
+		End:   pub.End,
+	}
+	if pub.Edition != nil {
+		po.Edition = pub.Edition.NormValue
+	}
+	if pub.Volume != nil {
+		po.Volume = pub.Volume.NormValue
+	}
+	if pub.Issue != nil {
+		po.Issue = pub.Issue.NormValue
+	}
+	po.Pages = pub.Pages.NormValue
+	if pub.Year != nil {
+		po.Year = pub.Year.NormValue
+	}
+	return &po
+}
+
+func (pub *publicationNode) tree() *TreeNode {
+	if pub == nil {
+		return nil
+	}
+	t := TreeNode{Kind: PublicationKind, Value: pub.value()}
+	for _, v := range pub.words() {
+		t.Children = append(t.Children, v.tree(PublicationPartKind))
+	}
+	return &t
+}
@@ -0,0 +1,83 @@
+package grammar
+
+import "strings"
+
+// suffixRank is a rank that a suffix of a uninomial implies under
+// a nomenclatural code.
+type suffixRank struct {
+	code       Code
+	rank       string
+	confidence float64
+}
+
+// rankSuffixes contain suffixes of uninomials with ranks they imply. The
+// first rank of a suffix is used when the code of a name is not known.
+// Suffixes that mean different ranks under different codes have lower
+// confidence. Longer suffixes go first.
+var rankSuffixes = []struct {
+	suffix string
+	ranks  []suffixRank
+}{
+	{"mycotina", []suffixRank{{BotanicalCode, "subdivision", 0.8}}},
+	{"phytina", []suffixRank{{BotanicalCode, "subdivision", 0.8}}},
+	{"phyceae", []suffixRank{{BotanicalCode, "class", 0.8}}},
+	{"mycetes", []suffixRank{{BotanicalCode, "class", 0.8}}},
+	{"oideae", []suffixRank{{BotanicalCode, "subfamily", 0.9}}},
+	{"opsida", []suffixRank{{BotanicalCode, "class", 0.8}}},
+	{"mycota", []suffixRank{{BotanicalCode, "division", 0.8}}},
+	{"aceae", []suffixRank{
+		{BotanicalCode, "family", 0.9}, {BacterialCode, "family", 0.9},
+	}},
+	{"ineae", []suffixRank{
+		{BotanicalCode, "suborder", 0.8}, {BacterialCode, "suborder", 0.8},
+	}},
+	{"phyta", []suffixRank{{BotanicalCode, "division", 0.8}}},
+	{"oidea", []suffixRank{{ZoologicalCode, "superfamily", 0.8}}},
+	{"ales", []suffixRank{
+		{BotanicalCode, "order", 0.8}, {BacterialCode, "order", 0.8},
+	}},
+	{"idae", []suffixRank{
+		{ZoologicalCode, "family", 0.8}, {BotanicalCode, "subclass", 0.5},
+		{BacterialCode, "subclass", 0.5},
+	}},
+	{"inae", []suffixRank{
+		{ZoologicalCode, "subfamily", 0.7}, {BotanicalCode, "subtribe", 0.5},
+	}},
+	{"eae", []suffixRank{{BotanicalCode, "tribe", 0.6}}},
+	{"ini", []suffixRank{{ZoologicalCode, "tribe", 0.6}}},
+}
+
+// inferRank finds a rank of a uninomial from its suffix. If the code of
+// the name is given, only ranks of this code are used. It returns nil if
+// the suffix does not imply any rank under the code.
+func (p *Engine) inferRank(w *wordNode) *suffixRank {
+	v := strings.ToLower(w.NormValue)
+	for _, rs := range rankSuffixes {
+		if !strings.HasSuffix(v, rs.suffix) || len(v) < len(rs.suffix)+2 {
+			continue
+		}
+		if p.Code == AnyCode {
+			res := rs.ranks[0]
+			return &res
+		}
+		for _, r := range rs.ranks {
+			if r.code == p.Code ||
+				(r.code == BotanicalCode && p.Code.isBotanical()) {
+				return &r
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func (sr *suffixRank) details() *InferredRankOutput {
+	if sr == nil {
+		return nil
+	}
+	return &InferredRankOutput{
+		Rank:       sr.rank,
+		Confidence: sr.confidence,
+		Code:       sr.code.String(),
+	}
+}
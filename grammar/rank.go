@@ -0,0 +1,214 @@
+package grammar
+
+import "strings"
+
+// rankTerms map rank markers to the controlled vocabulary of ranks. Keys are
+// lowercase markers without periods, brackets and spaces, so 'ssp.',
+// '[ssp.]' and 'SSP' have the same key.
+var rankTerms = map[string]string{
+	"f":         "form",
+	"fo":        "form",
+	"fma":       "form",
+	"form":      "form",
+	"forma":     "form",
+	"var":       "variety",
+	"variety":   "variety",
+	"ssp":       "subspecies",
+	"subsp":     "subspecies",
+	"subspec":   "subspecies",
+	"morph":     "morph",
+	"convar":    "convariety",
+	"pseudovar": "pseudovariety",
+	"subvar":    "subvariety",
+	"subf":      "subform",
+	"race":      "race",
+	"pv":        "pathovar",
+	"pathovar":  "pathovar",
+	"ab":        "aberration",
+	"abn":       "aberration",
+	"st":        "stirps",
+	"natio":     "natio",
+	"nat":       "natio",
+	"fsp":       "forma specialis",
+	"mut":       "mutation",
+	"serovar":   "serovar",
+	"biovar":    "biovar",
+	"prol":      "proles",
+	"proles":    "proles",
+	"subprol":   "subproles",
+	"lusus":     "lusus",
+	"sublusus":  "sublusus",
+	"subrace":   "subrace",
+	"cultivar":  "cultivar",
+	"modif":     "modification",
+	"monstr":    "monstrosity",
+
+	// Greek letters mark varieties in early botanical works, Latin letters
+	// and asterisks mark infraspecific taxa of an unknown rank.
+	"α":  "variety",
+	"β":  "variety",
+	"ββ": "variety",
+	"γ":  "variety",
+	"δ":  "variety",
+	"ε":  "variety",
+	"φ":  "variety",
+	"θ":  "variety",
+	"μ":  "variety",
+	"*":  "infraspecies",
+	"a":  "infraspecies",
+	"b":  "infraspecies",
+	"c":  "infraspecies",
+	"d":  "infraspecies",
+	"e":  "infraspecies",
+	"g":  "infraspecies",
+	"k":  "infraspecies",
+
+	"agamosp":    "agamospecies",
+	"agamossp":   "agamosubspecies",
+	"agamovar":   "agamovariety",
+	"nothovar":   "nothovariety",
+	"nvar":       "nothovariety",
+	"nothofo":    "nothoform",
+	"nothof":     "nothoform",
+	"nothosubsp": "nothosubspecies",
+	"nothossp":   "nothosubspecies",
+	"nothosupsp": "nothosubspecies",
+	"nothosu":    "nothosubspecies",
+	"nothosp":    "nothospecies",
+	"nothomorth": "nothomorph",
+
+	"cohors":       "cohort",
+	"infraord":     "infraorder",
+	"superfam":     "superfamily",
+	"fam":          "family",
+	"subfam":       "subfamily",
+	"supertrib":    "supertribe",
+	"trib":         "tribe",
+	"subtrib":      "subtribe",
+	"microgen":     "microgenus",
+	"subgen":       "subgenus",
+	"subg":         "subgenus",
+	"sect":         "section",
+	"subsect":      "subsection",
+	"ser":          "series",
+	"subser":       "subseries",
+	"nothogen":     "nothogenus",
+	"nothosubgen":  "nothosubgenus",
+	"nothosubgeen": "nothosubgenus",
+	"nothosubg":    "nothosubgenus",
+	"nothosect":    "nothosection",
+	"nothosubsect": "nothosubsection",
+	"nothoser":     "nothoseries",
+	"nothosubtrib": "nothosubtribe",
+}
+
+// rankLevels order ranks from higher to lower ones. Gaps between levels
+// leave space for ranks that might be added later. Hybrid and apomictic
+// ranks, like 'nothovariety' or 'agamospecies', have levels of their
+// base ranks.
+var rankLevels = map[string]int{
+	"division":        20,
+	"subdivision":     25,
+	"class":           30,
+	"subclass":        35,
+	"cohort":          38,
+	"order":           40,
+	"suborder":        45,
+	"infraorder":      47,
+	"superfamily":     50,
+	"family":          55,
+	"subfamily":       60,
+	"supertribe":      64,
+	"tribe":           65,
+	"subtribe":        70,
+	"genus":           75,
+	"microgenus":      77,
+	"subgenus":        80,
+	"section":         85,
+	"subsection":      90,
+	"series":          95,
+	"subseries":       100,
+	"species":         105,
+	"subspecies":      110,
+	"proles":          112,
+	"subproles":       113,
+	"convariety":      115,
+	"variety":         120,
+	"pseudovariety":   120,
+	"pathovar":        120,
+	"serovar":         120,
+	"biovar":          120,
+	"subvariety":      125,
+	"form":            130,
+	"forma specialis": 132,
+	"subform":         135,
+	"modification":    136,
+	"lusus":           137,
+	"sublusus":        138,
+	"monstrosity":     139,
+	"aberration":      140,
+	"morph":           140,
+	"mutation":        140,
+	"natio":           140,
+	"race":            140,
+	"stirps":          140,
+	"subrace":         142,
+	"cultivar":        150,
+}
+
+// historicRanks are ranks of early literature that modern codes do not use.
+var historicRanks = map[string]struct{}{
+	"cohort":       {},
+	"proles":       {},
+	"subproles":    {},
+	"lusus":        {},
+	"sublusus":     {},
+	"modification": {},
+	"monstrosity":  {},
+	"subrace":      {},
+}
+
+// nonStandardRanks are ranks that nomenclatural codes do not regulate, like
+// infrasubspecific ranks of bacteria or cultivars as botanical ranks.
+var nonStandardRanks = map[string]struct{}{
+	"microgenus": {},
+	"cultivar":   {},
+	"serovar":    {},
+	"biovar":     {},
+}
+
+// NormalizeRank returns a rank from the controlled vocabulary for a rank
+// marker, like 'subspecies' for 'ssp.' or 'nothovariety' for 'nvar.'. It
+// returns an empty string for unknown markers.
+func NormalizeRank(marker string) string {
+	k := strings.ToLower(marker)
+	k = strings.NewReplacer(".", "", "[", "", "]", "", " ", "").Replace(k)
+	return rankTerms[k]
+}
+
+// rankWarn adds warnings for historic and non-standard ranks.
+func (p *Engine) rankWarn(marker string) {
+	rank := NormalizeRank(marker)
+	if _, ok := historicRanks[rank]; ok {
+		p.AddWarn(RankHistoricWarn)
+	}
+	if _, ok := nonStandardRanks[rank]; ok {
+		p.AddWarn(RankNonStandardWarn)
+	}
+}
+
+// RankLevel returns the position of a normalized rank in the hierarchy of
+// ranks. Higher ranks have smaller levels, so infraspecific epithets of
+// a name are expected to have increasing levels. It returns 0 for ranks
+// without a known position, like 'infraspecies'.
+func RankLevel(rank string) int {
+	if l, ok := rankLevels[rank]; ok {
+		return l
+	}
+	for _, pref := range []string{"notho", "agamo"} {
+		if strings.HasPrefix(rank, pref) {
+			return rankLevels[rank[len(pref):]]
+		}
+	}
+	return 0
+}
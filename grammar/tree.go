@@ -0,0 +1,359 @@
+package grammar
+
+// NodeKind designates the meaning of a node in a tree of a parsed name.
+type NodeKind int
+
+const (
+	ScientificNameKind NodeKind = iota
+	HybridFormulaKind
+	NamedHybridKind
+	SpeciesKind
+	ComparisonKind
+	ApproxKind
+	UninomialKind
+	UninomialComboKind
+	GenusKind
+	SubGenusKind
+	SpEpithetKind
+	InfraSpEpithetKind
+	RankKind
+	HybridCharKind
+	AnnotationKind
+	IgnoredKind
+	AuthorshipKind
+	OriginalAuthorsKind
+	CombinationAuthorsKind
+	AuthorsTeamKind
+	ExAuthorsTeamKind
+	EmendAuthorsTeamKind
+	SanctioningAuthorsTeamKind
+	AuthorKind
+	AuthorWordKind
+	YearKind
+	CultivarNameKind
+	CultivarKind
+	TaxonConceptKind
+	QualifierKind
+	PublicationKind
+	PublicationPartKind
+	ExcludedAuthorshipKind
+)
+
+var nodeKinds = []string{
+	"scientificName", "hybridFormula", "namedHybrid", "species", "comparison",
+	"approximation", "uninomial", "uninomialCombo", "genus", "subGenus",
+	"specificEpithet", "infraspecificEpithet", "rank", "hybridChar",
+	"annotation", "ignored", "authorship", "originalAuthors",
+	"combinationAuthors", "authorsTeam", "exAuthorsTeam", "emendAuthorsTeam",
+	"sanctioningAuthorsTeam", "author", "authorWord", "year", "cultivarName",
+	"cultivar", "taxonConcept", "qualifier", "publication", "publicationPart",
+	"excludedAuthorship",
+}
+
+func (k NodeKind) String() string {
+	return nodeKinds[k]
+}
+
+// TreeNode is a read-only node of a tree of a parsed name. Changing
+// a tree does not change results of parsing.
+type TreeNode struct {
+	// Kind is the meaning of the node.
+	Kind NodeKind
+	// Value is a normalized value of the node with all its children.
+	Value string
+	// Word is a word of a name-string that corresponds to the node. It is nil
+	// for nodes made of several words and for words implied by the parser,
+	// for example a genus repeated in a hybrid formula.
+	Word *Word
+	// Children are nodes in the order they appear in the name-string.
+	Children []*TreeNode
+}
+
+// Word is a word of a name-string with its verbatim and normalized values,
+// its type and position.
+type Word struct {
+	Verbatim   string
+	Normalized string
+	Type       WordType
+	Start      int
+	End        int
+}
+
+// Visitor's Visit method is invoked for each node of a tree by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of the node with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(n *TreeNode) (w Visitor)
+}
+
+// Walk traverses a tree in depth-first order. It starts by calling
+// v.Visit(n).
+func Walk(v Visitor, n *TreeNode) {
+	if n == nil {
+		return
+	}
+	if v = v.Visit(n); v == nil {
+		return
+	}
+	for _, c := range n.Children {
+		Walk(v, c)
+	}
+	v.Visit(nil)
+}
+
+type inspector func(*TreeNode) bool
+
+func (f inspector) Visit(n *TreeNode) Visitor {
+	if f(n) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses a tree in depth-first order calling f(n) for each node.
+// If f returns true, Inspect continues with the children of n, followed by
+// a call of f(nil).
+func Inspect(n *TreeNode, f func(*TreeNode) bool) {
+	Walk(inspector(f), n)
+}
+
+// Tree returns a read-only tree of the parsed name. It returns nil if
+// the name was not parsed.
+func (sn *ScientificNameNode) Tree() *TreeNode {
+	if sn.Name == nil {
+		return nil
+	}
+	t := TreeNode{Kind: ScientificNameKind, Value: sn.Value()}
+	t.Children = treeNodes(sn.Name.tree(), sn.Pub.tree())
+	for _, v := range sn.Excluded {
+		t.Children = append(t.Children, v.tree())
+	}
+	t.Children = append(t.Children, treeNodes(sn.Concept.tree())...)
+	return &t
+}
+
+// treeNodes returns a slice of given nodes that are not nil.
+func treeNodes(ns ...*TreeNode) []*TreeNode {
+	var res []*TreeNode
+	for _, v := range ns {
+		if v != nil {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func (w *wordNode) tree(k NodeKind) *TreeNode {
+	if w == nil {
+		return nil
+	}
+	// words implied by the parser are not in the name-string
+	if w.Pos.End == 0 {
+		return &TreeNode{Kind: k, Value: w.NormValue}
+	}
+	wrd := Word{
+		Verbatim:   w.Value,
+		Normalized: w.NormValue,
+		Type:       w.Pos.Type,
+		Start:      w.Pos.Start,
+		End:        w.Pos.End,
+	}
+	return &TreeNode{Kind: k, Value: w.NormValue, Word: &wrd}
+}
+
+func (nf *hybridFormulaNode) tree() *TreeNode {
+	if nf == nil {
+		return nil
+	}
+	t := TreeNode{Kind: HybridFormulaKind, Value: nf.value()}
+	t.Children = treeNodes(nf.FirstSpecies.tree())
+	for _, v := range nf.HybridElements {
+		t.Children = append(t.Children,
+			treeNodes(v.HybridChar.tree(HybridCharKind))...)
+		if v.Species != nil {
+			t.Children = append(t.Children, treeNodes(v.Species.tree())...)
+		}
+	}
+	return &t
+}
+
+func (nh *namedGenusHybridNode) tree() *TreeNode {
+	if nh == nil {
+		return nil
+	}
+	t := TreeNode{Kind: NamedHybridKind, Value: nh.value()}
+	var name *TreeNode
+	if nh.Name != nil {
+		name = nh.Name.tree()
+	}
+	t.Children = treeNodes(nh.Hybrid.tree(HybridCharKind), name)
+	return &t
+}
+
+func (nh *namedSpeciesHybridNode) tree() *TreeNode {
+	if nh == nil {
+		return nil
+	}
+	t := TreeNode{Kind: NamedHybridKind, Value: nh.value()}
+	t.Children = treeNodes(
+		nh.Genus.tree(GenusKind),
+		nh.Comparison.tree(AnnotationKind),
+		nh.Hybrid.tree(HybridCharKind),
+		nh.SpEpithet.tree(),
+	)
+	for _, v := range nh.InfraSpecies {
+		t.Children = append(t.Children, treeNodes(v.tree())...)
+	}
+	return &t
+}
+
+func (apr *approxNode) tree() *TreeNode {
+	if apr == nil {
+		return nil
+	}
+	t := TreeNode{Kind: ApproxKind, Value: apr.value()}
+	t.Children = treeNodes(
+		apr.Genus.tree(GenusKind),
+		apr.SpEpithet.tree(),
+		apr.Approx.tree(AnnotationKind),
+	)
+	if apr.Ignored != "" {
+		ign := TreeNode{Kind: IgnoredKind, Value: apr.Ignored}
+		t.Children = append(t.Children, &ign)
+	}
+	return &t
+}
+
+func (comp *comparisonNode) tree() *TreeNode {
+	if comp == nil {
+		return nil
+	}
+	t := TreeNode{Kind: ComparisonKind, Value: comp.value()}
+	t.Children = treeNodes(
+		comp.Genus.tree(GenusKind),
+		comp.Comparison.tree(AnnotationKind),
+		comp.SpEpithet.tree(),
+	)
+	return &t
+}
+
+func (sp *speciesNode) tree() *TreeNode {
+	if sp == nil {
+		return nil
+	}
+	t := TreeNode{Kind: SpeciesKind, Value: sp.value()}
+	t.Children = treeNodes(
+		sp.Genus.tree(GenusKind),
+		sp.SubGenus.tree(SubGenusKind),
+		sp.SpEpithet.tree(),
+	)
+	for _, v := range sp.InfraSpecies {
+		t.Children = append(t.Children, treeNodes(v.tree())...)
+	}
+	return &t
+}
+
+func (sep *spEpithetNode) tree() *TreeNode {
+	if sep == nil {
+		return nil
+	}
+	t := sep.Word.tree(SpEpithetKind)
+	t.Value = sep.value()
+	t.Children = treeNodes(sep.Authorship.tree())
+	return t
+}
+
+func (inf *infraspEpithetNode) tree() *TreeNode {
+	if inf == nil {
+		return nil
+	}
+	t := inf.Word.tree(InfraSpEpithetKind)
+	t.Value = inf.value()
+	var rank *TreeNode
+	if inf.Rank != nil {
+		rank = inf.Rank.Word.tree(RankKind)
+	}
+	t.Children = treeNodes(rank, inf.Authorship.tree())
+	return t
+}
+
+func (u *uninomialNode) tree() *TreeNode {
+	if u == nil {
+		return nil
+	}
+	t := u.Word.tree(UninomialKind)
+	t.Value = u.value()
+	t.Children = treeNodes(u.Authorship.tree())
+	return t
+}
+
+func (u *uninomialComboNode) tree() *TreeNode {
+	if u == nil {
+		return nil
+	}
+	t := TreeNode{Kind: UninomialComboKind, Value: u.value()}
+	var rank *TreeNode
+	if u.Rank != nil {
+		rank = u.Rank.Word.tree(RankKind)
+	}
+	t.Children = treeNodes(u.Uninomial1.tree(), rank, u.Uninomial2.tree())
+	return &t
+}
+
+func (a *authorshipNode) tree() *TreeNode {
+	if a == nil {
+		return nil
+	}
+	t := TreeNode{Kind: AuthorshipKind, Value: a.value()}
+	t.Children = treeNodes(
+		a.OriginalAuthors.tree(OriginalAuthorsKind),
+		a.CombinationAuthors.tree(CombinationAuthorsKind),
+	)
+	return &t
+}
+
+func (ag *authorsGroupNode) tree(k NodeKind) *TreeNode {
+	if ag == nil {
+		return nil
+	}
+	t := TreeNode{Kind: k, Value: ag.value()}
+	t.Children = treeNodes(ag.Team1.tree(AuthorsTeamKind))
+	if ag.Team2 != nil {
+		k2 := ExAuthorsTeamKind
+		if ag.Team2Type != nil && ag.Team2Type.Pos.Type == AuthorWordEmendType {
+			k2 = EmendAuthorsTeamKind
+		}
+		t2 := ag.Team2.tree(k2)
+		if tt := ag.Team2Type.tree(k2); tt != nil {
+			t2.Word = tt.Word
+		}
+		t.Children = append(t.Children, t2)
+	}
+	if ag.Sanctioning != nil {
+		t.Children = append(t.Children,
+			ag.Sanctioning.tree(SanctioningAuthorsTeamKind))
+	}
+	return &t
+}
+
+func (aut *authorsTeamNode) tree(k NodeKind) *TreeNode {
+	if aut == nil {
+		return nil
+	}
+	t := TreeNode{Kind: k, Value: aut.value()}
+	for _, v := range aut.Authors {
+		t.Children = append(t.Children, v.tree())
+	}
+	if aut.Year != nil {
+		t.Children = append(t.Children, aut.Year.Word.tree(YearKind))
+	}
+	return &t
+}
+
+func (aun *authorNode) tree() *TreeNode {
+	t := TreeNode{Kind: AuthorKind, Value: aun.Value}
+	for _, v := range aun.Words {
+		t.Children = append(t.Children, v.tree(AuthorWordKind))
+	}
+	return &t
+}
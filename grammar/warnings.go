@@ -33,6 +33,8 @@ const (
 	NameApproxWarn
 	NameComparisonWarn
 	RankUncommonWarn
+	RankHistoricWarn
+	RankNonStandardWarn
 	SpaceMultipleWarn
 	SpaceNonStandardWarn
 	SpanishAndAsSeparator
@@ -49,4 +51,6 @@ const (
 	YearQuestionWarn
 	YearRangeWarn
 	YearSqBraketsWarn
+	PublicationYearWarn
+	ExcludedAuthorshipWarn
 )
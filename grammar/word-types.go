@@ -22,4 +22,16 @@ const (
 	UninomialType
 	YearApproximateType
 	YearType
+	CultivarType
+	CultivarGroupType
+	GrexType
+	TaxonConceptType
+	PubTitleType
+	PubEditionType
+	PubVolumeType
+	PubIssueType
+	PubPagesType
+	PubYearType
+	AuthorWordSanctioningType
+	ExclusionType
 )
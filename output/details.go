@@ -0,0 +1,172 @@
+package output
+
+import (
+	"fmt"
+	"unsafe"
+
+	grm "github.com/gnames/gnparser/grammar"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Details of Output are kept as one typed value, but in JSON they are
+// an array of elements to stay compatible with previous versions of
+// the output.
+func init() {
+	jsoniter.RegisterFieldEncoderFunc("output.Output", "Details",
+		encodeDetails, detailsIsEmpty)
+	jsoniter.RegisterFieldDecoderFunc("output.Output", "Details",
+		decodeDetails)
+}
+
+func encodeDetails(ptr unsafe.Pointer, stream *jsoniter.Stream) {
+	d := *(*grm.Details)(ptr)
+	ds := []grm.Details{d}
+	if hf, ok := d.(*grm.HybridFormulaOutput); ok {
+		ds = hf.Elements
+	}
+	res := make([]interface{}, len(ds))
+	for i, v := range ds {
+		res[i] = typedElement(v)
+	}
+	stream.WriteVal(res)
+}
+
+// typedElement adds the type of details to their JSON, so decoding does
+// not depend on the fields that details have.
+func typedElement(d grm.Details) interface{} {
+	t := d.DetailsType().String()
+	switch v := d.(type) {
+	case *grm.UninomialOutput:
+		return struct {
+			Type string `json:"detailsType"`
+			*grm.UninomialOutput
+		}{t, v}
+	case *grm.SpeciesOutput:
+		return struct {
+			Type string `json:"detailsType"`
+			*grm.SpeciesOutput
+		}{t, v}
+	case *grm.ComparisonOutput:
+		return struct {
+			Type string `json:"detailsType"`
+			*grm.ComparisonOutput
+		}{t, v}
+	case *grm.ApproxOutput:
+		return struct {
+			Type string `json:"detailsType"`
+			*grm.ApproxOutput
+		}{t, v}
+	case *grm.CultivarOutput:
+		return struct {
+			Type string `json:"detailsType"`
+			*grm.CultivarOutput
+		}{t, v}
+	}
+	return d
+}
+
+func detailsIsEmpty(ptr unsafe.Pointer) bool {
+	return *(*grm.Details)(ptr) == nil
+}
+
+func decodeDetails(ptr unsafe.Pointer, iter *jsoniter.Iterator) {
+	var raws []jsoniter.RawMessage
+	iter.ReadVal(&raws)
+	if iter.Error != nil {
+		return
+	}
+	d, err := detailsFromJSON(raws)
+	if err != nil {
+		iter.ReportError("decode details", err.Error())
+		return
+	}
+	*(*grm.Details)(ptr) = d
+}
+
+// detailsFromJSON restores typed details from JSON elements. Several
+// elements belong to a hybrid formula.
+func detailsFromJSON(raws []jsoniter.RawMessage) (grm.Details, error) {
+	ds := make([]grm.Details, len(raws))
+	for i, raw := range raws {
+		d, err := detailsElement(raw)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+	switch len(ds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return ds[0], nil
+	default:
+		return &grm.HybridFormulaOutput{Elements: ds}, nil
+	}
+}
+
+// detailsElement converts JSON to the type of details given by their
+// detailsType field. JSON of previous versions does not have this field,
+// then the type is found from the fields of details.
+func detailsElement(raw jsoniter.RawMessage) (grm.Details, error) {
+	var dt struct {
+		Type string `json:"detailsType"`
+	}
+	err := jsoniter.Unmarshal(raw, &dt)
+	if err != nil {
+		return nil, err
+	}
+	if dt.Type == "" {
+		dt.Type, err = detailsTypeByFields(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var d grm.Details
+	switch dt.Type {
+	case grm.UninomialDetails.String():
+		d = &grm.UninomialOutput{}
+	case grm.SpeciesDetails.String():
+		d = &grm.SpeciesOutput{}
+	case grm.ComparisonDetails.String():
+		d = &grm.ComparisonOutput{}
+	case grm.ApproxDetails.String():
+		d = &grm.ApproxOutput{}
+	case grm.CultivarDetails.String():
+		d = &grm.CultivarOutput{}
+	default:
+		return nil, fmt.Errorf("unknown details type '%s': %s", dt.Type,
+			string(raw))
+	}
+	err = jsoniter.Unmarshal(raw, d)
+	return d, err
+}
+
+// detailsTypeByFields finds the type of details that do not have
+// detailsType field. Comparisons and approximations both have
+// annotationIdentification, comparisons have 'cf' or 'cf.' there.
+func detailsTypeByFields(raw jsoniter.RawMessage) (string, error) {
+	var fs map[string]jsoniter.RawMessage
+	err := jsoniter.Unmarshal(raw, &fs)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case fs["cultivars"] != nil:
+		return grm.CultivarDetails.String(), nil
+	case fs["uninomial"] != nil:
+		return grm.UninomialDetails.String(), nil
+	case fs["annotationIdentification"] != nil:
+		var ai string
+		err = jsoniter.Unmarshal(fs["annotationIdentification"], &ai)
+		if err != nil {
+			return "", err
+		}
+		if ai == "cf" || ai == "cf." {
+			return grm.ComparisonDetails.String(), nil
+		}
+		return grm.ApproxDetails.String(), nil
+	case fs["genus"] != nil && fs["specificEpithet"] != nil:
+		return grm.SpeciesDetails.String(), nil
+	}
+	return "", fmt.Errorf("cannot find type of details: %s", string(raw))
+}
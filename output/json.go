@@ -2,8 +2,10 @@ package output
 
 import (
 	"bytes"
+	"math"
 
 	grm "github.com/gnames/gnparser/grammar"
+	"github.com/gnames/gnparser/preprocess"
 	"github.com/gnames/gnparser/stemmer"
 	jsoniter "github.com/json-iterator/go"
 )
@@ -30,8 +32,14 @@ type Output struct {
 	CanonicalName *canonical `json:"canonicalName,omitempty"`
 	// Authorship of a name-string, if available.
 	Authorship string `json:"authorship,omitempty"`
-	// Parsing details.
-	Details []interface{} `json:"details,omitempty"`
+	// ExpandedAuthorship is the authorship where abbreviations of authors'
+	// names are substituted by their full names. It is given only if
+	// the parser was asked to expand authors.
+	ExpandedAuthorship string `json:"expandedAuthorship,omitempty"`
+	// Details of parsing. DetailsType method of Details tells which of
+	// the types from the grammar package it contains. In JSON details are
+	// an array with one element per every part of a hybrid formula.
+	Details grm.Details `json:"details,omitempty"`
 	// Positions and a semantic meanings of words in the name-strings.
 	Positions []pos `json:"positions,omitempty"`
 	// Unofficial name-string label (for example names from BOLD project,
@@ -43,6 +51,25 @@ type Output struct {
 	Hybrid bool `json:"hybrid"`
 	// Bacteria is true if a name-string is classified as a bacteria.
 	Bacteria bool `json:"bacteria"`
+	// Code is a nomenclatural code given to the parser. It is empty if the
+	// parser was not limited to a particular code.
+	Code string `json:"code,omitempty"`
+	// NomenclaturalCode is a code inferred from the parsed name, with
+	// confidence and evidence of inference.
+	NomenclaturalCode *nomCode `json:"nomenclaturalCode,omitempty"`
+	// Annotations are nomenclatural status and act annotations of the name,
+	// like 'nom. nud.' or 'comb. nov.'.
+	Annotations []annotation `json:"annotations,omitempty"`
+	// Publication is a short reference to a publication that follows
+	// the authorship, like 'Syst. Nat. ed. 10, 1: 20. 1758'.
+	Publication *grm.PublicationOutput `json:"publication,omitempty"`
+	// ExcludedAuthorship are authorships after 'non' or 'nec', like
+	// 'Jones 1850' in 'Aus bus Smith non Jones 1850'. They belong to
+	// homonyms or misapplications of the name.
+	ExcludedAuthorship []*grm.AuthorshipOutput `json:"excludedAuthorship,omitempty"`
+	// TaxonConcept is a taxon concept qualifier of the name, like
+	// 'sensu Smith 1990', 'auct. non L.' or 's. l.'.
+	TaxonConcept *grm.TaxonConceptOutput `json:"taxonConcept,omitempty"`
 	// Tail is an unparseable tail of a name-string.
 	Tail string `json:"unparsedTail,omitempty"`
 	// NameStringID is a UUID v5 of a verbatim version of a name-string. This
@@ -55,18 +82,23 @@ type Output struct {
 func NewOutput(sn *grm.ScientificNameNode) *Output {
 	var co *canonical
 	var quality int
-	var au string
+	var au, eau string
 	var ws []Warning
 	var ps []pos
 	var hybrid bool
 	var parsed bool
+	var code string
+	if sn.Code != grm.AnyCode {
+		code = sn.Code.String()
+	}
 	det := sn.Details()
 	c := sn.Canonical()
 	if c != nil {
 		co = &canonical{
-			Full:   c.ValueRanked,
-			Simple: c.Value,
-			Stem:   stemmer.StemCanonical(c.Value),
+			Full:              c.ValueRanked,
+			Simple:            c.Value,
+			Stem:              stemmer.StemCanonical(c.Value),
+			IncludesCultivars: c.IncludesCultivars,
 		}
 		ws, quality = qualityAndWarnings(sn.Warnings)
 		ps = convertPos(sn.Pos())
@@ -76,27 +108,37 @@ func NewOutput(sn *grm.ScientificNameNode) *Output {
 		if lastAuthorship != nil {
 			au = lastAuthorship.Value
 		}
+		if sn.ExpandAuthors {
+			eau = sn.ExpandedAuthorship()
+		}
 
 	}
 
 	o := Output{
-		Parsed:        parsed,
-		Quality:       quality,
-		Warnings:      ws,
-		Verbatim:      sn.Verbatim,
-		NameStringID:  sn.VerbatimID,
-		Surrogate:     sn.Surrogate,
-		CanonicalName: co,
-		Virus:         sn.Virus,
-		Hybrid:        hybrid,
-		Normalized:    sn.Value(),
-		Cardinality:   sn.Cardinality,
-		Positions:     ps,
-		Bacteria:      sn.Bacteria,
-		Tail:          sn.Tail,
-		Details:       det,
-		Authorship:    au,
-		ParserVersion: sn.ParserVersion,
+		Parsed:             parsed,
+		Quality:            quality,
+		Warnings:           ws,
+		Verbatim:           sn.Verbatim,
+		NameStringID:       sn.VerbatimID,
+		Surrogate:          sn.Surrogate,
+		CanonicalName:      co,
+		Virus:              sn.Virus,
+		Hybrid:             hybrid,
+		Normalized:         sn.Value(),
+		Cardinality:        sn.Cardinality,
+		Positions:          ps,
+		Bacteria:           sn.Bacteria,
+		Code:               code,
+		NomenclaturalCode:  newNomCode(sn.InferCode()),
+		Annotations:        newAnnotations(sn.NomAnnotations),
+		Publication:        sn.Publication(),
+		ExcludedAuthorship: sn.ExcludedAuthorship(),
+		TaxonConcept:       sn.TaxonConcept(),
+		Tail:               sn.Tail,
+		Details:            det,
+		Authorship:         au,
+		ExpandedAuthorship: eau,
+		ParserVersion:      sn.ParserVersion,
 	}
 	return &o
 }
@@ -130,6 +172,55 @@ type canonical struct {
 	Full   string `json:"full"`
 	Simple string `json:"simple"`
 	Stem   string `json:"stem"`
+	// IncludesCultivars is true if the full canonical form contains cultivar
+	// epithets, cultivar groups or grex names.
+	IncludesCultivars bool `json:"includesCultivars,omitempty"`
+}
+
+type nomCode struct {
+	Code       string   `json:"code"`
+	Confidence float64  `json:"confidence"`
+	Evidence   []string `json:"evidence,omitempty"`
+}
+
+func newNomCode(ci *grm.CodeInference) *nomCode {
+	if ci == nil {
+		return nil
+	}
+	evs := make([]string, len(ci.Evidence))
+	for i, v := range ci.Evidence {
+		evs[i] = v.String()
+	}
+	return &nomCode{
+		Code:       ci.Code.String(),
+		Confidence: math.Round(ci.Confidence*100) / 100,
+		Evidence:   evs,
+	}
+}
+
+type annotation struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Verbatim string `json:"verbatim"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+func newAnnotations(nas []preprocess.NomAnnotation) []annotation {
+	if len(nas) == 0 {
+		return nil
+	}
+	res := make([]annotation, len(nas))
+	for i, v := range nas {
+		res[i] = annotation{
+			Type:     v.Type,
+			Value:    v.Value,
+			Verbatim: v.Verbatim,
+			Start:    v.Start,
+			End:      v.End,
+		}
+	}
+	return res
 }
 
 type pos struct {
@@ -37,6 +37,13 @@ var _ = Describe("Output", func() {
 	)
 })
 
+var _ = Describe("LastAuthorship", func() {
+	It("returns nil for cultivars without a botanical name", func() {
+		o := &Output{Authorship: "Smith", Details: &grm.CultivarOutput{}}
+		Expect(o.LastAuthorship()).To(BeNil())
+	})
+})
+
 var _ = Describe("Private Functions", func() {
 	Describe("prepareWarnings", func() {
 		It("sorts incoming data consistently", func() {
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/gnames/gnparser/grammar"
-	"github.com/gnames/gnparser/stemmer"
 )
 
 type simple struct {
@@ -19,44 +18,112 @@ type simple struct {
 	Authorship      string
 	Year            string
 	Quality         int
+	NomCode         string
+	Annotations     string
+	TaxonConcept    string
 }
 
+// NewSimpleOutput creates a flat output from the abstract syntax tree of
+// a name.
 func NewSimpleOutput(sn *grammar.ScientificNameNode) *simple {
-	ao := sn.LastAuthorship()
-	authorship := ""
-	if ao != nil {
-		authorship = ao.Value
-	}
-	yr := ""
-	if ao != nil && ao.Original.Year != nil {
-		yr = ao.Original.Year.Value
-		if ao.Original.Year.Approximate {
-			yr = fmt.Sprintf("(%s)", yr)
-		}
-	}
+	return newSimpleFromOutput(NewOutput(sn))
+}
 
-	quality := 0
-	c := sn.Canonical()
-	if c == nil {
-		c = &grammar.Canonical{}
-	} else {
-		_, quality = qualityAndWarnings(sn.Warnings)
+// simpleAnnotations joins normalized nomenclatural annotations.
+func simpleAnnotations(as []annotation) string {
+	vs := make([]string, len(as))
+	for i, v := range as {
+		vs[i] = v.Value
 	}
+	return strings.Join(vs, "; ")
+}
 
+// newSimpleFromOutput creates a flat output from the result of parsing.
+func newSimpleFromOutput(o *Output) *simple {
 	so := simple{
-		ID:              sn.VerbatimID,
-		Verbatim:        sn.Verbatim,
-		Cardinality:     sn.Cardinality,
-		CanonicalRanked: c.ValueRanked,
-		Canonical:       c.Value,
-		CanonicalStem:   stemmer.StemCanonical(c.Value),
-		Authorship:      authorship,
-		Year:            yr,
-		Quality:         quality,
+		ID:          o.NameStringID,
+		Verbatim:    o.Verbatim,
+		Cardinality: o.Cardinality,
+		Authorship:  o.Authorship,
+		Year:        o.year(),
+		Quality:     o.Quality,
+		Annotations: simpleAnnotations(o.Annotations),
+	}
+	if o.NomenclaturalCode != nil {
+		so.NomCode = o.NomenclaturalCode.Code
+	}
+	if o.TaxonConcept != nil {
+		so.TaxonConcept = o.TaxonConcept.Value
+	}
+	if o.CanonicalName != nil {
+		so.CanonicalRanked = o.CanonicalName.Full
+		so.Canonical = o.CanonicalName.Simple
+		so.CanonicalStem = o.CanonicalName.Stem
 	}
 	return &so
 }
 
+// ToSlice creates a flat simplified version of the Output, the same as
+// the one used for CSV format.
+func (o *Output) ToSlice() []string {
+	return newSimpleFromOutput(o).ToSlice()
+}
+
+// year returns the year of the last authorship of the name. The year of
+// a publication reference is not used, it is given only in JSON.
+func (o *Output) year() string {
+	ao := o.LastAuthorship()
+	if ao == nil || ao.Original == nil || ao.Original.Year == nil {
+		return ""
+	}
+	yr := ao.Original.Year.Value
+	if ao.Original.Year.Approximate {
+		yr = fmt.Sprintf("(%s)", yr)
+	}
+	return yr
+}
+
+// LastAuthorship finds the authorship of the last element of the name in
+// details. Hybrid formulas do not have such authorship.
+func (o *Output) LastAuthorship() *grammar.AuthorshipOutput {
+	var ao *grammar.AuthorshipOutput
+	if o.Authorship == "" {
+		return nil
+	}
+	d := o.Details
+	if co, ok := d.(*grammar.CultivarOutput); ok {
+		switch {
+		case co.SpeciesOutput != nil:
+			d = co.SpeciesOutput
+		case co.UninomialOutput != nil:
+			d = co.UninomialOutput
+		default:
+			return nil
+		}
+	}
+	switch d := d.(type) {
+	case *grammar.UninomialOutput:
+		ao = d.Uninomial.Authorship
+	case *grammar.SpeciesOutput:
+		ao = d.SpecEpithet.Authorship
+		if l := len(d.InfraSpecies); l > 0 {
+			ao = d.InfraSpecies[l-1].Authorship
+		}
+	case *grammar.ApproxOutput:
+		if d.SpecEpithet != nil {
+			ao = d.SpecEpithet.Authorship
+		}
+	case *grammar.ComparisonOutput:
+		if d.SpecEpithet != nil {
+			ao = d.SpecEpithet.Authorship
+		}
+	}
+	if ao == nil || ao.Value != o.Authorship {
+		return nil
+	}
+	return ao
+}
+
 func CSVHeader() string {
 	header := ([]string{
 		"Id",
@@ -68,6 +135,9 @@ func CSVHeader() string {
 		"Authorship",
 		"Year",
 		"Quality",
+		"NomenclaturalCode",
+		"Annotations",
+		"TaxonConcept",
 	})
 	return strings.Join(header, ",")
 }
@@ -90,6 +160,9 @@ func (so *simple) ToSlice() []string {
 		so.Authorship,
 		yr,
 		qual,
+		so.NomCode,
+		so.Annotations,
+		so.TaxonConcept,
 	}
 	return res
 }
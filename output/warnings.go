@@ -133,6 +133,14 @@ var warningMap = map[grm.Warning]Warning{
 		Quality: 3,
 		Message: "Uncommon rank",
 	},
+	grm.RankHistoricWarn: {
+		Quality: 2,
+		Message: "Historic rank",
+	},
+	grm.RankNonStandardWarn: {
+		Quality: 2,
+		Message: "Non-standard rank",
+	},
 	grm.SpaceMultipleWarn: {
 		Quality: 2,
 		Message: "Multiple adjacent space characters",
@@ -197,6 +205,14 @@ var warningMap = map[grm.Warning]Warning{
 		Quality: 3,
 		Message: "Year with square brakets",
 	},
+	grm.PublicationYearWarn: {
+		Quality: 2,
+		Message: "Publication year differs from authorship year",
+	},
+	grm.ExcludedAuthorshipWarn: {
+		Quality: 1,
+		Message: "Authors after `non` or `nec` belong to a homonym or misapplication",
+	},
 }
 
 func prepareWarnings(ws []grm.Warning) []Warning {
@@ -3,21 +3,33 @@ package output
 import grm "github.com/gnames/gnparser/grammar"
 
 var wordTypeMap = map[grm.WordType]string{
-	grm.UnknownType:          "word",
-	grm.ComparisonType:       "annotationIdentification",
-	grm.ApproxType:           "annotationIdentification",
-	grm.AuthorWordType:       "authorWord",
-	grm.AuthorWordExType:     "authorWord",
-	grm.AuthorWordEmendType:  "authorWord",
-	grm.AuthorWordFiliusType: "authorWordFilius",
-	grm.GenusType:            "genus",
-	grm.HybridCharType:       "hybridChar",
-	grm.InfraSpEpithetType:   "infraspecificEpithet",
-	grm.RankType:             "rank",
-	grm.RankUniType:          "rank",
-	grm.SpEpithetType:        "specificEpithet",
-	grm.SubGenusType:         "infragenericEpithet",
-	grm.UninomialType:        "uninomial",
-	grm.YearApproximateType:  "approximateYear",
-	grm.YearType:             "year",
+	grm.UnknownType:               "word",
+	grm.ComparisonType:            "annotationIdentification",
+	grm.ApproxType:                "annotationIdentification",
+	grm.AuthorWordType:            "authorWord",
+	grm.AuthorWordExType:          "authorWord",
+	grm.AuthorWordEmendType:       "authorWord",
+	grm.AuthorWordFiliusType:      "authorWordFilius",
+	grm.GenusType:                 "genus",
+	grm.HybridCharType:            "hybridChar",
+	grm.InfraSpEpithetType:        "infraspecificEpithet",
+	grm.RankType:                  "rank",
+	grm.RankUniType:               "rank",
+	grm.SpEpithetType:             "specificEpithet",
+	grm.SubGenusType:              "infragenericEpithet",
+	grm.UninomialType:             "uninomial",
+	grm.YearApproximateType:       "approximateYear",
+	grm.YearType:                  "year",
+	grm.CultivarType:              "cultivar",
+	grm.CultivarGroupType:         "cultivarGroup",
+	grm.GrexType:                  "grex",
+	grm.TaxonConceptType:          "taxonConcept",
+	grm.PubTitleType:              "publicationTitle",
+	grm.PubEditionType:            "publicationEdition",
+	grm.PubVolumeType:             "publicationVolume",
+	grm.PubIssueType:              "publicationIssue",
+	grm.PubPagesType:              "publicationPages",
+	grm.PubYearType:               "publicationYear",
+	grm.AuthorWordSanctioningType: "authorWordSanctioning",
+	grm.ExclusionType:             "exclusion",
 }
@@ -170,7 +170,11 @@ type InputArray struct {
 	// be parsed.
 	SkipCleaning bool `protobuf:"varint,2,opt,name=skip_cleaning,json=skipCleaning,proto3" json:"skip_cleaning,omitempty"`
 	// names is a list of name-strings to parse.
-	Names                []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	// code is a nomenclatural code of names: "zoological", "botanical",
+	// "bacterial", "cultivated", "virus" or "any". It settles cases that are
+	// ambiguous without the code. Empty code means "any".
+	Code                 string   `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -222,6 +226,13 @@ func (m *InputArray) GetNames() []string {
 	return nil
 }
 
+func (m *InputArray) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
 type OutputArray struct {
 	// output contains results of parsing. It keeps the same order of output as
 	// the one given in the input.
@@ -311,9 +322,38 @@ type Parsed struct {
 	// detailes_hybrid_formula describes details of hybrids. Hybrid formula
 	// contains several names.
 	DetailsHybridFormula []*HybridFormula `protobuf:"bytes,20,rep,name=details_hybrid_formula,json=detailsHybridFormula,proto3" json:"details_hybrid_formula,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	// idx is the position of the name-string in a stream of input
+	// name-strings, starting from 0.
+	Idx int32 `protobuf:"varint,21,opt,name=idx,proto3" json:"idx,omitempty"`
+	// code is the nomenclatural code used for parsing. It is empty if
+	// the parser was not limited to a particular code.
+	Code string `protobuf:"bytes,22,opt,name=code,proto3" json:"code,omitempty"`
+	// nomenclatural_code is a code inferred from the parsed name. It is nil if
+	// the code was not given and the name has no evidence of a code.
+	NomenclaturalCode *NomenclaturalCode `protobuf:"bytes,23,opt,name=nomenclatural_code,json=nomenclaturalCode,proto3" json:"nomenclatural_code,omitempty"`
+	// cultivars are cultivar epithets, cultivar groups and grex names of
+	// cultivated plants. They are parsed only with "cultivated" code.
+	Cultivars []*Cultivar `protobuf:"bytes,24,rep,name=cultivars,proto3" json:"cultivars,omitempty"`
+	// annotations are nomenclatural status and act annotations of the name,
+	// like 'nom. nud.' or 'comb. nov.'.
+	Annotations []*Annotation `protobuf:"bytes,25,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	// taxon_concept is a taxon concept qualifier of the name, like
+	// 'sensu Smith 1990', 'auct. non L.' or 's. l.'.
+	TaxonConcept *TaxonConcept `protobuf:"bytes,26,opt,name=taxon_concept,json=taxonConcept,proto3" json:"taxon_concept,omitempty"`
+	// publication is a short reference to a publication that follows
+	// the authorship, like 'Syst. Nat. ed. 10, 1: 20. 1758'.
+	Publication *Publication `protobuf:"bytes,27,opt,name=publication,proto3" json:"publication,omitempty"`
+	// excluded_authorship are authorships after 'non' or 'nec', like
+	// 'Jones 1850' in 'Aus bus Smith non Jones 1850'. They belong to
+	// homonyms or misapplications of the name.
+	ExcludedAuthorship []*Authorship `protobuf:"bytes,28,rep,name=excluded_authorship,json=excludedAuthorship,proto3" json:"excluded_authorship,omitempty"`
+	// expanded_authorship is the authorship where abbreviations of authors'
+	// names are substituted by their full names. It is given only if
+	// the parser was asked to expand authors.
+	ExpandedAuthorship   string   `protobuf:"bytes,29,opt,name=expanded_authorship,json=expandedAuthorship,proto3" json:"expanded_authorship,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Parsed) Reset()         { *m = Parsed{} }
@@ -516,6 +556,69 @@ func (m *Parsed) GetDetailsHybridFormula() []*HybridFormula {
 	return nil
 }
 
+func (m *Parsed) GetIdx() int32 {
+	if m != nil {
+		return m.Idx
+	}
+	return 0
+}
+
+func (m *Parsed) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Parsed) GetNomenclaturalCode() *NomenclaturalCode {
+	if m != nil {
+		return m.NomenclaturalCode
+	}
+	return nil
+}
+
+func (m *Parsed) GetCultivars() []*Cultivar {
+	if m != nil {
+		return m.Cultivars
+	}
+	return nil
+}
+
+func (m *Parsed) GetAnnotations() []*Annotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *Parsed) GetTaxonConcept() *TaxonConcept {
+	if m != nil {
+		return m.TaxonConcept
+	}
+	return nil
+}
+
+func (m *Parsed) GetPublication() *Publication {
+	if m != nil {
+		return m.Publication
+	}
+	return nil
+}
+
+func (m *Parsed) GetExcludedAuthorship() []*Authorship {
+	if m != nil {
+		return m.ExcludedAuthorship
+	}
+	return nil
+}
+
+func (m *Parsed) GetExpandedAuthorship() string {
+	if m != nil {
+		return m.ExpandedAuthorship
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Parsed) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -526,6 +629,390 @@ func (*Parsed) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+type Annotation struct {
+	// type is "status" for nomenclatural status and "act" for
+	// nomenclatural act.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// value is the normalized annotation.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// verbatim is the annotation as it is given in the name-string.
+	Verbatim string `protobuf:"bytes,3,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	// start is an offset of the start of the annotation.
+	Start int32 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	// end is an offset of the end of the annotation.
+	End                  int32    `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Annotation) Reset()         { *m = Annotation{} }
+func (m *Annotation) String() string { return proto.CompactTextString(m) }
+func (*Annotation) ProtoMessage()    {}
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{5}
+}
+
+func (m *Annotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Annotation.Unmarshal(m, b)
+}
+func (m *Annotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Annotation.Marshal(b, m, deterministic)
+}
+func (m *Annotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Annotation.Merge(m, src)
+}
+func (m *Annotation) XXX_Size() int {
+	return xxx_messageInfo_Annotation.Size(m)
+}
+func (m *Annotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Annotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Annotation proto.InternalMessageInfo
+
+func (m *Annotation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Annotation) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Annotation) GetVerbatim() string {
+	if m != nil {
+		return m.Verbatim
+	}
+	return ""
+}
+
+func (m *Annotation) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Annotation) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type Cultivar struct {
+	// value is a cultivar epithet, or a name of a cultivar group or a grex.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// type is "cultivar", "cultivarGroup" or "grex".
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cultivar) Reset()         { *m = Cultivar{} }
+func (m *Cultivar) String() string { return proto.CompactTextString(m) }
+func (*Cultivar) ProtoMessage()    {}
+func (*Cultivar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{6}
+}
+
+func (m *Cultivar) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cultivar.Unmarshal(m, b)
+}
+func (m *Cultivar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cultivar.Marshal(b, m, deterministic)
+}
+func (m *Cultivar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cultivar.Merge(m, src)
+}
+func (m *Cultivar) XXX_Size() int {
+	return xxx_messageInfo_Cultivar.Size(m)
+}
+func (m *Cultivar) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cultivar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cultivar proto.InternalMessageInfo
+
+func (m *Cultivar) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Cultivar) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type Publication struct {
+	// value is the normalized reference.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// title is an abbreviated title of the publication.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// edition of the publication, if given.
+	Edition string `protobuf:"bytes,3,opt,name=edition,proto3" json:"edition,omitempty"`
+	// volume of the publication, if given.
+	Volume string `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	// issue of the volume, if given.
+	Issue string `protobuf:"bytes,5,opt,name=issue,proto3" json:"issue,omitempty"`
+	// pages where the name is published.
+	Pages string `protobuf:"bytes,6,opt,name=pages,proto3" json:"pages,omitempty"`
+	// year of the publication, if given.
+	Year string `protobuf:"bytes,7,opt,name=year,proto3" json:"year,omitempty"`
+	// start is an offset of the start of the reference.
+	Start int32 `protobuf:"varint,8,opt,name=start,proto3" json:"start,omitempty"`
+	// end is an offset of the end of the reference.
+	End                  int32    `protobuf:"varint,9,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Publication) Reset()         { *m = Publication{} }
+func (m *Publication) String() string { return proto.CompactTextString(m) }
+func (*Publication) ProtoMessage()    {}
+func (*Publication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{7}
+}
+
+func (m *Publication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Publication.Unmarshal(m, b)
+}
+func (m *Publication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Publication.Marshal(b, m, deterministic)
+}
+func (m *Publication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Publication.Merge(m, src)
+}
+func (m *Publication) XXX_Size() int {
+	return xxx_messageInfo_Publication.Size(m)
+}
+func (m *Publication) XXX_DiscardUnknown() {
+	xxx_messageInfo_Publication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Publication proto.InternalMessageInfo
+
+func (m *Publication) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Publication) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Publication) GetEdition() string {
+	if m != nil {
+		return m.Edition
+	}
+	return ""
+}
+
+func (m *Publication) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *Publication) GetIssue() string {
+	if m != nil {
+		return m.Issue
+	}
+	return ""
+}
+
+func (m *Publication) GetPages() string {
+	if m != nil {
+		return m.Pages
+	}
+	return ""
+}
+
+func (m *Publication) GetYear() string {
+	if m != nil {
+		return m.Year
+	}
+	return ""
+}
+
+func (m *Publication) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Publication) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type TaxonConcept struct {
+	// type is "sensu", "sec", "auct", "sensuLato", "sensuStricto" or
+	// "proParte".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// value is the normalized qualifier with its authors.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// authorship are authors of the concept after 'sensu' or 'sec.'.
+	Authorship *Authorship `protobuf:"bytes,3,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// excluded_authorship are authors after 'non' or 'nec'.
+	ExcludedAuthorship []*Authorship `protobuf:"bytes,4,rep,name=excluded_authorship,json=excludedAuthorship,proto3" json:"excluded_authorship,omitempty"`
+	// start is an offset of the start of the qualifier.
+	Start int32 `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	// end is an offset of the end of the qualifier with its authors.
+	End                  int32    `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaxonConcept) Reset()         { *m = TaxonConcept{} }
+func (m *TaxonConcept) String() string { return proto.CompactTextString(m) }
+func (*TaxonConcept) ProtoMessage()    {}
+func (*TaxonConcept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{8}
+}
+
+func (m *TaxonConcept) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaxonConcept.Unmarshal(m, b)
+}
+func (m *TaxonConcept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaxonConcept.Marshal(b, m, deterministic)
+}
+func (m *TaxonConcept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxonConcept.Merge(m, src)
+}
+func (m *TaxonConcept) XXX_Size() int {
+	return xxx_messageInfo_TaxonConcept.Size(m)
+}
+func (m *TaxonConcept) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxonConcept.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxonConcept proto.InternalMessageInfo
+
+func (m *TaxonConcept) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TaxonConcept) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TaxonConcept) GetAuthorship() *Authorship {
+	if m != nil {
+		return m.Authorship
+	}
+	return nil
+}
+
+func (m *TaxonConcept) GetExcludedAuthorship() []*Authorship {
+	if m != nil {
+		return m.ExcludedAuthorship
+	}
+	return nil
+}
+
+func (m *TaxonConcept) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *TaxonConcept) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type NomenclaturalCode struct {
+	// code is the inferred code. It is "any" if the evidence supports
+	// several codes equally.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// confidence is the weighted share of evidence that supports the code.
+	// It is 1 if the code was given to the parser.
+	Confidence float64 `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// evidence are features of the name that point to a code.
+	Evidence             []string `protobuf:"bytes,3,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NomenclaturalCode) Reset()         { *m = NomenclaturalCode{} }
+func (m *NomenclaturalCode) String() string { return proto.CompactTextString(m) }
+func (*NomenclaturalCode) ProtoMessage()    {}
+func (*NomenclaturalCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{9}
+}
+
+func (m *NomenclaturalCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NomenclaturalCode.Unmarshal(m, b)
+}
+func (m *NomenclaturalCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NomenclaturalCode.Marshal(b, m, deterministic)
+}
+func (m *NomenclaturalCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NomenclaturalCode.Merge(m, src)
+}
+func (m *NomenclaturalCode) XXX_Size() int {
+	return xxx_messageInfo_NomenclaturalCode.Size(m)
+}
+func (m *NomenclaturalCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_NomenclaturalCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NomenclaturalCode proto.InternalMessageInfo
+
+func (m *NomenclaturalCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *NomenclaturalCode) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+func (m *NomenclaturalCode) GetEvidence() []string {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 type HybridFormula struct {
 	// element describes one of names in the hybrid formula.
 	//
@@ -544,7 +1031,7 @@ func (m *HybridFormula) Reset()         { *m = HybridFormula{} }
 func (m *HybridFormula) String() string { return proto.CompactTextString(m) }
 func (*HybridFormula) ProtoMessage()    {}
 func (*HybridFormula) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{5}
+	return fileDescriptor_586948aee85e7dfc, []int{10}
 }
 
 func (m *HybridFormula) XXX_Unmarshal(b []byte) error {
@@ -645,7 +1132,10 @@ type Canonical struct {
 	// simple contains canonical form without ranks.
 	Simple string `protobuf:"bytes,2,opt,name=simple,proto3" json:"simple,omitempty"`
 	// full contains canonical form with ranks.
-	Full                 string   `protobuf:"bytes,3,opt,name=full,proto3" json:"full,omitempty"`
+	Full string `protobuf:"bytes,3,opt,name=full,proto3" json:"full,omitempty"`
+	// includes_cultivars is true if the full canonical form contains cultivar
+	// epithets, cultivar groups or grex names.
+	IncludesCultivars    bool     `protobuf:"varint,4,opt,name=includes_cultivars,json=includesCultivars,proto3" json:"includes_cultivars,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -655,7 +1145,7 @@ func (m *Canonical) Reset()         { *m = Canonical{} }
 func (m *Canonical) String() string { return proto.CompactTextString(m) }
 func (*Canonical) ProtoMessage()    {}
 func (*Canonical) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{6}
+	return fileDescriptor_586948aee85e7dfc, []int{11}
 }
 
 func (m *Canonical) XXX_Unmarshal(b []byte) error {
@@ -697,6 +1187,13 @@ func (m *Canonical) GetFull() string {
 	return ""
 }
 
+func (m *Canonical) GetIncludesCultivars() bool {
+	if m != nil {
+		return m.IncludesCultivars
+	}
+	return false
+}
+
 type Position struct {
 	// type is a semantic meaning of a word.
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -713,7 +1210,7 @@ func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{7}
+	return fileDescriptor_586948aee85e7dfc, []int{12}
 }
 
 func (m *Position) XXX_Unmarshal(b []byte) error {
@@ -770,7 +1267,7 @@ func (m *QualityWarning) Reset()         { *m = QualityWarning{} }
 func (m *QualityWarning) String() string { return proto.CompactTextString(m) }
 func (*QualityWarning) ProtoMessage()    {}
 func (*QualityWarning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{8}
+	return fileDescriptor_586948aee85e7dfc, []int{13}
 }
 
 func (m *QualityWarning) XXX_Unmarshal(b []byte) error {
@@ -813,17 +1310,23 @@ type Uninomial struct {
 	// higher clade of the uninomial, if given.
 	Parent string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
 	// authorship of the uninomial, if given.
-	Authorship           *Authorship `protobuf:"bytes,4,opt,name=authorship,proto3" json:"authorship,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Authorship *Authorship `protobuf:"bytes,4,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// rank inferred from the suffix of the uninomial, if requested.
+	InferredRank *InferredRank `protobuf:"bytes,5,opt,name=inferred_rank,json=inferredRank,proto3" json:"inferred_rank,omitempty"`
+	// rank from the controlled vocabulary, like 'subgenus' for 'subg.'.
+	NormalizedRank string `protobuf:"bytes,6,opt,name=normalized_rank,json=normalizedRank,proto3" json:"normalized_rank,omitempty"`
+	// position of the rank in the hierarchy, lower ranks have bigger levels.
+	RankLevel            int32    `protobuf:"varint,7,opt,name=rank_level,json=rankLevel,proto3" json:"rank_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Uninomial) Reset()         { *m = Uninomial{} }
 func (m *Uninomial) String() string { return proto.CompactTextString(m) }
 func (*Uninomial) ProtoMessage()    {}
 func (*Uninomial) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{9}
+	return fileDescriptor_586948aee85e7dfc, []int{14}
 }
 
 func (m *Uninomial) XXX_Unmarshal(b []byte) error {
@@ -872,6 +1375,85 @@ func (m *Uninomial) GetAuthorship() *Authorship {
 	return nil
 }
 
+func (m *Uninomial) GetInferredRank() *InferredRank {
+	if m != nil {
+		return m.InferredRank
+	}
+	return nil
+}
+
+func (m *Uninomial) GetNormalizedRank() string {
+	if m != nil {
+		return m.NormalizedRank
+	}
+	return ""
+}
+
+func (m *Uninomial) GetRankLevel() int32 {
+	if m != nil {
+		return m.RankLevel
+	}
+	return 0
+}
+
+type InferredRank struct {
+	// rank implied by the suffix, like 'family' for 'Hominidae'.
+	Rank string `protobuf:"bytes,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// confidence of the inference from 0 to 1.
+	Confidence float64 `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// nomenclatural code that uses the suffix for the rank.
+	Code                 string   `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InferredRank) Reset()         { *m = InferredRank{} }
+func (m *InferredRank) String() string { return proto.CompactTextString(m) }
+func (*InferredRank) ProtoMessage()    {}
+func (*InferredRank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{15}
+}
+
+func (m *InferredRank) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InferredRank.Unmarshal(m, b)
+}
+func (m *InferredRank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InferredRank.Marshal(b, m, deterministic)
+}
+func (m *InferredRank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferredRank.Merge(m, src)
+}
+func (m *InferredRank) XXX_Size() int {
+	return xxx_messageInfo_InferredRank.Size(m)
+}
+func (m *InferredRank) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferredRank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferredRank proto.InternalMessageInfo
+
+func (m *InferredRank) GetRank() string {
+	if m != nil {
+		return m.Rank
+	}
+	return ""
+}
+
+func (m *InferredRank) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+func (m *InferredRank) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
 type Species struct {
 	// genux of the name.
 	Genus string `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
@@ -892,7 +1474,7 @@ func (m *Species) Reset()         { *m = Species{} }
 func (m *Species) String() string { return proto.CompactTextString(m) }
 func (*Species) ProtoMessage()    {}
 func (*Species) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{10}
+	return fileDescriptor_586948aee85e7dfc, []int{16}
 }
 
 func (m *Species) XXX_Unmarshal(b []byte) error {
@@ -954,17 +1536,21 @@ type InfraSpecies struct {
 	// rank of the inraspecific epithet.
 	Rank string `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
 	// authorship of the infraspecific epithet.
-	Authorship           *Authorship `protobuf:"bytes,3,opt,name=authorship,proto3" json:"authorship,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Authorship *Authorship `protobuf:"bytes,3,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// rank from the controlled vocabulary, like 'subspecies' for 'ssp.'.
+	NormalizedRank string `protobuf:"bytes,4,opt,name=normalized_rank,json=normalizedRank,proto3" json:"normalized_rank,omitempty"`
+	// position of the rank in the hierarchy, lower ranks have bigger levels.
+	RankLevel            int32    `protobuf:"varint,5,opt,name=rank_level,json=rankLevel,proto3" json:"rank_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InfraSpecies) Reset()         { *m = InfraSpecies{} }
 func (m *InfraSpecies) String() string { return proto.CompactTextString(m) }
 func (*InfraSpecies) ProtoMessage()    {}
 func (*InfraSpecies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{11}
+	return fileDescriptor_586948aee85e7dfc, []int{17}
 }
 
 func (m *InfraSpecies) XXX_Unmarshal(b []byte) error {
@@ -1006,6 +1592,20 @@ func (m *InfraSpecies) GetAuthorship() *Authorship {
 	return nil
 }
 
+func (m *InfraSpecies) GetNormalizedRank() string {
+	if m != nil {
+		return m.NormalizedRank
+	}
+	return ""
+}
+
+func (m *InfraSpecies) GetRankLevel() int32 {
+	if m != nil {
+		return m.RankLevel
+	}
+	return 0
+}
+
 type Comparison struct {
 	// genus of the name.
 	Genus string `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
@@ -1024,7 +1624,7 @@ func (m *Comparison) Reset()         { *m = Comparison{} }
 func (m *Comparison) String() string { return proto.CompactTextString(m) }
 func (*Comparison) ProtoMessage()    {}
 func (*Comparison) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{12}
+	return fileDescriptor_586948aee85e7dfc, []int{18}
 }
 
 func (m *Comparison) XXX_Unmarshal(b []byte) error {
@@ -1094,7 +1694,7 @@ func (m *Approximation) Reset()         { *m = Approximation{} }
 func (m *Approximation) String() string { return proto.CompactTextString(m) }
 func (*Approximation) ProtoMessage()    {}
 func (*Approximation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{13}
+	return fileDescriptor_586948aee85e7dfc, []int{19}
 }
 
 func (m *Approximation) XXX_Unmarshal(b []byte) error {
@@ -1168,7 +1768,7 @@ func (m *Authorship) Reset()         { *m = Authorship{} }
 func (m *Authorship) String() string { return proto.CompactTextString(m) }
 func (*Authorship) ProtoMessage()    {}
 func (*Authorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{14}
+	return fileDescriptor_586948aee85e7dfc, []int{20}
 }
 
 func (m *Authorship) XXX_Unmarshal(b []byte) error {
@@ -1227,17 +1827,22 @@ type AuthGroup struct {
 	// ex_authors are authors of a publication where name was described.
 	ExAuthors *Authors `protobuf:"bytes,4,opt,name=ex_authors,json=exAuthors,proto3" json:"ex_authors,omitempty"`
 	// emend_authors are authors that altered name meaning.
-	EmendAuthors         *Authors `protobuf:"bytes,5,opt,name=emend_authors,json=emendAuthors,proto3" json:"emend_authors,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	EmendAuthors *Authors `protobuf:"bytes,5,opt,name=emend_authors,json=emendAuthors,proto3" json:"emend_authors,omitempty"`
+	// sanctioning_authors are authors who sanctioned a name of fungi, like
+	// 'Fr.' in 'Boletus edulis Bull. : Fr.'.
+	SanctioningAuthors *Authors `protobuf:"bytes,6,opt,name=sanctioning_authors,json=sanctioningAuthors,proto3" json:"sanctioning_authors,omitempty"`
+	// author_details are structured names of the authors.
+	AuthorDetails        []*Author `protobuf:"bytes,7,rep,name=author_details,json=authorDetails,proto3" json:"author_details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *AuthGroup) Reset()         { *m = AuthGroup{} }
 func (m *AuthGroup) String() string { return proto.CompactTextString(m) }
 func (*AuthGroup) ProtoMessage()    {}
 func (*AuthGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{15}
+	return fileDescriptor_586948aee85e7dfc, []int{21}
 }
 
 func (m *AuthGroup) XXX_Unmarshal(b []byte) error {
@@ -1293,23 +1898,39 @@ func (m *AuthGroup) GetEmendAuthors() *Authors {
 	return nil
 }
 
+func (m *AuthGroup) GetSanctioningAuthors() *Authors {
+	if m != nil {
+		return m.SanctioningAuthors
+	}
+	return nil
+}
+
+func (m *AuthGroup) GetAuthorDetails() []*Author {
+	if m != nil {
+		return m.AuthorDetails
+	}
+	return nil
+}
+
 type Authors struct {
 	// authors is a list of authors.
 	Authors []string `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
 	// year of the publication.
 	Year string `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
 	// approximate_year is true if exact year of the publication is uncertain.
-	ApproximateYear      bool     `protobuf:"varint,3,opt,name=approximate_year,json=approximateYear,proto3" json:"approximate_year,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ApproximateYear bool `protobuf:"varint,3,opt,name=approximate_year,json=approximateYear,proto3" json:"approximate_year,omitempty"`
+	// author_details are structured names of the authors.
+	AuthorDetails        []*Author `protobuf:"bytes,4,rep,name=author_details,json=authorDetails,proto3" json:"author_details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Authors) Reset()         { *m = Authors{} }
 func (m *Authors) String() string { return proto.CompactTextString(m) }
 func (*Authors) ProtoMessage()    {}
 func (*Authors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_586948aee85e7dfc, []int{16}
+	return fileDescriptor_586948aee85e7dfc, []int{22}
 }
 
 func (m *Authors) XXX_Unmarshal(b []byte) error {
@@ -1351,6 +1972,127 @@ func (m *Authors) GetApproximateYear() bool {
 	return false
 }
 
+func (m *Authors) GetAuthorDetails() []*Author {
+	if m != nil {
+		return m.AuthorDetails
+	}
+	return nil
+}
+
+type Author struct {
+	// value is the normalized name of the author, like 'L. fil.'.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// surname of the author, often abbreviated, like 'L.' or 'Hoeven'.
+	Surname string `protobuf:"bytes,2,opt,name=surname,proto3" json:"surname,omitempty"`
+	// initials of given names of the author, like 'D. M.'.
+	Initials string `protobuf:"bytes,3,opt,name=initials,proto3" json:"initials,omitempty"`
+	// prefix is a particle before the surname, like 'van der'.
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// filius is true if the author is a son of an author with the same name.
+	Filius bool `protobuf:"varint,5,opt,name=filius,proto3" json:"filius,omitempty"`
+	// et_al is true if the name is followed by 'et al.'.
+	EtAl bool `protobuf:"varint,6,opt,name=et_al,json=etAl,proto3" json:"et_al,omitempty"`
+	// unknown is true for unknown authors, like 'anon.' or '?'.
+	Unknown bool `protobuf:"varint,7,opt,name=unknown,proto3" json:"unknown,omitempty"`
+	// expanded is the full name of the author if the name is a known
+	// abbreviation, like 'Carl Linnaeus' for 'L.'.
+	Expanded string `protobuf:"bytes,8,opt,name=expanded,proto3" json:"expanded,omitempty"`
+	// key is a normalized surname of the author for comparison with other
+	// authors, like 'linnaeus' for 'L.' and 'Linnaeus'.
+	Key                  string   `protobuf:"bytes,9,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Author) Reset()         { *m = Author{} }
+func (m *Author) String() string { return proto.CompactTextString(m) }
+func (*Author) ProtoMessage()    {}
+func (*Author) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586948aee85e7dfc, []int{23}
+}
+
+func (m *Author) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Author.Unmarshal(m, b)
+}
+func (m *Author) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Author.Marshal(b, m, deterministic)
+}
+func (m *Author) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Author.Merge(m, src)
+}
+func (m *Author) XXX_Size() int {
+	return xxx_messageInfo_Author.Size(m)
+}
+func (m *Author) XXX_DiscardUnknown() {
+	xxx_messageInfo_Author.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Author proto.InternalMessageInfo
+
+func (m *Author) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Author) GetSurname() string {
+	if m != nil {
+		return m.Surname
+	}
+	return ""
+}
+
+func (m *Author) GetInitials() string {
+	if m != nil {
+		return m.Initials
+	}
+	return ""
+}
+
+func (m *Author) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *Author) GetFilius() bool {
+	if m != nil {
+		return m.Filius
+	}
+	return false
+}
+
+func (m *Author) GetEtAl() bool {
+	if m != nil {
+		return m.EtAl
+	}
+	return false
+}
+
+func (m *Author) GetUnknown() bool {
+	if m != nil {
+		return m.Unknown
+	}
+	return false
+}
+
+func (m *Author) GetExpanded() string {
+	if m != nil {
+		return m.Expanded
+	}
+	return ""
+}
+
+func (m *Author) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("pb.NameType", NameType_name, NameType_value)
 	proto.RegisterType((*Version)(nil), "pb.Version")
@@ -1358,11 +2100,17 @@ func init() {
 	proto.RegisterType((*InputArray)(nil), "pb.InputArray")
 	proto.RegisterType((*OutputArray)(nil), "pb.OutputArray")
 	proto.RegisterType((*Parsed)(nil), "pb.Parsed")
+	proto.RegisterType((*Annotation)(nil), "pb.Annotation")
+	proto.RegisterType((*Cultivar)(nil), "pb.Cultivar")
+	proto.RegisterType((*Publication)(nil), "pb.Publication")
+	proto.RegisterType((*TaxonConcept)(nil), "pb.TaxonConcept")
+	proto.RegisterType((*NomenclaturalCode)(nil), "pb.NomenclaturalCode")
 	proto.RegisterType((*HybridFormula)(nil), "pb.HybridFormula")
 	proto.RegisterType((*Canonical)(nil), "pb.Canonical")
 	proto.RegisterType((*Position)(nil), "pb.Position")
 	proto.RegisterType((*QualityWarning)(nil), "pb.QualityWarning")
 	proto.RegisterType((*Uninomial)(nil), "pb.Uninomial")
+	proto.RegisterType((*InferredRank)(nil), "pb.InferredRank")
 	proto.RegisterType((*Species)(nil), "pb.Species")
 	proto.RegisterType((*InfraSpecies)(nil), "pb.InfraSpecies")
 	proto.RegisterType((*Comparison)(nil), "pb.Comparison")
@@ -1370,89 +2118,128 @@ func init() {
 	proto.RegisterType((*Authorship)(nil), "pb.Authorship")
 	proto.RegisterType((*AuthGroup)(nil), "pb.AuthGroup")
 	proto.RegisterType((*Authors)(nil), "pb.Authors")
+	proto.RegisterType((*Author)(nil), "pb.Author")
 }
 
 func init() { proto.RegisterFile("gnparser.proto", fileDescriptor_586948aee85e7dfc) }
 
 var fileDescriptor_586948aee85e7dfc = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x5e, 0xef, 0xaf, 0x7d, 0xf6, 0x27, 0xee, 0x10, 0x55, 0xa3, 0x22, 0xda, 0xc8, 0x80, 0x48,
-	0x8b, 0x48, 0x4b, 0x11, 0x17, 0xa8, 0x02, 0x69, 0x9b, 0xa6, 0xc9, 0x8a, 0x66, 0x37, 0x4c, 0x9a,
-	0x40, 0xe1, 0xc2, 0x1a, 0xef, 0x4e, 0x93, 0xa1, 0xf6, 0xd8, 0x1d, 0xdb, 0xa5, 0x8b, 0xc4, 0x6b,
-	0x70, 0xc3, 0x05, 0xe2, 0x45, 0xb8, 0xe0, 0x9a, 0x77, 0xe0, 0x55, 0xd0, 0x8c, 0xc7, 0x5e, 0x6f,
-	0x69, 0x95, 0x16, 0x09, 0xee, 0xce, 0xf9, 0xbe, 0xe3, 0x99, 0x73, 0xbe, 0x39, 0x73, 0x3c, 0x30,
-	0x3a, 0x13, 0x09, 0x95, 0x29, 0x93, 0x3b, 0x89, 0x8c, 0xb3, 0x18, 0x35, 0x93, 0xc0, 0xfb, 0x02,
-	0x7a, 0xa7, 0x4c, 0xa6, 0x3c, 0x16, 0x68, 0x13, 0x3a, 0xcf, 0x68, 0x98, 0x33, 0x6c, 0x6d, 0x59,
-	0xdb, 0x0e, 0x29, 0x1c, 0xf4, 0x0e, 0x40, 0x90, 0xf3, 0x70, 0xe1, 0x67, 0x3c, 0x62, 0xb8, 0xa9,
-	0x29, 0x47, 0x23, 0x0f, 0x79, 0xc4, 0xbc, 0x2e, 0xb4, 0x4f, 0x63, 0xbe, 0xf0, 0xce, 0x01, 0x26,
-	0x22, 0xc9, 0xb3, 0xb1, 0x94, 0x74, 0x89, 0xae, 0x41, 0xff, 0xfb, 0x38, 0x48, 0x7d, 0x91, 0x47,
-	0x01, 0x93, 0x7a, 0xc1, 0x0e, 0x01, 0x05, 0x4d, 0x35, 0x82, 0xde, 0x85, 0x61, 0xfa, 0x84, 0x27,
-	0xfe, 0x3c, 0x64, 0x54, 0x70, 0x71, 0xa6, 0x17, 0xb6, 0xc9, 0x40, 0x81, 0xbb, 0x06, 0x53, 0x09,
-	0x09, 0x1a, 0xb1, 0x14, 0xb7, 0xb6, 0x5a, 0x2a, 0x21, 0xed, 0x78, 0x1f, 0x43, 0x7f, 0x96, 0x67,
-	0xd5, 0x56, 0x1e, 0x74, 0x63, 0xed, 0x62, 0x6b, 0xab, 0xb5, 0xdd, 0xbf, 0x0d, 0x3b, 0x49, 0xb0,
-	0x73, 0xa4, 0xca, 0x5c, 0x10, 0xc3, 0x78, 0xbf, 0x75, 0xa1, 0x5b, 0x40, 0xe8, 0x32, 0x74, 0xb5,
-	0x06, 0x0b, 0x9d, 0x94, 0x4d, 0x8c, 0x87, 0x30, 0xf4, 0x9e, 0xe6, 0x34, 0xe4, 0xd9, 0x52, 0xa7,
-	0xd2, 0x21, 0xa5, 0x8b, 0xee, 0xc0, 0x86, 0x31, 0xfd, 0x1f, 0xa8, 0xd4, 0xc9, 0xb6, 0xf4, 0x4e,
-	0x48, 0xed, 0xf4, 0x55, 0x41, 0x7d, 0x5d, 0x30, 0x64, 0xf4, 0x74, 0xcd, 0x47, 0x57, 0xc0, 0x7e,
-	0xc6, 0x64, 0x40, 0x33, 0x1e, 0xe1, 0xb6, 0xd6, 0xae, 0xf2, 0xd1, 0x55, 0x00, 0x11, 0xcb, 0x88,
-	0x86, 0xfc, 0x47, 0xb6, 0xc0, 0x1d, 0xcd, 0xd6, 0x10, 0xf4, 0x21, 0x38, 0x73, 0x2a, 0x62, 0xc1,
-	0xe7, 0x34, 0xc4, 0xdd, 0x2d, 0x6b, 0xbb, 0x7f, 0x7b, 0xa8, 0xb6, 0xdc, 0x2d, 0x41, 0xb2, 0xe2,
-	0xd1, 0x0e, 0x00, 0xcd, 0xb3, 0xf3, 0x58, 0xa6, 0xe7, 0x3c, 0xc1, 0x3d, 0x1d, 0x3d, 0x52, 0xd1,
-	0xe3, 0x0a, 0x25, 0xb5, 0x08, 0x74, 0x03, 0x9c, 0x24, 0x4e, 0x79, 0xc6, 0x63, 0x91, 0x62, 0x5b,
-	0xd7, 0x33, 0xd0, 0xca, 0x19, 0x90, 0xac, 0x68, 0xa5, 0xd9, 0xf9, 0x32, 0x90, 0x7c, 0x81, 0x9d,
-	0x42, 0xb3, 0xc2, 0x53, 0xc5, 0x05, 0x74, 0x9e, 0x31, 0xc9, 0x29, 0x06, 0xcd, 0x54, 0x3e, 0x42,
-	0xd0, 0xce, 0x28, 0x0f, 0x71, 0x5f, 0x97, 0xa5, 0x6d, 0x34, 0x82, 0x26, 0x5f, 0xe0, 0x81, 0x46,
-	0x9a, 0x7c, 0x81, 0xde, 0x87, 0x51, 0xd1, 0x8f, 0xfe, 0xb3, 0xa2, 0x05, 0xf1, 0x50, 0x73, 0xc3,
-	0x02, 0x2d, 0xfb, 0x72, 0x0b, 0xfa, 0x73, 0x2a, 0x17, 0x5c, 0x14, 0xc7, 0x33, 0xd2, 0xc7, 0x53,
-	0x87, 0xd0, 0x75, 0x70, 0x54, 0x6f, 0xf8, 0xd9, 0x32, 0x61, 0x78, 0x63, 0xcb, 0xda, 0x1e, 0x15,
-	0xc5, 0x4c, 0x69, 0xc4, 0x1e, 0x2e, 0x13, 0x46, 0x6c, 0x61, 0x2c, 0xf4, 0x11, 0x38, 0xb9, 0xe0,
-	0x22, 0x8e, 0x38, 0x0d, 0xb1, 0xbb, 0x12, 0xf5, 0xa4, 0x04, 0x0f, 0x1a, 0x64, 0x15, 0x81, 0x3e,
-	0x80, 0x5e, 0x9a, 0xb0, 0x39, 0x67, 0x29, 0xbe, 0xa4, 0x83, 0xfb, 0x2a, 0xf8, 0xb8, 0x80, 0x0e,
-	0x1a, 0xa4, 0x64, 0xd1, 0x2d, 0x80, 0x79, 0x1c, 0x25, 0x54, 0xf2, 0x34, 0x16, 0x18, 0xad, 0xf4,
-	0xdf, 0xad, 0xd0, 0x83, 0x06, 0xa9, 0xc5, 0xa0, 0xcf, 0x60, 0x48, 0x93, 0x44, 0xc6, 0xcf, 0x79,
-	0x44, 0x95, 0xce, 0xf8, 0x2d, 0xfd, 0xd1, 0x25, 0x7d, 0x68, 0x75, 0xe2, 0xa0, 0x41, 0xd6, 0x23,
-	0xd1, 0x3e, 0x5c, 0x5e, 0x30, 0x25, 0x69, 0xea, 0x17, 0x47, 0xe1, 0x3f, 0x8e, 0x65, 0x94, 0x87,
-	0x14, 0x6f, 0xea, 0x93, 0xd4, 0x6b, 0x1c, 0x68, 0xe6, 0x7e, 0x41, 0x90, 0x4d, 0xf3, 0xc1, 0x1a,
-	0x7a, 0xd7, 0x81, 0x9e, 0xc1, 0xbd, 0xbf, 0x2c, 0x18, 0xae, 0x91, 0xeb, 0x52, 0x59, 0x6f, 0x22,
-	0x55, 0xf3, 0x0d, 0xa4, 0x6a, 0xfd, 0x1b, 0xa9, 0xda, 0xaf, 0x2b, 0x95, 0xaa, 0x90, 0x85, 0x2c,
-	0x62, 0x22, 0xf3, 0xbe, 0x04, 0xa7, 0xba, 0x3a, 0xaa, 0x3f, 0xd3, 0x8c, 0x45, 0x66, 0xd6, 0x69,
-	0x5b, 0xf5, 0x79, 0xca, 0xa3, 0x24, 0x2c, 0xc7, 0x9c, 0xf1, 0x54, 0xec, 0xe3, 0x3c, 0x0c, 0x75,
-	0xaa, 0x0e, 0xd1, 0xb6, 0x77, 0x1f, 0xec, 0xf2, 0xaa, 0xe8, 0x5e, 0x57, 0x9d, 0x67, 0xd6, 0x52,
-	0xb6, 0x9a, 0x5d, 0x69, 0x46, 0x65, 0x66, 0xa6, 0x49, 0xe1, 0x20, 0x17, 0x5a, 0x4c, 0x2c, 0xf4,
-	0x42, 0x1d, 0xa2, 0x4c, 0xef, 0x1e, 0x8c, 0xd6, 0x47, 0x48, 0x7d, 0x12, 0x59, 0xeb, 0x93, 0x08,
-	0x43, 0x2f, 0x62, 0x69, 0x4a, 0xcf, 0xca, 0x04, 0x4b, 0xd7, 0xfb, 0x09, 0x9c, 0xea, 0x54, 0x5e,
-	0x31, 0xc7, 0x11, 0xb4, 0x25, 0x15, 0x4f, 0xcc, 0x97, 0xda, 0x36, 0xc3, 0x90, 0x89, 0xcc, 0x94,
-	0x66, 0xbc, 0x17, 0x86, 0x49, 0xfb, 0xa2, 0x61, 0xe2, 0xfd, 0x69, 0x41, 0xcf, 0x1c, 0xb4, 0xda,
-	0xfd, 0x8c, 0x89, 0x3c, 0x2d, 0x77, 0xd7, 0x0e, 0x7a, 0x1b, 0x9c, 0x34, 0x0f, 0xfc, 0x82, 0x29,
-	0x52, 0xb0, 0xd3, 0x3c, 0xd8, 0xd7, 0x24, 0x5e, 0x75, 0x4e, 0x91, 0x47, 0xd5, 0x2a, 0x9f, 0x03,
-	0x32, 0xa6, 0x7f, 0x61, 0x42, 0x97, 0x4c, 0xe4, 0x0a, 0x42, 0x9f, 0xc2, 0x90, 0x8b, 0xc7, 0x92,
-	0xfa, 0xe5, 0xf2, 0x1d, 0x7d, 0x3d, 0x5c, 0xf5, 0xe5, 0x44, 0x11, 0x26, 0x69, 0x32, 0xe0, 0x35,
-	0xcf, 0x3b, 0x87, 0x41, 0x9d, 0x7d, 0x03, 0x41, 0xd7, 0x85, 0x6b, 0x5d, 0x28, 0xdc, 0x2f, 0x16,
-	0xc0, 0xaa, 0xeb, 0x5f, 0xa1, 0x1d, 0x5e, 0xbf, 0x58, 0x17, 0xca, 0xd3, 0x7a, 0x5d, 0x79, 0xae,
-	0xae, 0x5d, 0xc4, 0xe2, 0xf7, 0x54, 0x43, 0xbc, 0xdf, 0x2d, 0x18, 0xae, 0x5d, 0xaf, 0xff, 0x3b,
-	0xc1, 0xf7, 0x5e, 0x76, 0xef, 0x9d, 0x17, 0xa7, 0x21, 0x86, 0x1e, 0x3f, 0x13, 0xb1, 0xac, 0x7e,
-	0xa2, 0xa5, 0xeb, 0xfd, 0x6a, 0x01, 0xd4, 0x96, 0x7b, 0xf9, 0x39, 0x5e, 0x83, 0x3e, 0x0d, 0xc3,
-	0x32, 0x3f, 0xdc, 0xd4, 0x6f, 0x0d, 0xa0, 0x61, 0x68, 0xbe, 0x44, 0xd7, 0xc1, 0x8e, 0x25, 0x3f,
-	0x53, 0x3f, 0x1b, 0x93, 0xfa, 0xb0, 0x4c, 0x7d, 0x5f, 0xc6, 0x79, 0x42, 0x2a, 0x1a, 0xdd, 0x84,
-	0xfe, 0x3c, 0x8e, 0x02, 0x2e, 0xea, 0x63, 0xea, 0x85, 0xe8, 0x7a, 0x84, 0xf7, 0x87, 0x05, 0x4e,
-	0x45, 0xa9, 0x4a, 0xca, 0x34, 0x2c, 0x9d, 0x46, 0xe9, 0xaa, 0x66, 0x5b, 0x32, 0x2a, 0xcb, 0x66,
-	0x53, 0x36, 0xba, 0x0e, 0xee, 0x4a, 0x08, 0xe6, 0x6b, 0xbe, 0xa5, 0x7f, 0xc3, 0x1b, 0x35, 0xfc,
-	0x91, 0x0a, 0xbd, 0x01, 0xc0, 0x9e, 0x57, 0x25, 0xb6, 0x57, 0xe3, 0xd9, 0xd4, 0x48, 0x1c, 0xf6,
-	0xbc, 0x2c, 0xf7, 0x16, 0x0c, 0xd5, 0xbc, 0x5c, 0x54, 0xe1, 0x9d, 0x7f, 0x86, 0x0f, 0x74, 0x84,
-	0xf1, 0xbc, 0x00, 0x7a, 0xe5, 0xc7, 0xff, 0x55, 0x05, 0x37, 0x7e, 0xb6, 0xc0, 0x2e, 0x7f, 0xe7,
-	0xc8, 0x86, 0xf6, 0x74, 0x36, 0xdd, 0x73, 0x1b, 0x68, 0x08, 0xce, 0xc9, 0x74, 0x32, 0x9d, 0x1d,
-	0x4e, 0xc6, 0x0f, 0x5c, 0x0b, 0xf5, 0xa1, 0x77, 0x7c, 0xb4, 0xb7, 0x3b, 0xd9, 0x3b, 0x76, 0x9b,
-	0x68, 0x04, 0xb0, 0x3b, 0x3b, 0x3c, 0x1a, 0x93, 0xc9, 0xf1, 0x6c, 0xea, 0xb6, 0xd0, 0x26, 0xb8,
-	0xe3, 0xa3, 0x23, 0x32, 0xfb, 0xc6, 0x3f, 0x3e, 0x21, 0x64, 0xb6, 0x3f, 0x7e, 0xb8, 0xe7, 0xb6,
-	0xd5, 0x0a, 0x2b, 0xb7, 0x83, 0x5c, 0x18, 0x4c, 0xc7, 0x87, 0x7b, 0xf7, 0xfc, 0x83, 0x47, 0x77,
-	0xc9, 0xe4, 0x9e, 0xdb, 0x45, 0x08, 0x46, 0x85, 0xed, 0xdf, 0x9f, 0x91, 0xc3, 0x93, 0x07, 0x63,
-	0xb7, 0x87, 0x1c, 0xe8, 0x9c, 0x4e, 0xc8, 0xc9, 0xb1, 0x6b, 0xdf, 0xfe, 0x0e, 0xec, 0xfd, 0x69,
-	0xf1, 0x60, 0x41, 0x57, 0xa1, 0x75, 0xca, 0x24, 0xb2, 0x95, 0x54, 0xea, 0x55, 0x7c, 0x45, 0x8b,
-	0x66, 0xde, 0x31, 0x5e, 0x03, 0xdd, 0x04, 0xd0, 0xcf, 0xd0, 0xe2, 0xe5, 0x3a, 0x2a, 0xc6, 0x50,
-	0xf9, 0x92, 0xbd, 0xb2, 0xa1, 0xfc, 0xda, 0xd3, 0xd6, 0x6b, 0xdc, 0xed, 0x7e, 0xdb, 0xde, 0xb9,
-	0x93, 0x04, 0x41, 0x57, 0x3f, 0xd8, 0x3f, 0xf9, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x20, 0x9b, 0x02,
-	0x3d, 0xc2, 0x0b, 0x00, 0x00,
+	// 1832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x73, 0xdc, 0x48,
+	0x19, 0xb6, 0x46, 0xa3, 0x19, 0xe9, 0x1d, 0xcf, 0x78, 0xdc, 0xf1, 0x06, 0x91, 0x25, 0xd9, 0x29,
+	0x01, 0xb5, 0x49, 0xa8, 0x75, 0x3e, 0x60, 0x0f, 0xd4, 0xf2, 0x51, 0x13, 0xdb, 0xb1, 0x5d, 0x95,
+	0x8c, 0x4d, 0x3b, 0x36, 0x2c, 0x1c, 0x54, 0x3d, 0x52, 0xdb, 0x69, 0x2c, 0xb5, 0xb4, 0xfa, 0xf0,
+	0xda, 0x29, 0xfe, 0x06, 0x5c, 0x38, 0xf0, 0x17, 0xa8, 0xe2, 0xce, 0x95, 0x0b, 0x17, 0x8a, 0x1f,
+	0x40, 0xf1, 0x3f, 0x38, 0x50, 0xdd, 0xad, 0x96, 0x34, 0x8e, 0x5d, 0x76, 0xa8, 0x62, 0x6f, 0xfd,
+	0xbc, 0xef, 0xdb, 0xea, 0xf7, 0xab, 0x9f, 0xee, 0x16, 0x8c, 0x4e, 0x78, 0x4a, 0xb2, 0x9c, 0x66,
+	0xeb, 0x69, 0x96, 0x14, 0x09, 0xea, 0xa4, 0x73, 0xef, 0x67, 0xd0, 0x3f, 0xa2, 0x59, 0xce, 0x12,
+	0x8e, 0xd6, 0xc0, 0x3a, 0x23, 0x51, 0x49, 0x5d, 0x63, 0x62, 0x3c, 0x74, 0xb0, 0x02, 0xe8, 0x3e,
+	0xc0, 0xbc, 0x64, 0x51, 0xe8, 0x17, 0x2c, 0xa6, 0x6e, 0x47, 0xaa, 0x1c, 0x29, 0x79, 0xc3, 0x62,
+	0xea, 0xf5, 0xa0, 0x7b, 0x94, 0xb0, 0xd0, 0xfb, 0x1d, 0xc0, 0x2e, 0x4f, 0xcb, 0x62, 0x9a, 0x65,
+	0xe4, 0x02, 0x7d, 0x02, 0x83, 0xdf, 0x26, 0xf3, 0xdc, 0xe7, 0x65, 0x3c, 0xa7, 0x99, 0xfc, 0xa0,
+	0x85, 0x41, 0x88, 0x66, 0x52, 0x82, 0xbe, 0x0b, 0xc3, 0xfc, 0x94, 0xa5, 0x7e, 0x10, 0x51, 0xc2,
+	0x19, 0x3f, 0x91, 0x1f, 0xb6, 0xf1, 0xb2, 0x10, 0x6e, 0x54, 0x32, 0xe1, 0x10, 0x27, 0x31, 0xcd,
+	0x5d, 0x73, 0x62, 0x0a, 0x87, 0x24, 0x40, 0x08, 0xba, 0x41, 0x12, 0x52, 0xb7, 0x2b, 0x5d, 0x91,
+	0x63, 0xef, 0x19, 0x0c, 0xf6, 0xca, 0xa2, 0x5e, 0xde, 0x83, 0x5e, 0x22, 0xa1, 0x6b, 0x4c, 0xcc,
+	0x87, 0x83, 0xe7, 0xb0, 0x9e, 0xce, 0xd7, 0xf7, 0x45, 0xe8, 0x21, 0xae, 0x34, 0xde, 0xdf, 0x1c,
+	0xe8, 0x29, 0x11, 0xba, 0x0b, 0x3d, 0x99, 0x97, 0x50, 0x3a, 0x6a, 0xe3, 0x0a, 0x21, 0x17, 0xfa,
+	0x5f, 0x95, 0x24, 0x62, 0xc5, 0x85, 0x74, 0xcf, 0xc2, 0x1a, 0xa2, 0x2f, 0x60, 0xa5, 0x1a, 0xfa,
+	0x5f, 0x93, 0x4c, 0x06, 0x60, 0xca, 0x95, 0x90, 0x58, 0xe9, 0x17, 0x4a, 0xf5, 0x4b, 0xa5, 0xc1,
+	0xa3, 0xaf, 0x16, 0x30, 0xba, 0x07, 0xf6, 0x19, 0xcd, 0xe6, 0xa4, 0x60, 0x71, 0x15, 0x44, 0x8d,
+	0xd1, 0x03, 0x00, 0x9e, 0x64, 0x31, 0x89, 0xd8, 0x3b, 0x1a, 0xba, 0x96, 0xd4, 0xb6, 0x24, 0xe8,
+	0x07, 0xe0, 0x04, 0x84, 0x27, 0x9c, 0x05, 0x24, 0x72, 0x7b, 0x13, 0xe3, 0xe1, 0xe0, 0xf9, 0x50,
+	0x2c, 0xb9, 0xa1, 0x85, 0xb8, 0xd1, 0xa3, 0x75, 0x00, 0x52, 0x16, 0x6f, 0x93, 0x2c, 0x7f, 0xcb,
+	0x52, 0xb7, 0x2f, 0xad, 0x47, 0xc2, 0x7a, 0x5a, 0x4b, 0x71, 0xcb, 0x02, 0x3d, 0x06, 0x27, 0x4d,
+	0x72, 0x56, 0xb0, 0x84, 0xe7, 0xae, 0x2d, 0xe3, 0x59, 0x96, 0x99, 0xab, 0x84, 0xb8, 0x51, 0x8b,
+	0x9c, 0xbd, 0xbd, 0x98, 0x67, 0x2c, 0x74, 0x1d, 0x95, 0x33, 0x85, 0x44, 0x70, 0x73, 0x12, 0x14,
+	0x34, 0x63, 0xc4, 0x05, 0xa9, 0xa9, 0xb1, 0xa8, 0x5c, 0x41, 0x58, 0xe4, 0x0e, 0x54, 0xe5, 0xc4,
+	0x18, 0x8d, 0xa0, 0xc3, 0x42, 0x77, 0x59, 0x4a, 0x3a, 0x2c, 0x44, 0xdf, 0x87, 0x91, 0xea, 0x51,
+	0xff, 0x4c, 0xb5, 0xa5, 0x3b, 0x94, 0xba, 0xa1, 0x92, 0xea, 0x5e, 0x9d, 0xc0, 0x20, 0x20, 0x59,
+	0xc8, 0xb8, 0x2a, 0xcf, 0x48, 0x96, 0xa7, 0x2d, 0x42, 0x8f, 0xc0, 0x11, 0xfd, 0xe2, 0x17, 0x17,
+	0x29, 0x75, 0x57, 0x26, 0xc6, 0xc3, 0x91, 0x0a, 0x66, 0x46, 0x62, 0xfa, 0xe6, 0x22, 0xa5, 0xd8,
+	0xe6, 0xd5, 0x08, 0x7d, 0x06, 0x4e, 0xc9, 0x19, 0x4f, 0x62, 0x46, 0x22, 0x77, 0xdc, 0x24, 0xf5,
+	0x50, 0x0b, 0x77, 0x96, 0x70, 0x63, 0x81, 0x3e, 0x85, 0x7e, 0x9e, 0xd2, 0x80, 0xd1, 0xdc, 0x5d,
+	0x95, 0xc6, 0x03, 0x61, 0x7c, 0xa0, 0x44, 0x3b, 0x4b, 0x58, 0x6b, 0xd1, 0x53, 0x80, 0x20, 0x89,
+	0x53, 0x92, 0xb1, 0x3c, 0xe1, 0x2e, 0x6a, 0xf2, 0xbf, 0x51, 0x4b, 0x77, 0x96, 0x70, 0xcb, 0x06,
+	0xfd, 0x18, 0x86, 0x24, 0x4d, 0xb3, 0xe4, 0x9c, 0xc5, 0x44, 0xe4, 0xd9, 0xbd, 0x23, 0x27, 0xad,
+	0xca, 0xa2, 0xb5, 0x15, 0x3b, 0x4b, 0x78, 0xd1, 0x12, 0x6d, 0xc3, 0xdd, 0x90, 0x8a, 0x94, 0xe6,
+	0xbe, 0x2a, 0x85, 0x7f, 0x9c, 0x64, 0x71, 0x19, 0x11, 0x77, 0x6d, 0x62, 0xea, 0x6f, 0xec, 0x48,
+	0xcd, 0x4b, 0xa5, 0xc0, 0x6b, 0xd5, 0x84, 0x05, 0x29, 0x1a, 0x83, 0xc9, 0xc2, 0x73, 0xf7, 0x23,
+	0x99, 0x52, 0x31, 0xac, 0x77, 0xdc, 0xdd, 0x66, 0xc7, 0xa1, 0x4d, 0x40, 0x3c, 0x89, 0x29, 0x0f,
+	0x22, 0x52, 0x94, 0x19, 0x89, 0x7c, 0x69, 0xf1, 0x2d, 0xe9, 0xee, 0x47, 0x32, 0xcf, 0x6d, 0xed,
+	0x46, 0x12, 0x52, 0xbc, 0xca, 0x2f, 0x8b, 0x44, 0xc7, 0x05, 0x65, 0x54, 0xb0, 0x33, 0x92, 0xe5,
+	0xae, 0xdb, 0x74, 0xdc, 0x46, 0x25, 0xc4, 0x8d, 0x1a, 0x3d, 0x85, 0x01, 0xe1, 0x3c, 0x29, 0x88,
+	0xea, 0xcf, 0x6f, 0x4f, 0x4c, 0x9d, 0xce, 0x69, 0x2d, 0xc6, 0x6d, 0x13, 0xf4, 0x39, 0x0c, 0x0b,
+	0x72, 0x9e, 0x70, 0x3f, 0x48, 0x78, 0x40, 0xd3, 0xc2, 0xbd, 0x27, 0xdd, 0x1b, 0x8b, 0x39, 0x6f,
+	0x84, 0x62, 0x43, 0xc9, 0xf1, 0x72, 0xd1, 0x42, 0xe8, 0x19, 0x0c, 0xd2, 0x72, 0x1e, 0xb1, 0x40,
+	0x95, 0xe0, 0x63, 0x39, 0x69, 0x45, 0x6e, 0x84, 0x46, 0x8c, 0xdb, 0x36, 0xe8, 0xe7, 0x70, 0x87,
+	0x9e, 0x07, 0x51, 0x19, 0xd2, 0xd0, 0x6f, 0x6d, 0xb9, 0xef, 0xb4, 0x7c, 0xac, 0xa5, 0x18, 0x69,
+	0xd3, 0x46, 0x86, 0x9e, 0x88, 0x0f, 0xa4, 0x84, 0x5f, 0xfa, 0xc0, 0x7d, 0x99, 0x71, 0xa4, 0x55,
+	0xcd, 0x84, 0x17, 0x0e, 0xf4, 0xab, 0xea, 0x79, 0xef, 0x00, 0x9a, 0x0c, 0xc8, 0x4d, 0x26, 0x5a,
+	0xde, 0xa8, 0x36, 0x99, 0x68, 0xf0, 0x9a, 0xd9, 0x3b, 0x6d, 0x66, 0x6f, 0xf3, 0x90, 0x79, 0x89,
+	0x87, 0xd6, 0xc0, 0xca, 0x0b, 0x92, 0x15, 0x92, 0xa0, 0x2c, 0xac, 0x80, 0x68, 0x0d, 0xca, 0x15,
+	0x2d, 0x59, 0x58, 0x0c, 0xbd, 0x1f, 0x81, 0xad, 0x6b, 0x75, 0xcd, 0xf9, 0xa1, 0xfd, 0xe9, 0x34,
+	0xfe, 0x78, 0xff, 0x34, 0x60, 0xd0, 0xca, 0xe5, 0x35, 0x33, 0xd7, 0xc0, 0x2a, 0x58, 0x11, 0xd5,
+	0x5e, 0x4b, 0x20, 0x48, 0x99, 0x86, 0x92, 0x84, 0x2a, 0xa7, 0x35, 0x14, 0x94, 0x74, 0x96, 0x44,
+	0x65, 0xac, 0x8f, 0x86, 0x0a, 0x89, 0xef, 0xb0, 0x3c, 0x2f, 0x69, 0x45, 0xa7, 0x0a, 0x08, 0x69,
+	0x4a, 0x4e, 0x68, 0x2e, 0x59, 0xd4, 0xc1, 0x0a, 0x08, 0x6f, 0x2f, 0x28, 0xc9, 0x24, 0x59, 0x3a,
+	0x58, 0x8e, 0x9b, 0x5c, 0xd8, 0x57, 0xe4, 0xc2, 0x69, 0x72, 0xf1, 0x0f, 0x03, 0x96, 0xdb, 0x6d,
+	0xf5, 0x01, 0xa5, 0x58, 0x64, 0x6a, 0xf3, 0x46, 0xa6, 0xbe, 0xa6, 0xdf, 0xba, 0xb7, 0xee, 0xb7,
+	0x3a, 0x26, 0xeb, 0x8a, 0x98, 0x7a, 0x4d, 0x4c, 0x01, 0xac, 0xbe, 0xb7, 0x91, 0x6b, 0x3e, 0x30,
+	0x5a, 0x7c, 0xf0, 0x40, 0x70, 0x1d, 0x3f, 0x66, 0x21, 0xe5, 0x81, 0x0a, 0xce, 0xc0, 0x2d, 0x89,
+	0x68, 0x36, 0x7a, 0x56, 0x69, 0xd5, 0x71, 0x5e, 0x63, 0xef, 0x5f, 0x06, 0x0c, 0x17, 0x39, 0x68,
+	0x81, 0x91, 0x8d, 0x0f, 0x61, 0xe4, 0xce, 0x07, 0x30, 0xb2, 0xf9, 0xbf, 0x30, 0x72, 0xf7, 0xb6,
+	0x8c, 0x2c, 0xb6, 0x28, 0x8d, 0x68, 0x4c, 0x79, 0xe1, 0xbd, 0x03, 0xa7, 0x3e, 0xa1, 0x45, 0xfa,
+	0xf2, 0x82, 0xc6, 0x3a, 0x7d, 0x62, 0x2c, 0x7a, 0x37, 0x67, 0x71, 0x5a, 0x37, 0x7b, 0x85, 0x84,
+	0xed, 0x71, 0x19, 0x45, 0x55, 0xab, 0xcb, 0x31, 0xfa, 0x0c, 0x10, 0xe3, 0xb2, 0xa2, 0xb9, 0xdf,
+	0xb0, 0x67, 0x57, 0x1e, 0xb6, 0xab, 0x5a, 0xa3, 0x77, 0x65, 0xee, 0xbd, 0x04, 0x5b, 0x1f, 0xe0,
+	0xd7, 0x75, 0xa4, 0x6a, 0x85, 0xce, 0x15, 0xad, 0x60, 0x36, 0xad, 0xb0, 0x09, 0xa3, 0xc5, 0x8b,
+	0x4d, 0xfb, 0x7e, 0x64, 0x2c, 0xde, 0x8f, 0x5c, 0xe8, 0xc7, 0x34, 0xcf, 0xc9, 0x89, 0x8e, 0x47,
+	0x43, 0xef, 0x3f, 0x06, 0x38, 0x75, 0x15, 0xaf, 0xa7, 0x8c, 0x8c, 0xf0, 0x53, 0x4d, 0x19, 0x62,
+	0x5c, 0xdd, 0xd1, 0x28, 0x2f, 0xaa, 0x54, 0x54, 0xe8, 0xd2, 0xce, 0xe9, 0xde, 0xb8, 0x73, 0x3e,
+	0x87, 0x21, 0xe3, 0xc7, 0x34, 0xcb, 0x68, 0xe8, 0xcb, 0x45, 0xac, 0xe6, 0x4c, 0xd8, 0xad, 0x14,
+	0x98, 0xf0, 0x53, 0xbc, 0xcc, 0x5a, 0x08, 0x7d, 0x0a, 0x2b, 0xcd, 0x2d, 0x4c, 0x4d, 0x54, 0xbc,
+	0x31, 0x6a, 0xc4, 0xd2, 0xf0, 0x3e, 0x80, 0xd0, 0xfa, 0x11, 0x3d, 0xa3, 0x91, 0xa4, 0x11, 0x0b,
+	0x3b, 0x42, 0xf2, 0x4a, 0x08, 0xbc, 0x23, 0x58, 0x6e, 0xaf, 0x52, 0x87, 0x6a, 0xb4, 0x42, 0xbd,
+	0x69, 0x2b, 0xe9, 0xed, 0x67, 0xb6, 0x2e, 0xc0, 0x7f, 0x37, 0xa0, 0x5f, 0xf5, 0xbb, 0x48, 0xea,
+	0x09, 0xe5, 0x65, 0xae, 0x93, 0x2a, 0x01, 0xfa, 0x18, 0x9c, 0xbc, 0x9c, 0xfb, 0x4a, 0xa3, 0x32,
+	0x6b, 0xe7, 0xe5, 0x7c, 0x5b, 0x2a, 0xdd, 0x66, 0x03, 0x55, 0xa4, 0x5a, 0x41, 0xf4, 0x53, 0x40,
+	0xd5, 0xd0, 0xbf, 0x31, 0xcf, 0xab, 0x95, 0xe5, 0xf4, 0x72, 0xba, 0x33, 0xe2, 0xeb, 0xcf, 0x5b,
+	0x13, 0xb3, 0x95, 0xee, 0x8c, 0x54, 0x4e, 0xcb, 0x74, 0xd7, 0xc8, 0xfb, 0xb3, 0x21, 0xf3, 0x94,
+	0x91, 0x56, 0x4c, 0xb7, 0x6c, 0x94, 0x0f, 0xa5, 0xd2, 0x2b, 0x2a, 0xdb, 0xbd, 0x45, 0x65, 0xad,
+	0xcb, 0x95, 0xfd, 0xa3, 0x01, 0xd0, 0xb0, 0xc8, 0x35, 0x45, 0x70, 0x17, 0x89, 0xea, 0xc6, 0x3c,
+	0x9b, 0xb7, 0xcd, 0xf3, 0x83, 0x05, 0x62, 0x53, 0x01, 0xb4, 0x24, 0xde, 0x5f, 0x0d, 0x18, 0x2e,
+	0xd0, 0xd5, 0x37, 0xed, 0xe0, 0xf7, 0xae, 0xe2, 0x51, 0xe7, 0xf2, 0x25, 0xd6, 0x85, 0x3e, 0x3b,
+	0xe1, 0x49, 0x56, 0xbf, 0x7d, 0x34, 0xf4, 0xfe, 0x64, 0x00, 0x2c, 0x9e, 0x5f, 0x57, 0xf4, 0xc3,
+	0x27, 0x30, 0x20, 0x51, 0xa4, 0xfd, 0x73, 0x3b, 0xf2, 0x9c, 0x01, 0x12, 0x45, 0xd5, 0x4c, 0xf4,
+	0x08, 0xec, 0x24, 0x63, 0x27, 0xe2, 0x8d, 0xe0, 0x9a, 0xcd, 0xb1, 0x22, 0xd4, 0xdb, 0x59, 0x52,
+	0xa6, 0xb8, 0x56, 0xa3, 0x27, 0x30, 0x08, 0x92, 0x78, 0xce, 0x78, 0x9b, 0xf6, 0x2f, 0x59, 0xb7,
+	0x2d, 0xbc, 0xbf, 0x74, 0xc0, 0xa9, 0x55, 0x22, 0x12, 0xed, 0x86, 0x21, 0xdd, 0xd0, 0xb0, 0xbe,
+	0x62, 0x74, 0x5a, 0x57, 0x8c, 0x47, 0x30, 0x6e, 0x12, 0x41, 0x7d, 0xa9, 0x37, 0x25, 0xa1, 0xaf,
+	0xb4, 0xe4, 0x5f, 0x0a, 0xd3, 0xc7, 0x00, 0xf4, 0xbc, 0x0e, 0xb1, 0xdb, 0x1c, 0x77, 0x55, 0x8c,
+	0xd8, 0xa1, 0xe7, 0x3a, 0xdc, 0xa7, 0x30, 0x14, 0xe7, 0x4f, 0x7d, 0x47, 0x70, 0xad, 0xf7, 0xcd,
+	0x97, 0xa5, 0x85, 0x9e, 0xf1, 0x13, 0xb8, 0x93, 0x13, 0x1e, 0x88, 0x80, 0x18, 0x3f, 0xa9, 0xe7,
+	0xf5, 0xde, 0x9f, 0x87, 0x5a, 0x76, 0x7a, 0xf6, 0x33, 0x18, 0xa9, 0x19, 0x7e, 0x75, 0x37, 0x75,
+	0xfb, 0xcd, 0xfb, 0x5b, 0x19, 0xe1, 0xa1, 0xb2, 0xd8, 0xac, 0x2e, 0xaf, 0xbf, 0x37, 0xa0, 0xaf,
+	0xa7, 0xff, 0xdf, 0x72, 0xf6, 0xbe, 0x5f, 0xdd, 0x9b, 0xfc, 0xfa, 0xb7, 0x01, 0x3d, 0xa5, 0xb9,
+	0xa6, 0xd7, 0xc4, 0x4e, 0x29, 0x33, 0xf1, 0x86, 0xac, 0x77, 0x8a, 0x82, 0xe2, 0xaa, 0xc3, 0x38,
+	0x2b, 0x18, 0x89, 0x34, 0x9b, 0xd6, 0x58, 0x1e, 0x63, 0x19, 0x3d, 0x66, 0xe7, 0xfa, 0x8e, 0xaa,
+	0x90, 0x90, 0x1f, 0xb3, 0x88, 0x95, 0xaa, 0x44, 0x36, 0xae, 0x10, 0xba, 0x03, 0x16, 0x2d, 0xfc,
+	0xea, 0xad, 0x6f, 0xe3, 0x2e, 0x2d, 0xa6, 0x91, 0x58, 0xba, 0xe4, 0xa7, 0x3c, 0xf9, 0x9a, 0xcb,
+	0x03, 0xc6, 0xc6, 0x1a, 0x8a, 0xa5, 0xf5, 0x5b, 0x41, 0xde, 0x56, 0x1d, 0x5c, 0x63, 0x71, 0xa2,
+	0x9f, 0xd2, 0x0b, 0x79, 0x61, 0x75, 0xb0, 0x18, 0x3e, 0xfe, 0x83, 0x01, 0xb6, 0x7e, 0x0e, 0x23,
+	0x1b, 0xba, 0xb3, 0xbd, 0xd9, 0xd6, 0x78, 0x09, 0x0d, 0xc1, 0x39, 0x9c, 0xed, 0xce, 0xf6, 0x5e,
+	0xef, 0x4e, 0x5f, 0x8d, 0x0d, 0x34, 0x80, 0xfe, 0xc1, 0xfe, 0xd6, 0xc6, 0xee, 0xd6, 0xc1, 0xb8,
+	0x83, 0x46, 0x00, 0x1b, 0x7b, 0xaf, 0xf7, 0xa7, 0x78, 0xf7, 0x60, 0x6f, 0x36, 0x36, 0xd1, 0x1a,
+	0x8c, 0xa7, 0xfb, 0xfb, 0x78, 0xef, 0x57, 0xfe, 0xc1, 0x21, 0xc6, 0x7b, 0xdb, 0xd3, 0x37, 0x5b,
+	0xe3, 0xae, 0xf8, 0x42, 0x03, 0x2d, 0x34, 0x86, 0xe5, 0xd9, 0xf4, 0xf5, 0xd6, 0xa6, 0xbf, 0xf3,
+	0xe5, 0x0b, 0xbc, 0xbb, 0x39, 0xee, 0x21, 0x04, 0x23, 0x35, 0xf6, 0x5f, 0xee, 0xe1, 0xd7, 0x87,
+	0xaf, 0xa6, 0xe3, 0x3e, 0x72, 0xc0, 0x3a, 0xda, 0xc5, 0x87, 0x07, 0x63, 0xfb, 0xf9, 0x6f, 0xc0,
+	0xde, 0x9e, 0xa9, 0x07, 0x3f, 0x7a, 0x00, 0xe6, 0x11, 0xcd, 0x90, 0x2d, 0x4a, 0x25, 0xfe, 0x34,
+	0xdd, 0x93, 0x5d, 0x58, 0xfd, 0x07, 0xf0, 0x96, 0xd0, 0x13, 0x00, 0xf9, 0x1b, 0x47, 0xfd, 0xf9,
+	0x19, 0xa9, 0x83, 0x45, 0xff, 0x09, 0xba, 0x27, 0x9f, 0x6d, 0xad, 0x5f, 0x43, 0xde, 0xd2, 0x8b,
+	0xde, 0xaf, 0xbb, 0xeb, 0x5f, 0xa4, 0xf3, 0x79, 0x4f, 0xfe, 0x04, 0xfb, 0xe1, 0x7f, 0x07, 0x00,
+	0xa0, 0x4f, 0x3d, 0x75, 0x16, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
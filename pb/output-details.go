@@ -6,29 +6,31 @@ import (
 )
 
 func details(po *Parsed, o *output.Output) {
-	switch len(o.Details) {
-	case 0:
+	switch d := o.Details.(type) {
+	case nil:
 		return
-	case 1:
-		simpleName(po, o)
+	case *grammar.HybridFormulaOutput:
+		hybridName(po, d)
+	case *grammar.CultivarOutput:
+		cultivarName(po, d)
 	default:
-		hybridName(po, o)
+		simpleName(po, d)
 	}
 }
 
-func simpleName(po *Parsed, o *output.Output) {
-	switch d := o.Details[0].(type) {
+func simpleName(po *Parsed, det grammar.Details) {
+	switch d := det.(type) {
 	case *grammar.UninomialOutput:
-		res := uninomial(po, o, d)
+		res := uninomial(po, d)
 		po.Details = &Parsed_Uninomial{res}
 	case *grammar.SpeciesOutput:
-		res := species(po, o, d)
+		res := species(po, d)
 		po.Details = &Parsed_Species{res}
 	case *grammar.ComparisonOutput:
-		res := comparison(po, o, d)
+		res := comparison(po, d)
 		po.Details = &Parsed_Comparison{res}
 	case *grammar.ApproxOutput:
-		res := approx(po, o, d)
+		res := approx(po, d)
 		po.Details = &Parsed_Approximation{res}
 	}
 	if po.Hybrid {
@@ -36,21 +38,33 @@ func simpleName(po *Parsed, o *output.Output) {
 	}
 }
 
-func hybridName(po *Parsed, o *output.Output) {
-	hf := make([]*HybridFormula, len(o.Details))
-	for i, v := range o.Details {
+func cultivarName(po *Parsed, co *grammar.CultivarOutput) {
+	if co.SpeciesOutput != nil {
+		simpleName(po, co.SpeciesOutput)
+	} else if co.UninomialOutput != nil {
+		simpleName(po, co.UninomialOutput)
+	}
+	po.Cultivars = make([]*Cultivar, len(co.Cultivars))
+	for i, v := range co.Cultivars {
+		po.Cultivars[i] = &Cultivar{Value: v.Value, Type: v.Type}
+	}
+}
+
+func hybridName(po *Parsed, hfo *grammar.HybridFormulaOutput) {
+	hf := make([]*HybridFormula, len(hfo.Elements))
+	for i, v := range hfo.Elements {
 		switch d := v.(type) {
 		case *grammar.UninomialOutput:
-			res := uninomial(po, o, d)
+			res := uninomial(po, d)
 			hf[i] = &HybridFormula{Element: &HybridFormula_Uninomial{res}}
 		case *grammar.SpeciesOutput:
-			res := species(po, o, d)
+			res := species(po, d)
 			hf[i] = &HybridFormula{Element: &HybridFormula_Species{res}}
 		case *grammar.ComparisonOutput:
-			res := comparison(po, o, d)
+			res := comparison(po, d)
 			hf[i] = &HybridFormula{Element: &HybridFormula_Comparison{res}}
 		case *grammar.ApproxOutput:
-			res := approx(po, o, d)
+			res := approx(po, d)
 			hf[i] = &HybridFormula{Element: &HybridFormula_Approximation{res}}
 		}
 	}
@@ -59,12 +73,21 @@ func hybridName(po *Parsed, o *output.Output) {
 	po.DetailsHybridFormula = hf
 }
 
-func uninomial(po *Parsed, o *output.Output,
+func uninomial(po *Parsed,
 	uo *grammar.UninomialOutput) *Uninomial {
 	u := &Uninomial{
-		Value:  uo.Uninomial.Value,
-		Rank:   uo.Uninomial.Rank,
-		Parent: uo.Uninomial.Parent,
+		Value:          uo.Uninomial.Value,
+		Rank:           uo.Uninomial.Rank,
+		NormalizedRank: uo.Uninomial.NormalizedRank,
+		RankLevel:      int32(uo.Uninomial.RankLevel),
+		Parent:         uo.Uninomial.Parent,
+	}
+	if ir := uo.Uninomial.InferredRank; ir != nil {
+		u.InferredRank = &InferredRank{
+			Rank:       ir.Rank,
+			Confidence: ir.Confidence,
+			Code:       ir.Code,
+		}
 	}
 
 	if uo.Uninomial.Authorship != nil {
@@ -76,7 +99,7 @@ func uninomial(po *Parsed, o *output.Output,
 	return u
 }
 
-func species(po *Parsed, o *output.Output,
+func species(po *Parsed,
 	so *grammar.SpeciesOutput) *Species {
 	var au *Authorship
 	s := &Species{
@@ -97,6 +120,7 @@ func species(po *Parsed, o *output.Output,
 		for i, v := range so.InfraSpecies {
 			inf[i] = infraspecies(v)
 		}
+		s.InfraSpecies = inf
 		if inf[len(inf)-1].Authorship != nil {
 			au = inf[len(inf)-1].Authorship
 		}
@@ -108,7 +132,7 @@ func species(po *Parsed, o *output.Output,
 	return s
 }
 
-func comparison(po *Parsed, o *output.Output,
+func comparison(po *Parsed,
 	co *grammar.ComparisonOutput) *Comparison {
 	c := &Comparison{
 		Genus: co.Genus.Value,
@@ -128,7 +152,7 @@ func comparison(po *Parsed, o *output.Output,
 	return c
 }
 
-func approx(po *Parsed, o *output.Output,
+func approx(po *Parsed,
 	ao *grammar.ApproxOutput) *Approximation {
 	po.NameType = NameType_APPROX_SURROGATE
 	a := &Approximation{Genus: ao.Genus.Value}
@@ -151,6 +175,8 @@ func infraspecies(inf *grammar.InfraSpEpithetOutput) *InfraSpecies {
 	res := &InfraSpecies{Value: inf.Value}
 	if inf.Rank != "" {
 		res.Rank = inf.Rank
+		res.NormalizedRank = inf.NormalizedRank
+		res.RankLevel = int32(inf.RankLevel)
 	}
 	if inf.Authorship != nil {
 		res.Authorship = authorship(inf.Authorship)
@@ -186,7 +212,7 @@ func authorship(a *grammar.AuthorshipOutput) *Authorship {
 }
 
 func authGroup(ago *grammar.AuthGroupOutput) (*AuthGroup, []string) {
-	var exAu, emendAu *Authors
+	var exAu, emendAu, sanctAu *Authors
 	var authList []string
 	allAuth := ago.Authors
 
@@ -198,11 +224,17 @@ func authGroup(ago *grammar.AuthGroupOutput) (*AuthGroup, []string) {
 		emendAu, authList = authors(ago.EmendAuthors)
 		allAuth = append(allAuth, authList...)
 	}
+	if ago.SanctioningAuthors != nil {
+		sanctAu, authList = authors(ago.SanctioningAuthors)
+		allAuth = append(allAuth, authList...)
+	}
 
 	ag := &AuthGroup{
-		Authors:      ago.Authors,
-		ExAuthors:    exAu,
-		EmendAuthors: emendAu,
+		Authors:            ago.Authors,
+		AuthorDetails:      authorDetails(ago.AuthorDetails),
+		ExAuthors:          exAu,
+		EmendAuthors:       emendAu,
+		SanctioningAuthors: sanctAu,
 	}
 	if ago.Year != nil {
 		ag.Year = ago.Year.Value
@@ -212,10 +244,32 @@ func authGroup(ago *grammar.AuthGroupOutput) (*AuthGroup, []string) {
 }
 
 func authors(aso *grammar.AuthorsOutput) (*Authors, []string) {
-	as := &Authors{Authors: aso.Authors}
+	as := &Authors{
+		Authors:       aso.Authors,
+		AuthorDetails: authorDetails(aso.AuthorDetails),
+	}
 	if aso.Year != nil {
 		as.Year = aso.Year.Value
 		as.ApproximateYear = aso.Year.Approximate
 	}
 	return as, aso.Authors
 }
+
+func authorDetails(ads []*grammar.AuthorOutput) []*Author {
+	var res []*Author
+	for _, v := range ads {
+		a := &Author{
+			Value:    v.Value,
+			Surname:  v.Surname,
+			Initials: v.Initials,
+			Prefix:   v.Prefix,
+			Filius:   v.Filius,
+			EtAl:     v.EtAl,
+			Unknown:  v.Unknown,
+			Expanded: v.Expanded,
+			Key:      v.Key,
+		}
+		res = append(res, a)
+	}
+	return res
+}
@@ -7,19 +7,26 @@ import (
 
 func ToPB(o *output.Output) *Parsed {
 	po := &Parsed{
-		Parsed:         o.Parsed,
-		Quality:        int32(o.Quality),
-		QualityWarning: qualityWarning(o),
-		Verbatim:       o.Verbatim,
-		Id:             o.NameStringID,
-		Canonical:      canonicalName(o),
-		Hybrid:         o.Hybrid,
-		Normalized:     o.Normalized,
-		Cardinality:    int32(o.Cardinality),
-		Positions:      positions(o),
-		Bacteria:       o.Bacteria,
-		Tail:           o.Tail,
-		ParserVersion:  o.ParserVersion,
+		Parsed:             o.Parsed,
+		Quality:            int32(o.Quality),
+		QualityWarning:     qualityWarning(o),
+		Verbatim:           o.Verbatim,
+		Id:                 o.NameStringID,
+		Canonical:          canonicalName(o),
+		Hybrid:             o.Hybrid,
+		Normalized:         o.Normalized,
+		Cardinality:        int32(o.Cardinality),
+		Positions:          positions(o),
+		Bacteria:           o.Bacteria,
+		Code:               o.Code,
+		NomenclaturalCode:  nomenclaturalCode(o),
+		Annotations:        annotations(o),
+		Publication:        publication(o),
+		ExcludedAuthorship: excludedAuthorship(o),
+		ExpandedAuthorship: o.ExpandedAuthorship,
+		TaxonConcept:       taxonConcept(o),
+		Tail:               o.Tail,
+		ParserVersion:      o.ParserVersion,
 	}
 	details(po, o)
 
@@ -32,15 +39,89 @@ func ToPB(o *output.Output) *Parsed {
 	return po
 }
 
+func nomenclaturalCode(o *output.Output) *NomenclaturalCode {
+	nc := o.NomenclaturalCode
+	if nc == nil {
+		return nil
+	}
+	return &NomenclaturalCode{
+		Code:       nc.Code,
+		Confidence: nc.Confidence,
+		Evidence:   nc.Evidence,
+	}
+}
+
+func annotations(o *output.Output) []*Annotation {
+	if len(o.Annotations) == 0 {
+		return nil
+	}
+	res := make([]*Annotation, len(o.Annotations))
+	for i, v := range o.Annotations {
+		res[i] = &Annotation{
+			Type:     v.Type,
+			Value:    v.Value,
+			Verbatim: v.Verbatim,
+			Start:    int32(v.Start),
+			End:      int32(v.End),
+		}
+	}
+	return res
+}
+
+func publication(o *output.Output) *Publication {
+	po := o.Publication
+	if po == nil {
+		return nil
+	}
+	return &Publication{
+		Value:   po.Value,
+		Title:   po.Title,
+		Edition: po.Edition,
+		Volume:  po.Volume,
+		Issue:   po.Issue,
+		Pages:   po.Pages,
+		Year:    po.Year,
+		Start:   int32(po.Start),
+		End:     int32(po.End),
+	}
+}
+
+func excludedAuthorship(o *output.Output) []*Authorship {
+	var res []*Authorship
+	for _, v := range o.ExcludedAuthorship {
+		res = append(res, authorship(v))
+	}
+	return res
+}
+
+func taxonConcept(o *output.Output) *TaxonConcept {
+	tco := o.TaxonConcept
+	if tco == nil {
+		return nil
+	}
+	tc := &TaxonConcept{
+		Type:       tco.Type,
+		Value:      tco.Value,
+		Authorship: authorship(tco.Authorship),
+		Start:      int32(tco.Start),
+		End:        int32(tco.End),
+	}
+	for _, v := range tco.ExcludedAuthorship {
+		tc.ExcludedAuthorship = append(tc.ExcludedAuthorship, authorship(v))
+	}
+	return tc
+}
+
 func canonicalName(o *output.Output) *Canonical {
 	var cn *Canonical
 	if o.CanonicalName == nil {
 		return cn
 	}
 	cn = &Canonical{
-		Stem:   stemmer.StemCanonical(o.CanonicalName.Simple),
-		Simple: o.CanonicalName.Simple,
-		Full:   o.CanonicalName.Full,
+		Stem:              stemmer.StemCanonical(o.CanonicalName.Simple),
+		Simple:            o.CanonicalName.Simple,
+		Full:              o.CanonicalName.Full,
+		IncludesCultivars: o.CanonicalName.IncludesCultivars,
 	}
 	return cn
 }
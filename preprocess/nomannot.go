@@ -0,0 +1,92 @@
+package preprocess
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+// NomAnnotation is a nomenclatural status or nomenclatural act annotation
+// at the end of a name-string, like 'nom. nud.' or 'comb. nov.'.
+type NomAnnotation struct {
+	// Type is "status" for nomenclatural status, "act" for nomenclatural
+	// act.
+	Type string
+	// Value is a normalized annotation.
+	Value string
+	// Verbatim is the annotation as it is given in the name-string.
+	Verbatim string
+	// Start is the offset of the annotation in the name-string in runes.
+	Start int
+	// End is the offset of the end of the annotation in runes.
+	End int
+}
+
+type nomAnnotRule struct {
+	re    *regexp.Regexp
+	tp    string
+	value string
+}
+
+func newNomAnnotRule(re string, tp string, value string) nomAnnotRule {
+	return nomAnnotRule{
+		re:    regexp.MustCompile(`(?i)(,\s*|\s+)\(?(` + re + `)\)?\s*$`),
+		tp:    tp,
+		value: value,
+	}
+}
+
+var nomAnnotRules = []nomAnnotRule{
+	newNomAnnotRule(`nom(en|\.)?\s*nud(um|\.)?`, "status", "nom. nud."),
+	newNomAnnotRule(`nom(en|\.)?\s*illeg(itimum|\.)?`, "status", "nom. illeg."),
+	newNomAnnotRule(`nom(en|\.)?\s*inval(idum|\.)?`, "status", "nom. inval."),
+	newNomAnnotRule(`nom(en|\.)?\s*cons(ervandum|\.)?`, "status", "nom. cons."),
+	newNomAnnotRule(`nom(en|\.)?\s*rej(iciendum|\.)?`, "status", "nom. rej."),
+	newNomAnnotRule(`nom(en|\.)?\s*dub(ium|\.)?`, "status", "nom. dub."),
+	newNomAnnotRule(`nom(en|\.)?\s*superfl(uum|\.)?`, "status", "nom. superfl."),
+	newNomAnnotRule(`nom(en|\.)?\s*oblit(um|\.)?`, "status", "nom. oblit."),
+	newNomAnnotRule(`ined\.?`, "status", "ined."),
+	newNomAnnotRule(`nom(en|\.)?\s*nov(um|\.)?`, "act", "nom. nov."),
+	newNomAnnotRule(`comb(inatio|\.)?\s*(nov(a|\.)?|n\.)`, "act", "comb. nov."),
+	newNomAnnotRule(`stat(us|\.)?\s*(nov(us|\.)?|n\.)`, "act", "stat. nov."),
+	newNomAnnotRule(`syn\.?\s*(nov\.?|n\.)`, "act", "syn. nov."),
+	newNomAnnotRule(`(sp\.|spec\.|species)\s*(nov(a|\.)?|n\.)|n\.\s*sp\.`,
+		"act", "sp. nov."),
+	newNomAnnotRule(`(subsp|ssp)\.\s*(nov\.?|n\.)`, "act", "subsp. nov."),
+	newNomAnnotRule(`var\.\s*(nov\.?|n\.)`, "act", "var. nov."),
+	newNomAnnotRule(`(gen\.|genus)\s*(nov(um|\.)?|n\.)|n\.\s*gen\.`,
+		"act", "gen. nov."),
+	newNomAnnotRule(`fam\.\s*(nov\.?|n\.)`, "act", "fam. nov."),
+}
+
+// NomAnnotations finds nomenclatural annotations at the end of
+// a name-string. It returns the annotations in the order they appear and
+// the index where they start. If there are no annotations, the index is
+// the end of the input.
+func NomAnnotations(bs []byte) ([]NomAnnotation, int) {
+	var res []NomAnnotation
+	i := len(bs)
+	for {
+		var found bool
+		for _, r := range nomAnnotRules {
+			loc := r.re.FindSubmatchIndex(bs[0:i])
+			if len(loc) == 0 {
+				continue
+			}
+			start, end := loc[4], loc[5]
+			na := NomAnnotation{
+				Type:     r.tp,
+				Value:    r.value,
+				Verbatim: string(bs[start:end]),
+				Start:    utf8.RuneCount(bs[0:start]),
+				End:      utf8.RuneCount(bs[0:end]),
+			}
+			res = append([]NomAnnotation{na}, res...)
+			i = loc[0]
+			found = true
+			break
+		}
+		if !found {
+			return res, i
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 var hybridCharRe1 = regexp.MustCompile(`(^)[Xx](\p{Lu})`)
@@ -14,6 +15,12 @@ var hybridCharRe2 = regexp.MustCompile(`(\s|^)[Xx](\s|$)`)
 var notesRe = regexp.MustCompile(
 	`(?i)\s+(species\s+group|species\s+complex|group|author)\b.*$`,
 )
+
+// cultivarGroupRe finds cultivar groups, like 'Tardiana Group', in names
+// that are not cultivated plants, so their words do not become authors.
+var cultivarGroupRe = regexp.MustCompile(
+	`\s+\(?(\p{Lu}[^\s.()'"]*\s+)+Group\b.*$`,
+)
 var taxonConceptsRe1 = regexp.MustCompile(
 	`(?i)\s+(sensu|auct|sec|near|str)\.?\b.*$`,
 )
@@ -23,6 +30,13 @@ var taxonConceptsRe2 = regexp.MustCompile(
 var taxonConceptsRe3 = regexp.MustCompile(
 	`(?i)(,\s*|\s+)(pro parte|p\.\s?p\.)\s*$`,
 )
+var taxonConceptStartRe = regexp.MustCompile(
+	`(,\s*|\s+)\(?((?i:sensu|sec|auct|auctt|auctorum)\b|` +
+		`s\.\s?(l|lat|s|str)\.|(?i:pro\s+parte)|[pP]\.\s?[pP]\.)`,
+)
+var trailingYearRe = regexp.MustCompile(
+	`(,\s*|\s+)\(?[12][0789]\d\d\??\)?\s*$`,
+)
 var nomenConceptsRe = regexp.MustCompile(
 	`(?i)(,\s*|\s+)(\(?(nomen|nom\.|comb\.)(\s.*)?)$`,
 )
@@ -36,19 +50,49 @@ var stopWordsRe = regexp.MustCompile(
 	`\s+(of[\W_]|\(?ht\.?\W|\(?hort\.?\W|spec\.|nov\s+spec|cv\.?\W).*$`,
 )
 
+// notesCultivarRe and stopWordsCultivarRe keep cultivar epithets and
+// cultivar groups of cultivated plants.
+var notesCultivarRe = regexp.MustCompile(
+	`(?i)\s+(species\s+group|species\s+complex|author)\b.*$`,
+)
+var stopWordsCultivarRe = regexp.MustCompile(
+	`\s+(of[\W_]|\(?ht\.?\W|\(?hort\.?\W|spec\.|nov\s+spec).*$`,
+)
+
 type Preprocessor struct {
 	Virus       bool
 	Underscore  bool
 	NoParse     bool
 	Approximate bool
 	Annotation  bool
-	Body        []byte
-	Tail        []byte
+	// TaxonConcept is true if Body ends with a taxon concept qualifier,
+	// like 'sensu Smith' or 's. l.'.
+	TaxonConcept bool
+	Body         []byte
+	Tail         []byte
+	// NomAnnotations are nomenclatural annotations from the end of
+	// the name-string. They are not a part of Body or Tail.
+	NomAnnotations []NomAnnotation
+	// GapStart is the position in runes where annotations followed by
+	// a taxon concept or a year were removed from Body.
+	GapStart int
+	// GapLen is the length in runes of annotations removed from Body.
+	GapLen int
 }
 
 // Preprocess runs a series of regular expressions over the input to determine
 // features of the input before parsing.
 func Preprocess(bs []byte) *Preprocessor {
+	return preprocess(bs, false)
+}
+
+// PreprocessCultivars works like Preprocess, but keeps cultivar epithets,
+// cultivar groups and grex names of cultivated plants for parsing.
+func PreprocessCultivars(bs []byte) *Preprocessor {
+	return preprocess(bs, true)
+}
+
+func preprocess(bs []byte, cultivars bool) *Preprocessor {
 	pr := &Preprocessor{}
 	if len(bs) == 0 {
 		pr.NoParse = true
@@ -67,8 +111,16 @@ func Preprocess(bs []byte) *Preprocessor {
 	if pr.NoParse {
 		return pr
 	}
-	j := Annotation(bs[0:i])
-	if j < i {
+	pr.NomAnnotations, i = NomAnnotations(bs[0:i])
+	if len(pr.NomAnnotations) == 0 {
+		bs = pr.cutNomAnnotations(bs)
+		i = len(bs)
+	}
+	end := i
+	j := annotation(bs[0:i], cultivars)
+	if j < i && TaxonConcept(bs[0:i]) <= j {
+		pr.TaxonConcept = true
+	} else if j < i {
 		pr.Annotation = true
 		i = j
 	}
@@ -80,10 +132,33 @@ func Preprocess(bs []byte) *Preprocessor {
 	}
 
 	pr.Body = NormalizeHybridChar(bs[0:i])
-	pr.Tail = bs[i:]
+	pr.Tail = bs[i:end]
 	return pr
 }
 
+// cutNomAnnotations removes nomenclatural annotations that are followed
+// by a taxon concept or a year, like 'nom. nud.' in
+// 'Aus bus Smith nom. nud. 1888', and returns the rest of the name-string.
+func (pr *Preprocessor) cutNomAnnotations(bs []byte) []byte {
+	k := TaxonConcept(bs)
+	if loc := trailingYearRe.FindIndex(bs); len(loc) > 0 && loc[0] < k {
+		k = loc[0]
+	}
+	if k == len(bs) {
+		return bs
+	}
+	nas, i := NomAnnotations(bs[0:k])
+	if len(nas) == 0 {
+		return bs
+	}
+	pr.NomAnnotations = nas
+	pr.GapStart = utf8.RuneCount(bs[0:i])
+	pr.GapLen = utf8.RuneCount(bs[i:k])
+	res := make([]byte, 0, len(bs)-(k-i))
+	res = append(res, bs[0:i]...)
+	return append(res, bs[k:]...)
+}
+
 // LikeVirus takes a string and checks it against known species that can
 // easily be misparsed as viruses. If the string belongs to one of such species
 // returns true.
@@ -149,10 +224,19 @@ func NormalizeHybridChar(bs []byte) []byte {
 // the full string can be parsed, returns returns the index of the end of the
 // input.
 func Annotation(bs []byte) int {
+	return annotation(bs, false)
+}
+
+func annotation(bs []byte, cultivars bool) int {
 	i := len(bs)
 	regexps := []*regexp.Regexp{
-		notesRe, taxonConceptsRe1, taxonConceptsRe2, taxonConceptsRe3,
-		nomenConceptsRe, lastWordJunkRe, stopWordsRe,
+		cultivarGroupRe, notesRe, taxonConceptsRe1, taxonConceptsRe2,
+		taxonConceptsRe3, nomenConceptsRe, lastWordJunkRe, stopWordsRe,
+	}
+	if cultivars {
+		regexps = regexps[1:]
+		regexps[0] = notesCultivarRe
+		regexps[len(regexps)-1] = stopWordsCultivarRe
 	}
 	for _, r := range regexps {
 		loc := r.FindIndex(bs[0:i])
@@ -163,6 +247,17 @@ func Annotation(bs []byte) int {
 	return i
 }
 
+// TaxonConcept returns index where a taxon concept qualifier starts. Such
+// qualifiers are parsed with the name. If there is no qualifier, returns the
+// index of the end of the input.
+func TaxonConcept(bs []byte) int {
+	loc := taxonConceptStartRe.FindIndex(bs)
+	if len(loc) == 0 {
+		return len(bs)
+	}
+	return loc[0]
+}
+
 // UnderscoreToSpace takes a slice of bytes. If it finds that the string
 // contains underscores, but not spaces, it substitutes underscores to spaces
 // in the slice. In case if any spaces are present, the slice is returned
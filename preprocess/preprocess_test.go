@@ -192,6 +192,96 @@ var _ = Describe("Preprocess", func() {
 		Entry("No tail", "Homo sapiens s. s.", "Homo sapiens", " s. s."),
 		Entry("No tail", "Homo sapiens sensu Linn.", "Homo sapiens", " sensu Linn."),
 		Entry("No tail", "Homo sapiens nomen nudum", "Homo sapiens", " nomen nudum"),
+		Entry("Group", "Hosta Tardiana Group", "Hosta", " Tardiana Group"),
+		Entry("Group", "Aus bus L. (Foo Group)", "Aus bus L.", " (Foo Group)"),
+	)
+
+	DescribeTable("NomAnnotations",
+		func(s string, body string, annots []string) {
+			bs := []byte(s)
+			nas, i := NomAnnotations(bs)
+			Expect(string(bs[0:i])).To(Equal(body))
+			var res []string
+			for _, v := range nas {
+				res = append(res, v.Type+":"+v.Value+":"+v.Verbatim)
+			}
+			Expect(res).To(Equal(annots))
+		},
+		Entry("no annotations", "Homo sapiens L.", "Homo sapiens L.", nil),
+		Entry("nom. nud.", "Homo sapiens L. nom. nud.", "Homo sapiens L.",
+			[]string{"status:nom. nud.:nom. nud."}),
+		Entry("nomen nudum", "Homo sapiens (nomen nudum)", "Homo sapiens",
+			[]string{"status:nom. nud.:nomen nudum"}),
+		Entry("comma", "Aus bus Gaertn., nom. illeg.", "Aus bus Gaertn.",
+			[]string{"status:nom. illeg.:nom. illeg."}),
+		Entry("ined.", "Aus bus ined.", "Aus bus",
+			[]string{"status:ined.:ined."}),
+		Entry("comb. nov.", "Aus bus (L.) Smith comb. nov.", "Aus bus (L.) Smith",
+			[]string{"act:comb. nov.:comb. nov."}),
+		Entry("sp. n.", "Aus bus Smith, 1999 sp. n.", "Aus bus Smith, 1999",
+			[]string{"act:sp. nov.:sp. n."}),
+		Entry("several", "Aus bus L., nom. illeg., nom. rej.", "Aus bus L.",
+			[]string{"status:nom. illeg.:nom. illeg.", "status:nom. rej.:nom. rej."}),
+		Entry("not at the end", "Aus nom. nud. bus", "Aus nom. nud. bus", nil),
+	)
+
+	It("keeps nomenclatural annotations out of tail", func() {
+		pr := Preprocess([]byte("Aus bus L. species group, nom. illeg."))
+		Expect(string(pr.Body)).To(Equal("Aus bus L."))
+		Expect(string(pr.Tail)).To(Equal(" species group"))
+		Expect(pr.NomAnnotations[0].Start).To(Equal(26))
+		Expect(pr.NomAnnotations[0].End).To(Equal(37))
+	})
+
+	It("removes annotations followed by a taxon concept or a year", func() {
+		pr := Preprocess([]byte("Aus bus Smith nom. nov. sensu Jones"))
+		Expect(string(pr.Body)).To(Equal("Aus bus Smith sensu Jones"))
+		Expect(pr.NomAnnotations[0].Value).To(Equal("nom. nov."))
+		Expect(pr.GapStart).To(Equal(13))
+		Expect(pr.GapLen).To(Equal(10))
+
+		pr = Preprocess([]byte("Aus bus Smith nom. nud. 1888"))
+		Expect(string(pr.Body)).To(Equal("Aus bus Smith 1888"))
+		Expect(pr.NomAnnotations[0].Value).To(Equal("nom. nud."))
+		Expect(pr.GapStart).To(Equal(13))
+		Expect(pr.GapLen).To(Equal(10))
+	})
+
+	DescribeTable("PreprocessCultivars",
+		func(s string, body string, tail string) {
+			pr := PreprocessCultivars([]byte(s))
+			Expect(string(pr.Body)).To(Equal(body))
+			Expect(string(pr.Tail)).To(Equal(tail))
+		},
+		Entry("cv.", "Acer palmatum cv. Atropurpureum",
+			"Acer palmatum cv. Atropurpureum", ""),
+		Entry("group", "Hosta Tardiana Group", "Hosta Tardiana Group", ""),
+		Entry("species group", "Aus bus species group", "Aus bus",
+			" species group"),
+		Entry("sensu", "Rosa 'Peace' sensu Smith", "Rosa 'Peace' sensu Smith",
+			""),
+	)
+
+	DescribeTable("TaxonConcept",
+		func(s string, body string, tail string, concept bool) {
+			pr := Preprocess([]byte(s))
+			Expect(string(pr.Body)).To(Equal(body))
+			Expect(string(pr.Tail)).To(Equal(tail))
+			Expect(pr.TaxonConcept).To(Equal(concept))
+		},
+		Entry("no concept", "Aus bus L.", "Aus bus L.", "", false),
+		Entry("sensu", "Aus bus sensu Smith 1990", "Aus bus sensu Smith 1990",
+			"", true),
+		Entry("sec.", "Aus bus sec. Smith", "Aus bus sec. Smith", "", true),
+		Entry("auct.", "Aus bus auct. non L.", "Aus bus auct. non L.", "", true),
+		Entry("s. l.", "Aus bus L., s.l.", "Aus bus L., s.l.", "", true),
+		Entry("s. str.", "Aus bus L. (s. str.)", "Aus bus L. (s. str.)", "",
+			true),
+		Entry("pro parte", "Aus bus L. pro parte", "Aus bus L. pro parte", "",
+			true),
+		Entry("near", "Aus bus near cus", "Aus bus", " near cus", false),
+		Entry("annotation", "Aus bus sensu Smith nom. nud.", "Aus bus sensu Smith",
+			"", true),
 	)
 
 	DescribeTable("UnderscoreToSpace",
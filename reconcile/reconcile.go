@@ -0,0 +1,303 @@
+// Package reconcile matches parsed names to names of a reference checklist.
+// The checklist is indexed by canonical forms, stems and authorships of
+// its names, so matching works offline and needs only one pass over the
+// parsed names.
+package reconcile
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnparser/authorship"
+	"github.com/gnames/gnparser/grammar"
+	"github.com/gnames/gnparser/output"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// maxCandidates limits the number of candidates of a match, partial matches
+// by a genus might find thousands of names otherwise.
+const maxCandidates = 10
+
+// MatchType tells how an input name matches names of the reference.
+type MatchType int
+
+const (
+	// NoMatch means that the reference does not contain the name.
+	NoMatch MatchType = iota
+	// PartialGenus means that only the genus (or the uninomial) of the name
+	// is found in the reference.
+	PartialGenus
+	// FuzzyStem means that stemmed canonical forms of names are the same,
+	// like 'Aus alba' and 'Aus albus'.
+	FuzzyStem
+	// Canonical means that canonical forms of names are the same, but
+	// authorships differ.
+	Canonical
+	// Exact means that canonical forms and authorship keys are the same.
+	Exact
+)
+
+var matchTypeMap = map[MatchType]string{
+	NoMatch:      "none",
+	PartialGenus: "partialGenus",
+	FuzzyStem:    "fuzzyStem",
+	Canonical:    "canonical",
+	Exact:        "exact",
+}
+
+func (mt MatchType) String() string {
+	return matchTypeMap[mt]
+}
+
+// MarshalText represents a match type as a string in JSON.
+func (mt MatchType) MarshalText() ([]byte, error) {
+	return []byte(mt.String()), nil
+}
+
+// Candidate is a name of the reference that matches an input name.
+type Candidate struct {
+	// ID is the line number of the name in the reference starting from 1.
+	ID int `json:"id"`
+	// Name is the verbatim name-string from the reference.
+	Name string `json:"name"`
+	// Canonical is the full canonical form of the name.
+	Canonical string `json:"canonical"`
+	// Authorship of the name.
+	Authorship string `json:"authorship,omitempty"`
+	// AuthorshipMatch compares the authorship of the name with the
+	// authorship of the input name.
+	AuthorshipMatch authorship.Comparison `json:"authorshipMatch"`
+}
+
+// Result is the result of matching of an input name to the reference.
+type Result struct {
+	// Name is the verbatim input name-string.
+	Name string `json:"name"`
+	// MatchType is the type of the best match.
+	MatchType MatchType `json:"matchType"`
+	// BestMatch is the best candidate, it is nil if nothing matched.
+	BestMatch *Candidate `json:"bestMatch,omitempty"`
+	// Candidates are all found names of the reference with the same type of
+	// the match, the best ones first.
+	Candidates []Candidate `json:"candidates,omitempty"`
+}
+
+// entry keeps data of a reference name needed for matching, so the index
+// does not hold positions, warnings and other data of parsed names.
+type entry struct {
+	id         int
+	name       string
+	canonical  string
+	authorship string
+	authOutput *grammar.AuthorshipOutput
+	authKey    string
+}
+
+// Reference is an index of names of a reference checklist.
+type Reference struct {
+	entries  []entry
+	byAuth   map[string][]int
+	byFull   map[string][]int
+	bySimple map[string][]int
+	byStem   map[string][]int
+	byGenus  map[string][]int
+}
+
+// NewReference creates an index of parsed names of a checklist. Names are
+// identified by their position in the list starting from 1. Names that
+// could not be parsed are ignored.
+func NewReference(outs []*output.Output) *Reference {
+	r := Reference{
+		byAuth:   make(map[string][]int),
+		byFull:   make(map[string][]int),
+		bySimple: make(map[string][]int),
+		byStem:   make(map[string][]int),
+		byGenus:  make(map[string][]int),
+	}
+	for i, o := range outs {
+		if !o.Parsed {
+			continue
+		}
+		idx := len(r.entries)
+		r.entries = append(r.entries, entry{
+			id:         i + 1,
+			name:       o.Verbatim,
+			canonical:  o.CanonicalName.Full,
+			authorship: o.Authorship,
+			authOutput: o.LastAuthorship(),
+			authKey:    authKey(o),
+		})
+		c := o.CanonicalName
+		r.byAuth[authKey(o)] = append(r.byAuth[authKey(o)], idx)
+		r.byFull[c.Full] = append(r.byFull[c.Full], idx)
+		r.bySimple[c.Simple] = append(r.bySimple[c.Simple], idx)
+		r.byStem[c.Stem] = append(r.byStem[c.Stem], idx)
+		g := genus(o)
+		r.byGenus[g] = append(r.byGenus[g], idx)
+	}
+	return &r
+}
+
+// Len returns the number of indexed names of the reference.
+func (r *Reference) Len() int {
+	return len(r.entries)
+}
+
+// Match finds the best match of a parsed name in the reference. Exact
+// matches are preferred to canonical ones, then come matches by stems and
+// by genus. Candidates with the same type of match are sorted by agreement
+// of their authorships with the input.
+func (r *Reference) Match(o *output.Output) Result {
+	res := Result{Name: o.Verbatim}
+	if !o.Parsed {
+		return res
+	}
+	c := o.CanonicalName
+	var ids []int
+	switch {
+	case len(r.byAuth[authKey(o)]) > 0:
+		res.MatchType = Exact
+		ids = union(r.byFull[c.Full], r.bySimple[c.Simple])
+	case len(r.byFull[c.Full]) > 0 || len(r.bySimple[c.Simple]) > 0:
+		res.MatchType = Canonical
+		ids = union(r.byFull[c.Full], r.bySimple[c.Simple])
+	case len(r.byStem[c.Stem]) > 0:
+		res.MatchType = FuzzyStem
+		ids = r.byStem[c.Stem]
+	case len(r.byGenus[genus(o)]) > 0:
+		res.MatchType = PartialGenus
+		ids = r.byGenus[genus(o)]
+	default:
+		return res
+	}
+	res.Candidates = r.candidates(o, ids)
+	res.BestMatch = &res.Candidates[0]
+	return res
+}
+
+// candidates creates candidates for the input name. Names with the same
+// authorship key go first, the rest are sorted by their authorships.
+func (r *Reference) candidates(o *output.Output, ids []int) []Candidate {
+	ao := o.LastAuthorship()
+	key := authKey(o)
+	res := make([]Candidate, len(ids))
+	sameKey := make([]bool, len(ids))
+	for i, idx := range ids {
+		e := r.entries[idx]
+		sameKey[i] = e.authKey == key
+		res[i] = Candidate{
+			ID:              e.id,
+			Name:            e.name,
+			Canonical:       e.canonical,
+			Authorship:      e.authorship,
+			AuthorshipMatch: authorship.CompareAuthorship(ao, e.authOutput),
+		}
+	}
+	sort.Stable(byAuthorship{res, sameKey})
+	if len(res) > maxCandidates {
+		res = res[:maxCandidates]
+	}
+	return res
+}
+
+// CSVHeader returns names of fields created by Result.ToSlice.
+func CSVHeader() []string {
+	return []string{
+		"Name",
+		"MatchType",
+		"MatchID",
+		"MatchName",
+		"MatchCanonical",
+		"MatchAuthorship",
+		"AuthorshipMatch",
+		"CandidateIDs",
+	}
+}
+
+// ToSlice creates a flat version of the result for CSV output. IDs of
+// candidates are separated by semicolons.
+func (res Result) ToSlice() []string {
+	if res.BestMatch == nil {
+		return []string{res.Name, res.MatchType.String(), "", "", "", "", "", ""}
+	}
+	ids := make([]string, len(res.Candidates))
+	for i, v := range res.Candidates {
+		ids[i] = strconv.Itoa(v.ID)
+	}
+	bm := res.BestMatch
+	return []string{
+		res.Name,
+		res.MatchType.String(),
+		strconv.Itoa(bm.ID),
+		bm.Name,
+		bm.Canonical,
+		bm.Authorship,
+		bm.AuthorshipMatch.Match.String(),
+		strings.Join(ids, "; "),
+	}
+}
+
+// byAuthorship sorts candidates and their flags of the same authorship
+// key together.
+type byAuthorship struct {
+	cs      []Candidate
+	sameKey []bool
+}
+
+func (b byAuthorship) Len() int { return len(b.cs) }
+
+func (b byAuthorship) Swap(i, j int) {
+	b.cs[i], b.cs[j] = b.cs[j], b.cs[i]
+	b.sameKey[i], b.sameKey[j] = b.sameKey[j], b.sameKey[i]
+}
+
+func (b byAuthorship) Less(i, j int) bool {
+	if b.sameKey[i] != b.sameKey[j] {
+		return b.sameKey[i]
+	}
+	return b.cs[i].AuthorshipMatch.Match < b.cs[j].AuthorshipMatch.Match
+}
+
+// ToJSON converts the result to JSON representation.
+func (res Result) ToJSON(pretty bool) ([]byte, error) {
+	if pretty {
+		return jsoniter.MarshalIndent(res, "", "  ")
+	}
+	return jsoniter.Marshal(res)
+}
+
+func authKey(o *output.Output) string {
+	return o.CanonicalName.Full + "\t" + authorship.Key(o.LastAuthorship())
+}
+
+// genus returns the first word of the canonical form, a genus or
+// a uninomial.
+func genus(o *output.Output) string {
+	ws := strings.Fields(o.CanonicalName.Simple)
+	if len(ws) == 0 {
+		return ""
+	}
+	return ws[0]
+}
+
+// union merges two sorted lists of indices without duplicates.
+func union(a, b []int) []int {
+	res := make([]int, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || (i < len(a) && a[i] < b[j]):
+			res = append(res, a[i])
+			i++
+		case i == len(a) || b[j] < a[i]:
+			res = append(res, b[j])
+			j++
+		default:
+			res = append(res, a[i])
+			i++
+			j++
+		}
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+package reconcile_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/authorship"
+	. "github.com/gnames/gnparser/reconcile"
+)
+
+var _ = Describe("Reconcile", func() {
+	gnp := gnparser.NewGNparser()
+	ref := NewReference(gnp.ParseNames([]string{
+		"Aus bus (L.) Sm.",
+		"Aus bus (L.) Mill.",
+		"Aus albus Linnaeus 1753",
+		"Aus cus var. dus L.",
+		"not a name",
+		"Bus cus Smith",
+	}))
+
+	It("indexes parsed names", func() {
+		Expect(ref.Len()).To(Equal(5))
+	})
+
+	DescribeTable("Match",
+		func(name string, mt MatchType, id int, ids []int) {
+			o := gnp.ParseName(name)
+			res := ref.Match(o)
+			Expect(res.MatchType).To(Equal(mt))
+			if id == 0 {
+				Expect(res.BestMatch).To(BeNil())
+				return
+			}
+			Expect(res.BestMatch.ID).To(Equal(id))
+			var resIDs []int
+			for _, v := range res.Candidates {
+				resIDs = append(resIDs, v.ID)
+			}
+			Expect(resIDs).To(Equal(ids))
+		},
+		Entry("exact", "Aus bus (L.)Mill", Exact, 2, []int{2, 1}),
+		Entry("exact with full names", "Aus bus (Linnaeus) Miller",
+			Exact, 2, []int{2, 1}),
+		Entry("canonical", "Aus cus subsp. dus", Canonical, 4, []int{4}),
+		Entry("canonical with other authors", "Aus bus (L.) DC.",
+			Canonical, 1, []int{1, 2}),
+		Entry("fuzzy stem", "Aus alba L.", FuzzyStem, 3, []int{3}),
+		Entry("partial genus", "Bus xus", PartialGenus, 6, []int{6}),
+		Entry("none", "Cus dus", NoMatch, 0, nil),
+		Entry("not parsed", "not a name", NoMatch, 0, nil),
+	)
+
+	It("compares authorships of candidates", func() {
+		o := gnp.ParseName("Aus albus L.")
+		res := ref.Match(o)
+		Expect(res.BestMatch.AuthorshipMatch.Match).
+			To(Equal(authorship.Compatible))
+		Expect(res.ToSlice()).To(Equal([]string{
+			"Aus albus L.", "canonical", "3", "Aus albus Linnaeus 1753",
+			"Aus albus", "Linnaeus 1753", "compatible", "3",
+		}))
+		Expect(len(CSVHeader())).To(Equal(len(res.ToSlice())))
+		bs, err := res.ToJSON(false)
+		Expect(err).To(BeNil())
+		Expect(string(bs)).To(ContainSubstring(`"matchType":"canonical"`))
+	})
+})
@@ -4,14 +4,16 @@ import (
 	"fmt"
 	"log"
 	"net"
-	"sync"
 
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/dict"
+	"github.com/gnames/gnparser/grammar"
 	"github.com/gnames/gnparser/output"
 	"github.com/gnames/gnparser/pb"
 	context "golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type gnparserServer struct {
@@ -43,8 +45,16 @@ func (gnps gnparserServer) ParseArray(ctx context.Context,
 		err := fmt.Errorf("empty input")
 		return nil, err
 	}
+	if ia.Code != "" {
+		if _, err := grammar.NewCode(ia.Code); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 
-	parsed := gnps.parseArray(ia)
+	parsed, err := gnps.parseArray(ctx, ia)
+	if err != nil {
+		return nil, err
+	}
 	oa := &pb.OutputArray{Output: parsed}
 	return oa, nil
 }
@@ -65,62 +75,48 @@ func Run(port int, workersNum int) {
 	log.Fatal(srv.Serve(l))
 }
 
-type parseArrayOutput struct {
-	inputName    string
-	outputParsed *pb.Parsed
-}
-
-func (gnps gnparserServer) parseArray(ia *pb.InputArray) []*pb.Parsed {
+// parseArray parses names concurrently and places results according to
+// their indices in the input. It stops if the call is cancelled or its
+// deadline is exceeded.
+func (gnps gnparserServer) parseArray(ctx context.Context,
+	ia *pb.InputArray) ([]*pb.Parsed, error) {
 	jobs := int(ia.JobsNumber)
 	if jobs == 0 || gnps.MaxWorkersNum < jobs {
 		jobs = gnps.MaxWorkersNum
 	}
 	skipClean := ia.SkipCleaning
 	log.Printf("Processing %d names using %d jobs", len(ia.Names), jobs)
-	resMap := make(map[string]*pb.Parsed)
 	inCh := make(chan string)
-	outCh := make(chan *parseArrayOutput)
-	var parseWg sync.WaitGroup
-	var processWg sync.WaitGroup
-	processWg.Add(1)
-	parseWg.Add(jobs)
-
-	for i := 0; i < jobs; i++ {
-		go parseWorker(inCh, outCh, skipClean, &parseWg)
-	}
-	go processParseArray(outCh, &processWg, resMap)
-	for _, v := range ia.Names {
-		inCh <- v
+	outCh := make(chan *pb.Parsed)
+	errCh := make(chan error, 1)
+	opts := []gnparser.Option{gnparser.OptRemoveHTML(!skipClean)}
+	if ia.Code != "" {
+		opts = append(opts, gnparser.OptCode(ia.Code))
 	}
-	close(inCh)
-	parseWg.Wait()
-	close(outCh)
-	processWg.Wait()
-
-	res := make([]*pb.Parsed, len(ia.Names))
-	for i, v := range ia.Names {
-		if pv, ok := resMap[v]; ok {
-			res[i] = pv
+	go func() {
+		errCh <- gnparser.ParseStreamToObjectsContext(ctx, jobs, inCh, outCh,
+			opts...)
+	}()
+	go func() {
+		defer close(inCh)
+		for _, v := range ia.Names {
+			select {
+			case inCh <- v:
+			case <-ctx.Done():
+				return
+			}
 		}
-	}
-	return res
-}
+	}()
 
-func parseWorker(inCh <-chan string, outCh chan<- *parseArrayOutput,
-	skipClean bool, wg *sync.WaitGroup) {
-	defer wg.Done()
-	opts := []gnparser.Option{gnparser.OptRemoveHTML(!skipClean)}
-	gnp := gnparser.NewGNparser(opts...)
-	for v := range inCh {
-		res := gnp.ParseToObject(v)
-		outCh <- &parseArrayOutput{inputName: v, outputParsed: res}
+	res := make([]*pb.Parsed, len(ia.Names))
+	for v := range outCh {
+		res[v.Idx] = v
 	}
-}
-
-func processParseArray(ch <-chan *parseArrayOutput, wg *sync.WaitGroup,
-	resMap map[string]*pb.Parsed) {
-	defer wg.Done()
-	for v := range ch {
-		resMap[v.inputName] = v.outputParsed
+	if err := <-errCh; err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		return nil, err
 	}
+	return res, nil
 }
@@ -46,16 +46,16 @@ func genTestData() error {
 		case 1:
 			nameString = line
 			w.Write([]byte(nameString + "\n"))
-			gnp.Parse(nameString)
-			res := gnp.ParsedName()
+			o := gnp.ParseName(nameString)
+			res := gnp.ParsedNameString(nameString)
 			w.Write([]byte(res + "\n"))
-			bs, err := gnp.ToJSON()
+			bs, err := o.ToJSON(false)
 			if err != nil {
 				return err
 			}
 			w.Write(bs)
 			w.Write([]byte("\n"))
-			sl := gnp.ToSlice()
+			sl := o.ToSlice()
 			res = output.ToCSV(sl) + "\n"
 			w.Write([]byte(res))
 		case 4:
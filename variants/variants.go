@@ -0,0 +1,306 @@
+// Package variants generates orthographic variants of canonical forms of
+// names for search and matching. Every variant is tagged with the rule that
+// produced it. A variant differs from the original canonical form by one
+// word changed by one rule.
+package variants
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gnames/gnparser/output"
+	"github.com/gnames/gnparser/str"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Rule is a rule of generation of a variant.
+type Rule int
+
+const (
+	// GenderEnding changes gender endings of epithets, like 'albus', 'alba'
+	// and 'album', 'brevis' and 'breve', 'niger', 'nigra' and 'nigrum'.
+	GenderEnding Rule = iota
+	// Genitive changes genitive endings of epithets, like 'smithii' and
+	// 'smithi'.
+	Genitive
+	// Diphthong replaces 'ae' and 'oe' by 'e', like 'caeruleus' and
+	// 'ceruleus'.
+	Diphthong
+	// LetterJ replaces 'j' by 'i', and 'i' at the start of a word before
+	// a vowel by 'j', like 'javanicus' and 'iavanicus'.
+	LetterJ
+	// LetterV replaces 'v' by 'u', and 'u' at the start of a word before
+	// a vowel by 'v', like 'vulgaris' and 'uulgaris'.
+	LetterV
+	// Ligature restores ligatures, like 'Cæsalpinia' for 'Caesalpinia'.
+	Ligature
+	// Diacritic restores diacritics, like 'Aëtosaurus' for 'Aetosaurus' or
+	// 'Döringina' for 'Doeringina'.
+	Diacritic
+)
+
+var ruleMap = map[Rule]string{
+	GenderEnding: "genderEnding",
+	Genitive:     "genitive",
+	Diphthong:    "diphthong",
+	LetterJ:      "letterJ",
+	LetterV:      "letterV",
+	Ligature:     "ligature",
+	Diacritic:    "diacritic",
+}
+
+func (r Rule) String() string {
+	return ruleMap[r]
+}
+
+// MarshalText represents a rule as a string in JSON.
+func (r Rule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// Variant is a variant of a canonical form.
+type Variant struct {
+	// Canonical is the canonical form of the variant.
+	Canonical string `json:"canonical"`
+	// Rule is the rule that produced the variant.
+	Rule Rule `json:"rule"`
+}
+
+// Result contains variants of a parsed name.
+type Result struct {
+	// Name is the verbatim name-string.
+	Name string `json:"name"`
+	// Canonical is the simple canonical form of the name.
+	Canonical string `json:"canonical,omitempty"`
+	// Variants of the canonical form.
+	Variants []Variant `json:"variants,omitempty"`
+}
+
+// ToJSON converts the result to JSON representation.
+func (res Result) ToJSON(pretty bool) ([]byte, error) {
+	if pretty {
+		return jsoniter.MarshalIndent(res, "", "  ")
+	}
+	return jsoniter.Marshal(res)
+}
+
+// Generate creates variants of the simple canonical form of a parsed name.
+// Names that could not be parsed have no variants.
+func Generate(o *output.Output) Result {
+	res := Result{Name: o.Verbatim}
+	if !o.Parsed {
+		return res
+	}
+	res.Canonical = o.CanonicalName.Simple
+	res.Variants = GenerateCanonical(res.Canonical)
+	return res
+}
+
+// wordRule creates variants of a word. Epithet is false for capitalized
+// words, like genera.
+type wordRule struct {
+	rule Rule
+	gen  func(w string, epithet bool) []string
+}
+
+var wordRules = []wordRule{
+	{GenderEnding, genderEndings},
+	{Genitive, genitives},
+	{Diphthong, diphthongs},
+	{LetterJ, func(w string, _ bool) []string { return letters(w, 'j', 'i') }},
+	{LetterV, func(w string, _ bool) []string { return letters(w, 'v', 'u') }},
+	{Ligature, ligatures},
+	{Diacritic, diacritics},
+}
+
+// GenerateCanonical creates deduplicated variants of a canonical form. The
+// canonical form itself is not included.
+func GenerateCanonical(c string) []Variant {
+	var res []Variant
+	seen := map[string]struct{}{c: {}}
+	words := strings.Split(c, " ")
+	for _, r := range wordRules {
+		for i, w := range words {
+			if !isWord(w) {
+				continue
+			}
+			epithet := !unicode.IsUpper([]rune(w)[0])
+			for _, v := range r.gen(w, epithet) {
+				ws := append([]string{}, words...)
+				ws[i] = v
+				vc := strings.Join(ws, " ")
+				if _, ok := seen[vc]; ok {
+					continue
+				}
+				seen[vc] = struct{}{}
+				res = append(res, Variant{Canonical: vc, Rule: r.rule})
+			}
+		}
+	}
+	return res
+}
+
+// isWord is true for words that consist of letters, hybrid signs and
+// similar words are ignored.
+func isWord(w string) bool {
+	if len(w) < 2 {
+		return false
+	}
+	for _, r := range w {
+		if !unicode.IsLetter(r) && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiouyAEIOUY", b) > -1
+}
+
+// genderEndings changes endings of epithets between masculine, feminine and
+// neuter forms.
+func genderEndings(w string, epithet bool) []string {
+	if !epithet || strings.HasSuffix(w, "ae") {
+		return nil
+	}
+	// niger, nigra, nigrum
+	for _, e := range []string{"er", "ra", "rum"} {
+		if !strings.HasSuffix(w, e) {
+			continue
+		}
+		stem := w[0 : len(w)-len(e)]
+		if e != "er" && (len(stem) < 2 || isVowel(stem[len(stem)-1])) {
+			continue
+		}
+		return []string{stem + "er", stem + "ra", stem + "rum"}
+	}
+	for _, es := range [][]string{{"us", "a", "um"}, {"is", "e"}} {
+		for _, e := range es {
+			if !strings.HasSuffix(w, e) || len(w) < len(e)+2 {
+				continue
+			}
+			stem := w[0 : len(w)-len(e)]
+			if e == "e" && isVowel(stem[len(stem)-1]) {
+				continue
+			}
+			var res []string
+			for _, v := range es {
+				res = append(res, stem+v)
+			}
+			return res
+		}
+	}
+	return nil
+}
+
+// genitives changes '-ii' to '-i' and back.
+func genitives(w string, epithet bool) []string {
+	switch {
+	case !epithet || len(w) < 4:
+		return nil
+	case strings.HasSuffix(w, "ii"):
+		return []string{w[0 : len(w)-1]}
+	case strings.HasSuffix(w, "i"):
+		return []string{w + "i"}
+	}
+	return nil
+}
+
+// diphthongs replace 'ae' and 'oe' by 'e'.
+func diphthongs(w string, _ bool) []string {
+	var res []string
+	for _, d := range []string{"ae", "oe", "Ae", "Oe"} {
+		if strings.Contains(w, d) {
+			e := d[1:]
+			if d[0] < 'a' {
+				e = "E"
+			}
+			res = append(res, strings.Replace(w, d, e, -1))
+		}
+	}
+	return res
+}
+
+// letters replace one letter by another as it is done by the stemmer, like
+// 'j' by 'i'. The second letter at the start of a word before a vowel is
+// replaced by the first one.
+func letters(w string, from, to byte) []string {
+	var res []string
+	lw := strings.ToLower(w)
+	if strings.IndexByte(lw, from) > -1 {
+		v := strings.Replace(w, string(from), string(to), -1)
+		up := string(unicode.ToUpper(rune(from)))
+		v = strings.Replace(v, up, strings.ToUpper(string(to)), -1)
+		res = append(res, v)
+	}
+	if lw[0] == to && isVowel(lw[1]) {
+		f := string(from)
+		if w[0] != lw[0] {
+			f = strings.ToUpper(f)
+		}
+		res = append(res, f+w[1:])
+	}
+	return res
+}
+
+// restorations keep characters that are transliterated to two letters,
+// like 'æ' to 'ae', grouped by their transliterations.
+var ligatureRestorations, diacriticRestorations = restorations()
+
+func restorations() (map[string][]string, map[string][]string) {
+	lig := make(map[string][]string)
+	dia := make(map[string][]string)
+	for r, v := range str.Transliterations {
+		if len(v) != 2 || !unicode.IsLetter(r) {
+			continue
+		}
+		switch r {
+		case 'æ', 'Æ', 'œ', 'Œ':
+			lig[v] = append(lig[v], string(r))
+		case 'ß', 'þ', 'Þ', 'å', 'Å':
+		default:
+			dia[v] = append(dia[v], string(r))
+		}
+	}
+	for _, m := range []map[string][]string{lig, dia} {
+		for k := range m {
+			sort.Strings(m[k])
+		}
+	}
+	return lig, dia
+}
+
+func ligatures(w string, _ bool) []string {
+	return restore(w, ligatureRestorations)
+}
+
+// diacritics restore umlauts, like 'ö' for 'oe', and diaeresis of 'e' after
+// a vowel, like 'ë' in 'Aëtosaurus'.
+func diacritics(w string, _ bool) []string {
+	res := restore(w, diacriticRestorations)
+	for i := 1; i < len(w); i++ {
+		if w[i] == 'e' && isVowel(w[i-1]) {
+			res = append(res, w[0:i]+"ë"+w[i+1:])
+		}
+	}
+	return res
+}
+
+// restore replaces every occurrence of two letters by characters that are
+// transliterated to them, one occurrence at a time.
+func restore(w string, m map[string][]string) []string {
+	var res []string
+	for i := 0; i+1 < len(w); {
+		_, size := utf8.DecodeRuneInString(w[i:])
+		if size == 1 {
+			for _, v := range m[w[i:i+2]] {
+				res = append(res, w[0:i]+v+w[i+2:])
+			}
+		}
+		i += size
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+package variants_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/gnames/gnparser"
+	. "github.com/gnames/gnparser/variants"
+)
+
+var _ = Describe("Variants", func() {
+	DescribeTable("GenerateCanonical",
+		func(c string, expected map[string]Rule) {
+			res := make(map[string]Rule)
+			for _, v := range GenerateCanonical(c) {
+				res[v.Canonical] = v.Rule
+			}
+			for k, v := range expected {
+				Expect(res).To(HaveKeyWithValue(k, v))
+			}
+		},
+		Entry("gender endings", "Aus albus", map[string]Rule{
+			"Aus alba": GenderEnding, "Aus album": GenderEnding,
+		}),
+		Entry("-er endings", "Aus niger", map[string]Rule{
+			"Aus nigra": GenderEnding, "Aus nigrum": GenderEnding,
+		}),
+		Entry("-is endings", "Aus brevis", map[string]Rule{
+			"Aus breve": GenderEnding,
+		}),
+		Entry("genitive", "Aus smithii", map[string]Rule{
+			"Aus smithi": Genitive,
+		}),
+		Entry("diphthong", "Aus caeruleus", map[string]Rule{
+			"Aus ceruleus": Diphthong,
+		}),
+		Entry("j and i", "Aus javanicus", map[string]Rule{
+			"Aus iavanicus": LetterJ,
+		}),
+		Entry("u and v", "Aus uulgaris", map[string]Rule{
+			"Aus vulgaris": LetterV,
+		}),
+		Entry("ligature", "Caesalpinia", map[string]Rule{
+			"Cæsalpinia": Ligature,
+		}),
+		Entry("diacritics", "Doeringina", map[string]Rule{
+			"Döringina": Diacritic,
+		}),
+		Entry("diaeresis", "Aetosaurus", map[string]Rule{
+			"Aëtosaurus": Diacritic,
+		}),
+	)
+
+	It("deduplicates variants", func() {
+		vs := GenerateCanonical("Aus vulgaris uulgaris")
+		seen := make(map[string]struct{})
+		for _, v := range vs {
+			Expect(seen).ToNot(HaveKey(v.Canonical))
+			Expect(v.Canonical).ToNot(Equal("Aus vulgaris uulgaris"))
+			seen[v.Canonical] = struct{}{}
+		}
+		Expect(seen).To(HaveKey("Aus vulgaris vulgaris"))
+	})
+
+	It("does not change endings of genera", func() {
+		Expect(GenerateCanonical("Bus")).To(BeEmpty())
+		for _, v := range GenerateCanonical("Albus") {
+			Expect(v.Rule).ToNot(Equal(GenderEnding))
+		}
+	})
+
+	It("generates variants of parsed names", func() {
+		gnp := gnparser.NewGNparser()
+		o := gnp.ParseName("Aus albus var. alba L.")
+		res := Generate(o)
+		Expect(res.Canonical).To(Equal("Aus albus alba"))
+		Expect(res.Variants[0]).
+			To(Equal(Variant{Canonical: "Aus alba alba", Rule: GenderEnding}))
+		bs, err := res.ToJSON(false)
+		Expect(err).To(BeNil())
+		Expect(string(bs)).To(ContainSubstring(`"rule":"genderEnding"`))
+
+		o = gnp.ParseName("not a name")
+		Expect(Generate(o).Variants).To(BeNil())
+	})
+})
@@ -1,14 +1,17 @@
 package web
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"strings"
-	"sync"
 
 	"github.com/gorilla/mux"
 
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/grammar"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -24,42 +27,156 @@ func apiGetParse(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, "[]\n")
 		return
 	}
+	code := r.URL.Query().Get("code")
+	if !checkCode(w, code) {
+		return
+	}
 	names := strings.Split(namesPipe, "|")
-	parseSlice(w, names)
+	parseSlice(r.Context(), w, names, code)
 }
 
 func apiPostParse(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("code")
+	if !checkCode(w, code) {
+		return
+	}
 	var names []string
 	_ = jsoniter.NewDecoder(r.Body).Decode(&names)
 	if names == nil || len(names) == 0 {
 		fmt.Fprint(w, "[]\n")
 		return
 	}
-	parseSlice(w, names)
+	parseSlice(r.Context(), w, names, code)
 }
 
-func parseSlice(w http.ResponseWriter, ns []string) {
-	in := make(chan string)
-	out := make(chan *gnparser.ParseResult)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	opts := []gnparser.Option{gnparser.OptFormat("compact")}
-	go gnparser.ParseStream(8, in, out, opts...)
-	go processResults(w, out, &wg)
-	for _, v := range ns {
-		in <- v
+// checkCode writes the 'Bad Request' response and returns false if a code
+// is not empty and is not one of the known nomenclatural codes.
+func checkCode(w http.ResponseWriter, code string) bool {
+	if code == "" {
+		return true
+	}
+	if _, err := grammar.NewCode(code); err != nil {
+		badRequest(w, err)
+		return false
+	}
+	return true
+}
+
+// checkPairs writes the 'Bad Request' response and returns false if one of
+// the pairs does not contain exactly two names.
+func checkPairs(w http.ResponseWriter, pairs [][]string) bool {
+	for i, v := range pairs {
+		if len(v) != 2 {
+			err := fmt.Errorf("pair %d has %d names instead of 2", i, len(v))
+			badRequest(w, err)
+			return false
+		}
 	}
-	close(in)
-	wg.Wait()
+	return true
 }
 
-func processResults(w http.ResponseWriter, out <-chan *gnparser.ParseResult, wg *sync.WaitGroup) {
-	defer wg.Done()
-	var res []string
+// badRequest writes the 'Bad Request' status and the error as JSON.
+func badRequest(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusBadRequest)
+	res, _ := jsoniter.Marshal(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+	fmt.Fprintln(w, string(res))
+}
+
+// parseSlice parses names and writes results in the order of the input.
+// An empty code means that names can belong to any nomenclatural code.
+// Parsing stops if the client disconnects.
+func parseSlice(ctx context.Context, w http.ResponseWriter, ns []string,
+	code string) {
+	in := make(chan string)
+	out := make(chan *gnparser.ParseResult)
+	errCh := make(chan error, 1)
+	opts := []gnparser.Option{
+		gnparser.OptFormat("compact"),
+		gnparser.OptPreserveOrder(true),
+	}
+	if code != "" {
+		opts = append(opts, gnparser.OptCode(code))
+	}
+	go func() {
+		errCh <- gnparser.ParseStreamContext(ctx, 8, in, out, opts...)
+	}()
+	go sendNames(ctx, in, ns)
+	res := make([]string, 0, len(ns))
 	for r := range out {
 		if r.Error == nil {
 			res = append(res, r.Output)
 		}
 	}
+	if err := <-errCh; err != nil {
+		log.Printf("Parsing of %d names: %s", len(ns), err)
+		if ctx.Err() != nil {
+			return
+		}
+	}
 	fmt.Fprint(w, "[\n"+strings.Join(res, ",\n")+"]\n")
 }
+
+func apiGetCompare(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("code")
+	if !checkCode(w, code) {
+		return
+	}
+	params := mux.Vars(r)
+	pairs := [][]string{{params["a"], params["b"]}}
+	comparePairs(w, pairs, code)
+}
+
+func apiPostCompare(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("code")
+	if !checkCode(w, code) {
+		return
+	}
+	var pairs [][]string
+	err := jsoniter.NewDecoder(r.Body).Decode(&pairs)
+	if err != nil && err != io.EOF {
+		badRequest(w, err)
+		return
+	}
+	if !checkPairs(w, pairs) {
+		return
+	}
+	if len(pairs) == 0 {
+		fmt.Fprint(w, "[]\n")
+		return
+	}
+	comparePairs(w, pairs, code)
+}
+
+// comparePairs compares pairs of names and writes results in the order of
+// the input. Every pair has to contain two names.
+func comparePairs(w io.Writer, pairs [][]string, code string) {
+	var opts []gnparser.Option
+	if code != "" {
+		opts = append(opts, gnparser.OptCode(code))
+	}
+	gnp := gnparser.NewGNparser(opts...)
+	res := make([]gnparser.Comparison, 0, len(pairs))
+	for _, v := range pairs {
+		res = append(res, gnp.Compare(v[0], v[1]))
+	}
+	bs, err := jsoniter.Marshal(res)
+	if err != nil {
+		log.Printf("Comparison of %d pairs: %s", len(pairs), err)
+		fmt.Fprint(w, "[]\n")
+		return
+	}
+	fmt.Fprintln(w, string(bs))
+}
+
+func sendNames(ctx context.Context, in chan<- string, ns []string) {
+	defer close(in)
+	for _, v := range ns {
+		select {
+		case in <- v:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -19,6 +19,10 @@ func Run(port int, wn int) {
 	router.HandleFunc("/api", apiGetParse).Methods("GET").Queries("q", "{q}")
 	router.HandleFunc("/api", apiPostParse).Methods("POST")
 	router.HandleFunc("/api", apiEmptyRequest)
+	router.HandleFunc("/api/compare", apiGetCompare).Methods("GET").
+		Queries("a", "{a}", "b", "{b}")
+	router.HandleFunc("/api/compare", apiPostCompare).Methods("POST")
+	router.HandleFunc("/api/compare", apiEmptyRequest)
 	router.PathPrefix("/").Handler(http.FileServer(fs.Files))
 	srv := &http.Server{
 		Handler: router,
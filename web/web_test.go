@@ -1,6 +1,12 @@
 package web
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -19,4 +25,66 @@ var _ = Describe("Web", func() {
 		Entry("\r\nBubo\r\nHomo\n\n", "\r\nBubo\r\nHomo\n\n", []string{"Bubo", "Homo"}),
 		Entry("Bubo\r\nHomo   \n", "\r\nBubo\r\nHomo   \n", []string{"Bubo", "Homo"}),
 	)
+
+	Describe("comparePairs", func() {
+		It("compares pairs of names", func() {
+			var buf bytes.Buffer
+			pairs := [][]string{{"Aus bus L.", "Aus bus L."}, {"Aus", "Bus"}}
+			comparePairs(&buf, pairs, "")
+			var res []map[string]interface{}
+			err := json.Unmarshal(buf.Bytes(), &res)
+			Expect(err).To(BeNil())
+			Expect(len(res)).To(Equal(2))
+			Expect(res[0]["nameMatch"]).To(Equal("canonical"))
+			Expect(res[0]["score"]).To(Equal(1.0))
+		})
+
+		It("rejects malformed requests", func() {
+			for _, v := range []string{`[["Aus bus L.", "Aus bus L."], ["Aus"]]`,
+				`[["Aus", "Bus", "Cus"]]`, `{"a": "Aus"}`, `[["Aus", "Bus"]`} {
+				r := httptest.NewRequest("POST", "/api/compare",
+					strings.NewReader(v))
+				w := httptest.NewRecorder()
+				apiPostCompare(w, r)
+				Expect(w.Code).To(Equal(http.StatusBadRequest), v)
+				Expect(w.Body.String()).To(ContainSubstring(`"error"`), v)
+			}
+		})
+
+		It("accepts an empty request", func() {
+			for _, v := range []string{"", "[]"} {
+				r := httptest.NewRequest("POST", "/api/compare",
+					strings.NewReader(v))
+				w := httptest.NewRecorder()
+				apiPostCompare(w, r)
+				Expect(w.Code).To(Equal(http.StatusOK), v)
+				Expect(w.Body.String()).To(Equal("[]\n"), v)
+			}
+		})
+	})
+	Describe("checkCode", func() {
+		It("rejects unknown codes", func() {
+			r := httptest.NewRequest("POST", "/api?code=unknown",
+				strings.NewReader(`["Aus bus"]`))
+			w := httptest.NewRecorder()
+			apiPostParse(w, r)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("unknown"))
+
+			r = httptest.NewRequest("POST", "/api/compare?code=unknown",
+				strings.NewReader(`[["Aus bus", "Aus bus"]]`))
+			w = httptest.NewRecorder()
+			apiPostCompare(w, r)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("accepts known and empty codes", func() {
+			r := httptest.NewRequest("POST", "/api?code=botanical",
+				strings.NewReader(`["Aus bus"]`))
+			w := httptest.NewRecorder()
+			apiPostParse(w, r)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(checkCode(httptest.NewRecorder(), "")).To(BeTrue())
+		})
+	})
 })